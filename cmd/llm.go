@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/abhisek/mathiz/internal/llm"
@@ -85,6 +87,7 @@ var llmViewCmd = &cobra.Command{
 		if _, err := fmt.Sscanf(args[0], "%d", &id); err != nil {
 			return fmt.Errorf("invalid ID %q: %w", args[0], err)
 		}
+		owner, _ := cmd.Flags().GetString("owner")
 
 		dbPath, err := resolveDBPath(cmd)
 		if err != nil {
@@ -98,7 +101,7 @@ var llmViewCmd = &cobra.Command{
 		defer s.Close()
 
 		ctx := context.Background()
-		e, err := s.EventRepo().GetLLMEvent(ctx, id)
+		e, err := s.EventRepoFor(owner).GetLLMEvent(ctx, id)
 		if err != nil {
 			return fmt.Errorf("get event: %w", err)
 		}
@@ -145,7 +148,7 @@ var llmViewCmd = &cobra.Command{
 
 var llmStatsCmd = &cobra.Command{
 	Use:   "stats",
-	Short: "Show aggregated LLM token usage and estimated cost",
+	Short: "Show aggregated LLM token usage, estimated cost, and question sources",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		dbPath, err := resolveDBPath(cmd)
 		if err != nil {
@@ -166,7 +169,7 @@ var llmStatsCmd = &cobra.Command{
 
 		if len(stats) == 0 {
 			fmt.Println("No LLM usage recorded yet.")
-			return nil
+			return printQuestionSources(ctx, s.EventRepo())
 		}
 
 		// Usage by purpose.
@@ -233,10 +236,43 @@ var llmStatsCmd = &cobra.Command{
 			}
 		}
 
-		return nil
+		return printQuestionSources(ctx, s.EventRepo())
 	},
 }
 
+// printQuestionSources shows where session questions came from, so a high
+// offline share flags a failing or slow provider.
+func printQuestionSources(ctx context.Context, repo store.EventRepo) error {
+	sessions, err := repo.QuerySessionSummaries(ctx, store.QueryOpts{})
+	if err != nil {
+		return fmt.Errorf("query sessions: %w", err)
+	}
+	counts := make(map[string]int)
+	var total int
+	for _, s := range sessions {
+		for src, n := range s.QuestionSources {
+			counts[src] += n
+			total += n
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("Question Sources")
+	fmt.Println(strings.Repeat("\u2500", 72))
+	fmt.Printf("%-16s  %6s  %8s\n", "Source", "Count", "Share")
+	fmt.Println(strings.Repeat("\u2500", 72))
+	sources := slices.Sorted(maps.Keys(counts))
+	for _, src := range sources {
+		fmt.Printf("%-16s  %6d  %7.1f%%\n", src, counts[src], 100*float64(counts[src])/float64(total))
+	}
+	fmt.Println(strings.Repeat("\u2500", 72))
+	fmt.Printf("%-16s  %6d\n", "TOTAL", total)
+	return nil
+}
+
 func truncate(s string, max int) string {
 	if len(s) <= max {
 		return s
@@ -254,6 +290,7 @@ func formatCost(usd float64) string {
 func init() {
 	llmListCmd.Flags().IntP("limit", "n", 20, "Number of events to show")
 	llmListCmd.Flags().StringP("purpose", "p", "", "Filter by purpose (e.g. question-gen, lesson, diagnosis)")
+	llmViewCmd.Flags().String("owner", store.LocalOwner, "Learner whose event to view (a child UID in serve mode)")
 
 	llmCmd.AddCommand(llmListCmd)
 	llmCmd.AddCommand(llmViewCmd)
@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 )
 
@@ -24,8 +22,3 @@ func isDirectSession(cmd *cobra.Command) bool {
 	_, ok := cmd.Annotations["direct_session"]
 	return ok
 }
-
-// errNoLLM is returned when play is invoked without a configured LLM provider.
-var errNoLLM = fmt.Errorf("mathiz play requires a configured LLM provider.\n" +
-	"Set MATHIZ_LLM_PROVIDER and the corresponding API key environment variable.\n" +
-	"Example: MATHIZ_LLM_PROVIDER=anthropic MATHIZ_ANTHROPIC_API_KEY=sk-...")
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"charm.land/lipgloss/v2"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/llm"
 	"github.com/abhisek/mathiz/internal/problemgen"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/ui/diagram"
 	"github.com/spf13/cobra"
 )
 
@@ -19,13 +23,14 @@ var previewCmd = &cobra.Command{
 	Long: `Generate and interactively answer questions for a specific skill.
 
 This is a stateless developer tool — no database, no mastery tracking, no events.
-Useful for evaluating question quality and testing new skills.`,
+Useful for evaluating question quality and testing new skills. With
+--offline, questions come from the built-in arithmetic templates.`,
 	RunE: runPreview,
 }
 
 func init() {
-	previewCmd.Flags().String("skill", "", "Skill ID or CommonCoreID (required)")
-	previewCmd.Flags().String("tier", "learn", "Difficulty tier: learn or prove")
+	previewCmd.Flags().String("skill", "", "Skill ID or standard code, e.g. a Common Core ID (required)")
+	previewCmd.Flags().String("tier", "learn", "Difficulty tier: learn, prove or challenge")
 	previewCmd.Flags().Int("count", 5, "Number of questions to generate")
 	_ = previewCmd.MarkFlagRequired("skill")
 }
@@ -48,22 +53,35 @@ func runPreview(cmd *cobra.Command, args []string) error {
 		tier = skillgraph.TierLearn
 	case "prove":
 		tier = skillgraph.TierProve
+	case "challenge":
+		if !skill.Tiers.Has(skillgraph.TierChallenge) {
+			return fmt.Errorf("skill %q has no challenge tier", skill.ID)
+		}
+		tier = skillgraph.TierChallenge
 	default:
-		return fmt.Errorf("invalid tier %q: must be learn or prove", tierVal)
+		return fmt.Errorf("invalid tier %q: must be learn, prove or challenge", tierVal)
 	}
 
-	// Create LLM provider (no EventRepo — logging skipped).
+	// Create LLM provider (no EventRepo — logging skipped), or the offline
+	// templates with --offline.
 	ctx := context.Background()
-	provider, err := llm.NewProviderFromEnv(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("LLM provider: %w", err)
+	var gen problemgen.Generator
+	if offline, _ := cmd.Flags().GetBool("offline"); offline {
+		gen = problemgen.NewOffline(uint64(time.Now().UnixNano()))
+		if !problemgen.Supports(gen, skill) {
+			return fmt.Errorf("skill %q has no offline question template", skill.ID)
+		}
+	} else {
+		provider, err := llm.NewProviderFromEnv(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("LLM provider: %w", err)
+		}
+		gen = problemgen.New(provider, problemgen.DefaultConfig())
 	}
-
-	gen := problemgen.New(provider, problemgen.DefaultConfig())
 	scanner := bufio.NewScanner(os.Stdin)
 
-	fmt.Printf("Skill: %s — %s (Grade %d, %s)\n",
-		skill.ID, skill.Name, skill.GradeLevel, tierVal)
+	fmt.Printf("Skill: %s — %s (Grade %s, %s)\n",
+		skill.ID, skill.Name, skillgraph.GradeLabel(skill.GradeLevel), tierVal)
 	fmt.Printf("Generating %d questions...\n\n", count)
 
 	var correct int
@@ -74,6 +92,7 @@ func runPreview(cmd *cobra.Command, args []string) error {
 			Skill:          skill,
 			Tier:           tier,
 			PriorQuestions: priorQuestions,
+			Locale:         i18n.Active(),
 		}
 
 		q, err := gen.Generate(ctx, input)
@@ -87,11 +106,22 @@ func runPreview(cmd *cobra.Command, args []string) error {
 		// Display question.
 		fmt.Printf("── Question %d/%d ──\n", i, count)
 		fmt.Println(q.Text)
-		if q.Format == problemgen.FormatMultipleChoice && len(q.Choices) > 0 {
+		if pic := diagram.Render(q.Diagram); pic != "" {
+			lipgloss.Println(pic)
+		}
+		if q.Format.HasChoices() && len(q.Choices) > 0 {
 			for j, c := range q.Choices {
-				fmt.Printf("  %d) %s\n", j+1, c)
+				fmt.Printf("  %d) %s\n", j+1, q.DisplayChoice(c))
 			}
 		}
+		switch q.Format {
+		case problemgen.FormatOrdering:
+			fmt.Println("(type the items in order, separated by ;)")
+		case problemgen.FormatMultiSelect:
+			fmt.Println("(type every correct option, separated by ;)")
+		case problemgen.FormatMultiBlank:
+			fmt.Printf("(type the %d blanks in order, separated by ;)\n", q.BlankCount())
+		}
 
 		// Read answer.
 		fmt.Print("\nYour answer: ")
@@ -110,7 +140,7 @@ func runPreview(cmd *cobra.Command, args []string) error {
 			correct++
 			fmt.Println("\033[32m✓ Correct!\033[0m")
 		} else {
-			fmt.Printf("\033[31m✗ Wrong.\033[0m Answer: %s\n", q.Answer)
+			fmt.Printf("\033[31m✗ Wrong.\033[0m Answer: %s\n", q.DisplayAnswer())
 		}
 
 		if q.Explanation != "" {
@@ -124,20 +154,16 @@ func runPreview(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// resolveSkill finds a skill by ID first, then by CommonCoreID fallback.
+// resolveSkill finds a skill by ID first, then by its code in any standard
+// (e.g. a Common Core ID).
 func resolveSkill(val string) (skillgraph.Skill, error) {
 	// Try exact ID first.
 	if s, err := skillgraph.GetSkill(val); err == nil {
 		return s, nil
 	}
 
-	// Fall back to CommonCoreID match.
-	var matches []skillgraph.Skill
-	for _, s := range skillgraph.AllSkills() {
-		if s.CommonCoreID == val {
-			matches = append(matches, s)
-		}
-	}
+	// Fall back to standard code match.
+	matches := skillgraph.ByCode(val)
 
 	switch len(matches) {
 	case 0:
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/spacedrep"
+	"github.com/abhisek/mathiz/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var rebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Rebuild mastery and review state from the event log",
+	Long: "Replay every recorded answer and mastery event, oldest first, into fresh\n" +
+		"mastery and spaced-repetition state, and save it as a new snapshot. Gems\n" +
+		"and the learner profile carry over from the latest snapshot.\n\n" +
+		"With --check, nothing is saved: the events up to the latest snapshot are\n" +
+		"replayed and compared with it, and the command fails if they differ.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		owner, _ := cmd.Flags().GetString("owner")
+		check, _ := cmd.Flags().GetBool("check")
+
+		dbPath, err := resolveDBPath(cmd)
+		if err != nil {
+			return fmt.Errorf("resolve database path: %w", err)
+		}
+
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("open database: %w", err)
+		}
+		defer s.Close()
+
+		ctx := context.Background()
+		snapRepo := s.SnapshotRepoFor(owner)
+		latest, err := snapRepo.Latest(ctx)
+		if err != nil {
+			return fmt.Errorf("load snapshot: %w", err)
+		}
+
+		var opts store.QueryOpts
+		if check {
+			if latest == nil {
+				return fmt.Errorf("no snapshot to check")
+			}
+			opts.To = latest.Timestamp
+		}
+		replayed, answers, err := replayEvents(ctx, s.EventRepoFor(owner), opts)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Replayed %d answers.\n", answers)
+
+		var diffs []string
+		if latest != nil {
+			diffs = spacedrep.Diff(replayed, &latest.Data)
+		}
+
+		if check {
+			if len(diffs) == 0 {
+				fmt.Println("The latest snapshot matches the event log.")
+				return nil
+			}
+			for _, d := range diffs {
+				fmt.Println("  " + d)
+			}
+			return fmt.Errorf("the latest snapshot differs from the event log in %d places", len(diffs))
+		}
+
+		if latest != nil {
+			replayed.Gems = latest.Data.Gems
+			replayed.LearnerProfile = latest.Data.LearnerProfile
+		}
+		if err := snapRepo.Save(ctx, &store.Snapshot{Timestamp: time.Now(), Data: *replayed}); err != nil {
+			return err
+		}
+		if latest == nil {
+			fmt.Println("Saved a rebuilt snapshot.")
+			return nil
+		}
+		fmt.Printf("Saved a rebuilt snapshot (%d differences from the previous one).\n", len(diffs))
+		return nil
+	},
+}
+
+func init() {
+	rebuildCmd.Flags().String("owner", store.LocalOwner, "Learner to rebuild (a child UID in serve mode)")
+	rebuildCmd.Flags().Bool("check", false, "Compare the replayed state with the latest snapshot instead of saving it")
+
+	rootCmd.AddCommand(rebuildCmd)
+}
+
+// replayEvents rebuilds mastery and review state from the answer and
+// mastery events matching opts, returning it with the number of answers.
+func replayEvents(ctx context.Context, eventRepo store.EventRepo, opts store.QueryOpts) (*store.SnapshotData, int, error) {
+	answers, err := eventRepo.AnswerHistory(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	transitions, err := eventRepo.QueryMasteryEvents(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	slices.Reverse(transitions) // newest first → oldest first
+	return spacedrep.Rebuild(answers, transitions), len(answers), nil
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/abhisek/mathiz/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var reportsCmd = &cobra.Command{
+	Use:   "reports",
+	Short: "List questions learners and parents reported, for triage",
+	Long: "List reported questions across every learner in the database, newest first.\n" +
+		"Reported bank questions wait in the triage queue (`mathiz reports queue`); use\n" +
+		"`mathiz llm view <id> --owner <learner>` to see the request that generated one.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		dbPath, err := resolveDBPath(cmd)
+		if err != nil {
+			return fmt.Errorf("resolve database path: %w", err)
+		}
+
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("open database: %w", err)
+		}
+		defer s.Close()
+
+		ctx := context.Background()
+		owners, err := s.SnapshotOwners(ctx)
+		if err != nil {
+			return err
+		}
+
+		type ownedReport struct {
+			owner string
+			store.QuestionReportRecord
+		}
+		var reports []ownedReport
+		for _, owner := range owners {
+			recs, err := s.EventRepoFor(owner).QueryQuestionReports(ctx, store.QueryOpts{Limit: limit})
+			if err != nil {
+				return fmt.Errorf("query reports for %q: %w", owner, err)
+			}
+			for _, r := range recs {
+				reports = append(reports, ownedReport{owner: owner, QuestionReportRecord: r})
+			}
+		}
+		if len(reports) == 0 {
+			fmt.Println("No reported questions.")
+			return nil
+		}
+		sort.Slice(reports, func(i, j int) bool {
+			return reports[i].Timestamp.After(reports[j].Timestamp)
+		})
+		if limit > 0 && len(reports) > limit {
+			reports = reports[:limit]
+		}
+
+		sep := strings.Repeat("─", 72)
+		for _, r := range reports {
+			learner := r.owner
+			if learner == store.LocalOwner {
+				learner = "local learner"
+			}
+			source := r.Source
+			if source == "" {
+				source = "quest"
+			}
+			if r.LLMRequestID > 0 {
+				source = fmt.Sprintf("%s, llm #%d", source, r.LLMRequestID)
+				if r.LLMOwner != "" && r.LLMOwner != r.owner {
+					source += " of " + r.LLMOwner
+				}
+			}
+
+			fmt.Println(sep)
+			fmt.Printf("%s  %s  by %s\n", r.Timestamp.Local().Format("2006-01-02 15:04"), learner, r.Reporter)
+			fmt.Printf("Skill:     %s (%s)\n", r.SkillID, r.Tier)
+			fmt.Printf("Source:    %s\n", source)
+			fmt.Printf("Question:  %s\n", r.QuestionText)
+			if len(r.Choices) > 0 {
+				fmt.Printf("Choices:   %s\n", strings.Join(r.Choices, " | "))
+			}
+			fmt.Printf("Answer:    %s\n", r.Answer)
+			if r.LearnerAnswer != "" {
+				fmt.Printf("Learner:   %s\n", r.LearnerAnswer)
+			} else {
+				fmt.Println("Learner:   (skipped)")
+			}
+			if r.Reason != "" {
+				fmt.Printf("Reason:    %s\n", r.Reason)
+			}
+		}
+		fmt.Println(sep)
+		return nil
+	},
+}
+
+var reportsQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "List reported bank questions awaiting triage",
+	Long: "List banked questions learners reported since they were last triaged, most\n" +
+		"reported first. They are not served until triaged, and are retired once\n" +
+		"enough different learners report them. Settle one with `mathiz reports keep <id>`\n" +
+		"or `mathiz reports retire <id>`.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dbPath, err := resolveDBPath(cmd)
+		if err != nil {
+			return fmt.Errorf("resolve database path: %w", err)
+		}
+
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("open database: %w", err)
+		}
+		defer s.Close()
+
+		queue, err := s.QuestionBank().ReportedQuestions(context.Background())
+		if err != nil {
+			return err
+		}
+		if len(queue) == 0 {
+			fmt.Println("Triage queue is empty.")
+			return nil
+		}
+
+		sep := strings.Repeat("─", 72)
+		for _, q := range queue {
+			fmt.Println(sep)
+			fmt.Printf("#%d  %s (%s)  reported by %d of %d learners to retire\n",
+				q.ID, q.SkillID, q.Tier, q.Reports, store.BankRetireReports)
+			if q.LLMRequestID > 0 {
+				fmt.Printf("Source:    llm #%d of %s\n", q.LLMRequestID, q.LLMOwner)
+			}
+			fmt.Printf("Question:  %s\n", q.Text)
+			if len(q.Choices) > 0 {
+				fmt.Printf("Choices:   %s\n", strings.Join(q.Choices, " | "))
+			}
+			fmt.Printf("Answer:    %s\n", q.Answer)
+		}
+		fmt.Println(sep)
+		return nil
+	},
+}
+
+var reportsKeepCmd = &cobra.Command{
+	Use:   "keep <id>",
+	Short: "Clear the reports on a queued bank question and keep serving it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return triageQuestion(cmd, args[0], false)
+	},
+}
+
+var reportsRetireCmd = &cobra.Command{
+	Use:   "retire <id>",
+	Short: "Retire a queued bank question so it is never served again",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return triageQuestion(cmd, args[0], true)
+	},
+}
+
+// triageQuestion settles the triage queue entry with the given bank ID.
+func triageQuestion(cmd *cobra.Command, arg string, retire bool) error {
+	var id int
+	if _, err := fmt.Sscanf(arg, "%d", &id); err != nil {
+		return fmt.Errorf("invalid ID %q: %w", arg, err)
+	}
+
+	dbPath, err := resolveDBPath(cmd)
+	if err != nil {
+		return fmt.Errorf("resolve database path: %w", err)
+	}
+
+	s, err := store.Open(dbPath)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	defer s.Close()
+
+	if err := s.QuestionBank().TriageQuestion(context.Background(), id, retire); err != nil {
+		return err
+	}
+	if retire {
+		fmt.Printf("Retired bank question #%d.\n", id)
+	} else {
+		fmt.Printf("Kept bank question #%d; its reports are cleared.\n", id)
+	}
+	return nil
+}
+
+func init() {
+	reportsCmd.Flags().IntP("limit", "n", 20, "Number of reports to show")
+	reportsCmd.AddCommand(reportsQueueCmd)
+	reportsCmd.AddCommand(reportsKeepCmd)
+	reportsCmd.AddCommand(reportsRetireCmd)
+}
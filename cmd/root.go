@@ -1,6 +1,14 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/spacedrep"
 	"github.com/abhisek/mathiz/internal/store"
 	"github.com/spf13/cobra"
 )
@@ -8,7 +16,22 @@ import (
 var rootCmd = &cobra.Command{
 	Use:   "mathiz",
 	Short: "AI math tutor for kids",
-	Long:  "Mathiz — AI-native terminal app that helps children (grades 3-5) build math mastery.",
+	Long:  "Mathiz — AI-native terminal app that helps children (grades K-6) build math mastery.",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := loadCurriculum(cmd); err != nil {
+			return err
+		}
+		if err := loadStandard(cmd); err != nil {
+			return err
+		}
+		if err := loadLocale(cmd); err != nil {
+			return err
+		}
+		if err := loadMasteryModel(cmd); err != nil {
+			return err
+		}
+		return loadDecayPropagation(cmd)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return runApp(cmd)
 	},
@@ -20,11 +43,20 @@ func Execute() error {
 
 func init() {
 	rootCmd.PersistentFlags().String("db", "", "Path to SQLite database file (overrides MATHIZ_DB env var)")
+	rootCmd.PersistentFlags().String("curriculum", "", "Path to a YAML or JSON curriculum file (overrides MATHIZ_CURRICULUM env var)")
+	rootCmd.PersistentFlags().String("standard", "", "Curriculum standard for skill codes: ccss, uk-nc or cbse (overrides MATHIZ_STANDARD env var)")
+	rootCmd.PersistentFlags().String("lang", "", "Language for questions and the UI: en, es, fr or de (overrides MATHIZ_LANG env var)")
+	rootCmd.PersistentFlags().String("mastery-model", "", "Mastery model that decides tier completion: rules or bkt (overrides MATHIZ_MASTERY_MODEL env var)")
+	rootCmd.PersistentFlags().Bool("decay-propagation", false, "Bring forward reviews of skills built on a skill that goes rusty (overrides MATHIZ_DECAY_PROPAGATION env var)")
+	rootCmd.PersistentFlags().Bool("offline", false, "Generate arithmetic questions from built-in templates instead of an LLM")
+	// Local, not persistent: "skill list --grade" is a filter, not the learner.
+	rootCmd.Flags().Int("grade", 0, "Learner's school grade, 0 (kindergarten) to 6: placement skips skills above it (overrides MATHIZ_GRADE env var)")
 
 	rootCmd.AddCommand(playCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(llmCmd)
+	rootCmd.AddCommand(reportsCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(previewCmd)
@@ -43,3 +75,94 @@ func resolveDBPath(cmd *cobra.Command) (string, error) {
 	}
 	return store.DefaultDBPath()
 }
+
+// loadCurriculum activates an external curriculum file when one is configured
+// via --curriculum (highest priority) or MATHIZ_CURRICULUM; otherwise the
+// embedded seed graph stays active. A configured file that fails to load or
+// validate is an error, not a silent fallback — learners would otherwise
+// practise a different graph than the one their family chose.
+func loadCurriculum(cmd *cobra.Command) error {
+	path, _ := cmd.Flags().GetString("curriculum")
+	if path == "" {
+		path = os.Getenv("MATHIZ_CURRICULUM")
+	}
+	if path == "" {
+		return nil
+	}
+	c, err := skillgraph.LoadCurriculumFile(path)
+	if err != nil {
+		return err
+	}
+	if err := skillgraph.Use(c); err != nil {
+		return fmt.Errorf("curriculum %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadStandard sets the learner's curriculum standard from --standard
+// (highest priority) or MATHIZ_STANDARD. Local mode has one learner per
+// database, so the process-wide default is the learner's choice.
+func loadStandard(cmd *cobra.Command) error {
+	val, _ := cmd.Flags().GetString("standard")
+	if val == "" {
+		val = os.Getenv("MATHIZ_STANDARD")
+	}
+	std, err := skillgraph.ParseStandard(val)
+	if err != nil {
+		return err
+	}
+	skillgraph.UseStandard(std)
+	return nil
+}
+
+// loadLocale sets the learner's language from --lang (highest priority) or
+// MATHIZ_LANG. Like the standard, it is process-wide in local mode.
+func loadLocale(cmd *cobra.Command) error {
+	val, _ := cmd.Flags().GetString("lang")
+	if val == "" {
+		val = os.Getenv("MATHIZ_LANG")
+	}
+	l, err := i18n.Parse(val)
+	if err != nil {
+		return err
+	}
+	i18n.Use(l)
+	return nil
+}
+
+// loadMasteryModel selects the mastery model from --mastery-model (highest
+// priority) or MATHIZ_MASTERY_MODEL. It applies to every learner the
+// process serves, so hosted mode can A/B test a model per deployment.
+func loadMasteryModel(cmd *cobra.Command) error {
+	val, _ := cmd.Flags().GetString("mastery-model")
+	if val == "" {
+		val = os.Getenv("MATHIZ_MASTERY_MODEL")
+	}
+	kind, err := mastery.ParseModel(val)
+	if err != nil {
+		return err
+	}
+	mastery.UseModel(kind)
+	return nil
+}
+
+// loadDecayPropagation turns prerequisite-aware decay on from
+// --decay-propagation (highest priority) or MATHIZ_DECAY_PROPAGATION. Like
+// the mastery model, it applies to every learner the process serves.
+func loadDecayPropagation(cmd *cobra.Command) error {
+	if cmd.Flags().Changed("decay-propagation") {
+		on, _ := cmd.Flags().GetBool("decay-propagation")
+		spacedrep.UsePropagation(on)
+		return nil
+	}
+	val := os.Getenv("MATHIZ_DECAY_PROPAGATION")
+	if val == "" {
+		return nil
+	}
+	on, err := strconv.ParseBool(val)
+	if err != nil {
+		return fmt.Errorf("MATHIZ_DECAY_PROPAGATION: %q is not true or false", val)
+	}
+	spacedrep.UsePropagation(on)
+	return nil
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/abhisek/mathiz/internal/app"
 	"github.com/abhisek/mathiz/internal/llm"
@@ -14,6 +16,10 @@ import (
 // runApp opens the store, builds dependencies, and launches the TUI.
 func runApp(cmd *cobra.Command) error {
 	ctx := cmd.Context()
+	grade, err := resolveGrade(cmd)
+	if err != nil {
+		return err
+	}
 	dbPath, err := resolveDBPath(cmd)
 	if err != nil {
 		return fmt.Errorf("resolve DB path: %w", err)
@@ -29,20 +35,54 @@ func runApp(cmd *cobra.Command) error {
 	updateCh := checker.CheckAsync(ctx, &selfupdate.CheckInput{Version: version})
 
 	eventRepo := st.EventRepo()
-	provider, err := llm.NewProviderFromEnv(ctx, eventRepo)
+	provider, err := newProvider(cmd, eventRepo)
 	if err != nil {
-		if isDirectSession(cmd) {
-			return errNoLLM
-		}
 		fmt.Fprintln(os.Stderr, "LLM provider not configured:", err)
-		fmt.Fprintln(os.Stderr, "AI features will be unavailable.")
+		fmt.Fprintln(os.Stderr, "Practising arithmetic skills offline; AI features are unavailable.")
 		provider = nil
 	}
+	var judge llm.Provider
+	if provider != nil {
+		if judge, err = llm.NewJudgeProviderFromEnv(ctx, eventRepo, llm.PurposeQuestionGen); err != nil {
+			fmt.Fprintln(os.Stderr, "LLM judge not configured:", err)
+		}
+	}
 
-	opts, cleanup := app.BuildOptions(eventRepo, st.SnapshotRepo(), provider)
+	opts, cleanup := app.BuildOptions(eventRepo, st.SnapshotRepo(), st.QuestionBank(), provider, judge)
 	defer cleanup()
 	opts.UpdateCh = updateCh
 	opts.DirectSession = isDirectSession(cmd)
+	opts.Grade = grade
 
 	return app.Run(opts)
 }
+
+// resolveGrade returns the learner's school grade from --grade (highest
+// priority) or MATHIZ_GRADE, or nil when neither is set.
+func resolveGrade(cmd *cobra.Command) (*int, error) {
+	val := os.Getenv("MATHIZ_GRADE")
+	if cmd.Flags().Changed("grade") {
+		g, _ := cmd.Flags().GetInt("grade")
+		val = strconv.Itoa(g)
+	}
+	if val == "" {
+		return nil, nil
+	}
+	grade, err := strconv.Atoi(val)
+	if err != nil || grade < 0 || grade > 6 {
+		return nil, fmt.Errorf("grade %q: want 0 (kindergarten) to 6", val)
+	}
+	return &grade, nil
+}
+
+// errOffline is returned by newProvider when --offline is set.
+var errOffline = errors.New("--offline set")
+
+// newProvider returns the LLM provider from the environment, or errOffline
+// when --offline asks for the built-in question templates instead.
+func newProvider(cmd *cobra.Command, eventRepo store.EventRepo) (llm.Provider, error) {
+	if offline, _ := cmd.Flags().GetBool("offline"); offline {
+		return nil, errOffline
+	}
+	return llm.NewProviderFromEnv(cmd.Context(), eventRepo)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/llm"
 	"github.com/abhisek/mathiz/internal/saas/activity"
 	"github.com/abhisek/mathiz/internal/saas/auth"
@@ -45,6 +46,7 @@ Configuration is environment-driven:
   MATHIZ_SUPABASE_ANON_KEY    Supabase anon key (served to the SPA)
   MATHIZ_SUPABASE_JWT_SECRET  Legacy HS256 JWT secret (optional)
   MATHIZ_SERVER_ADDR          Listen address (default :8080)
+  MATHIZ_CURRICULUM           Curriculum file (default: built-in seed)
   MATHIZ_*_API_KEY            LLM provider credentials (as for local mode)`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return runServe(cmd.Context())
@@ -157,6 +159,9 @@ func runServe(ctx context.Context) error {
 	questsSvc := quests.New(st.Client(), creditsSvc, func(ctx context.Context, familySpaceID string) (llm.Provider, error) {
 		return llm.NewProviderFromEnv(ctx, st.EventRepoFor(quests.LLMOwnerID(familySpaceID)))
 	})
+	questsSvc.UseJudge(func(ctx context.Context, familySpaceID string) (llm.Provider, error) {
+		return llm.NewJudgeProviderFromEnv(ctx, st.EventRepoFor(quests.LLMOwnerID(familySpaceID)), llm.PurposeQuestGen)
+	})
 
 	// Activity timeline read model: merges the child's event streams for the
 	// parent dashboard. Quest attribution resolves through the quests service
@@ -178,6 +183,20 @@ func runServe(ctx context.Context) error {
 		Charge:      charge,
 		Slots:       slots,
 		Quests:      questsSvc,
+		Locale: func(ctx context.Context, childUID string) i18n.Locale {
+			c, err := svc.Child(ctx, childUID)
+			if err != nil {
+				return i18n.DefaultLocale
+			}
+			return i18n.Locale(c.Locale)
+		},
+		Grade: func(ctx context.Context, childUID string) *int {
+			c, err := svc.Child(ctx, childUID)
+			if err != nil {
+				return nil
+			}
+			return &c.Grade
+		},
 	})
 	srv := server.New(server.Deps{
 		Config:   cfg,
@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/problemgen"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
 	"github.com/spf13/cobra"
 )
 
@@ -19,29 +25,40 @@ var skillListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		strand, _ := cmd.Flags().GetString("strand")
 		grade, _ := cmd.Flags().GetInt("grade")
+		gradeSet := cmd.Flags().Changed("grade") // grade 0 is kindergarten
+		std := skillgraph.ActiveStandard()
+		stdFilter := cmd.Flags().Changed("standard") || os.Getenv("MATHIZ_STANDARD") != ""
 
 		var skills []skillgraph.Skill
 
 		switch {
-		case strand != "" && grade != 0:
+		case strand != "" && gradeSet:
 			return fmt.Errorf("use --strand or --grade, not both")
 		case strand != "":
 			skills = skillgraph.ByStrand(skillgraph.Strand(strand))
 			if len(skills) == 0 {
 				return fmt.Errorf("no skills found for strand %q", strand)
 			}
-		case grade != 0:
+		case gradeSet:
 			skills = skillgraph.ByGrade(grade)
 			if len(skills) == 0 {
-				return fmt.Errorf("no skills found for grade %d", grade)
+				return fmt.Errorf("no skills found for grade %s", skillgraph.GradeLabel(grade))
 			}
 		default:
 			skills = skillgraph.AllSkills()
 		}
 
+		// An explicitly chosen standard also filters out unmapped skills.
+		if stdFilter {
+			skills = slices.DeleteFunc(skills, func(s skillgraph.Skill) bool { return s.Code(std) == "" })
+			if len(skills) == 0 {
+				return fmt.Errorf("no skills mapped to standard %q (the curriculum carries no %s codes)", std, std.ShortName())
+			}
+		}
+
 		// Header.
 		fmt.Printf("%-30s  %-40s  %5s  %-24s  %s\n",
-			"ID", "Name", "Grade", "Strand", "CCSS")
+			"ID", "Name", "Grade", "Strand", std.ShortName())
 		fmt.Println(strings.Repeat("\u2500", 115))
 
 		for _, s := range skills {
@@ -49,9 +66,9 @@ var skillListCmd = &cobra.Command{
 			if len(name) > 40 {
 				name = name[:37] + "..."
 			}
-			fmt.Printf("%-30s  %-40s  %5d  %-24s  %s\n",
-				s.ID, name, s.GradeLevel,
-				skillgraph.StrandDisplayName(s.Strand), s.CommonCoreID)
+			fmt.Printf("%-30s  %-40s  %5s  %-24s  %s\n",
+				s.ID, name, skillgraph.GradeLabel(s.GradeLevel),
+				skillgraph.StrandDisplayName(s.Strand), s.Code(std))
 		}
 
 		fmt.Printf("\n%d skills\n", len(skills))
@@ -59,9 +76,302 @@ var skillListCmd = &cobra.Command{
 	},
 }
 
+var skillGraphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Export the prerequisite graph as DOT, Mermaid or JSON",
+	Long: `Export the skill graph's prerequisite DAG to stdout.
+
+Formats: dot (Graphviz), mermaid (flowchart) and json. With --mastery, nodes
+are coloured by the learner's mastery state from the latest snapshot.
+
+  mathiz skill graph | dot -Tsvg > graph.svg
+  mathiz skill graph --format mermaid --mastery > path.mmd`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		formatName, _ := cmd.Flags().GetString("format")
+		format, err := skillgraph.ParseExportFormat(formatName)
+		if err != nil {
+			return err
+		}
+		opts := skillgraph.ExportOptions{Standard: skillgraph.ActiveStandard()}
+
+		if withMastery, _ := cmd.Flags().GetBool("mastery"); withMastery {
+			opts.States, err = masteryStates(cmd)
+			if err != nil {
+				return err
+			}
+		}
+		return skillgraph.WriteGraph(cmd.OutOrStdout(), format, opts)
+	},
+}
+
+var skillLintCmd = &cobra.Command{
+	Use:   "lint [file]",
+	Short: "Check a curriculum for authoring problems",
+	Long: `Check a curriculum file (or, without one, the active curriculum) for
+structural errors and authoring smells:
+
+  orphan                  a skill with no prerequisites that nothing depends on
+  redundant-prerequisite  a prerequisite already implied by another one
+  grade-inversion         a skill that depends on a higher-grade skill
+  depth-gap               a strand that skips a grade
+
+Structural errors always fail. Smells are warnings unless --strict is set.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c := skillgraph.ActiveCurriculum()
+		if len(args) == 1 {
+			var err error
+			if c, err = loadCurriculumArg(args[0]); err != nil {
+				return err
+			}
+		}
+
+		out := cmd.OutOrStdout()
+		issues := skillgraph.Lint(c)
+		for _, issue := range issues {
+			fmt.Fprintf(out, "%-24s  %s\n", issue.Kind, issue.Message)
+		}
+		if len(issues) == 0 {
+			fmt.Fprintf(out, "%s: %d skills, no issues\n", c.Name, len(c.Skills))
+			return nil
+		}
+		fmt.Fprintf(out, "\n%s: %d skills, %d issues\n", c.Name, len(c.Skills), len(issues))
+		if strict, _ := cmd.Flags().GetBool("strict"); strict {
+			cmd.SilenceUsage = true // a failed lint isn't a usage error
+			return fmt.Errorf("%d lint issues", len(issues))
+		}
+		return nil
+	},
+}
+
+var skillDiffCmd = &cobra.Command{
+	Use:   "diff <old> [new]",
+	Short: "Compare two curricula and their effect on learners",
+	Long: `Compare two curriculum files: skills added, removed and changed (name,
+strand, grade, prerequisites), and removed skills no migration carries over. Without [new], the old file is compared with
+the active curriculum. Either side may be "builtin" for the embedded graph.
+
+Each learner's latest snapshot in the database is then checked for skills
+that would flip between locked and available.
+
+  mathiz skill diff builtin my-curriculum.yaml
+  mathiz skill diff v1.yaml v2.yaml --db postgres://...`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, err := loadCurriculumArg(args[0])
+		if err != nil {
+			return err
+		}
+		to := skillgraph.ActiveCurriculum()
+		if len(args) == 2 {
+			if to, err = loadCurriculumArg(args[1]); err != nil {
+				return err
+			}
+		}
+
+		out := cmd.OutOrStdout()
+		d := skillgraph.DiffCurricula(from, to)
+		fmt.Fprintf(out, "%s v%d → %s v%d: %d added, %d removed, %d changed\n",
+			from.Name, d.FromVersion, to.Name, d.ToVersion, len(d.Added), len(d.Removed), len(d.Changed))
+		for _, id := range d.Added {
+			fmt.Fprintf(out, "  + %s\n", id)
+		}
+		for _, id := range d.Removed {
+			fmt.Fprintf(out, "  - %s\n", id)
+		}
+		for _, ch := range d.Changed {
+			fmt.Fprintf(out, "  ~ %s: %s\n", ch.ID, strings.Join(ch.Changes, "; "))
+		}
+		if len(d.Unmigrated) > 0 {
+			fmt.Fprintf(out, "\nRemoved without a migration (learner progress is lost): %s\n", strings.Join(d.Unmigrated, ", "))
+			if d.ToVersion <= d.FromVersion {
+				fmt.Fprintln(out, "Bump the new curriculum's version and add alias, split or merge migrations to keep it.")
+			}
+		}
+
+		if skip, _ := cmd.Flags().GetBool("no-learners"); skip || d.Empty() {
+			return nil
+		}
+		return printLearnerUnlockChanges(cmd, from, to)
+	},
+}
+
+var skillMathCheckCmd = &cobra.Command{
+	Use:   "mathcheck",
+	Short: "Report which skills the math check can verify",
+	Long: `Run the math-check validator over every question in the question bank and
+report, per skill, how many answers it recomputed and confirmed, how many it
+rejected, and how many it could not check. Skills in the curriculum with no
+checked question are listed last: their answers rely on the LLM alone.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dbPath, err := resolveDBPath(cmd)
+		if err != nil {
+			return fmt.Errorf("resolve database path: %w", err)
+		}
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("open database: %w", err)
+		}
+		defer s.Close()
+
+		banked, err := s.QuestionBank().ListQuestions(context.Background())
+		if err != nil {
+			return err
+		}
+		v := &problemgen.MathCheckValidator{}
+		for _, d := range banked {
+			v.Validate(&problemgen.Question{
+				Text:       d.Text,
+				Answer:     d.Answer,
+				AnswerType: problemgen.AnswerType(d.AnswerType),
+				Format:     problemgen.AnswerFormat(d.Format),
+				Choices:    d.Choices,
+				SkillID:    d.SkillID,
+			}, problemgen.GenerateInput{})
+		}
+
+		out := cmd.OutOrStdout()
+		checked := make(map[string]bool)
+		fmt.Fprintf(out, "%-28s %8s %8s %10s\n", "Skill", "Checked", "Failed", "Unchecked")
+		for _, c := range v.Coverage() {
+			fmt.Fprintf(out, "%-28s %8d %8d %10d\n", c.SkillID, c.Checked, c.Failed, c.Unchecked)
+			if c.Checked+c.Failed > 0 {
+				checked[c.SkillID] = true
+			}
+		}
+		fmt.Fprintf(out, "\n%d banked questions\n", len(banked))
+
+		var unchecked []string
+		for _, skill := range skillgraph.AllSkills() {
+			if !checked[skill.ID] {
+				unchecked = append(unchecked, skill.ID)
+			}
+		}
+		if len(unchecked) > 0 {
+			fmt.Fprintf(out, "\nSkills with no checked questions (%d):\n", len(unchecked))
+			for _, id := range unchecked {
+				fmt.Fprintf(out, "  %s\n", id)
+			}
+		}
+		return nil
+	},
+}
+
+// loadCurriculumArg loads and validates a curriculum file; "builtin" is the
+// embedded seed graph.
+func loadCurriculumArg(arg string) (*skillgraph.Curriculum, error) {
+	if arg == "builtin" {
+		return skillgraph.SeedCurriculum(), nil
+	}
+	c, err := skillgraph.LoadCurriculumFile(arg)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("curriculum %s: %w", arg, err)
+	}
+	return c, nil
+}
+
+// printLearnerUnlockChanges reports, per learner with a snapshot, the skills
+// that would flip between locked and available going from one curriculum to
+// the other.
+func printLearnerUnlockChanges(cmd *cobra.Command, from, to *skillgraph.Curriculum) error {
+	out := cmd.OutOrStdout()
+	dbPath, err := resolveDBPath(cmd)
+	if err != nil {
+		return fmt.Errorf("resolve database path: %w", err)
+	}
+	if !store.IsPostgresDSN(dbPath) {
+		if _, err := os.Stat(dbPath); err != nil {
+			fmt.Fprintf(out, "\nNo learner database at %s.\n", dbPath)
+			return nil
+		}
+	}
+	s, err := store.Open(dbPath)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	defer s.Close()
+
+	ctx := context.Background()
+	owners, err := s.SnapshotOwners(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "\nLearners (%d):\n", len(owners))
+	for _, owner := range owners {
+		snap, err := s.SnapshotRepoFor(owner).Latest(ctx)
+		if err != nil {
+			return fmt.Errorf("load snapshot for %q: %w", owner, err)
+		}
+		if snap == nil {
+			continue
+		}
+		mastered := mastery.NewService(&snap.Data, nil).MasteredSkills()
+		changes := skillgraph.UnlockChanges(from, to, mastered)
+
+		name := owner
+		if owner == store.LocalOwner {
+			name = "local learner"
+		}
+		if len(changes) == 0 {
+			fmt.Fprintf(out, "  %s: no changes\n", name)
+			continue
+		}
+		fmt.Fprintf(out, "  %s:\n", name)
+		for _, ch := range changes {
+			transition := "available → locked"
+			if ch.Unlocked {
+				transition = "locked → available"
+			}
+			fmt.Fprintf(out, "    %-20s  %s\n", transition, ch.SkillID)
+		}
+	}
+	return nil
+}
+
+// masteryStates reads each skill's mastery state from the latest snapshot.
+func masteryStates(cmd *cobra.Command) (map[string]string, error) {
+	dbPath, err := resolveDBPath(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("resolve database path: %w", err)
+	}
+	s, err := store.Open(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	defer s.Close()
+
+	snap, err := s.SnapshotRepo().Latest(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot: %w", err)
+	}
+	var snapData *store.SnapshotData
+	if snap != nil {
+		snapData = &snap.Data
+	}
+
+	svc := mastery.NewService(snapData, nil)
+	states := make(map[string]string)
+	for _, skill := range skillgraph.AllSkills() {
+		states[skill.ID] = string(svc.GetMastery(skill.ID).State)
+	}
+	return states, nil
+}
+
 func init() {
+	skillGraphCmd.Flags().String("format", string(skillgraph.ExportDOT), "Output format: dot, mermaid or json")
+	skillGraphCmd.Flags().Bool("mastery", false, "Colour nodes by mastery state from the latest snapshot")
+	skillLintCmd.Flags().Bool("strict", false, "Exit non-zero on any lint issue, not just structural errors")
+	skillDiffCmd.Flags().Bool("no-learners", false, "Skip checking learner snapshots")
+
 	skillListCmd.Flags().String("strand", "", "Filter by strand (e.g. addition-and-subtraction)")
-	skillListCmd.Flags().Int("grade", 0, "Filter by grade level (3, 4, or 5)")
+	skillListCmd.Flags().Int("grade", 0, "Filter by grade level (0 = kindergarten, up to 6)")
 
 	skillCmd.AddCommand(skillListCmd)
+	skillCmd.AddCommand(skillGraphCmd)
+	skillCmd.AddCommand(skillLintCmd)
+	skillCmd.AddCommand(skillDiffCmd)
+	skillCmd.AddCommand(skillMathCheckCmd)
 }
@@ -125,6 +125,110 @@ var statsCmd = &cobra.Command{
 	},
 }
 
+var statsModelsCmd = &cobra.Command{
+	Use:   "models",
+	Short: "Compare mastery models by replaying a learner's answers",
+	Long: "Replay every recorded answer, oldest first, through each mastery model\n" +
+		"and show where each would have left every skill, so a model can be\n" +
+		"evaluated before switching to it with --mastery-model.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		owner, _ := cmd.Flags().GetString("owner")
+
+		dbPath, err := resolveDBPath(cmd)
+		if err != nil {
+			return fmt.Errorf("resolve database path: %w", err)
+		}
+
+		s, err := store.Open(dbPath)
+		if err != nil {
+			return fmt.Errorf("open database: %w", err)
+		}
+		defer s.Close()
+
+		answers, err := s.EventRepoFor(owner).AnswerHistory(context.Background(), store.QueryOpts{})
+		if err != nil {
+			return err
+		}
+		if len(answers) == 0 {
+			fmt.Println("No answers to replay.")
+			return nil
+		}
+
+		kinds := mastery.AllModels()
+		outcomes := make([]map[string]*mastery.ReplayOutcome, len(kinds))
+		for i, kind := range kinds {
+			outcomes[i] = mastery.Replay(answers, mastery.NewModel(kind))
+		}
+
+		fmt.Println("Mastery Model Comparison")
+		fmt.Println(strings.Repeat("\u2500", 36))
+		fmt.Println()
+		fmt.Printf("Replayed %d answers across %d skills.\n", len(answers), len(outcomes[0]))
+		fmt.Println()
+
+		fmt.Printf("  %-30s", "Skill")
+		for _, kind := range kinds {
+			fmt.Printf("  %-14s", kind)
+		}
+		fmt.Println()
+
+		mastered := make([]int, len(kinds))
+		answersToMaster := make([]int, len(kinds))
+		var bothMastered int
+		for _, skill := range skillgraph.AllSkills() {
+			if outcomes[0][skill.ID] == nil {
+				continue
+			}
+			fmt.Printf("  %-30s", skill.Name)
+			all := true
+			for i := range kinds {
+				o := outcomes[i][skill.ID]
+				fmt.Printf("  %-14s", replayLabel(o))
+				if o.MasteredAfter > 0 {
+					mastered[i]++
+				} else {
+					all = false
+				}
+			}
+			fmt.Println()
+			if all {
+				bothMastered++
+				for i := range kinds {
+					answersToMaster[i] += outcomes[i][skill.ID].MasteredAfter
+				}
+			}
+		}
+		fmt.Println()
+
+		for i, kind := range kinds {
+			fmt.Printf("%-6s %d mastered", kind, mastered[i])
+			if bothMastered > 0 {
+				fmt.Printf(", %.1f answers to master on average", float64(answersToMaster[i])/float64(bothMastered))
+			}
+			fmt.Println()
+		}
+		if bothMastered > 0 {
+			fmt.Printf("(averages cover the %d skills every model mastered)\n", bothMastered)
+		}
+		return nil
+	},
+}
+
+func init() {
+	statsModelsCmd.Flags().String("owner", store.LocalOwner, "Learner whose answers to replay (a child UID in serve mode)")
+
+	statsCmd.AddCommand(statsModelsCmd)
+}
+
+// replayLabel describes where a replay left a skill: "mastered @12" with
+// the answers it took, or the tier still in progress.
+func replayLabel(o *mastery.ReplayOutcome) string {
+	if o.MasteredAfter > 0 {
+		return fmt.Sprintf("mastered @%d", o.MasteredAfter)
+	}
+	return o.Tier.String()
+}
+
 func stateIcon(state mastery.MasteryState) string {
 	switch state {
 	case mastery.StateMastered:
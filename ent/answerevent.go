@@ -43,6 +43,12 @@ type AnswerEvent struct {
 	TimeMs int `json:"time_ms,omitempty"`
 	// numeric or multiple_choice
 	AnswerFormat string `json:"answer_format,omitempty"`
+	// Generator that produced the question: llm, offline, bank
+	Source string `json:"source,omitempty"`
+	// LLMRequestEvent that generated the question, 0 if none
+	LlmRequestID int `json:"llm_request_id,omitempty"`
+	// Learner whose LLM log holds llm_request_id; empty for this one
+	LlmOwner     string `json:"llm_owner,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -53,9 +59,9 @@ func (*AnswerEvent) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case answerevent.FieldCorrect:
 			values[i] = new(sql.NullBool)
-		case answerevent.FieldID, answerevent.FieldSequence, answerevent.FieldTimeMs:
+		case answerevent.FieldID, answerevent.FieldSequence, answerevent.FieldTimeMs, answerevent.FieldLlmRequestID:
 			values[i] = new(sql.NullInt64)
-		case answerevent.FieldOwnerID, answerevent.FieldSessionID, answerevent.FieldSkillID, answerevent.FieldTier, answerevent.FieldCategory, answerevent.FieldQuestionText, answerevent.FieldCorrectAnswer, answerevent.FieldLearnerAnswer, answerevent.FieldAnswerFormat:
+		case answerevent.FieldOwnerID, answerevent.FieldSessionID, answerevent.FieldSkillID, answerevent.FieldTier, answerevent.FieldCategory, answerevent.FieldQuestionText, answerevent.FieldCorrectAnswer, answerevent.FieldLearnerAnswer, answerevent.FieldAnswerFormat, answerevent.FieldSource, answerevent.FieldLlmOwner:
 			values[i] = new(sql.NullString)
 		case answerevent.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -158,6 +164,24 @@ func (_m *AnswerEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AnswerFormat = value.String
 			}
+		case answerevent.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case answerevent.FieldLlmRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_request_id", values[i])
+			} else if value.Valid {
+				_m.LlmRequestID = int(value.Int64)
+			}
+		case answerevent.FieldLlmOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_owner", values[i])
+			} else if value.Valid {
+				_m.LlmOwner = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -232,6 +256,15 @@ func (_m *AnswerEvent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("answer_format=")
 	builder.WriteString(_m.AnswerFormat)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("llm_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("llm_owner=")
+	builder.WriteString(_m.LlmOwner)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -39,6 +39,12 @@ const (
 	FieldTimeMs = "time_ms"
 	// FieldAnswerFormat holds the string denoting the answer_format field in the database.
 	FieldAnswerFormat = "answer_format"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldLlmRequestID holds the string denoting the llm_request_id field in the database.
+	FieldLlmRequestID = "llm_request_id"
+	// FieldLlmOwner holds the string denoting the llm_owner field in the database.
+	FieldLlmOwner = "llm_owner"
 	// Table holds the table name of the answerevent in the database.
 	Table = "answer_events"
 )
@@ -59,6 +65,9 @@ var Columns = []string{
 	FieldCorrect,
 	FieldTimeMs,
 	FieldAnswerFormat,
+	FieldSource,
+	FieldLlmRequestID,
+	FieldLlmOwner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -92,6 +101,12 @@ var (
 	LearnerAnswerValidator func(string) error
 	// AnswerFormatValidator is a validator for the "answer_format" field. It is called by the builders before save.
 	AnswerFormatValidator func(string) error
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultLlmRequestID holds the default value on creation for the "llm_request_id" field.
+	DefaultLlmRequestID int
+	// DefaultLlmOwner holds the default value on creation for the "llm_owner" field.
+	DefaultLlmOwner string
 )
 
 // OrderOption defines the ordering options for the AnswerEvent queries.
@@ -166,3 +181,18 @@ func ByTimeMs(opts ...sql.OrderTermOption) OrderOption {
 func ByAnswerFormat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnswerFormat, opts...).ToFunc()
 }
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByLlmRequestID orders the results by the llm_request_id field.
+func ByLlmRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmRequestID, opts...).ToFunc()
+}
+
+// ByLlmOwner orders the results by the llm_owner field.
+func ByLlmOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmOwner, opts...).ToFunc()
+}
@@ -119,6 +119,21 @@ func AnswerFormat(v string) predicate.AnswerEvent {
 	return predicate.AnswerEvent(sql.FieldEQ(FieldAnswerFormat, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// LlmRequestID applies equality check predicate on the "llm_request_id" field. It's identical to LlmRequestIDEQ.
+func LlmRequestID(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmOwner applies equality check predicate on the "llm_owner" field. It's identical to LlmOwnerEQ.
+func LlmOwner(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldLlmOwner, v))
+}
+
 // SequenceEQ applies the EQ predicate on the "sequence" field.
 func SequenceEQ(v int64) predicate.AnswerEvent {
 	return predicate.AnswerEvent(sql.FieldEQ(FieldSequence, v))
@@ -834,6 +849,176 @@ func AnswerFormatContainsFold(v string) predicate.AnswerEvent {
 	return predicate.AnswerEvent(sql.FieldContainsFold(FieldAnswerFormat, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldContainsFold(FieldSource, v))
+}
+
+// LlmRequestIDEQ applies the EQ predicate on the "llm_request_id" field.
+func LlmRequestIDEQ(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDNEQ applies the NEQ predicate on the "llm_request_id" field.
+func LlmRequestIDNEQ(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDIn applies the In predicate on the "llm_request_id" field.
+func LlmRequestIDIn(vs ...int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDNotIn applies the NotIn predicate on the "llm_request_id" field.
+func LlmRequestIDNotIn(vs ...int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNotIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDGT applies the GT predicate on the "llm_request_id" field.
+func LlmRequestIDGT(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDGTE applies the GTE predicate on the "llm_request_id" field.
+func LlmRequestIDGTE(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGTE(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLT applies the LT predicate on the "llm_request_id" field.
+func LlmRequestIDLT(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLTE applies the LTE predicate on the "llm_request_id" field.
+func LlmRequestIDLTE(v int) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLTE(FieldLlmRequestID, v))
+}
+
+// LlmOwnerEQ applies the EQ predicate on the "llm_owner" field.
+func LlmOwnerEQ(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerNEQ applies the NEQ predicate on the "llm_owner" field.
+func LlmOwnerNEQ(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerIn applies the In predicate on the "llm_owner" field.
+func LlmOwnerIn(vs ...string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerNotIn applies the NotIn predicate on the "llm_owner" field.
+func LlmOwnerNotIn(vs ...string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldNotIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerGT applies the GT predicate on the "llm_owner" field.
+func LlmOwnerGT(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGT(FieldLlmOwner, v))
+}
+
+// LlmOwnerGTE applies the GTE predicate on the "llm_owner" field.
+func LlmOwnerGTE(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldGTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerLT applies the LT predicate on the "llm_owner" field.
+func LlmOwnerLT(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLT(FieldLlmOwner, v))
+}
+
+// LlmOwnerLTE applies the LTE predicate on the "llm_owner" field.
+func LlmOwnerLTE(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldLTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerContains applies the Contains predicate on the "llm_owner" field.
+func LlmOwnerContains(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldContains(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasPrefix applies the HasPrefix predicate on the "llm_owner" field.
+func LlmOwnerHasPrefix(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldHasPrefix(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasSuffix applies the HasSuffix predicate on the "llm_owner" field.
+func LlmOwnerHasSuffix(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldHasSuffix(FieldLlmOwner, v))
+}
+
+// LlmOwnerEqualFold applies the EqualFold predicate on the "llm_owner" field.
+func LlmOwnerEqualFold(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldEqualFold(FieldLlmOwner, v))
+}
+
+// LlmOwnerContainsFold applies the ContainsFold predicate on the "llm_owner" field.
+func LlmOwnerContainsFold(v string) predicate.AnswerEvent {
+	return predicate.AnswerEvent(sql.FieldContainsFold(FieldLlmOwner, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AnswerEvent) predicate.AnswerEvent {
 	return predicate.AnswerEvent(sql.AndPredicates(predicates...))
@@ -114,6 +114,48 @@ func (_c *AnswerEventCreate) SetAnswerFormat(v string) *AnswerEventCreate {
 	return _c
 }
 
+// SetSource sets the "source" field.
+func (_c *AnswerEventCreate) SetSource(v string) *AnswerEventCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *AnswerEventCreate) SetNillableSource(v *string) *AnswerEventCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_c *AnswerEventCreate) SetLlmRequestID(v int) *AnswerEventCreate {
+	_c.mutation.SetLlmRequestID(v)
+	return _c
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_c *AnswerEventCreate) SetNillableLlmRequestID(v *int) *AnswerEventCreate {
+	if v != nil {
+		_c.SetLlmRequestID(*v)
+	}
+	return _c
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_c *AnswerEventCreate) SetLlmOwner(v string) *AnswerEventCreate {
+	_c.mutation.SetLlmOwner(v)
+	return _c
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_c *AnswerEventCreate) SetNillableLlmOwner(v *string) *AnswerEventCreate {
+	if v != nil {
+		_c.SetLlmOwner(*v)
+	}
+	return _c
+}
+
 // Mutation returns the AnswerEventMutation object of the builder.
 func (_c *AnswerEventCreate) Mutation() *AnswerEventMutation {
 	return _c.mutation
@@ -157,6 +199,18 @@ func (_c *AnswerEventCreate) defaults() {
 		v := answerevent.DefaultOwnerID
 		_c.mutation.SetOwnerID(v)
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := answerevent.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		v := answerevent.DefaultLlmRequestID
+		_c.mutation.SetLlmRequestID(v)
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		v := answerevent.DefaultLlmOwner
+		_c.mutation.SetLlmOwner(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -240,6 +294,15 @@ func (_c *AnswerEventCreate) check() error {
 			return &ValidationError{Name: "answer_format", err: fmt.Errorf(`ent: validator failed for field "AnswerEvent.answer_format": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "AnswerEvent.source"`)}
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		return &ValidationError{Name: "llm_request_id", err: errors.New(`ent: missing required field "AnswerEvent.llm_request_id"`)}
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		return &ValidationError{Name: "llm_owner", err: errors.New(`ent: missing required field "AnswerEvent.llm_owner"`)}
+	}
 	return nil
 }
 
@@ -318,6 +381,18 @@ func (_c *AnswerEventCreate) createSpec() (*AnswerEvent, *sqlgraph.CreateSpec) {
 		_spec.SetField(answerevent.FieldAnswerFormat, field.TypeString, value)
 		_node.AnswerFormat = value
 	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(answerevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.LlmRequestID(); ok {
+		_spec.SetField(answerevent.FieldLlmRequestID, field.TypeInt, value)
+		_node.LlmRequestID = value
+	}
+	if value, ok := _c.mutation.LlmOwner(); ok {
+		_spec.SetField(answerevent.FieldLlmOwner, field.TypeString, value)
+		_node.LlmOwner = value
+	}
 	return _node, _spec
 }
 
@@ -174,6 +174,55 @@ func (_u *AnswerEventUpdate) SetNillableAnswerFormat(v *string) *AnswerEventUpda
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *AnswerEventUpdate) SetSource(v string) *AnswerEventUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *AnswerEventUpdate) SetNillableSource(v *string) *AnswerEventUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *AnswerEventUpdate) SetLlmRequestID(v int) *AnswerEventUpdate {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *AnswerEventUpdate) SetNillableLlmRequestID(v *int) *AnswerEventUpdate {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *AnswerEventUpdate) AddLlmRequestID(v int) *AnswerEventUpdate {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *AnswerEventUpdate) SetLlmOwner(v string) *AnswerEventUpdate {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *AnswerEventUpdate) SetNillableLlmOwner(v *string) *AnswerEventUpdate {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AnswerEventMutation object of the builder.
 func (_u *AnswerEventUpdate) Mutation() *AnswerEventMutation {
 	return _u.mutation
@@ -296,6 +345,18 @@ func (_u *AnswerEventUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AnswerFormat(); ok {
 		_spec.SetField(answerevent.FieldAnswerFormat, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(answerevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(answerevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(answerevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(answerevent.FieldLlmOwner, field.TypeString, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{answerevent.Label}
@@ -463,6 +524,55 @@ func (_u *AnswerEventUpdateOne) SetNillableAnswerFormat(v *string) *AnswerEventU
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *AnswerEventUpdateOne) SetSource(v string) *AnswerEventUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *AnswerEventUpdateOne) SetNillableSource(v *string) *AnswerEventUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *AnswerEventUpdateOne) SetLlmRequestID(v int) *AnswerEventUpdateOne {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *AnswerEventUpdateOne) SetNillableLlmRequestID(v *int) *AnswerEventUpdateOne {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *AnswerEventUpdateOne) AddLlmRequestID(v int) *AnswerEventUpdateOne {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *AnswerEventUpdateOne) SetLlmOwner(v string) *AnswerEventUpdateOne {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *AnswerEventUpdateOne) SetNillableLlmOwner(v *string) *AnswerEventUpdateOne {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AnswerEventMutation object of the builder.
 func (_u *AnswerEventUpdateOne) Mutation() *AnswerEventMutation {
 	return _u.mutation
@@ -615,6 +725,18 @@ func (_u *AnswerEventUpdateOne) sqlSave(ctx context.Context) (_node *AnswerEvent
 	if value, ok := _u.mutation.AnswerFormat(); ok {
 		_spec.SetField(answerevent.FieldAnswerFormat, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(answerevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(answerevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(answerevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(answerevent.FieldLlmOwner, field.TypeString, value)
+	}
 	_node = &AnswerEvent{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
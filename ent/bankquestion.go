@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/abhisek/mathiz/ent/bankquestion"
+)
+
+// BankQuestion is the model entity for the BankQuestion schema.
+type BankQuestion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Skill the question was generated for
+	SkillID string `json:"skill_id,omitempty"`
+	// learn, prove, or challenge
+	Tier string `json:"tier,omitempty"`
+	// Generator-reported difficulty (1-5)
+	Difficulty int `json:"difficulty,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// integer | decimal | fraction | text
+	AnswerType string `json:"answer_type,omitempty"`
+	// numeric | multiple_choice
+	Format string `json:"format,omitempty"`
+	// Options for multiple_choice format
+	Choices []string `json:"choices,omitempty"`
+	// JSON-encoded diagram drawn with the question; empty for none
+	Diagram string `json:"diagram,omitempty"`
+	// Hint holds the value of the "hint" field.
+	Hint string `json:"hint,omitempty"`
+	// Explanation holds the value of the "explanation" field.
+	Explanation string `json:"explanation,omitempty"`
+	// Generator that produced the question (llm)
+	Source string `json:"source,omitempty"`
+	// LLMRequestEvent that generated the question, 0 if none
+	LlmRequestID int `json:"llm_request_id,omitempty"`
+	// Learner whose LLM log holds llm_request_id
+	LlmOwner string `json:"llm_owner,omitempty"`
+	// Language the question is written in (i18n.Locale)
+	Locale string `json:"locale,omitempty"`
+	// Times served from the bank
+	TimesServed int `json:"times_served,omitempty"`
+	// Graded answers across all learners
+	TimesAnswered int `json:"times_answered,omitempty"`
+	// Correct answers across all learners
+	TimesCorrect int `json:"times_correct,omitempty"`
+	// Learners who reported the question since it was last triaged
+	ReportedBy []string `json:"reported_by,omitempty"`
+	// Number of learners in reported_by; non-zero awaits triage
+	Reports int `json:"reports,omitempty"`
+	// Retired questions are never served again
+	Retired bool `json:"retired,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankQuestion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankquestion.FieldChoices, bankquestion.FieldReportedBy:
+			values[i] = new([]byte)
+		case bankquestion.FieldRetired:
+			values[i] = new(sql.NullBool)
+		case bankquestion.FieldID, bankquestion.FieldDifficulty, bankquestion.FieldLlmRequestID, bankquestion.FieldTimesServed, bankquestion.FieldTimesAnswered, bankquestion.FieldTimesCorrect, bankquestion.FieldReports:
+			values[i] = new(sql.NullInt64)
+		case bankquestion.FieldSkillID, bankquestion.FieldTier, bankquestion.FieldText, bankquestion.FieldAnswer, bankquestion.FieldAnswerType, bankquestion.FieldFormat, bankquestion.FieldDiagram, bankquestion.FieldHint, bankquestion.FieldExplanation, bankquestion.FieldSource, bankquestion.FieldLlmOwner, bankquestion.FieldLocale:
+			values[i] = new(sql.NullString)
+		case bankquestion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankQuestion fields.
+func (_m *BankQuestion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankquestion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bankquestion.FieldSkillID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skill_id", values[i])
+			} else if value.Valid {
+				_m.SkillID = value.String
+			}
+		case bankquestion.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				_m.Tier = value.String
+			}
+		case bankquestion.FieldDifficulty:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field difficulty", values[i])
+			} else if value.Valid {
+				_m.Difficulty = int(value.Int64)
+			}
+		case bankquestion.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case bankquestion.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case bankquestion.FieldAnswerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_type", values[i])
+			} else if value.Valid {
+				_m.AnswerType = value.String
+			}
+		case bankquestion.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case bankquestion.FieldChoices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Choices); err != nil {
+					return fmt.Errorf("unmarshal field choices: %w", err)
+				}
+			}
+		case bankquestion.FieldDiagram:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field diagram", values[i])
+			} else if value.Valid {
+				_m.Diagram = value.String
+			}
+		case bankquestion.FieldHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hint", values[i])
+			} else if value.Valid {
+				_m.Hint = value.String
+			}
+		case bankquestion.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				_m.Explanation = value.String
+			}
+		case bankquestion.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case bankquestion.FieldLlmRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_request_id", values[i])
+			} else if value.Valid {
+				_m.LlmRequestID = int(value.Int64)
+			}
+		case bankquestion.FieldLlmOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_owner", values[i])
+			} else if value.Valid {
+				_m.LlmOwner = value.String
+			}
+		case bankquestion.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
+		case bankquestion.FieldTimesServed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_served", values[i])
+			} else if value.Valid {
+				_m.TimesServed = int(value.Int64)
+			}
+		case bankquestion.FieldTimesAnswered:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_answered", values[i])
+			} else if value.Valid {
+				_m.TimesAnswered = int(value.Int64)
+			}
+		case bankquestion.FieldTimesCorrect:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_correct", values[i])
+			} else if value.Valid {
+				_m.TimesCorrect = int(value.Int64)
+			}
+		case bankquestion.FieldReportedBy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reported_by", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ReportedBy); err != nil {
+					return fmt.Errorf("unmarshal field reported_by: %w", err)
+				}
+			}
+		case bankquestion.FieldReports:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reports", values[i])
+			} else if value.Valid {
+				_m.Reports = int(value.Int64)
+			}
+		case bankquestion.FieldRetired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retired", values[i])
+			} else if value.Valid {
+				_m.Retired = value.Bool
+			}
+		case bankquestion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankQuestion.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankQuestion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BankQuestion.
+// Note that you need to call BankQuestion.Unwrap() before calling this method if this BankQuestion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankQuestion) Update() *BankQuestionUpdateOne {
+	return NewBankQuestionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankQuestion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankQuestion) Unwrap() *BankQuestion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankQuestion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankQuestion) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankQuestion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("skill_id=")
+	builder.WriteString(_m.SkillID)
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(_m.Tier)
+	builder.WriteString(", ")
+	builder.WriteString("difficulty=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Difficulty))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("answer_type=")
+	builder.WriteString(_m.AnswerType)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Choices))
+	builder.WriteString(", ")
+	builder.WriteString("diagram=")
+	builder.WriteString(_m.Diagram)
+	builder.WriteString(", ")
+	builder.WriteString("hint=")
+	builder.WriteString(_m.Hint)
+	builder.WriteString(", ")
+	builder.WriteString("explanation=")
+	builder.WriteString(_m.Explanation)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("llm_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("llm_owner=")
+	builder.WriteString(_m.LlmOwner)
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("times_served=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesServed))
+	builder.WriteString(", ")
+	builder.WriteString("times_answered=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesAnswered))
+	builder.WriteString(", ")
+	builder.WriteString("times_correct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesCorrect))
+	builder.WriteString(", ")
+	builder.WriteString("reported_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReportedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reports=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reports))
+	builder.WriteString(", ")
+	builder.WriteString("retired=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Retired))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankQuestions is a parsable slice of BankQuestion.
+type BankQuestions []*BankQuestion
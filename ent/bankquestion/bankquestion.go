@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankquestion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bankquestion type in the database.
+	Label = "bank_question"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSkillID holds the string denoting the skill_id field in the database.
+	FieldSkillID = "skill_id"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldDifficulty holds the string denoting the difficulty field in the database.
+	FieldDifficulty = "difficulty"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldAnswerType holds the string denoting the answer_type field in the database.
+	FieldAnswerType = "answer_type"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldChoices holds the string denoting the choices field in the database.
+	FieldChoices = "choices"
+	// FieldDiagram holds the string denoting the diagram field in the database.
+	FieldDiagram = "diagram"
+	// FieldHint holds the string denoting the hint field in the database.
+	FieldHint = "hint"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldLlmRequestID holds the string denoting the llm_request_id field in the database.
+	FieldLlmRequestID = "llm_request_id"
+	// FieldLlmOwner holds the string denoting the llm_owner field in the database.
+	FieldLlmOwner = "llm_owner"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimesServed holds the string denoting the times_served field in the database.
+	FieldTimesServed = "times_served"
+	// FieldTimesAnswered holds the string denoting the times_answered field in the database.
+	FieldTimesAnswered = "times_answered"
+	// FieldTimesCorrect holds the string denoting the times_correct field in the database.
+	FieldTimesCorrect = "times_correct"
+	// FieldReportedBy holds the string denoting the reported_by field in the database.
+	FieldReportedBy = "reported_by"
+	// FieldReports holds the string denoting the reports field in the database.
+	FieldReports = "reports"
+	// FieldRetired holds the string denoting the retired field in the database.
+	FieldRetired = "retired"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the bankquestion in the database.
+	Table = "bank_questions"
+)
+
+// Columns holds all SQL columns for bankquestion fields.
+var Columns = []string{
+	FieldID,
+	FieldSkillID,
+	FieldTier,
+	FieldDifficulty,
+	FieldText,
+	FieldAnswer,
+	FieldAnswerType,
+	FieldFormat,
+	FieldChoices,
+	FieldDiagram,
+	FieldHint,
+	FieldExplanation,
+	FieldSource,
+	FieldLlmRequestID,
+	FieldLlmOwner,
+	FieldLocale,
+	FieldTimesServed,
+	FieldTimesAnswered,
+	FieldTimesCorrect,
+	FieldReportedBy,
+	FieldReports,
+	FieldRetired,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SkillIDValidator is a validator for the "skill_id" field. It is called by the builders before save.
+	SkillIDValidator func(string) error
+	// TierValidator is a validator for the "tier" field. It is called by the builders before save.
+	TierValidator func(string) error
+	// DefaultDifficulty holds the default value on creation for the "difficulty" field.
+	DefaultDifficulty int
+	// TextValidator is a validator for the "text" field. It is called by the builders before save.
+	TextValidator func(string) error
+	// DefaultDiagram holds the default value on creation for the "diagram" field.
+	DefaultDiagram string
+	// DefaultHint holds the default value on creation for the "hint" field.
+	DefaultHint string
+	// DefaultExplanation holds the default value on creation for the "explanation" field.
+	DefaultExplanation string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultLlmRequestID holds the default value on creation for the "llm_request_id" field.
+	DefaultLlmRequestID int
+	// DefaultLlmOwner holds the default value on creation for the "llm_owner" field.
+	DefaultLlmOwner string
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// DefaultTimesServed holds the default value on creation for the "times_served" field.
+	DefaultTimesServed int
+	// DefaultTimesAnswered holds the default value on creation for the "times_answered" field.
+	DefaultTimesAnswered int
+	// DefaultTimesCorrect holds the default value on creation for the "times_correct" field.
+	DefaultTimesCorrect int
+	// DefaultReports holds the default value on creation for the "reports" field.
+	DefaultReports int
+	// DefaultRetired holds the default value on creation for the "retired" field.
+	DefaultRetired bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BankQuestion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySkillID orders the results by the skill_id field.
+func BySkillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkillID, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByDifficulty orders the results by the difficulty field.
+func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByAnswerType orders the results by the answer_type field.
+func ByAnswerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerType, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByDiagram orders the results by the diagram field.
+func ByDiagram(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiagram, opts...).ToFunc()
+}
+
+// ByHint orders the results by the hint field.
+func ByHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHint, opts...).ToFunc()
+}
+
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByLlmRequestID orders the results by the llm_request_id field.
+func ByLlmRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmRequestID, opts...).ToFunc()
+}
+
+// ByLlmOwner orders the results by the llm_owner field.
+func ByLlmOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmOwner, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimesServed orders the results by the times_served field.
+func ByTimesServed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesServed, opts...).ToFunc()
+}
+
+// ByTimesAnswered orders the results by the times_answered field.
+func ByTimesAnswered(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesAnswered, opts...).ToFunc()
+}
+
+// ByTimesCorrect orders the results by the times_correct field.
+func ByTimesCorrect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesCorrect, opts...).ToFunc()
+}
+
+// ByReports orders the results by the reports field.
+func ByReports(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReports, opts...).ToFunc()
+}
+
+// ByRetired orders the results by the retired field.
+func ByRetired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetired, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
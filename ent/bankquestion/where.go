@@ -0,0 +1,1260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankquestion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/abhisek/mathiz/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldID, id))
+}
+
+// SkillID applies equality check predicate on the "skill_id" field. It's identical to SkillIDEQ.
+func SkillID(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldSkillID, v))
+}
+
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTier, v))
+}
+
+// Difficulty applies equality check predicate on the "difficulty" field. It's identical to DifficultyEQ.
+func Difficulty(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldText, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerType applies equality check predicate on the "answer_type" field. It's identical to AnswerTypeEQ.
+func AnswerType(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldAnswerType, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldFormat, v))
+}
+
+// Diagram applies equality check predicate on the "diagram" field. It's identical to DiagramEQ.
+func Diagram(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldDiagram, v))
+}
+
+// Hint applies equality check predicate on the "hint" field. It's identical to HintEQ.
+func Hint(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldHint, v))
+}
+
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldExplanation, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldSource, v))
+}
+
+// LlmRequestID applies equality check predicate on the "llm_request_id" field. It's identical to LlmRequestIDEQ.
+func LlmRequestID(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmOwner applies equality check predicate on the "llm_owner" field. It's identical to LlmOwnerEQ.
+func LlmOwner(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLlmOwner, v))
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLocale, v))
+}
+
+// TimesServed applies equality check predicate on the "times_served" field. It's identical to TimesServedEQ.
+func TimesServed(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesServed, v))
+}
+
+// TimesAnswered applies equality check predicate on the "times_answered" field. It's identical to TimesAnsweredEQ.
+func TimesAnswered(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesAnswered, v))
+}
+
+// TimesCorrect applies equality check predicate on the "times_correct" field. It's identical to TimesCorrectEQ.
+func TimesCorrect(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesCorrect, v))
+}
+
+// Reports applies equality check predicate on the "reports" field. It's identical to ReportsEQ.
+func Reports(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldReports, v))
+}
+
+// Retired applies equality check predicate on the "retired" field. It's identical to RetiredEQ.
+func Retired(v bool) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldRetired, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SkillIDEQ applies the EQ predicate on the "skill_id" field.
+func SkillIDEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldSkillID, v))
+}
+
+// SkillIDNEQ applies the NEQ predicate on the "skill_id" field.
+func SkillIDNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldSkillID, v))
+}
+
+// SkillIDIn applies the In predicate on the "skill_id" field.
+func SkillIDIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldSkillID, vs...))
+}
+
+// SkillIDNotIn applies the NotIn predicate on the "skill_id" field.
+func SkillIDNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldSkillID, vs...))
+}
+
+// SkillIDGT applies the GT predicate on the "skill_id" field.
+func SkillIDGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldSkillID, v))
+}
+
+// SkillIDGTE applies the GTE predicate on the "skill_id" field.
+func SkillIDGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldSkillID, v))
+}
+
+// SkillIDLT applies the LT predicate on the "skill_id" field.
+func SkillIDLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldSkillID, v))
+}
+
+// SkillIDLTE applies the LTE predicate on the "skill_id" field.
+func SkillIDLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldSkillID, v))
+}
+
+// SkillIDContains applies the Contains predicate on the "skill_id" field.
+func SkillIDContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldSkillID, v))
+}
+
+// SkillIDHasPrefix applies the HasPrefix predicate on the "skill_id" field.
+func SkillIDHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldSkillID, v))
+}
+
+// SkillIDHasSuffix applies the HasSuffix predicate on the "skill_id" field.
+func SkillIDHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldSkillID, v))
+}
+
+// SkillIDEqualFold applies the EqualFold predicate on the "skill_id" field.
+func SkillIDEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldSkillID, v))
+}
+
+// SkillIDContainsFold applies the ContainsFold predicate on the "skill_id" field.
+func SkillIDContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldSkillID, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldTier, v))
+}
+
+// DifficultyEQ applies the EQ predicate on the "difficulty" field.
+func DifficultyEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// DifficultyNEQ applies the NEQ predicate on the "difficulty" field.
+func DifficultyNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldDifficulty, v))
+}
+
+// DifficultyIn applies the In predicate on the "difficulty" field.
+func DifficultyIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldDifficulty, vs...))
+}
+
+// DifficultyNotIn applies the NotIn predicate on the "difficulty" field.
+func DifficultyNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldDifficulty, vs...))
+}
+
+// DifficultyGT applies the GT predicate on the "difficulty" field.
+func DifficultyGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldDifficulty, v))
+}
+
+// DifficultyGTE applies the GTE predicate on the "difficulty" field.
+func DifficultyGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldDifficulty, v))
+}
+
+// DifficultyLT applies the LT predicate on the "difficulty" field.
+func DifficultyLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldDifficulty, v))
+}
+
+// DifficultyLTE applies the LTE predicate on the "difficulty" field.
+func DifficultyLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldDifficulty, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldText, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// AnswerTypeEQ applies the EQ predicate on the "answer_type" field.
+func AnswerTypeEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldAnswerType, v))
+}
+
+// AnswerTypeNEQ applies the NEQ predicate on the "answer_type" field.
+func AnswerTypeNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldAnswerType, v))
+}
+
+// AnswerTypeIn applies the In predicate on the "answer_type" field.
+func AnswerTypeIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldAnswerType, vs...))
+}
+
+// AnswerTypeNotIn applies the NotIn predicate on the "answer_type" field.
+func AnswerTypeNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldAnswerType, vs...))
+}
+
+// AnswerTypeGT applies the GT predicate on the "answer_type" field.
+func AnswerTypeGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldAnswerType, v))
+}
+
+// AnswerTypeGTE applies the GTE predicate on the "answer_type" field.
+func AnswerTypeGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldAnswerType, v))
+}
+
+// AnswerTypeLT applies the LT predicate on the "answer_type" field.
+func AnswerTypeLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldAnswerType, v))
+}
+
+// AnswerTypeLTE applies the LTE predicate on the "answer_type" field.
+func AnswerTypeLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldAnswerType, v))
+}
+
+// AnswerTypeContains applies the Contains predicate on the "answer_type" field.
+func AnswerTypeContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldAnswerType, v))
+}
+
+// AnswerTypeHasPrefix applies the HasPrefix predicate on the "answer_type" field.
+func AnswerTypeHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldAnswerType, v))
+}
+
+// AnswerTypeHasSuffix applies the HasSuffix predicate on the "answer_type" field.
+func AnswerTypeHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldAnswerType, v))
+}
+
+// AnswerTypeEqualFold applies the EqualFold predicate on the "answer_type" field.
+func AnswerTypeEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldAnswerType, v))
+}
+
+// AnswerTypeContainsFold applies the ContainsFold predicate on the "answer_type" field.
+func AnswerTypeContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldAnswerType, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// ChoicesIsNil applies the IsNil predicate on the "choices" field.
+func ChoicesIsNil() predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIsNull(FieldChoices))
+}
+
+// ChoicesNotNil applies the NotNil predicate on the "choices" field.
+func ChoicesNotNil() predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotNull(FieldChoices))
+}
+
+// DiagramEQ applies the EQ predicate on the "diagram" field.
+func DiagramEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldDiagram, v))
+}
+
+// DiagramNEQ applies the NEQ predicate on the "diagram" field.
+func DiagramNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldDiagram, v))
+}
+
+// DiagramIn applies the In predicate on the "diagram" field.
+func DiagramIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldDiagram, vs...))
+}
+
+// DiagramNotIn applies the NotIn predicate on the "diagram" field.
+func DiagramNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldDiagram, vs...))
+}
+
+// DiagramGT applies the GT predicate on the "diagram" field.
+func DiagramGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldDiagram, v))
+}
+
+// DiagramGTE applies the GTE predicate on the "diagram" field.
+func DiagramGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldDiagram, v))
+}
+
+// DiagramLT applies the LT predicate on the "diagram" field.
+func DiagramLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldDiagram, v))
+}
+
+// DiagramLTE applies the LTE predicate on the "diagram" field.
+func DiagramLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldDiagram, v))
+}
+
+// DiagramContains applies the Contains predicate on the "diagram" field.
+func DiagramContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldDiagram, v))
+}
+
+// DiagramHasPrefix applies the HasPrefix predicate on the "diagram" field.
+func DiagramHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldDiagram, v))
+}
+
+// DiagramHasSuffix applies the HasSuffix predicate on the "diagram" field.
+func DiagramHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldDiagram, v))
+}
+
+// DiagramEqualFold applies the EqualFold predicate on the "diagram" field.
+func DiagramEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldDiagram, v))
+}
+
+// DiagramContainsFold applies the ContainsFold predicate on the "diagram" field.
+func DiagramContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldDiagram, v))
+}
+
+// HintEQ applies the EQ predicate on the "hint" field.
+func HintEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldHint, v))
+}
+
+// HintNEQ applies the NEQ predicate on the "hint" field.
+func HintNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldHint, v))
+}
+
+// HintIn applies the In predicate on the "hint" field.
+func HintIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldHint, vs...))
+}
+
+// HintNotIn applies the NotIn predicate on the "hint" field.
+func HintNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldHint, vs...))
+}
+
+// HintGT applies the GT predicate on the "hint" field.
+func HintGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldHint, v))
+}
+
+// HintGTE applies the GTE predicate on the "hint" field.
+func HintGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldHint, v))
+}
+
+// HintLT applies the LT predicate on the "hint" field.
+func HintLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldHint, v))
+}
+
+// HintLTE applies the LTE predicate on the "hint" field.
+func HintLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldHint, v))
+}
+
+// HintContains applies the Contains predicate on the "hint" field.
+func HintContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldHint, v))
+}
+
+// HintHasPrefix applies the HasPrefix predicate on the "hint" field.
+func HintHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldHint, v))
+}
+
+// HintHasSuffix applies the HasSuffix predicate on the "hint" field.
+func HintHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldHint, v))
+}
+
+// HintEqualFold applies the EqualFold predicate on the "hint" field.
+func HintEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldHint, v))
+}
+
+// HintContainsFold applies the ContainsFold predicate on the "hint" field.
+func HintContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldHint, v))
+}
+
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldExplanation, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldSource, v))
+}
+
+// LlmRequestIDEQ applies the EQ predicate on the "llm_request_id" field.
+func LlmRequestIDEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDNEQ applies the NEQ predicate on the "llm_request_id" field.
+func LlmRequestIDNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDIn applies the In predicate on the "llm_request_id" field.
+func LlmRequestIDIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDNotIn applies the NotIn predicate on the "llm_request_id" field.
+func LlmRequestIDNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDGT applies the GT predicate on the "llm_request_id" field.
+func LlmRequestIDGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDGTE applies the GTE predicate on the "llm_request_id" field.
+func LlmRequestIDGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLT applies the LT predicate on the "llm_request_id" field.
+func LlmRequestIDLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLTE applies the LTE predicate on the "llm_request_id" field.
+func LlmRequestIDLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldLlmRequestID, v))
+}
+
+// LlmOwnerEQ applies the EQ predicate on the "llm_owner" field.
+func LlmOwnerEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerNEQ applies the NEQ predicate on the "llm_owner" field.
+func LlmOwnerNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerIn applies the In predicate on the "llm_owner" field.
+func LlmOwnerIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerNotIn applies the NotIn predicate on the "llm_owner" field.
+func LlmOwnerNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerGT applies the GT predicate on the "llm_owner" field.
+func LlmOwnerGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldLlmOwner, v))
+}
+
+// LlmOwnerGTE applies the GTE predicate on the "llm_owner" field.
+func LlmOwnerGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerLT applies the LT predicate on the "llm_owner" field.
+func LlmOwnerLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldLlmOwner, v))
+}
+
+// LlmOwnerLTE applies the LTE predicate on the "llm_owner" field.
+func LlmOwnerLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerContains applies the Contains predicate on the "llm_owner" field.
+func LlmOwnerContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasPrefix applies the HasPrefix predicate on the "llm_owner" field.
+func LlmOwnerHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasSuffix applies the HasSuffix predicate on the "llm_owner" field.
+func LlmOwnerHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldLlmOwner, v))
+}
+
+// LlmOwnerEqualFold applies the EqualFold predicate on the "llm_owner" field.
+func LlmOwnerEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldLlmOwner, v))
+}
+
+// LlmOwnerContainsFold applies the ContainsFold predicate on the "llm_owner" field.
+func LlmOwnerContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldLlmOwner, v))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldContainsFold(FieldLocale, v))
+}
+
+// TimesServedEQ applies the EQ predicate on the "times_served" field.
+func TimesServedEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesServed, v))
+}
+
+// TimesServedNEQ applies the NEQ predicate on the "times_served" field.
+func TimesServedNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldTimesServed, v))
+}
+
+// TimesServedIn applies the In predicate on the "times_served" field.
+func TimesServedIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldTimesServed, vs...))
+}
+
+// TimesServedNotIn applies the NotIn predicate on the "times_served" field.
+func TimesServedNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldTimesServed, vs...))
+}
+
+// TimesServedGT applies the GT predicate on the "times_served" field.
+func TimesServedGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldTimesServed, v))
+}
+
+// TimesServedGTE applies the GTE predicate on the "times_served" field.
+func TimesServedGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldTimesServed, v))
+}
+
+// TimesServedLT applies the LT predicate on the "times_served" field.
+func TimesServedLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldTimesServed, v))
+}
+
+// TimesServedLTE applies the LTE predicate on the "times_served" field.
+func TimesServedLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldTimesServed, v))
+}
+
+// TimesAnsweredEQ applies the EQ predicate on the "times_answered" field.
+func TimesAnsweredEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesAnswered, v))
+}
+
+// TimesAnsweredNEQ applies the NEQ predicate on the "times_answered" field.
+func TimesAnsweredNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldTimesAnswered, v))
+}
+
+// TimesAnsweredIn applies the In predicate on the "times_answered" field.
+func TimesAnsweredIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldTimesAnswered, vs...))
+}
+
+// TimesAnsweredNotIn applies the NotIn predicate on the "times_answered" field.
+func TimesAnsweredNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldTimesAnswered, vs...))
+}
+
+// TimesAnsweredGT applies the GT predicate on the "times_answered" field.
+func TimesAnsweredGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldTimesAnswered, v))
+}
+
+// TimesAnsweredGTE applies the GTE predicate on the "times_answered" field.
+func TimesAnsweredGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldTimesAnswered, v))
+}
+
+// TimesAnsweredLT applies the LT predicate on the "times_answered" field.
+func TimesAnsweredLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldTimesAnswered, v))
+}
+
+// TimesAnsweredLTE applies the LTE predicate on the "times_answered" field.
+func TimesAnsweredLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldTimesAnswered, v))
+}
+
+// TimesCorrectEQ applies the EQ predicate on the "times_correct" field.
+func TimesCorrectEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldTimesCorrect, v))
+}
+
+// TimesCorrectNEQ applies the NEQ predicate on the "times_correct" field.
+func TimesCorrectNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldTimesCorrect, v))
+}
+
+// TimesCorrectIn applies the In predicate on the "times_correct" field.
+func TimesCorrectIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldTimesCorrect, vs...))
+}
+
+// TimesCorrectNotIn applies the NotIn predicate on the "times_correct" field.
+func TimesCorrectNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldTimesCorrect, vs...))
+}
+
+// TimesCorrectGT applies the GT predicate on the "times_correct" field.
+func TimesCorrectGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldTimesCorrect, v))
+}
+
+// TimesCorrectGTE applies the GTE predicate on the "times_correct" field.
+func TimesCorrectGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldTimesCorrect, v))
+}
+
+// TimesCorrectLT applies the LT predicate on the "times_correct" field.
+func TimesCorrectLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldTimesCorrect, v))
+}
+
+// TimesCorrectLTE applies the LTE predicate on the "times_correct" field.
+func TimesCorrectLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldTimesCorrect, v))
+}
+
+// ReportedByIsNil applies the IsNil predicate on the "reported_by" field.
+func ReportedByIsNil() predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIsNull(FieldReportedBy))
+}
+
+// ReportedByNotNil applies the NotNil predicate on the "reported_by" field.
+func ReportedByNotNil() predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotNull(FieldReportedBy))
+}
+
+// ReportsEQ applies the EQ predicate on the "reports" field.
+func ReportsEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldReports, v))
+}
+
+// ReportsNEQ applies the NEQ predicate on the "reports" field.
+func ReportsNEQ(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldReports, v))
+}
+
+// ReportsIn applies the In predicate on the "reports" field.
+func ReportsIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldReports, vs...))
+}
+
+// ReportsNotIn applies the NotIn predicate on the "reports" field.
+func ReportsNotIn(vs ...int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldReports, vs...))
+}
+
+// ReportsGT applies the GT predicate on the "reports" field.
+func ReportsGT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldReports, v))
+}
+
+// ReportsGTE applies the GTE predicate on the "reports" field.
+func ReportsGTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldReports, v))
+}
+
+// ReportsLT applies the LT predicate on the "reports" field.
+func ReportsLT(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldReports, v))
+}
+
+// ReportsLTE applies the LTE predicate on the "reports" field.
+func ReportsLTE(v int) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldReports, v))
+}
+
+// RetiredEQ applies the EQ predicate on the "retired" field.
+func RetiredEQ(v bool) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldRetired, v))
+}
+
+// RetiredNEQ applies the NEQ predicate on the "retired" field.
+func RetiredNEQ(v bool) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldRetired, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankQuestion) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankQuestion) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankQuestion) predicate.BankQuestion {
+	return predicate.BankQuestion(sql.NotPredicates(p))
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/abhisek/mathiz/ent/bankquestion"
+)
+
+// BankQuestionCreate is the builder for creating a BankQuestion entity.
+type BankQuestionCreate struct {
+	config
+	mutation *BankQuestionMutation
+	hooks    []Hook
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_c *BankQuestionCreate) SetSkillID(v string) *BankQuestionCreate {
+	_c.mutation.SetSkillID(v)
+	return _c
+}
+
+// SetTier sets the "tier" field.
+func (_c *BankQuestionCreate) SetTier(v string) *BankQuestionCreate {
+	_c.mutation.SetTier(v)
+	return _c
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_c *BankQuestionCreate) SetDifficulty(v int) *BankQuestionCreate {
+	_c.mutation.SetDifficulty(v)
+	return _c
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableDifficulty(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetDifficulty(*v)
+	}
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *BankQuestionCreate) SetText(v string) *BankQuestionCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *BankQuestionCreate) SetAnswer(v string) *BankQuestionCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_c *BankQuestionCreate) SetAnswerType(v string) *BankQuestionCreate {
+	_c.mutation.SetAnswerType(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *BankQuestionCreate) SetFormat(v string) *BankQuestionCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetChoices sets the "choices" field.
+func (_c *BankQuestionCreate) SetChoices(v []string) *BankQuestionCreate {
+	_c.mutation.SetChoices(v)
+	return _c
+}
+
+// SetDiagram sets the "diagram" field.
+func (_c *BankQuestionCreate) SetDiagram(v string) *BankQuestionCreate {
+	_c.mutation.SetDiagram(v)
+	return _c
+}
+
+// SetNillableDiagram sets the "diagram" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableDiagram(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetDiagram(*v)
+	}
+	return _c
+}
+
+// SetHint sets the "hint" field.
+func (_c *BankQuestionCreate) SetHint(v string) *BankQuestionCreate {
+	_c.mutation.SetHint(v)
+	return _c
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableHint(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetHint(*v)
+	}
+	return _c
+}
+
+// SetExplanation sets the "explanation" field.
+func (_c *BankQuestionCreate) SetExplanation(v string) *BankQuestionCreate {
+	_c.mutation.SetExplanation(v)
+	return _c
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableExplanation(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetExplanation(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BankQuestionCreate) SetSource(v string) *BankQuestionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableSource(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_c *BankQuestionCreate) SetLlmRequestID(v int) *BankQuestionCreate {
+	_c.mutation.SetLlmRequestID(v)
+	return _c
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableLlmRequestID(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetLlmRequestID(*v)
+	}
+	return _c
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_c *BankQuestionCreate) SetLlmOwner(v string) *BankQuestionCreate {
+	_c.mutation.SetLlmOwner(v)
+	return _c
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableLlmOwner(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetLlmOwner(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *BankQuestionCreate) SetLocale(v string) *BankQuestionCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableLocale(v *string) *BankQuestionCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetTimesServed sets the "times_served" field.
+func (_c *BankQuestionCreate) SetTimesServed(v int) *BankQuestionCreate {
+	_c.mutation.SetTimesServed(v)
+	return _c
+}
+
+// SetNillableTimesServed sets the "times_served" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableTimesServed(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetTimesServed(*v)
+	}
+	return _c
+}
+
+// SetTimesAnswered sets the "times_answered" field.
+func (_c *BankQuestionCreate) SetTimesAnswered(v int) *BankQuestionCreate {
+	_c.mutation.SetTimesAnswered(v)
+	return _c
+}
+
+// SetNillableTimesAnswered sets the "times_answered" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableTimesAnswered(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetTimesAnswered(*v)
+	}
+	return _c
+}
+
+// SetTimesCorrect sets the "times_correct" field.
+func (_c *BankQuestionCreate) SetTimesCorrect(v int) *BankQuestionCreate {
+	_c.mutation.SetTimesCorrect(v)
+	return _c
+}
+
+// SetNillableTimesCorrect sets the "times_correct" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableTimesCorrect(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetTimesCorrect(*v)
+	}
+	return _c
+}
+
+// SetReportedBy sets the "reported_by" field.
+func (_c *BankQuestionCreate) SetReportedBy(v []string) *BankQuestionCreate {
+	_c.mutation.SetReportedBy(v)
+	return _c
+}
+
+// SetReports sets the "reports" field.
+func (_c *BankQuestionCreate) SetReports(v int) *BankQuestionCreate {
+	_c.mutation.SetReports(v)
+	return _c
+}
+
+// SetNillableReports sets the "reports" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableReports(v *int) *BankQuestionCreate {
+	if v != nil {
+		_c.SetReports(*v)
+	}
+	return _c
+}
+
+// SetRetired sets the "retired" field.
+func (_c *BankQuestionCreate) SetRetired(v bool) *BankQuestionCreate {
+	_c.mutation.SetRetired(v)
+	return _c
+}
+
+// SetNillableRetired sets the "retired" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableRetired(v *bool) *BankQuestionCreate {
+	if v != nil {
+		_c.SetRetired(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankQuestionCreate) SetCreatedAt(v time.Time) *BankQuestionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankQuestionCreate) SetNillableCreatedAt(v *time.Time) *BankQuestionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BankQuestionMutation object of the builder.
+func (_c *BankQuestionCreate) Mutation() *BankQuestionMutation {
+	return _c.mutation
+}
+
+// Save creates the BankQuestion in the database.
+func (_c *BankQuestionCreate) Save(ctx context.Context) (*BankQuestion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankQuestionCreate) SaveX(ctx context.Context) *BankQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankQuestionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankQuestionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankQuestionCreate) defaults() {
+	if _, ok := _c.mutation.Difficulty(); !ok {
+		v := bankquestion.DefaultDifficulty
+		_c.mutation.SetDifficulty(v)
+	}
+	if _, ok := _c.mutation.Diagram(); !ok {
+		v := bankquestion.DefaultDiagram
+		_c.mutation.SetDiagram(v)
+	}
+	if _, ok := _c.mutation.Hint(); !ok {
+		v := bankquestion.DefaultHint
+		_c.mutation.SetHint(v)
+	}
+	if _, ok := _c.mutation.Explanation(); !ok {
+		v := bankquestion.DefaultExplanation
+		_c.mutation.SetExplanation(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := bankquestion.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		v := bankquestion.DefaultLlmRequestID
+		_c.mutation.SetLlmRequestID(v)
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		v := bankquestion.DefaultLlmOwner
+		_c.mutation.SetLlmOwner(v)
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := bankquestion.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
+	if _, ok := _c.mutation.TimesServed(); !ok {
+		v := bankquestion.DefaultTimesServed
+		_c.mutation.SetTimesServed(v)
+	}
+	if _, ok := _c.mutation.TimesAnswered(); !ok {
+		v := bankquestion.DefaultTimesAnswered
+		_c.mutation.SetTimesAnswered(v)
+	}
+	if _, ok := _c.mutation.TimesCorrect(); !ok {
+		v := bankquestion.DefaultTimesCorrect
+		_c.mutation.SetTimesCorrect(v)
+	}
+	if _, ok := _c.mutation.Reports(); !ok {
+		v := bankquestion.DefaultReports
+		_c.mutation.SetReports(v)
+	}
+	if _, ok := _c.mutation.Retired(); !ok {
+		v := bankquestion.DefaultRetired
+		_c.mutation.SetRetired(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankquestion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankQuestionCreate) check() error {
+	if _, ok := _c.mutation.SkillID(); !ok {
+		return &ValidationError{Name: "skill_id", err: errors.New(`ent: missing required field "BankQuestion.skill_id"`)}
+	}
+	if v, ok := _c.mutation.SkillID(); ok {
+		if err := bankquestion.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.skill_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`ent: missing required field "BankQuestion.tier"`)}
+	}
+	if v, ok := _c.mutation.Tier(); ok {
+		if err := bankquestion.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.tier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Difficulty(); !ok {
+		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "BankQuestion.difficulty"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "BankQuestion.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := bankquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required field "BankQuestion.answer"`)}
+	}
+	if _, ok := _c.mutation.AnswerType(); !ok {
+		return &ValidationError{Name: "answer_type", err: errors.New(`ent: missing required field "BankQuestion.answer_type"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "BankQuestion.format"`)}
+	}
+	if _, ok := _c.mutation.Diagram(); !ok {
+		return &ValidationError{Name: "diagram", err: errors.New(`ent: missing required field "BankQuestion.diagram"`)}
+	}
+	if _, ok := _c.mutation.Hint(); !ok {
+		return &ValidationError{Name: "hint", err: errors.New(`ent: missing required field "BankQuestion.hint"`)}
+	}
+	if _, ok := _c.mutation.Explanation(); !ok {
+		return &ValidationError{Name: "explanation", err: errors.New(`ent: missing required field "BankQuestion.explanation"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "BankQuestion.source"`)}
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		return &ValidationError{Name: "llm_request_id", err: errors.New(`ent: missing required field "BankQuestion.llm_request_id"`)}
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		return &ValidationError{Name: "llm_owner", err: errors.New(`ent: missing required field "BankQuestion.llm_owner"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "BankQuestion.locale"`)}
+	}
+	if _, ok := _c.mutation.TimesServed(); !ok {
+		return &ValidationError{Name: "times_served", err: errors.New(`ent: missing required field "BankQuestion.times_served"`)}
+	}
+	if _, ok := _c.mutation.TimesAnswered(); !ok {
+		return &ValidationError{Name: "times_answered", err: errors.New(`ent: missing required field "BankQuestion.times_answered"`)}
+	}
+	if _, ok := _c.mutation.TimesCorrect(); !ok {
+		return &ValidationError{Name: "times_correct", err: errors.New(`ent: missing required field "BankQuestion.times_correct"`)}
+	}
+	if _, ok := _c.mutation.Reports(); !ok {
+		return &ValidationError{Name: "reports", err: errors.New(`ent: missing required field "BankQuestion.reports"`)}
+	}
+	if _, ok := _c.mutation.Retired(); !ok {
+		return &ValidationError{Name: "retired", err: errors.New(`ent: missing required field "BankQuestion.retired"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankQuestion.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BankQuestionCreate) sqlSave(ctx context.Context) (*BankQuestion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankQuestionCreate) createSpec() (*BankQuestion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankQuestion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankquestion.Table, sqlgraph.NewFieldSpec(bankquestion.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SkillID(); ok {
+		_spec.SetField(bankquestion.FieldSkillID, field.TypeString, value)
+		_node.SkillID = value
+	}
+	if value, ok := _c.mutation.Tier(); ok {
+		_spec.SetField(bankquestion.FieldTier, field.TypeString, value)
+		_node.Tier = value
+	}
+	if value, ok := _c.mutation.Difficulty(); ok {
+		_spec.SetField(bankquestion.FieldDifficulty, field.TypeInt, value)
+		_node.Difficulty = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(bankquestion.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(bankquestion.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.AnswerType(); ok {
+		_spec.SetField(bankquestion.FieldAnswerType, field.TypeString, value)
+		_node.AnswerType = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(bankquestion.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Choices(); ok {
+		_spec.SetField(bankquestion.FieldChoices, field.TypeJSON, value)
+		_node.Choices = value
+	}
+	if value, ok := _c.mutation.Diagram(); ok {
+		_spec.SetField(bankquestion.FieldDiagram, field.TypeString, value)
+		_node.Diagram = value
+	}
+	if value, ok := _c.mutation.Hint(); ok {
+		_spec.SetField(bankquestion.FieldHint, field.TypeString, value)
+		_node.Hint = value
+	}
+	if value, ok := _c.mutation.Explanation(); ok {
+		_spec.SetField(bankquestion.FieldExplanation, field.TypeString, value)
+		_node.Explanation = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bankquestion.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.LlmRequestID(); ok {
+		_spec.SetField(bankquestion.FieldLlmRequestID, field.TypeInt, value)
+		_node.LlmRequestID = value
+	}
+	if value, ok := _c.mutation.LlmOwner(); ok {
+		_spec.SetField(bankquestion.FieldLlmOwner, field.TypeString, value)
+		_node.LlmOwner = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(bankquestion.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.TimesServed(); ok {
+		_spec.SetField(bankquestion.FieldTimesServed, field.TypeInt, value)
+		_node.TimesServed = value
+	}
+	if value, ok := _c.mutation.TimesAnswered(); ok {
+		_spec.SetField(bankquestion.FieldTimesAnswered, field.TypeInt, value)
+		_node.TimesAnswered = value
+	}
+	if value, ok := _c.mutation.TimesCorrect(); ok {
+		_spec.SetField(bankquestion.FieldTimesCorrect, field.TypeInt, value)
+		_node.TimesCorrect = value
+	}
+	if value, ok := _c.mutation.ReportedBy(); ok {
+		_spec.SetField(bankquestion.FieldReportedBy, field.TypeJSON, value)
+		_node.ReportedBy = value
+	}
+	if value, ok := _c.mutation.Reports(); ok {
+		_spec.SetField(bankquestion.FieldReports, field.TypeInt, value)
+		_node.Reports = value
+	}
+	if value, ok := _c.mutation.Retired(); ok {
+		_spec.SetField(bankquestion.FieldRetired, field.TypeBool, value)
+		_node.Retired = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankquestion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BankQuestionCreateBulk is the builder for creating many BankQuestion entities in bulk.
+type BankQuestionCreateBulk struct {
+	config
+	err      error
+	builders []*BankQuestionCreate
+}
+
+// Save creates the BankQuestion entities in the database.
+func (_c *BankQuestionCreateBulk) Save(ctx context.Context) ([]*BankQuestion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankQuestion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankQuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankQuestionCreateBulk) SaveX(ctx context.Context) []*BankQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankQuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankQuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
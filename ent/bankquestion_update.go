@@ -0,0 +1,1104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/abhisek/mathiz/ent/bankquestion"
+	"github.com/abhisek/mathiz/ent/predicate"
+)
+
+// BankQuestionUpdate is the builder for updating BankQuestion entities.
+type BankQuestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BankQuestionMutation
+}
+
+// Where appends a list predicates to the BankQuestionUpdate builder.
+func (_u *BankQuestionUpdate) Where(ps ...predicate.BankQuestion) *BankQuestionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *BankQuestionUpdate) SetSkillID(v string) *BankQuestionUpdate {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableSkillID(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *BankQuestionUpdate) SetTier(v string) *BankQuestionUpdate {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableTier(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_u *BankQuestionUpdate) SetDifficulty(v int) *BankQuestionUpdate {
+	_u.mutation.ResetDifficulty()
+	_u.mutation.SetDifficulty(v)
+	return _u
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableDifficulty(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetDifficulty(*v)
+	}
+	return _u
+}
+
+// AddDifficulty adds value to the "difficulty" field.
+func (_u *BankQuestionUpdate) AddDifficulty(v int) *BankQuestionUpdate {
+	_u.mutation.AddDifficulty(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *BankQuestionUpdate) SetText(v string) *BankQuestionUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableText(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *BankQuestionUpdate) SetAnswer(v string) *BankQuestionUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableAnswer(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_u *BankQuestionUpdate) SetAnswerType(v string) *BankQuestionUpdate {
+	_u.mutation.SetAnswerType(v)
+	return _u
+}
+
+// SetNillableAnswerType sets the "answer_type" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableAnswerType(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetAnswerType(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankQuestionUpdate) SetFormat(v string) *BankQuestionUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableFormat(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *BankQuestionUpdate) SetChoices(v []string) *BankQuestionUpdate {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *BankQuestionUpdate) AppendChoices(v []string) *BankQuestionUpdate {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *BankQuestionUpdate) ClearChoices() *BankQuestionUpdate {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetDiagram sets the "diagram" field.
+func (_u *BankQuestionUpdate) SetDiagram(v string) *BankQuestionUpdate {
+	_u.mutation.SetDiagram(v)
+	return _u
+}
+
+// SetNillableDiagram sets the "diagram" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableDiagram(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetDiagram(*v)
+	}
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *BankQuestionUpdate) SetHint(v string) *BankQuestionUpdate {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableHint(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// SetExplanation sets the "explanation" field.
+func (_u *BankQuestionUpdate) SetExplanation(v string) *BankQuestionUpdate {
+	_u.mutation.SetExplanation(v)
+	return _u
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableExplanation(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetExplanation(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BankQuestionUpdate) SetSource(v string) *BankQuestionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableSource(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *BankQuestionUpdate) SetLlmRequestID(v int) *BankQuestionUpdate {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableLlmRequestID(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *BankQuestionUpdate) AddLlmRequestID(v int) *BankQuestionUpdate {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *BankQuestionUpdate) SetLlmOwner(v string) *BankQuestionUpdate {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableLlmOwner(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *BankQuestionUpdate) SetLocale(v string) *BankQuestionUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableLocale(v *string) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTimesServed sets the "times_served" field.
+func (_u *BankQuestionUpdate) SetTimesServed(v int) *BankQuestionUpdate {
+	_u.mutation.ResetTimesServed()
+	_u.mutation.SetTimesServed(v)
+	return _u
+}
+
+// SetNillableTimesServed sets the "times_served" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableTimesServed(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetTimesServed(*v)
+	}
+	return _u
+}
+
+// AddTimesServed adds value to the "times_served" field.
+func (_u *BankQuestionUpdate) AddTimesServed(v int) *BankQuestionUpdate {
+	_u.mutation.AddTimesServed(v)
+	return _u
+}
+
+// SetTimesAnswered sets the "times_answered" field.
+func (_u *BankQuestionUpdate) SetTimesAnswered(v int) *BankQuestionUpdate {
+	_u.mutation.ResetTimesAnswered()
+	_u.mutation.SetTimesAnswered(v)
+	return _u
+}
+
+// SetNillableTimesAnswered sets the "times_answered" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableTimesAnswered(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetTimesAnswered(*v)
+	}
+	return _u
+}
+
+// AddTimesAnswered adds value to the "times_answered" field.
+func (_u *BankQuestionUpdate) AddTimesAnswered(v int) *BankQuestionUpdate {
+	_u.mutation.AddTimesAnswered(v)
+	return _u
+}
+
+// SetTimesCorrect sets the "times_correct" field.
+func (_u *BankQuestionUpdate) SetTimesCorrect(v int) *BankQuestionUpdate {
+	_u.mutation.ResetTimesCorrect()
+	_u.mutation.SetTimesCorrect(v)
+	return _u
+}
+
+// SetNillableTimesCorrect sets the "times_correct" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableTimesCorrect(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetTimesCorrect(*v)
+	}
+	return _u
+}
+
+// AddTimesCorrect adds value to the "times_correct" field.
+func (_u *BankQuestionUpdate) AddTimesCorrect(v int) *BankQuestionUpdate {
+	_u.mutation.AddTimesCorrect(v)
+	return _u
+}
+
+// SetReportedBy sets the "reported_by" field.
+func (_u *BankQuestionUpdate) SetReportedBy(v []string) *BankQuestionUpdate {
+	_u.mutation.SetReportedBy(v)
+	return _u
+}
+
+// AppendReportedBy appends value to the "reported_by" field.
+func (_u *BankQuestionUpdate) AppendReportedBy(v []string) *BankQuestionUpdate {
+	_u.mutation.AppendReportedBy(v)
+	return _u
+}
+
+// ClearReportedBy clears the value of the "reported_by" field.
+func (_u *BankQuestionUpdate) ClearReportedBy() *BankQuestionUpdate {
+	_u.mutation.ClearReportedBy()
+	return _u
+}
+
+// SetReports sets the "reports" field.
+func (_u *BankQuestionUpdate) SetReports(v int) *BankQuestionUpdate {
+	_u.mutation.ResetReports()
+	_u.mutation.SetReports(v)
+	return _u
+}
+
+// SetNillableReports sets the "reports" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableReports(v *int) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetReports(*v)
+	}
+	return _u
+}
+
+// AddReports adds value to the "reports" field.
+func (_u *BankQuestionUpdate) AddReports(v int) *BankQuestionUpdate {
+	_u.mutation.AddReports(v)
+	return _u
+}
+
+// SetRetired sets the "retired" field.
+func (_u *BankQuestionUpdate) SetRetired(v bool) *BankQuestionUpdate {
+	_u.mutation.SetRetired(v)
+	return _u
+}
+
+// SetNillableRetired sets the "retired" field if the given value is not nil.
+func (_u *BankQuestionUpdate) SetNillableRetired(v *bool) *BankQuestionUpdate {
+	if v != nil {
+		_u.SetRetired(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BankQuestionMutation object of the builder.
+func (_u *BankQuestionUpdate) Mutation() *BankQuestionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankQuestionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankQuestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankQuestionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankQuestionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankQuestionUpdate) check() error {
+	if v, ok := _u.mutation.SkillID(); ok {
+		if err := bankquestion.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.skill_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := bankquestion.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := bankquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankQuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankquestion.Table, bankquestion.Columns, sqlgraph.NewFieldSpec(bankquestion.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SkillID(); ok {
+		_spec.SetField(bankquestion.FieldSkillID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(bankquestion.FieldTier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Difficulty(); ok {
+		_spec.SetField(bankquestion.FieldDifficulty, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDifficulty(); ok {
+		_spec.AddField(bankquestion.FieldDifficulty, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(bankquestion.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(bankquestion.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnswerType(); ok {
+		_spec.SetField(bankquestion.FieldAnswerType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankquestion.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(bankquestion.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bankquestion.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(bankquestion.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Diagram(); ok {
+		_spec.SetField(bankquestion.FieldDiagram, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(bankquestion.FieldHint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Explanation(); ok {
+		_spec.SetField(bankquestion.FieldExplanation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bankquestion.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(bankquestion.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(bankquestion.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(bankquestion.FieldLlmOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(bankquestion.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimesServed(); ok {
+		_spec.SetField(bankquestion.FieldTimesServed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesServed(); ok {
+		_spec.AddField(bankquestion.FieldTimesServed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimesAnswered(); ok {
+		_spec.SetField(bankquestion.FieldTimesAnswered, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesAnswered(); ok {
+		_spec.AddField(bankquestion.FieldTimesAnswered, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimesCorrect(); ok {
+		_spec.SetField(bankquestion.FieldTimesCorrect, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesCorrect(); ok {
+		_spec.AddField(bankquestion.FieldTimesCorrect, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReportedBy(); ok {
+		_spec.SetField(bankquestion.FieldReportedBy, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReportedBy(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bankquestion.FieldReportedBy, value)
+		})
+	}
+	if _u.mutation.ReportedByCleared() {
+		_spec.ClearField(bankquestion.FieldReportedBy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Reports(); ok {
+		_spec.SetField(bankquestion.FieldReports, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReports(); ok {
+		_spec.AddField(bankquestion.FieldReports, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Retired(); ok {
+		_spec.SetField(bankquestion.FieldRetired, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankQuestionUpdateOne is the builder for updating a single BankQuestion entity.
+type BankQuestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BankQuestionMutation
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *BankQuestionUpdateOne) SetSkillID(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableSkillID(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *BankQuestionUpdateOne) SetTier(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableTier(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_u *BankQuestionUpdateOne) SetDifficulty(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetDifficulty()
+	_u.mutation.SetDifficulty(v)
+	return _u
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableDifficulty(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetDifficulty(*v)
+	}
+	return _u
+}
+
+// AddDifficulty adds value to the "difficulty" field.
+func (_u *BankQuestionUpdateOne) AddDifficulty(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddDifficulty(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *BankQuestionUpdateOne) SetText(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableText(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *BankQuestionUpdateOne) SetAnswer(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableAnswer(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetAnswerType sets the "answer_type" field.
+func (_u *BankQuestionUpdateOne) SetAnswerType(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetAnswerType(v)
+	return _u
+}
+
+// SetNillableAnswerType sets the "answer_type" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableAnswerType(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetAnswerType(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankQuestionUpdateOne) SetFormat(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableFormat(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *BankQuestionUpdateOne) SetChoices(v []string) *BankQuestionUpdateOne {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *BankQuestionUpdateOne) AppendChoices(v []string) *BankQuestionUpdateOne {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *BankQuestionUpdateOne) ClearChoices() *BankQuestionUpdateOne {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetDiagram sets the "diagram" field.
+func (_u *BankQuestionUpdateOne) SetDiagram(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetDiagram(v)
+	return _u
+}
+
+// SetNillableDiagram sets the "diagram" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableDiagram(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetDiagram(*v)
+	}
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *BankQuestionUpdateOne) SetHint(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableHint(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// SetExplanation sets the "explanation" field.
+func (_u *BankQuestionUpdateOne) SetExplanation(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetExplanation(v)
+	return _u
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableExplanation(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetExplanation(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BankQuestionUpdateOne) SetSource(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableSource(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *BankQuestionUpdateOne) SetLlmRequestID(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableLlmRequestID(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *BankQuestionUpdateOne) AddLlmRequestID(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *BankQuestionUpdateOne) SetLlmOwner(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableLlmOwner(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *BankQuestionUpdateOne) SetLocale(v string) *BankQuestionUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableLocale(v *string) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTimesServed sets the "times_served" field.
+func (_u *BankQuestionUpdateOne) SetTimesServed(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetTimesServed()
+	_u.mutation.SetTimesServed(v)
+	return _u
+}
+
+// SetNillableTimesServed sets the "times_served" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableTimesServed(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetTimesServed(*v)
+	}
+	return _u
+}
+
+// AddTimesServed adds value to the "times_served" field.
+func (_u *BankQuestionUpdateOne) AddTimesServed(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddTimesServed(v)
+	return _u
+}
+
+// SetTimesAnswered sets the "times_answered" field.
+func (_u *BankQuestionUpdateOne) SetTimesAnswered(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetTimesAnswered()
+	_u.mutation.SetTimesAnswered(v)
+	return _u
+}
+
+// SetNillableTimesAnswered sets the "times_answered" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableTimesAnswered(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetTimesAnswered(*v)
+	}
+	return _u
+}
+
+// AddTimesAnswered adds value to the "times_answered" field.
+func (_u *BankQuestionUpdateOne) AddTimesAnswered(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddTimesAnswered(v)
+	return _u
+}
+
+// SetTimesCorrect sets the "times_correct" field.
+func (_u *BankQuestionUpdateOne) SetTimesCorrect(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetTimesCorrect()
+	_u.mutation.SetTimesCorrect(v)
+	return _u
+}
+
+// SetNillableTimesCorrect sets the "times_correct" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableTimesCorrect(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetTimesCorrect(*v)
+	}
+	return _u
+}
+
+// AddTimesCorrect adds value to the "times_correct" field.
+func (_u *BankQuestionUpdateOne) AddTimesCorrect(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddTimesCorrect(v)
+	return _u
+}
+
+// SetReportedBy sets the "reported_by" field.
+func (_u *BankQuestionUpdateOne) SetReportedBy(v []string) *BankQuestionUpdateOne {
+	_u.mutation.SetReportedBy(v)
+	return _u
+}
+
+// AppendReportedBy appends value to the "reported_by" field.
+func (_u *BankQuestionUpdateOne) AppendReportedBy(v []string) *BankQuestionUpdateOne {
+	_u.mutation.AppendReportedBy(v)
+	return _u
+}
+
+// ClearReportedBy clears the value of the "reported_by" field.
+func (_u *BankQuestionUpdateOne) ClearReportedBy() *BankQuestionUpdateOne {
+	_u.mutation.ClearReportedBy()
+	return _u
+}
+
+// SetReports sets the "reports" field.
+func (_u *BankQuestionUpdateOne) SetReports(v int) *BankQuestionUpdateOne {
+	_u.mutation.ResetReports()
+	_u.mutation.SetReports(v)
+	return _u
+}
+
+// SetNillableReports sets the "reports" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableReports(v *int) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetReports(*v)
+	}
+	return _u
+}
+
+// AddReports adds value to the "reports" field.
+func (_u *BankQuestionUpdateOne) AddReports(v int) *BankQuestionUpdateOne {
+	_u.mutation.AddReports(v)
+	return _u
+}
+
+// SetRetired sets the "retired" field.
+func (_u *BankQuestionUpdateOne) SetRetired(v bool) *BankQuestionUpdateOne {
+	_u.mutation.SetRetired(v)
+	return _u
+}
+
+// SetNillableRetired sets the "retired" field if the given value is not nil.
+func (_u *BankQuestionUpdateOne) SetNillableRetired(v *bool) *BankQuestionUpdateOne {
+	if v != nil {
+		_u.SetRetired(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BankQuestionMutation object of the builder.
+func (_u *BankQuestionUpdateOne) Mutation() *BankQuestionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BankQuestionUpdate builder.
+func (_u *BankQuestionUpdateOne) Where(ps ...predicate.BankQuestion) *BankQuestionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankQuestionUpdateOne) Select(field string, fields ...string) *BankQuestionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankQuestion entity.
+func (_u *BankQuestionUpdateOne) Save(ctx context.Context) (*BankQuestion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankQuestionUpdateOne) SaveX(ctx context.Context) *BankQuestion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankQuestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankQuestionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankQuestionUpdateOne) check() error {
+	if v, ok := _u.mutation.SkillID(); ok {
+		if err := bankquestion.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.skill_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := bankquestion.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := bankquestion.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "BankQuestion.text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankQuestionUpdateOne) sqlSave(ctx context.Context) (_node *BankQuestion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankquestion.Table, bankquestion.Columns, sqlgraph.NewFieldSpec(bankquestion.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankQuestion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankquestion.FieldID)
+		for _, f := range fields {
+			if !bankquestion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankquestion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SkillID(); ok {
+		_spec.SetField(bankquestion.FieldSkillID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(bankquestion.FieldTier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Difficulty(); ok {
+		_spec.SetField(bankquestion.FieldDifficulty, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDifficulty(); ok {
+		_spec.AddField(bankquestion.FieldDifficulty, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(bankquestion.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(bankquestion.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AnswerType(); ok {
+		_spec.SetField(bankquestion.FieldAnswerType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankquestion.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(bankquestion.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bankquestion.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(bankquestion.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Diagram(); ok {
+		_spec.SetField(bankquestion.FieldDiagram, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(bankquestion.FieldHint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Explanation(); ok {
+		_spec.SetField(bankquestion.FieldExplanation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bankquestion.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(bankquestion.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(bankquestion.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(bankquestion.FieldLlmOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(bankquestion.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimesServed(); ok {
+		_spec.SetField(bankquestion.FieldTimesServed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesServed(); ok {
+		_spec.AddField(bankquestion.FieldTimesServed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimesAnswered(); ok {
+		_spec.SetField(bankquestion.FieldTimesAnswered, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesAnswered(); ok {
+		_spec.AddField(bankquestion.FieldTimesAnswered, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimesCorrect(); ok {
+		_spec.SetField(bankquestion.FieldTimesCorrect, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesCorrect(); ok {
+		_spec.AddField(bankquestion.FieldTimesCorrect, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReportedBy(); ok {
+		_spec.SetField(bankquestion.FieldReportedBy, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReportedBy(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bankquestion.FieldReportedBy, value)
+		})
+	}
+	if _u.mutation.ReportedByCleared() {
+		_spec.ClearField(bankquestion.FieldReportedBy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Reports(); ok {
+		_spec.SetField(bankquestion.FieldReports, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReports(); ok {
+		_spec.AddField(bankquestion.FieldReports, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Retired(); ok {
+		_spec.SetField(bankquestion.FieldRetired, field.TypeBool, value)
+	}
+	_node = &BankQuestion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
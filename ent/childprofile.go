@@ -23,8 +23,12 @@ type ChildProfile struct {
 	FamilySpaceID string `json:"family_space_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
-	// School grade, 2-5
+	// School grade, 0 (kindergarten) to 6
 	Grade int `json:"grade,omitempty"`
+	// Curriculum standard whose skill codes the learner sees (skillgraph.Standard)
+	Standard string `json:"standard,omitempty"`
+	// Language for the learner's questions and UI (i18n.Locale)
+	Locale string `json:"locale,omitempty"`
 	// bcrypt hash of the profile PIN, empty when no PIN is set
 	PinHash string `json:"-"`
 	// Archived holds the value of the "archived" field.
@@ -43,7 +47,7 @@ func (*ChildProfile) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case childprofile.FieldID, childprofile.FieldGrade:
 			values[i] = new(sql.NullInt64)
-		case childprofile.FieldUID, childprofile.FieldFamilySpaceID, childprofile.FieldName, childprofile.FieldPinHash:
+		case childprofile.FieldUID, childprofile.FieldFamilySpaceID, childprofile.FieldName, childprofile.FieldStandard, childprofile.FieldLocale, childprofile.FieldPinHash:
 			values[i] = new(sql.NullString)
 		case childprofile.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -92,6 +96,18 @@ func (_m *ChildProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Grade = int(value.Int64)
 			}
+		case childprofile.FieldStandard:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field standard", values[i])
+			} else if value.Valid {
+				_m.Standard = value.String
+			}
+		case childprofile.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
 		case childprofile.FieldPinHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field pin_hash", values[i])
@@ -158,6 +174,12 @@ func (_m *ChildProfile) String() string {
 	builder.WriteString("grade=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Grade))
 	builder.WriteString(", ")
+	builder.WriteString("standard=")
+	builder.WriteString(_m.Standard)
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
 	builder.WriteString("pin_hash=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("archived=")
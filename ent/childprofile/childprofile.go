@@ -21,6 +21,10 @@ const (
 	FieldName = "name"
 	// FieldGrade holds the string denoting the grade field in the database.
 	FieldGrade = "grade"
+	// FieldStandard holds the string denoting the standard field in the database.
+	FieldStandard = "standard"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
 	// FieldPinHash holds the string denoting the pin_hash field in the database.
 	FieldPinHash = "pin_hash"
 	// FieldArchived holds the string denoting the archived field in the database.
@@ -38,6 +42,8 @@ var Columns = []string{
 	FieldFamilySpaceID,
 	FieldName,
 	FieldGrade,
+	FieldStandard,
+	FieldLocale,
 	FieldPinHash,
 	FieldArchived,
 	FieldCreatedAt,
@@ -54,6 +60,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultStandard holds the default value on creation for the "standard" field.
+	DefaultStandard string
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
 	// DefaultPinHash holds the default value on creation for the "pin_hash" field.
 	DefaultPinHash string
 	// DefaultArchived holds the default value on creation for the "archived" field.
@@ -90,6 +100,16 @@ func ByGrade(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGrade, opts...).ToFunc()
 }
 
+// ByStandard orders the results by the standard field.
+func ByStandard(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStandard, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
 // ByPinHash orders the results by the pin_hash field.
 func ByPinHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPinHash, opts...).ToFunc()
@@ -74,6 +74,16 @@ func Grade(v int) predicate.ChildProfile {
 	return predicate.ChildProfile(sql.FieldEQ(FieldGrade, v))
 }
 
+// Standard applies equality check predicate on the "standard" field. It's identical to StandardEQ.
+func Standard(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEQ(FieldStandard, v))
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEQ(FieldLocale, v))
+}
+
 // PinHash applies equality check predicate on the "pin_hash" field. It's identical to PinHashEQ.
 func PinHash(v string) predicate.ChildProfile {
 	return predicate.ChildProfile(sql.FieldEQ(FieldPinHash, v))
@@ -324,6 +334,136 @@ func GradeLTE(v int) predicate.ChildProfile {
 	return predicate.ChildProfile(sql.FieldLTE(FieldGrade, v))
 }
 
+// StandardEQ applies the EQ predicate on the "standard" field.
+func StandardEQ(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEQ(FieldStandard, v))
+}
+
+// StandardNEQ applies the NEQ predicate on the "standard" field.
+func StandardNEQ(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldNEQ(FieldStandard, v))
+}
+
+// StandardIn applies the In predicate on the "standard" field.
+func StandardIn(vs ...string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldIn(FieldStandard, vs...))
+}
+
+// StandardNotIn applies the NotIn predicate on the "standard" field.
+func StandardNotIn(vs ...string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldNotIn(FieldStandard, vs...))
+}
+
+// StandardGT applies the GT predicate on the "standard" field.
+func StandardGT(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldGT(FieldStandard, v))
+}
+
+// StandardGTE applies the GTE predicate on the "standard" field.
+func StandardGTE(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldGTE(FieldStandard, v))
+}
+
+// StandardLT applies the LT predicate on the "standard" field.
+func StandardLT(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldLT(FieldStandard, v))
+}
+
+// StandardLTE applies the LTE predicate on the "standard" field.
+func StandardLTE(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldLTE(FieldStandard, v))
+}
+
+// StandardContains applies the Contains predicate on the "standard" field.
+func StandardContains(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldContains(FieldStandard, v))
+}
+
+// StandardHasPrefix applies the HasPrefix predicate on the "standard" field.
+func StandardHasPrefix(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldHasPrefix(FieldStandard, v))
+}
+
+// StandardHasSuffix applies the HasSuffix predicate on the "standard" field.
+func StandardHasSuffix(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldHasSuffix(FieldStandard, v))
+}
+
+// StandardEqualFold applies the EqualFold predicate on the "standard" field.
+func StandardEqualFold(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEqualFold(FieldStandard, v))
+}
+
+// StandardContainsFold applies the ContainsFold predicate on the "standard" field.
+func StandardContainsFold(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldContainsFold(FieldStandard, v))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.ChildProfile {
+	return predicate.ChildProfile(sql.FieldContainsFold(FieldLocale, v))
+}
+
 // PinHashEQ applies the EQ predicate on the "pin_hash" field.
 func PinHashEQ(v string) predicate.ChildProfile {
 	return predicate.ChildProfile(sql.FieldEQ(FieldPinHash, v))
@@ -44,6 +44,34 @@ func (_c *ChildProfileCreate) SetGrade(v int) *ChildProfileCreate {
 	return _c
 }
 
+// SetStandard sets the "standard" field.
+func (_c *ChildProfileCreate) SetStandard(v string) *ChildProfileCreate {
+	_c.mutation.SetStandard(v)
+	return _c
+}
+
+// SetNillableStandard sets the "standard" field if the given value is not nil.
+func (_c *ChildProfileCreate) SetNillableStandard(v *string) *ChildProfileCreate {
+	if v != nil {
+		_c.SetStandard(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *ChildProfileCreate) SetLocale(v string) *ChildProfileCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *ChildProfileCreate) SetNillableLocale(v *string) *ChildProfileCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
 // SetPinHash sets the "pin_hash" field.
 func (_c *ChildProfileCreate) SetPinHash(v string) *ChildProfileCreate {
 	_c.mutation.SetPinHash(v)
@@ -121,6 +149,14 @@ func (_c *ChildProfileCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ChildProfileCreate) defaults() {
+	if _, ok := _c.mutation.Standard(); !ok {
+		v := childprofile.DefaultStandard
+		_c.mutation.SetStandard(v)
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := childprofile.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
 	if _, ok := _c.mutation.PinHash(); !ok {
 		v := childprofile.DefaultPinHash
 		_c.mutation.SetPinHash(v)
@@ -149,6 +185,12 @@ func (_c *ChildProfileCreate) check() error {
 	if _, ok := _c.mutation.Grade(); !ok {
 		return &ValidationError{Name: "grade", err: errors.New(`ent: missing required field "ChildProfile.grade"`)}
 	}
+	if _, ok := _c.mutation.Standard(); !ok {
+		return &ValidationError{Name: "standard", err: errors.New(`ent: missing required field "ChildProfile.standard"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "ChildProfile.locale"`)}
+	}
 	if _, ok := _c.mutation.PinHash(); !ok {
 		return &ValidationError{Name: "pin_hash", err: errors.New(`ent: missing required field "ChildProfile.pin_hash"`)}
 	}
@@ -200,6 +242,14 @@ func (_c *ChildProfileCreate) createSpec() (*ChildProfile, *sqlgraph.CreateSpec)
 		_spec.SetField(childprofile.FieldGrade, field.TypeInt, value)
 		_node.Grade = value
 	}
+	if value, ok := _c.mutation.Standard(); ok {
+		_spec.SetField(childprofile.FieldStandard, field.TypeString, value)
+		_node.Standard = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(childprofile.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
 	if value, ok := _c.mutation.PinHash(); ok {
 		_spec.SetField(childprofile.FieldPinHash, field.TypeString, value)
 		_node.PinHash = value
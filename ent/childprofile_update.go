@@ -62,6 +62,34 @@ func (_u *ChildProfileUpdate) AddGrade(v int) *ChildProfileUpdate {
 	return _u
 }
 
+// SetStandard sets the "standard" field.
+func (_u *ChildProfileUpdate) SetStandard(v string) *ChildProfileUpdate {
+	_u.mutation.SetStandard(v)
+	return _u
+}
+
+// SetNillableStandard sets the "standard" field if the given value is not nil.
+func (_u *ChildProfileUpdate) SetNillableStandard(v *string) *ChildProfileUpdate {
+	if v != nil {
+		_u.SetStandard(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *ChildProfileUpdate) SetLocale(v string) *ChildProfileUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *ChildProfileUpdate) SetNillableLocale(v *string) *ChildProfileUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
 // SetPinHash sets the "pin_hash" field.
 func (_u *ChildProfileUpdate) SetPinHash(v string) *ChildProfileUpdate {
 	_u.mutation.SetPinHash(v)
@@ -140,6 +168,12 @@ func (_u *ChildProfileUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedGrade(); ok {
 		_spec.AddField(childprofile.FieldGrade, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Standard(); ok {
+		_spec.SetField(childprofile.FieldStandard, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(childprofile.FieldLocale, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PinHash(); ok {
 		_spec.SetField(childprofile.FieldPinHash, field.TypeString, value)
 	}
@@ -201,6 +235,34 @@ func (_u *ChildProfileUpdateOne) AddGrade(v int) *ChildProfileUpdateOne {
 	return _u
 }
 
+// SetStandard sets the "standard" field.
+func (_u *ChildProfileUpdateOne) SetStandard(v string) *ChildProfileUpdateOne {
+	_u.mutation.SetStandard(v)
+	return _u
+}
+
+// SetNillableStandard sets the "standard" field if the given value is not nil.
+func (_u *ChildProfileUpdateOne) SetNillableStandard(v *string) *ChildProfileUpdateOne {
+	if v != nil {
+		_u.SetStandard(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *ChildProfileUpdateOne) SetLocale(v string) *ChildProfileUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *ChildProfileUpdateOne) SetNillableLocale(v *string) *ChildProfileUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
 // SetPinHash sets the "pin_hash" field.
 func (_u *ChildProfileUpdateOne) SetPinHash(v string) *ChildProfileUpdateOne {
 	_u.mutation.SetPinHash(v)
@@ -309,6 +371,12 @@ func (_u *ChildProfileUpdateOne) sqlSave(ctx context.Context) (_node *ChildProfi
 	if value, ok := _u.mutation.AddedGrade(); ok {
 		_spec.AddField(childprofile.FieldGrade, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Standard(); ok {
+		_spec.SetField(childprofile.FieldStandard, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(childprofile.FieldLocale, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PinHash(); ok {
 		_spec.SetField(childprofile.FieldPinHash, field.TypeString, value)
 	}
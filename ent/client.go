@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/abhisek/mathiz/ent/account"
 	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
 	"github.com/abhisek/mathiz/ent/billingstate"
 	"github.com/abhisek/mathiz/ent/childprofile"
 	"github.com/abhisek/mathiz/ent/creditentry"
@@ -32,6 +33,7 @@ import (
 	"github.com/abhisek/mathiz/ent/masteryevent"
 	"github.com/abhisek/mathiz/ent/parentinvite"
 	"github.com/abhisek/mathiz/ent/quest"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
 	"github.com/abhisek/mathiz/ent/questprogress"
 	"github.com/abhisek/mathiz/ent/questquestion"
 	"github.com/abhisek/mathiz/ent/sessionevent"
@@ -47,6 +49,8 @@ type Client struct {
 	Account *AccountClient
 	// AnswerEvent is the client for interacting with the AnswerEvent builders.
 	AnswerEvent *AnswerEventClient
+	// BankQuestion is the client for interacting with the BankQuestion builders.
+	BankQuestion *BankQuestionClient
 	// BillingState is the client for interacting with the BillingState builders.
 	BillingState *BillingStateClient
 	// ChildProfile is the client for interacting with the ChildProfile builders.
@@ -83,6 +87,8 @@ type Client struct {
 	QuestProgress *QuestProgressClient
 	// QuestQuestion is the client for interacting with the QuestQuestion builders.
 	QuestQuestion *QuestQuestionClient
+	// QuestionReportEvent is the client for interacting with the QuestionReportEvent builders.
+	QuestionReportEvent *QuestionReportEventClient
 	// SessionEvent is the client for interacting with the SessionEvent builders.
 	SessionEvent *SessionEventClient
 	// Snapshot is the client for interacting with the Snapshot builders.
@@ -100,6 +106,7 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
 	c.AnswerEvent = NewAnswerEventClient(c.config)
+	c.BankQuestion = NewBankQuestionClient(c.config)
 	c.BillingState = NewBillingStateClient(c.config)
 	c.ChildProfile = NewChildProfileClient(c.config)
 	c.CreditEntry = NewCreditEntryClient(c.config)
@@ -118,6 +125,7 @@ func (c *Client) init() {
 	c.Quest = NewQuestClient(c.config)
 	c.QuestProgress = NewQuestProgressClient(c.config)
 	c.QuestQuestion = NewQuestQuestionClient(c.config)
+	c.QuestionReportEvent = NewQuestionReportEventClient(c.config)
 	c.SessionEvent = NewSessionEventClient(c.config)
 	c.Snapshot = NewSnapshotClient(c.config)
 }
@@ -214,6 +222,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:              cfg,
 		Account:             NewAccountClient(cfg),
 		AnswerEvent:         NewAnswerEventClient(cfg),
+		BankQuestion:        NewBankQuestionClient(cfg),
 		BillingState:        NewBillingStateClient(cfg),
 		ChildProfile:        NewChildProfileClient(cfg),
 		CreditEntry:         NewCreditEntryClient(cfg),
@@ -232,6 +241,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Quest:               NewQuestClient(cfg),
 		QuestProgress:       NewQuestProgressClient(cfg),
 		QuestQuestion:       NewQuestQuestionClient(cfg),
+		QuestionReportEvent: NewQuestionReportEventClient(cfg),
 		SessionEvent:        NewSessionEventClient(cfg),
 		Snapshot:            NewSnapshotClient(cfg),
 	}, nil
@@ -255,6 +265,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:              cfg,
 		Account:             NewAccountClient(cfg),
 		AnswerEvent:         NewAnswerEventClient(cfg),
+		BankQuestion:        NewBankQuestionClient(cfg),
 		BillingState:        NewBillingStateClient(cfg),
 		ChildProfile:        NewChildProfileClient(cfg),
 		CreditEntry:         NewCreditEntryClient(cfg),
@@ -273,6 +284,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Quest:               NewQuestClient(cfg),
 		QuestProgress:       NewQuestProgressClient(cfg),
 		QuestQuestion:       NewQuestQuestionClient(cfg),
+		QuestionReportEvent: NewQuestionReportEventClient(cfg),
 		SessionEvent:        NewSessionEventClient(cfg),
 		Snapshot:            NewSnapshotClient(cfg),
 	}, nil
@@ -304,11 +316,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Account, c.AnswerEvent, c.BillingState, c.ChildProfile, c.CreditEntry,
-		c.DeviceToken, c.DiagnosisEvent, c.FamilyMember, c.FamilySpace, c.GemEvent,
-		c.HintEvent, c.Invite, c.LLMRequestEvent, c.LearnerProfileEvent, c.LessonEvent,
-		c.MasteryEvent, c.ParentInvite, c.Quest, c.QuestProgress, c.QuestQuestion,
-		c.SessionEvent, c.Snapshot,
+		c.Account, c.AnswerEvent, c.BankQuestion, c.BillingState, c.ChildProfile,
+		c.CreditEntry, c.DeviceToken, c.DiagnosisEvent, c.FamilyMember, c.FamilySpace,
+		c.GemEvent, c.HintEvent, c.Invite, c.LLMRequestEvent, c.LearnerProfileEvent,
+		c.LessonEvent, c.MasteryEvent, c.ParentInvite, c.Quest, c.QuestProgress,
+		c.QuestQuestion, c.QuestionReportEvent, c.SessionEvent, c.Snapshot,
 	} {
 		n.Use(hooks...)
 	}
@@ -318,11 +330,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Account, c.AnswerEvent, c.BillingState, c.ChildProfile, c.CreditEntry,
-		c.DeviceToken, c.DiagnosisEvent, c.FamilyMember, c.FamilySpace, c.GemEvent,
-		c.HintEvent, c.Invite, c.LLMRequestEvent, c.LearnerProfileEvent, c.LessonEvent,
-		c.MasteryEvent, c.ParentInvite, c.Quest, c.QuestProgress, c.QuestQuestion,
-		c.SessionEvent, c.Snapshot,
+		c.Account, c.AnswerEvent, c.BankQuestion, c.BillingState, c.ChildProfile,
+		c.CreditEntry, c.DeviceToken, c.DiagnosisEvent, c.FamilyMember, c.FamilySpace,
+		c.GemEvent, c.HintEvent, c.Invite, c.LLMRequestEvent, c.LearnerProfileEvent,
+		c.LessonEvent, c.MasteryEvent, c.ParentInvite, c.Quest, c.QuestProgress,
+		c.QuestQuestion, c.QuestionReportEvent, c.SessionEvent, c.Snapshot,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -335,6 +347,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Account.mutate(ctx, m)
 	case *AnswerEventMutation:
 		return c.AnswerEvent.mutate(ctx, m)
+	case *BankQuestionMutation:
+		return c.BankQuestion.mutate(ctx, m)
 	case *BillingStateMutation:
 		return c.BillingState.mutate(ctx, m)
 	case *ChildProfileMutation:
@@ -371,6 +385,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.QuestProgress.mutate(ctx, m)
 	case *QuestQuestionMutation:
 		return c.QuestQuestion.mutate(ctx, m)
+	case *QuestionReportEventMutation:
+		return c.QuestionReportEvent.mutate(ctx, m)
 	case *SessionEventMutation:
 		return c.SessionEvent.mutate(ctx, m)
 	case *SnapshotMutation:
@@ -646,6 +662,139 @@ func (c *AnswerEventClient) mutate(ctx context.Context, m *AnswerEventMutation)
 	}
 }
 
+// BankQuestionClient is a client for the BankQuestion schema.
+type BankQuestionClient struct {
+	config
+}
+
+// NewBankQuestionClient returns a client for the BankQuestion from the given config.
+func NewBankQuestionClient(c config) *BankQuestionClient {
+	return &BankQuestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bankquestion.Hooks(f(g(h())))`.
+func (c *BankQuestionClient) Use(hooks ...Hook) {
+	c.hooks.BankQuestion = append(c.hooks.BankQuestion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bankquestion.Intercept(f(g(h())))`.
+func (c *BankQuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BankQuestion = append(c.inters.BankQuestion, interceptors...)
+}
+
+// Create returns a builder for creating a BankQuestion entity.
+func (c *BankQuestionClient) Create() *BankQuestionCreate {
+	mutation := newBankQuestionMutation(c.config, OpCreate)
+	return &BankQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BankQuestion entities.
+func (c *BankQuestionClient) CreateBulk(builders ...*BankQuestionCreate) *BankQuestionCreateBulk {
+	return &BankQuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BankQuestionClient) MapCreateBulk(slice any, setFunc func(*BankQuestionCreate, int)) *BankQuestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BankQuestionCreateBulk{err: fmt.Errorf("calling to BankQuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BankQuestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BankQuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BankQuestion.
+func (c *BankQuestionClient) Update() *BankQuestionUpdate {
+	mutation := newBankQuestionMutation(c.config, OpUpdate)
+	return &BankQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BankQuestionClient) UpdateOne(_m *BankQuestion) *BankQuestionUpdateOne {
+	mutation := newBankQuestionMutation(c.config, OpUpdateOne, withBankQuestion(_m))
+	return &BankQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BankQuestionClient) UpdateOneID(id int) *BankQuestionUpdateOne {
+	mutation := newBankQuestionMutation(c.config, OpUpdateOne, withBankQuestionID(id))
+	return &BankQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BankQuestion.
+func (c *BankQuestionClient) Delete() *BankQuestionDelete {
+	mutation := newBankQuestionMutation(c.config, OpDelete)
+	return &BankQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BankQuestionClient) DeleteOne(_m *BankQuestion) *BankQuestionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BankQuestionClient) DeleteOneID(id int) *BankQuestionDeleteOne {
+	builder := c.Delete().Where(bankquestion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BankQuestionDeleteOne{builder}
+}
+
+// Query returns a query builder for BankQuestion.
+func (c *BankQuestionClient) Query() *BankQuestionQuery {
+	return &BankQuestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBankQuestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BankQuestion entity by its id.
+func (c *BankQuestionClient) Get(ctx context.Context, id int) (*BankQuestion, error) {
+	return c.Query().Where(bankquestion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BankQuestionClient) GetX(ctx context.Context, id int) *BankQuestion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BankQuestionClient) Hooks() []Hook {
+	return c.hooks.BankQuestion
+}
+
+// Interceptors returns the client interceptors.
+func (c *BankQuestionClient) Interceptors() []Interceptor {
+	return c.inters.BankQuestion
+}
+
+func (c *BankQuestionClient) mutate(ctx context.Context, m *BankQuestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BankQuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BankQuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BankQuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BankQuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BankQuestion mutation op: %q", m.Op())
+	}
+}
+
 // BillingStateClient is a client for the BillingState schema.
 type BillingStateClient struct {
 	config
@@ -3040,6 +3189,139 @@ func (c *QuestQuestionClient) mutate(ctx context.Context, m *QuestQuestionMutati
 	}
 }
 
+// QuestionReportEventClient is a client for the QuestionReportEvent schema.
+type QuestionReportEventClient struct {
+	config
+}
+
+// NewQuestionReportEventClient returns a client for the QuestionReportEvent from the given config.
+func NewQuestionReportEventClient(c config) *QuestionReportEventClient {
+	return &QuestionReportEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questionreportevent.Hooks(f(g(h())))`.
+func (c *QuestionReportEventClient) Use(hooks ...Hook) {
+	c.hooks.QuestionReportEvent = append(c.hooks.QuestionReportEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questionreportevent.Intercept(f(g(h())))`.
+func (c *QuestionReportEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionReportEvent = append(c.inters.QuestionReportEvent, interceptors...)
+}
+
+// Create returns a builder for creating a QuestionReportEvent entity.
+func (c *QuestionReportEventClient) Create() *QuestionReportEventCreate {
+	mutation := newQuestionReportEventMutation(c.config, OpCreate)
+	return &QuestionReportEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuestionReportEvent entities.
+func (c *QuestionReportEventClient) CreateBulk(builders ...*QuestionReportEventCreate) *QuestionReportEventCreateBulk {
+	return &QuestionReportEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionReportEventClient) MapCreateBulk(slice any, setFunc func(*QuestionReportEventCreate, int)) *QuestionReportEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionReportEventCreateBulk{err: fmt.Errorf("calling to QuestionReportEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionReportEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionReportEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuestionReportEvent.
+func (c *QuestionReportEventClient) Update() *QuestionReportEventUpdate {
+	mutation := newQuestionReportEventMutation(c.config, OpUpdate)
+	return &QuestionReportEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionReportEventClient) UpdateOne(_m *QuestionReportEvent) *QuestionReportEventUpdateOne {
+	mutation := newQuestionReportEventMutation(c.config, OpUpdateOne, withQuestionReportEvent(_m))
+	return &QuestionReportEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionReportEventClient) UpdateOneID(id int) *QuestionReportEventUpdateOne {
+	mutation := newQuestionReportEventMutation(c.config, OpUpdateOne, withQuestionReportEventID(id))
+	return &QuestionReportEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuestionReportEvent.
+func (c *QuestionReportEventClient) Delete() *QuestionReportEventDelete {
+	mutation := newQuestionReportEventMutation(c.config, OpDelete)
+	return &QuestionReportEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionReportEventClient) DeleteOne(_m *QuestionReportEvent) *QuestionReportEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionReportEventClient) DeleteOneID(id int) *QuestionReportEventDeleteOne {
+	builder := c.Delete().Where(questionreportevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionReportEventDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestionReportEvent.
+func (c *QuestionReportEventClient) Query() *QuestionReportEventQuery {
+	return &QuestionReportEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionReportEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestionReportEvent entity by its id.
+func (c *QuestionReportEventClient) Get(ctx context.Context, id int) (*QuestionReportEvent, error) {
+	return c.Query().Where(questionreportevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionReportEventClient) GetX(ctx context.Context, id int) *QuestionReportEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionReportEventClient) Hooks() []Hook {
+	return c.hooks.QuestionReportEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionReportEventClient) Interceptors() []Interceptor {
+	return c.inters.QuestionReportEvent
+}
+
+func (c *QuestionReportEventClient) mutate(ctx context.Context, m *QuestionReportEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionReportEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionReportEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionReportEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionReportEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionReportEvent mutation op: %q", m.Op())
+	}
+}
+
 // SessionEventClient is a client for the SessionEvent schema.
 type SessionEventClient struct {
 	config
@@ -3309,15 +3591,17 @@ func (c *SnapshotClient) mutate(ctx context.Context, m *SnapshotMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, AnswerEvent, BillingState, ChildProfile, CreditEntry, DeviceToken,
-		DiagnosisEvent, FamilyMember, FamilySpace, GemEvent, HintEvent, Invite,
-		LLMRequestEvent, LearnerProfileEvent, LessonEvent, MasteryEvent, ParentInvite,
-		Quest, QuestProgress, QuestQuestion, SessionEvent, Snapshot []ent.Hook
+		Account, AnswerEvent, BankQuestion, BillingState, ChildProfile, CreditEntry,
+		DeviceToken, DiagnosisEvent, FamilyMember, FamilySpace, GemEvent, HintEvent,
+		Invite, LLMRequestEvent, LearnerProfileEvent, LessonEvent, MasteryEvent,
+		ParentInvite, Quest, QuestProgress, QuestQuestion, QuestionReportEvent,
+		SessionEvent, Snapshot []ent.Hook
 	}
 	inters struct {
-		Account, AnswerEvent, BillingState, ChildProfile, CreditEntry, DeviceToken,
-		DiagnosisEvent, FamilyMember, FamilySpace, GemEvent, HintEvent, Invite,
-		LLMRequestEvent, LearnerProfileEvent, LessonEvent, MasteryEvent, ParentInvite,
-		Quest, QuestProgress, QuestQuestion, SessionEvent, Snapshot []ent.Interceptor
+		Account, AnswerEvent, BankQuestion, BillingState, ChildProfile, CreditEntry,
+		DeviceToken, DiagnosisEvent, FamilyMember, FamilySpace, GemEvent, HintEvent,
+		Invite, LLMRequestEvent, LearnerProfileEvent, LessonEvent, MasteryEvent,
+		ParentInvite, Quest, QuestProgress, QuestQuestion, QuestionReportEvent,
+		SessionEvent, Snapshot []ent.Interceptor
 	}
 )
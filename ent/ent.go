@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/abhisek/mathiz/ent/account"
 	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
 	"github.com/abhisek/mathiz/ent/billingstate"
 	"github.com/abhisek/mathiz/ent/childprofile"
 	"github.com/abhisek/mathiz/ent/creditentry"
@@ -30,6 +31,7 @@ import (
 	"github.com/abhisek/mathiz/ent/masteryevent"
 	"github.com/abhisek/mathiz/ent/parentinvite"
 	"github.com/abhisek/mathiz/ent/quest"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
 	"github.com/abhisek/mathiz/ent/questprogress"
 	"github.com/abhisek/mathiz/ent/questquestion"
 	"github.com/abhisek/mathiz/ent/sessionevent"
@@ -96,6 +98,7 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			account.Table:             account.ValidColumn,
 			answerevent.Table:         answerevent.ValidColumn,
+			bankquestion.Table:        bankquestion.ValidColumn,
 			billingstate.Table:        billingstate.ValidColumn,
 			childprofile.Table:        childprofile.ValidColumn,
 			creditentry.Table:         creditentry.ValidColumn,
@@ -114,6 +117,7 @@ func checkColumn(t, c string) error {
 			quest.Table:               quest.ValidColumn,
 			questprogress.Table:       questprogress.ValidColumn,
 			questquestion.Table:       questquestion.ValidColumn,
+			questionreportevent.Table: questionreportevent.ValidColumn,
 			sessionevent.Table:        sessionevent.ValidColumn,
 			snapshot.Table:            snapshot.ValidColumn,
 		})
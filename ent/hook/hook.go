@@ -33,6 +33,18 @@ func (f AnswerEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnswerEventMutation", m)
 }
 
+// The BankQuestionFunc type is an adapter to allow the use of ordinary
+// function as BankQuestion mutator.
+type BankQuestionFunc func(context.Context, *ent.BankQuestionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankQuestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankQuestionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankQuestionMutation", m)
+}
+
 // The BillingStateFunc type is an adapter to allow the use of ordinary
 // function as BillingState mutator.
 type BillingStateFunc func(context.Context, *ent.BillingStateMutation) (ent.Value, error)
@@ -249,6 +261,18 @@ func (f QuestQuestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestQuestionMutation", m)
 }
 
+// The QuestionReportEventFunc type is an adapter to allow the use of ordinary
+// function as QuestionReportEvent mutator.
+type QuestionReportEventFunc func(context.Context, *ent.QuestionReportEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestionReportEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuestionReportEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionReportEventMutation", m)
+}
+
 // The SessionEventFunc type is an adapter to allow the use of ordinary
 // function as SessionEvent mutator.
 type SessionEventFunc func(context.Context, *ent.SessionEventMutation) (ent.Value, error)
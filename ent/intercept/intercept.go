@@ -10,6 +10,7 @@ import (
 	"github.com/abhisek/mathiz/ent"
 	"github.com/abhisek/mathiz/ent/account"
 	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
 	"github.com/abhisek/mathiz/ent/billingstate"
 	"github.com/abhisek/mathiz/ent/childprofile"
 	"github.com/abhisek/mathiz/ent/creditentry"
@@ -27,6 +28,7 @@ import (
 	"github.com/abhisek/mathiz/ent/parentinvite"
 	"github.com/abhisek/mathiz/ent/predicate"
 	"github.com/abhisek/mathiz/ent/quest"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
 	"github.com/abhisek/mathiz/ent/questprogress"
 	"github.com/abhisek/mathiz/ent/questquestion"
 	"github.com/abhisek/mathiz/ent/sessionevent"
@@ -143,6 +145,33 @@ func (f TraverseAnswerEvent) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.AnswerEventQuery", q)
 }
 
+// The BankQuestionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BankQuestionFunc func(context.Context, *ent.BankQuestionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BankQuestionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BankQuestionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BankQuestionQuery", q)
+}
+
+// The TraverseBankQuestion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBankQuestion func(context.Context, *ent.BankQuestionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBankQuestion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBankQuestion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BankQuestionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BankQuestionQuery", q)
+}
+
 // The BillingStateFunc type is an adapter to allow the use of ordinary function as a Querier.
 type BillingStateFunc func(context.Context, *ent.BillingStateQuery) (ent.Value, error)
 
@@ -629,6 +658,33 @@ func (f TraverseQuestQuestion) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.QuestQuestionQuery", q)
 }
 
+// The QuestionReportEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type QuestionReportEventFunc func(context.Context, *ent.QuestionReportEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f QuestionReportEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.QuestionReportEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.QuestionReportEventQuery", q)
+}
+
+// The TraverseQuestionReportEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseQuestionReportEvent func(context.Context, *ent.QuestionReportEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseQuestionReportEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseQuestionReportEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.QuestionReportEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.QuestionReportEventQuery", q)
+}
+
 // The SessionEventFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SessionEventFunc func(context.Context, *ent.SessionEventQuery) (ent.Value, error)
 
@@ -690,6 +746,8 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.AccountQuery, predicate.Account, account.OrderOption]{typ: ent.TypeAccount, tq: q}, nil
 	case *ent.AnswerEventQuery:
 		return &query[*ent.AnswerEventQuery, predicate.AnswerEvent, answerevent.OrderOption]{typ: ent.TypeAnswerEvent, tq: q}, nil
+	case *ent.BankQuestionQuery:
+		return &query[*ent.BankQuestionQuery, predicate.BankQuestion, bankquestion.OrderOption]{typ: ent.TypeBankQuestion, tq: q}, nil
 	case *ent.BillingStateQuery:
 		return &query[*ent.BillingStateQuery, predicate.BillingState, billingstate.OrderOption]{typ: ent.TypeBillingState, tq: q}, nil
 	case *ent.ChildProfileQuery:
@@ -726,6 +784,8 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.QuestProgressQuery, predicate.QuestProgress, questprogress.OrderOption]{typ: ent.TypeQuestProgress, tq: q}, nil
 	case *ent.QuestQuestionQuery:
 		return &query[*ent.QuestQuestionQuery, predicate.QuestQuestion, questquestion.OrderOption]{typ: ent.TypeQuestQuestion, tq: q}, nil
+	case *ent.QuestionReportEventQuery:
+		return &query[*ent.QuestionReportEventQuery, predicate.QuestionReportEvent, questionreportevent.OrderOption]{typ: ent.TypeQuestionReportEvent, tq: q}, nil
 	case *ent.SessionEventQuery:
 		return &query[*ent.SessionEventQuery, predicate.SessionEvent, sessionevent.OrderOption]{typ: ent.TypeSessionEvent, tq: q}, nil
 	case *ent.SnapshotQuery:
@@ -46,6 +46,9 @@ var (
 		{Name: "correct", Type: field.TypeBool},
 		{Name: "time_ms", Type: field.TypeInt},
 		{Name: "answer_format", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "llm_request_id", Type: field.TypeInt, Default: 0},
+		{Name: "llm_owner", Type: field.TypeString, Default: ""},
 	}
 	// AnswerEventsTable holds the schema information for the "answer_events" table.
 	AnswerEventsTable = &schema.Table{
@@ -85,6 +88,50 @@ var (
 			},
 		},
 	}
+	// BankQuestionsColumns holds the columns for the "bank_questions" table.
+	BankQuestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "skill_id", Type: field.TypeString},
+		{Name: "tier", Type: field.TypeString},
+		{Name: "difficulty", Type: field.TypeInt, Default: 0},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString},
+		{Name: "answer_type", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString},
+		{Name: "choices", Type: field.TypeJSON, Nullable: true},
+		{Name: "diagram", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "hint", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "explanation", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "llm_request_id", Type: field.TypeInt, Default: 0},
+		{Name: "llm_owner", Type: field.TypeString, Default: ""},
+		{Name: "locale", Type: field.TypeString, Default: "en"},
+		{Name: "times_served", Type: field.TypeInt, Default: 0},
+		{Name: "times_answered", Type: field.TypeInt, Default: 0},
+		{Name: "times_correct", Type: field.TypeInt, Default: 0},
+		{Name: "reported_by", Type: field.TypeJSON, Nullable: true},
+		{Name: "reports", Type: field.TypeInt, Default: 0},
+		{Name: "retired", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BankQuestionsTable holds the schema information for the "bank_questions" table.
+	BankQuestionsTable = &schema.Table{
+		Name:       "bank_questions",
+		Columns:    BankQuestionsColumns,
+		PrimaryKey: []*schema.Column{BankQuestionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bankquestion_skill_id_tier_difficulty",
+				Unique:  false,
+				Columns: []*schema.Column{BankQuestionsColumns[1], BankQuestionsColumns[2], BankQuestionsColumns[3]},
+			},
+			{
+				Name:    "bankquestion_skill_id_text",
+				Unique:  true,
+				Columns: []*schema.Column{BankQuestionsColumns[1], BankQuestionsColumns[4]},
+			},
+		},
+	}
 	// BillingStatesColumns holds the columns for the "billing_states" table.
 	BillingStatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -118,6 +165,8 @@ var (
 		{Name: "family_space_id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "grade", Type: field.TypeInt},
+		{Name: "standard", Type: field.TypeString, Default: "ccss"},
+		{Name: "locale", Type: field.TypeString, Default: "en"},
 		{Name: "pin_hash", Type: field.TypeString, Default: ""},
 		{Name: "archived", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
@@ -720,6 +769,59 @@ var (
 			},
 		},
 	}
+	// QuestionReportEventsColumns holds the columns for the "question_report_events" table.
+	QuestionReportEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sequence", Type: field.TypeInt64, Unique: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "owner_id", Type: field.TypeString, Default: ""},
+		{Name: "session_id", Type: field.TypeString},
+		{Name: "skill_id", Type: field.TypeString},
+		{Name: "tier", Type: field.TypeString, Default: ""},
+		{Name: "question_text", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Default: ""},
+		{Name: "format", Type: field.TypeString, Default: ""},
+		{Name: "choices", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "llm_request_id", Type: field.TypeInt, Default: 0},
+		{Name: "llm_owner", Type: field.TypeString, Default: ""},
+		{Name: "learner_answer", Type: field.TypeString, Default: ""},
+		{Name: "reporter", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647, Default: ""},
+	}
+	// QuestionReportEventsTable holds the schema information for the "question_report_events" table.
+	QuestionReportEventsTable = &schema.Table{
+		Name:       "question_report_events",
+		Columns:    QuestionReportEventsColumns,
+		PrimaryKey: []*schema.Column{QuestionReportEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionreportevent_sequence",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionReportEventsColumns[1]},
+			},
+			{
+				Name:    "questionreportevent_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionReportEventsColumns[2]},
+			},
+			{
+				Name:    "questionreportevent_owner_id_sequence",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionReportEventsColumns[3], QuestionReportEventsColumns[1]},
+			},
+			{
+				Name:    "questionreportevent_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionReportEventsColumns[4]},
+			},
+			{
+				Name:    "questionreportevent_skill_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionReportEventsColumns[5]},
+			},
+		},
+	}
 	// SessionEventsColumns holds the columns for the "session_events" table.
 	SessionEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -732,6 +834,7 @@ var (
 		{Name: "correct_answers", Type: field.TypeInt, Default: 0},
 		{Name: "duration_secs", Type: field.TypeInt, Default: 0},
 		{Name: "plan_summary", Type: field.TypeJSON, Nullable: true},
+		{Name: "question_sources", Type: field.TypeJSON, Nullable: true},
 		{Name: "quest_uid", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "quest_name", Type: field.TypeString, Nullable: true, Default: ""},
 	}
@@ -803,6 +906,7 @@ var (
 	Tables = []*schema.Table{
 		AccountsTable,
 		AnswerEventsTable,
+		BankQuestionsTable,
 		BillingStatesTable,
 		ChildProfilesTable,
 		CreditEntriesTable,
@@ -821,6 +925,7 @@ var (
 		QuestsTable,
 		QuestProgressesTable,
 		QuestQuestionsTable,
+		QuestionReportEventsTable,
 		SessionEventsTable,
 		SnapshotsTable,
 	}
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/abhisek/mathiz/ent/account"
 	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
 	"github.com/abhisek/mathiz/ent/billingstate"
 	"github.com/abhisek/mathiz/ent/childprofile"
 	"github.com/abhisek/mathiz/ent/creditentry"
@@ -30,6 +31,7 @@ import (
 	"github.com/abhisek/mathiz/ent/parentinvite"
 	"github.com/abhisek/mathiz/ent/predicate"
 	"github.com/abhisek/mathiz/ent/quest"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
 	"github.com/abhisek/mathiz/ent/questprogress"
 	"github.com/abhisek/mathiz/ent/questquestion"
 	"github.com/abhisek/mathiz/ent/schema"
@@ -48,6 +50,7 @@ const (
 	// Node types.
 	TypeAccount             = "Account"
 	TypeAnswerEvent         = "AnswerEvent"
+	TypeBankQuestion        = "BankQuestion"
 	TypeBillingState        = "BillingState"
 	TypeChildProfile        = "ChildProfile"
 	TypeCreditEntry         = "CreditEntry"
@@ -66,6 +69,7 @@ const (
 	TypeQuest               = "Quest"
 	TypeQuestProgress       = "QuestProgress"
 	TypeQuestQuestion       = "QuestQuestion"
+	TypeQuestionReportEvent = "QuestionReportEvent"
 	TypeSessionEvent        = "SessionEvent"
 	TypeSnapshot            = "Snapshot"
 )
@@ -615,28 +619,32 @@ func (m *AccountMutation) ResetEdge(name string) error {
 // AnswerEventMutation represents an operation that mutates the AnswerEvent nodes in the graph.
 type AnswerEventMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	sequence       *int64
-	addsequence    *int64
-	timestamp      *time.Time
-	owner_id       *string
-	session_id     *string
-	skill_id       *string
-	tier           *string
-	category       *string
-	question_text  *string
-	correct_answer *string
-	learner_answer *string
-	correct        *bool
-	time_ms        *int
-	addtime_ms     *int
-	answer_format  *string
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*AnswerEvent, error)
-	predicates     []predicate.AnswerEvent
+	op                Op
+	typ               string
+	id                *int
+	sequence          *int64
+	addsequence       *int64
+	timestamp         *time.Time
+	owner_id          *string
+	session_id        *string
+	skill_id          *string
+	tier              *string
+	category          *string
+	question_text     *string
+	correct_answer    *string
+	learner_answer    *string
+	correct           *bool
+	time_ms           *int
+	addtime_ms        *int
+	answer_format     *string
+	source            *string
+	llm_request_id    *int
+	addllm_request_id *int
+	llm_owner         *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*AnswerEvent, error)
+	predicates        []predicate.AnswerEvent
 }
 
 var _ ent.Mutation = (*AnswerEventMutation)(nil)
@@ -1245,6 +1253,134 @@ func (m *AnswerEventMutation) ResetAnswerFormat() {
 	m.answer_format = nil
 }
 
+// SetSource sets the "source" field.
+func (m *AnswerEventMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *AnswerEventMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the AnswerEvent entity.
+// If the AnswerEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerEventMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *AnswerEventMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (m *AnswerEventMutation) SetLlmRequestID(i int) {
+	m.llm_request_id = &i
+	m.addllm_request_id = nil
+}
+
+// LlmRequestID returns the value of the "llm_request_id" field in the mutation.
+func (m *AnswerEventMutation) LlmRequestID() (r int, exists bool) {
+	v := m.llm_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLlmRequestID returns the old "llm_request_id" field's value of the AnswerEvent entity.
+// If the AnswerEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerEventMutation) OldLlmRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmRequestID: %w", err)
+	}
+	return oldValue.LlmRequestID, nil
+}
+
+// AddLlmRequestID adds i to the "llm_request_id" field.
+func (m *AnswerEventMutation) AddLlmRequestID(i int) {
+	if m.addllm_request_id != nil {
+		*m.addllm_request_id += i
+	} else {
+		m.addllm_request_id = &i
+	}
+}
+
+// AddedLlmRequestID returns the value that was added to the "llm_request_id" field in this mutation.
+func (m *AnswerEventMutation) AddedLlmRequestID() (r int, exists bool) {
+	v := m.addllm_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLlmRequestID resets all changes to the "llm_request_id" field.
+func (m *AnswerEventMutation) ResetLlmRequestID() {
+	m.llm_request_id = nil
+	m.addllm_request_id = nil
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (m *AnswerEventMutation) SetLlmOwner(s string) {
+	m.llm_owner = &s
+}
+
+// LlmOwner returns the value of the "llm_owner" field in the mutation.
+func (m *AnswerEventMutation) LlmOwner() (r string, exists bool) {
+	v := m.llm_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLlmOwner returns the old "llm_owner" field's value of the AnswerEvent entity.
+// If the AnswerEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerEventMutation) OldLlmOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmOwner: %w", err)
+	}
+	return oldValue.LlmOwner, nil
+}
+
+// ResetLlmOwner resets all changes to the "llm_owner" field.
+func (m *AnswerEventMutation) ResetLlmOwner() {
+	m.llm_owner = nil
+}
+
 // Where appends a list predicates to the AnswerEventMutation builder.
 func (m *AnswerEventMutation) Where(ps ...predicate.AnswerEvent) {
 	m.predicates = append(m.predicates, ps...)
@@ -1279,7 +1415,7 @@ func (m *AnswerEventMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AnswerEventMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 16)
 	if m.sequence != nil {
 		fields = append(fields, answerevent.FieldSequence)
 	}
@@ -1319,6 +1455,15 @@ func (m *AnswerEventMutation) Fields() []string {
 	if m.answer_format != nil {
 		fields = append(fields, answerevent.FieldAnswerFormat)
 	}
+	if m.source != nil {
+		fields = append(fields, answerevent.FieldSource)
+	}
+	if m.llm_request_id != nil {
+		fields = append(fields, answerevent.FieldLlmRequestID)
+	}
+	if m.llm_owner != nil {
+		fields = append(fields, answerevent.FieldLlmOwner)
+	}
 	return fields
 }
 
@@ -1353,6 +1498,12 @@ func (m *AnswerEventMutation) Field(name string) (ent.Value, bool) {
 		return m.TimeMs()
 	case answerevent.FieldAnswerFormat:
 		return m.AnswerFormat()
+	case answerevent.FieldSource:
+		return m.Source()
+	case answerevent.FieldLlmRequestID:
+		return m.LlmRequestID()
+	case answerevent.FieldLlmOwner:
+		return m.LlmOwner()
 	}
 	return nil, false
 }
@@ -1388,6 +1539,12 @@ func (m *AnswerEventMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldTimeMs(ctx)
 	case answerevent.FieldAnswerFormat:
 		return m.OldAnswerFormat(ctx)
+	case answerevent.FieldSource:
+		return m.OldSource(ctx)
+	case answerevent.FieldLlmRequestID:
+		return m.OldLlmRequestID(ctx)
+	case answerevent.FieldLlmOwner:
+		return m.OldLlmOwner(ctx)
 	}
 	return nil, fmt.Errorf("unknown AnswerEvent field %s", name)
 }
@@ -1488,6 +1645,27 @@ func (m *AnswerEventMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAnswerFormat(v)
 		return nil
+	case answerevent.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case answerevent.FieldLlmRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmRequestID(v)
+		return nil
+	case answerevent.FieldLlmOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmOwner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AnswerEvent field %s", name)
 }
@@ -1502,6 +1680,9 @@ func (m *AnswerEventMutation) AddedFields() []string {
 	if m.addtime_ms != nil {
 		fields = append(fields, answerevent.FieldTimeMs)
 	}
+	if m.addllm_request_id != nil {
+		fields = append(fields, answerevent.FieldLlmRequestID)
+	}
 	return fields
 }
 
@@ -1514,6 +1695,8 @@ func (m *AnswerEventMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedSequence()
 	case answerevent.FieldTimeMs:
 		return m.AddedTimeMs()
+	case answerevent.FieldLlmRequestID:
+		return m.AddedLlmRequestID()
 	}
 	return nil, false
 }
@@ -1537,6 +1720,13 @@ func (m *AnswerEventMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddTimeMs(v)
 		return nil
+	case answerevent.FieldLlmRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLlmRequestID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AnswerEvent numeric field %s", name)
 }
@@ -1603,6 +1793,15 @@ func (m *AnswerEventMutation) ResetField(name string) error {
 	case answerevent.FieldAnswerFormat:
 		m.ResetAnswerFormat()
 		return nil
+	case answerevent.FieldSource:
+		m.ResetSource()
+		return nil
+	case answerevent.FieldLlmRequestID:
+		m.ResetLlmRequestID()
+		return nil
+	case answerevent.FieldLlmOwner:
+		m.ResetLlmOwner()
+		return nil
 	}
 	return fmt.Errorf("unknown AnswerEvent field %s", name)
 }
@@ -1655,38 +1854,59 @@ func (m *AnswerEventMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AnswerEvent edge %s", name)
 }
 
-// BillingStateMutation represents an operation that mutates the BillingState nodes in the graph.
-type BillingStateMutation struct {
+// BankQuestionMutation represents an operation that mutates the BankQuestion nodes in the graph.
+type BankQuestionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	uid                *string
-	family_space_id    *string
-	provider           *string
-	customer_id        *string
-	subscription_id    *string
-	plan_id            *string
-	status             *string
-	current_period_end *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*BillingState, error)
-	predicates         []predicate.BillingState
-}
-
-var _ ent.Mutation = (*BillingStateMutation)(nil)
-
-// billingstateOption allows management of the mutation configuration using functional options.
-type billingstateOption func(*BillingStateMutation)
-
-// newBillingStateMutation creates new mutation for the BillingState entity.
-func newBillingStateMutation(c config, op Op, opts ...billingstateOption) *BillingStateMutation {
-	m := &BillingStateMutation{
+	op                Op
+	typ               string
+	id                *int
+	skill_id          *string
+	tier              *string
+	difficulty        *int
+	adddifficulty     *int
+	text              *string
+	answer            *string
+	answer_type       *string
+	format            *string
+	choices           *[]string
+	appendchoices     []string
+	diagram           *string
+	hint              *string
+	explanation       *string
+	source            *string
+	llm_request_id    *int
+	addllm_request_id *int
+	llm_owner         *string
+	locale            *string
+	times_served      *int
+	addtimes_served   *int
+	times_answered    *int
+	addtimes_answered *int
+	times_correct     *int
+	addtimes_correct  *int
+	reported_by       *[]string
+	appendreported_by []string
+	reports           *int
+	addreports        *int
+	retired           *bool
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*BankQuestion, error)
+	predicates        []predicate.BankQuestion
+}
+
+var _ ent.Mutation = (*BankQuestionMutation)(nil)
+
+// bankquestionOption allows management of the mutation configuration using functional options.
+type bankquestionOption func(*BankQuestionMutation)
+
+// newBankQuestionMutation creates new mutation for the BankQuestion entity.
+func newBankQuestionMutation(c config, op Op, opts ...bankquestionOption) *BankQuestionMutation {
+	m := &BankQuestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBillingState,
+		typ:           TypeBankQuestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1695,20 +1915,20 @@ func newBillingStateMutation(c config, op Op, opts ...billingstateOption) *Billi
 	return m
 }
 
-// withBillingStateID sets the ID field of the mutation.
-func withBillingStateID(id int) billingstateOption {
-	return func(m *BillingStateMutation) {
+// withBankQuestionID sets the ID field of the mutation.
+func withBankQuestionID(id int) bankquestionOption {
+	return func(m *BankQuestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BillingState
+			value *BankQuestion
 		)
-		m.oldValue = func(ctx context.Context) (*BillingState, error) {
+		m.oldValue = func(ctx context.Context) (*BankQuestion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BillingState.Get(ctx, id)
+					value, err = m.Client().BankQuestion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1717,10 +1937,10 @@ func withBillingStateID(id int) billingstateOption {
 	}
 }
 
-// withBillingState sets the old BillingState of the mutation.
-func withBillingState(node *BillingState) billingstateOption {
-	return func(m *BillingStateMutation) {
-		m.oldValue = func(context.Context) (*BillingState, error) {
+// withBankQuestion sets the old BankQuestion of the mutation.
+func withBankQuestion(node *BankQuestion) bankquestionOption {
+	return func(m *BankQuestionMutation) {
+		m.oldValue = func(context.Context) (*BankQuestion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1729,7 +1949,7 @@ func withBillingState(node *BillingState) billingstateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BillingStateMutation) Client() *Client {
+func (m BankQuestionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1737,7 +1957,7 @@ func (m BillingStateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BillingStateMutation) Tx() (*Tx, error) {
+func (m BankQuestionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1748,7 +1968,7 @@ func (m BillingStateMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BillingStateMutation) ID() (id int, exists bool) {
+func (m *BankQuestionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1759,7 +1979,7 @@ func (m *BillingStateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BillingStateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BankQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1768,1045 +1988,964 @@ func (m *BillingStateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BillingState.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BankQuestion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUID sets the "uid" field.
-func (m *BillingStateMutation) SetUID(s string) {
-	m.uid = &s
+// SetSkillID sets the "skill_id" field.
+func (m *BankQuestionMutation) SetSkillID(s string) {
+	m.skill_id = &s
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *BillingStateMutation) UID() (r string, exists bool) {
-	v := m.uid
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *BankQuestionMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillID returns the old "skill_id" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldSkillID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.SkillID, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *BillingStateMutation) ResetUID() {
-	m.uid = nil
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *BankQuestionMutation) ResetSkillID() {
+	m.skill_id = nil
 }
 
-// SetFamilySpaceID sets the "family_space_id" field.
-func (m *BillingStateMutation) SetFamilySpaceID(s string) {
-	m.family_space_id = &s
+// SetTier sets the "tier" field.
+func (m *BankQuestionMutation) SetTier(s string) {
+	m.tier = &s
 }
 
-// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *BillingStateMutation) FamilySpaceID() (r string, exists bool) {
-	v := m.family_space_id
+// Tier returns the value of the "tier" field in the mutation.
+func (m *BankQuestionMutation) Tier() (r string, exists bool) {
+	v := m.tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldTier returns the old "tier" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldTier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return oldValue.FamilySpaceID, nil
+	return oldValue.Tier, nil
 }
 
-// ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *BillingStateMutation) ResetFamilySpaceID() {
-	m.family_space_id = nil
+// ResetTier resets all changes to the "tier" field.
+func (m *BankQuestionMutation) ResetTier() {
+	m.tier = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *BillingStateMutation) SetProvider(s string) {
-	m.provider = &s
+// SetDifficulty sets the "difficulty" field.
+func (m *BankQuestionMutation) SetDifficulty(i int) {
+	m.difficulty = &i
+	m.adddifficulty = nil
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *BillingStateMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *BankQuestionMutation) Difficulty() (r int, exists bool) {
+	v := m.difficulty
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldDifficulty returns the old "difficulty" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldDifficulty(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Difficulty, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *BillingStateMutation) ResetProvider() {
-	m.provider = nil
+// AddDifficulty adds i to the "difficulty" field.
+func (m *BankQuestionMutation) AddDifficulty(i int) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += i
+	} else {
+		m.adddifficulty = &i
+	}
 }
 
-// SetCustomerID sets the "customer_id" field.
-func (m *BillingStateMutation) SetCustomerID(s string) {
-	m.customer_id = &s
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *BankQuestionMutation) AddedDifficulty() (r int, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CustomerID returns the value of the "customer_id" field in the mutation.
-func (m *BillingStateMutation) CustomerID() (r string, exists bool) {
-	v := m.customer_id
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *BankQuestionMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetText sets the "text" field.
+func (m *BankQuestionMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *BankQuestionMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCustomerID returns the old "customer_id" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldCustomerID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCustomerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCustomerID requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCustomerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.CustomerID, nil
+	return oldValue.Text, nil
 }
 
-// ResetCustomerID resets all changes to the "customer_id" field.
-func (m *BillingStateMutation) ResetCustomerID() {
-	m.customer_id = nil
+// ResetText resets all changes to the "text" field.
+func (m *BankQuestionMutation) ResetText() {
+	m.text = nil
 }
 
-// SetSubscriptionID sets the "subscription_id" field.
-func (m *BillingStateMutation) SetSubscriptionID(s string) {
-	m.subscription_id = &s
+// SetAnswer sets the "answer" field.
+func (m *BankQuestionMutation) SetAnswer(s string) {
+	m.answer = &s
 }
 
-// SubscriptionID returns the value of the "subscription_id" field in the mutation.
-func (m *BillingStateMutation) SubscriptionID() (r string, exists bool) {
-	v := m.subscription_id
+// Answer returns the value of the "answer" field in the mutation.
+func (m *BankQuestionMutation) Answer() (r string, exists bool) {
+	v := m.answer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubscriptionID returns the old "subscription_id" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswer returns the old "answer" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldSubscriptionID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
 	}
-	return oldValue.SubscriptionID, nil
+	return oldValue.Answer, nil
 }
 
-// ResetSubscriptionID resets all changes to the "subscription_id" field.
-func (m *BillingStateMutation) ResetSubscriptionID() {
-	m.subscription_id = nil
+// ResetAnswer resets all changes to the "answer" field.
+func (m *BankQuestionMutation) ResetAnswer() {
+	m.answer = nil
 }
 
-// SetPlanID sets the "plan_id" field.
-func (m *BillingStateMutation) SetPlanID(s string) {
-	m.plan_id = &s
+// SetAnswerType sets the "answer_type" field.
+func (m *BankQuestionMutation) SetAnswerType(s string) {
+	m.answer_type = &s
 }
 
-// PlanID returns the value of the "plan_id" field in the mutation.
-func (m *BillingStateMutation) PlanID() (r string, exists bool) {
-	v := m.plan_id
+// AnswerType returns the value of the "answer_type" field in the mutation.
+func (m *BankQuestionMutation) AnswerType() (r string, exists bool) {
+	v := m.answer_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlanID returns the old "plan_id" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswerType returns the old "answer_type" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldPlanID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldAnswerType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswerType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlanID requires an ID field in the mutation")
+		return v, errors.New("OldAnswerType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswerType: %w", err)
 	}
-	return oldValue.PlanID, nil
+	return oldValue.AnswerType, nil
 }
 
-// ResetPlanID resets all changes to the "plan_id" field.
-func (m *BillingStateMutation) ResetPlanID() {
-	m.plan_id = nil
+// ResetAnswerType resets all changes to the "answer_type" field.
+func (m *BankQuestionMutation) ResetAnswerType() {
+	m.answer_type = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *BillingStateMutation) SetStatus(s string) {
-	m.status = &s
+// SetFormat sets the "format" field.
+func (m *BankQuestionMutation) SetFormat(s string) {
+	m.format = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *BillingStateMutation) Status() (r string, exists bool) {
-	v := m.status
+// Format returns the value of the "format" field in the mutation.
+func (m *BankQuestionMutation) Format() (r string, exists bool) {
+	v := m.format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldFormat returns the old "format" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Format, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *BillingStateMutation) ResetStatus() {
-	m.status = nil
+// ResetFormat resets all changes to the "format" field.
+func (m *BankQuestionMutation) ResetFormat() {
+	m.format = nil
 }
 
-// SetCurrentPeriodEnd sets the "current_period_end" field.
-func (m *BillingStateMutation) SetCurrentPeriodEnd(t time.Time) {
-	m.current_period_end = &t
+// SetChoices sets the "choices" field.
+func (m *BankQuestionMutation) SetChoices(s []string) {
+	m.choices = &s
+	m.appendchoices = nil
 }
 
-// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
-func (m *BillingStateMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
-	v := m.current_period_end
+// Choices returns the value of the "choices" field in the mutation.
+func (m *BankQuestionMutation) Choices() (r []string, exists bool) {
+	v := m.choices
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldChoices returns the old "choices" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldCurrentPeriodEnd(ctx context.Context) (v *time.Time, err error) {
+func (m *BankQuestionMutation) OldChoices(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
+		return v, errors.New("OldChoices is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
+		return v, errors.New("OldChoices requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
+		return v, fmt.Errorf("querying old value for OldChoices: %w", err)
 	}
-	return oldValue.CurrentPeriodEnd, nil
+	return oldValue.Choices, nil
 }
 
-// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
-func (m *BillingStateMutation) ClearCurrentPeriodEnd() {
-	m.current_period_end = nil
-	m.clearedFields[billingstate.FieldCurrentPeriodEnd] = struct{}{}
+// AppendChoices adds s to the "choices" field.
+func (m *BankQuestionMutation) AppendChoices(s []string) {
+	m.appendchoices = append(m.appendchoices, s...)
 }
 
-// CurrentPeriodEndCleared returns if the "current_period_end" field was cleared in this mutation.
-func (m *BillingStateMutation) CurrentPeriodEndCleared() bool {
-	_, ok := m.clearedFields[billingstate.FieldCurrentPeriodEnd]
+// AppendedChoices returns the list of values that were appended to the "choices" field in this mutation.
+func (m *BankQuestionMutation) AppendedChoices() ([]string, bool) {
+	if len(m.appendchoices) == 0 {
+		return nil, false
+	}
+	return m.appendchoices, true
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (m *BankQuestionMutation) ClearChoices() {
+	m.choices = nil
+	m.appendchoices = nil
+	m.clearedFields[bankquestion.FieldChoices] = struct{}{}
+}
+
+// ChoicesCleared returns if the "choices" field was cleared in this mutation.
+func (m *BankQuestionMutation) ChoicesCleared() bool {
+	_, ok := m.clearedFields[bankquestion.FieldChoices]
 	return ok
 }
 
-// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
-func (m *BillingStateMutation) ResetCurrentPeriodEnd() {
-	m.current_period_end = nil
-	delete(m.clearedFields, billingstate.FieldCurrentPeriodEnd)
+// ResetChoices resets all changes to the "choices" field.
+func (m *BankQuestionMutation) ResetChoices() {
+	m.choices = nil
+	m.appendchoices = nil
+	delete(m.clearedFields, bankquestion.FieldChoices)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *BillingStateMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetDiagram sets the "diagram" field.
+func (m *BankQuestionMutation) SetDiagram(s string) {
+	m.diagram = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BillingStateMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Diagram returns the value of the "diagram" field in the mutation.
+func (m *BankQuestionMutation) Diagram() (r string, exists bool) {
+	v := m.diagram
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BillingState entity.
-// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// OldDiagram returns the old "diagram" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BillingStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BankQuestionMutation) OldDiagram(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiagram is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDiagram requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiagram: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Diagram, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BillingStateMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetDiagram resets all changes to the "diagram" field.
+func (m *BankQuestionMutation) ResetDiagram() {
+	m.diagram = nil
 }
 
-// Where appends a list predicates to the BillingStateMutation builder.
-func (m *BillingStateMutation) Where(ps ...predicate.BillingState) {
-	m.predicates = append(m.predicates, ps...)
+// SetHint sets the "hint" field.
+func (m *BankQuestionMutation) SetHint(s string) {
+	m.hint = &s
 }
 
-// WhereP appends storage-level predicates to the BillingStateMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BillingStateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BillingState, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Hint returns the value of the "hint" field in the mutation.
+func (m *BankQuestionMutation) Hint() (r string, exists bool) {
+	v := m.hint
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *BillingStateMutation) Op() Op {
-	return m.op
+// OldHint returns the old "hint" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+	}
+	return oldValue.Hint, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BillingStateMutation) SetOp(op Op) {
-	m.op = op
+// ResetHint resets all changes to the "hint" field.
+func (m *BankQuestionMutation) ResetHint() {
+	m.hint = nil
 }
 
-// Type returns the node type of this mutation (BillingState).
-func (m *BillingStateMutation) Type() string {
-	return m.typ
+// SetExplanation sets the "explanation" field.
+func (m *BankQuestionMutation) SetExplanation(s string) {
+	m.explanation = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BillingStateMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.uid != nil {
-		fields = append(fields, billingstate.FieldUID)
-	}
-	if m.family_space_id != nil {
-		fields = append(fields, billingstate.FieldFamilySpaceID)
-	}
-	if m.provider != nil {
-		fields = append(fields, billingstate.FieldProvider)
-	}
-	if m.customer_id != nil {
-		fields = append(fields, billingstate.FieldCustomerID)
-	}
-	if m.subscription_id != nil {
-		fields = append(fields, billingstate.FieldSubscriptionID)
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *BankQuestionMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
 	}
-	if m.plan_id != nil {
-		fields = append(fields, billingstate.FieldPlanID)
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
 	}
-	if m.status != nil {
-		fields = append(fields, billingstate.FieldStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
 	}
-	if m.current_period_end != nil {
-		fields = append(fields, billingstate.FieldCurrentPeriodEnd)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, billingstate.FieldUpdatedAt)
+	return oldValue.Explanation, nil
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *BankQuestionMutation) ResetExplanation() {
+	m.explanation = nil
+}
+
+// SetSource sets the "source" field.
+func (m *BankQuestionMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *BankQuestionMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BillingStateMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case billingstate.FieldUID:
-		return m.UID()
-	case billingstate.FieldFamilySpaceID:
-		return m.FamilySpaceID()
-	case billingstate.FieldProvider:
-		return m.Provider()
-	case billingstate.FieldCustomerID:
-		return m.CustomerID()
-	case billingstate.FieldSubscriptionID:
-		return m.SubscriptionID()
-	case billingstate.FieldPlanID:
-		return m.PlanID()
-	case billingstate.FieldStatus:
-		return m.Status()
-	case billingstate.FieldCurrentPeriodEnd:
-		return m.CurrentPeriodEnd()
-	case billingstate.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldSource returns the old "source" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BillingStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case billingstate.FieldUID:
-		return m.OldUID(ctx)
-	case billingstate.FieldFamilySpaceID:
-		return m.OldFamilySpaceID(ctx)
-	case billingstate.FieldProvider:
-		return m.OldProvider(ctx)
-	case billingstate.FieldCustomerID:
-		return m.OldCustomerID(ctx)
-	case billingstate.FieldSubscriptionID:
-		return m.OldSubscriptionID(ctx)
-	case billingstate.FieldPlanID:
-		return m.OldPlanID(ctx)
-	case billingstate.FieldStatus:
-		return m.OldStatus(ctx)
-	case billingstate.FieldCurrentPeriodEnd:
-		return m.OldCurrentPeriodEnd(ctx)
-	case billingstate.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
-	return nil, fmt.Errorf("unknown BillingState field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BillingStateMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case billingstate.FieldUID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUID(v)
-		return nil
-	case billingstate.FieldFamilySpaceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFamilySpaceID(v)
-		return nil
-	case billingstate.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case billingstate.FieldCustomerID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCustomerID(v)
-		return nil
-	case billingstate.FieldSubscriptionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubscriptionID(v)
-		return nil
-	case billingstate.FieldPlanID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlanID(v)
-		return nil
-	case billingstate.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case billingstate.FieldCurrentPeriodEnd:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPeriodEnd(v)
-		return nil
-	case billingstate.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return fmt.Errorf("unknown BillingState field %s", name)
+	return oldValue.Source, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BillingStateMutation) AddedFields() []string {
-	return nil
+// ResetSource resets all changes to the "source" field.
+func (m *BankQuestionMutation) ResetSource() {
+	m.source = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BillingStateMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetLlmRequestID sets the "llm_request_id" field.
+func (m *BankQuestionMutation) SetLlmRequestID(i int) {
+	m.llm_request_id = &i
+	m.addllm_request_id = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BillingStateMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// LlmRequestID returns the value of the "llm_request_id" field in the mutation.
+func (m *BankQuestionMutation) LlmRequestID() (r int, exists bool) {
+	v := m.llm_request_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown BillingState numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BillingStateMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(billingstate.FieldCurrentPeriodEnd) {
-		fields = append(fields, billingstate.FieldCurrentPeriodEnd)
+// OldLlmRequestID returns the old "llm_request_id" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldLlmRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmRequestID is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmRequestID: %w", err)
+	}
+	return oldValue.LlmRequestID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BillingStateMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddLlmRequestID adds i to the "llm_request_id" field.
+func (m *BankQuestionMutation) AddLlmRequestID(i int) {
+	if m.addllm_request_id != nil {
+		*m.addllm_request_id += i
+	} else {
+		m.addllm_request_id = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BillingStateMutation) ClearField(name string) error {
-	switch name {
-	case billingstate.FieldCurrentPeriodEnd:
-		m.ClearCurrentPeriodEnd()
-		return nil
+// AddedLlmRequestID returns the value that was added to the "llm_request_id" field in this mutation.
+func (m *BankQuestionMutation) AddedLlmRequestID() (r int, exists bool) {
+	v := m.addllm_request_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown BillingState nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BillingStateMutation) ResetField(name string) error {
-	switch name {
-	case billingstate.FieldUID:
-		m.ResetUID()
-		return nil
-	case billingstate.FieldFamilySpaceID:
-		m.ResetFamilySpaceID()
-		return nil
-	case billingstate.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case billingstate.FieldCustomerID:
-		m.ResetCustomerID()
-		return nil
-	case billingstate.FieldSubscriptionID:
-		m.ResetSubscriptionID()
-		return nil
-	case billingstate.FieldPlanID:
-		m.ResetPlanID()
-		return nil
-	case billingstate.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case billingstate.FieldCurrentPeriodEnd:
-		m.ResetCurrentPeriodEnd()
-		return nil
-	case billingstate.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown BillingState field %s", name)
+// ResetLlmRequestID resets all changes to the "llm_request_id" field.
+func (m *BankQuestionMutation) ResetLlmRequestID() {
+	m.llm_request_id = nil
+	m.addllm_request_id = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BillingStateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetLlmOwner sets the "llm_owner" field.
+func (m *BankQuestionMutation) SetLlmOwner(s string) {
+	m.llm_owner = &s
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BillingStateMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// LlmOwner returns the value of the "llm_owner" field in the mutation.
+func (m *BankQuestionMutation) LlmOwner() (r string, exists bool) {
+	v := m.llm_owner
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BillingStateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldLlmOwner returns the old "llm_owner" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldLlmOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmOwner: %w", err)
+	}
+	return oldValue.LlmOwner, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BillingStateMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetLlmOwner resets all changes to the "llm_owner" field.
+func (m *BankQuestionMutation) ResetLlmOwner() {
+	m.llm_owner = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BillingStateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetLocale sets the "locale" field.
+func (m *BankQuestionMutation) SetLocale(s string) {
+	m.locale = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BillingStateMutation) EdgeCleared(name string) bool {
-	return false
+// Locale returns the value of the "locale" field in the mutation.
+func (m *BankQuestionMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BillingStateMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BillingState unique edge %s", name)
+// OldLocale returns the old "locale" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BillingStateMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BillingState edge %s", name)
+// ResetLocale resets all changes to the "locale" field.
+func (m *BankQuestionMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// ChildProfileMutation represents an operation that mutates the ChildProfile nodes in the graph.
-type ChildProfileMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	uid             *string
-	family_space_id *string
-	name            *string
-	grade           *int
-	addgrade        *int
-	pin_hash        *string
-	archived        *bool
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*ChildProfile, error)
-	predicates      []predicate.ChildProfile
+// SetTimesServed sets the "times_served" field.
+func (m *BankQuestionMutation) SetTimesServed(i int) {
+	m.times_served = &i
+	m.addtimes_served = nil
 }
 
-var _ ent.Mutation = (*ChildProfileMutation)(nil)
-
-// childprofileOption allows management of the mutation configuration using functional options.
-type childprofileOption func(*ChildProfileMutation)
-
-// newChildProfileMutation creates new mutation for the ChildProfile entity.
-func newChildProfileMutation(c config, op Op, opts ...childprofileOption) *ChildProfileMutation {
-	m := &ChildProfileMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeChildProfile,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// TimesServed returns the value of the "times_served" field in the mutation.
+func (m *BankQuestionMutation) TimesServed() (r int, exists bool) {
+	v := m.times_served
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withChildProfileID sets the ID field of the mutation.
-func withChildProfileID(id int) childprofileOption {
-	return func(m *ChildProfileMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ChildProfile
-		)
-		m.oldValue = func(ctx context.Context) (*ChildProfile, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ChildProfile.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldTimesServed returns the old "times_served" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BankQuestionMutation) OldTimesServed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimesServed is only allowed on UpdateOne operations")
 	}
-}
-
-// withChildProfile sets the old ChildProfile of the mutation.
-func withChildProfile(node *ChildProfile) childprofileOption {
-	return func(m *ChildProfileMutation) {
-		m.oldValue = func(context.Context) (*ChildProfile, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimesServed requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimesServed: %w", err)
+	}
+	return oldValue.TimesServed, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChildProfileMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ChildProfileMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddTimesServed adds i to the "times_served" field.
+func (m *BankQuestionMutation) AddTimesServed(i int) {
+	if m.addtimes_served != nil {
+		*m.addtimes_served += i
+	} else {
+		m.addtimes_served = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ChildProfileMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedTimesServed returns the value that was added to the "times_served" field in this mutation.
+func (m *BankQuestionMutation) AddedTimesServed() (r int, exists bool) {
+	v := m.addtimes_served
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ChildProfileMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChildProfile.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetTimesServed resets all changes to the "times_served" field.
+func (m *BankQuestionMutation) ResetTimesServed() {
+	m.times_served = nil
+	m.addtimes_served = nil
 }
 
-// SetUID sets the "uid" field.
-func (m *ChildProfileMutation) SetUID(s string) {
-	m.uid = &s
+// SetTimesAnswered sets the "times_answered" field.
+func (m *BankQuestionMutation) SetTimesAnswered(i int) {
+	m.times_answered = &i
+	m.addtimes_answered = nil
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *ChildProfileMutation) UID() (r string, exists bool) {
-	v := m.uid
+// TimesAnswered returns the value of the "times_answered" field in the mutation.
+func (m *BankQuestionMutation) TimesAnswered() (r int, exists bool) {
+	v := m.times_answered
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldTimesAnswered returns the old "times_answered" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldTimesAnswered(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimesAnswered is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldTimesAnswered requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimesAnswered: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.TimesAnswered, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *ChildProfileMutation) ResetUID() {
-	m.uid = nil
+// AddTimesAnswered adds i to the "times_answered" field.
+func (m *BankQuestionMutation) AddTimesAnswered(i int) {
+	if m.addtimes_answered != nil {
+		*m.addtimes_answered += i
+	} else {
+		m.addtimes_answered = &i
+	}
 }
 
-// SetFamilySpaceID sets the "family_space_id" field.
-func (m *ChildProfileMutation) SetFamilySpaceID(s string) {
-	m.family_space_id = &s
+// AddedTimesAnswered returns the value that was added to the "times_answered" field in this mutation.
+func (m *BankQuestionMutation) AddedTimesAnswered() (r int, exists bool) {
+	v := m.addtimes_answered
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *ChildProfileMutation) FamilySpaceID() (r string, exists bool) {
-	v := m.family_space_id
+// ResetTimesAnswered resets all changes to the "times_answered" field.
+func (m *BankQuestionMutation) ResetTimesAnswered() {
+	m.times_answered = nil
+	m.addtimes_answered = nil
+}
+
+// SetTimesCorrect sets the "times_correct" field.
+func (m *BankQuestionMutation) SetTimesCorrect(i int) {
+	m.times_correct = &i
+	m.addtimes_correct = nil
+}
+
+// TimesCorrect returns the value of the "times_correct" field in the mutation.
+func (m *BankQuestionMutation) TimesCorrect() (r int, exists bool) {
+	v := m.times_correct
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldTimesCorrect returns the old "times_correct" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldTimesCorrect(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimesCorrect is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
+		return v, errors.New("OldTimesCorrect requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimesCorrect: %w", err)
 	}
-	return oldValue.FamilySpaceID, nil
+	return oldValue.TimesCorrect, nil
 }
 
-// ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *ChildProfileMutation) ResetFamilySpaceID() {
-	m.family_space_id = nil
+// AddTimesCorrect adds i to the "times_correct" field.
+func (m *BankQuestionMutation) AddTimesCorrect(i int) {
+	if m.addtimes_correct != nil {
+		*m.addtimes_correct += i
+	} else {
+		m.addtimes_correct = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *ChildProfileMutation) SetName(s string) {
-	m.name = &s
+// AddedTimesCorrect returns the value that was added to the "times_correct" field in this mutation.
+func (m *BankQuestionMutation) AddedTimesCorrect() (r int, exists bool) {
+	v := m.addtimes_correct
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ChildProfileMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetTimesCorrect resets all changes to the "times_correct" field.
+func (m *BankQuestionMutation) ResetTimesCorrect() {
+	m.times_correct = nil
+	m.addtimes_correct = nil
+}
+
+// SetReportedBy sets the "reported_by" field.
+func (m *BankQuestionMutation) SetReportedBy(s []string) {
+	m.reported_by = &s
+	m.appendreported_by = nil
+}
+
+// ReportedBy returns the value of the "reported_by" field in the mutation.
+func (m *BankQuestionMutation) ReportedBy() (r []string, exists bool) {
+	v := m.reported_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldReportedBy returns the old "reported_by" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldReportedBy(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldReportedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldReportedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldReportedBy: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ReportedBy, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ChildProfileMutation) ResetName() {
-	m.name = nil
+// AppendReportedBy adds s to the "reported_by" field.
+func (m *BankQuestionMutation) AppendReportedBy(s []string) {
+	m.appendreported_by = append(m.appendreported_by, s...)
 }
 
-// SetGrade sets the "grade" field.
-func (m *ChildProfileMutation) SetGrade(i int) {
-	m.grade = &i
-	m.addgrade = nil
+// AppendedReportedBy returns the list of values that were appended to the "reported_by" field in this mutation.
+func (m *BankQuestionMutation) AppendedReportedBy() ([]string, bool) {
+	if len(m.appendreported_by) == 0 {
+		return nil, false
+	}
+	return m.appendreported_by, true
 }
 
-// Grade returns the value of the "grade" field in the mutation.
-func (m *ChildProfileMutation) Grade() (r int, exists bool) {
-	v := m.grade
+// ClearReportedBy clears the value of the "reported_by" field.
+func (m *BankQuestionMutation) ClearReportedBy() {
+	m.reported_by = nil
+	m.appendreported_by = nil
+	m.clearedFields[bankquestion.FieldReportedBy] = struct{}{}
+}
+
+// ReportedByCleared returns if the "reported_by" field was cleared in this mutation.
+func (m *BankQuestionMutation) ReportedByCleared() bool {
+	_, ok := m.clearedFields[bankquestion.FieldReportedBy]
+	return ok
+}
+
+// ResetReportedBy resets all changes to the "reported_by" field.
+func (m *BankQuestionMutation) ResetReportedBy() {
+	m.reported_by = nil
+	m.appendreported_by = nil
+	delete(m.clearedFields, bankquestion.FieldReportedBy)
+}
+
+// SetReports sets the "reports" field.
+func (m *BankQuestionMutation) SetReports(i int) {
+	m.reports = &i
+	m.addreports = nil
+}
+
+// Reports returns the value of the "reports" field in the mutation.
+func (m *BankQuestionMutation) Reports() (r int, exists bool) {
+	v := m.reports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGrade returns the old "grade" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldReports returns the old "reports" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldGrade(ctx context.Context) (v int, err error) {
+func (m *BankQuestionMutation) OldReports(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGrade is only allowed on UpdateOne operations")
+		return v, errors.New("OldReports is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGrade requires an ID field in the mutation")
+		return v, errors.New("OldReports requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGrade: %w", err)
+		return v, fmt.Errorf("querying old value for OldReports: %w", err)
 	}
-	return oldValue.Grade, nil
+	return oldValue.Reports, nil
 }
 
-// AddGrade adds i to the "grade" field.
-func (m *ChildProfileMutation) AddGrade(i int) {
-	if m.addgrade != nil {
-		*m.addgrade += i
+// AddReports adds i to the "reports" field.
+func (m *BankQuestionMutation) AddReports(i int) {
+	if m.addreports != nil {
+		*m.addreports += i
 	} else {
-		m.addgrade = &i
+		m.addreports = &i
 	}
 }
 
-// AddedGrade returns the value that was added to the "grade" field in this mutation.
-func (m *ChildProfileMutation) AddedGrade() (r int, exists bool) {
-	v := m.addgrade
+// AddedReports returns the value that was added to the "reports" field in this mutation.
+func (m *BankQuestionMutation) AddedReports() (r int, exists bool) {
+	v := m.addreports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetGrade resets all changes to the "grade" field.
-func (m *ChildProfileMutation) ResetGrade() {
-	m.grade = nil
-	m.addgrade = nil
+// ResetReports resets all changes to the "reports" field.
+func (m *BankQuestionMutation) ResetReports() {
+	m.reports = nil
+	m.addreports = nil
 }
 
-// SetPinHash sets the "pin_hash" field.
-func (m *ChildProfileMutation) SetPinHash(s string) {
-	m.pin_hash = &s
+// SetRetired sets the "retired" field.
+func (m *BankQuestionMutation) SetRetired(b bool) {
+	m.retired = &b
 }
 
-// PinHash returns the value of the "pin_hash" field in the mutation.
-func (m *ChildProfileMutation) PinHash() (r string, exists bool) {
-	v := m.pin_hash
+// Retired returns the value of the "retired" field in the mutation.
+func (m *BankQuestionMutation) Retired() (r bool, exists bool) {
+	v := m.retired
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPinHash returns the old "pin_hash" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldRetired returns the old "retired" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldPinHash(ctx context.Context) (v string, err error) {
+func (m *BankQuestionMutation) OldRetired(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPinHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetired is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPinHash requires an ID field in the mutation")
+		return v, errors.New("OldRetired requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPinHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetired: %w", err)
 	}
-	return oldValue.PinHash, nil
+	return oldValue.Retired, nil
 }
 
-// ResetPinHash resets all changes to the "pin_hash" field.
-func (m *ChildProfileMutation) ResetPinHash() {
-	m.pin_hash = nil
+// ResetRetired resets all changes to the "retired" field.
+func (m *BankQuestionMutation) ResetRetired() {
+	m.retired = nil
 }
 
-// SetArchived sets the "archived" field.
-func (m *ChildProfileMutation) SetArchived(b bool) {
-	m.archived = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *BankQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Archived returns the value of the "archived" field in the mutation.
-func (m *ChildProfileMutation) Archived() (r bool, exists bool) {
-	v := m.archived
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BankQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArchived returns the old "archived" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldArchived(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArchived requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
-	}
-	return oldValue.Archived, nil
-}
-
-// ResetArchived resets all changes to the "archived" field.
-func (m *ChildProfileMutation) ResetArchived() {
-	m.archived = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ChildProfileMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChildProfileMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the ChildProfile entity.
-// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BankQuestion entity.
+// If the BankQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BankQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2821,19 +2960,19 @@ func (m *ChildProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChildProfileMutation) ResetCreatedAt() {
+func (m *BankQuestionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the ChildProfileMutation builder.
-func (m *ChildProfileMutation) Where(ps ...predicate.ChildProfile) {
+// Where appends a list predicates to the BankQuestionMutation builder.
+func (m *BankQuestionMutation) Where(ps ...predicate.BankQuestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChildProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BankQuestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChildProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChildProfile, len(ps))
+func (m *BankQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BankQuestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2841,45 +2980,90 @@ func (m *ChildProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChildProfileMutation) Op() Op {
+func (m *BankQuestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChildProfileMutation) SetOp(op Op) {
+func (m *BankQuestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChildProfile).
-func (m *ChildProfileMutation) Type() string {
+// Type returns the node type of this mutation (BankQuestion).
+func (m *BankQuestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChildProfileMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.uid != nil {
-		fields = append(fields, childprofile.FieldUID)
+func (m *BankQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.skill_id != nil {
+		fields = append(fields, bankquestion.FieldSkillID)
 	}
-	if m.family_space_id != nil {
-		fields = append(fields, childprofile.FieldFamilySpaceID)
+	if m.tier != nil {
+		fields = append(fields, bankquestion.FieldTier)
 	}
-	if m.name != nil {
-		fields = append(fields, childprofile.FieldName)
+	if m.difficulty != nil {
+		fields = append(fields, bankquestion.FieldDifficulty)
 	}
-	if m.grade != nil {
-		fields = append(fields, childprofile.FieldGrade)
+	if m.text != nil {
+		fields = append(fields, bankquestion.FieldText)
 	}
-	if m.pin_hash != nil {
-		fields = append(fields, childprofile.FieldPinHash)
+	if m.answer != nil {
+		fields = append(fields, bankquestion.FieldAnswer)
 	}
-	if m.archived != nil {
-		fields = append(fields, childprofile.FieldArchived)
+	if m.answer_type != nil {
+		fields = append(fields, bankquestion.FieldAnswerType)
+	}
+	if m.format != nil {
+		fields = append(fields, bankquestion.FieldFormat)
+	}
+	if m.choices != nil {
+		fields = append(fields, bankquestion.FieldChoices)
+	}
+	if m.diagram != nil {
+		fields = append(fields, bankquestion.FieldDiagram)
+	}
+	if m.hint != nil {
+		fields = append(fields, bankquestion.FieldHint)
+	}
+	if m.explanation != nil {
+		fields = append(fields, bankquestion.FieldExplanation)
+	}
+	if m.source != nil {
+		fields = append(fields, bankquestion.FieldSource)
+	}
+	if m.llm_request_id != nil {
+		fields = append(fields, bankquestion.FieldLlmRequestID)
+	}
+	if m.llm_owner != nil {
+		fields = append(fields, bankquestion.FieldLlmOwner)
+	}
+	if m.locale != nil {
+		fields = append(fields, bankquestion.FieldLocale)
+	}
+	if m.times_served != nil {
+		fields = append(fields, bankquestion.FieldTimesServed)
+	}
+	if m.times_answered != nil {
+		fields = append(fields, bankquestion.FieldTimesAnswered)
+	}
+	if m.times_correct != nil {
+		fields = append(fields, bankquestion.FieldTimesCorrect)
+	}
+	if m.reported_by != nil {
+		fields = append(fields, bankquestion.FieldReportedBy)
+	}
+	if m.reports != nil {
+		fields = append(fields, bankquestion.FieldReports)
+	}
+	if m.retired != nil {
+		fields = append(fields, bankquestion.FieldRetired)
 	}
 	if m.created_at != nil {
-		fields = append(fields, childprofile.FieldCreatedAt)
+		fields = append(fields, bankquestion.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2887,21 +3071,51 @@ func (m *ChildProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChildProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *BankQuestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case childprofile.FieldUID:
-		return m.UID()
-	case childprofile.FieldFamilySpaceID:
-		return m.FamilySpaceID()
-	case childprofile.FieldName:
-		return m.Name()
-	case childprofile.FieldGrade:
-		return m.Grade()
-	case childprofile.FieldPinHash:
-		return m.PinHash()
-	case childprofile.FieldArchived:
-		return m.Archived()
-	case childprofile.FieldCreatedAt:
+	case bankquestion.FieldSkillID:
+		return m.SkillID()
+	case bankquestion.FieldTier:
+		return m.Tier()
+	case bankquestion.FieldDifficulty:
+		return m.Difficulty()
+	case bankquestion.FieldText:
+		return m.Text()
+	case bankquestion.FieldAnswer:
+		return m.Answer()
+	case bankquestion.FieldAnswerType:
+		return m.AnswerType()
+	case bankquestion.FieldFormat:
+		return m.Format()
+	case bankquestion.FieldChoices:
+		return m.Choices()
+	case bankquestion.FieldDiagram:
+		return m.Diagram()
+	case bankquestion.FieldHint:
+		return m.Hint()
+	case bankquestion.FieldExplanation:
+		return m.Explanation()
+	case bankquestion.FieldSource:
+		return m.Source()
+	case bankquestion.FieldLlmRequestID:
+		return m.LlmRequestID()
+	case bankquestion.FieldLlmOwner:
+		return m.LlmOwner()
+	case bankquestion.FieldLocale:
+		return m.Locale()
+	case bankquestion.FieldTimesServed:
+		return m.TimesServed()
+	case bankquestion.FieldTimesAnswered:
+		return m.TimesAnswered()
+	case bankquestion.FieldTimesCorrect:
+		return m.TimesCorrect()
+	case bankquestion.FieldReportedBy:
+		return m.ReportedBy()
+	case bankquestion.FieldReports:
+		return m.Reports()
+	case bankquestion.FieldRetired:
+		return m.Retired()
+	case bankquestion.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2910,74 +3124,209 @@ func (m *ChildProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChildProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BankQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case childprofile.FieldUID:
-		return m.OldUID(ctx)
-	case childprofile.FieldFamilySpaceID:
-		return m.OldFamilySpaceID(ctx)
-	case childprofile.FieldName:
-		return m.OldName(ctx)
-	case childprofile.FieldGrade:
-		return m.OldGrade(ctx)
-	case childprofile.FieldPinHash:
-		return m.OldPinHash(ctx)
-	case childprofile.FieldArchived:
-		return m.OldArchived(ctx)
-	case childprofile.FieldCreatedAt:
+	case bankquestion.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case bankquestion.FieldTier:
+		return m.OldTier(ctx)
+	case bankquestion.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case bankquestion.FieldText:
+		return m.OldText(ctx)
+	case bankquestion.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case bankquestion.FieldAnswerType:
+		return m.OldAnswerType(ctx)
+	case bankquestion.FieldFormat:
+		return m.OldFormat(ctx)
+	case bankquestion.FieldChoices:
+		return m.OldChoices(ctx)
+	case bankquestion.FieldDiagram:
+		return m.OldDiagram(ctx)
+	case bankquestion.FieldHint:
+		return m.OldHint(ctx)
+	case bankquestion.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case bankquestion.FieldSource:
+		return m.OldSource(ctx)
+	case bankquestion.FieldLlmRequestID:
+		return m.OldLlmRequestID(ctx)
+	case bankquestion.FieldLlmOwner:
+		return m.OldLlmOwner(ctx)
+	case bankquestion.FieldLocale:
+		return m.OldLocale(ctx)
+	case bankquestion.FieldTimesServed:
+		return m.OldTimesServed(ctx)
+	case bankquestion.FieldTimesAnswered:
+		return m.OldTimesAnswered(ctx)
+	case bankquestion.FieldTimesCorrect:
+		return m.OldTimesCorrect(ctx)
+	case bankquestion.FieldReportedBy:
+		return m.OldReportedBy(ctx)
+	case bankquestion.FieldReports:
+		return m.OldReports(ctx)
+	case bankquestion.FieldRetired:
+		return m.OldRetired(ctx)
+	case bankquestion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChildProfile field %s", name)
+	return nil, fmt.Errorf("unknown BankQuestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildProfileMutation) SetField(name string, value ent.Value) error {
+func (m *BankQuestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case childprofile.FieldUID:
+	case bankquestion.FieldSkillID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUID(v)
+		m.SetSkillID(v)
 		return nil
-	case childprofile.FieldFamilySpaceID:
+	case bankquestion.FieldTier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFamilySpaceID(v)
+		m.SetTier(v)
 		return nil
-	case childprofile.FieldName:
+	case bankquestion.FieldDifficulty:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case bankquestion.FieldText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetText(v)
 		return nil
-	case childprofile.FieldGrade:
+	case bankquestion.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case bankquestion.FieldAnswerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerType(v)
+		return nil
+	case bankquestion.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case bankquestion.FieldChoices:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoices(v)
+		return nil
+	case bankquestion.FieldDiagram:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiagram(v)
+		return nil
+	case bankquestion.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case bankquestion.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case bankquestion.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case bankquestion.FieldLlmRequestID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGrade(v)
+		m.SetLlmRequestID(v)
 		return nil
-	case childprofile.FieldPinHash:
+	case bankquestion.FieldLlmOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPinHash(v)
+		m.SetLlmOwner(v)
 		return nil
-	case childprofile.FieldArchived:
+	case bankquestion.FieldLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case bankquestion.FieldTimesServed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesServed(v)
+		return nil
+	case bankquestion.FieldTimesAnswered:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesAnswered(v)
+		return nil
+	case bankquestion.FieldTimesCorrect:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesCorrect(v)
+		return nil
+	case bankquestion.FieldReportedBy:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportedBy(v)
+		return nil
+	case bankquestion.FieldReports:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReports(v)
+		return nil
+	case bankquestion.FieldRetired:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArchived(v)
+		m.SetRetired(v)
 		return nil
-	case childprofile.FieldCreatedAt:
+	case bankquestion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2985,15 +3334,30 @@ func (m *ChildProfileMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChildProfile field %s", name)
+	return fmt.Errorf("unknown BankQuestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChildProfileMutation) AddedFields() []string {
+func (m *BankQuestionMutation) AddedFields() []string {
 	var fields []string
-	if m.addgrade != nil {
-		fields = append(fields, childprofile.FieldGrade)
+	if m.adddifficulty != nil {
+		fields = append(fields, bankquestion.FieldDifficulty)
+	}
+	if m.addllm_request_id != nil {
+		fields = append(fields, bankquestion.FieldLlmRequestID)
+	}
+	if m.addtimes_served != nil {
+		fields = append(fields, bankquestion.FieldTimesServed)
+	}
+	if m.addtimes_answered != nil {
+		fields = append(fields, bankquestion.FieldTimesAnswered)
+	}
+	if m.addtimes_correct != nil {
+		fields = append(fields, bankquestion.FieldTimesCorrect)
+	}
+	if m.addreports != nil {
+		fields = append(fields, bankquestion.FieldReports)
 	}
 	return fields
 }
@@ -3001,10 +3365,20 @@ func (m *ChildProfileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChildProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BankQuestionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case childprofile.FieldGrade:
-		return m.AddedGrade()
+	case bankquestion.FieldDifficulty:
+		return m.AddedDifficulty()
+	case bankquestion.FieldLlmRequestID:
+		return m.AddedLlmRequestID()
+	case bankquestion.FieldTimesServed:
+		return m.AddedTimesServed()
+	case bankquestion.FieldTimesAnswered:
+		return m.AddedTimesAnswered()
+	case bankquestion.FieldTimesCorrect:
+		return m.AddedTimesCorrect()
+	case bankquestion.FieldReports:
+		return m.AddedReports()
 	}
 	return nil, false
 }
@@ -3012,148 +3386,242 @@ func (m *ChildProfileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildProfileMutation) AddField(name string, value ent.Value) error {
+func (m *BankQuestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case childprofile.FieldGrade:
+	case bankquestion.FieldDifficulty:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGrade(v)
+		m.AddDifficulty(v)
 		return nil
-	}
-	return fmt.Errorf("unknown ChildProfile numeric field %s", name)
-}
-
+	case bankquestion.FieldLlmRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLlmRequestID(v)
+		return nil
+	case bankquestion.FieldTimesServed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesServed(v)
+		return nil
+	case bankquestion.FieldTimesAnswered:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesAnswered(v)
+		return nil
+	case bankquestion.FieldTimesCorrect:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesCorrect(v)
+		return nil
+	case bankquestion.FieldReports:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReports(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BankQuestion numeric field %s", name)
+}
+
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChildProfileMutation) ClearedFields() []string {
-	return nil
+func (m *BankQuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bankquestion.FieldChoices) {
+		fields = append(fields, bankquestion.FieldChoices)
+	}
+	if m.FieldCleared(bankquestion.FieldReportedBy) {
+		fields = append(fields, bankquestion.FieldReportedBy)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChildProfileMutation) FieldCleared(name string) bool {
+func (m *BankQuestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChildProfileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ChildProfile nullable field %s", name)
+func (m *BankQuestionMutation) ClearField(name string) error {
+	switch name {
+	case bankquestion.FieldChoices:
+		m.ClearChoices()
+		return nil
+	case bankquestion.FieldReportedBy:
+		m.ClearReportedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown BankQuestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChildProfileMutation) ResetField(name string) error {
+func (m *BankQuestionMutation) ResetField(name string) error {
 	switch name {
-	case childprofile.FieldUID:
-		m.ResetUID()
+	case bankquestion.FieldSkillID:
+		m.ResetSkillID()
 		return nil
-	case childprofile.FieldFamilySpaceID:
-		m.ResetFamilySpaceID()
+	case bankquestion.FieldTier:
+		m.ResetTier()
 		return nil
-	case childprofile.FieldName:
-		m.ResetName()
+	case bankquestion.FieldDifficulty:
+		m.ResetDifficulty()
 		return nil
-	case childprofile.FieldGrade:
-		m.ResetGrade()
+	case bankquestion.FieldText:
+		m.ResetText()
 		return nil
-	case childprofile.FieldPinHash:
-		m.ResetPinHash()
+	case bankquestion.FieldAnswer:
+		m.ResetAnswer()
 		return nil
-	case childprofile.FieldArchived:
-		m.ResetArchived()
+	case bankquestion.FieldAnswerType:
+		m.ResetAnswerType()
 		return nil
-	case childprofile.FieldCreatedAt:
+	case bankquestion.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case bankquestion.FieldChoices:
+		m.ResetChoices()
+		return nil
+	case bankquestion.FieldDiagram:
+		m.ResetDiagram()
+		return nil
+	case bankquestion.FieldHint:
+		m.ResetHint()
+		return nil
+	case bankquestion.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case bankquestion.FieldSource:
+		m.ResetSource()
+		return nil
+	case bankquestion.FieldLlmRequestID:
+		m.ResetLlmRequestID()
+		return nil
+	case bankquestion.FieldLlmOwner:
+		m.ResetLlmOwner()
+		return nil
+	case bankquestion.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case bankquestion.FieldTimesServed:
+		m.ResetTimesServed()
+		return nil
+	case bankquestion.FieldTimesAnswered:
+		m.ResetTimesAnswered()
+		return nil
+	case bankquestion.FieldTimesCorrect:
+		m.ResetTimesCorrect()
+		return nil
+	case bankquestion.FieldReportedBy:
+		m.ResetReportedBy()
+		return nil
+	case bankquestion.FieldReports:
+		m.ResetReports()
+		return nil
+	case bankquestion.FieldRetired:
+		m.ResetRetired()
+		return nil
+	case bankquestion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ChildProfile field %s", name)
+	return fmt.Errorf("unknown BankQuestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChildProfileMutation) AddedEdges() []string {
+func (m *BankQuestionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChildProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *BankQuestionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChildProfileMutation) RemovedEdges() []string {
+func (m *BankQuestionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChildProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *BankQuestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChildProfileMutation) ClearedEdges() []string {
+func (m *BankQuestionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChildProfileMutation) EdgeCleared(name string) bool {
+func (m *BankQuestionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChildProfileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChildProfile unique edge %s", name)
+func (m *BankQuestionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BankQuestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChildProfileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChildProfile edge %s", name)
+func (m *BankQuestionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BankQuestion edge %s", name)
 }
 
-// CreditEntryMutation represents an operation that mutates the CreditEntry nodes in the graph.
-type CreditEntryMutation struct {
+// BillingStateMutation represents an operation that mutates the BillingState nodes in the graph.
+type BillingStateMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uid             *string
-	family_space_id *string
-	kind            *string
-	amount          *int
-	addamount       *int
-	remaining       *int
-	addremaining    *int
-	source          *string
-	expires_at      *time.Time
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*CreditEntry, error)
-	predicates      []predicate.CreditEntry
+	op                 Op
+	typ                string
+	id                 *int
+	uid                *string
+	family_space_id    *string
+	provider           *string
+	customer_id        *string
+	subscription_id    *string
+	plan_id            *string
+	status             *string
+	current_period_end *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BillingState, error)
+	predicates         []predicate.BillingState
 }
 
-var _ ent.Mutation = (*CreditEntryMutation)(nil)
+var _ ent.Mutation = (*BillingStateMutation)(nil)
 
-// creditentryOption allows management of the mutation configuration using functional options.
-type creditentryOption func(*CreditEntryMutation)
+// billingstateOption allows management of the mutation configuration using functional options.
+type billingstateOption func(*BillingStateMutation)
 
-// newCreditEntryMutation creates new mutation for the CreditEntry entity.
-func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditEntryMutation {
-	m := &CreditEntryMutation{
+// newBillingStateMutation creates new mutation for the BillingState entity.
+func newBillingStateMutation(c config, op Op, opts ...billingstateOption) *BillingStateMutation {
+	m := &BillingStateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCreditEntry,
+		typ:           TypeBillingState,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3162,20 +3630,20 @@ func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditE
 	return m
 }
 
-// withCreditEntryID sets the ID field of the mutation.
-func withCreditEntryID(id int) creditentryOption {
-	return func(m *CreditEntryMutation) {
+// withBillingStateID sets the ID field of the mutation.
+func withBillingStateID(id int) billingstateOption {
+	return func(m *BillingStateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CreditEntry
+			value *BillingState
 		)
-		m.oldValue = func(ctx context.Context) (*CreditEntry, error) {
+		m.oldValue = func(ctx context.Context) (*BillingState, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CreditEntry.Get(ctx, id)
+					value, err = m.Client().BillingState.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3184,10 +3652,10 @@ func withCreditEntryID(id int) creditentryOption {
 	}
 }
 
-// withCreditEntry sets the old CreditEntry of the mutation.
-func withCreditEntry(node *CreditEntry) creditentryOption {
-	return func(m *CreditEntryMutation) {
-		m.oldValue = func(context.Context) (*CreditEntry, error) {
+// withBillingState sets the old BillingState of the mutation.
+func withBillingState(node *BillingState) billingstateOption {
+	return func(m *BillingStateMutation) {
+		m.oldValue = func(context.Context) (*BillingState, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3196,7 +3664,7 @@ func withCreditEntry(node *CreditEntry) creditentryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CreditEntryMutation) Client() *Client {
+func (m BillingStateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3204,7 +3672,7 @@ func (m CreditEntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CreditEntryMutation) Tx() (*Tx, error) {
+func (m BillingStateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3215,7 +3683,7 @@ func (m CreditEntryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CreditEntryMutation) ID() (id int, exists bool) {
+func (m *BillingStateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3226,7 +3694,7 @@ func (m *CreditEntryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CreditEntryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BillingStateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3235,19 +3703,19 @@ func (m *CreditEntryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CreditEntry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BillingState.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *CreditEntryMutation) SetUID(s string) {
+func (m *BillingStateMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *CreditEntryMutation) UID() (r string, exists bool) {
+func (m *BillingStateMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -3255,10 +3723,10 @@ func (m *CreditEntryMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *BillingStateMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -3273,17 +3741,17 @@ func (m *CreditEntryMutation) OldUID(ctx context.Context) (v string, err error)
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *CreditEntryMutation) ResetUID() {
+func (m *BillingStateMutation) ResetUID() {
 	m.uid = nil
 }
 
 // SetFamilySpaceID sets the "family_space_id" field.
-func (m *CreditEntryMutation) SetFamilySpaceID(s string) {
+func (m *BillingStateMutation) SetFamilySpaceID(s string) {
 	m.family_space_id = &s
 }
 
 // FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *CreditEntryMutation) FamilySpaceID() (r string, exists bool) {
+func (m *BillingStateMutation) FamilySpaceID() (r string, exists bool) {
 	v := m.family_space_id
 	if v == nil {
 		return
@@ -3291,10 +3759,10 @@ func (m *CreditEntryMutation) FamilySpaceID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *BillingStateMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
@@ -3309,288 +3777,284 @@ func (m *CreditEntryMutation) OldFamilySpaceID(ctx context.Context) (v string, e
 }
 
 // ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *CreditEntryMutation) ResetFamilySpaceID() {
+func (m *BillingStateMutation) ResetFamilySpaceID() {
 	m.family_space_id = nil
 }
 
-// SetKind sets the "kind" field.
-func (m *CreditEntryMutation) SetKind(s string) {
-	m.kind = &s
+// SetProvider sets the "provider" field.
+func (m *BillingStateMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *CreditEntryMutation) Kind() (r string, exists bool) {
-	v := m.kind
+// Provider returns the value of the "provider" field in the mutation.
+func (m *BillingStateMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKind returns the old "kind" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldKind(ctx context.Context) (v string, err error) {
+func (m *BillingStateMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Kind, nil
+	return oldValue.Provider, nil
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *CreditEntryMutation) ResetKind() {
-	m.kind = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *BillingStateMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetAmount sets the "amount" field.
-func (m *CreditEntryMutation) SetAmount(i int) {
-	m.amount = &i
-	m.addamount = nil
+// SetCustomerID sets the "customer_id" field.
+func (m *BillingStateMutation) SetCustomerID(s string) {
+	m.customer_id = &s
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *CreditEntryMutation) Amount() (r int, exists bool) {
-	v := m.amount
+// CustomerID returns the value of the "customer_id" field in the mutation.
+func (m *BillingStateMutation) CustomerID() (r string, exists bool) {
+	v := m.customer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCustomerID returns the old "customer_id" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldAmount(ctx context.Context) (v int, err error) {
+func (m *BillingStateMutation) OldCustomerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCustomerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldCustomerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCustomerID: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.CustomerID, nil
 }
 
-// AddAmount adds i to the "amount" field.
-func (m *CreditEntryMutation) AddAmount(i int) {
-	if m.addamount != nil {
-		*m.addamount += i
-	} else {
-		m.addamount = &i
-	}
-}
-
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *CreditEntryMutation) AddedAmount() (r int, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetAmount resets all changes to the "amount" field.
-func (m *CreditEntryMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetCustomerID resets all changes to the "customer_id" field.
+func (m *BillingStateMutation) ResetCustomerID() {
+	m.customer_id = nil
 }
 
-// SetRemaining sets the "remaining" field.
-func (m *CreditEntryMutation) SetRemaining(i int) {
-	m.remaining = &i
-	m.addremaining = nil
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *BillingStateMutation) SetSubscriptionID(s string) {
+	m.subscription_id = &s
 }
 
-// Remaining returns the value of the "remaining" field in the mutation.
-func (m *CreditEntryMutation) Remaining() (r int, exists bool) {
-	v := m.remaining
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *BillingStateMutation) SubscriptionID() (r string, exists bool) {
+	v := m.subscription_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemaining returns the old "remaining" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldSubscriptionID returns the old "subscription_id" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldRemaining(ctx context.Context) (v int, err error) {
+func (m *BillingStateMutation) OldSubscriptionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemaining is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemaining requires an ID field in the mutation")
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemaining: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
 	}
-	return oldValue.Remaining, nil
+	return oldValue.SubscriptionID, nil
 }
 
-// AddRemaining adds i to the "remaining" field.
-func (m *CreditEntryMutation) AddRemaining(i int) {
-	if m.addremaining != nil {
-		*m.addremaining += i
-	} else {
-		m.addremaining = &i
-	}
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *BillingStateMutation) ResetSubscriptionID() {
+	m.subscription_id = nil
 }
 
-// AddedRemaining returns the value that was added to the "remaining" field in this mutation.
-func (m *CreditEntryMutation) AddedRemaining() (r int, exists bool) {
-	v := m.addremaining
+// SetPlanID sets the "plan_id" field.
+func (m *BillingStateMutation) SetPlanID(s string) {
+	m.plan_id = &s
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *BillingStateMutation) PlanID() (r string, exists bool) {
+	v := m.plan_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRemaining resets all changes to the "remaining" field.
-func (m *CreditEntryMutation) ResetRemaining() {
-	m.remaining = nil
-	m.addremaining = nil
+// OldPlanID returns the old "plan_id" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BillingStateMutation) OldPlanID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
 }
 
-// SetSource sets the "source" field.
-func (m *CreditEntryMutation) SetSource(s string) {
-	m.source = &s
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *BillingStateMutation) ResetPlanID() {
+	m.plan_id = nil
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *CreditEntryMutation) Source() (r string, exists bool) {
-	v := m.source
+// SetStatus sets the "status" field.
+func (m *BillingStateMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BillingStateMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *BillingStateMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Status, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *CreditEntryMutation) ResetSource() {
-	m.source = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *BillingStateMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *CreditEntryMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (m *BillingStateMutation) SetCurrentPeriodEnd(t time.Time) {
+	m.current_period_end = &t
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *CreditEntryMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// CurrentPeriodEnd returns the value of the "current_period_end" field in the mutation.
+func (m *BillingStateMutation) CurrentPeriodEnd() (r time.Time, exists bool) {
+	v := m.current_period_end
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrentPeriodEnd returns the old "current_period_end" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+func (m *BillingStateMutation) OldCurrentPeriodEnd(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentPeriodEnd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldCurrentPeriodEnd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentPeriodEnd: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.CurrentPeriodEnd, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *CreditEntryMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[creditentry.FieldExpiresAt] = struct{}{}
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (m *BillingStateMutation) ClearCurrentPeriodEnd() {
+	m.current_period_end = nil
+	m.clearedFields[billingstate.FieldCurrentPeriodEnd] = struct{}{}
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *CreditEntryMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldExpiresAt]
+// CurrentPeriodEndCleared returns if the "current_period_end" field was cleared in this mutation.
+func (m *BillingStateMutation) CurrentPeriodEndCleared() bool {
+	_, ok := m.clearedFields[billingstate.FieldCurrentPeriodEnd]
 	return ok
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *CreditEntryMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, creditentry.FieldExpiresAt)
+// ResetCurrentPeriodEnd resets all changes to the "current_period_end" field.
+func (m *BillingStateMutation) ResetCurrentPeriodEnd() {
+	m.current_period_end = nil
+	delete(m.clearedFields, billingstate.FieldCurrentPeriodEnd)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CreditEntryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BillingStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CreditEntryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BillingStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BillingState entity.
+// If the BillingState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BillingStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CreditEntryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BillingStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the CreditEntryMutation builder.
-func (m *CreditEntryMutation) Where(ps ...predicate.CreditEntry) {
+// Where appends a list predicates to the BillingStateMutation builder.
+func (m *BillingStateMutation) Where(ps ...predicate.BillingState) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CreditEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BillingStateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CreditEntry, len(ps))
+func (m *BillingStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BillingState, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3598,48 +4062,51 @@ func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CreditEntryMutation) Op() Op {
+func (m *BillingStateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CreditEntryMutation) SetOp(op Op) {
+func (m *BillingStateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CreditEntry).
-func (m *CreditEntryMutation) Type() string {
+// Type returns the node type of this mutation (BillingState).
+func (m *BillingStateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CreditEntryMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *BillingStateMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.uid != nil {
-		fields = append(fields, creditentry.FieldUID)
+		fields = append(fields, billingstate.FieldUID)
 	}
 	if m.family_space_id != nil {
-		fields = append(fields, creditentry.FieldFamilySpaceID)
+		fields = append(fields, billingstate.FieldFamilySpaceID)
 	}
-	if m.kind != nil {
-		fields = append(fields, creditentry.FieldKind)
+	if m.provider != nil {
+		fields = append(fields, billingstate.FieldProvider)
 	}
-	if m.amount != nil {
-		fields = append(fields, creditentry.FieldAmount)
+	if m.customer_id != nil {
+		fields = append(fields, billingstate.FieldCustomerID)
 	}
-	if m.remaining != nil {
-		fields = append(fields, creditentry.FieldRemaining)
+	if m.subscription_id != nil {
+		fields = append(fields, billingstate.FieldSubscriptionID)
 	}
-	if m.source != nil {
-		fields = append(fields, creditentry.FieldSource)
+	if m.plan_id != nil {
+		fields = append(fields, billingstate.FieldPlanID)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, creditentry.FieldExpiresAt)
+	if m.status != nil {
+		fields = append(fields, billingstate.FieldStatus)
 	}
-	if m.created_at != nil {
-		fields = append(fields, creditentry.FieldCreatedAt)
+	if m.current_period_end != nil {
+		fields = append(fields, billingstate.FieldCurrentPeriodEnd)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, billingstate.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3647,24 +4114,26 @@ func (m *CreditEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *BillingStateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case creditentry.FieldUID:
+	case billingstate.FieldUID:
 		return m.UID()
-	case creditentry.FieldFamilySpaceID:
+	case billingstate.FieldFamilySpaceID:
 		return m.FamilySpaceID()
-	case creditentry.FieldKind:
-		return m.Kind()
-	case creditentry.FieldAmount:
-		return m.Amount()
-	case creditentry.FieldRemaining:
-		return m.Remaining()
-	case creditentry.FieldSource:
-		return m.Source()
-	case creditentry.FieldExpiresAt:
-		return m.ExpiresAt()
-	case creditentry.FieldCreatedAt:
-		return m.CreatedAt()
+	case billingstate.FieldProvider:
+		return m.Provider()
+	case billingstate.FieldCustomerID:
+		return m.CustomerID()
+	case billingstate.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case billingstate.FieldPlanID:
+		return m.PlanID()
+	case billingstate.FieldStatus:
+		return m.Status()
+	case billingstate.FieldCurrentPeriodEnd:
+		return m.CurrentPeriodEnd()
+	case billingstate.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3672,282 +4141,269 @@ func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CreditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BillingStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case creditentry.FieldUID:
+	case billingstate.FieldUID:
 		return m.OldUID(ctx)
-	case creditentry.FieldFamilySpaceID:
+	case billingstate.FieldFamilySpaceID:
 		return m.OldFamilySpaceID(ctx)
-	case creditentry.FieldKind:
-		return m.OldKind(ctx)
-	case creditentry.FieldAmount:
-		return m.OldAmount(ctx)
-	case creditentry.FieldRemaining:
-		return m.OldRemaining(ctx)
-	case creditentry.FieldSource:
-		return m.OldSource(ctx)
-	case creditentry.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case creditentry.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case billingstate.FieldProvider:
+		return m.OldProvider(ctx)
+	case billingstate.FieldCustomerID:
+		return m.OldCustomerID(ctx)
+	case billingstate.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case billingstate.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case billingstate.FieldStatus:
+		return m.OldStatus(ctx)
+	case billingstate.FieldCurrentPeriodEnd:
+		return m.OldCurrentPeriodEnd(ctx)
+	case billingstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CreditEntry field %s", name)
+	return nil, fmt.Errorf("unknown BillingState field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditEntryMutation) SetField(name string, value ent.Value) error {
+func (m *BillingStateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case creditentry.FieldUID:
+	case billingstate.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case creditentry.FieldFamilySpaceID:
+	case billingstate.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFamilySpaceID(v)
 		return nil
-	case creditentry.FieldKind:
+	case billingstate.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetProvider(v)
 		return nil
-	case creditentry.FieldAmount:
-		v, ok := value.(int)
+	case billingstate.FieldCustomerID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetCustomerID(v)
 		return nil
-	case creditentry.FieldRemaining:
-		v, ok := value.(int)
+	case billingstate.FieldSubscriptionID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemaining(v)
+		m.SetSubscriptionID(v)
 		return nil
-	case creditentry.FieldSource:
+	case billingstate.FieldPlanID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetPlanID(v)
 		return nil
-	case creditentry.FieldExpiresAt:
+	case billingstate.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case billingstate.FieldCurrentPeriodEnd:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetCurrentPeriodEnd(v)
 		return nil
-	case creditentry.FieldCreatedAt:
+	case billingstate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry field %s", name)
+	return fmt.Errorf("unknown BillingState field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CreditEntryMutation) AddedFields() []string {
-	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, creditentry.FieldAmount)
-	}
-	if m.addremaining != nil {
-		fields = append(fields, creditentry.FieldRemaining)
-	}
-	return fields
+func (m *BillingStateMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CreditEntryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case creditentry.FieldAmount:
-		return m.AddedAmount()
-	case creditentry.FieldRemaining:
-		return m.AddedRemaining()
-	}
+func (m *BillingStateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditEntryMutation) AddField(name string, value ent.Value) error {
+func (m *BillingStateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case creditentry.FieldAmount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAmount(v)
-		return nil
-	case creditentry.FieldRemaining:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRemaining(v)
-		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry numeric field %s", name)
+	return fmt.Errorf("unknown BillingState numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CreditEntryMutation) ClearedFields() []string {
+func (m *BillingStateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(creditentry.FieldExpiresAt) {
-		fields = append(fields, creditentry.FieldExpiresAt)
+	if m.FieldCleared(billingstate.FieldCurrentPeriodEnd) {
+		fields = append(fields, billingstate.FieldCurrentPeriodEnd)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CreditEntryMutation) FieldCleared(name string) bool {
+func (m *BillingStateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CreditEntryMutation) ClearField(name string) error {
+func (m *BillingStateMutation) ClearField(name string) error {
 	switch name {
-	case creditentry.FieldExpiresAt:
-		m.ClearExpiresAt()
+	case billingstate.FieldCurrentPeriodEnd:
+		m.ClearCurrentPeriodEnd()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry nullable field %s", name)
+	return fmt.Errorf("unknown BillingState nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CreditEntryMutation) ResetField(name string) error {
+func (m *BillingStateMutation) ResetField(name string) error {
 	switch name {
-	case creditentry.FieldUID:
+	case billingstate.FieldUID:
 		m.ResetUID()
 		return nil
-	case creditentry.FieldFamilySpaceID:
+	case billingstate.FieldFamilySpaceID:
 		m.ResetFamilySpaceID()
 		return nil
-	case creditentry.FieldKind:
-		m.ResetKind()
+	case billingstate.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case creditentry.FieldAmount:
-		m.ResetAmount()
+	case billingstate.FieldCustomerID:
+		m.ResetCustomerID()
 		return nil
-	case creditentry.FieldRemaining:
-		m.ResetRemaining()
+	case billingstate.FieldSubscriptionID:
+		m.ResetSubscriptionID()
 		return nil
-	case creditentry.FieldSource:
-		m.ResetSource()
+	case billingstate.FieldPlanID:
+		m.ResetPlanID()
 		return nil
-	case creditentry.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case billingstate.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case creditentry.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case billingstate.FieldCurrentPeriodEnd:
+		m.ResetCurrentPeriodEnd()
+		return nil
+	case billingstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry field %s", name)
+	return fmt.Errorf("unknown BillingState field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CreditEntryMutation) AddedEdges() []string {
+func (m *BillingStateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CreditEntryMutation) AddedIDs(name string) []ent.Value {
+func (m *BillingStateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CreditEntryMutation) RemovedEdges() []string {
+func (m *BillingStateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CreditEntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *BillingStateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CreditEntryMutation) ClearedEdges() []string {
+func (m *BillingStateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CreditEntryMutation) EdgeCleared(name string) bool {
+func (m *BillingStateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CreditEntryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CreditEntry unique edge %s", name)
+func (m *BillingStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BillingState unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CreditEntryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CreditEntry edge %s", name)
+func (m *BillingStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BillingState edge %s", name)
 }
 
-// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
-type DeviceTokenMutation struct {
+// ChildProfileMutation represents an operation that mutates the ChildProfile nodes in the graph.
+type ChildProfileMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	uid              *string
-	child_profile_id *string
-	family_space_id  *string
-	token_hash       *string
-	device_label     *string
-	revoked          *bool
-	last_used_at     *time.Time
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*DeviceToken, error)
-	predicates       []predicate.DeviceToken
-}
-
-var _ ent.Mutation = (*DeviceTokenMutation)(nil)
-
-// devicetokenOption allows management of the mutation configuration using functional options.
-type devicetokenOption func(*DeviceTokenMutation)
-
-// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
-func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
-	m := &DeviceTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDeviceToken,
-		clearedFields: make(map[string]struct{}),
+	op              Op
+	typ             string
+	id              *int
+	uid             *string
+	family_space_id *string
+	name            *string
+	grade           *int
+	addgrade        *int
+	standard        *string
+	locale          *string
+	pin_hash        *string
+	archived        *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ChildProfile, error)
+	predicates      []predicate.ChildProfile
+}
+
+var _ ent.Mutation = (*ChildProfileMutation)(nil)
+
+// childprofileOption allows management of the mutation configuration using functional options.
+type childprofileOption func(*ChildProfileMutation)
+
+// newChildProfileMutation creates new mutation for the ChildProfile entity.
+func newChildProfileMutation(c config, op Op, opts ...childprofileOption) *ChildProfileMutation {
+	m := &ChildProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChildProfile,
+		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
 		opt(m)
@@ -3955,20 +4411,20 @@ func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceT
 	return m
 }
 
-// withDeviceTokenID sets the ID field of the mutation.
-func withDeviceTokenID(id int) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
+// withChildProfileID sets the ID field of the mutation.
+func withChildProfileID(id int) childprofileOption {
+	return func(m *ChildProfileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceToken
+			value *ChildProfile
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
+		m.oldValue = func(ctx context.Context) (*ChildProfile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceToken.Get(ctx, id)
+					value, err = m.Client().ChildProfile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3977,10 +4433,10 @@ func withDeviceTokenID(id int) devicetokenOption {
 	}
 }
 
-// withDeviceToken sets the old DeviceToken of the mutation.
-func withDeviceToken(node *DeviceToken) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
-		m.oldValue = func(context.Context) (*DeviceToken, error) {
+// withChildProfile sets the old ChildProfile of the mutation.
+func withChildProfile(node *ChildProfile) childprofileOption {
+	return func(m *ChildProfileMutation) {
+		m.oldValue = func(context.Context) (*ChildProfile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3989,7 +4445,7 @@ func withDeviceToken(node *DeviceToken) devicetokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceTokenMutation) Client() *Client {
+func (m ChildProfileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3997,7 +4453,7 @@ func (m DeviceTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceTokenMutation) Tx() (*Tx, error) {
+func (m ChildProfileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4008,7 +4464,7 @@ func (m DeviceTokenMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceTokenMutation) ID() (id int, exists bool) {
+func (m *ChildProfileMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4019,7 +4475,7 @@ func (m *DeviceTokenMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChildProfileMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4028,19 +4484,19 @@ func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChildProfile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *DeviceTokenMutation) SetUID(s string) {
+func (m *ChildProfileMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *DeviceTokenMutation) UID() (r string, exists bool) {
+func (m *ChildProfileMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -4048,10 +4504,10 @@ func (m *DeviceTokenMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *ChildProfileMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -4066,246 +4522,289 @@ func (m *DeviceTokenMutation) OldUID(ctx context.Context) (v string, err error)
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *DeviceTokenMutation) ResetUID() {
+func (m *ChildProfileMutation) ResetUID() {
 	m.uid = nil
 }
 
-// SetChildProfileID sets the "child_profile_id" field.
-func (m *DeviceTokenMutation) SetChildProfileID(s string) {
-	m.child_profile_id = &s
+// SetFamilySpaceID sets the "family_space_id" field.
+func (m *ChildProfileMutation) SetFamilySpaceID(s string) {
+	m.family_space_id = &s
 }
 
-// ChildProfileID returns the value of the "child_profile_id" field in the mutation.
-func (m *DeviceTokenMutation) ChildProfileID() (r string, exists bool) {
-	v := m.child_profile_id
+// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
+func (m *ChildProfileMutation) FamilySpaceID() (r string, exists bool) {
+	v := m.family_space_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChildProfileID returns the old "child_profile_id" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldChildProfileID(ctx context.Context) (v string, err error) {
+func (m *ChildProfileMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChildProfileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChildProfileID requires an ID field in the mutation")
+		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChildProfileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
 	}
-	return oldValue.ChildProfileID, nil
+	return oldValue.FamilySpaceID, nil
 }
 
-// ResetChildProfileID resets all changes to the "child_profile_id" field.
-func (m *DeviceTokenMutation) ResetChildProfileID() {
-	m.child_profile_id = nil
+// ResetFamilySpaceID resets all changes to the "family_space_id" field.
+func (m *ChildProfileMutation) ResetFamilySpaceID() {
+	m.family_space_id = nil
 }
 
-// SetFamilySpaceID sets the "family_space_id" field.
-func (m *DeviceTokenMutation) SetFamilySpaceID(s string) {
-	m.family_space_id = &s
+// SetName sets the "name" field.
+func (m *ChildProfileMutation) SetName(s string) {
+	m.name = &s
 }
 
-// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *DeviceTokenMutation) FamilySpaceID() (r string, exists bool) {
-	v := m.family_space_id
+// Name returns the value of the "name" field in the mutation.
+func (m *ChildProfileMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *ChildProfileMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.FamilySpaceID, nil
+	return oldValue.Name, nil
 }
 
-// ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *DeviceTokenMutation) ResetFamilySpaceID() {
-	m.family_space_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *ChildProfileMutation) ResetName() {
+	m.name = nil
 }
 
-// SetTokenHash sets the "token_hash" field.
-func (m *DeviceTokenMutation) SetTokenHash(s string) {
-	m.token_hash = &s
+// SetGrade sets the "grade" field.
+func (m *ChildProfileMutation) SetGrade(i int) {
+	m.grade = &i
+	m.addgrade = nil
 }
 
-// TokenHash returns the value of the "token_hash" field in the mutation.
-func (m *DeviceTokenMutation) TokenHash() (r string, exists bool) {
-	v := m.token_hash
+// Grade returns the value of the "grade" field in the mutation.
+func (m *ChildProfileMutation) Grade() (r int, exists bool) {
+	v := m.grade
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenHash returns the old "token_hash" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldGrade returns the old "grade" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+func (m *ChildProfileMutation) OldGrade(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldGrade is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+		return v, errors.New("OldGrade requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldGrade: %w", err)
 	}
-	return oldValue.TokenHash, nil
+	return oldValue.Grade, nil
 }
 
-// ResetTokenHash resets all changes to the "token_hash" field.
-func (m *DeviceTokenMutation) ResetTokenHash() {
-	m.token_hash = nil
+// AddGrade adds i to the "grade" field.
+func (m *ChildProfileMutation) AddGrade(i int) {
+	if m.addgrade != nil {
+		*m.addgrade += i
+	} else {
+		m.addgrade = &i
+	}
 }
 
-// SetDeviceLabel sets the "device_label" field.
-func (m *DeviceTokenMutation) SetDeviceLabel(s string) {
-	m.device_label = &s
+// AddedGrade returns the value that was added to the "grade" field in this mutation.
+func (m *ChildProfileMutation) AddedGrade() (r int, exists bool) {
+	v := m.addgrade
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DeviceLabel returns the value of the "device_label" field in the mutation.
-func (m *DeviceTokenMutation) DeviceLabel() (r string, exists bool) {
-	v := m.device_label
+// ResetGrade resets all changes to the "grade" field.
+func (m *ChildProfileMutation) ResetGrade() {
+	m.grade = nil
+	m.addgrade = nil
+}
+
+// SetStandard sets the "standard" field.
+func (m *ChildProfileMutation) SetStandard(s string) {
+	m.standard = &s
+}
+
+// Standard returns the value of the "standard" field in the mutation.
+func (m *ChildProfileMutation) Standard() (r string, exists bool) {
+	v := m.standard
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceLabel returns the old "device_label" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldStandard returns the old "standard" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldDeviceLabel(ctx context.Context) (v string, err error) {
+func (m *ChildProfileMutation) OldStandard(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceLabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldStandard is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceLabel requires an ID field in the mutation")
+		return v, errors.New("OldStandard requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceLabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldStandard: %w", err)
 	}
-	return oldValue.DeviceLabel, nil
+	return oldValue.Standard, nil
 }
 
-// ResetDeviceLabel resets all changes to the "device_label" field.
-func (m *DeviceTokenMutation) ResetDeviceLabel() {
-	m.device_label = nil
+// ResetStandard resets all changes to the "standard" field.
+func (m *ChildProfileMutation) ResetStandard() {
+	m.standard = nil
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *DeviceTokenMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// SetLocale sets the "locale" field.
+func (m *ChildProfileMutation) SetLocale(s string) {
+	m.locale = &s
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *DeviceTokenMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// Locale returns the value of the "locale" field in the mutation.
+func (m *ChildProfileMutation) Locale() (r string, exists bool) {
+	v := m.locale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldLocale returns the old "locale" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *ChildProfileMutation) OldLocale(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldLocale requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.Locale, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *DeviceTokenMutation) ResetRevoked() {
-	m.revoked = nil
+// ResetLocale resets all changes to the "locale" field.
+func (m *ChildProfileMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// SetLastUsedAt sets the "last_used_at" field.
-func (m *DeviceTokenMutation) SetLastUsedAt(t time.Time) {
-	m.last_used_at = &t
+// SetPinHash sets the "pin_hash" field.
+func (m *ChildProfileMutation) SetPinHash(s string) {
+	m.pin_hash = &s
 }
 
-// LastUsedAt returns the value of the "last_used_at" field in the mutation.
-func (m *DeviceTokenMutation) LastUsedAt() (r time.Time, exists bool) {
-	v := m.last_used_at
+// PinHash returns the value of the "pin_hash" field in the mutation.
+func (m *ChildProfileMutation) PinHash() (r string, exists bool) {
+	v := m.pin_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsedAt returns the old "last_used_at" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPinHash returns the old "pin_hash" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ChildProfileMutation) OldPinHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPinHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldPinHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPinHash: %w", err)
 	}
-	return oldValue.LastUsedAt, nil
+	return oldValue.PinHash, nil
 }
 
-// ClearLastUsedAt clears the value of the "last_used_at" field.
-func (m *DeviceTokenMutation) ClearLastUsedAt() {
-	m.last_used_at = nil
-	m.clearedFields[devicetoken.FieldLastUsedAt] = struct{}{}
+// ResetPinHash resets all changes to the "pin_hash" field.
+func (m *ChildProfileMutation) ResetPinHash() {
+	m.pin_hash = nil
 }
 
-// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
-func (m *DeviceTokenMutation) LastUsedAtCleared() bool {
-	_, ok := m.clearedFields[devicetoken.FieldLastUsedAt]
-	return ok
+// SetArchived sets the "archived" field.
+func (m *ChildProfileMutation) SetArchived(b bool) {
+	m.archived = &b
 }
 
-// ResetLastUsedAt resets all changes to the "last_used_at" field.
-func (m *DeviceTokenMutation) ResetLastUsedAt() {
-	m.last_used_at = nil
-	delete(m.clearedFields, devicetoken.FieldLastUsedAt)
+// Archived returns the value of the "archived" field in the mutation.
+func (m *ChildProfileMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildProfileMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *ChildProfileMutation) ResetArchived() {
+	m.archived = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DeviceTokenMutation) SetCreatedAt(t time.Time) {
+func (m *ChildProfileMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ChildProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4313,10 +4812,10 @@ func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ChildProfile entity.
+// If the ChildProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChildProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4331,19 +4830,19 @@ func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeviceTokenMutation) ResetCreatedAt() {
+func (m *ChildProfileMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the DeviceTokenMutation builder.
-func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+// Where appends a list predicates to the ChildProfileMutation builder.
+func (m *ChildProfileMutation) Where(ps ...predicate.ChildProfile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChildProfileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceToken, len(ps))
+func (m *ChildProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChildProfile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4351,48 +4850,51 @@ func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceTokenMutation) Op() Op {
+func (m *ChildProfileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceTokenMutation) SetOp(op Op) {
+func (m *ChildProfileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeviceToken).
-func (m *DeviceTokenMutation) Type() string {
+// Type returns the node type of this mutation (ChildProfile).
+func (m *ChildProfileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceTokenMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *ChildProfileMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.uid != nil {
-		fields = append(fields, devicetoken.FieldUID)
-	}
-	if m.child_profile_id != nil {
-		fields = append(fields, devicetoken.FieldChildProfileID)
+		fields = append(fields, childprofile.FieldUID)
 	}
 	if m.family_space_id != nil {
-		fields = append(fields, devicetoken.FieldFamilySpaceID)
+		fields = append(fields, childprofile.FieldFamilySpaceID)
 	}
-	if m.token_hash != nil {
-		fields = append(fields, devicetoken.FieldTokenHash)
+	if m.name != nil {
+		fields = append(fields, childprofile.FieldName)
 	}
-	if m.device_label != nil {
-		fields = append(fields, devicetoken.FieldDeviceLabel)
+	if m.grade != nil {
+		fields = append(fields, childprofile.FieldGrade)
 	}
-	if m.revoked != nil {
-		fields = append(fields, devicetoken.FieldRevoked)
+	if m.standard != nil {
+		fields = append(fields, childprofile.FieldStandard)
 	}
-	if m.last_used_at != nil {
-		fields = append(fields, devicetoken.FieldLastUsedAt)
+	if m.locale != nil {
+		fields = append(fields, childprofile.FieldLocale)
+	}
+	if m.pin_hash != nil {
+		fields = append(fields, childprofile.FieldPinHash)
+	}
+	if m.archived != nil {
+		fields = append(fields, childprofile.FieldArchived)
 	}
 	if m.created_at != nil {
-		fields = append(fields, devicetoken.FieldCreatedAt)
+		fields = append(fields, childprofile.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4400,23 +4902,25 @@ func (m *DeviceTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *ChildProfileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicetoken.FieldUID:
+	case childprofile.FieldUID:
 		return m.UID()
-	case devicetoken.FieldChildProfileID:
-		return m.ChildProfileID()
-	case devicetoken.FieldFamilySpaceID:
+	case childprofile.FieldFamilySpaceID:
 		return m.FamilySpaceID()
-	case devicetoken.FieldTokenHash:
-		return m.TokenHash()
-	case devicetoken.FieldDeviceLabel:
-		return m.DeviceLabel()
-	case devicetoken.FieldRevoked:
-		return m.Revoked()
-	case devicetoken.FieldLastUsedAt:
-		return m.LastUsedAt()
-	case devicetoken.FieldCreatedAt:
+	case childprofile.FieldName:
+		return m.Name()
+	case childprofile.FieldGrade:
+		return m.Grade()
+	case childprofile.FieldStandard:
+		return m.Standard()
+	case childprofile.FieldLocale:
+		return m.Locale()
+	case childprofile.FieldPinHash:
+		return m.PinHash()
+	case childprofile.FieldArchived:
+		return m.Archived()
+	case childprofile.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -4425,83 +4929,92 @@ func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChildProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicetoken.FieldUID:
+	case childprofile.FieldUID:
 		return m.OldUID(ctx)
-	case devicetoken.FieldChildProfileID:
-		return m.OldChildProfileID(ctx)
-	case devicetoken.FieldFamilySpaceID:
+	case childprofile.FieldFamilySpaceID:
 		return m.OldFamilySpaceID(ctx)
-	case devicetoken.FieldTokenHash:
-		return m.OldTokenHash(ctx)
-	case devicetoken.FieldDeviceLabel:
-		return m.OldDeviceLabel(ctx)
-	case devicetoken.FieldRevoked:
-		return m.OldRevoked(ctx)
-	case devicetoken.FieldLastUsedAt:
-		return m.OldLastUsedAt(ctx)
-	case devicetoken.FieldCreatedAt:
+	case childprofile.FieldName:
+		return m.OldName(ctx)
+	case childprofile.FieldGrade:
+		return m.OldGrade(ctx)
+	case childprofile.FieldStandard:
+		return m.OldStandard(ctx)
+	case childprofile.FieldLocale:
+		return m.OldLocale(ctx)
+	case childprofile.FieldPinHash:
+		return m.OldPinHash(ctx)
+	case childprofile.FieldArchived:
+		return m.OldArchived(ctx)
+	case childprofile.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
+	return nil, fmt.Errorf("unknown ChildProfile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
+func (m *ChildProfileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldUID:
+	case childprofile.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case devicetoken.FieldChildProfileID:
+	case childprofile.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChildProfileID(v)
+		m.SetFamilySpaceID(v)
 		return nil
-	case devicetoken.FieldFamilySpaceID:
+	case childprofile.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFamilySpaceID(v)
+		m.SetName(v)
 		return nil
-	case devicetoken.FieldTokenHash:
+	case childprofile.FieldGrade:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrade(v)
+		return nil
+	case childprofile.FieldStandard:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenHash(v)
+		m.SetStandard(v)
 		return nil
-	case devicetoken.FieldDeviceLabel:
+	case childprofile.FieldLocale:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceLabel(v)
+		m.SetLocale(v)
 		return nil
-	case devicetoken.FieldRevoked:
-		v, ok := value.(bool)
+	case childprofile.FieldPinHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevoked(v)
+		m.SetPinHash(v)
 		return nil
-	case devicetoken.FieldLastUsedAt:
-		v, ok := value.(time.Time)
+	case childprofile.FieldArchived:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsedAt(v)
+		m.SetArchived(v)
 		return nil
-	case devicetoken.FieldCreatedAt:
+	case childprofile.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4509,177 +5022,181 @@ func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown ChildProfile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceTokenMutation) AddedFields() []string {
-	return nil
+func (m *ChildProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.addgrade != nil {
+		fields = append(fields, childprofile.FieldGrade)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChildProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case childprofile.FieldGrade:
+		return m.AddedGrade()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
+func (m *ChildProfileMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case childprofile.FieldGrade:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGrade(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
+	return fmt.Errorf("unknown ChildProfile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceTokenMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(devicetoken.FieldLastUsedAt) {
-		fields = append(fields, devicetoken.FieldLastUsedAt)
-	}
-	return fields
+func (m *ChildProfileMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceTokenMutation) FieldCleared(name string) bool {
+func (m *ChildProfileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ClearField(name string) error {
-	switch name {
-	case devicetoken.FieldLastUsedAt:
-		m.ClearLastUsedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
+func (m *ChildProfileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChildProfile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ResetField(name string) error {
+func (m *ChildProfileMutation) ResetField(name string) error {
 	switch name {
-	case devicetoken.FieldUID:
+	case childprofile.FieldUID:
 		m.ResetUID()
 		return nil
-	case devicetoken.FieldChildProfileID:
-		m.ResetChildProfileID()
-		return nil
-	case devicetoken.FieldFamilySpaceID:
+	case childprofile.FieldFamilySpaceID:
 		m.ResetFamilySpaceID()
 		return nil
-	case devicetoken.FieldTokenHash:
-		m.ResetTokenHash()
+	case childprofile.FieldName:
+		m.ResetName()
 		return nil
-	case devicetoken.FieldDeviceLabel:
-		m.ResetDeviceLabel()
+	case childprofile.FieldGrade:
+		m.ResetGrade()
 		return nil
-	case devicetoken.FieldRevoked:
-		m.ResetRevoked()
+	case childprofile.FieldStandard:
+		m.ResetStandard()
 		return nil
-	case devicetoken.FieldLastUsedAt:
-		m.ResetLastUsedAt()
+	case childprofile.FieldLocale:
+		m.ResetLocale()
 		return nil
-	case devicetoken.FieldCreatedAt:
+	case childprofile.FieldPinHash:
+		m.ResetPinHash()
+		return nil
+	case childprofile.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case childprofile.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown ChildProfile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceTokenMutation) AddedEdges() []string {
+func (m *ChildProfileMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *ChildProfileMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceTokenMutation) RemovedEdges() []string {
+func (m *ChildProfileMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChildProfileMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceTokenMutation) ClearedEdges() []string {
+func (m *ChildProfileMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
+func (m *ChildProfileMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
+func (m *ChildProfileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChildProfile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken edge %s", name)
+func (m *ChildProfileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChildProfile edge %s", name)
 }
 
-// DiagnosisEventMutation represents an operation that mutates the DiagnosisEvent nodes in the graph.
-type DiagnosisEventMutation struct {
+// CreditEntryMutation represents an operation that mutates the CreditEntry nodes in the graph.
+type CreditEntryMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	sequence         *int64
-	addsequence      *int64
-	timestamp        *time.Time
-	owner_id         *string
-	session_id       *string
-	skill_id         *string
-	question_text    *string
-	correct_answer   *string
-	learner_answer   *string
-	category         *string
-	misconception_id *string
-	confidence       *float64
-	addconfidence    *float64
-	classifier_name  *string
-	reasoning        *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*DiagnosisEvent, error)
-	predicates       []predicate.DiagnosisEvent
+	op              Op
+	typ             string
+	id              *int
+	uid             *string
+	family_space_id *string
+	kind            *string
+	amount          *int
+	addamount       *int
+	remaining       *int
+	addremaining    *int
+	source          *string
+	expires_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*CreditEntry, error)
+	predicates      []predicate.CreditEntry
 }
 
-var _ ent.Mutation = (*DiagnosisEventMutation)(nil)
+var _ ent.Mutation = (*CreditEntryMutation)(nil)
 
-// diagnosiseventOption allows management of the mutation configuration using functional options.
-type diagnosiseventOption func(*DiagnosisEventMutation)
+// creditentryOption allows management of the mutation configuration using functional options.
+type creditentryOption func(*CreditEntryMutation)
 
-// newDiagnosisEventMutation creates new mutation for the DiagnosisEvent entity.
-func newDiagnosisEventMutation(c config, op Op, opts ...diagnosiseventOption) *DiagnosisEventMutation {
-	m := &DiagnosisEventMutation{
+// newCreditEntryMutation creates new mutation for the CreditEntry entity.
+func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditEntryMutation {
+	m := &CreditEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDiagnosisEvent,
+		typ:           TypeCreditEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4688,20 +5205,20 @@ func newDiagnosisEventMutation(c config, op Op, opts ...diagnosiseventOption) *D
 	return m
 }
 
-// withDiagnosisEventID sets the ID field of the mutation.
-func withDiagnosisEventID(id int) diagnosiseventOption {
-	return func(m *DiagnosisEventMutation) {
+// withCreditEntryID sets the ID field of the mutation.
+func withCreditEntryID(id int) creditentryOption {
+	return func(m *CreditEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DiagnosisEvent
+			value *CreditEntry
 		)
-		m.oldValue = func(ctx context.Context) (*DiagnosisEvent, error) {
+		m.oldValue = func(ctx context.Context) (*CreditEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DiagnosisEvent.Get(ctx, id)
+					value, err = m.Client().CreditEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4710,10 +5227,10 @@ func withDiagnosisEventID(id int) diagnosiseventOption {
 	}
 }
 
-// withDiagnosisEvent sets the old DiagnosisEvent of the mutation.
-func withDiagnosisEvent(node *DiagnosisEvent) diagnosiseventOption {
-	return func(m *DiagnosisEventMutation) {
-		m.oldValue = func(context.Context) (*DiagnosisEvent, error) {
+// withCreditEntry sets the old CreditEntry of the mutation.
+func withCreditEntry(node *CreditEntry) creditentryOption {
+	return func(m *CreditEntryMutation) {
+		m.oldValue = func(context.Context) (*CreditEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4722,7 +5239,7 @@ func withDiagnosisEvent(node *DiagnosisEvent) diagnosiseventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiagnosisEventMutation) Client() *Client {
+func (m CreditEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4730,7 +5247,7 @@ func (m DiagnosisEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiagnosisEventMutation) Tx() (*Tx, error) {
+func (m CreditEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4741,7 +5258,7 @@ func (m DiagnosisEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiagnosisEventMutation) ID() (id int, exists bool) {
+func (m *CreditEntryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4752,7 +5269,7 @@ func (m *DiagnosisEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiagnosisEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CreditEntryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4761,619 +5278,411 @@ func (m *DiagnosisEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DiagnosisEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CreditEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSequence sets the "sequence" field.
-func (m *DiagnosisEventMutation) SetSequence(i int64) {
-	m.sequence = &i
-	m.addsequence = nil
+// SetUID sets the "uid" field.
+func (m *CreditEntryMutation) SetUID(s string) {
+	m.uid = &s
 }
 
-// Sequence returns the value of the "sequence" field in the mutation.
-func (m *DiagnosisEventMutation) Sequence() (r int64, exists bool) {
-	v := m.sequence
+// UID returns the value of the "uid" field in the mutation.
+func (m *CreditEntryMutation) UID() (r string, exists bool) {
+	v := m.uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *CreditEntryMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequence requires an ID field in the mutation")
+		return v, errors.New("OldUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
-	}
-	return oldValue.Sequence, nil
-}
-
-// AddSequence adds i to the "sequence" field.
-func (m *DiagnosisEventMutation) AddSequence(i int64) {
-	if m.addsequence != nil {
-		*m.addsequence += i
-	} else {
-		m.addsequence = &i
-	}
-}
-
-// AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *DiagnosisEventMutation) AddedSequence() (r int64, exists bool) {
-	v := m.addsequence
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
 	}
-	return *v, true
+	return oldValue.UID, nil
 }
 
-// ResetSequence resets all changes to the "sequence" field.
-func (m *DiagnosisEventMutation) ResetSequence() {
-	m.sequence = nil
-	m.addsequence = nil
+// ResetUID resets all changes to the "uid" field.
+func (m *CreditEntryMutation) ResetUID() {
+	m.uid = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *DiagnosisEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetFamilySpaceID sets the "family_space_id" field.
+func (m *CreditEntryMutation) SetFamilySpaceID(s string) {
+	m.family_space_id = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *DiagnosisEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
+func (m *CreditEntryMutation) FamilySpaceID() (r string, exists bool) {
+	v := m.family_space_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *CreditEntryMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.FamilySpaceID, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *DiagnosisEventMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetFamilySpaceID resets all changes to the "family_space_id" field.
+func (m *CreditEntryMutation) ResetFamilySpaceID() {
+	m.family_space_id = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *DiagnosisEventMutation) SetOwnerID(s string) {
-	m.owner_id = &s
+// SetKind sets the "kind" field.
+func (m *CreditEntryMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *DiagnosisEventMutation) OwnerID() (r string, exists bool) {
-	v := m.owner_id
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CreditEntryMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+func (m *CreditEntryMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Kind, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *DiagnosisEventMutation) ResetOwnerID() {
-	m.owner_id = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *CreditEntryMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *DiagnosisEventMutation) SetSessionID(s string) {
-	m.session_id = &s
+// SetAmount sets the "amount" field.
+func (m *CreditEntryMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *DiagnosisEventMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditEntryMutation) Amount() (r int, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *CreditEntryMutation) OldAmount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.SessionID, nil
-}
-
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *DiagnosisEventMutation) ResetSessionID() {
-	m.session_id = nil
+	return oldValue.Amount, nil
 }
 
-// SetSkillID sets the "skill_id" field.
-func (m *DiagnosisEventMutation) SetSkillID(s string) {
-	m.skill_id = &s
+// AddAmount adds i to the "amount" field.
+func (m *CreditEntryMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
 }
 
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *DiagnosisEventMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditEntryMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkillID returns the old "skill_id" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
-	}
-	return oldValue.SkillID, nil
-}
-
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *DiagnosisEventMutation) ResetSkillID() {
-	m.skill_id = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditEntryMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
 }
 
-// SetQuestionText sets the "question_text" field.
-func (m *DiagnosisEventMutation) SetQuestionText(s string) {
-	m.question_text = &s
+// SetRemaining sets the "remaining" field.
+func (m *CreditEntryMutation) SetRemaining(i int) {
+	m.remaining = &i
+	m.addremaining = nil
 }
 
-// QuestionText returns the value of the "question_text" field in the mutation.
-func (m *DiagnosisEventMutation) QuestionText() (r string, exists bool) {
-	v := m.question_text
+// Remaining returns the value of the "remaining" field in the mutation.
+func (m *CreditEntryMutation) Remaining() (r int, exists bool) {
+	v := m.remaining
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionText returns the old "question_text" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldRemaining returns the old "remaining" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldQuestionText(ctx context.Context) (v string, err error) {
+func (m *CreditEntryMutation) OldRemaining(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemaining is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionText requires an ID field in the mutation")
+		return v, errors.New("OldRemaining requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemaining: %w", err)
 	}
-	return oldValue.QuestionText, nil
-}
-
-// ResetQuestionText resets all changes to the "question_text" field.
-func (m *DiagnosisEventMutation) ResetQuestionText() {
-	m.question_text = nil
+	return oldValue.Remaining, nil
 }
 
-// SetCorrectAnswer sets the "correct_answer" field.
-func (m *DiagnosisEventMutation) SetCorrectAnswer(s string) {
-	m.correct_answer = &s
+// AddRemaining adds i to the "remaining" field.
+func (m *CreditEntryMutation) AddRemaining(i int) {
+	if m.addremaining != nil {
+		*m.addremaining += i
+	} else {
+		m.addremaining = &i
+	}
 }
 
-// CorrectAnswer returns the value of the "correct_answer" field in the mutation.
-func (m *DiagnosisEventMutation) CorrectAnswer() (r string, exists bool) {
-	v := m.correct_answer
+// AddedRemaining returns the value that was added to the "remaining" field in this mutation.
+func (m *CreditEntryMutation) AddedRemaining() (r int, exists bool) {
+	v := m.addremaining
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCorrectAnswer returns the old "correct_answer" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldCorrectAnswer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrectAnswer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrectAnswer requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrectAnswer: %w", err)
-	}
-	return oldValue.CorrectAnswer, nil
-}
-
-// ResetCorrectAnswer resets all changes to the "correct_answer" field.
-func (m *DiagnosisEventMutation) ResetCorrectAnswer() {
-	m.correct_answer = nil
+// ResetRemaining resets all changes to the "remaining" field.
+func (m *CreditEntryMutation) ResetRemaining() {
+	m.remaining = nil
+	m.addremaining = nil
 }
 
-// SetLearnerAnswer sets the "learner_answer" field.
-func (m *DiagnosisEventMutation) SetLearnerAnswer(s string) {
-	m.learner_answer = &s
+// SetSource sets the "source" field.
+func (m *CreditEntryMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// LearnerAnswer returns the value of the "learner_answer" field in the mutation.
-func (m *DiagnosisEventMutation) LearnerAnswer() (r string, exists bool) {
-	v := m.learner_answer
+// Source returns the value of the "source" field in the mutation.
+func (m *CreditEntryMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLearnerAnswer returns the old "learner_answer" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldLearnerAnswer(ctx context.Context) (v string, err error) {
+func (m *CreditEntryMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLearnerAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLearnerAnswer requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLearnerAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.LearnerAnswer, nil
+	return oldValue.Source, nil
 }
 
-// ResetLearnerAnswer resets all changes to the "learner_answer" field.
-func (m *DiagnosisEventMutation) ResetLearnerAnswer() {
-	m.learner_answer = nil
+// ResetSource resets all changes to the "source" field.
+func (m *CreditEntryMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *DiagnosisEventMutation) SetCategory(s string) {
-	m.category = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *CreditEntryMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *DiagnosisEventMutation) Category() (r string, exists bool) {
-	v := m.category
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CreditEntryMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *CreditEntryMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *DiagnosisEventMutation) ResetCategory() {
-	m.category = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *CreditEntryMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[creditentry.FieldExpiresAt] = struct{}{}
 }
 
-// SetMisconceptionID sets the "misconception_id" field.
-func (m *DiagnosisEventMutation) SetMisconceptionID(s string) {
-	m.misconception_id = &s
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *CreditEntryMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldExpiresAt]
+	return ok
 }
 
-// MisconceptionID returns the value of the "misconception_id" field in the mutation.
-func (m *DiagnosisEventMutation) MisconceptionID() (r string, exists bool) {
-	v := m.misconception_id
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CreditEntryMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, creditentry.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMisconceptionID returns the old "misconception_id" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldMisconceptionID(ctx context.Context) (v *string, err error) {
+func (m *CreditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMisconceptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMisconceptionID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMisconceptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.MisconceptionID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearMisconceptionID clears the value of the "misconception_id" field.
-func (m *DiagnosisEventMutation) ClearMisconceptionID() {
-	m.misconception_id = nil
-	m.clearedFields[diagnosisevent.FieldMisconceptionID] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// MisconceptionIDCleared returns if the "misconception_id" field was cleared in this mutation.
-func (m *DiagnosisEventMutation) MisconceptionIDCleared() bool {
-	_, ok := m.clearedFields[diagnosisevent.FieldMisconceptionID]
-	return ok
+// Where appends a list predicates to the CreditEntryMutation builder.
+func (m *CreditEntryMutation) Where(ps ...predicate.CreditEntry) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetMisconceptionID resets all changes to the "misconception_id" field.
-func (m *DiagnosisEventMutation) ResetMisconceptionID() {
-	m.misconception_id = nil
-	delete(m.clearedFields, diagnosisevent.FieldMisconceptionID)
-}
-
-// SetConfidence sets the "confidence" field.
-func (m *DiagnosisEventMutation) SetConfidence(f float64) {
-	m.confidence = &f
-	m.addconfidence = nil
-}
-
-// Confidence returns the value of the "confidence" field in the mutation.
-func (m *DiagnosisEventMutation) Confidence() (r float64, exists bool) {
-	v := m.confidence
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldConfidence returns the old "confidence" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldConfidence(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfidence is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfidence requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfidence: %w", err)
-	}
-	return oldValue.Confidence, nil
-}
-
-// AddConfidence adds f to the "confidence" field.
-func (m *DiagnosisEventMutation) AddConfidence(f float64) {
-	if m.addconfidence != nil {
-		*m.addconfidence += f
-	} else {
-		m.addconfidence = &f
-	}
-}
-
-// AddedConfidence returns the value that was added to the "confidence" field in this mutation.
-func (m *DiagnosisEventMutation) AddedConfidence() (r float64, exists bool) {
-	v := m.addconfidence
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetConfidence resets all changes to the "confidence" field.
-func (m *DiagnosisEventMutation) ResetConfidence() {
-	m.confidence = nil
-	m.addconfidence = nil
-}
-
-// SetClassifierName sets the "classifier_name" field.
-func (m *DiagnosisEventMutation) SetClassifierName(s string) {
-	m.classifier_name = &s
-}
-
-// ClassifierName returns the value of the "classifier_name" field in the mutation.
-func (m *DiagnosisEventMutation) ClassifierName() (r string, exists bool) {
-	v := m.classifier_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldClassifierName returns the old "classifier_name" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldClassifierName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClassifierName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClassifierName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClassifierName: %w", err)
-	}
-	return oldValue.ClassifierName, nil
-}
-
-// ResetClassifierName resets all changes to the "classifier_name" field.
-func (m *DiagnosisEventMutation) ResetClassifierName() {
-	m.classifier_name = nil
-}
-
-// SetReasoning sets the "reasoning" field.
-func (m *DiagnosisEventMutation) SetReasoning(s string) {
-	m.reasoning = &s
-}
-
-// Reasoning returns the value of the "reasoning" field in the mutation.
-func (m *DiagnosisEventMutation) Reasoning() (r string, exists bool) {
-	v := m.reasoning
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReasoning returns the old "reasoning" field's value of the DiagnosisEvent entity.
-// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiagnosisEventMutation) OldReasoning(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReasoning is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReasoning requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReasoning: %w", err)
-	}
-	return oldValue.Reasoning, nil
-}
-
-// ClearReasoning clears the value of the "reasoning" field.
-func (m *DiagnosisEventMutation) ClearReasoning() {
-	m.reasoning = nil
-	m.clearedFields[diagnosisevent.FieldReasoning] = struct{}{}
-}
-
-// ReasoningCleared returns if the "reasoning" field was cleared in this mutation.
-func (m *DiagnosisEventMutation) ReasoningCleared() bool {
-	_, ok := m.clearedFields[diagnosisevent.FieldReasoning]
-	return ok
-}
-
-// ResetReasoning resets all changes to the "reasoning" field.
-func (m *DiagnosisEventMutation) ResetReasoning() {
-	m.reasoning = nil
-	delete(m.clearedFields, diagnosisevent.FieldReasoning)
-}
-
-// Where appends a list predicates to the DiagnosisEventMutation builder.
-func (m *DiagnosisEventMutation) Where(ps ...predicate.DiagnosisEvent) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DiagnosisEventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiagnosisEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DiagnosisEvent, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// WhereP appends storage-level predicates to the CreditEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *DiagnosisEventMutation) Op() Op {
+func (m *CreditEntryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DiagnosisEventMutation) SetOp(op Op) {
+func (m *CreditEntryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DiagnosisEvent).
-func (m *DiagnosisEventMutation) Type() string {
+// Type returns the node type of this mutation (CreditEntry).
+func (m *CreditEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DiagnosisEventMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.sequence != nil {
-		fields = append(fields, diagnosisevent.FieldSequence)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, diagnosisevent.FieldTimestamp)
-	}
-	if m.owner_id != nil {
-		fields = append(fields, diagnosisevent.FieldOwnerID)
-	}
-	if m.session_id != nil {
-		fields = append(fields, diagnosisevent.FieldSessionID)
-	}
-	if m.skill_id != nil {
-		fields = append(fields, diagnosisevent.FieldSkillID)
-	}
-	if m.question_text != nil {
-		fields = append(fields, diagnosisevent.FieldQuestionText)
+func (m *CreditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.uid != nil {
+		fields = append(fields, creditentry.FieldUID)
 	}
-	if m.correct_answer != nil {
-		fields = append(fields, diagnosisevent.FieldCorrectAnswer)
+	if m.family_space_id != nil {
+		fields = append(fields, creditentry.FieldFamilySpaceID)
 	}
-	if m.learner_answer != nil {
-		fields = append(fields, diagnosisevent.FieldLearnerAnswer)
+	if m.kind != nil {
+		fields = append(fields, creditentry.FieldKind)
 	}
-	if m.category != nil {
-		fields = append(fields, diagnosisevent.FieldCategory)
+	if m.amount != nil {
+		fields = append(fields, creditentry.FieldAmount)
 	}
-	if m.misconception_id != nil {
-		fields = append(fields, diagnosisevent.FieldMisconceptionID)
+	if m.remaining != nil {
+		fields = append(fields, creditentry.FieldRemaining)
 	}
-	if m.confidence != nil {
-		fields = append(fields, diagnosisevent.FieldConfidence)
+	if m.source != nil {
+		fields = append(fields, creditentry.FieldSource)
 	}
-	if m.classifier_name != nil {
-		fields = append(fields, diagnosisevent.FieldClassifierName)
+	if m.expires_at != nil {
+		fields = append(fields, creditentry.FieldExpiresAt)
 	}
-	if m.reasoning != nil {
-		fields = append(fields, diagnosisevent.FieldReasoning)
+	if m.created_at != nil {
+		fields = append(fields, creditentry.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5381,34 +5690,24 @@ func (m *DiagnosisEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiagnosisEventMutation) Field(name string) (ent.Value, bool) {
+func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		return m.Sequence()
-	case diagnosisevent.FieldTimestamp:
-		return m.Timestamp()
-	case diagnosisevent.FieldOwnerID:
-		return m.OwnerID()
-	case diagnosisevent.FieldSessionID:
-		return m.SessionID()
-	case diagnosisevent.FieldSkillID:
-		return m.SkillID()
-	case diagnosisevent.FieldQuestionText:
-		return m.QuestionText()
-	case diagnosisevent.FieldCorrectAnswer:
-		return m.CorrectAnswer()
-	case diagnosisevent.FieldLearnerAnswer:
-		return m.LearnerAnswer()
-	case diagnosisevent.FieldCategory:
-		return m.Category()
-	case diagnosisevent.FieldMisconceptionID:
-		return m.MisconceptionID()
-	case diagnosisevent.FieldConfidence:
-		return m.Confidence()
-	case diagnosisevent.FieldClassifierName:
-		return m.ClassifierName()
-	case diagnosisevent.FieldReasoning:
-		return m.Reasoning()
+	case creditentry.FieldUID:
+		return m.UID()
+	case creditentry.FieldFamilySpaceID:
+		return m.FamilySpaceID()
+	case creditentry.FieldKind:
+		return m.Kind()
+	case creditentry.FieldAmount:
+		return m.Amount()
+	case creditentry.FieldRemaining:
+		return m.Remaining()
+	case creditentry.FieldSource:
+		return m.Source()
+	case creditentry.FieldExpiresAt:
+		return m.ExpiresAt()
+	case creditentry.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -5416,147 +5715,102 @@ func (m *DiagnosisEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiagnosisEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CreditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		return m.OldSequence(ctx)
-	case diagnosisevent.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case diagnosisevent.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case diagnosisevent.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case diagnosisevent.FieldSkillID:
-		return m.OldSkillID(ctx)
-	case diagnosisevent.FieldQuestionText:
-		return m.OldQuestionText(ctx)
-	case diagnosisevent.FieldCorrectAnswer:
-		return m.OldCorrectAnswer(ctx)
-	case diagnosisevent.FieldLearnerAnswer:
-		return m.OldLearnerAnswer(ctx)
-	case diagnosisevent.FieldCategory:
-		return m.OldCategory(ctx)
-	case diagnosisevent.FieldMisconceptionID:
-		return m.OldMisconceptionID(ctx)
-	case diagnosisevent.FieldConfidence:
-		return m.OldConfidence(ctx)
-	case diagnosisevent.FieldClassifierName:
-		return m.OldClassifierName(ctx)
-	case diagnosisevent.FieldReasoning:
-		return m.OldReasoning(ctx)
+	case creditentry.FieldUID:
+		return m.OldUID(ctx)
+	case creditentry.FieldFamilySpaceID:
+		return m.OldFamilySpaceID(ctx)
+	case creditentry.FieldKind:
+		return m.OldKind(ctx)
+	case creditentry.FieldAmount:
+		return m.OldAmount(ctx)
+	case creditentry.FieldRemaining:
+		return m.OldRemaining(ctx)
+	case creditentry.FieldSource:
+		return m.OldSource(ctx)
+	case creditentry.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case creditentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DiagnosisEvent field %s", name)
+	return nil, fmt.Errorf("unknown CreditEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiagnosisEventMutation) SetField(name string, value ent.Value) error {
+func (m *CreditEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSequence(v)
-		return nil
-	case diagnosisevent.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
-		return nil
-	case diagnosisevent.FieldOwnerID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
-	case diagnosisevent.FieldSessionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionID(v)
-		return nil
-	case diagnosisevent.FieldSkillID:
+	case creditentry.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillID(v)
+		m.SetUID(v)
 		return nil
-	case diagnosisevent.FieldQuestionText:
+	case creditentry.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionText(v)
+		m.SetFamilySpaceID(v)
 		return nil
-	case diagnosisevent.FieldCorrectAnswer:
+	case creditentry.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCorrectAnswer(v)
+		m.SetKind(v)
 		return nil
-	case diagnosisevent.FieldLearnerAnswer:
-		v, ok := value.(string)
+	case creditentry.FieldAmount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLearnerAnswer(v)
+		m.SetAmount(v)
 		return nil
-	case diagnosisevent.FieldCategory:
-		v, ok := value.(string)
+	case creditentry.FieldRemaining:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetRemaining(v)
 		return nil
-	case diagnosisevent.FieldMisconceptionID:
+	case creditentry.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMisconceptionID(v)
-		return nil
-	case diagnosisevent.FieldConfidence:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConfidence(v)
+		m.SetSource(v)
 		return nil
-	case diagnosisevent.FieldClassifierName:
-		v, ok := value.(string)
+	case creditentry.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClassifierName(v)
+		m.SetExpiresAt(v)
 		return nil
-	case diagnosisevent.FieldReasoning:
-		v, ok := value.(string)
+	case creditentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReasoning(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiagnosisEvent field %s", name)
+	return fmt.Errorf("unknown CreditEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiagnosisEventMutation) AddedFields() []string {
+func (m *CreditEntryMutation) AddedFields() []string {
 	var fields []string
-	if m.addsequence != nil {
-		fields = append(fields, diagnosisevent.FieldSequence)
+	if m.addamount != nil {
+		fields = append(fields, creditentry.FieldAmount)
 	}
-	if m.addconfidence != nil {
-		fields = append(fields, diagnosisevent.FieldConfidence)
+	if m.addremaining != nil {
+		fields = append(fields, creditentry.FieldRemaining)
 	}
 	return fields
 }
@@ -5564,12 +5818,12 @@ func (m *DiagnosisEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiagnosisEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CreditEntryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		return m.AddedSequence()
-	case diagnosisevent.FieldConfidence:
-		return m.AddedConfidence()
+	case creditentry.FieldAmount:
+		return m.AddedAmount()
+	case creditentry.FieldRemaining:
+		return m.AddedRemaining()
 	}
 	return nil, false
 }
@@ -5577,183 +5831,165 @@ func (m *DiagnosisEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiagnosisEventMutation) AddField(name string, value ent.Value) error {
+func (m *CreditEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		v, ok := value.(int64)
+	case creditentry.FieldAmount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSequence(v)
+		m.AddAmount(v)
 		return nil
-	case diagnosisevent.FieldConfidence:
-		v, ok := value.(float64)
+	case creditentry.FieldRemaining:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConfidence(v)
+		m.AddRemaining(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiagnosisEvent numeric field %s", name)
+	return fmt.Errorf("unknown CreditEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiagnosisEventMutation) ClearedFields() []string {
+func (m *CreditEntryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(diagnosisevent.FieldMisconceptionID) {
-		fields = append(fields, diagnosisevent.FieldMisconceptionID)
-	}
-	if m.FieldCleared(diagnosisevent.FieldReasoning) {
-		fields = append(fields, diagnosisevent.FieldReasoning)
+	if m.FieldCleared(creditentry.FieldExpiresAt) {
+		fields = append(fields, creditentry.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiagnosisEventMutation) FieldCleared(name string) bool {
+func (m *CreditEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiagnosisEventMutation) ClearField(name string) error {
+func (m *CreditEntryMutation) ClearField(name string) error {
 	switch name {
-	case diagnosisevent.FieldMisconceptionID:
-		m.ClearMisconceptionID()
-		return nil
-	case diagnosisevent.FieldReasoning:
-		m.ClearReasoning()
+	case creditentry.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DiagnosisEvent nullable field %s", name)
+	return fmt.Errorf("unknown CreditEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiagnosisEventMutation) ResetField(name string) error {
+func (m *CreditEntryMutation) ResetField(name string) error {
 	switch name {
-	case diagnosisevent.FieldSequence:
-		m.ResetSequence()
-		return nil
-	case diagnosisevent.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case diagnosisevent.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case diagnosisevent.FieldSessionID:
-		m.ResetSessionID()
-		return nil
-	case diagnosisevent.FieldSkillID:
-		m.ResetSkillID()
-		return nil
-	case diagnosisevent.FieldQuestionText:
-		m.ResetQuestionText()
+	case creditentry.FieldUID:
+		m.ResetUID()
 		return nil
-	case diagnosisevent.FieldCorrectAnswer:
-		m.ResetCorrectAnswer()
+	case creditentry.FieldFamilySpaceID:
+		m.ResetFamilySpaceID()
 		return nil
-	case diagnosisevent.FieldLearnerAnswer:
-		m.ResetLearnerAnswer()
+	case creditentry.FieldKind:
+		m.ResetKind()
 		return nil
-	case diagnosisevent.FieldCategory:
-		m.ResetCategory()
+	case creditentry.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case diagnosisevent.FieldMisconceptionID:
-		m.ResetMisconceptionID()
+	case creditentry.FieldRemaining:
+		m.ResetRemaining()
 		return nil
-	case diagnosisevent.FieldConfidence:
-		m.ResetConfidence()
+	case creditentry.FieldSource:
+		m.ResetSource()
 		return nil
-	case diagnosisevent.FieldClassifierName:
-		m.ResetClassifierName()
+	case creditentry.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case diagnosisevent.FieldReasoning:
-		m.ResetReasoning()
+	case creditentry.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DiagnosisEvent field %s", name)
+	return fmt.Errorf("unknown CreditEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiagnosisEventMutation) AddedEdges() []string {
+func (m *CreditEntryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiagnosisEventMutation) AddedIDs(name string) []ent.Value {
+func (m *CreditEntryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiagnosisEventMutation) RemovedEdges() []string {
+func (m *CreditEntryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiagnosisEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *CreditEntryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiagnosisEventMutation) ClearedEdges() []string {
+func (m *CreditEntryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiagnosisEventMutation) EdgeCleared(name string) bool {
+func (m *CreditEntryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiagnosisEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DiagnosisEvent unique edge %s", name)
+func (m *CreditEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CreditEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiagnosisEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DiagnosisEvent edge %s", name)
+func (m *CreditEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CreditEntry edge %s", name)
 }
 
-// FamilyMemberMutation represents an operation that mutates the FamilyMember nodes in the graph.
-type FamilyMemberMutation struct {
+// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
+type DeviceTokenMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uid             *string
-	family_space_id *string
-	account_id      *string
-	role            *string
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*FamilyMember, error)
-	predicates      []predicate.FamilyMember
+	op               Op
+	typ              string
+	id               *int
+	uid              *string
+	child_profile_id *string
+	family_space_id  *string
+	token_hash       *string
+	device_label     *string
+	revoked          *bool
+	last_used_at     *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*DeviceToken, error)
+	predicates       []predicate.DeviceToken
 }
 
-var _ ent.Mutation = (*FamilyMemberMutation)(nil)
+var _ ent.Mutation = (*DeviceTokenMutation)(nil)
 
-// familymemberOption allows management of the mutation configuration using functional options.
-type familymemberOption func(*FamilyMemberMutation)
+// devicetokenOption allows management of the mutation configuration using functional options.
+type devicetokenOption func(*DeviceTokenMutation)
 
-// newFamilyMemberMutation creates new mutation for the FamilyMember entity.
-func newFamilyMemberMutation(c config, op Op, opts ...familymemberOption) *FamilyMemberMutation {
-	m := &FamilyMemberMutation{
+// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
+func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
+	m := &DeviceTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFamilyMember,
+		typ:           TypeDeviceToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5762,20 +5998,20 @@ func newFamilyMemberMutation(c config, op Op, opts ...familymemberOption) *Famil
 	return m
 }
 
-// withFamilyMemberID sets the ID field of the mutation.
-func withFamilyMemberID(id int) familymemberOption {
-	return func(m *FamilyMemberMutation) {
+// withDeviceTokenID sets the ID field of the mutation.
+func withDeviceTokenID(id int) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FamilyMember
+			value *DeviceToken
 		)
-		m.oldValue = func(ctx context.Context) (*FamilyMember, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FamilyMember.Get(ctx, id)
+					value, err = m.Client().DeviceToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5784,10 +6020,10 @@ func withFamilyMemberID(id int) familymemberOption {
 	}
 }
 
-// withFamilyMember sets the old FamilyMember of the mutation.
-func withFamilyMember(node *FamilyMember) familymemberOption {
-	return func(m *FamilyMemberMutation) {
-		m.oldValue = func(context.Context) (*FamilyMember, error) {
+// withDeviceToken sets the old DeviceToken of the mutation.
+func withDeviceToken(node *DeviceToken) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		m.oldValue = func(context.Context) (*DeviceToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5796,7 +6032,7 @@ func withFamilyMember(node *FamilyMember) familymemberOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FamilyMemberMutation) Client() *Client {
+func (m DeviceTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5804,7 +6040,7 @@ func (m FamilyMemberMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FamilyMemberMutation) Tx() (*Tx, error) {
+func (m DeviceTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5815,7 +6051,7 @@ func (m FamilyMemberMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FamilyMemberMutation) ID() (id int, exists bool) {
+func (m *DeviceTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5826,7 +6062,7 @@ func (m *FamilyMemberMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FamilyMemberMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5835,19 +6071,19 @@ func (m *FamilyMemberMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FamilyMember.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *FamilyMemberMutation) SetUID(s string) {
+func (m *DeviceTokenMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *FamilyMemberMutation) UID() (r string, exists bool) {
+func (m *DeviceTokenMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -5855,10 +6091,10 @@ func (m *FamilyMemberMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the FamilyMember entity.
-// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilyMemberMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -5873,17 +6109,53 @@ func (m *FamilyMemberMutation) OldUID(ctx context.Context) (v string, err error)
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *FamilyMemberMutation) ResetUID() {
+func (m *DeviceTokenMutation) ResetUID() {
 	m.uid = nil
 }
 
+// SetChildProfileID sets the "child_profile_id" field.
+func (m *DeviceTokenMutation) SetChildProfileID(s string) {
+	m.child_profile_id = &s
+}
+
+// ChildProfileID returns the value of the "child_profile_id" field in the mutation.
+func (m *DeviceTokenMutation) ChildProfileID() (r string, exists bool) {
+	v := m.child_profile_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChildProfileID returns the old "child_profile_id" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldChildProfileID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChildProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChildProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChildProfileID: %w", err)
+	}
+	return oldValue.ChildProfileID, nil
+}
+
+// ResetChildProfileID resets all changes to the "child_profile_id" field.
+func (m *DeviceTokenMutation) ResetChildProfileID() {
+	m.child_profile_id = nil
+}
+
 // SetFamilySpaceID sets the "family_space_id" field.
-func (m *FamilyMemberMutation) SetFamilySpaceID(s string) {
+func (m *DeviceTokenMutation) SetFamilySpaceID(s string) {
 	m.family_space_id = &s
 }
 
 // FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *FamilyMemberMutation) FamilySpaceID() (r string, exists bool) {
+func (m *DeviceTokenMutation) FamilySpaceID() (r string, exists bool) {
 	v := m.family_space_id
 	if v == nil {
 		return
@@ -5891,10 +6163,10 @@ func (m *FamilyMemberMutation) FamilySpaceID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the FamilyMember entity.
-// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilyMemberMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
@@ -5909,167 +6181,261 @@ func (m *FamilyMemberMutation) OldFamilySpaceID(ctx context.Context) (v string,
 }
 
 // ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *FamilyMemberMutation) ResetFamilySpaceID() {
+func (m *DeviceTokenMutation) ResetFamilySpaceID() {
 	m.family_space_id = nil
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *FamilyMemberMutation) SetAccountID(s string) {
-	m.account_id = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *DeviceTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *FamilyMemberMutation) AccountID() (r string, exists bool) {
-	v := m.account_id
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *DeviceTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccountID returns the old "account_id" field's value of the FamilyMember entity.
-// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilyMemberMutation) OldAccountID(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.AccountID, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *FamilyMemberMutation) ResetAccountID() {
-	m.account_id = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *DeviceTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetRole sets the "role" field.
-func (m *FamilyMemberMutation) SetRole(s string) {
-	m.role = &s
+// SetDeviceLabel sets the "device_label" field.
+func (m *DeviceTokenMutation) SetDeviceLabel(s string) {
+	m.device_label = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *FamilyMemberMutation) Role() (r string, exists bool) {
-	v := m.role
+// DeviceLabel returns the value of the "device_label" field in the mutation.
+func (m *DeviceTokenMutation) DeviceLabel() (r string, exists bool) {
+	v := m.device_label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the FamilyMember entity.
-// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceLabel returns the old "device_label" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilyMemberMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldDeviceLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldDeviceLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceLabel: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.DeviceLabel, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *FamilyMemberMutation) ResetRole() {
-	m.role = nil
+// ResetDeviceLabel resets all changes to the "device_label" field.
+func (m *DeviceTokenMutation) ResetDeviceLabel() {
+	m.device_label = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FamilyMemberMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRevoked sets the "revoked" field.
+func (m *DeviceTokenMutation) SetRevoked(b bool) {
+	m.revoked = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FamilyMemberMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *DeviceTokenMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FamilyMember entity.
-// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilyMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Revoked, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FamilyMemberMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *DeviceTokenMutation) ResetRevoked() {
+	m.revoked = nil
 }
 
-// Where appends a list predicates to the FamilyMemberMutation builder.
-func (m *FamilyMemberMutation) Where(ps ...predicate.FamilyMember) {
-	m.predicates = append(m.predicates, ps...)
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *DeviceTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// WhereP appends storage-level predicates to the FamilyMemberMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FamilyMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FamilyMember, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *DeviceTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *FamilyMemberMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *FamilyMemberMutation) SetOp(op Op) {
-	m.op = op
+	return *v, true
 }
 
-// Type returns the node type of this mutation (FamilyMember).
-func (m *FamilyMemberMutation) Type() string {
+// OldLastUsedAt returns the old "last_used_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *DeviceTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[devicetoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *DeviceTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[devicetoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *DeviceTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, devicetoken.FieldLastUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeviceTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeviceTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the DeviceTokenMutation builder.
+func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceToken).
+func (m *DeviceTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FamilyMemberMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *DeviceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.uid != nil {
-		fields = append(fields, familymember.FieldUID)
+		fields = append(fields, devicetoken.FieldUID)
+	}
+	if m.child_profile_id != nil {
+		fields = append(fields, devicetoken.FieldChildProfileID)
 	}
 	if m.family_space_id != nil {
-		fields = append(fields, familymember.FieldFamilySpaceID)
+		fields = append(fields, devicetoken.FieldFamilySpaceID)
 	}
-	if m.account_id != nil {
-		fields = append(fields, familymember.FieldAccountID)
+	if m.token_hash != nil {
+		fields = append(fields, devicetoken.FieldTokenHash)
 	}
-	if m.role != nil {
-		fields = append(fields, familymember.FieldRole)
+	if m.device_label != nil {
+		fields = append(fields, devicetoken.FieldDeviceLabel)
+	}
+	if m.revoked != nil {
+		fields = append(fields, devicetoken.FieldRevoked)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, devicetoken.FieldLastUsedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, familymember.FieldCreatedAt)
+		fields = append(fields, devicetoken.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6077,17 +6443,23 @@ func (m *FamilyMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FamilyMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case familymember.FieldUID:
+	case devicetoken.FieldUID:
 		return m.UID()
-	case familymember.FieldFamilySpaceID:
+	case devicetoken.FieldChildProfileID:
+		return m.ChildProfileID()
+	case devicetoken.FieldFamilySpaceID:
 		return m.FamilySpaceID()
-	case familymember.FieldAccountID:
-		return m.AccountID()
-	case familymember.FieldRole:
-		return m.Role()
-	case familymember.FieldCreatedAt:
+	case devicetoken.FieldTokenHash:
+		return m.TokenHash()
+	case devicetoken.FieldDeviceLabel:
+		return m.DeviceLabel()
+	case devicetoken.FieldRevoked:
+		return m.Revoked()
+	case devicetoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case devicetoken.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -6096,56 +6468,83 @@ func (m *FamilyMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FamilyMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case familymember.FieldUID:
+	case devicetoken.FieldUID:
 		return m.OldUID(ctx)
-	case familymember.FieldFamilySpaceID:
+	case devicetoken.FieldChildProfileID:
+		return m.OldChildProfileID(ctx)
+	case devicetoken.FieldFamilySpaceID:
 		return m.OldFamilySpaceID(ctx)
-	case familymember.FieldAccountID:
-		return m.OldAccountID(ctx)
-	case familymember.FieldRole:
-		return m.OldRole(ctx)
-	case familymember.FieldCreatedAt:
+	case devicetoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case devicetoken.FieldDeviceLabel:
+		return m.OldDeviceLabel(ctx)
+	case devicetoken.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case devicetoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case devicetoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FamilyMember field %s", name)
+	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FamilyMemberMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case familymember.FieldUID:
+	case devicetoken.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case familymember.FieldFamilySpaceID:
+	case devicetoken.FieldChildProfileID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChildProfileID(v)
+		return nil
+	case devicetoken.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFamilySpaceID(v)
 		return nil
-	case familymember.FieldAccountID:
+	case devicetoken.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccountID(v)
+		m.SetTokenHash(v)
 		return nil
-	case familymember.FieldRole:
+	case devicetoken.FieldDeviceLabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetDeviceLabel(v)
 		return nil
-	case familymember.FieldCreatedAt:
+	case devicetoken.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case devicetoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case devicetoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -6153,148 +6552,177 @@ func (m *FamilyMemberMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FamilyMember field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FamilyMemberMutation) AddedFields() []string {
+func (m *DeviceTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FamilyMemberMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FamilyMemberMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown FamilyMember numeric field %s", name)
+	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FamilyMemberMutation) ClearedFields() []string {
-	return nil
+func (m *DeviceTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicetoken.FieldLastUsedAt) {
+		fields = append(fields, devicetoken.FieldLastUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FamilyMemberMutation) FieldCleared(name string) bool {
+func (m *DeviceTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FamilyMemberMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FamilyMember nullable field %s", name)
+func (m *DeviceTokenMutation) ClearField(name string) error {
+	switch name {
+	case devicetoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FamilyMemberMutation) ResetField(name string) error {
+func (m *DeviceTokenMutation) ResetField(name string) error {
 	switch name {
-	case familymember.FieldUID:
+	case devicetoken.FieldUID:
 		m.ResetUID()
 		return nil
-	case familymember.FieldFamilySpaceID:
+	case devicetoken.FieldChildProfileID:
+		m.ResetChildProfileID()
+		return nil
+	case devicetoken.FieldFamilySpaceID:
 		m.ResetFamilySpaceID()
 		return nil
-	case familymember.FieldAccountID:
-		m.ResetAccountID()
+	case devicetoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case familymember.FieldRole:
-		m.ResetRole()
+	case devicetoken.FieldDeviceLabel:
+		m.ResetDeviceLabel()
 		return nil
-	case familymember.FieldCreatedAt:
+	case devicetoken.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case devicetoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case devicetoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FamilyMember field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FamilyMemberMutation) AddedEdges() []string {
+func (m *DeviceTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FamilyMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FamilyMemberMutation) RemovedEdges() []string {
+func (m *DeviceTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FamilyMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FamilyMemberMutation) ClearedEdges() []string {
+func (m *DeviceTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FamilyMemberMutation) EdgeCleared(name string) bool {
+func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FamilyMemberMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FamilyMember unique edge %s", name)
+func (m *DeviceTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FamilyMemberMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FamilyMember edge %s", name)
+func (m *DeviceTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken edge %s", name)
 }
 
-// FamilySpaceMutation represents an operation that mutates the FamilySpace nodes in the graph.
-type FamilySpaceMutation struct {
+// DiagnosisEventMutation represents an operation that mutates the DiagnosisEvent nodes in the graph.
+type DiagnosisEventMutation struct {
 	config
 	op               Op
 	typ              string
 	id               *int
-	uid              *string
-	owner_account_id *string
-	name             *string
-	created_at       *time.Time
+	sequence         *int64
+	addsequence      *int64
+	timestamp        *time.Time
+	owner_id         *string
+	session_id       *string
+	skill_id         *string
+	question_text    *string
+	correct_answer   *string
+	learner_answer   *string
+	category         *string
+	misconception_id *string
+	confidence       *float64
+	addconfidence    *float64
+	classifier_name  *string
+	reasoning        *string
 	clearedFields    map[string]struct{}
 	done             bool
-	oldValue         func(context.Context) (*FamilySpace, error)
-	predicates       []predicate.FamilySpace
+	oldValue         func(context.Context) (*DiagnosisEvent, error)
+	predicates       []predicate.DiagnosisEvent
 }
 
-var _ ent.Mutation = (*FamilySpaceMutation)(nil)
+var _ ent.Mutation = (*DiagnosisEventMutation)(nil)
 
-// familyspaceOption allows management of the mutation configuration using functional options.
-type familyspaceOption func(*FamilySpaceMutation)
+// diagnosiseventOption allows management of the mutation configuration using functional options.
+type diagnosiseventOption func(*DiagnosisEventMutation)
 
-// newFamilySpaceMutation creates new mutation for the FamilySpace entity.
-func newFamilySpaceMutation(c config, op Op, opts ...familyspaceOption) *FamilySpaceMutation {
-	m := &FamilySpaceMutation{
+// newDiagnosisEventMutation creates new mutation for the DiagnosisEvent entity.
+func newDiagnosisEventMutation(c config, op Op, opts ...diagnosiseventOption) *DiagnosisEventMutation {
+	m := &DiagnosisEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFamilySpace,
+		typ:           TypeDiagnosisEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6303,20 +6731,20 @@ func newFamilySpaceMutation(c config, op Op, opts ...familyspaceOption) *FamilyS
 	return m
 }
 
-// withFamilySpaceID sets the ID field of the mutation.
-func withFamilySpaceID(id int) familyspaceOption {
-	return func(m *FamilySpaceMutation) {
+// withDiagnosisEventID sets the ID field of the mutation.
+func withDiagnosisEventID(id int) diagnosiseventOption {
+	return func(m *DiagnosisEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FamilySpace
+			value *DiagnosisEvent
 		)
-		m.oldValue = func(ctx context.Context) (*FamilySpace, error) {
+		m.oldValue = func(ctx context.Context) (*DiagnosisEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FamilySpace.Get(ctx, id)
+					value, err = m.Client().DiagnosisEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6325,10 +6753,10 @@ func withFamilySpaceID(id int) familyspaceOption {
 	}
 }
 
-// withFamilySpace sets the old FamilySpace of the mutation.
-func withFamilySpace(node *FamilySpace) familyspaceOption {
-	return func(m *FamilySpaceMutation) {
-		m.oldValue = func(context.Context) (*FamilySpace, error) {
+// withDiagnosisEvent sets the old DiagnosisEvent of the mutation.
+func withDiagnosisEvent(node *DiagnosisEvent) diagnosiseventOption {
+	return func(m *DiagnosisEventMutation) {
+		m.oldValue = func(context.Context) (*DiagnosisEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6337,7 +6765,7 @@ func withFamilySpace(node *FamilySpace) familyspaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FamilySpaceMutation) Client() *Client {
+func (m DiagnosisEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6345,7 +6773,7 @@ func (m FamilySpaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FamilySpaceMutation) Tx() (*Tx, error) {
+func (m DiagnosisEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6356,7 +6784,7 @@ func (m FamilySpaceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FamilySpaceMutation) ID() (id int, exists bool) {
+func (m *DiagnosisEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6367,7 +6795,7 @@ func (m *FamilySpaceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FamilySpaceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DiagnosisEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6376,964 +6804,654 @@ func (m *FamilySpaceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FamilySpace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DiagnosisEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUID sets the "uid" field.
-func (m *FamilySpaceMutation) SetUID(s string) {
-	m.uid = &s
+// SetSequence sets the "sequence" field.
+func (m *DiagnosisEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *FamilySpaceMutation) UID() (r string, exists bool) {
-	v := m.uid
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *DiagnosisEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the FamilySpace entity.
-// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilySpaceMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *DiagnosisEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.Sequence, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *FamilySpaceMutation) ResetUID() {
-	m.uid = nil
+// AddSequence adds i to the "sequence" field.
+func (m *DiagnosisEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
 }
 
-// SetOwnerAccountID sets the "owner_account_id" field.
-func (m *FamilySpaceMutation) SetOwnerAccountID(s string) {
-	m.owner_account_id = &s
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *DiagnosisEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerAccountID returns the value of the "owner_account_id" field in the mutation.
-func (m *FamilySpaceMutation) OwnerAccountID() (r string, exists bool) {
-	v := m.owner_account_id
+// ResetSequence resets all changes to the "sequence" field.
+func (m *DiagnosisEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *DiagnosisEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *DiagnosisEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerAccountID returns the old "owner_account_id" field's value of the FamilySpace entity.
-// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilySpaceMutation) OldOwnerAccountID(ctx context.Context) (v string, err error) {
+func (m *DiagnosisEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerAccountID requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.OwnerAccountID, nil
+	return oldValue.Timestamp, nil
 }
 
-// ResetOwnerAccountID resets all changes to the "owner_account_id" field.
-func (m *FamilySpaceMutation) ResetOwnerAccountID() {
-	m.owner_account_id = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *DiagnosisEventMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetName sets the "name" field.
-func (m *FamilySpaceMutation) SetName(s string) {
-	m.name = &s
+// SetOwnerID sets the "owner_id" field.
+func (m *DiagnosisEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FamilySpaceMutation) Name() (r string, exists bool) {
-	v := m.name
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *DiagnosisEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the FamilySpace entity.
-// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilySpaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DiagnosisEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OwnerID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FamilySpaceMutation) ResetName() {
-	m.name = nil
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *DiagnosisEventMutation) ResetOwnerID() {
+	m.owner_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FamilySpaceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetSessionID sets the "session_id" field.
+func (m *DiagnosisEventMutation) SetSessionID(s string) {
+	m.session_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FamilySpaceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *DiagnosisEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FamilySpace entity.
-// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FamilySpaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DiagnosisEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.SessionID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FamilySpaceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *DiagnosisEventMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// Where appends a list predicates to the FamilySpaceMutation builder.
-func (m *FamilySpaceMutation) Where(ps ...predicate.FamilySpace) {
-	m.predicates = append(m.predicates, ps...)
+// SetSkillID sets the "skill_id" field.
+func (m *DiagnosisEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
 }
 
-// WhereP appends storage-level predicates to the FamilySpaceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FamilySpaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FamilySpace, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *DiagnosisEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *FamilySpaceMutation) Op() Op {
-	return m.op
+// OldSkillID returns the old "skill_id" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	}
+	return oldValue.SkillID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *FamilySpaceMutation) SetOp(op Op) {
-	m.op = op
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *DiagnosisEventMutation) ResetSkillID() {
+	m.skill_id = nil
 }
 
-// Type returns the node type of this mutation (FamilySpace).
-func (m *FamilySpaceMutation) Type() string {
-	return m.typ
+// SetQuestionText sets the "question_text" field.
+func (m *DiagnosisEventMutation) SetQuestionText(s string) {
+	m.question_text = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *FamilySpaceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.uid != nil {
-		fields = append(fields, familyspace.FieldUID)
+// QuestionText returns the value of the "question_text" field in the mutation.
+func (m *DiagnosisEventMutation) QuestionText() (r string, exists bool) {
+	v := m.question_text
+	if v == nil {
+		return
 	}
-	if m.owner_account_id != nil {
-		fields = append(fields, familyspace.FieldOwnerAccountID)
+	return *v, true
+}
+
+// OldQuestionText returns the old "question_text" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldQuestionText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
 	}
-	if m.name != nil {
-		fields = append(fields, familyspace.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionText requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, familyspace.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
 	}
-	return fields
+	return oldValue.QuestionText, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *FamilySpaceMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case familyspace.FieldUID:
-		return m.UID()
-	case familyspace.FieldOwnerAccountID:
-		return m.OwnerAccountID()
-	case familyspace.FieldName:
-		return m.Name()
-	case familyspace.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetQuestionText resets all changes to the "question_text" field.
+func (m *DiagnosisEventMutation) ResetQuestionText() {
+	m.question_text = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *FamilySpaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case familyspace.FieldUID:
-		return m.OldUID(ctx)
-	case familyspace.FieldOwnerAccountID:
-		return m.OldOwnerAccountID(ctx)
-	case familyspace.FieldName:
-		return m.OldName(ctx)
-	case familyspace.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// SetCorrectAnswer sets the "correct_answer" field.
+func (m *DiagnosisEventMutation) SetCorrectAnswer(s string) {
+	m.correct_answer = &s
+}
+
+// CorrectAnswer returns the value of the "correct_answer" field in the mutation.
+func (m *DiagnosisEventMutation) CorrectAnswer() (r string, exists bool) {
+	v := m.correct_answer
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown FamilySpace field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FamilySpaceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case familyspace.FieldUID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUID(v)
-		return nil
-	case familyspace.FieldOwnerAccountID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerAccountID(v)
-		return nil
-	case familyspace.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case familyspace.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// OldCorrectAnswer returns the old "correct_answer" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldCorrectAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrectAnswer is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown FamilySpace field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrectAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrectAnswer: %w", err)
+	}
+	return oldValue.CorrectAnswer, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *FamilySpaceMutation) AddedFields() []string {
-	return nil
+// ResetCorrectAnswer resets all changes to the "correct_answer" field.
+func (m *DiagnosisEventMutation) ResetCorrectAnswer() {
+	m.correct_answer = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *FamilySpaceMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetLearnerAnswer sets the "learner_answer" field.
+func (m *DiagnosisEventMutation) SetLearnerAnswer(s string) {
+	m.learner_answer = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FamilySpaceMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// LearnerAnswer returns the value of the "learner_answer" field in the mutation.
+func (m *DiagnosisEventMutation) LearnerAnswer() (r string, exists bool) {
+	v := m.learner_answer
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown FamilySpace numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *FamilySpaceMutation) ClearedFields() []string {
-	return nil
+// OldLearnerAnswer returns the old "learner_answer" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldLearnerAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLearnerAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLearnerAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLearnerAnswer: %w", err)
+	}
+	return oldValue.LearnerAnswer, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *FamilySpaceMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetLearnerAnswer resets all changes to the "learner_answer" field.
+func (m *DiagnosisEventMutation) ResetLearnerAnswer() {
+	m.learner_answer = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *FamilySpaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FamilySpace nullable field %s", name)
+// SetCategory sets the "category" field.
+func (m *DiagnosisEventMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *FamilySpaceMutation) ResetField(name string) error {
-	switch name {
-	case familyspace.FieldUID:
-		m.ResetUID()
-		return nil
-	case familyspace.FieldOwnerAccountID:
-		m.ResetOwnerAccountID()
-		return nil
-	case familyspace.FieldName:
-		m.ResetName()
-		return nil
-	case familyspace.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// Category returns the value of the "category" field in the mutation.
+func (m *DiagnosisEventMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown FamilySpace field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FamilySpaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *FamilySpaceMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FamilySpaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *FamilySpaceMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FamilySpaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *FamilySpaceMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FamilySpaceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FamilySpace unique edge %s", name)
+// OldCategory returns the old "category" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *FamilySpaceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FamilySpace edge %s", name)
+// ResetCategory resets all changes to the "category" field.
+func (m *DiagnosisEventMutation) ResetCategory() {
+	m.category = nil
 }
 
-// GemEventMutation represents an operation that mutates the GemEvent nodes in the graph.
-type GemEventMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	sequence      *int64
-	addsequence   *int64
-	timestamp     *time.Time
-	owner_id      *string
-	gem_type      *string
-	rarity        *string
-	skill_id      *string
-	skill_name    *string
-	session_id    *string
-	reason        *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*GemEvent, error)
-	predicates    []predicate.GemEvent
+// SetMisconceptionID sets the "misconception_id" field.
+func (m *DiagnosisEventMutation) SetMisconceptionID(s string) {
+	m.misconception_id = &s
 }
 
-var _ ent.Mutation = (*GemEventMutation)(nil)
-
-// gemeventOption allows management of the mutation configuration using functional options.
-type gemeventOption func(*GemEventMutation)
-
-// newGemEventMutation creates new mutation for the GemEvent entity.
-func newGemEventMutation(c config, op Op, opts ...gemeventOption) *GemEventMutation {
-	m := &GemEventMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGemEvent,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// MisconceptionID returns the value of the "misconception_id" field in the mutation.
+func (m *DiagnosisEventMutation) MisconceptionID() (r string, exists bool) {
+	v := m.misconception_id
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withGemEventID sets the ID field of the mutation.
-func withGemEventID(id int) gemeventOption {
-	return func(m *GemEventMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *GemEvent
-		)
-		m.oldValue = func(ctx context.Context) (*GemEvent, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().GemEvent.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldMisconceptionID returns the old "misconception_id" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosisEventMutation) OldMisconceptionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMisconceptionID is only allowed on UpdateOne operations")
 	}
-}
-
-// withGemEvent sets the old GemEvent of the mutation.
-func withGemEvent(node *GemEvent) gemeventOption {
-	return func(m *GemEventMutation) {
-		m.oldValue = func(context.Context) (*GemEvent, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMisconceptionID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMisconceptionID: %w", err)
+	}
+	return oldValue.MisconceptionID, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GemEventMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GemEventMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearMisconceptionID clears the value of the "misconception_id" field.
+func (m *DiagnosisEventMutation) ClearMisconceptionID() {
+	m.misconception_id = nil
+	m.clearedFields[diagnosisevent.FieldMisconceptionID] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GemEventMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// MisconceptionIDCleared returns if the "misconception_id" field was cleared in this mutation.
+func (m *DiagnosisEventMutation) MisconceptionIDCleared() bool {
+	_, ok := m.clearedFields[diagnosisevent.FieldMisconceptionID]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GemEventMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GemEvent.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetMisconceptionID resets all changes to the "misconception_id" field.
+func (m *DiagnosisEventMutation) ResetMisconceptionID() {
+	m.misconception_id = nil
+	delete(m.clearedFields, diagnosisevent.FieldMisconceptionID)
 }
 
-// SetSequence sets the "sequence" field.
-func (m *GemEventMutation) SetSequence(i int64) {
-	m.sequence = &i
-	m.addsequence = nil
+// SetConfidence sets the "confidence" field.
+func (m *DiagnosisEventMutation) SetConfidence(f float64) {
+	m.confidence = &f
+	m.addconfidence = nil
 }
 
-// Sequence returns the value of the "sequence" field in the mutation.
-func (m *GemEventMutation) Sequence() (r int64, exists bool) {
-	v := m.sequence
+// Confidence returns the value of the "confidence" field in the mutation.
+func (m *DiagnosisEventMutation) Confidence() (r float64, exists bool) {
+	v := m.confidence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldConfidence returns the old "confidence" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *DiagnosisEventMutation) OldConfidence(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfidence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequence requires an ID field in the mutation")
+		return v, errors.New("OldConfidence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfidence: %w", err)
 	}
-	return oldValue.Sequence, nil
+	return oldValue.Confidence, nil
 }
 
-// AddSequence adds i to the "sequence" field.
-func (m *GemEventMutation) AddSequence(i int64) {
-	if m.addsequence != nil {
-		*m.addsequence += i
+// AddConfidence adds f to the "confidence" field.
+func (m *DiagnosisEventMutation) AddConfidence(f float64) {
+	if m.addconfidence != nil {
+		*m.addconfidence += f
 	} else {
-		m.addsequence = &i
+		m.addconfidence = &f
 	}
 }
 
-// AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *GemEventMutation) AddedSequence() (r int64, exists bool) {
-	v := m.addsequence
+// AddedConfidence returns the value that was added to the "confidence" field in this mutation.
+func (m *DiagnosisEventMutation) AddedConfidence() (r float64, exists bool) {
+	v := m.addconfidence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSequence resets all changes to the "sequence" field.
-func (m *GemEventMutation) ResetSequence() {
-	m.sequence = nil
-	m.addsequence = nil
+// ResetConfidence resets all changes to the "confidence" field.
+func (m *DiagnosisEventMutation) ResetConfidence() {
+	m.confidence = nil
+	m.addconfidence = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *GemEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetClassifierName sets the "classifier_name" field.
+func (m *DiagnosisEventMutation) SetClassifierName(s string) {
+	m.classifier_name = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *GemEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// ClassifierName returns the value of the "classifier_name" field in the mutation.
+func (m *DiagnosisEventMutation) ClassifierName() (r string, exists bool) {
+	v := m.classifier_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldClassifierName returns the old "classifier_name" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *DiagnosisEventMutation) OldClassifierName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldClassifierName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldClassifierName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldClassifierName: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.ClassifierName, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *GemEventMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetClassifierName resets all changes to the "classifier_name" field.
+func (m *DiagnosisEventMutation) ResetClassifierName() {
+	m.classifier_name = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *GemEventMutation) SetOwnerID(s string) {
-	m.owner_id = &s
+// SetReasoning sets the "reasoning" field.
+func (m *DiagnosisEventMutation) SetReasoning(s string) {
+	m.reasoning = &s
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *GemEventMutation) OwnerID() (r string, exists bool) {
-	v := m.owner_id
+// Reasoning returns the value of the "reasoning" field in the mutation.
+func (m *DiagnosisEventMutation) Reasoning() (r string, exists bool) {
+	v := m.reasoning
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldReasoning returns the old "reasoning" field's value of the DiagnosisEvent entity.
+// If the DiagnosisEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+func (m *DiagnosisEventMutation) OldReasoning(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReasoning is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldReasoning requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReasoning: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Reasoning, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *GemEventMutation) ResetOwnerID() {
-	m.owner_id = nil
+// ClearReasoning clears the value of the "reasoning" field.
+func (m *DiagnosisEventMutation) ClearReasoning() {
+	m.reasoning = nil
+	m.clearedFields[diagnosisevent.FieldReasoning] = struct{}{}
 }
 
-// SetGemType sets the "gem_type" field.
-func (m *GemEventMutation) SetGemType(s string) {
-	m.gem_type = &s
+// ReasoningCleared returns if the "reasoning" field was cleared in this mutation.
+func (m *DiagnosisEventMutation) ReasoningCleared() bool {
+	_, ok := m.clearedFields[diagnosisevent.FieldReasoning]
+	return ok
 }
 
-// GemType returns the value of the "gem_type" field in the mutation.
-func (m *GemEventMutation) GemType() (r string, exists bool) {
-	v := m.gem_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetReasoning resets all changes to the "reasoning" field.
+func (m *DiagnosisEventMutation) ResetReasoning() {
+	m.reasoning = nil
+	delete(m.clearedFields, diagnosisevent.FieldReasoning)
 }
 
-// OldGemType returns the old "gem_type" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldGemType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGemType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGemType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGemType: %w", err)
+// Where appends a list predicates to the DiagnosisEventMutation builder.
+func (m *DiagnosisEventMutation) Where(ps ...predicate.DiagnosisEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiagnosisEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiagnosisEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiagnosisEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.GemType, nil
+	m.Where(p...)
 }
 
-// ResetGemType resets all changes to the "gem_type" field.
-func (m *GemEventMutation) ResetGemType() {
-	m.gem_type = nil
+// Op returns the operation name.
+func (m *DiagnosisEventMutation) Op() Op {
+	return m.op
 }
 
-// SetRarity sets the "rarity" field.
-func (m *GemEventMutation) SetRarity(s string) {
-	m.rarity = &s
+// SetOp allows setting the mutation operation.
+func (m *DiagnosisEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Rarity returns the value of the "rarity" field in the mutation.
-func (m *GemEventMutation) Rarity() (r string, exists bool) {
-	v := m.rarity
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (DiagnosisEvent).
+func (m *DiagnosisEventMutation) Type() string {
+	return m.typ
 }
 
-// OldRarity returns the old "rarity" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldRarity(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRarity is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiagnosisEventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.sequence != nil {
+		fields = append(fields, diagnosisevent.FieldSequence)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRarity requires an ID field in the mutation")
+	if m.timestamp != nil {
+		fields = append(fields, diagnosisevent.FieldTimestamp)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRarity: %w", err)
+	if m.owner_id != nil {
+		fields = append(fields, diagnosisevent.FieldOwnerID)
 	}
-	return oldValue.Rarity, nil
-}
-
-// ResetRarity resets all changes to the "rarity" field.
-func (m *GemEventMutation) ResetRarity() {
-	m.rarity = nil
-}
-
-// SetSkillID sets the "skill_id" field.
-func (m *GemEventMutation) SetSkillID(s string) {
-	m.skill_id = &s
-}
-
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *GemEventMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
-	if v == nil {
-		return
+	if m.session_id != nil {
+		fields = append(fields, diagnosisevent.FieldSessionID)
 	}
-	return *v, true
-}
-
-// OldSkillID returns the old "skill_id" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldSkillID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	if m.skill_id != nil {
+		fields = append(fields, diagnosisevent.FieldSkillID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	if m.question_text != nil {
+		fields = append(fields, diagnosisevent.FieldQuestionText)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	if m.correct_answer != nil {
+		fields = append(fields, diagnosisevent.FieldCorrectAnswer)
 	}
-	return oldValue.SkillID, nil
-}
-
-// ClearSkillID clears the value of the "skill_id" field.
-func (m *GemEventMutation) ClearSkillID() {
-	m.skill_id = nil
-	m.clearedFields[gemevent.FieldSkillID] = struct{}{}
-}
-
-// SkillIDCleared returns if the "skill_id" field was cleared in this mutation.
-func (m *GemEventMutation) SkillIDCleared() bool {
-	_, ok := m.clearedFields[gemevent.FieldSkillID]
-	return ok
-}
-
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *GemEventMutation) ResetSkillID() {
-	m.skill_id = nil
-	delete(m.clearedFields, gemevent.FieldSkillID)
-}
-
-// SetSkillName sets the "skill_name" field.
-func (m *GemEventMutation) SetSkillName(s string) {
-	m.skill_name = &s
-}
-
-// SkillName returns the value of the "skill_name" field in the mutation.
-func (m *GemEventMutation) SkillName() (r string, exists bool) {
-	v := m.skill_name
-	if v == nil {
-		return
+	if m.learner_answer != nil {
+		fields = append(fields, diagnosisevent.FieldLearnerAnswer)
 	}
-	return *v, true
-}
-
-// OldSkillName returns the old "skill_name" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldSkillName(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillName is only allowed on UpdateOne operations")
+	if m.category != nil {
+		fields = append(fields, diagnosisevent.FieldCategory)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillName requires an ID field in the mutation")
+	if m.misconception_id != nil {
+		fields = append(fields, diagnosisevent.FieldMisconceptionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillName: %w", err)
+	if m.confidence != nil {
+		fields = append(fields, diagnosisevent.FieldConfidence)
 	}
-	return oldValue.SkillName, nil
-}
-
-// ClearSkillName clears the value of the "skill_name" field.
-func (m *GemEventMutation) ClearSkillName() {
-	m.skill_name = nil
-	m.clearedFields[gemevent.FieldSkillName] = struct{}{}
-}
-
-// SkillNameCleared returns if the "skill_name" field was cleared in this mutation.
-func (m *GemEventMutation) SkillNameCleared() bool {
-	_, ok := m.clearedFields[gemevent.FieldSkillName]
-	return ok
-}
-
-// ResetSkillName resets all changes to the "skill_name" field.
-func (m *GemEventMutation) ResetSkillName() {
-	m.skill_name = nil
-	delete(m.clearedFields, gemevent.FieldSkillName)
-}
-
-// SetSessionID sets the "session_id" field.
-func (m *GemEventMutation) SetSessionID(s string) {
-	m.session_id = &s
-}
-
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *GemEventMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSessionID returns the old "session_id" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
-	}
-	return oldValue.SessionID, nil
-}
-
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *GemEventMutation) ResetSessionID() {
-	m.session_id = nil
-}
-
-// SetReason sets the "reason" field.
-func (m *GemEventMutation) SetReason(s string) {
-	m.reason = &s
-}
-
-// Reason returns the value of the "reason" field in the mutation.
-func (m *GemEventMutation) Reason() (r string, exists bool) {
-	v := m.reason
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReason returns the old "reason" field's value of the GemEvent entity.
-// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GemEventMutation) OldReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
-	}
-	return oldValue.Reason, nil
-}
-
-// ResetReason resets all changes to the "reason" field.
-func (m *GemEventMutation) ResetReason() {
-	m.reason = nil
-}
-
-// Where appends a list predicates to the GemEventMutation builder.
-func (m *GemEventMutation) Where(ps ...predicate.GemEvent) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the GemEventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GemEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GemEvent, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *GemEventMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *GemEventMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (GemEvent).
-func (m *GemEventMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GemEventMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.sequence != nil {
-		fields = append(fields, gemevent.FieldSequence)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, gemevent.FieldTimestamp)
-	}
-	if m.owner_id != nil {
-		fields = append(fields, gemevent.FieldOwnerID)
-	}
-	if m.gem_type != nil {
-		fields = append(fields, gemevent.FieldGemType)
-	}
-	if m.rarity != nil {
-		fields = append(fields, gemevent.FieldRarity)
-	}
-	if m.skill_id != nil {
-		fields = append(fields, gemevent.FieldSkillID)
-	}
-	if m.skill_name != nil {
-		fields = append(fields, gemevent.FieldSkillName)
-	}
-	if m.session_id != nil {
-		fields = append(fields, gemevent.FieldSessionID)
-	}
-	if m.reason != nil {
-		fields = append(fields, gemevent.FieldReason)
-	}
-	return fields
+	if m.classifier_name != nil {
+		fields = append(fields, diagnosisevent.FieldClassifierName)
+	}
+	if m.reasoning != nil {
+		fields = append(fields, diagnosisevent.FieldReasoning)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GemEventMutation) Field(name string) (ent.Value, bool) {
+func (m *DiagnosisEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		return m.Sequence()
-	case gemevent.FieldTimestamp:
+	case diagnosisevent.FieldTimestamp:
 		return m.Timestamp()
-	case gemevent.FieldOwnerID:
+	case diagnosisevent.FieldOwnerID:
 		return m.OwnerID()
-	case gemevent.FieldGemType:
-		return m.GemType()
-	case gemevent.FieldRarity:
-		return m.Rarity()
-	case gemevent.FieldSkillID:
-		return m.SkillID()
-	case gemevent.FieldSkillName:
-		return m.SkillName()
-	case gemevent.FieldSessionID:
+	case diagnosisevent.FieldSessionID:
 		return m.SessionID()
-	case gemevent.FieldReason:
-		return m.Reason()
+	case diagnosisevent.FieldSkillID:
+		return m.SkillID()
+	case diagnosisevent.FieldQuestionText:
+		return m.QuestionText()
+	case diagnosisevent.FieldCorrectAnswer:
+		return m.CorrectAnswer()
+	case diagnosisevent.FieldLearnerAnswer:
+		return m.LearnerAnswer()
+	case diagnosisevent.FieldCategory:
+		return m.Category()
+	case diagnosisevent.FieldMisconceptionID:
+		return m.MisconceptionID()
+	case diagnosisevent.FieldConfidence:
+		return m.Confidence()
+	case diagnosisevent.FieldClassifierName:
+		return m.ClassifierName()
+	case diagnosisevent.FieldReasoning:
+		return m.Reasoning()
 	}
 	return nil, false
 }
@@ -7341,108 +7459,147 @@ func (m *GemEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GemEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiagnosisEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		return m.OldSequence(ctx)
-	case gemevent.FieldTimestamp:
+	case diagnosisevent.FieldTimestamp:
 		return m.OldTimestamp(ctx)
-	case gemevent.FieldOwnerID:
+	case diagnosisevent.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case gemevent.FieldGemType:
-		return m.OldGemType(ctx)
-	case gemevent.FieldRarity:
-		return m.OldRarity(ctx)
-	case gemevent.FieldSkillID:
-		return m.OldSkillID(ctx)
-	case gemevent.FieldSkillName:
-		return m.OldSkillName(ctx)
-	case gemevent.FieldSessionID:
+	case diagnosisevent.FieldSessionID:
 		return m.OldSessionID(ctx)
-	case gemevent.FieldReason:
-		return m.OldReason(ctx)
+	case diagnosisevent.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case diagnosisevent.FieldQuestionText:
+		return m.OldQuestionText(ctx)
+	case diagnosisevent.FieldCorrectAnswer:
+		return m.OldCorrectAnswer(ctx)
+	case diagnosisevent.FieldLearnerAnswer:
+		return m.OldLearnerAnswer(ctx)
+	case diagnosisevent.FieldCategory:
+		return m.OldCategory(ctx)
+	case diagnosisevent.FieldMisconceptionID:
+		return m.OldMisconceptionID(ctx)
+	case diagnosisevent.FieldConfidence:
+		return m.OldConfidence(ctx)
+	case diagnosisevent.FieldClassifierName:
+		return m.OldClassifierName(ctx)
+	case diagnosisevent.FieldReasoning:
+		return m.OldReasoning(ctx)
 	}
-	return nil, fmt.Errorf("unknown GemEvent field %s", name)
+	return nil, fmt.Errorf("unknown DiagnosisEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GemEventMutation) SetField(name string, value ent.Value) error {
+func (m *DiagnosisEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSequence(v)
 		return nil
-	case gemevent.FieldTimestamp:
+	case diagnosisevent.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTimestamp(v)
 		return nil
-	case gemevent.FieldOwnerID:
+	case diagnosisevent.FieldOwnerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwnerID(v)
 		return nil
-	case gemevent.FieldGemType:
+	case diagnosisevent.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGemType(v)
+		m.SetSessionID(v)
 		return nil
-	case gemevent.FieldRarity:
+	case diagnosisevent.FieldSkillID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRarity(v)
+		m.SetSkillID(v)
 		return nil
-	case gemevent.FieldSkillID:
+	case diagnosisevent.FieldQuestionText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillID(v)
+		m.SetQuestionText(v)
 		return nil
-	case gemevent.FieldSkillName:
+	case diagnosisevent.FieldCorrectAnswer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillName(v)
+		m.SetCorrectAnswer(v)
 		return nil
-	case gemevent.FieldSessionID:
+	case diagnosisevent.FieldLearnerAnswer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionID(v)
+		m.SetLearnerAnswer(v)
 		return nil
-	case gemevent.FieldReason:
+	case diagnosisevent.FieldCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetCategory(v)
 		return nil
-	}
-	return fmt.Errorf("unknown GemEvent field %s", name)
+	case diagnosisevent.FieldMisconceptionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMisconceptionID(v)
+		return nil
+	case diagnosisevent.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfidence(v)
+		return nil
+	case diagnosisevent.FieldClassifierName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClassifierName(v)
+		return nil
+	case diagnosisevent.FieldReasoning:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasoning(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiagnosisEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GemEventMutation) AddedFields() []string {
+func (m *DiagnosisEventMutation) AddedFields() []string {
 	var fields []string
 	if m.addsequence != nil {
-		fields = append(fields, gemevent.FieldSequence)
+		fields = append(fields, diagnosisevent.FieldSequence)
+	}
+	if m.addconfidence != nil {
+		fields = append(fields, diagnosisevent.FieldConfidence)
 	}
 	return fields
 }
@@ -7450,10 +7607,12 @@ func (m *GemEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GemEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DiagnosisEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		return m.AddedSequence()
+	case diagnosisevent.FieldConfidence:
+		return m.AddedConfidence()
 	}
 	return nil, false
 }
@@ -7461,167 +7620,183 @@ func (m *GemEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GemEventMutation) AddField(name string, value ent.Value) error {
+func (m *DiagnosisEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddSequence(v)
 		return nil
+	case diagnosisevent.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfidence(v)
+		return nil
 	}
-	return fmt.Errorf("unknown GemEvent numeric field %s", name)
+	return fmt.Errorf("unknown DiagnosisEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GemEventMutation) ClearedFields() []string {
+func (m *DiagnosisEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(gemevent.FieldSkillID) {
-		fields = append(fields, gemevent.FieldSkillID)
+	if m.FieldCleared(diagnosisevent.FieldMisconceptionID) {
+		fields = append(fields, diagnosisevent.FieldMisconceptionID)
 	}
-	if m.FieldCleared(gemevent.FieldSkillName) {
-		fields = append(fields, gemevent.FieldSkillName)
+	if m.FieldCleared(diagnosisevent.FieldReasoning) {
+		fields = append(fields, diagnosisevent.FieldReasoning)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GemEventMutation) FieldCleared(name string) bool {
+func (m *DiagnosisEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GemEventMutation) ClearField(name string) error {
+func (m *DiagnosisEventMutation) ClearField(name string) error {
 	switch name {
-	case gemevent.FieldSkillID:
-		m.ClearSkillID()
+	case diagnosisevent.FieldMisconceptionID:
+		m.ClearMisconceptionID()
 		return nil
-	case gemevent.FieldSkillName:
-		m.ClearSkillName()
+	case diagnosisevent.FieldReasoning:
+		m.ClearReasoning()
 		return nil
 	}
-	return fmt.Errorf("unknown GemEvent nullable field %s", name)
+	return fmt.Errorf("unknown DiagnosisEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GemEventMutation) ResetField(name string) error {
+func (m *DiagnosisEventMutation) ResetField(name string) error {
 	switch name {
-	case gemevent.FieldSequence:
+	case diagnosisevent.FieldSequence:
 		m.ResetSequence()
 		return nil
-	case gemevent.FieldTimestamp:
+	case diagnosisevent.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
-	case gemevent.FieldOwnerID:
+	case diagnosisevent.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case gemevent.FieldGemType:
-		m.ResetGemType()
-		return nil
-	case gemevent.FieldRarity:
-		m.ResetRarity()
+	case diagnosisevent.FieldSessionID:
+		m.ResetSessionID()
 		return nil
-	case gemevent.FieldSkillID:
+	case diagnosisevent.FieldSkillID:
 		m.ResetSkillID()
 		return nil
-	case gemevent.FieldSkillName:
-		m.ResetSkillName()
+	case diagnosisevent.FieldQuestionText:
+		m.ResetQuestionText()
 		return nil
-	case gemevent.FieldSessionID:
-		m.ResetSessionID()
+	case diagnosisevent.FieldCorrectAnswer:
+		m.ResetCorrectAnswer()
 		return nil
-	case gemevent.FieldReason:
-		m.ResetReason()
+	case diagnosisevent.FieldLearnerAnswer:
+		m.ResetLearnerAnswer()
+		return nil
+	case diagnosisevent.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case diagnosisevent.FieldMisconceptionID:
+		m.ResetMisconceptionID()
+		return nil
+	case diagnosisevent.FieldConfidence:
+		m.ResetConfidence()
+		return nil
+	case diagnosisevent.FieldClassifierName:
+		m.ResetClassifierName()
+		return nil
+	case diagnosisevent.FieldReasoning:
+		m.ResetReasoning()
 		return nil
 	}
-	return fmt.Errorf("unknown GemEvent field %s", name)
+	return fmt.Errorf("unknown DiagnosisEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GemEventMutation) AddedEdges() []string {
+func (m *DiagnosisEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GemEventMutation) AddedIDs(name string) []ent.Value {
+func (m *DiagnosisEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GemEventMutation) RemovedEdges() []string {
+func (m *DiagnosisEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GemEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *DiagnosisEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GemEventMutation) ClearedEdges() []string {
+func (m *DiagnosisEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GemEventMutation) EdgeCleared(name string) bool {
+func (m *DiagnosisEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GemEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown GemEvent unique edge %s", name)
+func (m *DiagnosisEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DiagnosisEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GemEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown GemEvent edge %s", name)
+func (m *DiagnosisEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DiagnosisEvent edge %s", name)
 }
 
-// HintEventMutation represents an operation that mutates the HintEvent nodes in the graph.
-type HintEventMutation struct {
+// FamilyMemberMutation represents an operation that mutates the FamilyMember nodes in the graph.
+type FamilyMemberMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	sequence      *int64
-	addsequence   *int64
-	timestamp     *time.Time
-	owner_id      *string
-	session_id    *string
-	skill_id      *string
-	question_text *string
-	hint_text     *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*HintEvent, error)
-	predicates    []predicate.HintEvent
+	op              Op
+	typ             string
+	id              *int
+	uid             *string
+	family_space_id *string
+	account_id      *string
+	role            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*FamilyMember, error)
+	predicates      []predicate.FamilyMember
 }
 
-var _ ent.Mutation = (*HintEventMutation)(nil)
+var _ ent.Mutation = (*FamilyMemberMutation)(nil)
 
-// hinteventOption allows management of the mutation configuration using functional options.
-type hinteventOption func(*HintEventMutation)
+// familymemberOption allows management of the mutation configuration using functional options.
+type familymemberOption func(*FamilyMemberMutation)
 
-// newHintEventMutation creates new mutation for the HintEvent entity.
-func newHintEventMutation(c config, op Op, opts ...hinteventOption) *HintEventMutation {
-	m := &HintEventMutation{
+// newFamilyMemberMutation creates new mutation for the FamilyMember entity.
+func newFamilyMemberMutation(c config, op Op, opts ...familymemberOption) *FamilyMemberMutation {
+	m := &FamilyMemberMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHintEvent,
+		typ:           TypeFamilyMember,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7630,20 +7805,20 @@ func newHintEventMutation(c config, op Op, opts ...hinteventOption) *HintEventMu
 	return m
 }
 
-// withHintEventID sets the ID field of the mutation.
-func withHintEventID(id int) hinteventOption {
-	return func(m *HintEventMutation) {
+// withFamilyMemberID sets the ID field of the mutation.
+func withFamilyMemberID(id int) familymemberOption {
+	return func(m *FamilyMemberMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *HintEvent
+			value *FamilyMember
 		)
-		m.oldValue = func(ctx context.Context) (*HintEvent, error) {
+		m.oldValue = func(ctx context.Context) (*FamilyMember, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().HintEvent.Get(ctx, id)
+					value, err = m.Client().FamilyMember.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7652,10 +7827,10 @@ func withHintEventID(id int) hinteventOption {
 	}
 }
 
-// withHintEvent sets the old HintEvent of the mutation.
-func withHintEvent(node *HintEvent) hinteventOption {
-	return func(m *HintEventMutation) {
-		m.oldValue = func(context.Context) (*HintEvent, error) {
+// withFamilyMember sets the old FamilyMember of the mutation.
+func withFamilyMember(node *FamilyMember) familymemberOption {
+	return func(m *FamilyMemberMutation) {
+		m.oldValue = func(context.Context) (*FamilyMember, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7664,7 +7839,7 @@ func withHintEvent(node *HintEvent) hinteventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HintEventMutation) Client() *Client {
+func (m FamilyMemberMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7672,7 +7847,7 @@ func (m HintEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HintEventMutation) Tx() (*Tx, error) {
+func (m FamilyMemberMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7683,7 +7858,7 @@ func (m HintEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HintEventMutation) ID() (id int, exists bool) {
+func (m *FamilyMemberMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7694,7 +7869,7 @@ func (m *HintEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HintEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FamilyMemberMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7703,293 +7878,201 @@ func (m *HintEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HintEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FamilyMember.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSequence sets the "sequence" field.
-func (m *HintEventMutation) SetSequence(i int64) {
-	m.sequence = &i
-	m.addsequence = nil
+// SetUID sets the "uid" field.
+func (m *FamilyMemberMutation) SetUID(s string) {
+	m.uid = &s
 }
 
-// Sequence returns the value of the "sequence" field in the mutation.
-func (m *HintEventMutation) Sequence() (r int64, exists bool) {
-	v := m.sequence
+// UID returns the value of the "uid" field in the mutation.
+func (m *FamilyMemberMutation) UID() (r string, exists bool) {
+	v := m.uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *FamilyMemberMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequence requires an ID field in the mutation")
+		return v, errors.New("OldUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
 	}
-	return oldValue.Sequence, nil
+	return oldValue.UID, nil
 }
 
-// AddSequence adds i to the "sequence" field.
-func (m *HintEventMutation) AddSequence(i int64) {
-	if m.addsequence != nil {
-		*m.addsequence += i
-	} else {
-		m.addsequence = &i
-	}
-}
-
-// AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *HintEventMutation) AddedSequence() (r int64, exists bool) {
-	v := m.addsequence
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSequence resets all changes to the "sequence" field.
-func (m *HintEventMutation) ResetSequence() {
-	m.sequence = nil
-	m.addsequence = nil
-}
-
-// SetTimestamp sets the "timestamp" field.
-func (m *HintEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
-}
-
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *HintEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTimestamp returns the old "timestamp" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
-	}
-	return oldValue.Timestamp, nil
-}
-
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *HintEventMutation) ResetTimestamp() {
-	m.timestamp = nil
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (m *HintEventMutation) SetOwnerID(s string) {
-	m.owner_id = &s
-}
-
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *HintEventMutation) OwnerID() (r string, exists bool) {
-	v := m.owner_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOwnerID returns the old "owner_id" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
-	}
-	return oldValue.OwnerID, nil
-}
-
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *HintEventMutation) ResetOwnerID() {
-	m.owner_id = nil
+// ResetUID resets all changes to the "uid" field.
+func (m *FamilyMemberMutation) ResetUID() {
+	m.uid = nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *HintEventMutation) SetSessionID(s string) {
-	m.session_id = &s
+// SetFamilySpaceID sets the "family_space_id" field.
+func (m *FamilyMemberMutation) SetFamilySpaceID(s string) {
+	m.family_space_id = &s
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *HintEventMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
+func (m *FamilyMemberMutation) FamilySpaceID() (r string, exists bool) {
+	v := m.family_space_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *FamilyMemberMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
 	}
-	return oldValue.SessionID, nil
+	return oldValue.FamilySpaceID, nil
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *HintEventMutation) ResetSessionID() {
-	m.session_id = nil
+// ResetFamilySpaceID resets all changes to the "family_space_id" field.
+func (m *FamilyMemberMutation) ResetFamilySpaceID() {
+	m.family_space_id = nil
 }
 
-// SetSkillID sets the "skill_id" field.
-func (m *HintEventMutation) SetSkillID(s string) {
-	m.skill_id = &s
+// SetAccountID sets the "account_id" field.
+func (m *FamilyMemberMutation) SetAccountID(s string) {
+	m.account_id = &s
 }
 
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *HintEventMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *FamilyMemberMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkillID returns the old "skill_id" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+func (m *FamilyMemberMutation) OldAccountID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.SkillID, nil
+	return oldValue.AccountID, nil
 }
 
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *HintEventMutation) ResetSkillID() {
-	m.skill_id = nil
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *FamilyMemberMutation) ResetAccountID() {
+	m.account_id = nil
 }
 
-// SetQuestionText sets the "question_text" field.
-func (m *HintEventMutation) SetQuestionText(s string) {
-	m.question_text = &s
+// SetRole sets the "role" field.
+func (m *FamilyMemberMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// QuestionText returns the value of the "question_text" field in the mutation.
-func (m *HintEventMutation) QuestionText() (r string, exists bool) {
-	v := m.question_text
+// Role returns the value of the "role" field in the mutation.
+func (m *FamilyMemberMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionText returns the old "question_text" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldQuestionText(ctx context.Context) (v string, err error) {
+func (m *FamilyMemberMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionText requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.QuestionText, nil
+	return oldValue.Role, nil
 }
 
-// ResetQuestionText resets all changes to the "question_text" field.
-func (m *HintEventMutation) ResetQuestionText() {
-	m.question_text = nil
+// ResetRole resets all changes to the "role" field.
+func (m *FamilyMemberMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetHintText sets the "hint_text" field.
-func (m *HintEventMutation) SetHintText(s string) {
-	m.hint_text = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *FamilyMemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// HintText returns the value of the "hint_text" field in the mutation.
-func (m *HintEventMutation) HintText() (r string, exists bool) {
-	v := m.hint_text
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FamilyMemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHintText returns the old "hint_text" field's value of the HintEvent entity.
-// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HintEventMutation) OldHintText(ctx context.Context) (v string, err error) {
+func (m *FamilyMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHintText is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHintText requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHintText: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.HintText, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetHintText resets all changes to the "hint_text" field.
-func (m *HintEventMutation) ResetHintText() {
-	m.hint_text = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FamilyMemberMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the HintEventMutation builder.
-func (m *HintEventMutation) Where(ps ...predicate.HintEvent) {
+// Where appends a list predicates to the FamilyMemberMutation builder.
+func (m *FamilyMemberMutation) Where(ps ...predicate.FamilyMember) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HintEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FamilyMemberMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HintEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HintEvent, len(ps))
+func (m *FamilyMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FamilyMember, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7997,45 +8080,39 @@ func (m *HintEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HintEventMutation) Op() Op {
+func (m *FamilyMemberMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HintEventMutation) SetOp(op Op) {
+func (m *FamilyMemberMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (HintEvent).
-func (m *HintEventMutation) Type() string {
+// Type returns the node type of this mutation (FamilyMember).
+func (m *FamilyMemberMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HintEventMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.sequence != nil {
-		fields = append(fields, hintevent.FieldSequence)
+func (m *FamilyMemberMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.uid != nil {
+		fields = append(fields, familymember.FieldUID)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, hintevent.FieldTimestamp)
+	if m.family_space_id != nil {
+		fields = append(fields, familymember.FieldFamilySpaceID)
 	}
-	if m.owner_id != nil {
-		fields = append(fields, hintevent.FieldOwnerID)
+	if m.account_id != nil {
+		fields = append(fields, familymember.FieldAccountID)
 	}
-	if m.session_id != nil {
-		fields = append(fields, hintevent.FieldSessionID)
+	if m.role != nil {
+		fields = append(fields, familymember.FieldRole)
 	}
-	if m.skill_id != nil {
-		fields = append(fields, hintevent.FieldSkillID)
-	}
-	if m.question_text != nil {
-		fields = append(fields, hintevent.FieldQuestionText)
-	}
-	if m.hint_text != nil {
-		fields = append(fields, hintevent.FieldHintText)
+	if m.created_at != nil {
+		fields = append(fields, familymember.FieldCreatedAt)
 	}
 	return fields
 }
@@ -8043,22 +8120,18 @@ func (m *HintEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HintEventMutation) Field(name string) (ent.Value, bool) {
+func (m *FamilyMemberMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hintevent.FieldSequence:
-		return m.Sequence()
-	case hintevent.FieldTimestamp:
-		return m.Timestamp()
-	case hintevent.FieldOwnerID:
-		return m.OwnerID()
-	case hintevent.FieldSessionID:
-		return m.SessionID()
-	case hintevent.FieldSkillID:
-		return m.SkillID()
-	case hintevent.FieldQuestionText:
-		return m.QuestionText()
-	case hintevent.FieldHintText:
-		return m.HintText()
+	case familymember.FieldUID:
+		return m.UID()
+	case familymember.FieldFamilySpaceID:
+		return m.FamilySpaceID()
+	case familymember.FieldAccountID:
+		return m.AccountID()
+	case familymember.FieldRole:
+		return m.Role()
+	case familymember.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -8066,246 +8139,205 @@ func (m *HintEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HintEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FamilyMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hintevent.FieldSequence:
-		return m.OldSequence(ctx)
-	case hintevent.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case hintevent.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case hintevent.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case hintevent.FieldSkillID:
-		return m.OldSkillID(ctx)
-	case hintevent.FieldQuestionText:
-		return m.OldQuestionText(ctx)
-	case hintevent.FieldHintText:
-		return m.OldHintText(ctx)
+	case familymember.FieldUID:
+		return m.OldUID(ctx)
+	case familymember.FieldFamilySpaceID:
+		return m.OldFamilySpaceID(ctx)
+	case familymember.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case familymember.FieldRole:
+		return m.OldRole(ctx)
+	case familymember.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown HintEvent field %s", name)
+	return nil, fmt.Errorf("unknown FamilyMember field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HintEventMutation) SetField(name string, value ent.Value) error {
+func (m *FamilyMemberMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hintevent.FieldSequence:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSequence(v)
-		return nil
-	case hintevent.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
-		return nil
-	case hintevent.FieldOwnerID:
+	case familymember.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
+		m.SetUID(v)
 		return nil
-	case hintevent.FieldSessionID:
+	case familymember.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionID(v)
+		m.SetFamilySpaceID(v)
 		return nil
-	case hintevent.FieldSkillID:
+	case familymember.FieldAccountID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillID(v)
+		m.SetAccountID(v)
 		return nil
-	case hintevent.FieldQuestionText:
+	case familymember.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionText(v)
+		m.SetRole(v)
 		return nil
-	case hintevent.FieldHintText:
-		v, ok := value.(string)
+	case familymember.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHintText(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HintEvent field %s", name)
+	return fmt.Errorf("unknown FamilyMember field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HintEventMutation) AddedFields() []string {
-	var fields []string
-	if m.addsequence != nil {
-		fields = append(fields, hintevent.FieldSequence)
-	}
-	return fields
+func (m *FamilyMemberMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HintEventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case hintevent.FieldSequence:
-		return m.AddedSequence()
-	}
+func (m *FamilyMemberMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HintEventMutation) AddField(name string, value ent.Value) error {
+func (m *FamilyMemberMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case hintevent.FieldSequence:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSequence(v)
-		return nil
 	}
-	return fmt.Errorf("unknown HintEvent numeric field %s", name)
+	return fmt.Errorf("unknown FamilyMember numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HintEventMutation) ClearedFields() []string {
+func (m *FamilyMemberMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HintEventMutation) FieldCleared(name string) bool {
+func (m *FamilyMemberMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HintEventMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown HintEvent nullable field %s", name)
+func (m *FamilyMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FamilyMember nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HintEventMutation) ResetField(name string) error {
+func (m *FamilyMemberMutation) ResetField(name string) error {
 	switch name {
-	case hintevent.FieldSequence:
-		m.ResetSequence()
-		return nil
-	case hintevent.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case hintevent.FieldOwnerID:
-		m.ResetOwnerID()
+	case familymember.FieldUID:
+		m.ResetUID()
 		return nil
-	case hintevent.FieldSessionID:
-		m.ResetSessionID()
+	case familymember.FieldFamilySpaceID:
+		m.ResetFamilySpaceID()
 		return nil
-	case hintevent.FieldSkillID:
-		m.ResetSkillID()
+	case familymember.FieldAccountID:
+		m.ResetAccountID()
 		return nil
-	case hintevent.FieldQuestionText:
-		m.ResetQuestionText()
+	case familymember.FieldRole:
+		m.ResetRole()
 		return nil
-	case hintevent.FieldHintText:
-		m.ResetHintText()
+	case familymember.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown HintEvent field %s", name)
+	return fmt.Errorf("unknown FamilyMember field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HintEventMutation) AddedEdges() []string {
+func (m *FamilyMemberMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HintEventMutation) AddedIDs(name string) []ent.Value {
+func (m *FamilyMemberMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HintEventMutation) RemovedEdges() []string {
+func (m *FamilyMemberMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HintEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *FamilyMemberMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HintEventMutation) ClearedEdges() []string {
+func (m *FamilyMemberMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HintEventMutation) EdgeCleared(name string) bool {
+func (m *FamilyMemberMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HintEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown HintEvent unique edge %s", name)
+func (m *FamilyMemberMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FamilyMember unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HintEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown HintEvent edge %s", name)
+func (m *FamilyMemberMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FamilyMember edge %s", name)
 }
 
-// InviteMutation represents an operation that mutates the Invite nodes in the graph.
-type InviteMutation struct {
+// FamilySpaceMutation represents an operation that mutates the FamilySpace nodes in the graph.
+type FamilySpaceMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uid             *string
-	family_space_id *string
-	code            *string
-	expires_at      *time.Time
-	revoked         *bool
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Invite, error)
-	predicates      []predicate.Invite
+	op               Op
+	typ              string
+	id               *int
+	uid              *string
+	owner_account_id *string
+	name             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*FamilySpace, error)
+	predicates       []predicate.FamilySpace
 }
 
-var _ ent.Mutation = (*InviteMutation)(nil)
+var _ ent.Mutation = (*FamilySpaceMutation)(nil)
 
-// inviteOption allows management of the mutation configuration using functional options.
-type inviteOption func(*InviteMutation)
+// familyspaceOption allows management of the mutation configuration using functional options.
+type familyspaceOption func(*FamilySpaceMutation)
 
-// newInviteMutation creates new mutation for the Invite entity.
-func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
-	m := &InviteMutation{
+// newFamilySpaceMutation creates new mutation for the FamilySpace entity.
+func newFamilySpaceMutation(c config, op Op, opts ...familyspaceOption) *FamilySpaceMutation {
+	m := &FamilySpaceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInvite,
+		typ:           TypeFamilySpace,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8314,20 +8346,20 @@ func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
 	return m
 }
 
-// withInviteID sets the ID field of the mutation.
-func withInviteID(id int) inviteOption {
-	return func(m *InviteMutation) {
+// withFamilySpaceID sets the ID field of the mutation.
+func withFamilySpaceID(id int) familyspaceOption {
+	return func(m *FamilySpaceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Invite
+			value *FamilySpace
 		)
-		m.oldValue = func(ctx context.Context) (*Invite, error) {
+		m.oldValue = func(ctx context.Context) (*FamilySpace, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Invite.Get(ctx, id)
+					value, err = m.Client().FamilySpace.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8336,10 +8368,10 @@ func withInviteID(id int) inviteOption {
 	}
 }
 
-// withInvite sets the old Invite of the mutation.
-func withInvite(node *Invite) inviteOption {
-	return func(m *InviteMutation) {
-		m.oldValue = func(context.Context) (*Invite, error) {
+// withFamilySpace sets the old FamilySpace of the mutation.
+func withFamilySpace(node *FamilySpace) familyspaceOption {
+	return func(m *FamilySpaceMutation) {
+		m.oldValue = func(context.Context) (*FamilySpace, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8348,7 +8380,7 @@ func withInvite(node *Invite) inviteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InviteMutation) Client() *Client {
+func (m FamilySpaceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8356,7 +8388,7 @@ func (m InviteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InviteMutation) Tx() (*Tx, error) {
+func (m FamilySpaceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8367,7 +8399,7 @@ func (m InviteMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InviteMutation) ID() (id int, exists bool) {
+func (m *FamilySpaceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8378,7 +8410,7 @@ func (m *InviteMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InviteMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FamilySpaceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8387,19 +8419,19 @@ func (m *InviteMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Invite.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FamilySpace.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *InviteMutation) SetUID(s string) {
+func (m *FamilySpaceMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *InviteMutation) UID() (r string, exists bool) {
+func (m *FamilySpaceMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -8407,10 +8439,10 @@ func (m *InviteMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the FamilySpace entity.
+// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *FamilySpaceMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -8425,161 +8457,89 @@ func (m *InviteMutation) OldUID(ctx context.Context) (v string, err error) {
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *InviteMutation) ResetUID() {
+func (m *FamilySpaceMutation) ResetUID() {
 	m.uid = nil
 }
 
-// SetFamilySpaceID sets the "family_space_id" field.
-func (m *InviteMutation) SetFamilySpaceID(s string) {
-	m.family_space_id = &s
-}
-
-// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *InviteMutation) FamilySpaceID() (r string, exists bool) {
-	v := m.family_space_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFamilySpaceID returns the old "family_space_id" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
-	}
-	return oldValue.FamilySpaceID, nil
-}
-
-// ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *InviteMutation) ResetFamilySpaceID() {
-	m.family_space_id = nil
-}
-
-// SetCode sets the "code" field.
-func (m *InviteMutation) SetCode(s string) {
-	m.code = &s
-}
-
-// Code returns the value of the "code" field in the mutation.
-func (m *InviteMutation) Code() (r string, exists bool) {
-	v := m.code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCode returns the old "code" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
-	}
-	return oldValue.Code, nil
-}
-
-// ResetCode resets all changes to the "code" field.
-func (m *InviteMutation) ResetCode() {
-	m.code = nil
-}
-
-// SetExpiresAt sets the "expires_at" field.
-func (m *InviteMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetOwnerAccountID sets the "owner_account_id" field.
+func (m *FamilySpaceMutation) SetOwnerAccountID(s string) {
+	m.owner_account_id = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *InviteMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// OwnerAccountID returns the value of the "owner_account_id" field in the mutation.
+func (m *FamilySpaceMutation) OwnerAccountID() (r string, exists bool) {
+	v := m.owner_account_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerAccountID returns the old "owner_account_id" field's value of the FamilySpace entity.
+// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *FamilySpaceMutation) OldOwnerAccountID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldOwnerAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerAccountID: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.OwnerAccountID, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *InviteMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetOwnerAccountID resets all changes to the "owner_account_id" field.
+func (m *FamilySpaceMutation) ResetOwnerAccountID() {
+	m.owner_account_id = nil
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *InviteMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// SetName sets the "name" field.
+func (m *FamilySpaceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *InviteMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// Name returns the value of the "name" field in the mutation.
+func (m *FamilySpaceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the FamilySpace entity.
+// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *FamilySpaceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.Name, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *InviteMutation) ResetRevoked() {
-	m.revoked = nil
+// ResetName resets all changes to the "name" field.
+func (m *FamilySpaceMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *InviteMutation) SetCreatedAt(t time.Time) {
+func (m *FamilySpaceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FamilySpaceMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8587,10 +8547,10 @@ func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Invite entity.
-// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FamilySpace entity.
+// If the FamilySpace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FamilySpaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8605,19 +8565,19 @@ func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InviteMutation) ResetCreatedAt() {
+func (m *FamilySpaceMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the InviteMutation builder.
-func (m *InviteMutation) Where(ps ...predicate.Invite) {
+// Where appends a list predicates to the FamilySpaceMutation builder.
+func (m *FamilySpaceMutation) Where(ps ...predicate.FamilySpace) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InviteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FamilySpaceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Invite, len(ps))
+func (m *FamilySpaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FamilySpace, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8625,42 +8585,36 @@ func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InviteMutation) Op() Op {
+func (m *FamilySpaceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InviteMutation) SetOp(op Op) {
+func (m *FamilySpaceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Invite).
-func (m *InviteMutation) Type() string {
+// Type returns the node type of this mutation (FamilySpace).
+func (m *FamilySpaceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InviteMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *FamilySpaceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.uid != nil {
-		fields = append(fields, invite.FieldUID)
-	}
-	if m.family_space_id != nil {
-		fields = append(fields, invite.FieldFamilySpaceID)
-	}
-	if m.code != nil {
-		fields = append(fields, invite.FieldCode)
+		fields = append(fields, familyspace.FieldUID)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, invite.FieldExpiresAt)
+	if m.owner_account_id != nil {
+		fields = append(fields, familyspace.FieldOwnerAccountID)
 	}
-	if m.revoked != nil {
-		fields = append(fields, invite.FieldRevoked)
+	if m.name != nil {
+		fields = append(fields, familyspace.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, invite.FieldCreatedAt)
+		fields = append(fields, familyspace.FieldCreatedAt)
 	}
 	return fields
 }
@@ -8668,19 +8622,15 @@ func (m *InviteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InviteMutation) Field(name string) (ent.Value, bool) {
+func (m *FamilySpaceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case invite.FieldUID:
+	case familyspace.FieldUID:
 		return m.UID()
-	case invite.FieldFamilySpaceID:
-		return m.FamilySpaceID()
-	case invite.FieldCode:
-		return m.Code()
-	case invite.FieldExpiresAt:
-		return m.ExpiresAt()
-	case invite.FieldRevoked:
-		return m.Revoked()
-	case invite.FieldCreatedAt:
+	case familyspace.FieldOwnerAccountID:
+		return m.OwnerAccountID()
+	case familyspace.FieldName:
+		return m.Name()
+	case familyspace.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -8689,65 +8639,47 @@ func (m *InviteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FamilySpaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case invite.FieldUID:
+	case familyspace.FieldUID:
 		return m.OldUID(ctx)
-	case invite.FieldFamilySpaceID:
-		return m.OldFamilySpaceID(ctx)
-	case invite.FieldCode:
-		return m.OldCode(ctx)
-	case invite.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case invite.FieldRevoked:
-		return m.OldRevoked(ctx)
-	case invite.FieldCreatedAt:
+	case familyspace.FieldOwnerAccountID:
+		return m.OldOwnerAccountID(ctx)
+	case familyspace.FieldName:
+		return m.OldName(ctx)
+	case familyspace.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Invite field %s", name)
+	return nil, fmt.Errorf("unknown FamilySpace field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InviteMutation) SetField(name string, value ent.Value) error {
+func (m *FamilySpaceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case invite.FieldUID:
+	case familyspace.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case invite.FieldFamilySpaceID:
+	case familyspace.FieldOwnerAccountID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFamilySpaceID(v)
+		m.SetOwnerAccountID(v)
 		return nil
-	case invite.FieldCode:
+	case familyspace.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetName(v)
 		return nil
-	case invite.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case invite.FieldRevoked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRevoked(v)
-		return nil
-	case invite.FieldCreatedAt:
+	case familyspace.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -8755,164 +8687,151 @@ func (m *InviteMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Invite field %s", name)
+	return fmt.Errorf("unknown FamilySpace field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InviteMutation) AddedFields() []string {
+func (m *FamilySpaceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InviteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FamilySpaceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InviteMutation) AddField(name string, value ent.Value) error {
+func (m *FamilySpaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Invite numeric field %s", name)
+	return fmt.Errorf("unknown FamilySpace numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InviteMutation) ClearedFields() []string {
+func (m *FamilySpaceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InviteMutation) FieldCleared(name string) bool {
+func (m *FamilySpaceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InviteMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Invite nullable field %s", name)
+func (m *FamilySpaceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FamilySpace nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InviteMutation) ResetField(name string) error {
+func (m *FamilySpaceMutation) ResetField(name string) error {
 	switch name {
-	case invite.FieldUID:
+	case familyspace.FieldUID:
 		m.ResetUID()
 		return nil
-	case invite.FieldFamilySpaceID:
-		m.ResetFamilySpaceID()
-		return nil
-	case invite.FieldCode:
-		m.ResetCode()
-		return nil
-	case invite.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case familyspace.FieldOwnerAccountID:
+		m.ResetOwnerAccountID()
 		return nil
-	case invite.FieldRevoked:
-		m.ResetRevoked()
+	case familyspace.FieldName:
+		m.ResetName()
 		return nil
-	case invite.FieldCreatedAt:
+	case familyspace.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Invite field %s", name)
+	return fmt.Errorf("unknown FamilySpace field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InviteMutation) AddedEdges() []string {
+func (m *FamilySpaceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InviteMutation) AddedIDs(name string) []ent.Value {
+func (m *FamilySpaceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InviteMutation) RemovedEdges() []string {
+func (m *FamilySpaceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InviteMutation) RemovedIDs(name string) []ent.Value {
+func (m *FamilySpaceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InviteMutation) ClearedEdges() []string {
+func (m *FamilySpaceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InviteMutation) EdgeCleared(name string) bool {
+func (m *FamilySpaceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InviteMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Invite unique edge %s", name)
+func (m *FamilySpaceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FamilySpace unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InviteMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Invite edge %s", name)
+func (m *FamilySpaceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FamilySpace edge %s", name)
 }
 
-// LLMRequestEventMutation represents an operation that mutates the LLMRequestEvent nodes in the graph.
-type LLMRequestEventMutation struct {
+// GemEventMutation represents an operation that mutates the GemEvent nodes in the graph.
+type GemEventMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	sequence         *int64
-	addsequence      *int64
-	timestamp        *time.Time
-	owner_id         *string
-	provider         *string
-	model            *string
-	purpose          *string
-	input_tokens     *int
-	addinput_tokens  *int
-	output_tokens    *int
-	addoutput_tokens *int
-	latency_ms       *int64
-	addlatency_ms    *int64
-	success          *bool
-	error_message    *string
-	request_body     *string
-	response_body    *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*LLMRequestEvent, error)
-	predicates       []predicate.LLMRequestEvent
+	op            Op
+	typ           string
+	id            *int
+	sequence      *int64
+	addsequence   *int64
+	timestamp     *time.Time
+	owner_id      *string
+	gem_type      *string
+	rarity        *string
+	skill_id      *string
+	skill_name    *string
+	session_id    *string
+	reason        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*GemEvent, error)
+	predicates    []predicate.GemEvent
 }
 
-var _ ent.Mutation = (*LLMRequestEventMutation)(nil)
+var _ ent.Mutation = (*GemEventMutation)(nil)
 
-// llmrequesteventOption allows management of the mutation configuration using functional options.
-type llmrequesteventOption func(*LLMRequestEventMutation)
+// gemeventOption allows management of the mutation configuration using functional options.
+type gemeventOption func(*GemEventMutation)
 
-// newLLMRequestEventMutation creates new mutation for the LLMRequestEvent entity.
-func newLLMRequestEventMutation(c config, op Op, opts ...llmrequesteventOption) *LLMRequestEventMutation {
-	m := &LLMRequestEventMutation{
+// newGemEventMutation creates new mutation for the GemEvent entity.
+func newGemEventMutation(c config, op Op, opts ...gemeventOption) *GemEventMutation {
+	m := &GemEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLLMRequestEvent,
+		typ:           TypeGemEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8921,20 +8840,20 @@ func newLLMRequestEventMutation(c config, op Op, opts ...llmrequesteventOption)
 	return m
 }
 
-// withLLMRequestEventID sets the ID field of the mutation.
-func withLLMRequestEventID(id int) llmrequesteventOption {
-	return func(m *LLMRequestEventMutation) {
+// withGemEventID sets the ID field of the mutation.
+func withGemEventID(id int) gemeventOption {
+	return func(m *GemEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LLMRequestEvent
+			value *GemEvent
 		)
-		m.oldValue = func(ctx context.Context) (*LLMRequestEvent, error) {
+		m.oldValue = func(ctx context.Context) (*GemEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LLMRequestEvent.Get(ctx, id)
+					value, err = m.Client().GemEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8943,10 +8862,10 @@ func withLLMRequestEventID(id int) llmrequesteventOption {
 	}
 }
 
-// withLLMRequestEvent sets the old LLMRequestEvent of the mutation.
-func withLLMRequestEvent(node *LLMRequestEvent) llmrequesteventOption {
-	return func(m *LLMRequestEventMutation) {
-		m.oldValue = func(context.Context) (*LLMRequestEvent, error) {
+// withGemEvent sets the old GemEvent of the mutation.
+func withGemEvent(node *GemEvent) gemeventOption {
+	return func(m *GemEventMutation) {
+		m.oldValue = func(context.Context) (*GemEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8955,7 +8874,7 @@ func withLLMRequestEvent(node *LLMRequestEvent) llmrequesteventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LLMRequestEventMutation) Client() *Client {
+func (m GemEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8963,7 +8882,7 @@ func (m LLMRequestEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LLMRequestEventMutation) Tx() (*Tx, error) {
+func (m GemEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8974,7 +8893,7 @@ func (m LLMRequestEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LLMRequestEventMutation) ID() (id int, exists bool) {
+func (m *GemEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8985,7 +8904,7 @@ func (m *LLMRequestEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LLMRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GemEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8994,20 +8913,20 @@ func (m *LLMRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LLMRequestEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GemEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetSequence sets the "sequence" field.
-func (m *LLMRequestEventMutation) SetSequence(i int64) {
+func (m *GemEventMutation) SetSequence(i int64) {
 	m.sequence = &i
 	m.addsequence = nil
 }
 
 // Sequence returns the value of the "sequence" field in the mutation.
-func (m *LLMRequestEventMutation) Sequence() (r int64, exists bool) {
+func (m *GemEventMutation) Sequence() (r int64, exists bool) {
 	v := m.sequence
 	if v == nil {
 		return
@@ -9015,10 +8934,10 @@ func (m *LLMRequestEventMutation) Sequence() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *GemEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
@@ -9033,7 +8952,7 @@ func (m *LLMRequestEventMutation) OldSequence(ctx context.Context) (v int64, err
 }
 
 // AddSequence adds i to the "sequence" field.
-func (m *LLMRequestEventMutation) AddSequence(i int64) {
+func (m *GemEventMutation) AddSequence(i int64) {
 	if m.addsequence != nil {
 		*m.addsequence += i
 	} else {
@@ -9042,7 +8961,7 @@ func (m *LLMRequestEventMutation) AddSequence(i int64) {
 }
 
 // AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *LLMRequestEventMutation) AddedSequence() (r int64, exists bool) {
+func (m *GemEventMutation) AddedSequence() (r int64, exists bool) {
 	v := m.addsequence
 	if v == nil {
 		return
@@ -9051,18 +8970,18 @@ func (m *LLMRequestEventMutation) AddedSequence() (r int64, exists bool) {
 }
 
 // ResetSequence resets all changes to the "sequence" field.
-func (m *LLMRequestEventMutation) ResetSequence() {
+func (m *GemEventMutation) ResetSequence() {
 	m.sequence = nil
 	m.addsequence = nil
 }
 
 // SetTimestamp sets the "timestamp" field.
-func (m *LLMRequestEventMutation) SetTimestamp(t time.Time) {
+func (m *GemEventMutation) SetTimestamp(t time.Time) {
 	m.timestamp = &t
 }
 
 // Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *LLMRequestEventMutation) Timestamp() (r time.Time, exists bool) {
+func (m *GemEventMutation) Timestamp() (r time.Time, exists bool) {
 	v := m.timestamp
 	if v == nil {
 		return
@@ -9070,10 +8989,10 @@ func (m *LLMRequestEventMutation) Timestamp() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *GemEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
@@ -9088,17 +9007,17 @@ func (m *LLMRequestEventMutation) OldTimestamp(ctx context.Context) (v time.Time
 }
 
 // ResetTimestamp resets all changes to the "timestamp" field.
-func (m *LLMRequestEventMutation) ResetTimestamp() {
+func (m *GemEventMutation) ResetTimestamp() {
 	m.timestamp = nil
 }
 
 // SetOwnerID sets the "owner_id" field.
-func (m *LLMRequestEventMutation) SetOwnerID(s string) {
+func (m *GemEventMutation) SetOwnerID(s string) {
 	m.owner_id = &s
 }
 
 // OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *LLMRequestEventMutation) OwnerID() (r string, exists bool) {
+func (m *GemEventMutation) OwnerID() (r string, exists bool) {
 	v := m.owner_id
 	if v == nil {
 		return
@@ -9106,10 +9025,10 @@ func (m *LLMRequestEventMutation) OwnerID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+func (m *GemEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
@@ -9124,503 +9043,313 @@ func (m *LLMRequestEventMutation) OldOwnerID(ctx context.Context) (v string, err
 }
 
 // ResetOwnerID resets all changes to the "owner_id" field.
-func (m *LLMRequestEventMutation) ResetOwnerID() {
+func (m *GemEventMutation) ResetOwnerID() {
 	m.owner_id = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *LLMRequestEventMutation) SetProvider(s string) {
-	m.provider = &s
+// SetGemType sets the "gem_type" field.
+func (m *GemEventMutation) SetGemType(s string) {
+	m.gem_type = &s
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *LLMRequestEventMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// GemType returns the value of the "gem_type" field in the mutation.
+func (m *GemEventMutation) GemType() (r string, exists bool) {
+	v := m.gem_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldGemType returns the old "gem_type" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *GemEventMutation) OldGemType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldGemType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldGemType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldGemType: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.GemType, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *LLMRequestEventMutation) ResetProvider() {
-	m.provider = nil
+// ResetGemType resets all changes to the "gem_type" field.
+func (m *GemEventMutation) ResetGemType() {
+	m.gem_type = nil
 }
 
-// SetModel sets the "model" field.
-func (m *LLMRequestEventMutation) SetModel(s string) {
-	m.model = &s
+// SetRarity sets the "rarity" field.
+func (m *GemEventMutation) SetRarity(s string) {
+	m.rarity = &s
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *LLMRequestEventMutation) Model() (r string, exists bool) {
-	v := m.model
+// Rarity returns the value of the "rarity" field in the mutation.
+func (m *GemEventMutation) Rarity() (r string, exists bool) {
+	v := m.rarity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldRarity returns the old "rarity" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *GemEventMutation) OldRarity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldRarity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldRarity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldRarity: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.Rarity, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *LLMRequestEventMutation) ResetModel() {
-	m.model = nil
+// ResetRarity resets all changes to the "rarity" field.
+func (m *GemEventMutation) ResetRarity() {
+	m.rarity = nil
 }
 
-// SetPurpose sets the "purpose" field.
-func (m *LLMRequestEventMutation) SetPurpose(s string) {
-	m.purpose = &s
+// SetSkillID sets the "skill_id" field.
+func (m *GemEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
 }
 
-// Purpose returns the value of the "purpose" field in the mutation.
-func (m *LLMRequestEventMutation) Purpose() (r string, exists bool) {
-	v := m.purpose
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *GemEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurpose returns the old "purpose" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillID returns the old "skill_id" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldPurpose(ctx context.Context) (v string, err error) {
+func (m *GemEventMutation) OldSkillID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurpose requires an ID field in the mutation")
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
 	}
-	return oldValue.Purpose, nil
+	return oldValue.SkillID, nil
 }
 
-// ResetPurpose resets all changes to the "purpose" field.
-func (m *LLMRequestEventMutation) ResetPurpose() {
-	m.purpose = nil
+// ClearSkillID clears the value of the "skill_id" field.
+func (m *GemEventMutation) ClearSkillID() {
+	m.skill_id = nil
+	m.clearedFields[gemevent.FieldSkillID] = struct{}{}
 }
 
-// SetInputTokens sets the "input_tokens" field.
-func (m *LLMRequestEventMutation) SetInputTokens(i int) {
-	m.input_tokens = &i
-	m.addinput_tokens = nil
+// SkillIDCleared returns if the "skill_id" field was cleared in this mutation.
+func (m *GemEventMutation) SkillIDCleared() bool {
+	_, ok := m.clearedFields[gemevent.FieldSkillID]
+	return ok
 }
 
-// InputTokens returns the value of the "input_tokens" field in the mutation.
-func (m *LLMRequestEventMutation) InputTokens() (r int, exists bool) {
-	v := m.input_tokens
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *GemEventMutation) ResetSkillID() {
+	m.skill_id = nil
+	delete(m.clearedFields, gemevent.FieldSkillID)
+}
+
+// SetSkillName sets the "skill_name" field.
+func (m *GemEventMutation) SetSkillName(s string) {
+	m.skill_name = &s
+}
+
+// SkillName returns the value of the "skill_name" field in the mutation.
+func (m *GemEventMutation) SkillName() (r string, exists bool) {
+	v := m.skill_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputTokens returns the old "input_tokens" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillName returns the old "skill_name" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldInputTokens(ctx context.Context) (v int, err error) {
+func (m *GemEventMutation) OldSkillName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkillName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+		return v, errors.New("OldSkillName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkillName: %w", err)
 	}
-	return oldValue.InputTokens, nil
+	return oldValue.SkillName, nil
 }
 
-// AddInputTokens adds i to the "input_tokens" field.
-func (m *LLMRequestEventMutation) AddInputTokens(i int) {
-	if m.addinput_tokens != nil {
-		*m.addinput_tokens += i
-	} else {
-		m.addinput_tokens = &i
-	}
+// ClearSkillName clears the value of the "skill_name" field.
+func (m *GemEventMutation) ClearSkillName() {
+	m.skill_name = nil
+	m.clearedFields[gemevent.FieldSkillName] = struct{}{}
 }
 
-// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
-func (m *LLMRequestEventMutation) AddedInputTokens() (r int, exists bool) {
-	v := m.addinput_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// SkillNameCleared returns if the "skill_name" field was cleared in this mutation.
+func (m *GemEventMutation) SkillNameCleared() bool {
+	_, ok := m.clearedFields[gemevent.FieldSkillName]
+	return ok
 }
 
-// ResetInputTokens resets all changes to the "input_tokens" field.
-func (m *LLMRequestEventMutation) ResetInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
+// ResetSkillName resets all changes to the "skill_name" field.
+func (m *GemEventMutation) ResetSkillName() {
+	m.skill_name = nil
+	delete(m.clearedFields, gemevent.FieldSkillName)
 }
 
-// SetOutputTokens sets the "output_tokens" field.
-func (m *LLMRequestEventMutation) SetOutputTokens(i int) {
-	m.output_tokens = &i
-	m.addoutput_tokens = nil
+// SetSessionID sets the "session_id" field.
+func (m *GemEventMutation) SetSessionID(s string) {
+	m.session_id = &s
 }
 
-// OutputTokens returns the value of the "output_tokens" field in the mutation.
-func (m *LLMRequestEventMutation) OutputTokens() (r int, exists bool) {
-	v := m.output_tokens
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *GemEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputTokens returns the old "output_tokens" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
+func (m *GemEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
-	}
-	return oldValue.OutputTokens, nil
-}
-
-// AddOutputTokens adds i to the "output_tokens" field.
-func (m *LLMRequestEventMutation) AddOutputTokens(i int) {
-	if m.addoutput_tokens != nil {
-		*m.addoutput_tokens += i
-	} else {
-		m.addoutput_tokens = &i
-	}
-}
-
-// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
-func (m *LLMRequestEventMutation) AddedOutputTokens() (r int, exists bool) {
-	v := m.addoutput_tokens
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
-	return *v, true
+	return oldValue.SessionID, nil
 }
 
-// ResetOutputTokens resets all changes to the "output_tokens" field.
-func (m *LLMRequestEventMutation) ResetOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *GemEventMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// SetLatencyMs sets the "latency_ms" field.
-func (m *LLMRequestEventMutation) SetLatencyMs(i int64) {
-	m.latency_ms = &i
-	m.addlatency_ms = nil
+// SetReason sets the "reason" field.
+func (m *GemEventMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// LatencyMs returns the value of the "latency_ms" field in the mutation.
-func (m *LLMRequestEventMutation) LatencyMs() (r int64, exists bool) {
-	v := m.latency_ms
+// Reason returns the value of the "reason" field in the mutation.
+func (m *GemEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatencyMs returns the old "latency_ms" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the GemEvent entity.
+// If the GemEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+func (m *GemEventMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.LatencyMs, nil
+	return oldValue.Reason, nil
 }
 
-// AddLatencyMs adds i to the "latency_ms" field.
-func (m *LLMRequestEventMutation) AddLatencyMs(i int64) {
-	if m.addlatency_ms != nil {
-		*m.addlatency_ms += i
-	} else {
-		m.addlatency_ms = &i
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *GemEventMutation) ResetReason() {
+	m.reason = nil
 }
 
-// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
-func (m *LLMRequestEventMutation) AddedLatencyMs() (r int64, exists bool) {
-	v := m.addlatency_ms
-	if v == nil {
-		return
+// Where appends a list predicates to the GemEventMutation builder.
+func (m *GemEventMutation) Where(ps ...predicate.GemEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GemEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GemEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GemEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetLatencyMs resets all changes to the "latency_ms" field.
-func (m *LLMRequestEventMutation) ResetLatencyMs() {
-	m.latency_ms = nil
-	m.addlatency_ms = nil
+// Op returns the operation name.
+func (m *GemEventMutation) Op() Op {
+	return m.op
 }
 
-// SetSuccess sets the "success" field.
-func (m *LLMRequestEventMutation) SetSuccess(b bool) {
-	m.success = &b
+// SetOp allows setting the mutation operation.
+func (m *GemEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *LLMRequestEventMutation) Success() (r bool, exists bool) {
-	v := m.success
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (GemEvent).
+func (m *GemEventMutation) Type() string {
+	return m.typ
 }
 
-// OldSuccess returns the old "success" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldSuccess(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
-	}
-	return oldValue.Success, nil
-}
-
-// ResetSuccess resets all changes to the "success" field.
-func (m *LLMRequestEventMutation) ResetSuccess() {
-	m.success = nil
-}
-
-// SetErrorMessage sets the "error_message" field.
-func (m *LLMRequestEventMutation) SetErrorMessage(s string) {
-	m.error_message = &s
-}
-
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *LLMRequestEventMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldErrorMessage returns the old "error_message" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
-	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *LLMRequestEventMutation) ResetErrorMessage() {
-	m.error_message = nil
-}
-
-// SetRequestBody sets the "request_body" field.
-func (m *LLMRequestEventMutation) SetRequestBody(s string) {
-	m.request_body = &s
-}
-
-// RequestBody returns the value of the "request_body" field in the mutation.
-func (m *LLMRequestEventMutation) RequestBody() (r string, exists bool) {
-	v := m.request_body
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRequestBody returns the old "request_body" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldRequestBody(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestBody is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestBody requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestBody: %w", err)
-	}
-	return oldValue.RequestBody, nil
-}
-
-// ResetRequestBody resets all changes to the "request_body" field.
-func (m *LLMRequestEventMutation) ResetRequestBody() {
-	m.request_body = nil
-}
-
-// SetResponseBody sets the "response_body" field.
-func (m *LLMRequestEventMutation) SetResponseBody(s string) {
-	m.response_body = &s
-}
-
-// ResponseBody returns the value of the "response_body" field in the mutation.
-func (m *LLMRequestEventMutation) ResponseBody() (r string, exists bool) {
-	v := m.response_body
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldResponseBody returns the old "response_body" field's value of the LLMRequestEvent entity.
-// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LLMRequestEventMutation) OldResponseBody(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponseBody requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
-	}
-	return oldValue.ResponseBody, nil
-}
-
-// ResetResponseBody resets all changes to the "response_body" field.
-func (m *LLMRequestEventMutation) ResetResponseBody() {
-	m.response_body = nil
-}
-
-// Where appends a list predicates to the LLMRequestEventMutation builder.
-func (m *LLMRequestEventMutation) Where(ps ...predicate.LLMRequestEvent) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the LLMRequestEventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LLMRequestEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LLMRequestEvent, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *LLMRequestEventMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *LLMRequestEventMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (LLMRequestEvent).
-func (m *LLMRequestEventMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *LLMRequestEventMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.sequence != nil {
-		fields = append(fields, llmrequestevent.FieldSequence)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GemEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.sequence != nil {
+		fields = append(fields, gemevent.FieldSequence)
 	}
 	if m.timestamp != nil {
-		fields = append(fields, llmrequestevent.FieldTimestamp)
+		fields = append(fields, gemevent.FieldTimestamp)
 	}
 	if m.owner_id != nil {
-		fields = append(fields, llmrequestevent.FieldOwnerID)
-	}
-	if m.provider != nil {
-		fields = append(fields, llmrequestevent.FieldProvider)
-	}
-	if m.model != nil {
-		fields = append(fields, llmrequestevent.FieldModel)
-	}
-	if m.purpose != nil {
-		fields = append(fields, llmrequestevent.FieldPurpose)
-	}
-	if m.input_tokens != nil {
-		fields = append(fields, llmrequestevent.FieldInputTokens)
+		fields = append(fields, gemevent.FieldOwnerID)
 	}
-	if m.output_tokens != nil {
-		fields = append(fields, llmrequestevent.FieldOutputTokens)
+	if m.gem_type != nil {
+		fields = append(fields, gemevent.FieldGemType)
 	}
-	if m.latency_ms != nil {
-		fields = append(fields, llmrequestevent.FieldLatencyMs)
+	if m.rarity != nil {
+		fields = append(fields, gemevent.FieldRarity)
 	}
-	if m.success != nil {
-		fields = append(fields, llmrequestevent.FieldSuccess)
+	if m.skill_id != nil {
+		fields = append(fields, gemevent.FieldSkillID)
 	}
-	if m.error_message != nil {
-		fields = append(fields, llmrequestevent.FieldErrorMessage)
+	if m.skill_name != nil {
+		fields = append(fields, gemevent.FieldSkillName)
 	}
-	if m.request_body != nil {
-		fields = append(fields, llmrequestevent.FieldRequestBody)
+	if m.session_id != nil {
+		fields = append(fields, gemevent.FieldSessionID)
 	}
-	if m.response_body != nil {
-		fields = append(fields, llmrequestevent.FieldResponseBody)
+	if m.reason != nil {
+		fields = append(fields, gemevent.FieldReason)
 	}
 	return fields
 }
@@ -9628,34 +9357,26 @@ func (m *LLMRequestEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LLMRequestEventMutation) Field(name string) (ent.Value, bool) {
+func (m *GemEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		return m.Sequence()
-	case llmrequestevent.FieldTimestamp:
+	case gemevent.FieldTimestamp:
 		return m.Timestamp()
-	case llmrequestevent.FieldOwnerID:
+	case gemevent.FieldOwnerID:
 		return m.OwnerID()
-	case llmrequestevent.FieldProvider:
-		return m.Provider()
-	case llmrequestevent.FieldModel:
-		return m.Model()
-	case llmrequestevent.FieldPurpose:
-		return m.Purpose()
-	case llmrequestevent.FieldInputTokens:
-		return m.InputTokens()
-	case llmrequestevent.FieldOutputTokens:
-		return m.OutputTokens()
-	case llmrequestevent.FieldLatencyMs:
-		return m.LatencyMs()
-	case llmrequestevent.FieldSuccess:
-		return m.Success()
-	case llmrequestevent.FieldErrorMessage:
-		return m.ErrorMessage()
-	case llmrequestevent.FieldRequestBody:
-		return m.RequestBody()
-	case llmrequestevent.FieldResponseBody:
-		return m.ResponseBody()
+	case gemevent.FieldGemType:
+		return m.GemType()
+	case gemevent.FieldRarity:
+		return m.Rarity()
+	case gemevent.FieldSkillID:
+		return m.SkillID()
+	case gemevent.FieldSkillName:
+		return m.SkillName()
+	case gemevent.FieldSessionID:
+		return m.SessionID()
+	case gemevent.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -9663,153 +9384,108 @@ func (m *LLMRequestEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LLMRequestEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GemEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		return m.OldSequence(ctx)
-	case llmrequestevent.FieldTimestamp:
+	case gemevent.FieldTimestamp:
 		return m.OldTimestamp(ctx)
-	case llmrequestevent.FieldOwnerID:
+	case gemevent.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case llmrequestevent.FieldProvider:
-		return m.OldProvider(ctx)
-	case llmrequestevent.FieldModel:
-		return m.OldModel(ctx)
-	case llmrequestevent.FieldPurpose:
-		return m.OldPurpose(ctx)
-	case llmrequestevent.FieldInputTokens:
-		return m.OldInputTokens(ctx)
-	case llmrequestevent.FieldOutputTokens:
-		return m.OldOutputTokens(ctx)
-	case llmrequestevent.FieldLatencyMs:
-		return m.OldLatencyMs(ctx)
-	case llmrequestevent.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case llmrequestevent.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case llmrequestevent.FieldRequestBody:
-		return m.OldRequestBody(ctx)
-	case llmrequestevent.FieldResponseBody:
-		return m.OldResponseBody(ctx)
+	case gemevent.FieldGemType:
+		return m.OldGemType(ctx)
+	case gemevent.FieldRarity:
+		return m.OldRarity(ctx)
+	case gemevent.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case gemevent.FieldSkillName:
+		return m.OldSkillName(ctx)
+	case gemevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case gemevent.FieldReason:
+		return m.OldReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown LLMRequestEvent field %s", name)
+	return nil, fmt.Errorf("unknown GemEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LLMRequestEventMutation) SetField(name string, value ent.Value) error {
+func (m *GemEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSequence(v)
 		return nil
-	case llmrequestevent.FieldTimestamp:
+	case gemevent.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTimestamp(v)
 		return nil
-	case llmrequestevent.FieldOwnerID:
+	case gemevent.FieldOwnerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwnerID(v)
 		return nil
-	case llmrequestevent.FieldProvider:
+	case gemevent.FieldGemType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.SetGemType(v)
 		return nil
-	case llmrequestevent.FieldModel:
+	case gemevent.FieldRarity:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetRarity(v)
 		return nil
-	case llmrequestevent.FieldPurpose:
+	case gemevent.FieldSkillID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPurpose(v)
-		return nil
-	case llmrequestevent.FieldInputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInputTokens(v)
-		return nil
-	case llmrequestevent.FieldOutputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutputTokens(v)
-		return nil
-	case llmrequestevent.FieldLatencyMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLatencyMs(v)
-		return nil
-	case llmrequestevent.FieldSuccess:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuccess(v)
+		m.SetSkillID(v)
 		return nil
-	case llmrequestevent.FieldErrorMessage:
+	case gemevent.FieldSkillName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetSkillName(v)
 		return nil
-	case llmrequestevent.FieldRequestBody:
+	case gemevent.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequestBody(v)
+		m.SetSessionID(v)
 		return nil
-	case llmrequestevent.FieldResponseBody:
+	case gemevent.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResponseBody(v)
+		m.SetReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LLMRequestEvent field %s", name)
+	return fmt.Errorf("unknown GemEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LLMRequestEventMutation) AddedFields() []string {
+func (m *GemEventMutation) AddedFields() []string {
 	var fields []string
 	if m.addsequence != nil {
-		fields = append(fields, llmrequestevent.FieldSequence)
-	}
-	if m.addinput_tokens != nil {
-		fields = append(fields, llmrequestevent.FieldInputTokens)
-	}
-	if m.addoutput_tokens != nil {
-		fields = append(fields, llmrequestevent.FieldOutputTokens)
-	}
-	if m.addlatency_ms != nil {
-		fields = append(fields, llmrequestevent.FieldLatencyMs)
+		fields = append(fields, gemevent.FieldSequence)
 	}
 	return fields
 }
@@ -9817,16 +9493,10 @@ func (m *LLMRequestEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LLMRequestEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GemEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		return m.AddedSequence()
-	case llmrequestevent.FieldInputTokens:
-		return m.AddedInputTokens()
-	case llmrequestevent.FieldOutputTokens:
-		return m.AddedOutputTokens()
-	case llmrequestevent.FieldLatencyMs:
-		return m.AddedLatencyMs()
 	}
 	return nil, false
 }
@@ -9834,189 +9504,167 @@ func (m *LLMRequestEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LLMRequestEventMutation) AddField(name string, value ent.Value) error {
+func (m *GemEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddSequence(v)
 		return nil
-	case llmrequestevent.FieldInputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputTokens(v)
-		return nil
-	case llmrequestevent.FieldOutputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputTokens(v)
-		return nil
-	case llmrequestevent.FieldLatencyMs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLatencyMs(v)
-		return nil
 	}
-	return fmt.Errorf("unknown LLMRequestEvent numeric field %s", name)
+	return fmt.Errorf("unknown GemEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LLMRequestEventMutation) ClearedFields() []string {
-	return nil
+func (m *GemEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gemevent.FieldSkillID) {
+		fields = append(fields, gemevent.FieldSkillID)
+	}
+	if m.FieldCleared(gemevent.FieldSkillName) {
+		fields = append(fields, gemevent.FieldSkillName)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LLMRequestEventMutation) FieldCleared(name string) bool {
+func (m *GemEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LLMRequestEventMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LLMRequestEvent nullable field %s", name)
+func (m *GemEventMutation) ClearField(name string) error {
+	switch name {
+	case gemevent.FieldSkillID:
+		m.ClearSkillID()
+		return nil
+	case gemevent.FieldSkillName:
+		m.ClearSkillName()
+		return nil
+	}
+	return fmt.Errorf("unknown GemEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LLMRequestEventMutation) ResetField(name string) error {
+func (m *GemEventMutation) ResetField(name string) error {
 	switch name {
-	case llmrequestevent.FieldSequence:
+	case gemevent.FieldSequence:
 		m.ResetSequence()
 		return nil
-	case llmrequestevent.FieldTimestamp:
+	case gemevent.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
-	case llmrequestevent.FieldOwnerID:
+	case gemevent.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case llmrequestevent.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case llmrequestevent.FieldModel:
-		m.ResetModel()
-		return nil
-	case llmrequestevent.FieldPurpose:
-		m.ResetPurpose()
-		return nil
-	case llmrequestevent.FieldInputTokens:
-		m.ResetInputTokens()
-		return nil
-	case llmrequestevent.FieldOutputTokens:
-		m.ResetOutputTokens()
+	case gemevent.FieldGemType:
+		m.ResetGemType()
 		return nil
-	case llmrequestevent.FieldLatencyMs:
-		m.ResetLatencyMs()
+	case gemevent.FieldRarity:
+		m.ResetRarity()
 		return nil
-	case llmrequestevent.FieldSuccess:
-		m.ResetSuccess()
+	case gemevent.FieldSkillID:
+		m.ResetSkillID()
 		return nil
-	case llmrequestevent.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case gemevent.FieldSkillName:
+		m.ResetSkillName()
 		return nil
-	case llmrequestevent.FieldRequestBody:
-		m.ResetRequestBody()
+	case gemevent.FieldSessionID:
+		m.ResetSessionID()
 		return nil
-	case llmrequestevent.FieldResponseBody:
-		m.ResetResponseBody()
+	case gemevent.FieldReason:
+		m.ResetReason()
 		return nil
 	}
-	return fmt.Errorf("unknown LLMRequestEvent field %s", name)
+	return fmt.Errorf("unknown GemEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LLMRequestEventMutation) AddedEdges() []string {
+func (m *GemEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LLMRequestEventMutation) AddedIDs(name string) []ent.Value {
+func (m *GemEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LLMRequestEventMutation) RemovedEdges() []string {
+func (m *GemEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LLMRequestEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *GemEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LLMRequestEventMutation) ClearedEdges() []string {
+func (m *GemEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LLMRequestEventMutation) EdgeCleared(name string) bool {
+func (m *GemEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LLMRequestEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LLMRequestEvent unique edge %s", name)
+func (m *GemEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GemEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LLMRequestEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LLMRequestEvent edge %s", name)
+func (m *GemEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GemEvent edge %s", name)
 }
 
-// LearnerProfileEventMutation represents an operation that mutates the LearnerProfileEvent nodes in the graph.
-type LearnerProfileEventMutation struct {
+// HintEventMutation represents an operation that mutates the HintEvent nodes in the graph.
+type HintEventMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	sequence         *int64
-	addsequence      *int64
-	timestamp        *time.Time
-	owner_id         *string
-	summary          *string
-	strengths        *[]string
-	appendstrengths  []string
-	weaknesses       *[]string
-	appendweaknesses []string
-	patterns         *[]string
-	appendpatterns   []string
-	generated_at     *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*LearnerProfileEvent, error)
-	predicates       []predicate.LearnerProfileEvent
-}
-
-var _ ent.Mutation = (*LearnerProfileEventMutation)(nil)
-
-// learnerprofileeventOption allows management of the mutation configuration using functional options.
-type learnerprofileeventOption func(*LearnerProfileEventMutation)
+	op            Op
+	typ           string
+	id            *int
+	sequence      *int64
+	addsequence   *int64
+	timestamp     *time.Time
+	owner_id      *string
+	session_id    *string
+	skill_id      *string
+	question_text *string
+	hint_text     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*HintEvent, error)
+	predicates    []predicate.HintEvent
+}
 
-// newLearnerProfileEventMutation creates new mutation for the LearnerProfileEvent entity.
-func newLearnerProfileEventMutation(c config, op Op, opts ...learnerprofileeventOption) *LearnerProfileEventMutation {
-	m := &LearnerProfileEventMutation{
+var _ ent.Mutation = (*HintEventMutation)(nil)
+
+// hinteventOption allows management of the mutation configuration using functional options.
+type hinteventOption func(*HintEventMutation)
+
+// newHintEventMutation creates new mutation for the HintEvent entity.
+func newHintEventMutation(c config, op Op, opts ...hinteventOption) *HintEventMutation {
+	m := &HintEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLearnerProfileEvent,
+		typ:           TypeHintEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10025,20 +9673,20 @@ func newLearnerProfileEventMutation(c config, op Op, opts ...learnerprofileevent
 	return m
 }
 
-// withLearnerProfileEventID sets the ID field of the mutation.
-func withLearnerProfileEventID(id int) learnerprofileeventOption {
-	return func(m *LearnerProfileEventMutation) {
+// withHintEventID sets the ID field of the mutation.
+func withHintEventID(id int) hinteventOption {
+	return func(m *HintEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LearnerProfileEvent
+			value *HintEvent
 		)
-		m.oldValue = func(ctx context.Context) (*LearnerProfileEvent, error) {
+		m.oldValue = func(ctx context.Context) (*HintEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LearnerProfileEvent.Get(ctx, id)
+					value, err = m.Client().HintEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10047,10 +9695,10 @@ func withLearnerProfileEventID(id int) learnerprofileeventOption {
 	}
 }
 
-// withLearnerProfileEvent sets the old LearnerProfileEvent of the mutation.
-func withLearnerProfileEvent(node *LearnerProfileEvent) learnerprofileeventOption {
-	return func(m *LearnerProfileEventMutation) {
-		m.oldValue = func(context.Context) (*LearnerProfileEvent, error) {
+// withHintEvent sets the old HintEvent of the mutation.
+func withHintEvent(node *HintEvent) hinteventOption {
+	return func(m *HintEventMutation) {
+		m.oldValue = func(context.Context) (*HintEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10059,7 +9707,7 @@ func withLearnerProfileEvent(node *LearnerProfileEvent) learnerprofileeventOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LearnerProfileEventMutation) Client() *Client {
+func (m HintEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10067,7 +9715,7 @@ func (m LearnerProfileEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LearnerProfileEventMutation) Tx() (*Tx, error) {
+func (m HintEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10078,7 +9726,7 @@ func (m LearnerProfileEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LearnerProfileEventMutation) ID() (id int, exists bool) {
+func (m *HintEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10089,7 +9737,7 @@ func (m *LearnerProfileEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LearnerProfileEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HintEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10098,20 +9746,20 @@ func (m *LearnerProfileEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LearnerProfileEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().HintEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetSequence sets the "sequence" field.
-func (m *LearnerProfileEventMutation) SetSequence(i int64) {
+func (m *HintEventMutation) SetSequence(i int64) {
 	m.sequence = &i
 	m.addsequence = nil
 }
 
 // Sequence returns the value of the "sequence" field in the mutation.
-func (m *LearnerProfileEventMutation) Sequence() (r int64, exists bool) {
+func (m *HintEventMutation) Sequence() (r int64, exists bool) {
 	v := m.sequence
 	if v == nil {
 		return
@@ -10119,10 +9767,10 @@ func (m *LearnerProfileEventMutation) Sequence() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *HintEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
@@ -10137,7 +9785,7 @@ func (m *LearnerProfileEventMutation) OldSequence(ctx context.Context) (v int64,
 }
 
 // AddSequence adds i to the "sequence" field.
-func (m *LearnerProfileEventMutation) AddSequence(i int64) {
+func (m *HintEventMutation) AddSequence(i int64) {
 	if m.addsequence != nil {
 		*m.addsequence += i
 	} else {
@@ -10146,7 +9794,7 @@ func (m *LearnerProfileEventMutation) AddSequence(i int64) {
 }
 
 // AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *LearnerProfileEventMutation) AddedSequence() (r int64, exists bool) {
+func (m *HintEventMutation) AddedSequence() (r int64, exists bool) {
 	v := m.addsequence
 	if v == nil {
 		return
@@ -10155,18 +9803,18 @@ func (m *LearnerProfileEventMutation) AddedSequence() (r int64, exists bool) {
 }
 
 // ResetSequence resets all changes to the "sequence" field.
-func (m *LearnerProfileEventMutation) ResetSequence() {
+func (m *HintEventMutation) ResetSequence() {
 	m.sequence = nil
 	m.addsequence = nil
 }
 
 // SetTimestamp sets the "timestamp" field.
-func (m *LearnerProfileEventMutation) SetTimestamp(t time.Time) {
+func (m *HintEventMutation) SetTimestamp(t time.Time) {
 	m.timestamp = &t
 }
 
 // Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *LearnerProfileEventMutation) Timestamp() (r time.Time, exists bool) {
+func (m *HintEventMutation) Timestamp() (r time.Time, exists bool) {
 	v := m.timestamp
 	if v == nil {
 		return
@@ -10174,10 +9822,10 @@ func (m *LearnerProfileEventMutation) Timestamp() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *HintEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
@@ -10192,17 +9840,17 @@ func (m *LearnerProfileEventMutation) OldTimestamp(ctx context.Context) (v time.
 }
 
 // ResetTimestamp resets all changes to the "timestamp" field.
-func (m *LearnerProfileEventMutation) ResetTimestamp() {
+func (m *HintEventMutation) ResetTimestamp() {
 	m.timestamp = nil
 }
 
 // SetOwnerID sets the "owner_id" field.
-func (m *LearnerProfileEventMutation) SetOwnerID(s string) {
+func (m *HintEventMutation) SetOwnerID(s string) {
 	m.owner_id = &s
 }
 
 // OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *LearnerProfileEventMutation) OwnerID() (r string, exists bool) {
+func (m *HintEventMutation) OwnerID() (r string, exists bool) {
 	v := m.owner_id
 	if v == nil {
 		return
@@ -10210,10 +9858,10 @@ func (m *LearnerProfileEventMutation) OwnerID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+func (m *HintEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
@@ -10228,286 +9876,163 @@ func (m *LearnerProfileEventMutation) OldOwnerID(ctx context.Context) (v string,
 }
 
 // ResetOwnerID resets all changes to the "owner_id" field.
-func (m *LearnerProfileEventMutation) ResetOwnerID() {
+func (m *HintEventMutation) ResetOwnerID() {
 	m.owner_id = nil
 }
 
-// SetSummary sets the "summary" field.
-func (m *LearnerProfileEventMutation) SetSummary(s string) {
-	m.summary = &s
+// SetSessionID sets the "session_id" field.
+func (m *HintEventMutation) SetSessionID(s string) {
+	m.session_id = &s
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *LearnerProfileEventMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *HintEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *HintEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
-	return oldValue.Summary, nil
+	return oldValue.SessionID, nil
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *LearnerProfileEventMutation) ResetSummary() {
-	m.summary = nil
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *HintEventMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// SetStrengths sets the "strengths" field.
-func (m *LearnerProfileEventMutation) SetStrengths(s []string) {
-	m.strengths = &s
-	m.appendstrengths = nil
+// SetSkillID sets the "skill_id" field.
+func (m *HintEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
 }
 
-// Strengths returns the value of the "strengths" field in the mutation.
-func (m *LearnerProfileEventMutation) Strengths() (r []string, exists bool) {
-	v := m.strengths
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *HintEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrengths returns the old "strengths" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillID returns the old "skill_id" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldStrengths(ctx context.Context) (v []string, err error) {
+func (m *HintEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrengths is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrengths requires an ID field in the mutation")
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrengths: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
 	}
-	return oldValue.Strengths, nil
+	return oldValue.SkillID, nil
 }
 
-// AppendStrengths adds s to the "strengths" field.
-func (m *LearnerProfileEventMutation) AppendStrengths(s []string) {
-	m.appendstrengths = append(m.appendstrengths, s...)
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *HintEventMutation) ResetSkillID() {
+	m.skill_id = nil
 }
 
-// AppendedStrengths returns the list of values that were appended to the "strengths" field in this mutation.
-func (m *LearnerProfileEventMutation) AppendedStrengths() ([]string, bool) {
-	if len(m.appendstrengths) == 0 {
-		return nil, false
-	}
-	return m.appendstrengths, true
+// SetQuestionText sets the "question_text" field.
+func (m *HintEventMutation) SetQuestionText(s string) {
+	m.question_text = &s
 }
 
-// ClearStrengths clears the value of the "strengths" field.
-func (m *LearnerProfileEventMutation) ClearStrengths() {
-	m.strengths = nil
-	m.appendstrengths = nil
-	m.clearedFields[learnerprofileevent.FieldStrengths] = struct{}{}
+// QuestionText returns the value of the "question_text" field in the mutation.
+func (m *HintEventMutation) QuestionText() (r string, exists bool) {
+	v := m.question_text
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// StrengthsCleared returns if the "strengths" field was cleared in this mutation.
-func (m *LearnerProfileEventMutation) StrengthsCleared() bool {
-	_, ok := m.clearedFields[learnerprofileevent.FieldStrengths]
-	return ok
+// OldQuestionText returns the old "question_text" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HintEventMutation) OldQuestionText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
+	}
+	return oldValue.QuestionText, nil
 }
 
-// ResetStrengths resets all changes to the "strengths" field.
-func (m *LearnerProfileEventMutation) ResetStrengths() {
-	m.strengths = nil
-	m.appendstrengths = nil
-	delete(m.clearedFields, learnerprofileevent.FieldStrengths)
+// ResetQuestionText resets all changes to the "question_text" field.
+func (m *HintEventMutation) ResetQuestionText() {
+	m.question_text = nil
 }
 
-// SetWeaknesses sets the "weaknesses" field.
-func (m *LearnerProfileEventMutation) SetWeaknesses(s []string) {
-	m.weaknesses = &s
-	m.appendweaknesses = nil
+// SetHintText sets the "hint_text" field.
+func (m *HintEventMutation) SetHintText(s string) {
+	m.hint_text = &s
 }
 
-// Weaknesses returns the value of the "weaknesses" field in the mutation.
-func (m *LearnerProfileEventMutation) Weaknesses() (r []string, exists bool) {
-	v := m.weaknesses
+// HintText returns the value of the "hint_text" field in the mutation.
+func (m *HintEventMutation) HintText() (r string, exists bool) {
+	v := m.hint_text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeaknesses returns the old "weaknesses" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldHintText returns the old "hint_text" field's value of the HintEvent entity.
+// If the HintEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldWeaknesses(ctx context.Context) (v []string, err error) {
+func (m *HintEventMutation) OldHintText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeaknesses is only allowed on UpdateOne operations")
+		return v, errors.New("OldHintText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeaknesses requires an ID field in the mutation")
+		return v, errors.New("OldHintText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeaknesses: %w", err)
+		return v, fmt.Errorf("querying old value for OldHintText: %w", err)
 	}
-	return oldValue.Weaknesses, nil
+	return oldValue.HintText, nil
 }
 
-// AppendWeaknesses adds s to the "weaknesses" field.
-func (m *LearnerProfileEventMutation) AppendWeaknesses(s []string) {
-	m.appendweaknesses = append(m.appendweaknesses, s...)
+// ResetHintText resets all changes to the "hint_text" field.
+func (m *HintEventMutation) ResetHintText() {
+	m.hint_text = nil
 }
 
-// AppendedWeaknesses returns the list of values that were appended to the "weaknesses" field in this mutation.
-func (m *LearnerProfileEventMutation) AppendedWeaknesses() ([]string, bool) {
-	if len(m.appendweaknesses) == 0 {
-		return nil, false
-	}
-	return m.appendweaknesses, true
+// Where appends a list predicates to the HintEventMutation builder.
+func (m *HintEventMutation) Where(ps ...predicate.HintEvent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearWeaknesses clears the value of the "weaknesses" field.
-func (m *LearnerProfileEventMutation) ClearWeaknesses() {
-	m.weaknesses = nil
-	m.appendweaknesses = nil
-	m.clearedFields[learnerprofileevent.FieldWeaknesses] = struct{}{}
-}
-
-// WeaknessesCleared returns if the "weaknesses" field was cleared in this mutation.
-func (m *LearnerProfileEventMutation) WeaknessesCleared() bool {
-	_, ok := m.clearedFields[learnerprofileevent.FieldWeaknesses]
-	return ok
-}
-
-// ResetWeaknesses resets all changes to the "weaknesses" field.
-func (m *LearnerProfileEventMutation) ResetWeaknesses() {
-	m.weaknesses = nil
-	m.appendweaknesses = nil
-	delete(m.clearedFields, learnerprofileevent.FieldWeaknesses)
-}
-
-// SetPatterns sets the "patterns" field.
-func (m *LearnerProfileEventMutation) SetPatterns(s []string) {
-	m.patterns = &s
-	m.appendpatterns = nil
-}
-
-// Patterns returns the value of the "patterns" field in the mutation.
-func (m *LearnerProfileEventMutation) Patterns() (r []string, exists bool) {
-	v := m.patterns
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPatterns returns the old "patterns" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldPatterns(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPatterns is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPatterns requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPatterns: %w", err)
-	}
-	return oldValue.Patterns, nil
-}
-
-// AppendPatterns adds s to the "patterns" field.
-func (m *LearnerProfileEventMutation) AppendPatterns(s []string) {
-	m.appendpatterns = append(m.appendpatterns, s...)
-}
-
-// AppendedPatterns returns the list of values that were appended to the "patterns" field in this mutation.
-func (m *LearnerProfileEventMutation) AppendedPatterns() ([]string, bool) {
-	if len(m.appendpatterns) == 0 {
-		return nil, false
-	}
-	return m.appendpatterns, true
-}
-
-// ClearPatterns clears the value of the "patterns" field.
-func (m *LearnerProfileEventMutation) ClearPatterns() {
-	m.patterns = nil
-	m.appendpatterns = nil
-	m.clearedFields[learnerprofileevent.FieldPatterns] = struct{}{}
-}
-
-// PatternsCleared returns if the "patterns" field was cleared in this mutation.
-func (m *LearnerProfileEventMutation) PatternsCleared() bool {
-	_, ok := m.clearedFields[learnerprofileevent.FieldPatterns]
-	return ok
-}
-
-// ResetPatterns resets all changes to the "patterns" field.
-func (m *LearnerProfileEventMutation) ResetPatterns() {
-	m.patterns = nil
-	m.appendpatterns = nil
-	delete(m.clearedFields, learnerprofileevent.FieldPatterns)
-}
-
-// SetGeneratedAt sets the "generated_at" field.
-func (m *LearnerProfileEventMutation) SetGeneratedAt(s string) {
-	m.generated_at = &s
-}
-
-// GeneratedAt returns the value of the "generated_at" field in the mutation.
-func (m *LearnerProfileEventMutation) GeneratedAt() (r string, exists bool) {
-	v := m.generated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGeneratedAt returns the old "generated_at" field's value of the LearnerProfileEvent entity.
-// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearnerProfileEventMutation) OldGeneratedAt(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
-	}
-	return oldValue.GeneratedAt, nil
-}
-
-// ResetGeneratedAt resets all changes to the "generated_at" field.
-func (m *LearnerProfileEventMutation) ResetGeneratedAt() {
-	m.generated_at = nil
-}
-
-// Where appends a list predicates to the LearnerProfileEventMutation builder.
-func (m *LearnerProfileEventMutation) Where(ps ...predicate.LearnerProfileEvent) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the LearnerProfileEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the HintEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LearnerProfileEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LearnerProfileEvent, len(ps))
+func (m *HintEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HintEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10515,48 +10040,45 @@ func (m *LearnerProfileEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LearnerProfileEventMutation) Op() Op {
+func (m *HintEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LearnerProfileEventMutation) SetOp(op Op) {
+func (m *HintEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LearnerProfileEvent).
-func (m *LearnerProfileEventMutation) Type() string {
+// Type returns the node type of this mutation (HintEvent).
+func (m *HintEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LearnerProfileEventMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *HintEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.sequence != nil {
-		fields = append(fields, learnerprofileevent.FieldSequence)
+		fields = append(fields, hintevent.FieldSequence)
 	}
 	if m.timestamp != nil {
-		fields = append(fields, learnerprofileevent.FieldTimestamp)
+		fields = append(fields, hintevent.FieldTimestamp)
 	}
 	if m.owner_id != nil {
-		fields = append(fields, learnerprofileevent.FieldOwnerID)
-	}
-	if m.summary != nil {
-		fields = append(fields, learnerprofileevent.FieldSummary)
+		fields = append(fields, hintevent.FieldOwnerID)
 	}
-	if m.strengths != nil {
-		fields = append(fields, learnerprofileevent.FieldStrengths)
+	if m.session_id != nil {
+		fields = append(fields, hintevent.FieldSessionID)
 	}
-	if m.weaknesses != nil {
-		fields = append(fields, learnerprofileevent.FieldWeaknesses)
+	if m.skill_id != nil {
+		fields = append(fields, hintevent.FieldSkillID)
 	}
-	if m.patterns != nil {
-		fields = append(fields, learnerprofileevent.FieldPatterns)
+	if m.question_text != nil {
+		fields = append(fields, hintevent.FieldQuestionText)
 	}
-	if m.generated_at != nil {
-		fields = append(fields, learnerprofileevent.FieldGeneratedAt)
+	if m.hint_text != nil {
+		fields = append(fields, hintevent.FieldHintText)
 	}
 	return fields
 }
@@ -10564,24 +10086,22 @@ func (m *LearnerProfileEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LearnerProfileEventMutation) Field(name string) (ent.Value, bool) {
+func (m *HintEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		return m.Sequence()
-	case learnerprofileevent.FieldTimestamp:
+	case hintevent.FieldTimestamp:
 		return m.Timestamp()
-	case learnerprofileevent.FieldOwnerID:
+	case hintevent.FieldOwnerID:
 		return m.OwnerID()
-	case learnerprofileevent.FieldSummary:
-		return m.Summary()
-	case learnerprofileevent.FieldStrengths:
-		return m.Strengths()
-	case learnerprofileevent.FieldWeaknesses:
-		return m.Weaknesses()
-	case learnerprofileevent.FieldPatterns:
-		return m.Patterns()
-	case learnerprofileevent.FieldGeneratedAt:
-		return m.GeneratedAt()
+	case hintevent.FieldSessionID:
+		return m.SessionID()
+	case hintevent.FieldSkillID:
+		return m.SkillID()
+	case hintevent.FieldQuestionText:
+		return m.QuestionText()
+	case hintevent.FieldHintText:
+		return m.HintText()
 	}
 	return nil, false
 }
@@ -10589,99 +10109,90 @@ func (m *LearnerProfileEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LearnerProfileEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HintEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		return m.OldSequence(ctx)
-	case learnerprofileevent.FieldTimestamp:
+	case hintevent.FieldTimestamp:
 		return m.OldTimestamp(ctx)
-	case learnerprofileevent.FieldOwnerID:
+	case hintevent.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case learnerprofileevent.FieldSummary:
-		return m.OldSummary(ctx)
-	case learnerprofileevent.FieldStrengths:
-		return m.OldStrengths(ctx)
-	case learnerprofileevent.FieldWeaknesses:
-		return m.OldWeaknesses(ctx)
-	case learnerprofileevent.FieldPatterns:
-		return m.OldPatterns(ctx)
-	case learnerprofileevent.FieldGeneratedAt:
-		return m.OldGeneratedAt(ctx)
+	case hintevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case hintevent.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case hintevent.FieldQuestionText:
+		return m.OldQuestionText(ctx)
+	case hintevent.FieldHintText:
+		return m.OldHintText(ctx)
 	}
-	return nil, fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+	return nil, fmt.Errorf("unknown HintEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LearnerProfileEventMutation) SetField(name string, value ent.Value) error {
+func (m *HintEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSequence(v)
 		return nil
-	case learnerprofileevent.FieldTimestamp:
+	case hintevent.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTimestamp(v)
 		return nil
-	case learnerprofileevent.FieldOwnerID:
+	case hintevent.FieldOwnerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwnerID(v)
 		return nil
-	case learnerprofileevent.FieldSummary:
+	case hintevent.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummary(v)
-		return nil
-	case learnerprofileevent.FieldStrengths:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStrengths(v)
+		m.SetSessionID(v)
 		return nil
-	case learnerprofileevent.FieldWeaknesses:
-		v, ok := value.([]string)
+	case hintevent.FieldSkillID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeaknesses(v)
+		m.SetSkillID(v)
 		return nil
-	case learnerprofileevent.FieldPatterns:
-		v, ok := value.([]string)
+	case hintevent.FieldQuestionText:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPatterns(v)
+		m.SetQuestionText(v)
 		return nil
-	case learnerprofileevent.FieldGeneratedAt:
+	case hintevent.FieldHintText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGeneratedAt(v)
+		m.SetHintText(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+	return fmt.Errorf("unknown HintEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LearnerProfileEventMutation) AddedFields() []string {
+func (m *HintEventMutation) AddedFields() []string {
 	var fields []string
 	if m.addsequence != nil {
-		fields = append(fields, learnerprofileevent.FieldSequence)
+		fields = append(fields, hintevent.FieldSequence)
 	}
 	return fields
 }
@@ -10689,9 +10200,9 @@ func (m *LearnerProfileEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LearnerProfileEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *HintEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		return m.AddedSequence()
 	}
 	return nil, false
@@ -10700,9 +10211,9 @@ func (m *LearnerProfileEventMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LearnerProfileEventMutation) AddField(name string, value ent.Value) error {
+func (m *HintEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -10710,167 +10221,134 @@ func (m *LearnerProfileEventMutation) AddField(name string, value ent.Value) err
 		m.AddSequence(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LearnerProfileEvent numeric field %s", name)
+	return fmt.Errorf("unknown HintEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LearnerProfileEventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(learnerprofileevent.FieldStrengths) {
-		fields = append(fields, learnerprofileevent.FieldStrengths)
-	}
-	if m.FieldCleared(learnerprofileevent.FieldWeaknesses) {
-		fields = append(fields, learnerprofileevent.FieldWeaknesses)
-	}
-	if m.FieldCleared(learnerprofileevent.FieldPatterns) {
-		fields = append(fields, learnerprofileevent.FieldPatterns)
-	}
-	return fields
+func (m *HintEventMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LearnerProfileEventMutation) FieldCleared(name string) bool {
+func (m *HintEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LearnerProfileEventMutation) ClearField(name string) error {
-	switch name {
-	case learnerprofileevent.FieldStrengths:
-		m.ClearStrengths()
-		return nil
-	case learnerprofileevent.FieldWeaknesses:
-		m.ClearWeaknesses()
-		return nil
-	case learnerprofileevent.FieldPatterns:
-		m.ClearPatterns()
-		return nil
-	}
-	return fmt.Errorf("unknown LearnerProfileEvent nullable field %s", name)
+func (m *HintEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HintEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LearnerProfileEventMutation) ResetField(name string) error {
+func (m *HintEventMutation) ResetField(name string) error {
 	switch name {
-	case learnerprofileevent.FieldSequence:
+	case hintevent.FieldSequence:
 		m.ResetSequence()
 		return nil
-	case learnerprofileevent.FieldTimestamp:
+	case hintevent.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
-	case learnerprofileevent.FieldOwnerID:
+	case hintevent.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case learnerprofileevent.FieldSummary:
-		m.ResetSummary()
-		return nil
-	case learnerprofileevent.FieldStrengths:
-		m.ResetStrengths()
+	case hintevent.FieldSessionID:
+		m.ResetSessionID()
 		return nil
-	case learnerprofileevent.FieldWeaknesses:
-		m.ResetWeaknesses()
+	case hintevent.FieldSkillID:
+		m.ResetSkillID()
 		return nil
-	case learnerprofileevent.FieldPatterns:
-		m.ResetPatterns()
+	case hintevent.FieldQuestionText:
+		m.ResetQuestionText()
 		return nil
-	case learnerprofileevent.FieldGeneratedAt:
-		m.ResetGeneratedAt()
+	case hintevent.FieldHintText:
+		m.ResetHintText()
 		return nil
 	}
-	return fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+	return fmt.Errorf("unknown HintEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LearnerProfileEventMutation) AddedEdges() []string {
+func (m *HintEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LearnerProfileEventMutation) AddedIDs(name string) []ent.Value {
+func (m *HintEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LearnerProfileEventMutation) RemovedEdges() []string {
+func (m *HintEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LearnerProfileEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *HintEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LearnerProfileEventMutation) ClearedEdges() []string {
+func (m *HintEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LearnerProfileEventMutation) EdgeCleared(name string) bool {
+func (m *HintEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LearnerProfileEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LearnerProfileEvent unique edge %s", name)
+func (m *HintEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HintEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LearnerProfileEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LearnerProfileEvent edge %s", name)
+func (m *HintEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HintEvent edge %s", name)
 }
 
-// LessonEventMutation represents an operation that mutates the LessonEvent nodes in the graph.
-type LessonEventMutation struct {
+// InviteMutation represents an operation that mutates the Invite nodes in the graph.
+type InviteMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	sequence             *int64
-	addsequence          *int64
-	timestamp            *time.Time
-	owner_id             *string
-	session_id           *string
-	skill_id             *string
-	lesson_title         *string
-	practice_attempted   *bool
-	practice_correct     *bool
-	practice_skipped     *bool
-	explanation          *string
-	worked_example       *string
-	practice_text        *string
-	practice_answer      *string
-	practice_explanation *string
-	clearedFields        map[string]struct{}
-	done                 bool
-	oldValue             func(context.Context) (*LessonEvent, error)
-	predicates           []predicate.LessonEvent
+	op              Op
+	typ             string
+	id              *int
+	uid             *string
+	family_space_id *string
+	code            *string
+	expires_at      *time.Time
+	revoked         *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Invite, error)
+	predicates      []predicate.Invite
 }
 
-var _ ent.Mutation = (*LessonEventMutation)(nil)
+var _ ent.Mutation = (*InviteMutation)(nil)
 
-// lessoneventOption allows management of the mutation configuration using functional options.
-type lessoneventOption func(*LessonEventMutation)
+// inviteOption allows management of the mutation configuration using functional options.
+type inviteOption func(*InviteMutation)
 
-// newLessonEventMutation creates new mutation for the LessonEvent entity.
-func newLessonEventMutation(c config, op Op, opts ...lessoneventOption) *LessonEventMutation {
-	m := &LessonEventMutation{
+// newInviteMutation creates new mutation for the Invite entity.
+func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
+	m := &InviteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLessonEvent,
+		typ:           TypeInvite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10879,20 +10357,20 @@ func newLessonEventMutation(c config, op Op, opts ...lessoneventOption) *LessonE
 	return m
 }
 
-// withLessonEventID sets the ID field of the mutation.
-func withLessonEventID(id int) lessoneventOption {
-	return func(m *LessonEventMutation) {
+// withInviteID sets the ID field of the mutation.
+func withInviteID(id int) inviteOption {
+	return func(m *InviteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LessonEvent
+			value *Invite
 		)
-		m.oldValue = func(ctx context.Context) (*LessonEvent, error) {
+		m.oldValue = func(ctx context.Context) (*Invite, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LessonEvent.Get(ctx, id)
+					value, err = m.Client().Invite.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10901,10 +10379,10 @@ func withLessonEventID(id int) lessoneventOption {
 	}
 }
 
-// withLessonEvent sets the old LessonEvent of the mutation.
-func withLessonEvent(node *LessonEvent) lessoneventOption {
-	return func(m *LessonEventMutation) {
-		m.oldValue = func(context.Context) (*LessonEvent, error) {
+// withInvite sets the old Invite of the mutation.
+func withInvite(node *Invite) inviteOption {
+	return func(m *InviteMutation) {
+		m.oldValue = func(context.Context) (*Invite, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10913,7 +10391,7 @@ func withLessonEvent(node *LessonEvent) lessoneventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LessonEventMutation) Client() *Client {
+func (m InviteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10921,7 +10399,7 @@ func (m LessonEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LessonEventMutation) Tx() (*Tx, error) {
+func (m InviteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10932,7 +10410,7 @@ func (m LessonEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LessonEventMutation) ID() (id int, exists bool) {
+func (m *InviteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10943,7 +10421,7 @@ func (m *LessonEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LessonEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *InviteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10952,612 +10430,4075 @@ func (m *LessonEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LessonEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Invite.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSequence sets the "sequence" field.
-func (m *LessonEventMutation) SetSequence(i int64) {
-	m.sequence = &i
-	m.addsequence = nil
+// SetUID sets the "uid" field.
+func (m *InviteMutation) SetUID(s string) {
+	m.uid = &s
 }
 
-// Sequence returns the value of the "sequence" field in the mutation.
-func (m *LessonEventMutation) Sequence() (r int64, exists bool) {
-	v := m.sequence
+// UID returns the value of the "uid" field in the mutation.
+func (m *InviteMutation) UID() (r string, exists bool) {
+	v := m.uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSequence returns the old "sequence" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+func (m *InviteMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequence requires an ID field in the mutation")
+		return v, errors.New("OldUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
-	}
-	return oldValue.Sequence, nil
-}
-
-// AddSequence adds i to the "sequence" field.
-func (m *LessonEventMutation) AddSequence(i int64) {
-	if m.addsequence != nil {
-		*m.addsequence += i
-	} else {
-		m.addsequence = &i
-	}
-}
-
-// AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *LessonEventMutation) AddedSequence() (r int64, exists bool) {
-	v := m.addsequence
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
 	}
-	return *v, true
+	return oldValue.UID, nil
 }
 
-// ResetSequence resets all changes to the "sequence" field.
-func (m *LessonEventMutation) ResetSequence() {
-	m.sequence = nil
-	m.addsequence = nil
+// ResetUID resets all changes to the "uid" field.
+func (m *InviteMutation) ResetUID() {
+	m.uid = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *LessonEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetFamilySpaceID sets the "family_space_id" field.
+func (m *InviteMutation) SetFamilySpaceID(s string) {
+	m.family_space_id = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *LessonEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
+func (m *InviteMutation) FamilySpaceID() (r string, exists bool) {
+	v := m.family_space_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *InviteMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.FamilySpaceID, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *LessonEventMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetFamilySpaceID resets all changes to the "family_space_id" field.
+func (m *InviteMutation) ResetFamilySpaceID() {
+	m.family_space_id = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *LessonEventMutation) SetOwnerID(s string) {
-	m.owner_id = &s
+// SetCode sets the "code" field.
+func (m *InviteMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *LessonEventMutation) OwnerID() (r string, exists bool) {
-	v := m.owner_id
+// Code returns the value of the "code" field in the mutation.
+func (m *InviteMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Code, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *LessonEventMutation) ResetOwnerID() {
-	m.owner_id = nil
+// ResetCode resets all changes to the "code" field.
+func (m *InviteMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *LessonEventMutation) SetSessionID(s string) {
-	m.session_id = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *InviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *LessonEventMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *InviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.SessionID, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *LessonEventMutation) ResetSessionID() {
-	m.session_id = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *InviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetSkillID sets the "skill_id" field.
-func (m *LessonEventMutation) SetSkillID(s string) {
-	m.skill_id = &s
+// SetRevoked sets the "revoked" field.
+func (m *InviteMutation) SetRevoked(b bool) {
+	m.revoked = &b
 }
 
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *LessonEventMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *InviteMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkillID returns the old "skill_id" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
 	}
-	return oldValue.SkillID, nil
+	return oldValue.Revoked, nil
 }
 
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *LessonEventMutation) ResetSkillID() {
-	m.skill_id = nil
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *InviteMutation) ResetRevoked() {
+	m.revoked = nil
 }
 
-// SetLessonTitle sets the "lesson_title" field.
-func (m *LessonEventMutation) SetLessonTitle(s string) {
-	m.lesson_title = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *InviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// LessonTitle returns the value of the "lesson_title" field in the mutation.
-func (m *LessonEventMutation) LessonTitle() (r string, exists bool) {
-	v := m.lesson_title
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLessonTitle returns the old "lesson_title" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldLessonTitle(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLessonTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLessonTitle requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLessonTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LessonTitle, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLessonTitle resets all changes to the "lesson_title" field.
-func (m *LessonEventMutation) ResetLessonTitle() {
-	m.lesson_title = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InviteMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetPracticeAttempted sets the "practice_attempted" field.
-func (m *LessonEventMutation) SetPracticeAttempted(b bool) {
-	m.practice_attempted = &b
+// Where appends a list predicates to the InviteMutation builder.
+func (m *InviteMutation) Where(ps ...predicate.Invite) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// PracticeAttempted returns the value of the "practice_attempted" field in the mutation.
-func (m *LessonEventMutation) PracticeAttempted() (r bool, exists bool) {
-	v := m.practice_attempted
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the InviteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldPracticeAttempted returns the old "practice_attempted" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeAttempted(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeAttempted is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeAttempted requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeAttempted: %w", err)
-	}
-	return oldValue.PracticeAttempted, nil
+// Op returns the operation name.
+func (m *InviteMutation) Op() Op {
+	return m.op
 }
 
-// ResetPracticeAttempted resets all changes to the "practice_attempted" field.
-func (m *LessonEventMutation) ResetPracticeAttempted() {
-	m.practice_attempted = nil
+// SetOp allows setting the mutation operation.
+func (m *InviteMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetPracticeCorrect sets the "practice_correct" field.
-func (m *LessonEventMutation) SetPracticeCorrect(b bool) {
-	m.practice_correct = &b
+// Type returns the node type of this mutation (Invite).
+func (m *InviteMutation) Type() string {
+	return m.typ
 }
 
-// PracticeCorrect returns the value of the "practice_correct" field in the mutation.
-func (m *LessonEventMutation) PracticeCorrect() (r bool, exists bool) {
-	v := m.practice_correct
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InviteMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.uid != nil {
+		fields = append(fields, invite.FieldUID)
 	}
-	return *v, true
-}
-
-// OldPracticeCorrect returns the old "practice_correct" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeCorrect(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeCorrect is only allowed on UpdateOne operations")
+	if m.family_space_id != nil {
+		fields = append(fields, invite.FieldFamilySpaceID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeCorrect requires an ID field in the mutation")
+	if m.code != nil {
+		fields = append(fields, invite.FieldCode)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeCorrect: %w", err)
+	if m.expires_at != nil {
+		fields = append(fields, invite.FieldExpiresAt)
 	}
-	return oldValue.PracticeCorrect, nil
-}
-
-// ResetPracticeCorrect resets all changes to the "practice_correct" field.
-func (m *LessonEventMutation) ResetPracticeCorrect() {
-	m.practice_correct = nil
+	if m.revoked != nil {
+		fields = append(fields, invite.FieldRevoked)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invite.FieldCreatedAt)
+	}
+	return fields
 }
 
-// SetPracticeSkipped sets the "practice_skipped" field.
-func (m *LessonEventMutation) SetPracticeSkipped(b bool) {
-	m.practice_skipped = &b
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InviteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invite.FieldUID:
+		return m.UID()
+	case invite.FieldFamilySpaceID:
+		return m.FamilySpaceID()
+	case invite.FieldCode:
+		return m.Code()
+	case invite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case invite.FieldRevoked:
+		return m.Revoked()
+	case invite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// PracticeSkipped returns the value of the "practice_skipped" field in the mutation.
-func (m *LessonEventMutation) PracticeSkipped() (r bool, exists bool) {
-	v := m.practice_skipped
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invite.FieldUID:
+		return m.OldUID(ctx)
+	case invite.FieldFamilySpaceID:
+		return m.OldFamilySpaceID(ctx)
+	case invite.FieldCode:
+		return m.OldCode(ctx)
+	case invite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case invite.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case invite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Invite field %s", name)
 }
 
-// OldPracticeSkipped returns the old "practice_skipped" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeSkipped(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeSkipped is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeSkipped requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeSkipped: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InviteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invite.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case invite.FieldFamilySpaceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilySpaceID(v)
+		return nil
+	case invite.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case invite.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case invite.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case invite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.PracticeSkipped, nil
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
-// ResetPracticeSkipped resets all changes to the "practice_skipped" field.
-func (m *LessonEventMutation) ResetPracticeSkipped() {
-	m.practice_skipped = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InviteMutation) AddedFields() []string {
+	return nil
 }
 
-// SetExplanation sets the "explanation" field.
-func (m *LessonEventMutation) SetExplanation(s string) {
-	m.explanation = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InviteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Explanation returns the value of the "explanation" field in the mutation.
-func (m *LessonEventMutation) Explanation() (r string, exists bool) {
-	v := m.explanation
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InviteMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Invite numeric field %s", name)
 }
 
-// OldExplanation returns the old "explanation" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldExplanation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExplanation requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
-	}
-	return oldValue.Explanation, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InviteMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetExplanation resets all changes to the "explanation" field.
-func (m *LessonEventMutation) ResetExplanation() {
-	m.explanation = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InviteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetWorkedExample sets the "worked_example" field.
-func (m *LessonEventMutation) SetWorkedExample(s string) {
-	m.worked_example = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InviteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Invite nullable field %s", name)
 }
 
-// WorkedExample returns the value of the "worked_example" field in the mutation.
-func (m *LessonEventMutation) WorkedExample() (r string, exists bool) {
-	v := m.worked_example
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InviteMutation) ResetField(name string) error {
+	switch name {
+	case invite.FieldUID:
+		m.ResetUID()
+		return nil
+	case invite.FieldFamilySpaceID:
+		m.ResetFamilySpaceID()
+		return nil
+	case invite.FieldCode:
+		m.ResetCode()
+		return nil
+	case invite.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case invite.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case invite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
-// OldWorkedExample returns the old "worked_example" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldWorkedExample(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWorkedExample is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWorkedExample requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWorkedExample: %w", err)
-	}
-	return oldValue.WorkedExample, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InviteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetWorkedExample resets all changes to the "worked_example" field.
-func (m *LessonEventMutation) ResetWorkedExample() {
-	m.worked_example = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InviteMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetPracticeText sets the "practice_text" field.
-func (m *LessonEventMutation) SetPracticeText(s string) {
-	m.practice_text = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InviteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// PracticeText returns the value of the "practice_text" field in the mutation.
-func (m *LessonEventMutation) PracticeText() (r string, exists bool) {
-	v := m.practice_text
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InviteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldPracticeText returns the old "practice_text" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeText(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeText is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeText requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeText: %w", err)
-	}
-	return oldValue.PracticeText, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InviteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetPracticeText resets all changes to the "practice_text" field.
-func (m *LessonEventMutation) ResetPracticeText() {
-	m.practice_text = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InviteMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetPracticeAnswer sets the "practice_answer" field.
-func (m *LessonEventMutation) SetPracticeAnswer(s string) {
-	m.practice_answer = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InviteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Invite unique edge %s", name)
 }
 
-// PracticeAnswer returns the value of the "practice_answer" field in the mutation.
-func (m *LessonEventMutation) PracticeAnswer() (r string, exists bool) {
-	v := m.practice_answer
-	if v == nil {
-		return
-	}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InviteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Invite edge %s", name)
+}
+
+// LLMRequestEventMutation represents an operation that mutates the LLMRequestEvent nodes in the graph.
+type LLMRequestEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	sequence         *int64
+	addsequence      *int64
+	timestamp        *time.Time
+	owner_id         *string
+	provider         *string
+	model            *string
+	purpose          *string
+	input_tokens     *int
+	addinput_tokens  *int
+	output_tokens    *int
+	addoutput_tokens *int
+	latency_ms       *int64
+	addlatency_ms    *int64
+	success          *bool
+	error_message    *string
+	request_body     *string
+	response_body    *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*LLMRequestEvent, error)
+	predicates       []predicate.LLMRequestEvent
+}
+
+var _ ent.Mutation = (*LLMRequestEventMutation)(nil)
+
+// llmrequesteventOption allows management of the mutation configuration using functional options.
+type llmrequesteventOption func(*LLMRequestEventMutation)
+
+// newLLMRequestEventMutation creates new mutation for the LLMRequestEvent entity.
+func newLLMRequestEventMutation(c config, op Op, opts ...llmrequesteventOption) *LLMRequestEventMutation {
+	m := &LLMRequestEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLLMRequestEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLLMRequestEventID sets the ID field of the mutation.
+func withLLMRequestEventID(id int) llmrequesteventOption {
+	return func(m *LLMRequestEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LLMRequestEvent
+		)
+		m.oldValue = func(ctx context.Context) (*LLMRequestEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LLMRequestEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLLMRequestEvent sets the old LLMRequestEvent of the mutation.
+func withLLMRequestEvent(node *LLMRequestEvent) llmrequesteventOption {
+	return func(m *LLMRequestEventMutation) {
+		m.oldValue = func(context.Context) (*LLMRequestEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LLMRequestEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LLMRequestEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LLMRequestEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LLMRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LLMRequestEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSequence sets the "sequence" field.
+func (m *LLMRequestEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
+}
+
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *LLMRequestEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
+	if v == nil {
+		return
+	}
 	return *v, true
 }
 
-// OldPracticeAnswer returns the old "practice_answer" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeAnswer(ctx context.Context) (v string, err error) {
+func (m *LLMRequestEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeAnswer requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.PracticeAnswer, nil
+	return oldValue.Sequence, nil
 }
 
-// ResetPracticeAnswer resets all changes to the "practice_answer" field.
-func (m *LessonEventMutation) ResetPracticeAnswer() {
-	m.practice_answer = nil
+// AddSequence adds i to the "sequence" field.
+func (m *LLMRequestEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
 }
 
-// SetPracticeExplanation sets the "practice_explanation" field.
-func (m *LessonEventMutation) SetPracticeExplanation(s string) {
-	m.practice_explanation = &s
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *LLMRequestEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PracticeExplanation returns the value of the "practice_explanation" field in the mutation.
-func (m *LessonEventMutation) PracticeExplanation() (r string, exists bool) {
-	v := m.practice_explanation
+// ResetSequence resets all changes to the "sequence" field.
+func (m *LLMRequestEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *LLMRequestEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *LLMRequestEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPracticeExplanation returns the old "practice_explanation" field's value of the LessonEvent entity.
-// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LessonEventMutation) OldPracticeExplanation(ctx context.Context) (v string, err error) {
+func (m *LLMRequestEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeExplanation is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeExplanation requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeExplanation: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.PracticeExplanation, nil
+	return oldValue.Timestamp, nil
 }
 
-// ResetPracticeExplanation resets all changes to the "practice_explanation" field.
-func (m *LessonEventMutation) ResetPracticeExplanation() {
-	m.practice_explanation = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *LLMRequestEventMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// Where appends a list predicates to the LessonEventMutation builder.
-func (m *LessonEventMutation) Where(ps ...predicate.LessonEvent) {
-	m.predicates = append(m.predicates, ps...)
+// SetOwnerID sets the "owner_id" field.
+func (m *LLMRequestEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
 }
 
-// WhereP appends storage-level predicates to the LessonEventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LessonEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LessonEvent, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *LLMRequestEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *LessonEventMutation) Op() Op {
-	return m.op
+// OldOwnerID returns the old "owner_id" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *LessonEventMutation) SetOp(op Op) {
-	m.op = op
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *LLMRequestEventMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *LLMRequestEventMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *LLMRequestEventMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *LLMRequestEventMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetModel sets the "model" field.
+func (m *LLMRequestEventMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *LLMRequestEventMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *LLMRequestEventMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *LLMRequestEventMutation) SetPurpose(s string) {
+	m.purpose = &s
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *LLMRequestEventMutation) Purpose() (r string, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldPurpose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *LLMRequestEventMutation) ResetPurpose() {
+	m.purpose = nil
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *LLMRequestEventMutation) SetInputTokens(i int) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *LLMRequestEventMutation) InputTokens() (r int, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldInputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *LLMRequestEventMutation) AddInputTokens(i int) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *LLMRequestEventMutation) AddedInputTokens() (r int, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *LLMRequestEventMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *LLMRequestEventMutation) SetOutputTokens(i int) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *LLMRequestEventMutation) OutputTokens() (r int, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *LLMRequestEventMutation) AddOutputTokens(i int) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *LLMRequestEventMutation) AddedOutputTokens() (r int, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *LLMRequestEventMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *LLMRequestEventMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *LLMRequestEventMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *LLMRequestEventMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *LLMRequestEventMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *LLMRequestEventMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *LLMRequestEventMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *LLMRequestEventMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *LLMRequestEventMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *LLMRequestEventMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *LLMRequestEventMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *LLMRequestEventMutation) ResetErrorMessage() {
+	m.error_message = nil
+}
+
+// SetRequestBody sets the "request_body" field.
+func (m *LLMRequestEventMutation) SetRequestBody(s string) {
+	m.request_body = &s
+}
+
+// RequestBody returns the value of the "request_body" field in the mutation.
+func (m *LLMRequestEventMutation) RequestBody() (r string, exists bool) {
+	v := m.request_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestBody returns the old "request_body" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldRequestBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestBody: %w", err)
+	}
+	return oldValue.RequestBody, nil
+}
+
+// ResetRequestBody resets all changes to the "request_body" field.
+func (m *LLMRequestEventMutation) ResetRequestBody() {
+	m.request_body = nil
+}
+
+// SetResponseBody sets the "response_body" field.
+func (m *LLMRequestEventMutation) SetResponseBody(s string) {
+	m.response_body = &s
+}
+
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *LLMRequestEventMutation) ResponseBody() (r string, exists bool) {
+	v := m.response_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseBody returns the old "response_body" field's value of the LLMRequestEvent entity.
+// If the LLMRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LLMRequestEventMutation) OldResponseBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
+	}
+	return oldValue.ResponseBody, nil
+}
+
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *LLMRequestEventMutation) ResetResponseBody() {
+	m.response_body = nil
+}
+
+// Where appends a list predicates to the LLMRequestEventMutation builder.
+func (m *LLMRequestEventMutation) Where(ps ...predicate.LLMRequestEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LLMRequestEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LLMRequestEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LLMRequestEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LLMRequestEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LLMRequestEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LLMRequestEvent).
+func (m *LLMRequestEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LLMRequestEventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.sequence != nil {
+		fields = append(fields, llmrequestevent.FieldSequence)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, llmrequestevent.FieldTimestamp)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, llmrequestevent.FieldOwnerID)
+	}
+	if m.provider != nil {
+		fields = append(fields, llmrequestevent.FieldProvider)
+	}
+	if m.model != nil {
+		fields = append(fields, llmrequestevent.FieldModel)
+	}
+	if m.purpose != nil {
+		fields = append(fields, llmrequestevent.FieldPurpose)
+	}
+	if m.input_tokens != nil {
+		fields = append(fields, llmrequestevent.FieldInputTokens)
+	}
+	if m.output_tokens != nil {
+		fields = append(fields, llmrequestevent.FieldOutputTokens)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, llmrequestevent.FieldLatencyMs)
+	}
+	if m.success != nil {
+		fields = append(fields, llmrequestevent.FieldSuccess)
+	}
+	if m.error_message != nil {
+		fields = append(fields, llmrequestevent.FieldErrorMessage)
+	}
+	if m.request_body != nil {
+		fields = append(fields, llmrequestevent.FieldRequestBody)
+	}
+	if m.response_body != nil {
+		fields = append(fields, llmrequestevent.FieldResponseBody)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LLMRequestEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		return m.Sequence()
+	case llmrequestevent.FieldTimestamp:
+		return m.Timestamp()
+	case llmrequestevent.FieldOwnerID:
+		return m.OwnerID()
+	case llmrequestevent.FieldProvider:
+		return m.Provider()
+	case llmrequestevent.FieldModel:
+		return m.Model()
+	case llmrequestevent.FieldPurpose:
+		return m.Purpose()
+	case llmrequestevent.FieldInputTokens:
+		return m.InputTokens()
+	case llmrequestevent.FieldOutputTokens:
+		return m.OutputTokens()
+	case llmrequestevent.FieldLatencyMs:
+		return m.LatencyMs()
+	case llmrequestevent.FieldSuccess:
+		return m.Success()
+	case llmrequestevent.FieldErrorMessage:
+		return m.ErrorMessage()
+	case llmrequestevent.FieldRequestBody:
+		return m.RequestBody()
+	case llmrequestevent.FieldResponseBody:
+		return m.ResponseBody()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LLMRequestEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case llmrequestevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case llmrequestevent.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case llmrequestevent.FieldProvider:
+		return m.OldProvider(ctx)
+	case llmrequestevent.FieldModel:
+		return m.OldModel(ctx)
+	case llmrequestevent.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case llmrequestevent.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case llmrequestevent.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case llmrequestevent.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case llmrequestevent.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case llmrequestevent.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case llmrequestevent.FieldRequestBody:
+		return m.OldRequestBody(ctx)
+	case llmrequestevent.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	}
+	return nil, fmt.Errorf("unknown LLMRequestEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMRequestEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case llmrequestevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case llmrequestevent.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case llmrequestevent.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case llmrequestevent.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case llmrequestevent.FieldPurpose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case llmrequestevent.FieldInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputTokens(v)
+		return nil
+	case llmrequestevent.FieldOutputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTokens(v)
+		return nil
+	case llmrequestevent.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case llmrequestevent.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case llmrequestevent.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case llmrequestevent.FieldRequestBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestBody(v)
+		return nil
+	case llmrequestevent.FieldResponseBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseBody(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMRequestEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LLMRequestEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, llmrequestevent.FieldSequence)
+	}
+	if m.addinput_tokens != nil {
+		fields = append(fields, llmrequestevent.FieldInputTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, llmrequestevent.FieldOutputTokens)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, llmrequestevent.FieldLatencyMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LLMRequestEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		return m.AddedSequence()
+	case llmrequestevent.FieldInputTokens:
+		return m.AddedInputTokens()
+	case llmrequestevent.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case llmrequestevent.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LLMRequestEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	case llmrequestevent.FieldInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case llmrequestevent.FieldOutputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case llmrequestevent.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LLMRequestEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LLMRequestEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LLMRequestEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LLMRequestEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LLMRequestEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LLMRequestEventMutation) ResetField(name string) error {
+	switch name {
+	case llmrequestevent.FieldSequence:
+		m.ResetSequence()
+		return nil
+	case llmrequestevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case llmrequestevent.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case llmrequestevent.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case llmrequestevent.FieldModel:
+		m.ResetModel()
+		return nil
+	case llmrequestevent.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case llmrequestevent.FieldInputTokens:
+		m.ResetInputTokens()
+		return nil
+	case llmrequestevent.FieldOutputTokens:
+		m.ResetOutputTokens()
+		return nil
+	case llmrequestevent.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case llmrequestevent.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case llmrequestevent.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case llmrequestevent.FieldRequestBody:
+		m.ResetRequestBody()
+		return nil
+	case llmrequestevent.FieldResponseBody:
+		m.ResetResponseBody()
+		return nil
+	}
+	return fmt.Errorf("unknown LLMRequestEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LLMRequestEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LLMRequestEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LLMRequestEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LLMRequestEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LLMRequestEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LLMRequestEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LLMRequestEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LLMRequestEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LLMRequestEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LLMRequestEvent edge %s", name)
+}
+
+// LearnerProfileEventMutation represents an operation that mutates the LearnerProfileEvent nodes in the graph.
+type LearnerProfileEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	sequence         *int64
+	addsequence      *int64
+	timestamp        *time.Time
+	owner_id         *string
+	summary          *string
+	strengths        *[]string
+	appendstrengths  []string
+	weaknesses       *[]string
+	appendweaknesses []string
+	patterns         *[]string
+	appendpatterns   []string
+	generated_at     *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*LearnerProfileEvent, error)
+	predicates       []predicate.LearnerProfileEvent
+}
+
+var _ ent.Mutation = (*LearnerProfileEventMutation)(nil)
+
+// learnerprofileeventOption allows management of the mutation configuration using functional options.
+type learnerprofileeventOption func(*LearnerProfileEventMutation)
+
+// newLearnerProfileEventMutation creates new mutation for the LearnerProfileEvent entity.
+func newLearnerProfileEventMutation(c config, op Op, opts ...learnerprofileeventOption) *LearnerProfileEventMutation {
+	m := &LearnerProfileEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLearnerProfileEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLearnerProfileEventID sets the ID field of the mutation.
+func withLearnerProfileEventID(id int) learnerprofileeventOption {
+	return func(m *LearnerProfileEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LearnerProfileEvent
+		)
+		m.oldValue = func(ctx context.Context) (*LearnerProfileEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LearnerProfileEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLearnerProfileEvent sets the old LearnerProfileEvent of the mutation.
+func withLearnerProfileEvent(node *LearnerProfileEvent) learnerprofileeventOption {
+	return func(m *LearnerProfileEventMutation) {
+		m.oldValue = func(context.Context) (*LearnerProfileEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LearnerProfileEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LearnerProfileEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LearnerProfileEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LearnerProfileEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LearnerProfileEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSequence sets the "sequence" field.
+func (m *LearnerProfileEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
+}
+
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *LearnerProfileEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSequence returns the old "sequence" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSequence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
+	}
+	return oldValue.Sequence, nil
+}
+
+// AddSequence adds i to the "sequence" field.
+func (m *LearnerProfileEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
+}
+
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *LearnerProfileEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSequence resets all changes to the "sequence" field.
+func (m *LearnerProfileEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *LearnerProfileEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *LearnerProfileEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *LearnerProfileEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *LearnerProfileEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *LearnerProfileEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *LearnerProfileEventMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *LearnerProfileEventMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *LearnerProfileEventMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *LearnerProfileEventMutation) ResetSummary() {
+	m.summary = nil
+}
+
+// SetStrengths sets the "strengths" field.
+func (m *LearnerProfileEventMutation) SetStrengths(s []string) {
+	m.strengths = &s
+	m.appendstrengths = nil
+}
+
+// Strengths returns the value of the "strengths" field in the mutation.
+func (m *LearnerProfileEventMutation) Strengths() (r []string, exists bool) {
+	v := m.strengths
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrengths returns the old "strengths" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldStrengths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrengths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrengths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrengths: %w", err)
+	}
+	return oldValue.Strengths, nil
+}
+
+// AppendStrengths adds s to the "strengths" field.
+func (m *LearnerProfileEventMutation) AppendStrengths(s []string) {
+	m.appendstrengths = append(m.appendstrengths, s...)
+}
+
+// AppendedStrengths returns the list of values that were appended to the "strengths" field in this mutation.
+func (m *LearnerProfileEventMutation) AppendedStrengths() ([]string, bool) {
+	if len(m.appendstrengths) == 0 {
+		return nil, false
+	}
+	return m.appendstrengths, true
+}
+
+// ClearStrengths clears the value of the "strengths" field.
+func (m *LearnerProfileEventMutation) ClearStrengths() {
+	m.strengths = nil
+	m.appendstrengths = nil
+	m.clearedFields[learnerprofileevent.FieldStrengths] = struct{}{}
+}
+
+// StrengthsCleared returns if the "strengths" field was cleared in this mutation.
+func (m *LearnerProfileEventMutation) StrengthsCleared() bool {
+	_, ok := m.clearedFields[learnerprofileevent.FieldStrengths]
+	return ok
+}
+
+// ResetStrengths resets all changes to the "strengths" field.
+func (m *LearnerProfileEventMutation) ResetStrengths() {
+	m.strengths = nil
+	m.appendstrengths = nil
+	delete(m.clearedFields, learnerprofileevent.FieldStrengths)
+}
+
+// SetWeaknesses sets the "weaknesses" field.
+func (m *LearnerProfileEventMutation) SetWeaknesses(s []string) {
+	m.weaknesses = &s
+	m.appendweaknesses = nil
+}
+
+// Weaknesses returns the value of the "weaknesses" field in the mutation.
+func (m *LearnerProfileEventMutation) Weaknesses() (r []string, exists bool) {
+	v := m.weaknesses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeaknesses returns the old "weaknesses" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldWeaknesses(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeaknesses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeaknesses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeaknesses: %w", err)
+	}
+	return oldValue.Weaknesses, nil
+}
+
+// AppendWeaknesses adds s to the "weaknesses" field.
+func (m *LearnerProfileEventMutation) AppendWeaknesses(s []string) {
+	m.appendweaknesses = append(m.appendweaknesses, s...)
+}
+
+// AppendedWeaknesses returns the list of values that were appended to the "weaknesses" field in this mutation.
+func (m *LearnerProfileEventMutation) AppendedWeaknesses() ([]string, bool) {
+	if len(m.appendweaknesses) == 0 {
+		return nil, false
+	}
+	return m.appendweaknesses, true
+}
+
+// ClearWeaknesses clears the value of the "weaknesses" field.
+func (m *LearnerProfileEventMutation) ClearWeaknesses() {
+	m.weaknesses = nil
+	m.appendweaknesses = nil
+	m.clearedFields[learnerprofileevent.FieldWeaknesses] = struct{}{}
+}
+
+// WeaknessesCleared returns if the "weaknesses" field was cleared in this mutation.
+func (m *LearnerProfileEventMutation) WeaknessesCleared() bool {
+	_, ok := m.clearedFields[learnerprofileevent.FieldWeaknesses]
+	return ok
+}
+
+// ResetWeaknesses resets all changes to the "weaknesses" field.
+func (m *LearnerProfileEventMutation) ResetWeaknesses() {
+	m.weaknesses = nil
+	m.appendweaknesses = nil
+	delete(m.clearedFields, learnerprofileevent.FieldWeaknesses)
+}
+
+// SetPatterns sets the "patterns" field.
+func (m *LearnerProfileEventMutation) SetPatterns(s []string) {
+	m.patterns = &s
+	m.appendpatterns = nil
+}
+
+// Patterns returns the value of the "patterns" field in the mutation.
+func (m *LearnerProfileEventMutation) Patterns() (r []string, exists bool) {
+	v := m.patterns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatterns returns the old "patterns" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldPatterns(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatterns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatterns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatterns: %w", err)
+	}
+	return oldValue.Patterns, nil
+}
+
+// AppendPatterns adds s to the "patterns" field.
+func (m *LearnerProfileEventMutation) AppendPatterns(s []string) {
+	m.appendpatterns = append(m.appendpatterns, s...)
+}
+
+// AppendedPatterns returns the list of values that were appended to the "patterns" field in this mutation.
+func (m *LearnerProfileEventMutation) AppendedPatterns() ([]string, bool) {
+	if len(m.appendpatterns) == 0 {
+		return nil, false
+	}
+	return m.appendpatterns, true
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (m *LearnerProfileEventMutation) ClearPatterns() {
+	m.patterns = nil
+	m.appendpatterns = nil
+	m.clearedFields[learnerprofileevent.FieldPatterns] = struct{}{}
+}
+
+// PatternsCleared returns if the "patterns" field was cleared in this mutation.
+func (m *LearnerProfileEventMutation) PatternsCleared() bool {
+	_, ok := m.clearedFields[learnerprofileevent.FieldPatterns]
+	return ok
+}
+
+// ResetPatterns resets all changes to the "patterns" field.
+func (m *LearnerProfileEventMutation) ResetPatterns() {
+	m.patterns = nil
+	m.appendpatterns = nil
+	delete(m.clearedFields, learnerprofileevent.FieldPatterns)
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (m *LearnerProfileEventMutation) SetGeneratedAt(s string) {
+	m.generated_at = &s
+}
+
+// GeneratedAt returns the value of the "generated_at" field in the mutation.
+func (m *LearnerProfileEventMutation) GeneratedAt() (r string, exists bool) {
+	v := m.generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneratedAt returns the old "generated_at" field's value of the LearnerProfileEvent entity.
+// If the LearnerProfileEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerProfileEventMutation) OldGeneratedAt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneratedAt: %w", err)
+	}
+	return oldValue.GeneratedAt, nil
+}
+
+// ResetGeneratedAt resets all changes to the "generated_at" field.
+func (m *LearnerProfileEventMutation) ResetGeneratedAt() {
+	m.generated_at = nil
+}
+
+// Where appends a list predicates to the LearnerProfileEventMutation builder.
+func (m *LearnerProfileEventMutation) Where(ps ...predicate.LearnerProfileEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LearnerProfileEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LearnerProfileEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LearnerProfileEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LearnerProfileEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LearnerProfileEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LearnerProfileEvent).
+func (m *LearnerProfileEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LearnerProfileEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.sequence != nil {
+		fields = append(fields, learnerprofileevent.FieldSequence)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, learnerprofileevent.FieldTimestamp)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, learnerprofileevent.FieldOwnerID)
+	}
+	if m.summary != nil {
+		fields = append(fields, learnerprofileevent.FieldSummary)
+	}
+	if m.strengths != nil {
+		fields = append(fields, learnerprofileevent.FieldStrengths)
+	}
+	if m.weaknesses != nil {
+		fields = append(fields, learnerprofileevent.FieldWeaknesses)
+	}
+	if m.patterns != nil {
+		fields = append(fields, learnerprofileevent.FieldPatterns)
+	}
+	if m.generated_at != nil {
+		fields = append(fields, learnerprofileevent.FieldGeneratedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LearnerProfileEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		return m.Sequence()
+	case learnerprofileevent.FieldTimestamp:
+		return m.Timestamp()
+	case learnerprofileevent.FieldOwnerID:
+		return m.OwnerID()
+	case learnerprofileevent.FieldSummary:
+		return m.Summary()
+	case learnerprofileevent.FieldStrengths:
+		return m.Strengths()
+	case learnerprofileevent.FieldWeaknesses:
+		return m.Weaknesses()
+	case learnerprofileevent.FieldPatterns:
+		return m.Patterns()
+	case learnerprofileevent.FieldGeneratedAt:
+		return m.GeneratedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LearnerProfileEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case learnerprofileevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case learnerprofileevent.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case learnerprofileevent.FieldSummary:
+		return m.OldSummary(ctx)
+	case learnerprofileevent.FieldStrengths:
+		return m.OldStrengths(ctx)
+	case learnerprofileevent.FieldWeaknesses:
+		return m.OldWeaknesses(ctx)
+	case learnerprofileevent.FieldPatterns:
+		return m.OldPatterns(ctx)
+	case learnerprofileevent.FieldGeneratedAt:
+		return m.OldGeneratedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LearnerProfileEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case learnerprofileevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case learnerprofileevent.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case learnerprofileevent.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case learnerprofileevent.FieldStrengths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrengths(v)
+		return nil
+	case learnerprofileevent.FieldWeaknesses:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeaknesses(v)
+		return nil
+	case learnerprofileevent.FieldPatterns:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatterns(v)
+		return nil
+	case learnerprofileevent.FieldGeneratedAt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneratedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LearnerProfileEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, learnerprofileevent.FieldSequence)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LearnerProfileEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		return m.AddedSequence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LearnerProfileEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerProfileEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LearnerProfileEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(learnerprofileevent.FieldStrengths) {
+		fields = append(fields, learnerprofileevent.FieldStrengths)
+	}
+	if m.FieldCleared(learnerprofileevent.FieldWeaknesses) {
+		fields = append(fields, learnerprofileevent.FieldWeaknesses)
+	}
+	if m.FieldCleared(learnerprofileevent.FieldPatterns) {
+		fields = append(fields, learnerprofileevent.FieldPatterns)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LearnerProfileEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LearnerProfileEventMutation) ClearField(name string) error {
+	switch name {
+	case learnerprofileevent.FieldStrengths:
+		m.ClearStrengths()
+		return nil
+	case learnerprofileevent.FieldWeaknesses:
+		m.ClearWeaknesses()
+		return nil
+	case learnerprofileevent.FieldPatterns:
+		m.ClearPatterns()
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerProfileEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LearnerProfileEventMutation) ResetField(name string) error {
+	switch name {
+	case learnerprofileevent.FieldSequence:
+		m.ResetSequence()
+		return nil
+	case learnerprofileevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case learnerprofileevent.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case learnerprofileevent.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case learnerprofileevent.FieldStrengths:
+		m.ResetStrengths()
+		return nil
+	case learnerprofileevent.FieldWeaknesses:
+		m.ResetWeaknesses()
+		return nil
+	case learnerprofileevent.FieldPatterns:
+		m.ResetPatterns()
+		return nil
+	case learnerprofileevent.FieldGeneratedAt:
+		m.ResetGeneratedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerProfileEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LearnerProfileEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LearnerProfileEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LearnerProfileEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LearnerProfileEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LearnerProfileEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LearnerProfileEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LearnerProfileEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LearnerProfileEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LearnerProfileEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LearnerProfileEvent edge %s", name)
+}
+
+// LessonEventMutation represents an operation that mutates the LessonEvent nodes in the graph.
+type LessonEventMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	sequence             *int64
+	addsequence          *int64
+	timestamp            *time.Time
+	owner_id             *string
+	session_id           *string
+	skill_id             *string
+	lesson_title         *string
+	practice_attempted   *bool
+	practice_correct     *bool
+	practice_skipped     *bool
+	explanation          *string
+	worked_example       *string
+	practice_text        *string
+	practice_answer      *string
+	practice_explanation *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*LessonEvent, error)
+	predicates           []predicate.LessonEvent
+}
+
+var _ ent.Mutation = (*LessonEventMutation)(nil)
+
+// lessoneventOption allows management of the mutation configuration using functional options.
+type lessoneventOption func(*LessonEventMutation)
+
+// newLessonEventMutation creates new mutation for the LessonEvent entity.
+func newLessonEventMutation(c config, op Op, opts ...lessoneventOption) *LessonEventMutation {
+	m := &LessonEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLessonEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLessonEventID sets the ID field of the mutation.
+func withLessonEventID(id int) lessoneventOption {
+	return func(m *LessonEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LessonEvent
+		)
+		m.oldValue = func(ctx context.Context) (*LessonEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LessonEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLessonEvent sets the old LessonEvent of the mutation.
+func withLessonEvent(node *LessonEvent) lessoneventOption {
+	return func(m *LessonEventMutation) {
+		m.oldValue = func(context.Context) (*LessonEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LessonEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LessonEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LessonEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LessonEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LessonEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSequence sets the "sequence" field.
+func (m *LessonEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
+}
+
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *LessonEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSequence returns the old "sequence" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSequence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
+	}
+	return oldValue.Sequence, nil
+}
+
+// AddSequence adds i to the "sequence" field.
+func (m *LessonEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
+}
+
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *LessonEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSequence resets all changes to the "sequence" field.
+func (m *LessonEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *LessonEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *LessonEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *LessonEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *LessonEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *LessonEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *LessonEventMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *LessonEventMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *LessonEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *LessonEventMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetSkillID sets the "skill_id" field.
+func (m *LessonEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
+}
+
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *LessonEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkillID returns the old "skill_id" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	}
+	return oldValue.SkillID, nil
+}
+
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *LessonEventMutation) ResetSkillID() {
+	m.skill_id = nil
+}
+
+// SetLessonTitle sets the "lesson_title" field.
+func (m *LessonEventMutation) SetLessonTitle(s string) {
+	m.lesson_title = &s
+}
+
+// LessonTitle returns the value of the "lesson_title" field in the mutation.
+func (m *LessonEventMutation) LessonTitle() (r string, exists bool) {
+	v := m.lesson_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLessonTitle returns the old "lesson_title" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldLessonTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLessonTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLessonTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLessonTitle: %w", err)
+	}
+	return oldValue.LessonTitle, nil
+}
+
+// ResetLessonTitle resets all changes to the "lesson_title" field.
+func (m *LessonEventMutation) ResetLessonTitle() {
+	m.lesson_title = nil
+}
+
+// SetPracticeAttempted sets the "practice_attempted" field.
+func (m *LessonEventMutation) SetPracticeAttempted(b bool) {
+	m.practice_attempted = &b
+}
+
+// PracticeAttempted returns the value of the "practice_attempted" field in the mutation.
+func (m *LessonEventMutation) PracticeAttempted() (r bool, exists bool) {
+	v := m.practice_attempted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeAttempted returns the old "practice_attempted" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeAttempted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeAttempted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeAttempted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeAttempted: %w", err)
+	}
+	return oldValue.PracticeAttempted, nil
+}
+
+// ResetPracticeAttempted resets all changes to the "practice_attempted" field.
+func (m *LessonEventMutation) ResetPracticeAttempted() {
+	m.practice_attempted = nil
+}
+
+// SetPracticeCorrect sets the "practice_correct" field.
+func (m *LessonEventMutation) SetPracticeCorrect(b bool) {
+	m.practice_correct = &b
+}
+
+// PracticeCorrect returns the value of the "practice_correct" field in the mutation.
+func (m *LessonEventMutation) PracticeCorrect() (r bool, exists bool) {
+	v := m.practice_correct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeCorrect returns the old "practice_correct" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeCorrect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeCorrect: %w", err)
+	}
+	return oldValue.PracticeCorrect, nil
+}
+
+// ResetPracticeCorrect resets all changes to the "practice_correct" field.
+func (m *LessonEventMutation) ResetPracticeCorrect() {
+	m.practice_correct = nil
+}
+
+// SetPracticeSkipped sets the "practice_skipped" field.
+func (m *LessonEventMutation) SetPracticeSkipped(b bool) {
+	m.practice_skipped = &b
+}
+
+// PracticeSkipped returns the value of the "practice_skipped" field in the mutation.
+func (m *LessonEventMutation) PracticeSkipped() (r bool, exists bool) {
+	v := m.practice_skipped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeSkipped returns the old "practice_skipped" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeSkipped(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeSkipped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeSkipped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeSkipped: %w", err)
+	}
+	return oldValue.PracticeSkipped, nil
+}
+
+// ResetPracticeSkipped resets all changes to the "practice_skipped" field.
+func (m *LessonEventMutation) ResetPracticeSkipped() {
+	m.practice_skipped = nil
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *LessonEventMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *LessonEventMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *LessonEventMutation) ResetExplanation() {
+	m.explanation = nil
+}
+
+// SetWorkedExample sets the "worked_example" field.
+func (m *LessonEventMutation) SetWorkedExample(s string) {
+	m.worked_example = &s
+}
+
+// WorkedExample returns the value of the "worked_example" field in the mutation.
+func (m *LessonEventMutation) WorkedExample() (r string, exists bool) {
+	v := m.worked_example
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkedExample returns the old "worked_example" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldWorkedExample(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkedExample is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkedExample requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkedExample: %w", err)
+	}
+	return oldValue.WorkedExample, nil
+}
+
+// ResetWorkedExample resets all changes to the "worked_example" field.
+func (m *LessonEventMutation) ResetWorkedExample() {
+	m.worked_example = nil
+}
+
+// SetPracticeText sets the "practice_text" field.
+func (m *LessonEventMutation) SetPracticeText(s string) {
+	m.practice_text = &s
+}
+
+// PracticeText returns the value of the "practice_text" field in the mutation.
+func (m *LessonEventMutation) PracticeText() (r string, exists bool) {
+	v := m.practice_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeText returns the old "practice_text" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeText: %w", err)
+	}
+	return oldValue.PracticeText, nil
+}
+
+// ResetPracticeText resets all changes to the "practice_text" field.
+func (m *LessonEventMutation) ResetPracticeText() {
+	m.practice_text = nil
+}
+
+// SetPracticeAnswer sets the "practice_answer" field.
+func (m *LessonEventMutation) SetPracticeAnswer(s string) {
+	m.practice_answer = &s
+}
+
+// PracticeAnswer returns the value of the "practice_answer" field in the mutation.
+func (m *LessonEventMutation) PracticeAnswer() (r string, exists bool) {
+	v := m.practice_answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeAnswer returns the old "practice_answer" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeAnswer: %w", err)
+	}
+	return oldValue.PracticeAnswer, nil
+}
+
+// ResetPracticeAnswer resets all changes to the "practice_answer" field.
+func (m *LessonEventMutation) ResetPracticeAnswer() {
+	m.practice_answer = nil
+}
+
+// SetPracticeExplanation sets the "practice_explanation" field.
+func (m *LessonEventMutation) SetPracticeExplanation(s string) {
+	m.practice_explanation = &s
+}
+
+// PracticeExplanation returns the value of the "practice_explanation" field in the mutation.
+func (m *LessonEventMutation) PracticeExplanation() (r string, exists bool) {
+	v := m.practice_explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeExplanation returns the old "practice_explanation" field's value of the LessonEvent entity.
+// If the LessonEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LessonEventMutation) OldPracticeExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeExplanation: %w", err)
+	}
+	return oldValue.PracticeExplanation, nil
+}
+
+// ResetPracticeExplanation resets all changes to the "practice_explanation" field.
+func (m *LessonEventMutation) ResetPracticeExplanation() {
+	m.practice_explanation = nil
+}
+
+// Where appends a list predicates to the LessonEventMutation builder.
+func (m *LessonEventMutation) Where(ps ...predicate.LessonEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LessonEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LessonEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LessonEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LessonEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LessonEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LessonEvent).
+func (m *LessonEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LessonEventMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.sequence != nil {
+		fields = append(fields, lessonevent.FieldSequence)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, lessonevent.FieldTimestamp)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, lessonevent.FieldOwnerID)
+	}
+	if m.session_id != nil {
+		fields = append(fields, lessonevent.FieldSessionID)
+	}
+	if m.skill_id != nil {
+		fields = append(fields, lessonevent.FieldSkillID)
+	}
+	if m.lesson_title != nil {
+		fields = append(fields, lessonevent.FieldLessonTitle)
+	}
+	if m.practice_attempted != nil {
+		fields = append(fields, lessonevent.FieldPracticeAttempted)
+	}
+	if m.practice_correct != nil {
+		fields = append(fields, lessonevent.FieldPracticeCorrect)
+	}
+	if m.practice_skipped != nil {
+		fields = append(fields, lessonevent.FieldPracticeSkipped)
+	}
+	if m.explanation != nil {
+		fields = append(fields, lessonevent.FieldExplanation)
+	}
+	if m.worked_example != nil {
+		fields = append(fields, lessonevent.FieldWorkedExample)
+	}
+	if m.practice_text != nil {
+		fields = append(fields, lessonevent.FieldPracticeText)
+	}
+	if m.practice_answer != nil {
+		fields = append(fields, lessonevent.FieldPracticeAnswer)
+	}
+	if m.practice_explanation != nil {
+		fields = append(fields, lessonevent.FieldPracticeExplanation)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LessonEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lessonevent.FieldSequence:
+		return m.Sequence()
+	case lessonevent.FieldTimestamp:
+		return m.Timestamp()
+	case lessonevent.FieldOwnerID:
+		return m.OwnerID()
+	case lessonevent.FieldSessionID:
+		return m.SessionID()
+	case lessonevent.FieldSkillID:
+		return m.SkillID()
+	case lessonevent.FieldLessonTitle:
+		return m.LessonTitle()
+	case lessonevent.FieldPracticeAttempted:
+		return m.PracticeAttempted()
+	case lessonevent.FieldPracticeCorrect:
+		return m.PracticeCorrect()
+	case lessonevent.FieldPracticeSkipped:
+		return m.PracticeSkipped()
+	case lessonevent.FieldExplanation:
+		return m.Explanation()
+	case lessonevent.FieldWorkedExample:
+		return m.WorkedExample()
+	case lessonevent.FieldPracticeText:
+		return m.PracticeText()
+	case lessonevent.FieldPracticeAnswer:
+		return m.PracticeAnswer()
+	case lessonevent.FieldPracticeExplanation:
+		return m.PracticeExplanation()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LessonEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lessonevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case lessonevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case lessonevent.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case lessonevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case lessonevent.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case lessonevent.FieldLessonTitle:
+		return m.OldLessonTitle(ctx)
+	case lessonevent.FieldPracticeAttempted:
+		return m.OldPracticeAttempted(ctx)
+	case lessonevent.FieldPracticeCorrect:
+		return m.OldPracticeCorrect(ctx)
+	case lessonevent.FieldPracticeSkipped:
+		return m.OldPracticeSkipped(ctx)
+	case lessonevent.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case lessonevent.FieldWorkedExample:
+		return m.OldWorkedExample(ctx)
+	case lessonevent.FieldPracticeText:
+		return m.OldPracticeText(ctx)
+	case lessonevent.FieldPracticeAnswer:
+		return m.OldPracticeAnswer(ctx)
+	case lessonevent.FieldPracticeExplanation:
+		return m.OldPracticeExplanation(ctx)
+	}
+	return nil, fmt.Errorf("unknown LessonEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LessonEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lessonevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case lessonevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case lessonevent.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case lessonevent.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case lessonevent.FieldSkillID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkillID(v)
+		return nil
+	case lessonevent.FieldLessonTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLessonTitle(v)
+		return nil
+	case lessonevent.FieldPracticeAttempted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeAttempted(v)
+		return nil
+	case lessonevent.FieldPracticeCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeCorrect(v)
+		return nil
+	case lessonevent.FieldPracticeSkipped:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeSkipped(v)
+		return nil
+	case lessonevent.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case lessonevent.FieldWorkedExample:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkedExample(v)
+		return nil
+	case lessonevent.FieldPracticeText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeText(v)
+		return nil
+	case lessonevent.FieldPracticeAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeAnswer(v)
+		return nil
+	case lessonevent.FieldPracticeExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeExplanation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LessonEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LessonEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, lessonevent.FieldSequence)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LessonEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lessonevent.FieldSequence:
+		return m.AddedSequence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LessonEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lessonevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LessonEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LessonEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LessonEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LessonEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LessonEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LessonEventMutation) ResetField(name string) error {
+	switch name {
+	case lessonevent.FieldSequence:
+		m.ResetSequence()
+		return nil
+	case lessonevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case lessonevent.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case lessonevent.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case lessonevent.FieldSkillID:
+		m.ResetSkillID()
+		return nil
+	case lessonevent.FieldLessonTitle:
+		m.ResetLessonTitle()
+		return nil
+	case lessonevent.FieldPracticeAttempted:
+		m.ResetPracticeAttempted()
+		return nil
+	case lessonevent.FieldPracticeCorrect:
+		m.ResetPracticeCorrect()
+		return nil
+	case lessonevent.FieldPracticeSkipped:
+		m.ResetPracticeSkipped()
+		return nil
+	case lessonevent.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case lessonevent.FieldWorkedExample:
+		m.ResetWorkedExample()
+		return nil
+	case lessonevent.FieldPracticeText:
+		m.ResetPracticeText()
+		return nil
+	case lessonevent.FieldPracticeAnswer:
+		m.ResetPracticeAnswer()
+		return nil
+	case lessonevent.FieldPracticeExplanation:
+		m.ResetPracticeExplanation()
+		return nil
+	}
+	return fmt.Errorf("unknown LessonEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LessonEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LessonEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LessonEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LessonEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LessonEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LessonEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LessonEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LessonEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LessonEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LessonEvent edge %s", name)
+}
+
+// MasteryEventMutation represents an operation that mutates the MasteryEvent nodes in the graph.
+type MasteryEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	sequence         *int64
+	addsequence      *int64
+	timestamp        *time.Time
+	owner_id         *string
+	skill_id         *string
+	from_state       *string
+	to_state         *string
+	trigger          *string
+	fluency_score    *float64
+	addfluency_score *float64
+	session_id       *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*MasteryEvent, error)
+	predicates       []predicate.MasteryEvent
+}
+
+var _ ent.Mutation = (*MasteryEventMutation)(nil)
+
+// masteryeventOption allows management of the mutation configuration using functional options.
+type masteryeventOption func(*MasteryEventMutation)
+
+// newMasteryEventMutation creates new mutation for the MasteryEvent entity.
+func newMasteryEventMutation(c config, op Op, opts ...masteryeventOption) *MasteryEventMutation {
+	m := &MasteryEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMasteryEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMasteryEventID sets the ID field of the mutation.
+func withMasteryEventID(id int) masteryeventOption {
+	return func(m *MasteryEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MasteryEvent
+		)
+		m.oldValue = func(ctx context.Context) (*MasteryEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MasteryEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMasteryEvent sets the old MasteryEvent of the mutation.
+func withMasteryEvent(node *MasteryEvent) masteryeventOption {
+	return func(m *MasteryEventMutation) {
+		m.oldValue = func(context.Context) (*MasteryEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MasteryEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MasteryEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MasteryEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MasteryEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MasteryEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSequence sets the "sequence" field.
+func (m *MasteryEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
+}
+
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *MasteryEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSequence returns the old "sequence" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSequence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
+	}
+	return oldValue.Sequence, nil
+}
+
+// AddSequence adds i to the "sequence" field.
+func (m *MasteryEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
+}
+
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *MasteryEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSequence resets all changes to the "sequence" field.
+func (m *MasteryEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *MasteryEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *MasteryEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *MasteryEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *MasteryEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *MasteryEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *MasteryEventMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetSkillID sets the "skill_id" field.
+func (m *MasteryEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
+}
+
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *MasteryEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkillID returns the old "skill_id" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	}
+	return oldValue.SkillID, nil
+}
+
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *MasteryEventMutation) ResetSkillID() {
+	m.skill_id = nil
+}
+
+// SetFromState sets the "from_state" field.
+func (m *MasteryEventMutation) SetFromState(s string) {
+	m.from_state = &s
+}
+
+// FromState returns the value of the "from_state" field in the mutation.
+func (m *MasteryEventMutation) FromState() (r string, exists bool) {
+	v := m.from_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromState returns the old "from_state" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldFromState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromState: %w", err)
+	}
+	return oldValue.FromState, nil
+}
+
+// ResetFromState resets all changes to the "from_state" field.
+func (m *MasteryEventMutation) ResetFromState() {
+	m.from_state = nil
+}
+
+// SetToState sets the "to_state" field.
+func (m *MasteryEventMutation) SetToState(s string) {
+	m.to_state = &s
 }
 
-// Type returns the node type of this mutation (LessonEvent).
-func (m *LessonEventMutation) Type() string {
+// ToState returns the value of the "to_state" field in the mutation.
+func (m *MasteryEventMutation) ToState() (r string, exists bool) {
+	v := m.to_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToState returns the old "to_state" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldToState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToState: %w", err)
+	}
+	return oldValue.ToState, nil
+}
+
+// ResetToState resets all changes to the "to_state" field.
+func (m *MasteryEventMutation) ResetToState() {
+	m.to_state = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *MasteryEventMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *MasteryEventMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *MasteryEventMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetFluencyScore sets the "fluency_score" field.
+func (m *MasteryEventMutation) SetFluencyScore(f float64) {
+	m.fluency_score = &f
+	m.addfluency_score = nil
+}
+
+// FluencyScore returns the value of the "fluency_score" field in the mutation.
+func (m *MasteryEventMutation) FluencyScore() (r float64, exists bool) {
+	v := m.fluency_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFluencyScore returns the old "fluency_score" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldFluencyScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFluencyScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFluencyScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFluencyScore: %w", err)
+	}
+	return oldValue.FluencyScore, nil
+}
+
+// AddFluencyScore adds f to the "fluency_score" field.
+func (m *MasteryEventMutation) AddFluencyScore(f float64) {
+	if m.addfluency_score != nil {
+		*m.addfluency_score += f
+	} else {
+		m.addfluency_score = &f
+	}
+}
+
+// AddedFluencyScore returns the value that was added to the "fluency_score" field in this mutation.
+func (m *MasteryEventMutation) AddedFluencyScore() (r float64, exists bool) {
+	v := m.addfluency_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFluencyScore resets all changes to the "fluency_score" field.
+func (m *MasteryEventMutation) ResetFluencyScore() {
+	m.fluency_score = nil
+	m.addfluency_score = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *MasteryEventMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *MasteryEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the MasteryEvent entity.
+// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MasteryEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *MasteryEventMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[masteryevent.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *MasteryEventMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[masteryevent.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *MasteryEventMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, masteryevent.FieldSessionID)
+}
+
+// Where appends a list predicates to the MasteryEventMutation builder.
+func (m *MasteryEventMutation) Where(ps ...predicate.MasteryEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MasteryEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MasteryEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MasteryEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MasteryEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MasteryEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MasteryEvent).
+func (m *MasteryEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LessonEventMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *MasteryEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.sequence != nil {
-		fields = append(fields, lessonevent.FieldSequence)
+		fields = append(fields, masteryevent.FieldSequence)
 	}
 	if m.timestamp != nil {
-		fields = append(fields, lessonevent.FieldTimestamp)
+		fields = append(fields, masteryevent.FieldTimestamp)
 	}
 	if m.owner_id != nil {
-		fields = append(fields, lessonevent.FieldOwnerID)
-	}
-	if m.session_id != nil {
-		fields = append(fields, lessonevent.FieldSessionID)
+		fields = append(fields, masteryevent.FieldOwnerID)
 	}
 	if m.skill_id != nil {
-		fields = append(fields, lessonevent.FieldSkillID)
-	}
-	if m.lesson_title != nil {
-		fields = append(fields, lessonevent.FieldLessonTitle)
-	}
-	if m.practice_attempted != nil {
-		fields = append(fields, lessonevent.FieldPracticeAttempted)
-	}
-	if m.practice_correct != nil {
-		fields = append(fields, lessonevent.FieldPracticeCorrect)
-	}
-	if m.practice_skipped != nil {
-		fields = append(fields, lessonevent.FieldPracticeSkipped)
+		fields = append(fields, masteryevent.FieldSkillID)
 	}
-	if m.explanation != nil {
-		fields = append(fields, lessonevent.FieldExplanation)
+	if m.from_state != nil {
+		fields = append(fields, masteryevent.FieldFromState)
 	}
-	if m.worked_example != nil {
-		fields = append(fields, lessonevent.FieldWorkedExample)
+	if m.to_state != nil {
+		fields = append(fields, masteryevent.FieldToState)
 	}
-	if m.practice_text != nil {
-		fields = append(fields, lessonevent.FieldPracticeText)
+	if m.trigger != nil {
+		fields = append(fields, masteryevent.FieldTrigger)
 	}
-	if m.practice_answer != nil {
-		fields = append(fields, lessonevent.FieldPracticeAnswer)
+	if m.fluency_score != nil {
+		fields = append(fields, masteryevent.FieldFluencyScore)
 	}
-	if m.practice_explanation != nil {
-		fields = append(fields, lessonevent.FieldPracticeExplanation)
+	if m.session_id != nil {
+		fields = append(fields, masteryevent.FieldSessionID)
 	}
 	return fields
 }
@@ -11565,36 +14506,26 @@ func (m *LessonEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LessonEventMutation) Field(name string) (ent.Value, bool) {
+func (m *MasteryEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		return m.Sequence()
-	case lessonevent.FieldTimestamp:
+	case masteryevent.FieldTimestamp:
 		return m.Timestamp()
-	case lessonevent.FieldOwnerID:
+	case masteryevent.FieldOwnerID:
 		return m.OwnerID()
-	case lessonevent.FieldSessionID:
-		return m.SessionID()
-	case lessonevent.FieldSkillID:
+	case masteryevent.FieldSkillID:
 		return m.SkillID()
-	case lessonevent.FieldLessonTitle:
-		return m.LessonTitle()
-	case lessonevent.FieldPracticeAttempted:
-		return m.PracticeAttempted()
-	case lessonevent.FieldPracticeCorrect:
-		return m.PracticeCorrect()
-	case lessonevent.FieldPracticeSkipped:
-		return m.PracticeSkipped()
-	case lessonevent.FieldExplanation:
-		return m.Explanation()
-	case lessonevent.FieldWorkedExample:
-		return m.WorkedExample()
-	case lessonevent.FieldPracticeText:
-		return m.PracticeText()
-	case lessonevent.FieldPracticeAnswer:
-		return m.PracticeAnswer()
-	case lessonevent.FieldPracticeExplanation:
-		return m.PracticeExplanation()
+	case masteryevent.FieldFromState:
+		return m.FromState()
+	case masteryevent.FieldToState:
+		return m.ToState()
+	case masteryevent.FieldTrigger:
+		return m.Trigger()
+	case masteryevent.FieldFluencyScore:
+		return m.FluencyScore()
+	case masteryevent.FieldSessionID:
+		return m.SessionID()
 	}
 	return nil, false
 }
@@ -11602,153 +14533,111 @@ func (m *LessonEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LessonEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MasteryEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		return m.OldSequence(ctx)
-	case lessonevent.FieldTimestamp:
+	case masteryevent.FieldTimestamp:
 		return m.OldTimestamp(ctx)
-	case lessonevent.FieldOwnerID:
+	case masteryevent.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case lessonevent.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case lessonevent.FieldSkillID:
+	case masteryevent.FieldSkillID:
 		return m.OldSkillID(ctx)
-	case lessonevent.FieldLessonTitle:
-		return m.OldLessonTitle(ctx)
-	case lessonevent.FieldPracticeAttempted:
-		return m.OldPracticeAttempted(ctx)
-	case lessonevent.FieldPracticeCorrect:
-		return m.OldPracticeCorrect(ctx)
-	case lessonevent.FieldPracticeSkipped:
-		return m.OldPracticeSkipped(ctx)
-	case lessonevent.FieldExplanation:
-		return m.OldExplanation(ctx)
-	case lessonevent.FieldWorkedExample:
-		return m.OldWorkedExample(ctx)
-	case lessonevent.FieldPracticeText:
-		return m.OldPracticeText(ctx)
-	case lessonevent.FieldPracticeAnswer:
-		return m.OldPracticeAnswer(ctx)
-	case lessonevent.FieldPracticeExplanation:
-		return m.OldPracticeExplanation(ctx)
+	case masteryevent.FieldFromState:
+		return m.OldFromState(ctx)
+	case masteryevent.FieldToState:
+		return m.OldToState(ctx)
+	case masteryevent.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case masteryevent.FieldFluencyScore:
+		return m.OldFluencyScore(ctx)
+	case masteryevent.FieldSessionID:
+		return m.OldSessionID(ctx)
 	}
-	return nil, fmt.Errorf("unknown LessonEvent field %s", name)
+	return nil, fmt.Errorf("unknown MasteryEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LessonEventMutation) SetField(name string, value ent.Value) error {
+func (m *MasteryEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSequence(v)
 		return nil
-	case lessonevent.FieldTimestamp:
+	case masteryevent.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTimestamp(v)
 		return nil
-	case lessonevent.FieldOwnerID:
+	case masteryevent.FieldOwnerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwnerID(v)
 		return nil
-	case lessonevent.FieldSessionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionID(v)
-		return nil
-	case lessonevent.FieldSkillID:
+	case masteryevent.FieldSkillID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSkillID(v)
 		return nil
-	case lessonevent.FieldLessonTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLessonTitle(v)
-		return nil
-	case lessonevent.FieldPracticeAttempted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPracticeAttempted(v)
-		return nil
-	case lessonevent.FieldPracticeCorrect:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPracticeCorrect(v)
-		return nil
-	case lessonevent.FieldPracticeSkipped:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPracticeSkipped(v)
-		return nil
-	case lessonevent.FieldExplanation:
+	case masteryevent.FieldFromState:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExplanation(v)
+		m.SetFromState(v)
 		return nil
-	case lessonevent.FieldWorkedExample:
+	case masteryevent.FieldToState:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWorkedExample(v)
+		m.SetToState(v)
 		return nil
-	case lessonevent.FieldPracticeText:
+	case masteryevent.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPracticeText(v)
+		m.SetTrigger(v)
 		return nil
-	case lessonevent.FieldPracticeAnswer:
-		v, ok := value.(string)
+	case masteryevent.FieldFluencyScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPracticeAnswer(v)
+		m.SetFluencyScore(v)
 		return nil
-	case lessonevent.FieldPracticeExplanation:
+	case masteryevent.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPracticeExplanation(v)
+		m.SetSessionID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LessonEvent field %s", name)
+	return fmt.Errorf("unknown MasteryEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LessonEventMutation) AddedFields() []string {
+func (m *MasteryEventMutation) AddedFields() []string {
 	var fields []string
 	if m.addsequence != nil {
-		fields = append(fields, lessonevent.FieldSequence)
+		fields = append(fields, masteryevent.FieldSequence)
+	}
+	if m.addfluency_score != nil {
+		fields = append(fields, masteryevent.FieldFluencyScore)
 	}
 	return fields
 }
@@ -11756,10 +14645,12 @@ func (m *LessonEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LessonEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MasteryEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		return m.AddedSequence()
+	case masteryevent.FieldFluencyScore:
+		return m.AddedFluencyScore()
 	}
 	return nil, false
 }
@@ -11767,170 +14658,166 @@ func (m *LessonEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LessonEventMutation) AddField(name string, value ent.Value) error {
+func (m *MasteryEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddSequence(v)
 		return nil
+	case masteryevent.FieldFluencyScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFluencyScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown LessonEvent numeric field %s", name)
+	return fmt.Errorf("unknown MasteryEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LessonEventMutation) ClearedFields() []string {
-	return nil
+func (m *MasteryEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(masteryevent.FieldSessionID) {
+		fields = append(fields, masteryevent.FieldSessionID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LessonEventMutation) FieldCleared(name string) bool {
+func (m *MasteryEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LessonEventMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LessonEvent nullable field %s", name)
+func (m *MasteryEventMutation) ClearField(name string) error {
+	switch name {
+	case masteryevent.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	}
+	return fmt.Errorf("unknown MasteryEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LessonEventMutation) ResetField(name string) error {
+func (m *MasteryEventMutation) ResetField(name string) error {
 	switch name {
-	case lessonevent.FieldSequence:
+	case masteryevent.FieldSequence:
 		m.ResetSequence()
 		return nil
-	case lessonevent.FieldTimestamp:
+	case masteryevent.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
-	case lessonevent.FieldOwnerID:
+	case masteryevent.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case lessonevent.FieldSessionID:
-		m.ResetSessionID()
-		return nil
-	case lessonevent.FieldSkillID:
+	case masteryevent.FieldSkillID:
 		m.ResetSkillID()
 		return nil
-	case lessonevent.FieldLessonTitle:
-		m.ResetLessonTitle()
-		return nil
-	case lessonevent.FieldPracticeAttempted:
-		m.ResetPracticeAttempted()
-		return nil
-	case lessonevent.FieldPracticeCorrect:
-		m.ResetPracticeCorrect()
-		return nil
-	case lessonevent.FieldPracticeSkipped:
-		m.ResetPracticeSkipped()
-		return nil
-	case lessonevent.FieldExplanation:
-		m.ResetExplanation()
+	case masteryevent.FieldFromState:
+		m.ResetFromState()
 		return nil
-	case lessonevent.FieldWorkedExample:
-		m.ResetWorkedExample()
+	case masteryevent.FieldToState:
+		m.ResetToState()
 		return nil
-	case lessonevent.FieldPracticeText:
-		m.ResetPracticeText()
+	case masteryevent.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case lessonevent.FieldPracticeAnswer:
-		m.ResetPracticeAnswer()
+	case masteryevent.FieldFluencyScore:
+		m.ResetFluencyScore()
 		return nil
-	case lessonevent.FieldPracticeExplanation:
-		m.ResetPracticeExplanation()
+	case masteryevent.FieldSessionID:
+		m.ResetSessionID()
 		return nil
 	}
-	return fmt.Errorf("unknown LessonEvent field %s", name)
+	return fmt.Errorf("unknown MasteryEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LessonEventMutation) AddedEdges() []string {
+func (m *MasteryEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LessonEventMutation) AddedIDs(name string) []ent.Value {
+func (m *MasteryEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LessonEventMutation) RemovedEdges() []string {
+func (m *MasteryEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LessonEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *MasteryEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LessonEventMutation) ClearedEdges() []string {
+func (m *MasteryEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LessonEventMutation) EdgeCleared(name string) bool {
+func (m *MasteryEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LessonEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LessonEvent unique edge %s", name)
+func (m *MasteryEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MasteryEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LessonEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LessonEvent edge %s", name)
+func (m *MasteryEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MasteryEvent edge %s", name)
 }
 
-// MasteryEventMutation represents an operation that mutates the MasteryEvent nodes in the graph.
-type MasteryEventMutation struct {
+// ParentInviteMutation represents an operation that mutates the ParentInvite nodes in the graph.
+type ParentInviteMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	sequence         *int64
-	addsequence      *int64
-	timestamp        *time.Time
-	owner_id         *string
-	skill_id         *string
-	from_state       *string
-	to_state         *string
-	trigger          *string
-	fluency_score    *float64
-	addfluency_score *float64
-	session_id       *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*MasteryEvent, error)
-	predicates       []predicate.MasteryEvent
+	op              Op
+	typ             string
+	id              *int
+	uid             *string
+	family_space_id *string
+	email           *string
+	status          *string
+	created_by      *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ParentInvite, error)
+	predicates      []predicate.ParentInvite
 }
 
-var _ ent.Mutation = (*MasteryEventMutation)(nil)
+var _ ent.Mutation = (*ParentInviteMutation)(nil)
 
-// masteryeventOption allows management of the mutation configuration using functional options.
-type masteryeventOption func(*MasteryEventMutation)
+// parentinviteOption allows management of the mutation configuration using functional options.
+type parentinviteOption func(*ParentInviteMutation)
 
-// newMasteryEventMutation creates new mutation for the MasteryEvent entity.
-func newMasteryEventMutation(c config, op Op, opts ...masteryeventOption) *MasteryEventMutation {
-	m := &MasteryEventMutation{
+// newParentInviteMutation creates new mutation for the ParentInvite entity.
+func newParentInviteMutation(c config, op Op, opts ...parentinviteOption) *ParentInviteMutation {
+	m := &ParentInviteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMasteryEvent,
+		typ:           TypeParentInvite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11939,20 +14826,20 @@ func newMasteryEventMutation(c config, op Op, opts ...masteryeventOption) *Maste
 	return m
 }
 
-// withMasteryEventID sets the ID field of the mutation.
-func withMasteryEventID(id int) masteryeventOption {
-	return func(m *MasteryEventMutation) {
+// withParentInviteID sets the ID field of the mutation.
+func withParentInviteID(id int) parentinviteOption {
+	return func(m *ParentInviteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MasteryEvent
+			value *ParentInvite
 		)
-		m.oldValue = func(ctx context.Context) (*MasteryEvent, error) {
+		m.oldValue = func(ctx context.Context) (*ParentInvite, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MasteryEvent.Get(ctx, id)
+					value, err = m.Client().ParentInvite.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11961,10 +14848,10 @@ func withMasteryEventID(id int) masteryeventOption {
 	}
 }
 
-// withMasteryEvent sets the old MasteryEvent of the mutation.
-func withMasteryEvent(node *MasteryEvent) masteryeventOption {
-	return func(m *MasteryEventMutation) {
-		m.oldValue = func(context.Context) (*MasteryEvent, error) {
+// withParentInvite sets the old ParentInvite of the mutation.
+func withParentInvite(node *ParentInvite) parentinviteOption {
+	return func(m *ParentInviteMutation) {
+		m.oldValue = func(context.Context) (*ParentInvite, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11973,7 +14860,7 @@ func withMasteryEvent(node *MasteryEvent) masteryeventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MasteryEventMutation) Client() *Client {
+func (m ParentInviteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11981,7 +14868,7 @@ func (m MasteryEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MasteryEventMutation) Tx() (*Tx, error) {
+func (m ParentInviteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11992,7 +14879,7 @@ func (m MasteryEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MasteryEventMutation) ID() (id int, exists bool) {
+func (m *ParentInviteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12003,7 +14890,7 @@ func (m *MasteryEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MasteryEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ParentInviteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12012,398 +14899,237 @@ func (m *MasteryEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MasteryEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ParentInvite.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSequence sets the "sequence" field.
-func (m *MasteryEventMutation) SetSequence(i int64) {
-	m.sequence = &i
-	m.addsequence = nil
-}
-
-// Sequence returns the value of the "sequence" field in the mutation.
-func (m *MasteryEventMutation) Sequence() (r int64, exists bool) {
-	v := m.sequence
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSequence returns the old "sequence" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequence requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
-	}
-	return oldValue.Sequence, nil
-}
-
-// AddSequence adds i to the "sequence" field.
-func (m *MasteryEventMutation) AddSequence(i int64) {
-	if m.addsequence != nil {
-		*m.addsequence += i
-	} else {
-		m.addsequence = &i
-	}
-}
-
-// AddedSequence returns the value that was added to the "sequence" field in this mutation.
-func (m *MasteryEventMutation) AddedSequence() (r int64, exists bool) {
-	v := m.addsequence
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSequence resets all changes to the "sequence" field.
-func (m *MasteryEventMutation) ResetSequence() {
-	m.sequence = nil
-	m.addsequence = nil
-}
-
-// SetTimestamp sets the "timestamp" field.
-func (m *MasteryEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
-}
-
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *MasteryEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTimestamp returns the old "timestamp" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
-	}
-	return oldValue.Timestamp, nil
-}
-
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *MasteryEventMutation) ResetTimestamp() {
-	m.timestamp = nil
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (m *MasteryEventMutation) SetOwnerID(s string) {
-	m.owner_id = &s
-}
-
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *MasteryEventMutation) OwnerID() (r string, exists bool) {
-	v := m.owner_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOwnerID returns the old "owner_id" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
-	}
-	return oldValue.OwnerID, nil
-}
-
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *MasteryEventMutation) ResetOwnerID() {
-	m.owner_id = nil
-}
-
-// SetSkillID sets the "skill_id" field.
-func (m *MasteryEventMutation) SetSkillID(s string) {
-	m.skill_id = &s
-}
-
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *MasteryEventMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSkillID returns the old "skill_id" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
-	}
-	return oldValue.SkillID, nil
-}
-
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *MasteryEventMutation) ResetSkillID() {
-	m.skill_id = nil
-}
-
-// SetFromState sets the "from_state" field.
-func (m *MasteryEventMutation) SetFromState(s string) {
-	m.from_state = &s
+// SetUID sets the "uid" field.
+func (m *ParentInviteMutation) SetUID(s string) {
+	m.uid = &s
 }
 
-// FromState returns the value of the "from_state" field in the mutation.
-func (m *MasteryEventMutation) FromState() (r string, exists bool) {
-	v := m.from_state
+// UID returns the value of the "uid" field in the mutation.
+func (m *ParentInviteMutation) UID() (r string, exists bool) {
+	v := m.uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromState returns the old "from_state" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldFromState(ctx context.Context) (v string, err error) {
+func (m *ParentInviteMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromState is only allowed on UpdateOne operations")
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromState requires an ID field in the mutation")
+		return v, errors.New("OldUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromState: %w", err)
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
 	}
-	return oldValue.FromState, nil
+	return oldValue.UID, nil
 }
 
-// ResetFromState resets all changes to the "from_state" field.
-func (m *MasteryEventMutation) ResetFromState() {
-	m.from_state = nil
+// ResetUID resets all changes to the "uid" field.
+func (m *ParentInviteMutation) ResetUID() {
+	m.uid = nil
 }
 
-// SetToState sets the "to_state" field.
-func (m *MasteryEventMutation) SetToState(s string) {
-	m.to_state = &s
+// SetFamilySpaceID sets the "family_space_id" field.
+func (m *ParentInviteMutation) SetFamilySpaceID(s string) {
+	m.family_space_id = &s
 }
 
-// ToState returns the value of the "to_state" field in the mutation.
-func (m *MasteryEventMutation) ToState() (r string, exists bool) {
-	v := m.to_state
+// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
+func (m *ParentInviteMutation) FamilySpaceID() (r string, exists bool) {
+	v := m.family_space_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToState returns the old "to_state" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldToState(ctx context.Context) (v string, err error) {
+func (m *ParentInviteMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToState is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToState requires an ID field in the mutation")
+		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToState: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
 	}
-	return oldValue.ToState, nil
+	return oldValue.FamilySpaceID, nil
 }
 
-// ResetToState resets all changes to the "to_state" field.
-func (m *MasteryEventMutation) ResetToState() {
-	m.to_state = nil
+// ResetFamilySpaceID resets all changes to the "family_space_id" field.
+func (m *ParentInviteMutation) ResetFamilySpaceID() {
+	m.family_space_id = nil
 }
 
-// SetTrigger sets the "trigger" field.
-func (m *MasteryEventMutation) SetTrigger(s string) {
-	m.trigger = &s
+// SetEmail sets the "email" field.
+func (m *ParentInviteMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Trigger returns the value of the "trigger" field in the mutation.
-func (m *MasteryEventMutation) Trigger() (r string, exists bool) {
-	v := m.trigger
+// Email returns the value of the "email" field in the mutation.
+func (m *ParentInviteMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrigger returns the old "trigger" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldTrigger(ctx context.Context) (v string, err error) {
+func (m *ParentInviteMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrigger requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Trigger, nil
+	return oldValue.Email, nil
 }
 
-// ResetTrigger resets all changes to the "trigger" field.
-func (m *MasteryEventMutation) ResetTrigger() {
-	m.trigger = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *ParentInviteMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetFluencyScore sets the "fluency_score" field.
-func (m *MasteryEventMutation) SetFluencyScore(f float64) {
-	m.fluency_score = &f
-	m.addfluency_score = nil
+// SetStatus sets the "status" field.
+func (m *ParentInviteMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// FluencyScore returns the value of the "fluency_score" field in the mutation.
-func (m *MasteryEventMutation) FluencyScore() (r float64, exists bool) {
-	v := m.fluency_score
+// Status returns the value of the "status" field in the mutation.
+func (m *ParentInviteMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFluencyScore returns the old "fluency_score" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldFluencyScore(ctx context.Context) (v float64, err error) {
+func (m *ParentInviteMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFluencyScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFluencyScore requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFluencyScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.FluencyScore, nil
+	return oldValue.Status, nil
 }
 
-// AddFluencyScore adds f to the "fluency_score" field.
-func (m *MasteryEventMutation) AddFluencyScore(f float64) {
-	if m.addfluency_score != nil {
-		*m.addfluency_score += f
-	} else {
-		m.addfluency_score = &f
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *ParentInviteMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedFluencyScore returns the value that was added to the "fluency_score" field in this mutation.
-func (m *MasteryEventMutation) AddedFluencyScore() (r float64, exists bool) {
-	v := m.addfluency_score
+// SetCreatedBy sets the "created_by" field.
+func (m *ParentInviteMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *ParentInviteMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFluencyScore resets all changes to the "fluency_score" field.
-func (m *MasteryEventMutation) ResetFluencyScore() {
-	m.fluency_score = nil
-	m.addfluency_score = nil
+// OldCreatedBy returns the old "created_by" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ParentInviteMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *MasteryEventMutation) SetSessionID(s string) {
-	m.session_id = &s
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *ParentInviteMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *MasteryEventMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// SetCreatedAt sets the "created_at" field.
+func (m *ParentInviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ParentInviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the MasteryEvent entity.
-// If the MasteryEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ParentInvite entity.
+// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MasteryEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *ParentInviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SessionID, nil
-}
-
-// ClearSessionID clears the value of the "session_id" field.
-func (m *MasteryEventMutation) ClearSessionID() {
-	m.session_id = nil
-	m.clearedFields[masteryevent.FieldSessionID] = struct{}{}
-}
-
-// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
-func (m *MasteryEventMutation) SessionIDCleared() bool {
-	_, ok := m.clearedFields[masteryevent.FieldSessionID]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *MasteryEventMutation) ResetSessionID() {
-	m.session_id = nil
-	delete(m.clearedFields, masteryevent.FieldSessionID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ParentInviteMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the MasteryEventMutation builder.
-func (m *MasteryEventMutation) Where(ps ...predicate.MasteryEvent) {
+// Where appends a list predicates to the ParentInviteMutation builder.
+func (m *ParentInviteMutation) Where(ps ...predicate.ParentInvite) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MasteryEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ParentInviteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MasteryEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MasteryEvent, len(ps))
+func (m *ParentInviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ParentInvite, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12411,51 +15137,42 @@ func (m *MasteryEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MasteryEventMutation) Op() Op {
+func (m *ParentInviteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MasteryEventMutation) SetOp(op Op) {
+func (m *ParentInviteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MasteryEvent).
-func (m *MasteryEventMutation) Type() string {
+// Type returns the node type of this mutation (ParentInvite).
+func (m *ParentInviteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MasteryEventMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.sequence != nil {
-		fields = append(fields, masteryevent.FieldSequence)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, masteryevent.FieldTimestamp)
-	}
-	if m.owner_id != nil {
-		fields = append(fields, masteryevent.FieldOwnerID)
-	}
-	if m.skill_id != nil {
-		fields = append(fields, masteryevent.FieldSkillID)
+func (m *ParentInviteMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.uid != nil {
+		fields = append(fields, parentinvite.FieldUID)
 	}
-	if m.from_state != nil {
-		fields = append(fields, masteryevent.FieldFromState)
+	if m.family_space_id != nil {
+		fields = append(fields, parentinvite.FieldFamilySpaceID)
 	}
-	if m.to_state != nil {
-		fields = append(fields, masteryevent.FieldToState)
+	if m.email != nil {
+		fields = append(fields, parentinvite.FieldEmail)
 	}
-	if m.trigger != nil {
-		fields = append(fields, masteryevent.FieldTrigger)
+	if m.status != nil {
+		fields = append(fields, parentinvite.FieldStatus)
 	}
-	if m.fluency_score != nil {
-		fields = append(fields, masteryevent.FieldFluencyScore)
+	if m.created_by != nil {
+		fields = append(fields, parentinvite.FieldCreatedBy)
 	}
-	if m.session_id != nil {
-		fields = append(fields, masteryevent.FieldSessionID)
+	if m.created_at != nil {
+		fields = append(fields, parentinvite.FieldCreatedAt)
 	}
 	return fields
 }
@@ -12463,26 +15180,20 @@ func (m *MasteryEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MasteryEventMutation) Field(name string) (ent.Value, bool) {
+func (m *ParentInviteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case masteryevent.FieldSequence:
-		return m.Sequence()
-	case masteryevent.FieldTimestamp:
-		return m.Timestamp()
-	case masteryevent.FieldOwnerID:
-		return m.OwnerID()
-	case masteryevent.FieldSkillID:
-		return m.SkillID()
-	case masteryevent.FieldFromState:
-		return m.FromState()
-	case masteryevent.FieldToState:
-		return m.ToState()
-	case masteryevent.FieldTrigger:
-		return m.Trigger()
-	case masteryevent.FieldFluencyScore:
-		return m.FluencyScore()
-	case masteryevent.FieldSessionID:
-		return m.SessionID()
+	case parentinvite.FieldUID:
+		return m.UID()
+	case parentinvite.FieldFamilySpaceID:
+		return m.FamilySpaceID()
+	case parentinvite.FieldEmail:
+		return m.Email()
+	case parentinvite.FieldStatus:
+		return m.Status()
+	case parentinvite.FieldCreatedBy:
+		return m.CreatedBy()
+	case parentinvite.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -12490,291 +15201,223 @@ func (m *MasteryEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MasteryEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ParentInviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case masteryevent.FieldSequence:
-		return m.OldSequence(ctx)
-	case masteryevent.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case masteryevent.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case masteryevent.FieldSkillID:
-		return m.OldSkillID(ctx)
-	case masteryevent.FieldFromState:
-		return m.OldFromState(ctx)
-	case masteryevent.FieldToState:
-		return m.OldToState(ctx)
-	case masteryevent.FieldTrigger:
-		return m.OldTrigger(ctx)
-	case masteryevent.FieldFluencyScore:
-		return m.OldFluencyScore(ctx)
-	case masteryevent.FieldSessionID:
-		return m.OldSessionID(ctx)
+	case parentinvite.FieldUID:
+		return m.OldUID(ctx)
+	case parentinvite.FieldFamilySpaceID:
+		return m.OldFamilySpaceID(ctx)
+	case parentinvite.FieldEmail:
+		return m.OldEmail(ctx)
+	case parentinvite.FieldStatus:
+		return m.OldStatus(ctx)
+	case parentinvite.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case parentinvite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MasteryEvent field %s", name)
+	return nil, fmt.Errorf("unknown ParentInvite field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MasteryEventMutation) SetField(name string, value ent.Value) error {
+func (m *ParentInviteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case masteryevent.FieldSequence:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSequence(v)
-		return nil
-	case masteryevent.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
-		return nil
-	case masteryevent.FieldOwnerID:
+	case parentinvite.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
+		m.SetUID(v)
 		return nil
-	case masteryevent.FieldSkillID:
+	case parentinvite.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillID(v)
+		m.SetFamilySpaceID(v)
 		return nil
-	case masteryevent.FieldFromState:
+	case parentinvite.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromState(v)
+		m.SetEmail(v)
 		return nil
-	case masteryevent.FieldToState:
+	case parentinvite.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToState(v)
+		m.SetStatus(v)
 		return nil
-	case masteryevent.FieldTrigger:
+	case parentinvite.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrigger(v)
-		return nil
-	case masteryevent.FieldFluencyScore:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFluencyScore(v)
+		m.SetCreatedBy(v)
 		return nil
-	case masteryevent.FieldSessionID:
-		v, ok := value.(string)
+	case parentinvite.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionID(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MasteryEvent field %s", name)
+	return fmt.Errorf("unknown ParentInvite field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MasteryEventMutation) AddedFields() []string {
-	var fields []string
-	if m.addsequence != nil {
-		fields = append(fields, masteryevent.FieldSequence)
-	}
-	if m.addfluency_score != nil {
-		fields = append(fields, masteryevent.FieldFluencyScore)
-	}
-	return fields
+func (m *ParentInviteMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MasteryEventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case masteryevent.FieldSequence:
-		return m.AddedSequence()
-	case masteryevent.FieldFluencyScore:
-		return m.AddedFluencyScore()
-	}
+func (m *ParentInviteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MasteryEventMutation) AddField(name string, value ent.Value) error {
+func (m *ParentInviteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case masteryevent.FieldSequence:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSequence(v)
-		return nil
-	case masteryevent.FieldFluencyScore:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFluencyScore(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MasteryEvent numeric field %s", name)
+	return fmt.Errorf("unknown ParentInvite numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MasteryEventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(masteryevent.FieldSessionID) {
-		fields = append(fields, masteryevent.FieldSessionID)
-	}
-	return fields
+func (m *ParentInviteMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MasteryEventMutation) FieldCleared(name string) bool {
+func (m *ParentInviteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MasteryEventMutation) ClearField(name string) error {
-	switch name {
-	case masteryevent.FieldSessionID:
-		m.ClearSessionID()
-		return nil
-	}
-	return fmt.Errorf("unknown MasteryEvent nullable field %s", name)
+func (m *ParentInviteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ParentInvite nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MasteryEventMutation) ResetField(name string) error {
+func (m *ParentInviteMutation) ResetField(name string) error {
 	switch name {
-	case masteryevent.FieldSequence:
-		m.ResetSequence()
-		return nil
-	case masteryevent.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case masteryevent.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case masteryevent.FieldSkillID:
-		m.ResetSkillID()
+	case parentinvite.FieldUID:
+		m.ResetUID()
 		return nil
-	case masteryevent.FieldFromState:
-		m.ResetFromState()
+	case parentinvite.FieldFamilySpaceID:
+		m.ResetFamilySpaceID()
 		return nil
-	case masteryevent.FieldToState:
-		m.ResetToState()
+	case parentinvite.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case masteryevent.FieldTrigger:
-		m.ResetTrigger()
+	case parentinvite.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case masteryevent.FieldFluencyScore:
-		m.ResetFluencyScore()
+	case parentinvite.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case masteryevent.FieldSessionID:
-		m.ResetSessionID()
+	case parentinvite.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MasteryEvent field %s", name)
+	return fmt.Errorf("unknown ParentInvite field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MasteryEventMutation) AddedEdges() []string {
+func (m *ParentInviteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MasteryEventMutation) AddedIDs(name string) []ent.Value {
+func (m *ParentInviteMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MasteryEventMutation) RemovedEdges() []string {
+func (m *ParentInviteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MasteryEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *ParentInviteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MasteryEventMutation) ClearedEdges() []string {
+func (m *ParentInviteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MasteryEventMutation) EdgeCleared(name string) bool {
+func (m *ParentInviteMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MasteryEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MasteryEvent unique edge %s", name)
+func (m *ParentInviteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ParentInvite unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MasteryEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MasteryEvent edge %s", name)
+func (m *ParentInviteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ParentInvite edge %s", name)
 }
 
-// ParentInviteMutation represents an operation that mutates the ParentInvite nodes in the graph.
-type ParentInviteMutation struct {
+// QuestMutation represents an operation that mutates the Quest nodes in the graph.
+type QuestMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
 	uid             *string
 	family_space_id *string
-	email           *string
+	name            *string
+	emoji           *string
+	skill_id        *string
+	child_uid       *string
 	status          *string
 	created_by      *string
 	created_at      *time.Time
+	updated_at      *time.Time
 	clearedFields   map[string]struct{}
 	done            bool
-	oldValue        func(context.Context) (*ParentInvite, error)
-	predicates      []predicate.ParentInvite
+	oldValue        func(context.Context) (*Quest, error)
+	predicates      []predicate.Quest
 }
 
-var _ ent.Mutation = (*ParentInviteMutation)(nil)
+var _ ent.Mutation = (*QuestMutation)(nil)
 
-// parentinviteOption allows management of the mutation configuration using functional options.
-type parentinviteOption func(*ParentInviteMutation)
+// questOption allows management of the mutation configuration using functional options.
+type questOption func(*QuestMutation)
 
-// newParentInviteMutation creates new mutation for the ParentInvite entity.
-func newParentInviteMutation(c config, op Op, opts ...parentinviteOption) *ParentInviteMutation {
-	m := &ParentInviteMutation{
+// newQuestMutation creates new mutation for the Quest entity.
+func newQuestMutation(c config, op Op, opts ...questOption) *QuestMutation {
+	m := &QuestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeParentInvite,
+		typ:           TypeQuest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12783,20 +15426,20 @@ func newParentInviteMutation(c config, op Op, opts ...parentinviteOption) *Paren
 	return m
 }
 
-// withParentInviteID sets the ID field of the mutation.
-func withParentInviteID(id int) parentinviteOption {
-	return func(m *ParentInviteMutation) {
+// withQuestID sets the ID field of the mutation.
+func withQuestID(id int) questOption {
+	return func(m *QuestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ParentInvite
+			value *Quest
 		)
-		m.oldValue = func(ctx context.Context) (*ParentInvite, error) {
+		m.oldValue = func(ctx context.Context) (*Quest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ParentInvite.Get(ctx, id)
+					value, err = m.Client().Quest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12805,10 +15448,10 @@ func withParentInviteID(id int) parentinviteOption {
 	}
 }
 
-// withParentInvite sets the old ParentInvite of the mutation.
-func withParentInvite(node *ParentInvite) parentinviteOption {
-	return func(m *ParentInviteMutation) {
-		m.oldValue = func(context.Context) (*ParentInvite, error) {
+// withQuest sets the old Quest of the mutation.
+func withQuest(node *Quest) questOption {
+	return func(m *QuestMutation) {
+		m.oldValue = func(context.Context) (*Quest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12817,7 +15460,7 @@ func withParentInvite(node *ParentInvite) parentinviteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ParentInviteMutation) Client() *Client {
+func (m QuestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12825,7 +15468,7 @@ func (m ParentInviteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ParentInviteMutation) Tx() (*Tx, error) {
+func (m QuestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12836,7 +15479,7 @@ func (m ParentInviteMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ParentInviteMutation) ID() (id int, exists bool) {
+func (m *QuestMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12847,7 +15490,7 @@ func (m *ParentInviteMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ParentInviteMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *QuestMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12856,19 +15499,19 @@ func (m *ParentInviteMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ParentInvite.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Quest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *ParentInviteMutation) SetUID(s string) {
+func (m *QuestMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *ParentInviteMutation) UID() (r string, exists bool) {
+func (m *QuestMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -12876,10 +15519,10 @@ func (m *ParentInviteMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *QuestMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -12894,17 +15537,17 @@ func (m *ParentInviteMutation) OldUID(ctx context.Context) (v string, err error)
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *ParentInviteMutation) ResetUID() {
+func (m *QuestMutation) ResetUID() {
 	m.uid = nil
 }
 
 // SetFamilySpaceID sets the "family_space_id" field.
-func (m *ParentInviteMutation) SetFamilySpaceID(s string) {
+func (m *QuestMutation) SetFamilySpaceID(s string) {
 	m.family_space_id = &s
 }
 
 // FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *ParentInviteMutation) FamilySpaceID() (r string, exists bool) {
+func (m *QuestMutation) FamilySpaceID() (r string, exists bool) {
 	v := m.family_space_id
 	if v == nil {
 		return
@@ -12912,10 +15555,10 @@ func (m *ParentInviteMutation) FamilySpaceID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldFamilySpaceID returns the old "family_space_id" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilySpaceID returns the old "family_space_id" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
+func (m *QuestMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
 	}
@@ -12930,53 +15573,161 @@ func (m *ParentInviteMutation) OldFamilySpaceID(ctx context.Context) (v string,
 }
 
 // ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *ParentInviteMutation) ResetFamilySpaceID() {
+func (m *QuestMutation) ResetFamilySpaceID() {
 	m.family_space_id = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *ParentInviteMutation) SetEmail(s string) {
-	m.email = &s
+// SetName sets the "name" field.
+func (m *QuestMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *ParentInviteMutation) Email() (r string, exists bool) {
-	v := m.email
+// Name returns the value of the "name" field in the mutation.
+func (m *QuestMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *QuestMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Name, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *ParentInviteMutation) ResetEmail() {
-	m.email = nil
+// ResetName resets all changes to the "name" field.
+func (m *QuestMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *QuestMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *QuestMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *QuestMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetSkillID sets the "skill_id" field.
+func (m *QuestMutation) SetSkillID(s string) {
+	m.skill_id = &s
+}
+
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *QuestMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkillID returns the old "skill_id" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestMutation) OldSkillID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	}
+	return oldValue.SkillID, nil
+}
+
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *QuestMutation) ResetSkillID() {
+	m.skill_id = nil
+}
+
+// SetChildUID sets the "child_uid" field.
+func (m *QuestMutation) SetChildUID(s string) {
+	m.child_uid = &s
+}
+
+// ChildUID returns the value of the "child_uid" field in the mutation.
+func (m *QuestMutation) ChildUID() (r string, exists bool) {
+	v := m.child_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChildUID returns the old "child_uid" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestMutation) OldChildUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChildUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChildUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChildUID: %w", err)
+	}
+	return oldValue.ChildUID, nil
+}
+
+// ResetChildUID resets all changes to the "child_uid" field.
+func (m *QuestMutation) ResetChildUID() {
+	m.child_uid = nil
 }
 
 // SetStatus sets the "status" field.
-func (m *ParentInviteMutation) SetStatus(s string) {
+func (m *QuestMutation) SetStatus(s string) {
 	m.status = &s
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *ParentInviteMutation) Status() (r string, exists bool) {
+func (m *QuestMutation) Status() (r string, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -12984,10 +15735,10 @@ func (m *ParentInviteMutation) Status() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *QuestMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -13002,17 +15753,17 @@ func (m *ParentInviteMutation) OldStatus(ctx context.Context) (v string, err err
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *ParentInviteMutation) ResetStatus() {
+func (m *QuestMutation) ResetStatus() {
 	m.status = nil
 }
 
 // SetCreatedBy sets the "created_by" field.
-func (m *ParentInviteMutation) SetCreatedBy(s string) {
+func (m *QuestMutation) SetCreatedBy(s string) {
 	m.created_by = &s
 }
 
 // CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *ParentInviteMutation) CreatedBy() (r string, exists bool) {
+func (m *QuestMutation) CreatedBy() (r string, exists bool) {
 	v := m.created_by
 	if v == nil {
 		return
@@ -13020,10 +15771,10 @@ func (m *ParentInviteMutation) CreatedBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *QuestMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
@@ -13038,17 +15789,17 @@ func (m *ParentInviteMutation) OldCreatedBy(ctx context.Context) (v string, err
 }
 
 // ResetCreatedBy resets all changes to the "created_by" field.
-func (m *ParentInviteMutation) ResetCreatedBy() {
+func (m *QuestMutation) ResetCreatedBy() {
 	m.created_by = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ParentInviteMutation) SetCreatedAt(t time.Time) {
+func (m *QuestMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ParentInviteMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *QuestMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -13056,37 +15807,73 @@ func (m *ParentInviteMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ParentInvite entity.
-// If the ParentInvite object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Quest entity.
+// If the Quest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParentInviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ParentInviteMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the ParentInviteMutation builder.
-func (m *ParentInviteMutation) Where(ps ...predicate.ParentInvite) {
+// Where appends a list predicates to the QuestMutation builder.
+func (m *QuestMutation) Where(ps ...predicate.Quest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ParentInviteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the QuestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ParentInviteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ParentInvite, len(ps))
+func (m *QuestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13094,42 +15881,54 @@ func (m *ParentInviteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ParentInviteMutation) Op() Op {
+func (m *QuestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ParentInviteMutation) SetOp(op Op) {
+func (m *QuestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ParentInvite).
-func (m *ParentInviteMutation) Type() string {
+// Type returns the node type of this mutation (Quest).
+func (m *QuestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ParentInviteMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *QuestMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.uid != nil {
-		fields = append(fields, parentinvite.FieldUID)
+		fields = append(fields, quest.FieldUID)
 	}
 	if m.family_space_id != nil {
-		fields = append(fields, parentinvite.FieldFamilySpaceID)
+		fields = append(fields, quest.FieldFamilySpaceID)
 	}
-	if m.email != nil {
-		fields = append(fields, parentinvite.FieldEmail)
+	if m.name != nil {
+		fields = append(fields, quest.FieldName)
+	}
+	if m.emoji != nil {
+		fields = append(fields, quest.FieldEmoji)
+	}
+	if m.skill_id != nil {
+		fields = append(fields, quest.FieldSkillID)
+	}
+	if m.child_uid != nil {
+		fields = append(fields, quest.FieldChildUID)
 	}
 	if m.status != nil {
-		fields = append(fields, parentinvite.FieldStatus)
+		fields = append(fields, quest.FieldStatus)
 	}
 	if m.created_by != nil {
-		fields = append(fields, parentinvite.FieldCreatedBy)
+		fields = append(fields, quest.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, parentinvite.FieldCreatedAt)
+		fields = append(fields, quest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, quest.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -13137,20 +15936,28 @@ func (m *ParentInviteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ParentInviteMutation) Field(name string) (ent.Value, bool) {
+func (m *QuestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case parentinvite.FieldUID:
+	case quest.FieldUID:
 		return m.UID()
-	case parentinvite.FieldFamilySpaceID:
+	case quest.FieldFamilySpaceID:
 		return m.FamilySpaceID()
-	case parentinvite.FieldEmail:
-		return m.Email()
-	case parentinvite.FieldStatus:
+	case quest.FieldName:
+		return m.Name()
+	case quest.FieldEmoji:
+		return m.Emoji()
+	case quest.FieldSkillID:
+		return m.SkillID()
+	case quest.FieldChildUID:
+		return m.ChildUID()
+	case quest.FieldStatus:
 		return m.Status()
-	case parentinvite.FieldCreatedBy:
+	case quest.FieldCreatedBy:
 		return m.CreatedBy()
-	case parentinvite.FieldCreatedAt:
+	case quest.FieldCreatedAt:
 		return m.CreatedAt()
+	case quest.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -13158,223 +15965,267 @@ func (m *ParentInviteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ParentInviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case parentinvite.FieldUID:
+	case quest.FieldUID:
 		return m.OldUID(ctx)
-	case parentinvite.FieldFamilySpaceID:
+	case quest.FieldFamilySpaceID:
 		return m.OldFamilySpaceID(ctx)
-	case parentinvite.FieldEmail:
-		return m.OldEmail(ctx)
-	case parentinvite.FieldStatus:
+	case quest.FieldName:
+		return m.OldName(ctx)
+	case quest.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case quest.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case quest.FieldChildUID:
+		return m.OldChildUID(ctx)
+	case quest.FieldStatus:
 		return m.OldStatus(ctx)
-	case parentinvite.FieldCreatedBy:
+	case quest.FieldCreatedBy:
 		return m.OldCreatedBy(ctx)
-	case parentinvite.FieldCreatedAt:
+	case quest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case quest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ParentInvite field %s", name)
+	return nil, fmt.Errorf("unknown Quest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ParentInviteMutation) SetField(name string, value ent.Value) error {
+func (m *QuestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case parentinvite.FieldUID:
+	case quest.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case parentinvite.FieldFamilySpaceID:
+	case quest.FieldFamilySpaceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFamilySpaceID(v)
 		return nil
-	case parentinvite.FieldEmail:
+	case quest.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetName(v)
 		return nil
-	case parentinvite.FieldStatus:
+	case quest.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case quest.FieldSkillID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkillID(v)
+		return nil
+	case quest.FieldChildUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChildUID(v)
+		return nil
+	case quest.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case parentinvite.FieldCreatedBy:
+	case quest.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedBy(v)
 		return nil
-	case parentinvite.FieldCreatedAt:
+	case quest.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case quest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ParentInvite field %s", name)
+	return fmt.Errorf("unknown Quest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ParentInviteMutation) AddedFields() []string {
+func (m *QuestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ParentInviteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ParentInviteMutation) AddField(name string, value ent.Value) error {
+func (m *QuestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ParentInvite numeric field %s", name)
+	return fmt.Errorf("unknown Quest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ParentInviteMutation) ClearedFields() []string {
+func (m *QuestMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ParentInviteMutation) FieldCleared(name string) bool {
+func (m *QuestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ParentInviteMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ParentInvite nullable field %s", name)
+func (m *QuestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Quest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ParentInviteMutation) ResetField(name string) error {
+func (m *QuestMutation) ResetField(name string) error {
 	switch name {
-	case parentinvite.FieldUID:
+	case quest.FieldUID:
 		m.ResetUID()
 		return nil
-	case parentinvite.FieldFamilySpaceID:
+	case quest.FieldFamilySpaceID:
 		m.ResetFamilySpaceID()
 		return nil
-	case parentinvite.FieldEmail:
-		m.ResetEmail()
+	case quest.FieldName:
+		m.ResetName()
 		return nil
-	case parentinvite.FieldStatus:
+	case quest.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case quest.FieldSkillID:
+		m.ResetSkillID()
+		return nil
+	case quest.FieldChildUID:
+		m.ResetChildUID()
+		return nil
+	case quest.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case parentinvite.FieldCreatedBy:
+	case quest.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
-	case parentinvite.FieldCreatedAt:
+	case quest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case quest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown ParentInvite field %s", name)
+	return fmt.Errorf("unknown Quest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ParentInviteMutation) AddedEdges() []string {
+func (m *QuestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ParentInviteMutation) AddedIDs(name string) []ent.Value {
+func (m *QuestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ParentInviteMutation) RemovedEdges() []string {
+func (m *QuestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ParentInviteMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ParentInviteMutation) ClearedEdges() []string {
+func (m *QuestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ParentInviteMutation) EdgeCleared(name string) bool {
+func (m *QuestMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ParentInviteMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ParentInvite unique edge %s", name)
+func (m *QuestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Quest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ParentInviteMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ParentInvite edge %s", name)
+func (m *QuestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Quest edge %s", name)
 }
 
-// QuestMutation represents an operation that mutates the Quest nodes in the graph.
-type QuestMutation struct {
+// QuestProgressMutation represents an operation that mutates the QuestProgress nodes in the graph.
+type QuestProgressMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	uid             *string
-	family_space_id *string
-	name            *string
-	emoji           *string
-	skill_id        *string
-	child_uid       *string
-	status          *string
-	created_by      *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Quest, error)
-	predicates      []predicate.Quest
+	op            Op
+	typ           string
+	id            *int
+	uid           *string
+	quest_uid     *string
+	child_uid     *string
+	question_uid  *string
+	correct       *bool
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QuestProgress, error)
+	predicates    []predicate.QuestProgress
 }
 
-var _ ent.Mutation = (*QuestMutation)(nil)
+var _ ent.Mutation = (*QuestProgressMutation)(nil)
 
-// questOption allows management of the mutation configuration using functional options.
-type questOption func(*QuestMutation)
+// questprogressOption allows management of the mutation configuration using functional options.
+type questprogressOption func(*QuestProgressMutation)
 
-// newQuestMutation creates new mutation for the Quest entity.
-func newQuestMutation(c config, op Op, opts ...questOption) *QuestMutation {
-	m := &QuestMutation{
+// newQuestProgressMutation creates new mutation for the QuestProgress entity.
+func newQuestProgressMutation(c config, op Op, opts ...questprogressOption) *QuestProgressMutation {
+	m := &QuestProgressMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuest,
+		typ:           TypeQuestProgress,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13383,20 +16234,20 @@ func newQuestMutation(c config, op Op, opts ...questOption) *QuestMutation {
 	return m
 }
 
-// withQuestID sets the ID field of the mutation.
-func withQuestID(id int) questOption {
-	return func(m *QuestMutation) {
+// withQuestProgressID sets the ID field of the mutation.
+func withQuestProgressID(id int) questprogressOption {
+	return func(m *QuestProgressMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Quest
+			value *QuestProgress
 		)
-		m.oldValue = func(ctx context.Context) (*Quest, error) {
+		m.oldValue = func(ctx context.Context) (*QuestProgress, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Quest.Get(ctx, id)
+					value, err = m.Client().QuestProgress.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13405,10 +16256,10 @@ func withQuestID(id int) questOption {
 	}
 }
 
-// withQuest sets the old Quest of the mutation.
-func withQuest(node *Quest) questOption {
-	return func(m *QuestMutation) {
-		m.oldValue = func(context.Context) (*Quest, error) {
+// withQuestProgress sets the old QuestProgress of the mutation.
+func withQuestProgress(node *QuestProgress) questprogressOption {
+	return func(m *QuestProgressMutation) {
+		m.oldValue = func(context.Context) (*QuestProgress, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13417,7 +16268,7 @@ func withQuest(node *Quest) questOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestMutation) Client() *Client {
+func (m QuestProgressMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13425,7 +16276,7 @@ func (m QuestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestMutation) Tx() (*Tx, error) {
+func (m QuestProgressMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -13436,7 +16287,7 @@ func (m QuestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestMutation) ID() (id int, exists bool) {
+func (m *QuestProgressMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13447,7 +16298,7 @@ func (m *QuestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *QuestProgressMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13456,19 +16307,19 @@ func (m *QuestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Quest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().QuestProgress.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *QuestMutation) SetUID(s string) {
+func (m *QuestProgressMutation) SetUID(s string) {
 	m.uid = &s
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *QuestMutation) UID() (r string, exists bool) {
+func (m *QuestProgressMutation) UID() (r string, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -13476,10 +16327,10 @@ func (m *QuestMutation) UID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *QuestProgressMutation) OldUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -13494,161 +16345,53 @@ func (m *QuestMutation) OldUID(ctx context.Context) (v string, err error) {
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *QuestMutation) ResetUID() {
+func (m *QuestProgressMutation) ResetUID() {
 	m.uid = nil
 }
 
-// SetFamilySpaceID sets the "family_space_id" field.
-func (m *QuestMutation) SetFamilySpaceID(s string) {
-	m.family_space_id = &s
-}
-
-// FamilySpaceID returns the value of the "family_space_id" field in the mutation.
-func (m *QuestMutation) FamilySpaceID() (r string, exists bool) {
-	v := m.family_space_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFamilySpaceID returns the old "family_space_id" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldFamilySpaceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamilySpaceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamilySpaceID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamilySpaceID: %w", err)
-	}
-	return oldValue.FamilySpaceID, nil
-}
-
-// ResetFamilySpaceID resets all changes to the "family_space_id" field.
-func (m *QuestMutation) ResetFamilySpaceID() {
-	m.family_space_id = nil
-}
-
-// SetName sets the "name" field.
-func (m *QuestMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *QuestMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *QuestMutation) ResetName() {
-	m.name = nil
-}
-
-// SetEmoji sets the "emoji" field.
-func (m *QuestMutation) SetEmoji(s string) {
-	m.emoji = &s
-}
-
-// Emoji returns the value of the "emoji" field in the mutation.
-func (m *QuestMutation) Emoji() (r string, exists bool) {
-	v := m.emoji
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmoji returns the old "emoji" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldEmoji(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmoji requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
-	}
-	return oldValue.Emoji, nil
-}
-
-// ResetEmoji resets all changes to the "emoji" field.
-func (m *QuestMutation) ResetEmoji() {
-	m.emoji = nil
-}
-
-// SetSkillID sets the "skill_id" field.
-func (m *QuestMutation) SetSkillID(s string) {
-	m.skill_id = &s
+// SetQuestUID sets the "quest_uid" field.
+func (m *QuestProgressMutation) SetQuestUID(s string) {
+	m.quest_uid = &s
 }
 
-// SkillID returns the value of the "skill_id" field in the mutation.
-func (m *QuestMutation) SkillID() (r string, exists bool) {
-	v := m.skill_id
+// QuestUID returns the value of the "quest_uid" field in the mutation.
+func (m *QuestProgressMutation) QuestUID() (r string, exists bool) {
+	v := m.quest_uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkillID returns the old "skill_id" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestUID returns the old "quest_uid" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldSkillID(ctx context.Context) (v string, err error) {
+func (m *QuestProgressMutation) OldQuestUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillID requires an ID field in the mutation")
+		return v, errors.New("OldQuestUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuestUID: %w", err)
 	}
-	return oldValue.SkillID, nil
+	return oldValue.QuestUID, nil
 }
 
-// ResetSkillID resets all changes to the "skill_id" field.
-func (m *QuestMutation) ResetSkillID() {
-	m.skill_id = nil
+// ResetQuestUID resets all changes to the "quest_uid" field.
+func (m *QuestProgressMutation) ResetQuestUID() {
+	m.quest_uid = nil
 }
 
 // SetChildUID sets the "child_uid" field.
-func (m *QuestMutation) SetChildUID(s string) {
+func (m *QuestProgressMutation) SetChildUID(s string) {
 	m.child_uid = &s
 }
 
 // ChildUID returns the value of the "child_uid" field in the mutation.
-func (m *QuestMutation) ChildUID() (r string, exists bool) {
+func (m *QuestProgressMutation) ChildUID() (r string, exists bool) {
 	v := m.child_uid
 	if v == nil {
 		return
@@ -13656,143 +16399,107 @@ func (m *QuestMutation) ChildUID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldChildUID returns the old "child_uid" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldChildUID returns the old "child_uid" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldChildUID(ctx context.Context) (v string, err error) {
+func (m *QuestProgressMutation) OldChildUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChildUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChildUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChildUID: %w", err)
-	}
-	return oldValue.ChildUID, nil
-}
-
-// ResetChildUID resets all changes to the "child_uid" field.
-func (m *QuestMutation) ResetChildUID() {
-	m.child_uid = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *QuestMutation) SetStatus(s string) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *QuestMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldChildUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldChildUID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ChildUID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *QuestMutation) ResetStatus() {
-	m.status = nil
+// ResetChildUID resets all changes to the "child_uid" field.
+func (m *QuestProgressMutation) ResetChildUID() {
+	m.child_uid = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *QuestMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// SetQuestionUID sets the "question_uid" field.
+func (m *QuestProgressMutation) SetQuestionUID(s string) {
+	m.question_uid = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *QuestMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// QuestionUID returns the value of the "question_uid" field in the mutation.
+func (m *QuestProgressMutation) QuestionUID() (r string, exists bool) {
+	v := m.question_uid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionUID returns the old "question_uid" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *QuestProgressMutation) OldQuestionUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldQuestionUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuestionUID: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.QuestionUID, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *QuestMutation) ResetCreatedBy() {
-	m.created_by = nil
+// ResetQuestionUID resets all changes to the "question_uid" field.
+func (m *QuestProgressMutation) ResetQuestionUID() {
+	m.question_uid = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *QuestMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCorrect sets the "correct" field.
+func (m *QuestProgressMutation) SetCorrect(b bool) {
+	m.correct = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Correct returns the value of the "correct" field in the mutation.
+func (m *QuestProgressMutation) Correct() (r bool, exists bool) {
+	v := m.correct
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldCorrect returns the old "correct" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestProgressMutation) OldCorrect(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCorrect is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCorrect requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCorrect: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Correct, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetCorrect resets all changes to the "correct" field.
+func (m *QuestProgressMutation) ResetCorrect() {
+	m.correct = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *QuestMutation) SetUpdatedAt(t time.Time) {
+func (m *QuestProgressMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QuestMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *QuestProgressMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -13800,10 +16507,10 @@ func (m *QuestMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Quest entity.
-// If the Quest object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the QuestProgress entity.
+// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -13818,19 +16525,19 @@ func (m *QuestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QuestMutation) ResetUpdatedAt() {
+func (m *QuestProgressMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the QuestMutation builder.
-func (m *QuestMutation) Where(ps ...predicate.Quest) {
+// Where appends a list predicates to the QuestProgressMutation builder.
+func (m *QuestProgressMutation) Where(ps ...predicate.QuestProgress) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the QuestProgressMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Quest, len(ps))
+func (m *QuestProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestProgress, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13838,54 +16545,42 @@ func (m *QuestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestMutation) Op() Op {
+func (m *QuestProgressMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestMutation) SetOp(op Op) {
+func (m *QuestProgressMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Quest).
-func (m *QuestMutation) Type() string {
+// Type returns the node type of this mutation (QuestProgress).
+func (m *QuestProgressMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *QuestProgressMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.uid != nil {
-		fields = append(fields, quest.FieldUID)
-	}
-	if m.family_space_id != nil {
-		fields = append(fields, quest.FieldFamilySpaceID)
-	}
-	if m.name != nil {
-		fields = append(fields, quest.FieldName)
-	}
-	if m.emoji != nil {
-		fields = append(fields, quest.FieldEmoji)
+		fields = append(fields, questprogress.FieldUID)
 	}
-	if m.skill_id != nil {
-		fields = append(fields, quest.FieldSkillID)
+	if m.quest_uid != nil {
+		fields = append(fields, questprogress.FieldQuestUID)
 	}
 	if m.child_uid != nil {
-		fields = append(fields, quest.FieldChildUID)
-	}
-	if m.status != nil {
-		fields = append(fields, quest.FieldStatus)
+		fields = append(fields, questprogress.FieldChildUID)
 	}
-	if m.created_by != nil {
-		fields = append(fields, quest.FieldCreatedBy)
+	if m.question_uid != nil {
+		fields = append(fields, questprogress.FieldQuestionUID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, quest.FieldCreatedAt)
+	if m.correct != nil {
+		fields = append(fields, questprogress.FieldCorrect)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, quest.FieldUpdatedAt)
+		fields = append(fields, questprogress.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -13893,27 +16588,19 @@ func (m *QuestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestMutation) Field(name string) (ent.Value, bool) {
+func (m *QuestProgressMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case quest.FieldUID:
+	case questprogress.FieldUID:
 		return m.UID()
-	case quest.FieldFamilySpaceID:
-		return m.FamilySpaceID()
-	case quest.FieldName:
-		return m.Name()
-	case quest.FieldEmoji:
-		return m.Emoji()
-	case quest.FieldSkillID:
-		return m.SkillID()
-	case quest.FieldChildUID:
+	case questprogress.FieldQuestUID:
+		return m.QuestUID()
+	case questprogress.FieldChildUID:
 		return m.ChildUID()
-	case quest.FieldStatus:
-		return m.Status()
-	case quest.FieldCreatedBy:
-		return m.CreatedBy()
-	case quest.FieldCreatedAt:
-		return m.CreatedAt()
-	case quest.FieldUpdatedAt:
+	case questprogress.FieldQuestionUID:
+		return m.QuestionUID()
+	case questprogress.FieldCorrect:
+		return m.Correct()
+	case questprogress.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -13922,101 +16609,65 @@ func (m *QuestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuestProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case quest.FieldUID:
+	case questprogress.FieldUID:
 		return m.OldUID(ctx)
-	case quest.FieldFamilySpaceID:
-		return m.OldFamilySpaceID(ctx)
-	case quest.FieldName:
-		return m.OldName(ctx)
-	case quest.FieldEmoji:
-		return m.OldEmoji(ctx)
-	case quest.FieldSkillID:
-		return m.OldSkillID(ctx)
-	case quest.FieldChildUID:
+	case questprogress.FieldQuestUID:
+		return m.OldQuestUID(ctx)
+	case questprogress.FieldChildUID:
 		return m.OldChildUID(ctx)
-	case quest.FieldStatus:
-		return m.OldStatus(ctx)
-	case quest.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case quest.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case quest.FieldUpdatedAt:
+	case questprogress.FieldQuestionUID:
+		return m.OldQuestionUID(ctx)
+	case questprogress.FieldCorrect:
+		return m.OldCorrect(ctx)
+	case questprogress.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Quest field %s", name)
+	return nil, fmt.Errorf("unknown QuestProgress field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestMutation) SetField(name string, value ent.Value) error {
+func (m *QuestProgressMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case quest.FieldUID:
+	case questprogress.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case quest.FieldFamilySpaceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFamilySpaceID(v)
-		return nil
-	case quest.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case quest.FieldEmoji:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmoji(v)
-		return nil
-	case quest.FieldSkillID:
+	case questprogress.FieldQuestUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillID(v)
+		m.SetQuestUID(v)
 		return nil
-	case quest.FieldChildUID:
+	case questprogress.FieldChildUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChildUID(v)
 		return nil
-	case quest.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case quest.FieldCreatedBy:
+	case questprogress.FieldQuestionUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetQuestionUID(v)
 		return nil
-	case quest.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case questprogress.FieldCorrect:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCorrect(v)
 		return nil
-	case quest.FieldUpdatedAt:
+	case questprogress.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -14024,477 +16675,738 @@ func (m *QuestMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Quest field %s", name)
+	return fmt.Errorf("unknown QuestProgress field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestMutation) AddedFields() []string {
+func (m *QuestProgressMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuestProgressMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestMutation) AddField(name string, value ent.Value) error {
+func (m *QuestProgressMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Quest numeric field %s", name)
+	return fmt.Errorf("unknown QuestProgress numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestMutation) ClearedFields() []string {
+func (m *QuestProgressMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestMutation) FieldCleared(name string) bool {
+func (m *QuestProgressMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Quest nullable field %s", name)
+func (m *QuestProgressMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QuestProgress nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestMutation) ResetField(name string) error {
+func (m *QuestProgressMutation) ResetField(name string) error {
 	switch name {
-	case quest.FieldUID:
+	case questprogress.FieldUID:
 		m.ResetUID()
 		return nil
-	case quest.FieldFamilySpaceID:
-		m.ResetFamilySpaceID()
-		return nil
-	case quest.FieldName:
-		m.ResetName()
-		return nil
-	case quest.FieldEmoji:
-		m.ResetEmoji()
-		return nil
-	case quest.FieldSkillID:
-		m.ResetSkillID()
+	case questprogress.FieldQuestUID:
+		m.ResetQuestUID()
 		return nil
-	case quest.FieldChildUID:
+	case questprogress.FieldChildUID:
 		m.ResetChildUID()
 		return nil
-	case quest.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case quest.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case questprogress.FieldQuestionUID:
+		m.ResetQuestionUID()
 		return nil
-	case quest.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case questprogress.FieldCorrect:
+		m.ResetCorrect()
 		return nil
-	case quest.FieldUpdatedAt:
+	case questprogress.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Quest field %s", name)
+	return fmt.Errorf("unknown QuestProgress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestProgressMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestProgressMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestProgressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QuestProgress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestProgressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QuestProgress edge %s", name)
+}
+
+// QuestQuestionMutation represents an operation that mutates the QuestQuestion nodes in the graph.
+type QuestQuestionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	uid           *string
+	quest_uid     *string
+	position      *int
+	addposition   *int
+	text          *string
+	answer        *string
+	answer_type   *string
+	format        *string
+	choices       *[]string
+	appendchoices []string
+	hint          *string
+	explanation   *string
+	client_key    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QuestQuestion, error)
+	predicates    []predicate.QuestQuestion
+}
+
+var _ ent.Mutation = (*QuestQuestionMutation)(nil)
+
+// questquestionOption allows management of the mutation configuration using functional options.
+type questquestionOption func(*QuestQuestionMutation)
+
+// newQuestQuestionMutation creates new mutation for the QuestQuestion entity.
+func newQuestQuestionMutation(c config, op Op, opts ...questquestionOption) *QuestQuestionMutation {
+	m := &QuestQuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestQuestionID sets the ID field of the mutation.
+func withQuestQuestionID(id int) questquestionOption {
+	return func(m *QuestQuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuestQuestion
+		)
+		m.oldValue = func(ctx context.Context) (*QuestQuestion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuestQuestion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestQuestion sets the old QuestQuestion of the mutation.
+func withQuestQuestion(node *QuestQuestion) questquestionOption {
+	return func(m *QuestQuestionMutation) {
+		m.oldValue = func(context.Context) (*QuestQuestion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestQuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestQuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestQuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuestQuestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUID sets the "uid" field.
+func (m *QuestQuestionMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *QuestQuestionMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *QuestQuestionMutation) ResetUID() {
+	m.uid = nil
+}
+
+// SetQuestUID sets the "quest_uid" field.
+func (m *QuestQuestionMutation) SetQuestUID(s string) {
+	m.quest_uid = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// QuestUID returns the value of the "quest_uid" field in the mutation.
+func (m *QuestQuestionMutation) QuestUID() (r string, exists bool) {
+	v := m.quest_uid
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *QuestMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldQuestUID returns the old "quest_uid" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldQuestUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestUID: %w", err)
+	}
+	return oldValue.QuestUID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetQuestUID resets all changes to the "quest_uid" field.
+func (m *QuestQuestionMutation) ResetQuestUID() {
+	m.quest_uid = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *QuestMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetPosition sets the "position" field.
+func (m *QuestQuestionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// Position returns the value of the "position" field in the mutation.
+func (m *QuestQuestionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *QuestMutation) EdgeCleared(name string) bool {
-	return false
+// OldPosition returns the old "position" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *QuestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Quest unique edge %s", name)
+// AddPosition adds i to the "position" field.
+func (m *QuestQuestionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *QuestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Quest edge %s", name)
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *QuestQuestionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// QuestProgressMutation represents an operation that mutates the QuestProgress nodes in the graph.
-type QuestProgressMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	uid           *string
-	quest_uid     *string
-	child_uid     *string
-	question_uid  *string
-	correct       *bool
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*QuestProgress, error)
-	predicates    []predicate.QuestProgress
+// ResetPosition resets all changes to the "position" field.
+func (m *QuestQuestionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-var _ ent.Mutation = (*QuestProgressMutation)(nil)
+// SetText sets the "text" field.
+func (m *QuestQuestionMutation) SetText(s string) {
+	m.text = &s
+}
 
-// questprogressOption allows management of the mutation configuration using functional options.
-type questprogressOption func(*QuestProgressMutation)
+// Text returns the value of the "text" field in the mutation.
+func (m *QuestQuestionMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newQuestProgressMutation creates new mutation for the QuestProgress entity.
-func newQuestProgressMutation(c config, op Op, opts ...questprogressOption) *QuestProgressMutation {
-	m := &QuestProgressMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeQuestProgress,
-		clearedFields: make(map[string]struct{}),
+// OldText returns the old "text" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
 }
 
-// withQuestProgressID sets the ID field of the mutation.
-func withQuestProgressID(id int) questprogressOption {
-	return func(m *QuestProgressMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *QuestProgress
-		)
-		m.oldValue = func(ctx context.Context) (*QuestProgress, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().QuestProgress.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetText resets all changes to the "text" field.
+func (m *QuestQuestionMutation) ResetText() {
+	m.text = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *QuestQuestionMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *QuestQuestionMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withQuestProgress sets the old QuestProgress of the mutation.
-func withQuestProgress(node *QuestProgress) questprogressOption {
-	return func(m *QuestProgressMutation) {
-		m.oldValue = func(context.Context) (*QuestProgress, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldAnswer returns the old "answer" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
 	}
+	return oldValue.Answer, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestProgressMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetAnswer resets all changes to the "answer" field.
+func (m *QuestQuestionMutation) ResetAnswer() {
+	m.answer = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m QuestProgressMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetAnswerType sets the "answer_type" field.
+func (m *QuestQuestionMutation) SetAnswerType(s string) {
+	m.answer_type = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *QuestProgressMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AnswerType returns the value of the "answer_type" field in the mutation.
+func (m *QuestQuestionMutation) AnswerType() (r string, exists bool) {
+	v := m.answer_type
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
+}
+
+// OldAnswerType returns the old "answer_type" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestQuestionMutation) OldAnswerType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerType: %w", err)
+	}
+	return oldValue.AnswerType, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *QuestProgressMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QuestProgress.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetAnswerType resets all changes to the "answer_type" field.
+func (m *QuestQuestionMutation) ResetAnswerType() {
+	m.answer_type = nil
 }
 
-// SetUID sets the "uid" field.
-func (m *QuestProgressMutation) SetUID(s string) {
-	m.uid = &s
+// SetFormat sets the "format" field.
+func (m *QuestQuestionMutation) SetFormat(s string) {
+	m.format = &s
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *QuestProgressMutation) UID() (r string, exists bool) {
-	v := m.uid
+// Format returns the value of the "format" field in the mutation.
+func (m *QuestQuestionMutation) Format() (r string, exists bool) {
+	v := m.format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldFormat returns the old "format" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *QuestQuestionMutation) OldFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.Format, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *QuestProgressMutation) ResetUID() {
-	m.uid = nil
+// ResetFormat resets all changes to the "format" field.
+func (m *QuestQuestionMutation) ResetFormat() {
+	m.format = nil
 }
 
-// SetQuestUID sets the "quest_uid" field.
-func (m *QuestProgressMutation) SetQuestUID(s string) {
-	m.quest_uid = &s
+// SetChoices sets the "choices" field.
+func (m *QuestQuestionMutation) SetChoices(s []string) {
+	m.choices = &s
+	m.appendchoices = nil
 }
 
-// QuestUID returns the value of the "quest_uid" field in the mutation.
-func (m *QuestProgressMutation) QuestUID() (r string, exists bool) {
-	v := m.quest_uid
+// Choices returns the value of the "choices" field in the mutation.
+func (m *QuestQuestionMutation) Choices() (r []string, exists bool) {
+	v := m.choices
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestUID returns the old "quest_uid" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldChoices returns the old "choices" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldQuestUID(ctx context.Context) (v string, err error) {
+func (m *QuestQuestionMutation) OldChoices(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChoices is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestUID requires an ID field in the mutation")
+		return v, errors.New("OldChoices requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChoices: %w", err)
 	}
-	return oldValue.QuestUID, nil
+	return oldValue.Choices, nil
 }
 
-// ResetQuestUID resets all changes to the "quest_uid" field.
-func (m *QuestProgressMutation) ResetQuestUID() {
-	m.quest_uid = nil
+// AppendChoices adds s to the "choices" field.
+func (m *QuestQuestionMutation) AppendChoices(s []string) {
+	m.appendchoices = append(m.appendchoices, s...)
 }
 
-// SetChildUID sets the "child_uid" field.
-func (m *QuestProgressMutation) SetChildUID(s string) {
-	m.child_uid = &s
+// AppendedChoices returns the list of values that were appended to the "choices" field in this mutation.
+func (m *QuestQuestionMutation) AppendedChoices() ([]string, bool) {
+	if len(m.appendchoices) == 0 {
+		return nil, false
+	}
+	return m.appendchoices, true
 }
 
-// ChildUID returns the value of the "child_uid" field in the mutation.
-func (m *QuestProgressMutation) ChildUID() (r string, exists bool) {
-	v := m.child_uid
+// ClearChoices clears the value of the "choices" field.
+func (m *QuestQuestionMutation) ClearChoices() {
+	m.choices = nil
+	m.appendchoices = nil
+	m.clearedFields[questquestion.FieldChoices] = struct{}{}
+}
+
+// ChoicesCleared returns if the "choices" field was cleared in this mutation.
+func (m *QuestQuestionMutation) ChoicesCleared() bool {
+	_, ok := m.clearedFields[questquestion.FieldChoices]
+	return ok
+}
+
+// ResetChoices resets all changes to the "choices" field.
+func (m *QuestQuestionMutation) ResetChoices() {
+	m.choices = nil
+	m.appendchoices = nil
+	delete(m.clearedFields, questquestion.FieldChoices)
+}
+
+// SetHint sets the "hint" field.
+func (m *QuestQuestionMutation) SetHint(s string) {
+	m.hint = &s
+}
+
+// Hint returns the value of the "hint" field in the mutation.
+func (m *QuestQuestionMutation) Hint() (r string, exists bool) {
+	v := m.hint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChildUID returns the old "child_uid" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldHint returns the old "hint" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldChildUID(ctx context.Context) (v string, err error) {
+func (m *QuestQuestionMutation) OldHint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChildUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChildUID requires an ID field in the mutation")
+		return v, errors.New("OldHint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChildUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
 	}
-	return oldValue.ChildUID, nil
+	return oldValue.Hint, nil
 }
 
-// ResetChildUID resets all changes to the "child_uid" field.
-func (m *QuestProgressMutation) ResetChildUID() {
-	m.child_uid = nil
+// ResetHint resets all changes to the "hint" field.
+func (m *QuestQuestionMutation) ResetHint() {
+	m.hint = nil
 }
 
-// SetQuestionUID sets the "question_uid" field.
-func (m *QuestProgressMutation) SetQuestionUID(s string) {
-	m.question_uid = &s
+// SetExplanation sets the "explanation" field.
+func (m *QuestQuestionMutation) SetExplanation(s string) {
+	m.explanation = &s
 }
 
-// QuestionUID returns the value of the "question_uid" field in the mutation.
-func (m *QuestProgressMutation) QuestionUID() (r string, exists bool) {
-	v := m.question_uid
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *QuestQuestionMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionUID returns the old "question_uid" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldExplanation returns the old "explanation" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldQuestionUID(ctx context.Context) (v string, err error) {
+func (m *QuestQuestionMutation) OldExplanation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionUID requires an ID field in the mutation")
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
 	}
-	return oldValue.QuestionUID, nil
+	return oldValue.Explanation, nil
 }
 
-// ResetQuestionUID resets all changes to the "question_uid" field.
-func (m *QuestProgressMutation) ResetQuestionUID() {
-	m.question_uid = nil
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *QuestQuestionMutation) ResetExplanation() {
+	m.explanation = nil
 }
 
-// SetCorrect sets the "correct" field.
-func (m *QuestProgressMutation) SetCorrect(b bool) {
-	m.correct = &b
+// SetClientKey sets the "client_key" field.
+func (m *QuestQuestionMutation) SetClientKey(s string) {
+	m.client_key = &s
 }
 
-// Correct returns the value of the "correct" field in the mutation.
-func (m *QuestProgressMutation) Correct() (r bool, exists bool) {
-	v := m.correct
+// ClientKey returns the value of the "client_key" field in the mutation.
+func (m *QuestQuestionMutation) ClientKey() (r string, exists bool) {
+	v := m.client_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCorrect returns the old "correct" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldClientKey returns the old "client_key" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldCorrect(ctx context.Context) (v bool, err error) {
+func (m *QuestQuestionMutation) OldClientKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrect is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrect requires an ID field in the mutation")
+		return v, errors.New("OldClientKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrect: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientKey: %w", err)
 	}
-	return oldValue.Correct, nil
+	return oldValue.ClientKey, nil
 }
 
-// ResetCorrect resets all changes to the "correct" field.
-func (m *QuestProgressMutation) ResetCorrect() {
-	m.correct = nil
+// ResetClientKey resets all changes to the "client_key" field.
+func (m *QuestQuestionMutation) ResetClientKey() {
+	m.client_key = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *QuestProgressMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QuestProgressMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the QuestProgress entity.
-// If the QuestProgress object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the QuestQuestion entity.
+// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QuestProgressMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestQuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the QuestProgressMutation builder.
-func (m *QuestProgressMutation) Where(ps ...predicate.QuestProgress) {
+// Where appends a list predicates to the QuestQuestionMutation builder.
+func (m *QuestQuestionMutation) Where(ps ...predicate.QuestQuestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestProgressMutation builder. Using this method,
+// WhereP appends storage-level predicates to the QuestQuestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestProgressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QuestProgress, len(ps))
+func (m *QuestQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestQuestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -14502,42 +17414,60 @@ func (m *QuestProgressMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestProgressMutation) Op() Op {
+func (m *QuestQuestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestProgressMutation) SetOp(op Op) {
+func (m *QuestQuestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QuestProgress).
-func (m *QuestProgressMutation) Type() string {
+// Type returns the node type of this mutation (QuestQuestion).
+func (m *QuestQuestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestProgressMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *QuestQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.uid != nil {
-		fields = append(fields, questprogress.FieldUID)
+		fields = append(fields, questquestion.FieldUID)
 	}
 	if m.quest_uid != nil {
-		fields = append(fields, questprogress.FieldQuestUID)
+		fields = append(fields, questquestion.FieldQuestUID)
 	}
-	if m.child_uid != nil {
-		fields = append(fields, questprogress.FieldChildUID)
+	if m.position != nil {
+		fields = append(fields, questquestion.FieldPosition)
 	}
-	if m.question_uid != nil {
-		fields = append(fields, questprogress.FieldQuestionUID)
+	if m.text != nil {
+		fields = append(fields, questquestion.FieldText)
 	}
-	if m.correct != nil {
-		fields = append(fields, questprogress.FieldCorrect)
+	if m.answer != nil {
+		fields = append(fields, questquestion.FieldAnswer)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, questprogress.FieldUpdatedAt)
+	if m.answer_type != nil {
+		fields = append(fields, questquestion.FieldAnswerType)
+	}
+	if m.format != nil {
+		fields = append(fields, questquestion.FieldFormat)
+	}
+	if m.choices != nil {
+		fields = append(fields, questquestion.FieldChoices)
+	}
+	if m.hint != nil {
+		fields = append(fields, questquestion.FieldHint)
+	}
+	if m.explanation != nil {
+		fields = append(fields, questquestion.FieldExplanation)
+	}
+	if m.client_key != nil {
+		fields = append(fields, questquestion.FieldClientKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, questquestion.FieldCreatedAt)
 	}
 	return fields
 }
@@ -14545,20 +17475,32 @@ func (m *QuestProgressMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestProgressMutation) Field(name string) (ent.Value, bool) {
+func (m *QuestQuestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case questprogress.FieldUID:
+	case questquestion.FieldUID:
 		return m.UID()
-	case questprogress.FieldQuestUID:
+	case questquestion.FieldQuestUID:
 		return m.QuestUID()
-	case questprogress.FieldChildUID:
-		return m.ChildUID()
-	case questprogress.FieldQuestionUID:
-		return m.QuestionUID()
-	case questprogress.FieldCorrect:
-		return m.Correct()
-	case questprogress.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case questquestion.FieldPosition:
+		return m.Position()
+	case questquestion.FieldText:
+		return m.Text()
+	case questquestion.FieldAnswer:
+		return m.Answer()
+	case questquestion.FieldAnswerType:
+		return m.AnswerType()
+	case questquestion.FieldFormat:
+		return m.Format()
+	case questquestion.FieldChoices:
+		return m.Choices()
+	case questquestion.FieldHint:
+		return m.Hint()
+	case questquestion.FieldExplanation:
+		return m.Explanation()
+	case questquestion.FieldClientKey:
+		return m.ClientKey()
+	case questquestion.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -14566,227 +17508,328 @@ func (m *QuestProgressMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuestQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case questprogress.FieldUID:
+	case questquestion.FieldUID:
 		return m.OldUID(ctx)
-	case questprogress.FieldQuestUID:
+	case questquestion.FieldQuestUID:
 		return m.OldQuestUID(ctx)
-	case questprogress.FieldChildUID:
-		return m.OldChildUID(ctx)
-	case questprogress.FieldQuestionUID:
-		return m.OldQuestionUID(ctx)
-	case questprogress.FieldCorrect:
-		return m.OldCorrect(ctx)
-	case questprogress.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case questquestion.FieldPosition:
+		return m.OldPosition(ctx)
+	case questquestion.FieldText:
+		return m.OldText(ctx)
+	case questquestion.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case questquestion.FieldAnswerType:
+		return m.OldAnswerType(ctx)
+	case questquestion.FieldFormat:
+		return m.OldFormat(ctx)
+	case questquestion.FieldChoices:
+		return m.OldChoices(ctx)
+	case questquestion.FieldHint:
+		return m.OldHint(ctx)
+	case questquestion.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case questquestion.FieldClientKey:
+		return m.OldClientKey(ctx)
+	case questquestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QuestProgress field %s", name)
+	return nil, fmt.Errorf("unknown QuestQuestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestProgressMutation) SetField(name string, value ent.Value) error {
+func (m *QuestQuestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case questprogress.FieldUID:
+	case questquestion.FieldUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case questprogress.FieldQuestUID:
+	case questquestion.FieldQuestUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetQuestUID(v)
 		return nil
-	case questprogress.FieldChildUID:
+	case questquestion.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case questquestion.FieldText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChildUID(v)
+		m.SetText(v)
 		return nil
-	case questprogress.FieldQuestionUID:
+	case questquestion.FieldAnswer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionUID(v)
+		m.SetAnswer(v)
 		return nil
-	case questprogress.FieldCorrect:
-		v, ok := value.(bool)
+	case questquestion.FieldAnswerType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCorrect(v)
+		m.SetAnswerType(v)
 		return nil
-	case questprogress.FieldUpdatedAt:
+	case questquestion.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case questquestion.FieldChoices:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoices(v)
+		return nil
+	case questquestion.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case questquestion.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case questquestion.FieldClientKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientKey(v)
+		return nil
+	case questquestion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuestProgress field %s", name)
+	return fmt.Errorf("unknown QuestQuestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestProgressMutation) AddedFields() []string {
-	return nil
+func (m *QuestQuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, questquestion.FieldPosition)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestProgressMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuestQuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case questquestion.FieldPosition:
+		return m.AddedPosition()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestProgressMutation) AddField(name string, value ent.Value) error {
+func (m *QuestQuestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case questquestion.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return fmt.Errorf("unknown QuestProgress numeric field %s", name)
+	return fmt.Errorf("unknown QuestQuestion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestProgressMutation) ClearedFields() []string {
-	return nil
+func (m *QuestQuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(questquestion.FieldChoices) {
+		fields = append(fields, questquestion.FieldChoices)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestProgressMutation) FieldCleared(name string) bool {
+func (m *QuestQuestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestProgressMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown QuestProgress nullable field %s", name)
+func (m *QuestQuestionMutation) ClearField(name string) error {
+	switch name {
+	case questquestion.FieldChoices:
+		m.ClearChoices()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestQuestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestProgressMutation) ResetField(name string) error {
+func (m *QuestQuestionMutation) ResetField(name string) error {
 	switch name {
-	case questprogress.FieldUID:
+	case questquestion.FieldUID:
 		m.ResetUID()
 		return nil
-	case questprogress.FieldQuestUID:
+	case questquestion.FieldQuestUID:
 		m.ResetQuestUID()
 		return nil
-	case questprogress.FieldChildUID:
-		m.ResetChildUID()
+	case questquestion.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case questquestion.FieldText:
+		m.ResetText()
+		return nil
+	case questquestion.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case questquestion.FieldAnswerType:
+		m.ResetAnswerType()
+		return nil
+	case questquestion.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case questquestion.FieldChoices:
+		m.ResetChoices()
+		return nil
+	case questquestion.FieldHint:
+		m.ResetHint()
 		return nil
-	case questprogress.FieldQuestionUID:
-		m.ResetQuestionUID()
+	case questquestion.FieldExplanation:
+		m.ResetExplanation()
 		return nil
-	case questprogress.FieldCorrect:
-		m.ResetCorrect()
+	case questquestion.FieldClientKey:
+		m.ResetClientKey()
 		return nil
-	case questprogress.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case questquestion.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QuestProgress field %s", name)
+	return fmt.Errorf("unknown QuestQuestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestProgressMutation) AddedEdges() []string {
+func (m *QuestQuestionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestProgressMutation) AddedIDs(name string) []ent.Value {
+func (m *QuestQuestionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestProgressMutation) RemovedEdges() []string {
+func (m *QuestQuestionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestProgressMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuestQuestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestProgressMutation) ClearedEdges() []string {
+func (m *QuestQuestionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestProgressMutation) EdgeCleared(name string) bool {
+func (m *QuestQuestionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestProgressMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown QuestProgress unique edge %s", name)
+func (m *QuestQuestionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QuestQuestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestProgressMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown QuestProgress edge %s", name)
+func (m *QuestQuestionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QuestQuestion edge %s", name)
 }
 
-// QuestQuestionMutation represents an operation that mutates the QuestQuestion nodes in the graph.
-type QuestQuestionMutation struct {
+// QuestionReportEventMutation represents an operation that mutates the QuestionReportEvent nodes in the graph.
+type QuestionReportEventMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	uid           *string
-	quest_uid     *string
-	position      *int
-	addposition   *int
-	text          *string
-	answer        *string
-	answer_type   *string
-	format        *string
-	choices       *[]string
-	appendchoices []string
-	hint          *string
-	explanation   *string
-	client_key    *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*QuestQuestion, error)
-	predicates    []predicate.QuestQuestion
-}
-
-var _ ent.Mutation = (*QuestQuestionMutation)(nil)
-
-// questquestionOption allows management of the mutation configuration using functional options.
-type questquestionOption func(*QuestQuestionMutation)
-
-// newQuestQuestionMutation creates new mutation for the QuestQuestion entity.
-func newQuestQuestionMutation(c config, op Op, opts ...questquestionOption) *QuestQuestionMutation {
-	m := &QuestQuestionMutation{
+	op                Op
+	typ               string
+	id                *int
+	sequence          *int64
+	addsequence       *int64
+	timestamp         *time.Time
+	owner_id          *string
+	session_id        *string
+	skill_id          *string
+	tier              *string
+	question_text     *string
+	answer            *string
+	format            *string
+	choices           *[]string
+	appendchoices     []string
+	source            *string
+	llm_request_id    *int
+	addllm_request_id *int
+	llm_owner         *string
+	learner_answer    *string
+	reporter          *string
+	reason            *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*QuestionReportEvent, error)
+	predicates        []predicate.QuestionReportEvent
+}
+
+var _ ent.Mutation = (*QuestionReportEventMutation)(nil)
+
+// questionreporteventOption allows management of the mutation configuration using functional options.
+type questionreporteventOption func(*QuestionReportEventMutation)
+
+// newQuestionReportEventMutation creates new mutation for the QuestionReportEvent entity.
+func newQuestionReportEventMutation(c config, op Op, opts ...questionreporteventOption) *QuestionReportEventMutation {
+	m := &QuestionReportEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestQuestion,
+		typ:           TypeQuestionReportEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -14795,20 +17838,20 @@ func newQuestQuestionMutation(c config, op Op, opts ...questquestionOption) *Que
 	return m
 }
 
-// withQuestQuestionID sets the ID field of the mutation.
-func withQuestQuestionID(id int) questquestionOption {
-	return func(m *QuestQuestionMutation) {
+// withQuestionReportEventID sets the ID field of the mutation.
+func withQuestionReportEventID(id int) questionreporteventOption {
+	return func(m *QuestionReportEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *QuestQuestion
+			value *QuestionReportEvent
 		)
-		m.oldValue = func(ctx context.Context) (*QuestQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*QuestionReportEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().QuestQuestion.Get(ctx, id)
+					value, err = m.Client().QuestionReportEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -14817,10 +17860,10 @@ func withQuestQuestionID(id int) questquestionOption {
 	}
 }
 
-// withQuestQuestion sets the old QuestQuestion of the mutation.
-func withQuestQuestion(node *QuestQuestion) questquestionOption {
-	return func(m *QuestQuestionMutation) {
-		m.oldValue = func(context.Context) (*QuestQuestion, error) {
+// withQuestionReportEvent sets the old QuestionReportEvent of the mutation.
+func withQuestionReportEvent(node *QuestionReportEvent) questionreporteventOption {
+	return func(m *QuestionReportEventMutation) {
+		m.oldValue = func(context.Context) (*QuestionReportEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -14829,7 +17872,7 @@ func withQuestQuestion(node *QuestQuestion) questquestionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestQuestionMutation) Client() *Client {
+func (m QuestionReportEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -14837,7 +17880,7 @@ func (m QuestQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestQuestionMutation) Tx() (*Tx, error) {
+func (m QuestionReportEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -14848,7 +17891,7 @@ func (m QuestQuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestQuestionMutation) ID() (id int, exists bool) {
+func (m *QuestionReportEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -14859,7 +17902,7 @@ func (m *QuestQuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *QuestionReportEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -14868,255 +17911,327 @@ func (m *QuestQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QuestQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().QuestionReportEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUID sets the "uid" field.
-func (m *QuestQuestionMutation) SetUID(s string) {
-	m.uid = &s
+// SetSequence sets the "sequence" field.
+func (m *QuestionReportEventMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *QuestQuestionMutation) UID() (r string, exists bool) {
-	v := m.uid
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *QuestionReportEventMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.Sequence, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *QuestQuestionMutation) ResetUID() {
-	m.uid = nil
+// AddSequence adds i to the "sequence" field.
+func (m *QuestionReportEventMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
 }
 
-// SetQuestUID sets the "quest_uid" field.
-func (m *QuestQuestionMutation) SetQuestUID(s string) {
-	m.quest_uid = &s
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *QuestionReportEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// QuestUID returns the value of the "quest_uid" field in the mutation.
-func (m *QuestQuestionMutation) QuestUID() (r string, exists bool) {
-	v := m.quest_uid
+// ResetSequence resets all changes to the "sequence" field.
+func (m *QuestionReportEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *QuestionReportEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *QuestionReportEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestUID returns the old "quest_uid" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldQuestUID(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestUID requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.QuestUID, nil
+	return oldValue.Timestamp, nil
 }
 
-// ResetQuestUID resets all changes to the "quest_uid" field.
-func (m *QuestQuestionMutation) ResetQuestUID() {
-	m.quest_uid = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *QuestionReportEventMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetPosition sets the "position" field.
-func (m *QuestQuestionMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// SetOwnerID sets the "owner_id" field.
+func (m *QuestionReportEventMutation) SetOwnerID(s string) {
+	m.owner_id = &s
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *QuestQuestionMutation) Position() (r int, exists bool) {
-	v := m.position
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *QuestionReportEventMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldPosition(ctx context.Context) (v int, err error) {
+func (m *QuestionReportEventMutation) OldOwnerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.Position, nil
+	return oldValue.OwnerID, nil
 }
 
-// AddPosition adds i to the "position" field.
-func (m *QuestQuestionMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
-	} else {
-		m.addposition = &i
-	}
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *QuestionReportEventMutation) ResetOwnerID() {
+	m.owner_id = nil
 }
 
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *QuestQuestionMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
+// SetSessionID sets the "session_id" field.
+func (m *QuestionReportEventMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *QuestionReportEventMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *QuestQuestionMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// OldSessionID returns the old "session_id" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionReportEventMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
 }
 
-// SetText sets the "text" field.
-func (m *QuestQuestionMutation) SetText(s string) {
-	m.text = &s
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *QuestionReportEventMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *QuestQuestionMutation) Text() (r string, exists bool) {
-	v := m.text
+// SetSkillID sets the "skill_id" field.
+func (m *QuestionReportEventMutation) SetSkillID(s string) {
+	m.skill_id = &s
+}
+
+// SkillID returns the value of the "skill_id" field in the mutation.
+func (m *QuestionReportEventMutation) SkillID() (r string, exists bool) {
+	v := m.skill_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillID returns the old "skill_id" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionReportEventMutation) OldSkillID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillID: %w", err)
+	}
+	return oldValue.SkillID, nil
+}
+
+// ResetSkillID resets all changes to the "skill_id" field.
+func (m *QuestionReportEventMutation) ResetSkillID() {
+	m.skill_id = nil
+}
+
+// SetTier sets the "tier" field.
+func (m *QuestionReportEventMutation) SetTier(s string) {
+	m.tier = &s
+}
+
+// Tier returns the value of the "tier" field in the mutation.
+func (m *QuestionReportEventMutation) Tier() (r string, exists bool) {
+	v := m.tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTier returns the old "tier" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldTier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Tier, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *QuestQuestionMutation) ResetText() {
-	m.text = nil
+// ResetTier resets all changes to the "tier" field.
+func (m *QuestionReportEventMutation) ResetTier() {
+	m.tier = nil
 }
 
-// SetAnswer sets the "answer" field.
-func (m *QuestQuestionMutation) SetAnswer(s string) {
-	m.answer = &s
+// SetQuestionText sets the "question_text" field.
+func (m *QuestionReportEventMutation) SetQuestionText(s string) {
+	m.question_text = &s
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *QuestQuestionMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// QuestionText returns the value of the "question_text" field in the mutation.
+func (m *QuestionReportEventMutation) QuestionText() (r string, exists bool) {
+	v := m.question_text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionText returns the old "question_text" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldQuestionText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldQuestionText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
 	}
-	return oldValue.Answer, nil
+	return oldValue.QuestionText, nil
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *QuestQuestionMutation) ResetAnswer() {
-	m.answer = nil
+// ResetQuestionText resets all changes to the "question_text" field.
+func (m *QuestionReportEventMutation) ResetQuestionText() {
+	m.question_text = nil
 }
 
-// SetAnswerType sets the "answer_type" field.
-func (m *QuestQuestionMutation) SetAnswerType(s string) {
-	m.answer_type = &s
+// SetAnswer sets the "answer" field.
+func (m *QuestionReportEventMutation) SetAnswer(s string) {
+	m.answer = &s
 }
 
-// AnswerType returns the value of the "answer_type" field in the mutation.
-func (m *QuestQuestionMutation) AnswerType() (r string, exists bool) {
-	v := m.answer_type
+// Answer returns the value of the "answer" field in the mutation.
+func (m *QuestionReportEventMutation) Answer() (r string, exists bool) {
+	v := m.answer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswerType returns the old "answer_type" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswer returns the old "answer" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldAnswerType(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldAnswer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswerType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswerType requires an ID field in the mutation")
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswerType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
 	}
-	return oldValue.AnswerType, nil
+	return oldValue.Answer, nil
 }
 
-// ResetAnswerType resets all changes to the "answer_type" field.
-func (m *QuestQuestionMutation) ResetAnswerType() {
-	m.answer_type = nil
+// ResetAnswer resets all changes to the "answer" field.
+func (m *QuestionReportEventMutation) ResetAnswer() {
+	m.answer = nil
 }
 
 // SetFormat sets the "format" field.
-func (m *QuestQuestionMutation) SetFormat(s string) {
+func (m *QuestionReportEventMutation) SetFormat(s string) {
 	m.format = &s
 }
 
 // Format returns the value of the "format" field in the mutation.
-func (m *QuestQuestionMutation) Format() (r string, exists bool) {
+func (m *QuestionReportEventMutation) Format() (r string, exists bool) {
 	v := m.format
 	if v == nil {
 		return
@@ -15124,10 +18239,10 @@ func (m *QuestQuestionMutation) Format() (r string, exists bool) {
 	return *v, true
 }
 
-// OldFormat returns the old "format" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldFormat returns the old "format" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldFormat(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
@@ -15142,18 +18257,18 @@ func (m *QuestQuestionMutation) OldFormat(ctx context.Context) (v string, err er
 }
 
 // ResetFormat resets all changes to the "format" field.
-func (m *QuestQuestionMutation) ResetFormat() {
+func (m *QuestionReportEventMutation) ResetFormat() {
 	m.format = nil
 }
 
 // SetChoices sets the "choices" field.
-func (m *QuestQuestionMutation) SetChoices(s []string) {
+func (m *QuestionReportEventMutation) SetChoices(s []string) {
 	m.choices = &s
 	m.appendchoices = nil
 }
 
 // Choices returns the value of the "choices" field in the mutation.
-func (m *QuestQuestionMutation) Choices() (r []string, exists bool) {
+func (m *QuestionReportEventMutation) Choices() (r []string, exists bool) {
 	v := m.choices
 	if v == nil {
 		return
@@ -15161,10 +18276,10 @@ func (m *QuestQuestionMutation) Choices() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldChoices returns the old "choices" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldChoices returns the old "choices" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldChoices(ctx context.Context) (v []string, err error) {
+func (m *QuestionReportEventMutation) OldChoices(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChoices is only allowed on UpdateOne operations")
 	}
@@ -15179,12 +18294,12 @@ func (m *QuestQuestionMutation) OldChoices(ctx context.Context) (v []string, err
 }
 
 // AppendChoices adds s to the "choices" field.
-func (m *QuestQuestionMutation) AppendChoices(s []string) {
+func (m *QuestionReportEventMutation) AppendChoices(s []string) {
 	m.appendchoices = append(m.appendchoices, s...)
 }
 
 // AppendedChoices returns the list of values that were appended to the "choices" field in this mutation.
-func (m *QuestQuestionMutation) AppendedChoices() ([]string, bool) {
+func (m *QuestionReportEventMutation) AppendedChoices() ([]string, bool) {
 	if len(m.appendchoices) == 0 {
 		return nil, false
 	}
@@ -15192,178 +18307,270 @@ func (m *QuestQuestionMutation) AppendedChoices() ([]string, bool) {
 }
 
 // ClearChoices clears the value of the "choices" field.
-func (m *QuestQuestionMutation) ClearChoices() {
+func (m *QuestionReportEventMutation) ClearChoices() {
 	m.choices = nil
 	m.appendchoices = nil
-	m.clearedFields[questquestion.FieldChoices] = struct{}{}
+	m.clearedFields[questionreportevent.FieldChoices] = struct{}{}
 }
 
 // ChoicesCleared returns if the "choices" field was cleared in this mutation.
-func (m *QuestQuestionMutation) ChoicesCleared() bool {
-	_, ok := m.clearedFields[questquestion.FieldChoices]
+func (m *QuestionReportEventMutation) ChoicesCleared() bool {
+	_, ok := m.clearedFields[questionreportevent.FieldChoices]
 	return ok
 }
 
 // ResetChoices resets all changes to the "choices" field.
-func (m *QuestQuestionMutation) ResetChoices() {
+func (m *QuestionReportEventMutation) ResetChoices() {
 	m.choices = nil
 	m.appendchoices = nil
-	delete(m.clearedFields, questquestion.FieldChoices)
+	delete(m.clearedFields, questionreportevent.FieldChoices)
 }
 
-// SetHint sets the "hint" field.
-func (m *QuestQuestionMutation) SetHint(s string) {
-	m.hint = &s
+// SetSource sets the "source" field.
+func (m *QuestionReportEventMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Hint returns the value of the "hint" field in the mutation.
-func (m *QuestQuestionMutation) Hint() (r string, exists bool) {
-	v := m.hint
+// Source returns the value of the "source" field in the mutation.
+func (m *QuestionReportEventMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHint returns the old "hint" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldHint(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHint requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Hint, nil
+	return oldValue.Source, nil
 }
 
-// ResetHint resets all changes to the "hint" field.
-func (m *QuestQuestionMutation) ResetHint() {
-	m.hint = nil
+// ResetSource resets all changes to the "source" field.
+func (m *QuestionReportEventMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetExplanation sets the "explanation" field.
-func (m *QuestQuestionMutation) SetExplanation(s string) {
-	m.explanation = &s
+// SetLlmRequestID sets the "llm_request_id" field.
+func (m *QuestionReportEventMutation) SetLlmRequestID(i int) {
+	m.llm_request_id = &i
+	m.addllm_request_id = nil
 }
 
-// Explanation returns the value of the "explanation" field in the mutation.
-func (m *QuestQuestionMutation) Explanation() (r string, exists bool) {
-	v := m.explanation
+// LlmRequestID returns the value of the "llm_request_id" field in the mutation.
+func (m *QuestionReportEventMutation) LlmRequestID() (r int, exists bool) {
+	v := m.llm_request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExplanation returns the old "explanation" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldLlmRequestID returns the old "llm_request_id" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldExplanation(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldLlmRequestID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+		return v, errors.New("OldLlmRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExplanation requires an ID field in the mutation")
+		return v, errors.New("OldLlmRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+		return v, fmt.Errorf("querying old value for OldLlmRequestID: %w", err)
 	}
-	return oldValue.Explanation, nil
+	return oldValue.LlmRequestID, nil
 }
 
-// ResetExplanation resets all changes to the "explanation" field.
-func (m *QuestQuestionMutation) ResetExplanation() {
-	m.explanation = nil
+// AddLlmRequestID adds i to the "llm_request_id" field.
+func (m *QuestionReportEventMutation) AddLlmRequestID(i int) {
+	if m.addllm_request_id != nil {
+		*m.addllm_request_id += i
+	} else {
+		m.addllm_request_id = &i
+	}
 }
 
-// SetClientKey sets the "client_key" field.
-func (m *QuestQuestionMutation) SetClientKey(s string) {
-	m.client_key = &s
+// AddedLlmRequestID returns the value that was added to the "llm_request_id" field in this mutation.
+func (m *QuestionReportEventMutation) AddedLlmRequestID() (r int, exists bool) {
+	v := m.addllm_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClientKey returns the value of the "client_key" field in the mutation.
-func (m *QuestQuestionMutation) ClientKey() (r string, exists bool) {
-	v := m.client_key
+// ResetLlmRequestID resets all changes to the "llm_request_id" field.
+func (m *QuestionReportEventMutation) ResetLlmRequestID() {
+	m.llm_request_id = nil
+	m.addllm_request_id = nil
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (m *QuestionReportEventMutation) SetLlmOwner(s string) {
+	m.llm_owner = &s
+}
+
+// LlmOwner returns the value of the "llm_owner" field in the mutation.
+func (m *QuestionReportEventMutation) LlmOwner() (r string, exists bool) {
+	v := m.llm_owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientKey returns the old "client_key" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldLlmOwner returns the old "llm_owner" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldClientKey(ctx context.Context) (v string, err error) {
+func (m *QuestionReportEventMutation) OldLlmOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldLlmOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientKey requires an ID field in the mutation")
+		return v, errors.New("OldLlmOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldLlmOwner: %w", err)
 	}
-	return oldValue.ClientKey, nil
+	return oldValue.LlmOwner, nil
 }
 
-// ResetClientKey resets all changes to the "client_key" field.
-func (m *QuestQuestionMutation) ResetClientKey() {
-	m.client_key = nil
+// ResetLlmOwner resets all changes to the "llm_owner" field.
+func (m *QuestionReportEventMutation) ResetLlmOwner() {
+	m.llm_owner = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *QuestQuestionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLearnerAnswer sets the "learner_answer" field.
+func (m *QuestionReportEventMutation) SetLearnerAnswer(s string) {
+	m.learner_answer = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestQuestionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LearnerAnswer returns the value of the "learner_answer" field in the mutation.
+func (m *QuestionReportEventMutation) LearnerAnswer() (r string, exists bool) {
+	v := m.learner_answer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the QuestQuestion entity.
-// If the QuestQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldLearnerAnswer returns the old "learner_answer" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestionReportEventMutation) OldLearnerAnswer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLearnerAnswer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLearnerAnswer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLearnerAnswer: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LearnerAnswer, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestQuestionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetLearnerAnswer resets all changes to the "learner_answer" field.
+func (m *QuestionReportEventMutation) ResetLearnerAnswer() {
+	m.learner_answer = nil
 }
 
-// Where appends a list predicates to the QuestQuestionMutation builder.
-func (m *QuestQuestionMutation) Where(ps ...predicate.QuestQuestion) {
+// SetReporter sets the "reporter" field.
+func (m *QuestionReportEventMutation) SetReporter(s string) {
+	m.reporter = &s
+}
+
+// Reporter returns the value of the "reporter" field in the mutation.
+func (m *QuestionReportEventMutation) Reporter() (r string, exists bool) {
+	v := m.reporter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReporter returns the old "reporter" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionReportEventMutation) OldReporter(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReporter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReporter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReporter: %w", err)
+	}
+	return oldValue.Reporter, nil
+}
+
+// ResetReporter resets all changes to the "reporter" field.
+func (m *QuestionReportEventMutation) ResetReporter() {
+	m.reporter = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *QuestionReportEventMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *QuestionReportEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the QuestionReportEvent entity.
+// If the QuestionReportEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionReportEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *QuestionReportEventMutation) ResetReason() {
+	m.reason = nil
+}
+
+// Where appends a list predicates to the QuestionReportEventMutation builder.
+func (m *QuestionReportEventMutation) Where(ps ...predicate.QuestionReportEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestQuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the QuestionReportEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QuestQuestion, len(ps))
+func (m *QuestionReportEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestionReportEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -15371,60 +18578,72 @@ func (m *QuestQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestQuestionMutation) Op() Op {
+func (m *QuestionReportEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestQuestionMutation) SetOp(op Op) {
+func (m *QuestionReportEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QuestQuestion).
-func (m *QuestQuestionMutation) Type() string {
+// Type returns the node type of this mutation (QuestionReportEvent).
+func (m *QuestionReportEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestQuestionMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.uid != nil {
-		fields = append(fields, questquestion.FieldUID)
+func (m *QuestionReportEventMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.sequence != nil {
+		fields = append(fields, questionreportevent.FieldSequence)
 	}
-	if m.quest_uid != nil {
-		fields = append(fields, questquestion.FieldQuestUID)
+	if m.timestamp != nil {
+		fields = append(fields, questionreportevent.FieldTimestamp)
 	}
-	if m.position != nil {
-		fields = append(fields, questquestion.FieldPosition)
+	if m.owner_id != nil {
+		fields = append(fields, questionreportevent.FieldOwnerID)
+	}
+	if m.session_id != nil {
+		fields = append(fields, questionreportevent.FieldSessionID)
+	}
+	if m.skill_id != nil {
+		fields = append(fields, questionreportevent.FieldSkillID)
+	}
+	if m.tier != nil {
+		fields = append(fields, questionreportevent.FieldTier)
 	}
-	if m.text != nil {
-		fields = append(fields, questquestion.FieldText)
+	if m.question_text != nil {
+		fields = append(fields, questionreportevent.FieldQuestionText)
 	}
 	if m.answer != nil {
-		fields = append(fields, questquestion.FieldAnswer)
-	}
-	if m.answer_type != nil {
-		fields = append(fields, questquestion.FieldAnswerType)
+		fields = append(fields, questionreportevent.FieldAnswer)
 	}
 	if m.format != nil {
-		fields = append(fields, questquestion.FieldFormat)
+		fields = append(fields, questionreportevent.FieldFormat)
 	}
 	if m.choices != nil {
-		fields = append(fields, questquestion.FieldChoices)
+		fields = append(fields, questionreportevent.FieldChoices)
 	}
-	if m.hint != nil {
-		fields = append(fields, questquestion.FieldHint)
+	if m.source != nil {
+		fields = append(fields, questionreportevent.FieldSource)
 	}
-	if m.explanation != nil {
-		fields = append(fields, questquestion.FieldExplanation)
+	if m.llm_request_id != nil {
+		fields = append(fields, questionreportevent.FieldLlmRequestID)
 	}
-	if m.client_key != nil {
-		fields = append(fields, questquestion.FieldClientKey)
+	if m.llm_owner != nil {
+		fields = append(fields, questionreportevent.FieldLlmOwner)
 	}
-	if m.created_at != nil {
-		fields = append(fields, questquestion.FieldCreatedAt)
+	if m.learner_answer != nil {
+		fields = append(fields, questionreportevent.FieldLearnerAnswer)
+	}
+	if m.reporter != nil {
+		fields = append(fields, questionreportevent.FieldReporter)
+	}
+	if m.reason != nil {
+		fields = append(fields, questionreportevent.FieldReason)
 	}
 	return fields
 }
@@ -15432,32 +18651,40 @@ func (m *QuestQuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestQuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *QuestionReportEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case questquestion.FieldUID:
-		return m.UID()
-	case questquestion.FieldQuestUID:
-		return m.QuestUID()
-	case questquestion.FieldPosition:
-		return m.Position()
-	case questquestion.FieldText:
-		return m.Text()
-	case questquestion.FieldAnswer:
+	case questionreportevent.FieldSequence:
+		return m.Sequence()
+	case questionreportevent.FieldTimestamp:
+		return m.Timestamp()
+	case questionreportevent.FieldOwnerID:
+		return m.OwnerID()
+	case questionreportevent.FieldSessionID:
+		return m.SessionID()
+	case questionreportevent.FieldSkillID:
+		return m.SkillID()
+	case questionreportevent.FieldTier:
+		return m.Tier()
+	case questionreportevent.FieldQuestionText:
+		return m.QuestionText()
+	case questionreportevent.FieldAnswer:
 		return m.Answer()
-	case questquestion.FieldAnswerType:
-		return m.AnswerType()
-	case questquestion.FieldFormat:
+	case questionreportevent.FieldFormat:
 		return m.Format()
-	case questquestion.FieldChoices:
+	case questionreportevent.FieldChoices:
 		return m.Choices()
-	case questquestion.FieldHint:
-		return m.Hint()
-	case questquestion.FieldExplanation:
-		return m.Explanation()
-	case questquestion.FieldClientKey:
-		return m.ClientKey()
-	case questquestion.FieldCreatedAt:
-		return m.CreatedAt()
+	case questionreportevent.FieldSource:
+		return m.Source()
+	case questionreportevent.FieldLlmRequestID:
+		return m.LlmRequestID()
+	case questionreportevent.FieldLlmOwner:
+		return m.LlmOwner()
+	case questionreportevent.FieldLearnerAnswer:
+		return m.LearnerAnswer()
+	case questionreportevent.FieldReporter:
+		return m.Reporter()
+	case questionreportevent.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -15465,135 +18692,174 @@ func (m *QuestQuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuestionReportEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case questquestion.FieldUID:
-		return m.OldUID(ctx)
-	case questquestion.FieldQuestUID:
-		return m.OldQuestUID(ctx)
-	case questquestion.FieldPosition:
-		return m.OldPosition(ctx)
-	case questquestion.FieldText:
-		return m.OldText(ctx)
-	case questquestion.FieldAnswer:
+	case questionreportevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case questionreportevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case questionreportevent.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case questionreportevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case questionreportevent.FieldSkillID:
+		return m.OldSkillID(ctx)
+	case questionreportevent.FieldTier:
+		return m.OldTier(ctx)
+	case questionreportevent.FieldQuestionText:
+		return m.OldQuestionText(ctx)
+	case questionreportevent.FieldAnswer:
 		return m.OldAnswer(ctx)
-	case questquestion.FieldAnswerType:
-		return m.OldAnswerType(ctx)
-	case questquestion.FieldFormat:
+	case questionreportevent.FieldFormat:
 		return m.OldFormat(ctx)
-	case questquestion.FieldChoices:
+	case questionreportevent.FieldChoices:
 		return m.OldChoices(ctx)
-	case questquestion.FieldHint:
-		return m.OldHint(ctx)
-	case questquestion.FieldExplanation:
-		return m.OldExplanation(ctx)
-	case questquestion.FieldClientKey:
-		return m.OldClientKey(ctx)
-	case questquestion.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case questionreportevent.FieldSource:
+		return m.OldSource(ctx)
+	case questionreportevent.FieldLlmRequestID:
+		return m.OldLlmRequestID(ctx)
+	case questionreportevent.FieldLlmOwner:
+		return m.OldLlmOwner(ctx)
+	case questionreportevent.FieldLearnerAnswer:
+		return m.OldLearnerAnswer(ctx)
+	case questionreportevent.FieldReporter:
+		return m.OldReporter(ctx)
+	case questionreportevent.FieldReason:
+		return m.OldReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown QuestQuestion field %s", name)
+	return nil, fmt.Errorf("unknown QuestionReportEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestQuestionMutation) SetField(name string, value ent.Value) error {
+func (m *QuestionReportEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case questquestion.FieldUID:
+	case questionreportevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case questionreportevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case questionreportevent.FieldOwnerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUID(v)
+		m.SetOwnerID(v)
 		return nil
-	case questquestion.FieldQuestUID:
+	case questionreportevent.FieldSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestUID(v)
+		m.SetSessionID(v)
 		return nil
-	case questquestion.FieldPosition:
-		v, ok := value.(int)
+	case questionreportevent.FieldSkillID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetSkillID(v)
 		return nil
-	case questquestion.FieldText:
+	case questionreportevent.FieldTier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetTier(v)
 		return nil
-	case questquestion.FieldAnswer:
+	case questionreportevent.FieldQuestionText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswer(v)
+		m.SetQuestionText(v)
 		return nil
-	case questquestion.FieldAnswerType:
+	case questionreportevent.FieldAnswer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswerType(v)
+		m.SetAnswer(v)
 		return nil
-	case questquestion.FieldFormat:
+	case questionreportevent.FieldFormat:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFormat(v)
 		return nil
-	case questquestion.FieldChoices:
+	case questionreportevent.FieldChoices:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChoices(v)
 		return nil
-	case questquestion.FieldHint:
+	case questionreportevent.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHint(v)
+		m.SetSource(v)
 		return nil
-	case questquestion.FieldExplanation:
+	case questionreportevent.FieldLlmRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmRequestID(v)
+		return nil
+	case questionreportevent.FieldLlmOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExplanation(v)
+		m.SetLlmOwner(v)
 		return nil
-	case questquestion.FieldClientKey:
+	case questionreportevent.FieldLearnerAnswer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientKey(v)
+		m.SetLearnerAnswer(v)
 		return nil
-	case questquestion.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case questionreportevent.FieldReporter:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetReporter(v)
+		return nil
+	case questionreportevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuestQuestion field %s", name)
+	return fmt.Errorf("unknown QuestionReportEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestQuestionMutation) AddedFields() []string {
+func (m *QuestionReportEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addposition != nil {
-		fields = append(fields, questquestion.FieldPosition)
+	if m.addsequence != nil {
+		fields = append(fields, questionreportevent.FieldSequence)
+	}
+	if m.addllm_request_id != nil {
+		fields = append(fields, questionreportevent.FieldLlmRequestID)
 	}
 	return fields
 }
@@ -15601,10 +18867,12 @@ func (m *QuestQuestionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestQuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuestionReportEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case questquestion.FieldPosition:
-		return m.AddedPosition()
+	case questionreportevent.FieldSequence:
+		return m.AddedSequence()
+	case questionreportevent.FieldLlmRequestID:
+		return m.AddedLlmRequestID()
 	}
 	return nil, false
 }
@@ -15612,137 +18880,156 @@ func (m *QuestQuestionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestQuestionMutation) AddField(name string, value ent.Value) error {
+func (m *QuestionReportEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case questquestion.FieldPosition:
+	case questionreportevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	case questionreportevent.FieldLlmRequestID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPosition(v)
+		m.AddLlmRequestID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuestQuestion numeric field %s", name)
+	return fmt.Errorf("unknown QuestionReportEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestQuestionMutation) ClearedFields() []string {
+func (m *QuestionReportEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(questquestion.FieldChoices) {
-		fields = append(fields, questquestion.FieldChoices)
+	if m.FieldCleared(questionreportevent.FieldChoices) {
+		fields = append(fields, questionreportevent.FieldChoices)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestQuestionMutation) FieldCleared(name string) bool {
+func (m *QuestionReportEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestQuestionMutation) ClearField(name string) error {
+func (m *QuestionReportEventMutation) ClearField(name string) error {
 	switch name {
-	case questquestion.FieldChoices:
+	case questionreportevent.FieldChoices:
 		m.ClearChoices()
 		return nil
 	}
-	return fmt.Errorf("unknown QuestQuestion nullable field %s", name)
+	return fmt.Errorf("unknown QuestionReportEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestQuestionMutation) ResetField(name string) error {
+func (m *QuestionReportEventMutation) ResetField(name string) error {
 	switch name {
-	case questquestion.FieldUID:
-		m.ResetUID()
+	case questionreportevent.FieldSequence:
+		m.ResetSequence()
 		return nil
-	case questquestion.FieldQuestUID:
-		m.ResetQuestUID()
+	case questionreportevent.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
-	case questquestion.FieldPosition:
-		m.ResetPosition()
+	case questionreportevent.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
-	case questquestion.FieldText:
-		m.ResetText()
+	case questionreportevent.FieldSessionID:
+		m.ResetSessionID()
 		return nil
-	case questquestion.FieldAnswer:
-		m.ResetAnswer()
+	case questionreportevent.FieldSkillID:
+		m.ResetSkillID()
 		return nil
-	case questquestion.FieldAnswerType:
-		m.ResetAnswerType()
+	case questionreportevent.FieldTier:
+		m.ResetTier()
 		return nil
-	case questquestion.FieldFormat:
+	case questionreportevent.FieldQuestionText:
+		m.ResetQuestionText()
+		return nil
+	case questionreportevent.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case questionreportevent.FieldFormat:
 		m.ResetFormat()
 		return nil
-	case questquestion.FieldChoices:
+	case questionreportevent.FieldChoices:
 		m.ResetChoices()
 		return nil
-	case questquestion.FieldHint:
-		m.ResetHint()
+	case questionreportevent.FieldSource:
+		m.ResetSource()
 		return nil
-	case questquestion.FieldExplanation:
-		m.ResetExplanation()
+	case questionreportevent.FieldLlmRequestID:
+		m.ResetLlmRequestID()
 		return nil
-	case questquestion.FieldClientKey:
-		m.ResetClientKey()
+	case questionreportevent.FieldLlmOwner:
+		m.ResetLlmOwner()
 		return nil
-	case questquestion.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case questionreportevent.FieldLearnerAnswer:
+		m.ResetLearnerAnswer()
+		return nil
+	case questionreportevent.FieldReporter:
+		m.ResetReporter()
+		return nil
+	case questionreportevent.FieldReason:
+		m.ResetReason()
 		return nil
 	}
-	return fmt.Errorf("unknown QuestQuestion field %s", name)
+	return fmt.Errorf("unknown QuestionReportEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestQuestionMutation) AddedEdges() []string {
+func (m *QuestionReportEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestQuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *QuestionReportEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestQuestionMutation) RemovedEdges() []string {
+func (m *QuestionReportEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestQuestionMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuestionReportEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestQuestionMutation) ClearedEdges() []string {
+func (m *QuestionReportEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestQuestionMutation) EdgeCleared(name string) bool {
+func (m *QuestionReportEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestQuestionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown QuestQuestion unique edge %s", name)
+func (m *QuestionReportEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QuestionReportEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestQuestionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown QuestQuestion edge %s", name)
+func (m *QuestionReportEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QuestionReportEvent edge %s", name)
 }
 
 // SessionEventMutation represents an operation that mutates the SessionEvent nodes in the graph.
@@ -15765,6 +19052,7 @@ type SessionEventMutation struct {
 	addduration_secs    *int
 	plan_summary        *[]schema.PlanSlotSummary
 	appendplan_summary  []schema.PlanSlotSummary
+	question_sources    *map[string]int
 	quest_uid           *string
 	quest_name          *string
 	clearedFields       map[string]struct{}
@@ -16304,6 +19592,55 @@ func (m *SessionEventMutation) ResetPlanSummary() {
 	delete(m.clearedFields, sessionevent.FieldPlanSummary)
 }
 
+// SetQuestionSources sets the "question_sources" field.
+func (m *SessionEventMutation) SetQuestionSources(value map[string]int) {
+	m.question_sources = &value
+}
+
+// QuestionSources returns the value of the "question_sources" field in the mutation.
+func (m *SessionEventMutation) QuestionSources() (r map[string]int, exists bool) {
+	v := m.question_sources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionSources returns the old "question_sources" field's value of the SessionEvent entity.
+// If the SessionEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionEventMutation) OldQuestionSources(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionSources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionSources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionSources: %w", err)
+	}
+	return oldValue.QuestionSources, nil
+}
+
+// ClearQuestionSources clears the value of the "question_sources" field.
+func (m *SessionEventMutation) ClearQuestionSources() {
+	m.question_sources = nil
+	m.clearedFields[sessionevent.FieldQuestionSources] = struct{}{}
+}
+
+// QuestionSourcesCleared returns if the "question_sources" field was cleared in this mutation.
+func (m *SessionEventMutation) QuestionSourcesCleared() bool {
+	_, ok := m.clearedFields[sessionevent.FieldQuestionSources]
+	return ok
+}
+
+// ResetQuestionSources resets all changes to the "question_sources" field.
+func (m *SessionEventMutation) ResetQuestionSources() {
+	m.question_sources = nil
+	delete(m.clearedFields, sessionevent.FieldQuestionSources)
+}
+
 // SetQuestUID sets the "quest_uid" field.
 func (m *SessionEventMutation) SetQuestUID(s string) {
 	m.quest_uid = &s
@@ -16436,7 +19773,7 @@ func (m *SessionEventMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SessionEventMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.sequence != nil {
 		fields = append(fields, sessionevent.FieldSequence)
 	}
@@ -16464,6 +19801,9 @@ func (m *SessionEventMutation) Fields() []string {
 	if m.plan_summary != nil {
 		fields = append(fields, sessionevent.FieldPlanSummary)
 	}
+	if m.question_sources != nil {
+		fields = append(fields, sessionevent.FieldQuestionSources)
+	}
 	if m.quest_uid != nil {
 		fields = append(fields, sessionevent.FieldQuestUID)
 	}
@@ -16496,6 +19836,8 @@ func (m *SessionEventMutation) Field(name string) (ent.Value, bool) {
 		return m.DurationSecs()
 	case sessionevent.FieldPlanSummary:
 		return m.PlanSummary()
+	case sessionevent.FieldQuestionSources:
+		return m.QuestionSources()
 	case sessionevent.FieldQuestUID:
 		return m.QuestUID()
 	case sessionevent.FieldQuestName:
@@ -16527,6 +19869,8 @@ func (m *SessionEventMutation) OldField(ctx context.Context, name string) (ent.V
 		return m.OldDurationSecs(ctx)
 	case sessionevent.FieldPlanSummary:
 		return m.OldPlanSummary(ctx)
+	case sessionevent.FieldQuestionSources:
+		return m.OldQuestionSources(ctx)
 	case sessionevent.FieldQuestUID:
 		return m.OldQuestUID(ctx)
 	case sessionevent.FieldQuestName:
@@ -16603,6 +19947,13 @@ func (m *SessionEventMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPlanSummary(v)
 		return nil
+	case sessionevent.FieldQuestionSources:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionSources(v)
+		return nil
 	case sessionevent.FieldQuestUID:
 		v, ok := value.(string)
 		if !ok {
@@ -16701,6 +20052,9 @@ func (m *SessionEventMutation) ClearedFields() []string {
 	if m.FieldCleared(sessionevent.FieldPlanSummary) {
 		fields = append(fields, sessionevent.FieldPlanSummary)
 	}
+	if m.FieldCleared(sessionevent.FieldQuestionSources) {
+		fields = append(fields, sessionevent.FieldQuestionSources)
+	}
 	if m.FieldCleared(sessionevent.FieldQuestUID) {
 		fields = append(fields, sessionevent.FieldQuestUID)
 	}
@@ -16724,6 +20078,9 @@ func (m *SessionEventMutation) ClearField(name string) error {
 	case sessionevent.FieldPlanSummary:
 		m.ClearPlanSummary()
 		return nil
+	case sessionevent.FieldQuestionSources:
+		m.ClearQuestionSources()
+		return nil
 	case sessionevent.FieldQuestUID:
 		m.ClearQuestUID()
 		return nil
@@ -16765,6 +20122,9 @@ func (m *SessionEventMutation) ResetField(name string) error {
 	case sessionevent.FieldPlanSummary:
 		m.ResetPlanSummary()
 		return nil
+	case sessionevent.FieldQuestionSources:
+		m.ResetQuestionSources()
+		return nil
 	case sessionevent.FieldQuestUID:
 		m.ResetQuestUID()
 		return nil
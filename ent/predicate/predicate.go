@@ -12,6 +12,9 @@ type Account func(*sql.Selector)
 // AnswerEvent is the predicate function for answerevent builders.
 type AnswerEvent func(*sql.Selector)
 
+// BankQuestion is the predicate function for bankquestion builders.
+type BankQuestion func(*sql.Selector)
+
 // BillingState is the predicate function for billingstate builders.
 type BillingState func(*sql.Selector)
 
@@ -66,6 +69,9 @@ type QuestProgress func(*sql.Selector)
 // QuestQuestion is the predicate function for questquestion builders.
 type QuestQuestion func(*sql.Selector)
 
+// QuestionReportEvent is the predicate function for questionreportevent builders.
+type QuestionReportEvent func(*sql.Selector)
+
 // SessionEvent is the predicate function for sessionevent builders.
 type SessionEvent func(*sql.Selector)
 
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+// QuestionReportEvent is the model entity for the QuestionReportEvent schema.
+type QuestionReportEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Monotonically increasing global sequence number
+	Sequence int64 `json:"sequence,omitempty"`
+	// UTC wall-clock time of the event
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Owning learner (child profile ID in SaaS mode, empty for local single-user)
+	OwnerID string `json:"owner_id,omitempty"`
+	// Links to SessionEvent
+	SessionID string `json:"session_id,omitempty"`
+	// Skill the question was for
+	SkillID string `json:"skill_id,omitempty"`
+	// learn, prove or challenge
+	Tier string `json:"tier,omitempty"`
+	// The question shown
+	QuestionText string `json:"question_text,omitempty"`
+	// The question's answer key
+	Answer string `json:"answer,omitempty"`
+	// Answer format, e.g. numeric or multiple_choice
+	Format string `json:"format,omitempty"`
+	// Options shown for choice formats
+	Choices []string `json:"choices,omitempty"`
+	// Generator that produced the question: llm, offline, bank
+	Source string `json:"source,omitempty"`
+	// LLMRequestEvent that generated the question, 0 if none
+	LlmRequestID int `json:"llm_request_id,omitempty"`
+	// Learner whose LLM log holds llm_request_id; empty for the reporter
+	LlmOwner string `json:"llm_owner,omitempty"`
+	// What the learner entered, empty if unanswered
+	LearnerAnswer string `json:"learner_answer,omitempty"`
+	// learner or parent
+	Reporter string `json:"reporter,omitempty"`
+	// Free-text reason, may be empty
+	Reason       string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionReportEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionreportevent.FieldChoices:
+			values[i] = new([]byte)
+		case questionreportevent.FieldID, questionreportevent.FieldSequence, questionreportevent.FieldLlmRequestID:
+			values[i] = new(sql.NullInt64)
+		case questionreportevent.FieldOwnerID, questionreportevent.FieldSessionID, questionreportevent.FieldSkillID, questionreportevent.FieldTier, questionreportevent.FieldQuestionText, questionreportevent.FieldAnswer, questionreportevent.FieldFormat, questionreportevent.FieldSource, questionreportevent.FieldLlmOwner, questionreportevent.FieldLearnerAnswer, questionreportevent.FieldReporter, questionreportevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case questionreportevent.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionReportEvent fields.
+func (_m *QuestionReportEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionreportevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case questionreportevent.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = value.Int64
+			}
+		case questionreportevent.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		case questionreportevent.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case questionreportevent.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case questionreportevent.FieldSkillID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skill_id", values[i])
+			} else if value.Valid {
+				_m.SkillID = value.String
+			}
+		case questionreportevent.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				_m.Tier = value.String
+			}
+		case questionreportevent.FieldQuestionText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_text", values[i])
+			} else if value.Valid {
+				_m.QuestionText = value.String
+			}
+		case questionreportevent.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case questionreportevent.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case questionreportevent.FieldChoices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Choices); err != nil {
+					return fmt.Errorf("unmarshal field choices: %w", err)
+				}
+			}
+		case questionreportevent.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case questionreportevent.FieldLlmRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_request_id", values[i])
+			} else if value.Valid {
+				_m.LlmRequestID = int(value.Int64)
+			}
+		case questionreportevent.FieldLlmOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_owner", values[i])
+			} else if value.Valid {
+				_m.LlmOwner = value.String
+			}
+		case questionreportevent.FieldLearnerAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field learner_answer", values[i])
+			} else if value.Valid {
+				_m.LearnerAnswer = value.String
+			}
+		case questionreportevent.FieldReporter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reporter", values[i])
+			} else if value.Valid {
+				_m.Reporter = value.String
+			}
+		case questionreportevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionReportEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuestionReportEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QuestionReportEvent.
+// Note that you need to call QuestionReportEvent.Unwrap() before calling this method if this QuestionReportEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuestionReportEvent) Update() *QuestionReportEventUpdateOne {
+	return NewQuestionReportEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuestionReportEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuestionReportEvent) Unwrap() *QuestionReportEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionReportEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuestionReportEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionReportEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("skill_id=")
+	builder.WriteString(_m.SkillID)
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(_m.Tier)
+	builder.WriteString(", ")
+	builder.WriteString("question_text=")
+	builder.WriteString(_m.QuestionText)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Choices))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("llm_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("llm_owner=")
+	builder.WriteString(_m.LlmOwner)
+	builder.WriteString(", ")
+	builder.WriteString("learner_answer=")
+	builder.WriteString(_m.LearnerAnswer)
+	builder.WriteString(", ")
+	builder.WriteString("reporter=")
+	builder.WriteString(_m.Reporter)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionReportEvents is a parsable slice of QuestionReportEvent.
+type QuestionReportEvents []*QuestionReportEvent
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionreportevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the questionreportevent type in the database.
+	Label = "question_report_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSequence holds the string denoting the sequence field in the database.
+	FieldSequence = "sequence"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldSkillID holds the string denoting the skill_id field in the database.
+	FieldSkillID = "skill_id"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldQuestionText holds the string denoting the question_text field in the database.
+	FieldQuestionText = "question_text"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldChoices holds the string denoting the choices field in the database.
+	FieldChoices = "choices"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldLlmRequestID holds the string denoting the llm_request_id field in the database.
+	FieldLlmRequestID = "llm_request_id"
+	// FieldLlmOwner holds the string denoting the llm_owner field in the database.
+	FieldLlmOwner = "llm_owner"
+	// FieldLearnerAnswer holds the string denoting the learner_answer field in the database.
+	FieldLearnerAnswer = "learner_answer"
+	// FieldReporter holds the string denoting the reporter field in the database.
+	FieldReporter = "reporter"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the questionreportevent in the database.
+	Table = "question_report_events"
+)
+
+// Columns holds all SQL columns for questionreportevent fields.
+var Columns = []string{
+	FieldID,
+	FieldSequence,
+	FieldTimestamp,
+	FieldOwnerID,
+	FieldSessionID,
+	FieldSkillID,
+	FieldTier,
+	FieldQuestionText,
+	FieldAnswer,
+	FieldFormat,
+	FieldChoices,
+	FieldSource,
+	FieldLlmRequestID,
+	FieldLlmOwner,
+	FieldLearnerAnswer,
+	FieldReporter,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// DefaultOwnerID holds the default value on creation for the "owner_id" field.
+	DefaultOwnerID string
+	// SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	SessionIDValidator func(string) error
+	// SkillIDValidator is a validator for the "skill_id" field. It is called by the builders before save.
+	SkillIDValidator func(string) error
+	// DefaultTier holds the default value on creation for the "tier" field.
+	DefaultTier string
+	// QuestionTextValidator is a validator for the "question_text" field. It is called by the builders before save.
+	QuestionTextValidator func(string) error
+	// DefaultAnswer holds the default value on creation for the "answer" field.
+	DefaultAnswer string
+	// DefaultFormat holds the default value on creation for the "format" field.
+	DefaultFormat string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultLlmRequestID holds the default value on creation for the "llm_request_id" field.
+	DefaultLlmRequestID int
+	// DefaultLlmOwner holds the default value on creation for the "llm_owner" field.
+	DefaultLlmOwner string
+	// DefaultLearnerAnswer holds the default value on creation for the "learner_answer" field.
+	DefaultLearnerAnswer string
+	// ReporterValidator is a validator for the "reporter" field. It is called by the builders before save.
+	ReporterValidator func(string) error
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+)
+
+// OrderOption defines the ordering options for the QuestionReportEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySequence orders the results by the sequence field.
+func BySequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequence, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// BySkillID orders the results by the skill_id field.
+func BySkillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkillID, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByQuestionText orders the results by the question_text field.
+func ByQuestionText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionText, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByLlmRequestID orders the results by the llm_request_id field.
+func ByLlmRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmRequestID, opts...).ToFunc()
+}
+
+// ByLlmOwner orders the results by the llm_owner field.
+func ByLlmOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmOwner, opts...).ToFunc()
+}
+
+// ByLearnerAnswer orders the results by the learner_answer field.
+func ByLearnerAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLearnerAnswer, opts...).ToFunc()
+}
+
+// ByReporter orders the results by the reporter field.
+func ByReporter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReporter, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
@@ -0,0 +1,1055 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionreportevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/abhisek/mathiz/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSequence, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SkillID applies equality check predicate on the "skill_id" field. It's identical to SkillIDEQ.
+func SkillID(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSkillID, v))
+}
+
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldTier, v))
+}
+
+// QuestionText applies equality check predicate on the "question_text" field. It's identical to QuestionTextEQ.
+func QuestionText(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldFormat, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// LlmRequestID applies equality check predicate on the "llm_request_id" field. It's identical to LlmRequestIDEQ.
+func LlmRequestID(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmOwner applies equality check predicate on the "llm_owner" field. It's identical to LlmOwnerEQ.
+func LlmOwner(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLlmOwner, v))
+}
+
+// LearnerAnswer applies equality check predicate on the "learner_answer" field. It's identical to LearnerAnswerEQ.
+func LearnerAnswer(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLearnerAnswer, v))
+}
+
+// Reporter applies equality check predicate on the "reporter" field. It's identical to ReporterEQ.
+func Reporter(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldReporter, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int64) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldSequence, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// SkillIDEQ applies the EQ predicate on the "skill_id" field.
+func SkillIDEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSkillID, v))
+}
+
+// SkillIDNEQ applies the NEQ predicate on the "skill_id" field.
+func SkillIDNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldSkillID, v))
+}
+
+// SkillIDIn applies the In predicate on the "skill_id" field.
+func SkillIDIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldSkillID, vs...))
+}
+
+// SkillIDNotIn applies the NotIn predicate on the "skill_id" field.
+func SkillIDNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldSkillID, vs...))
+}
+
+// SkillIDGT applies the GT predicate on the "skill_id" field.
+func SkillIDGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldSkillID, v))
+}
+
+// SkillIDGTE applies the GTE predicate on the "skill_id" field.
+func SkillIDGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldSkillID, v))
+}
+
+// SkillIDLT applies the LT predicate on the "skill_id" field.
+func SkillIDLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldSkillID, v))
+}
+
+// SkillIDLTE applies the LTE predicate on the "skill_id" field.
+func SkillIDLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldSkillID, v))
+}
+
+// SkillIDContains applies the Contains predicate on the "skill_id" field.
+func SkillIDContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldSkillID, v))
+}
+
+// SkillIDHasPrefix applies the HasPrefix predicate on the "skill_id" field.
+func SkillIDHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldSkillID, v))
+}
+
+// SkillIDHasSuffix applies the HasSuffix predicate on the "skill_id" field.
+func SkillIDHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldSkillID, v))
+}
+
+// SkillIDEqualFold applies the EqualFold predicate on the "skill_id" field.
+func SkillIDEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldSkillID, v))
+}
+
+// SkillIDContainsFold applies the ContainsFold predicate on the "skill_id" field.
+func SkillIDContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldSkillID, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldTier, v))
+}
+
+// QuestionTextEQ applies the EQ predicate on the "question_text" field.
+func QuestionTextEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// QuestionTextNEQ applies the NEQ predicate on the "question_text" field.
+func QuestionTextNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldQuestionText, v))
+}
+
+// QuestionTextIn applies the In predicate on the "question_text" field.
+func QuestionTextIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextNotIn applies the NotIn predicate on the "question_text" field.
+func QuestionTextNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextGT applies the GT predicate on the "question_text" field.
+func QuestionTextGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldQuestionText, v))
+}
+
+// QuestionTextGTE applies the GTE predicate on the "question_text" field.
+func QuestionTextGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldQuestionText, v))
+}
+
+// QuestionTextLT applies the LT predicate on the "question_text" field.
+func QuestionTextLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldQuestionText, v))
+}
+
+// QuestionTextLTE applies the LTE predicate on the "question_text" field.
+func QuestionTextLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldQuestionText, v))
+}
+
+// QuestionTextContains applies the Contains predicate on the "question_text" field.
+func QuestionTextContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldQuestionText, v))
+}
+
+// QuestionTextHasPrefix applies the HasPrefix predicate on the "question_text" field.
+func QuestionTextHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldQuestionText, v))
+}
+
+// QuestionTextHasSuffix applies the HasSuffix predicate on the "question_text" field.
+func QuestionTextHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldQuestionText, v))
+}
+
+// QuestionTextEqualFold applies the EqualFold predicate on the "question_text" field.
+func QuestionTextEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldQuestionText, v))
+}
+
+// QuestionTextContainsFold applies the ContainsFold predicate on the "question_text" field.
+func QuestionTextContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldQuestionText, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// ChoicesIsNil applies the IsNil predicate on the "choices" field.
+func ChoicesIsNil() predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIsNull(FieldChoices))
+}
+
+// ChoicesNotNil applies the NotNil predicate on the "choices" field.
+func ChoicesNotNil() predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotNull(FieldChoices))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldSource, v))
+}
+
+// LlmRequestIDEQ applies the EQ predicate on the "llm_request_id" field.
+func LlmRequestIDEQ(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDNEQ applies the NEQ predicate on the "llm_request_id" field.
+func LlmRequestIDNEQ(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDIn applies the In predicate on the "llm_request_id" field.
+func LlmRequestIDIn(vs ...int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDNotIn applies the NotIn predicate on the "llm_request_id" field.
+func LlmRequestIDNotIn(vs ...int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldLlmRequestID, vs...))
+}
+
+// LlmRequestIDGT applies the GT predicate on the "llm_request_id" field.
+func LlmRequestIDGT(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDGTE applies the GTE predicate on the "llm_request_id" field.
+func LlmRequestIDGTE(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLT applies the LT predicate on the "llm_request_id" field.
+func LlmRequestIDLT(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldLlmRequestID, v))
+}
+
+// LlmRequestIDLTE applies the LTE predicate on the "llm_request_id" field.
+func LlmRequestIDLTE(v int) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldLlmRequestID, v))
+}
+
+// LlmOwnerEQ applies the EQ predicate on the "llm_owner" field.
+func LlmOwnerEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerNEQ applies the NEQ predicate on the "llm_owner" field.
+func LlmOwnerNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldLlmOwner, v))
+}
+
+// LlmOwnerIn applies the In predicate on the "llm_owner" field.
+func LlmOwnerIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerNotIn applies the NotIn predicate on the "llm_owner" field.
+func LlmOwnerNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldLlmOwner, vs...))
+}
+
+// LlmOwnerGT applies the GT predicate on the "llm_owner" field.
+func LlmOwnerGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldLlmOwner, v))
+}
+
+// LlmOwnerGTE applies the GTE predicate on the "llm_owner" field.
+func LlmOwnerGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerLT applies the LT predicate on the "llm_owner" field.
+func LlmOwnerLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldLlmOwner, v))
+}
+
+// LlmOwnerLTE applies the LTE predicate on the "llm_owner" field.
+func LlmOwnerLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldLlmOwner, v))
+}
+
+// LlmOwnerContains applies the Contains predicate on the "llm_owner" field.
+func LlmOwnerContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasPrefix applies the HasPrefix predicate on the "llm_owner" field.
+func LlmOwnerHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldLlmOwner, v))
+}
+
+// LlmOwnerHasSuffix applies the HasSuffix predicate on the "llm_owner" field.
+func LlmOwnerHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldLlmOwner, v))
+}
+
+// LlmOwnerEqualFold applies the EqualFold predicate on the "llm_owner" field.
+func LlmOwnerEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldLlmOwner, v))
+}
+
+// LlmOwnerContainsFold applies the ContainsFold predicate on the "llm_owner" field.
+func LlmOwnerContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldLlmOwner, v))
+}
+
+// LearnerAnswerEQ applies the EQ predicate on the "learner_answer" field.
+func LearnerAnswerEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerNEQ applies the NEQ predicate on the "learner_answer" field.
+func LearnerAnswerNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerIn applies the In predicate on the "learner_answer" field.
+func LearnerAnswerIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldLearnerAnswer, vs...))
+}
+
+// LearnerAnswerNotIn applies the NotIn predicate on the "learner_answer" field.
+func LearnerAnswerNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldLearnerAnswer, vs...))
+}
+
+// LearnerAnswerGT applies the GT predicate on the "learner_answer" field.
+func LearnerAnswerGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerGTE applies the GTE predicate on the "learner_answer" field.
+func LearnerAnswerGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerLT applies the LT predicate on the "learner_answer" field.
+func LearnerAnswerLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerLTE applies the LTE predicate on the "learner_answer" field.
+func LearnerAnswerLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerContains applies the Contains predicate on the "learner_answer" field.
+func LearnerAnswerContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerHasPrefix applies the HasPrefix predicate on the "learner_answer" field.
+func LearnerAnswerHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerHasSuffix applies the HasSuffix predicate on the "learner_answer" field.
+func LearnerAnswerHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerEqualFold applies the EqualFold predicate on the "learner_answer" field.
+func LearnerAnswerEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldLearnerAnswer, v))
+}
+
+// LearnerAnswerContainsFold applies the ContainsFold predicate on the "learner_answer" field.
+func LearnerAnswerContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldLearnerAnswer, v))
+}
+
+// ReporterEQ applies the EQ predicate on the "reporter" field.
+func ReporterEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldReporter, v))
+}
+
+// ReporterNEQ applies the NEQ predicate on the "reporter" field.
+func ReporterNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldReporter, v))
+}
+
+// ReporterIn applies the In predicate on the "reporter" field.
+func ReporterIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldReporter, vs...))
+}
+
+// ReporterNotIn applies the NotIn predicate on the "reporter" field.
+func ReporterNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldReporter, vs...))
+}
+
+// ReporterGT applies the GT predicate on the "reporter" field.
+func ReporterGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldReporter, v))
+}
+
+// ReporterGTE applies the GTE predicate on the "reporter" field.
+func ReporterGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldReporter, v))
+}
+
+// ReporterLT applies the LT predicate on the "reporter" field.
+func ReporterLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldReporter, v))
+}
+
+// ReporterLTE applies the LTE predicate on the "reporter" field.
+func ReporterLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldReporter, v))
+}
+
+// ReporterContains applies the Contains predicate on the "reporter" field.
+func ReporterContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldReporter, v))
+}
+
+// ReporterHasPrefix applies the HasPrefix predicate on the "reporter" field.
+func ReporterHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldReporter, v))
+}
+
+// ReporterHasSuffix applies the HasSuffix predicate on the "reporter" field.
+func ReporterHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldReporter, v))
+}
+
+// ReporterEqualFold applies the EqualFold predicate on the "reporter" field.
+func ReporterEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldReporter, v))
+}
+
+// ReporterContainsFold applies the ContainsFold predicate on the "reporter" field.
+func ReporterContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldReporter, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionReportEvent) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionReportEvent) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionReportEvent) predicate.QuestionReportEvent {
+	return predicate.QuestionReportEvent(sql.NotPredicates(p))
+}
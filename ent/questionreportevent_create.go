@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+// QuestionReportEventCreate is the builder for creating a QuestionReportEvent entity.
+type QuestionReportEventCreate struct {
+	config
+	mutation *QuestionReportEventMutation
+	hooks    []Hook
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *QuestionReportEventCreate) SetSequence(v int64) *QuestionReportEventCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *QuestionReportEventCreate) SetTimestamp(v time.Time) *QuestionReportEventCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableTimestamp(v *time.Time) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetTimestamp(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *QuestionReportEventCreate) SetOwnerID(v string) *QuestionReportEventCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableOwnerID(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *QuestionReportEventCreate) SetSessionID(v string) *QuestionReportEventCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_c *QuestionReportEventCreate) SetSkillID(v string) *QuestionReportEventCreate {
+	_c.mutation.SetSkillID(v)
+	return _c
+}
+
+// SetTier sets the "tier" field.
+func (_c *QuestionReportEventCreate) SetTier(v string) *QuestionReportEventCreate {
+	_c.mutation.SetTier(v)
+	return _c
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableTier(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetTier(*v)
+	}
+	return _c
+}
+
+// SetQuestionText sets the "question_text" field.
+func (_c *QuestionReportEventCreate) SetQuestionText(v string) *QuestionReportEventCreate {
+	_c.mutation.SetQuestionText(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *QuestionReportEventCreate) SetAnswer(v string) *QuestionReportEventCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableAnswer(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *QuestionReportEventCreate) SetFormat(v string) *QuestionReportEventCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableFormat(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetChoices sets the "choices" field.
+func (_c *QuestionReportEventCreate) SetChoices(v []string) *QuestionReportEventCreate {
+	_c.mutation.SetChoices(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *QuestionReportEventCreate) SetSource(v string) *QuestionReportEventCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableSource(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_c *QuestionReportEventCreate) SetLlmRequestID(v int) *QuestionReportEventCreate {
+	_c.mutation.SetLlmRequestID(v)
+	return _c
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableLlmRequestID(v *int) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetLlmRequestID(*v)
+	}
+	return _c
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_c *QuestionReportEventCreate) SetLlmOwner(v string) *QuestionReportEventCreate {
+	_c.mutation.SetLlmOwner(v)
+	return _c
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableLlmOwner(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetLlmOwner(*v)
+	}
+	return _c
+}
+
+// SetLearnerAnswer sets the "learner_answer" field.
+func (_c *QuestionReportEventCreate) SetLearnerAnswer(v string) *QuestionReportEventCreate {
+	_c.mutation.SetLearnerAnswer(v)
+	return _c
+}
+
+// SetNillableLearnerAnswer sets the "learner_answer" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableLearnerAnswer(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetLearnerAnswer(*v)
+	}
+	return _c
+}
+
+// SetReporter sets the "reporter" field.
+func (_c *QuestionReportEventCreate) SetReporter(v string) *QuestionReportEventCreate {
+	_c.mutation.SetReporter(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *QuestionReportEventCreate) SetReason(v string) *QuestionReportEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *QuestionReportEventCreate) SetNillableReason(v *string) *QuestionReportEventCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// Mutation returns the QuestionReportEventMutation object of the builder.
+func (_c *QuestionReportEventCreate) Mutation() *QuestionReportEventMutation {
+	return _c.mutation
+}
+
+// Save creates the QuestionReportEvent in the database.
+func (_c *QuestionReportEventCreate) Save(ctx context.Context) (*QuestionReportEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionReportEventCreate) SaveX(ctx context.Context) *QuestionReportEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionReportEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionReportEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionReportEventCreate) defaults() {
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		v := questionreportevent.DefaultTimestamp()
+		_c.mutation.SetTimestamp(v)
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		v := questionreportevent.DefaultOwnerID
+		_c.mutation.SetOwnerID(v)
+	}
+	if _, ok := _c.mutation.Tier(); !ok {
+		v := questionreportevent.DefaultTier
+		_c.mutation.SetTier(v)
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		v := questionreportevent.DefaultAnswer
+		_c.mutation.SetAnswer(v)
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		v := questionreportevent.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := questionreportevent.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		v := questionreportevent.DefaultLlmRequestID
+		_c.mutation.SetLlmRequestID(v)
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		v := questionreportevent.DefaultLlmOwner
+		_c.mutation.SetLlmOwner(v)
+	}
+	if _, ok := _c.mutation.LearnerAnswer(); !ok {
+		v := questionreportevent.DefaultLearnerAnswer
+		_c.mutation.SetLearnerAnswer(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := questionreportevent.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionReportEventCreate) check() error {
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "QuestionReportEvent.sequence"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "QuestionReportEvent.timestamp"`)}
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "QuestionReportEvent.owner_id"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "QuestionReportEvent.session_id"`)}
+	}
+	if v, ok := _c.mutation.SessionID(); ok {
+		if err := questionreportevent.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.session_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SkillID(); !ok {
+		return &ValidationError{Name: "skill_id", err: errors.New(`ent: missing required field "QuestionReportEvent.skill_id"`)}
+	}
+	if v, ok := _c.mutation.SkillID(); ok {
+		if err := questionreportevent.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.skill_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`ent: missing required field "QuestionReportEvent.tier"`)}
+	}
+	if _, ok := _c.mutation.QuestionText(); !ok {
+		return &ValidationError{Name: "question_text", err: errors.New(`ent: missing required field "QuestionReportEvent.question_text"`)}
+	}
+	if v, ok := _c.mutation.QuestionText(); ok {
+		if err := questionreportevent.QuestionTextValidator(v); err != nil {
+			return &ValidationError{Name: "question_text", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.question_text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required field "QuestionReportEvent.answer"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "QuestionReportEvent.format"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "QuestionReportEvent.source"`)}
+	}
+	if _, ok := _c.mutation.LlmRequestID(); !ok {
+		return &ValidationError{Name: "llm_request_id", err: errors.New(`ent: missing required field "QuestionReportEvent.llm_request_id"`)}
+	}
+	if _, ok := _c.mutation.LlmOwner(); !ok {
+		return &ValidationError{Name: "llm_owner", err: errors.New(`ent: missing required field "QuestionReportEvent.llm_owner"`)}
+	}
+	if _, ok := _c.mutation.LearnerAnswer(); !ok {
+		return &ValidationError{Name: "learner_answer", err: errors.New(`ent: missing required field "QuestionReportEvent.learner_answer"`)}
+	}
+	if _, ok := _c.mutation.Reporter(); !ok {
+		return &ValidationError{Name: "reporter", err: errors.New(`ent: missing required field "QuestionReportEvent.reporter"`)}
+	}
+	if v, ok := _c.mutation.Reporter(); ok {
+		if err := questionreportevent.ReporterValidator(v); err != nil {
+			return &ValidationError{Name: "reporter", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.reporter": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "QuestionReportEvent.reason"`)}
+	}
+	return nil
+}
+
+func (_c *QuestionReportEventCreate) sqlSave(ctx context.Context) (*QuestionReportEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionReportEventCreate) createSpec() (*QuestionReportEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionReportEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(questionreportevent.Table, sqlgraph.NewFieldSpec(questionreportevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(questionreportevent.FieldSequence, field.TypeInt64, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(questionreportevent.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(questionreportevent.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(questionreportevent.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.SkillID(); ok {
+		_spec.SetField(questionreportevent.FieldSkillID, field.TypeString, value)
+		_node.SkillID = value
+	}
+	if value, ok := _c.mutation.Tier(); ok {
+		_spec.SetField(questionreportevent.FieldTier, field.TypeString, value)
+		_node.Tier = value
+	}
+	if value, ok := _c.mutation.QuestionText(); ok {
+		_spec.SetField(questionreportevent.FieldQuestionText, field.TypeString, value)
+		_node.QuestionText = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(questionreportevent.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(questionreportevent.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Choices(); ok {
+		_spec.SetField(questionreportevent.FieldChoices, field.TypeJSON, value)
+		_node.Choices = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(questionreportevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.LlmRequestID(); ok {
+		_spec.SetField(questionreportevent.FieldLlmRequestID, field.TypeInt, value)
+		_node.LlmRequestID = value
+	}
+	if value, ok := _c.mutation.LlmOwner(); ok {
+		_spec.SetField(questionreportevent.FieldLlmOwner, field.TypeString, value)
+		_node.LlmOwner = value
+	}
+	if value, ok := _c.mutation.LearnerAnswer(); ok {
+		_spec.SetField(questionreportevent.FieldLearnerAnswer, field.TypeString, value)
+		_node.LearnerAnswer = value
+	}
+	if value, ok := _c.mutation.Reporter(); ok {
+		_spec.SetField(questionreportevent.FieldReporter, field.TypeString, value)
+		_node.Reporter = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(questionreportevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// QuestionReportEventCreateBulk is the builder for creating many QuestionReportEvent entities in bulk.
+type QuestionReportEventCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionReportEventCreate
+}
+
+// Save creates the QuestionReportEvent entities in the database.
+func (_c *QuestionReportEventCreateBulk) Save(ctx context.Context) ([]*QuestionReportEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuestionReportEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionReportEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionReportEventCreateBulk) SaveX(ctx context.Context) []*QuestionReportEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionReportEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionReportEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/abhisek/mathiz/ent/predicate"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+// QuestionReportEventDelete is the builder for deleting a QuestionReportEvent entity.
+type QuestionReportEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestionReportEventMutation
+}
+
+// Where appends a list predicates to the QuestionReportEventDelete builder.
+func (_d *QuestionReportEventDelete) Where(ps ...predicate.QuestionReportEvent) *QuestionReportEventDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *QuestionReportEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionReportEventDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *QuestionReportEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questionreportevent.Table, sqlgraph.NewFieldSpec(questionreportevent.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// QuestionReportEventDeleteOne is the builder for deleting a single QuestionReportEvent entity.
+type QuestionReportEventDeleteOne struct {
+	_d *QuestionReportEventDelete
+}
+
+// Where appends a list predicates to the QuestionReportEventDelete builder.
+func (_d *QuestionReportEventDeleteOne) Where(ps ...predicate.QuestionReportEvent) *QuestionReportEventDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *QuestionReportEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questionreportevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionReportEventDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/abhisek/mathiz/ent/predicate"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+// QuestionReportEventUpdate is the builder for updating QuestionReportEvent entities.
+type QuestionReportEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionReportEventMutation
+}
+
+// Where appends a list predicates to the QuestionReportEventUpdate builder.
+func (_u *QuestionReportEventUpdate) Where(ps ...predicate.QuestionReportEvent) *QuestionReportEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *QuestionReportEventUpdate) SetSessionID(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableSessionID(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *QuestionReportEventUpdate) SetSkillID(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableSkillID(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *QuestionReportEventUpdate) SetTier(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableTier(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetQuestionText sets the "question_text" field.
+func (_u *QuestionReportEventUpdate) SetQuestionText(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetQuestionText(v)
+	return _u
+}
+
+// SetNillableQuestionText sets the "question_text" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableQuestionText(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetQuestionText(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *QuestionReportEventUpdate) SetAnswer(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableAnswer(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *QuestionReportEventUpdate) SetFormat(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableFormat(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *QuestionReportEventUpdate) SetChoices(v []string) *QuestionReportEventUpdate {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *QuestionReportEventUpdate) AppendChoices(v []string) *QuestionReportEventUpdate {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *QuestionReportEventUpdate) ClearChoices() *QuestionReportEventUpdate {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *QuestionReportEventUpdate) SetSource(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableSource(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *QuestionReportEventUpdate) SetLlmRequestID(v int) *QuestionReportEventUpdate {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableLlmRequestID(v *int) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *QuestionReportEventUpdate) AddLlmRequestID(v int) *QuestionReportEventUpdate {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *QuestionReportEventUpdate) SetLlmOwner(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableLlmOwner(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
+// SetLearnerAnswer sets the "learner_answer" field.
+func (_u *QuestionReportEventUpdate) SetLearnerAnswer(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetLearnerAnswer(v)
+	return _u
+}
+
+// SetNillableLearnerAnswer sets the "learner_answer" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableLearnerAnswer(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetLearnerAnswer(*v)
+	}
+	return _u
+}
+
+// SetReporter sets the "reporter" field.
+func (_u *QuestionReportEventUpdate) SetReporter(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetReporter(v)
+	return _u
+}
+
+// SetNillableReporter sets the "reporter" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableReporter(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetReporter(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *QuestionReportEventUpdate) SetReason(v string) *QuestionReportEventUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *QuestionReportEventUpdate) SetNillableReason(v *string) *QuestionReportEventUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuestionReportEventMutation object of the builder.
+func (_u *QuestionReportEventUpdate) Mutation() *QuestionReportEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionReportEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionReportEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionReportEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionReportEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionReportEventUpdate) check() error {
+	if v, ok := _u.mutation.SessionID(); ok {
+		if err := questionreportevent.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.session_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SkillID(); ok {
+		if err := questionreportevent.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.skill_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuestionText(); ok {
+		if err := questionreportevent.QuestionTextValidator(v); err != nil {
+			return &ValidationError{Name: "question_text", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.question_text": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reporter(); ok {
+		if err := questionreportevent.ReporterValidator(v); err != nil {
+			return &ValidationError{Name: "reporter", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.reporter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionReportEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionreportevent.Table, questionreportevent.Columns, sqlgraph.NewFieldSpec(questionreportevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(questionreportevent.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkillID(); ok {
+		_spec.SetField(questionreportevent.FieldSkillID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(questionreportevent.FieldTier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionText(); ok {
+		_spec.SetField(questionreportevent.FieldQuestionText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(questionreportevent.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(questionreportevent.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(questionreportevent.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questionreportevent.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(questionreportevent.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(questionreportevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(questionreportevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(questionreportevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(questionreportevent.FieldLlmOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LearnerAnswer(); ok {
+		_spec.SetField(questionreportevent.FieldLearnerAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reporter(); ok {
+		_spec.SetField(questionreportevent.FieldReporter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(questionreportevent.FieldReason, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionreportevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionReportEventUpdateOne is the builder for updating a single QuestionReportEvent entity.
+type QuestionReportEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionReportEventMutation
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *QuestionReportEventUpdateOne) SetSessionID(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableSessionID(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *QuestionReportEventUpdateOne) SetSkillID(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableSkillID(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *QuestionReportEventUpdateOne) SetTier(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableTier(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetQuestionText sets the "question_text" field.
+func (_u *QuestionReportEventUpdateOne) SetQuestionText(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetQuestionText(v)
+	return _u
+}
+
+// SetNillableQuestionText sets the "question_text" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableQuestionText(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetQuestionText(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *QuestionReportEventUpdateOne) SetAnswer(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableAnswer(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *QuestionReportEventUpdateOne) SetFormat(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableFormat(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetChoices sets the "choices" field.
+func (_u *QuestionReportEventUpdateOne) SetChoices(v []string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetChoices(v)
+	return _u
+}
+
+// AppendChoices appends value to the "choices" field.
+func (_u *QuestionReportEventUpdateOne) AppendChoices(v []string) *QuestionReportEventUpdateOne {
+	_u.mutation.AppendChoices(v)
+	return _u
+}
+
+// ClearChoices clears the value of the "choices" field.
+func (_u *QuestionReportEventUpdateOne) ClearChoices() *QuestionReportEventUpdateOne {
+	_u.mutation.ClearChoices()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *QuestionReportEventUpdateOne) SetSource(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableSource(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetLlmRequestID sets the "llm_request_id" field.
+func (_u *QuestionReportEventUpdateOne) SetLlmRequestID(v int) *QuestionReportEventUpdateOne {
+	_u.mutation.ResetLlmRequestID()
+	_u.mutation.SetLlmRequestID(v)
+	return _u
+}
+
+// SetNillableLlmRequestID sets the "llm_request_id" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableLlmRequestID(v *int) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetLlmRequestID(*v)
+	}
+	return _u
+}
+
+// AddLlmRequestID adds value to the "llm_request_id" field.
+func (_u *QuestionReportEventUpdateOne) AddLlmRequestID(v int) *QuestionReportEventUpdateOne {
+	_u.mutation.AddLlmRequestID(v)
+	return _u
+}
+
+// SetLlmOwner sets the "llm_owner" field.
+func (_u *QuestionReportEventUpdateOne) SetLlmOwner(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetLlmOwner(v)
+	return _u
+}
+
+// SetNillableLlmOwner sets the "llm_owner" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableLlmOwner(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetLlmOwner(*v)
+	}
+	return _u
+}
+
+// SetLearnerAnswer sets the "learner_answer" field.
+func (_u *QuestionReportEventUpdateOne) SetLearnerAnswer(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetLearnerAnswer(v)
+	return _u
+}
+
+// SetNillableLearnerAnswer sets the "learner_answer" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableLearnerAnswer(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetLearnerAnswer(*v)
+	}
+	return _u
+}
+
+// SetReporter sets the "reporter" field.
+func (_u *QuestionReportEventUpdateOne) SetReporter(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetReporter(v)
+	return _u
+}
+
+// SetNillableReporter sets the "reporter" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableReporter(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetReporter(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *QuestionReportEventUpdateOne) SetReason(v string) *QuestionReportEventUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *QuestionReportEventUpdateOne) SetNillableReason(v *string) *QuestionReportEventUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuestionReportEventMutation object of the builder.
+func (_u *QuestionReportEventUpdateOne) Mutation() *QuestionReportEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QuestionReportEventUpdate builder.
+func (_u *QuestionReportEventUpdateOne) Where(ps ...predicate.QuestionReportEvent) *QuestionReportEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionReportEventUpdateOne) Select(field string, fields ...string) *QuestionReportEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuestionReportEvent entity.
+func (_u *QuestionReportEventUpdateOne) Save(ctx context.Context) (*QuestionReportEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionReportEventUpdateOne) SaveX(ctx context.Context) *QuestionReportEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionReportEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionReportEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionReportEventUpdateOne) check() error {
+	if v, ok := _u.mutation.SessionID(); ok {
+		if err := questionreportevent.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.session_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SkillID(); ok {
+		if err := questionreportevent.SkillIDValidator(v); err != nil {
+			return &ValidationError{Name: "skill_id", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.skill_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuestionText(); ok {
+		if err := questionreportevent.QuestionTextValidator(v); err != nil {
+			return &ValidationError{Name: "question_text", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.question_text": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reporter(); ok {
+		if err := questionreportevent.ReporterValidator(v); err != nil {
+			return &ValidationError{Name: "reporter", err: fmt.Errorf(`ent: validator failed for field "QuestionReportEvent.reporter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionReportEventUpdateOne) sqlSave(ctx context.Context) (_node *QuestionReportEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionreportevent.Table, questionreportevent.Columns, sqlgraph.NewFieldSpec(questionreportevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionReportEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionreportevent.FieldID)
+		for _, f := range fields {
+			if !questionreportevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionreportevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(questionreportevent.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkillID(); ok {
+		_spec.SetField(questionreportevent.FieldSkillID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(questionreportevent.FieldTier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionText(); ok {
+		_spec.SetField(questionreportevent.FieldQuestionText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(questionreportevent.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(questionreportevent.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Choices(); ok {
+		_spec.SetField(questionreportevent.FieldChoices, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChoices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questionreportevent.FieldChoices, value)
+		})
+	}
+	if _u.mutation.ChoicesCleared() {
+		_spec.ClearField(questionreportevent.FieldChoices, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(questionreportevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LlmRequestID(); ok {
+		_spec.SetField(questionreportevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLlmRequestID(); ok {
+		_spec.AddField(questionreportevent.FieldLlmRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LlmOwner(); ok {
+		_spec.SetField(questionreportevent.FieldLlmOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LearnerAnswer(); ok {
+		_spec.SetField(questionreportevent.FieldLearnerAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reporter(); ok {
+		_spec.SetField(questionreportevent.FieldReporter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(questionreportevent.FieldReason, field.TypeString, value)
+	}
+	_node = &QuestionReportEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionreportevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
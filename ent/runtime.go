@@ -7,6 +7,7 @@ import (
 
 	"github.com/abhisek/mathiz/ent/account"
 	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
 	"github.com/abhisek/mathiz/ent/billingstate"
 	"github.com/abhisek/mathiz/ent/childprofile"
 	"github.com/abhisek/mathiz/ent/creditentry"
@@ -23,6 +24,7 @@ import (
 	"github.com/abhisek/mathiz/ent/masteryevent"
 	"github.com/abhisek/mathiz/ent/parentinvite"
 	"github.com/abhisek/mathiz/ent/quest"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
 	"github.com/abhisek/mathiz/ent/questprogress"
 	"github.com/abhisek/mathiz/ent/questquestion"
 	"github.com/abhisek/mathiz/ent/schema"
@@ -93,6 +95,88 @@ func init() {
 	answereventDescAnswerFormat := answereventFields[9].Descriptor()
 	// answerevent.AnswerFormatValidator is a validator for the "answer_format" field. It is called by the builders before save.
 	answerevent.AnswerFormatValidator = answereventDescAnswerFormat.Validators[0].(func(string) error)
+	// answereventDescSource is the schema descriptor for source field.
+	answereventDescSource := answereventFields[10].Descriptor()
+	// answerevent.DefaultSource holds the default value on creation for the source field.
+	answerevent.DefaultSource = answereventDescSource.Default.(string)
+	// answereventDescLlmRequestID is the schema descriptor for llm_request_id field.
+	answereventDescLlmRequestID := answereventFields[11].Descriptor()
+	// answerevent.DefaultLlmRequestID holds the default value on creation for the llm_request_id field.
+	answerevent.DefaultLlmRequestID = answereventDescLlmRequestID.Default.(int)
+	// answereventDescLlmOwner is the schema descriptor for llm_owner field.
+	answereventDescLlmOwner := answereventFields[12].Descriptor()
+	// answerevent.DefaultLlmOwner holds the default value on creation for the llm_owner field.
+	answerevent.DefaultLlmOwner = answereventDescLlmOwner.Default.(string)
+	bankquestionFields := schema.BankQuestion{}.Fields()
+	_ = bankquestionFields
+	// bankquestionDescSkillID is the schema descriptor for skill_id field.
+	bankquestionDescSkillID := bankquestionFields[0].Descriptor()
+	// bankquestion.SkillIDValidator is a validator for the "skill_id" field. It is called by the builders before save.
+	bankquestion.SkillIDValidator = bankquestionDescSkillID.Validators[0].(func(string) error)
+	// bankquestionDescTier is the schema descriptor for tier field.
+	bankquestionDescTier := bankquestionFields[1].Descriptor()
+	// bankquestion.TierValidator is a validator for the "tier" field. It is called by the builders before save.
+	bankquestion.TierValidator = bankquestionDescTier.Validators[0].(func(string) error)
+	// bankquestionDescDifficulty is the schema descriptor for difficulty field.
+	bankquestionDescDifficulty := bankquestionFields[2].Descriptor()
+	// bankquestion.DefaultDifficulty holds the default value on creation for the difficulty field.
+	bankquestion.DefaultDifficulty = bankquestionDescDifficulty.Default.(int)
+	// bankquestionDescText is the schema descriptor for text field.
+	bankquestionDescText := bankquestionFields[3].Descriptor()
+	// bankquestion.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	bankquestion.TextValidator = bankquestionDescText.Validators[0].(func(string) error)
+	// bankquestionDescDiagram is the schema descriptor for diagram field.
+	bankquestionDescDiagram := bankquestionFields[8].Descriptor()
+	// bankquestion.DefaultDiagram holds the default value on creation for the diagram field.
+	bankquestion.DefaultDiagram = bankquestionDescDiagram.Default.(string)
+	// bankquestionDescHint is the schema descriptor for hint field.
+	bankquestionDescHint := bankquestionFields[9].Descriptor()
+	// bankquestion.DefaultHint holds the default value on creation for the hint field.
+	bankquestion.DefaultHint = bankquestionDescHint.Default.(string)
+	// bankquestionDescExplanation is the schema descriptor for explanation field.
+	bankquestionDescExplanation := bankquestionFields[10].Descriptor()
+	// bankquestion.DefaultExplanation holds the default value on creation for the explanation field.
+	bankquestion.DefaultExplanation = bankquestionDescExplanation.Default.(string)
+	// bankquestionDescSource is the schema descriptor for source field.
+	bankquestionDescSource := bankquestionFields[11].Descriptor()
+	// bankquestion.DefaultSource holds the default value on creation for the source field.
+	bankquestion.DefaultSource = bankquestionDescSource.Default.(string)
+	// bankquestionDescLlmRequestID is the schema descriptor for llm_request_id field.
+	bankquestionDescLlmRequestID := bankquestionFields[12].Descriptor()
+	// bankquestion.DefaultLlmRequestID holds the default value on creation for the llm_request_id field.
+	bankquestion.DefaultLlmRequestID = bankquestionDescLlmRequestID.Default.(int)
+	// bankquestionDescLlmOwner is the schema descriptor for llm_owner field.
+	bankquestionDescLlmOwner := bankquestionFields[13].Descriptor()
+	// bankquestion.DefaultLlmOwner holds the default value on creation for the llm_owner field.
+	bankquestion.DefaultLlmOwner = bankquestionDescLlmOwner.Default.(string)
+	// bankquestionDescLocale is the schema descriptor for locale field.
+	bankquestionDescLocale := bankquestionFields[14].Descriptor()
+	// bankquestion.DefaultLocale holds the default value on creation for the locale field.
+	bankquestion.DefaultLocale = bankquestionDescLocale.Default.(string)
+	// bankquestionDescTimesServed is the schema descriptor for times_served field.
+	bankquestionDescTimesServed := bankquestionFields[15].Descriptor()
+	// bankquestion.DefaultTimesServed holds the default value on creation for the times_served field.
+	bankquestion.DefaultTimesServed = bankquestionDescTimesServed.Default.(int)
+	// bankquestionDescTimesAnswered is the schema descriptor for times_answered field.
+	bankquestionDescTimesAnswered := bankquestionFields[16].Descriptor()
+	// bankquestion.DefaultTimesAnswered holds the default value on creation for the times_answered field.
+	bankquestion.DefaultTimesAnswered = bankquestionDescTimesAnswered.Default.(int)
+	// bankquestionDescTimesCorrect is the schema descriptor for times_correct field.
+	bankquestionDescTimesCorrect := bankquestionFields[17].Descriptor()
+	// bankquestion.DefaultTimesCorrect holds the default value on creation for the times_correct field.
+	bankquestion.DefaultTimesCorrect = bankquestionDescTimesCorrect.Default.(int)
+	// bankquestionDescReports is the schema descriptor for reports field.
+	bankquestionDescReports := bankquestionFields[19].Descriptor()
+	// bankquestion.DefaultReports holds the default value on creation for the reports field.
+	bankquestion.DefaultReports = bankquestionDescReports.Default.(int)
+	// bankquestionDescRetired is the schema descriptor for retired field.
+	bankquestionDescRetired := bankquestionFields[20].Descriptor()
+	// bankquestion.DefaultRetired holds the default value on creation for the retired field.
+	bankquestion.DefaultRetired = bankquestionDescRetired.Default.(bool)
+	// bankquestionDescCreatedAt is the schema descriptor for created_at field.
+	bankquestionDescCreatedAt := bankquestionFields[21].Descriptor()
+	// bankquestion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankquestion.DefaultCreatedAt = bankquestionDescCreatedAt.Default.(func() time.Time)
 	billingstateFields := schema.BillingState{}.Fields()
 	_ = billingstateFields
 	// billingstateDescProvider is the schema descriptor for provider field.
@@ -123,16 +207,24 @@ func init() {
 	billingstate.UpdateDefaultUpdatedAt = billingstateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	childprofileFields := schema.ChildProfile{}.Fields()
 	_ = childprofileFields
+	// childprofileDescStandard is the schema descriptor for standard field.
+	childprofileDescStandard := childprofileFields[4].Descriptor()
+	// childprofile.DefaultStandard holds the default value on creation for the standard field.
+	childprofile.DefaultStandard = childprofileDescStandard.Default.(string)
+	// childprofileDescLocale is the schema descriptor for locale field.
+	childprofileDescLocale := childprofileFields[5].Descriptor()
+	// childprofile.DefaultLocale holds the default value on creation for the locale field.
+	childprofile.DefaultLocale = childprofileDescLocale.Default.(string)
 	// childprofileDescPinHash is the schema descriptor for pin_hash field.
-	childprofileDescPinHash := childprofileFields[4].Descriptor()
+	childprofileDescPinHash := childprofileFields[6].Descriptor()
 	// childprofile.DefaultPinHash holds the default value on creation for the pin_hash field.
 	childprofile.DefaultPinHash = childprofileDescPinHash.Default.(string)
 	// childprofileDescArchived is the schema descriptor for archived field.
-	childprofileDescArchived := childprofileFields[5].Descriptor()
+	childprofileDescArchived := childprofileFields[7].Descriptor()
 	// childprofile.DefaultArchived holds the default value on creation for the archived field.
 	childprofile.DefaultArchived = childprofileDescArchived.Default.(bool)
 	// childprofileDescCreatedAt is the schema descriptor for created_at field.
-	childprofileDescCreatedAt := childprofileFields[6].Descriptor()
+	childprofileDescCreatedAt := childprofileFields[8].Descriptor()
 	// childprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
 	childprofile.DefaultCreatedAt = childprofileDescCreatedAt.Default.(func() time.Time)
 	creditentryFields := schema.CreditEntry{}.Fields()
@@ -488,6 +580,67 @@ func init() {
 	questquestionDescCreatedAt := questquestionFields[11].Descriptor()
 	// questquestion.DefaultCreatedAt holds the default value on creation for the created_at field.
 	questquestion.DefaultCreatedAt = questquestionDescCreatedAt.Default.(func() time.Time)
+	questionreporteventMixin := schema.QuestionReportEvent{}.Mixin()
+	questionreporteventMixinFields0 := questionreporteventMixin[0].Fields()
+	_ = questionreporteventMixinFields0
+	questionreporteventFields := schema.QuestionReportEvent{}.Fields()
+	_ = questionreporteventFields
+	// questionreporteventDescTimestamp is the schema descriptor for timestamp field.
+	questionreporteventDescTimestamp := questionreporteventMixinFields0[1].Descriptor()
+	// questionreportevent.DefaultTimestamp holds the default value on creation for the timestamp field.
+	questionreportevent.DefaultTimestamp = questionreporteventDescTimestamp.Default.(func() time.Time)
+	// questionreporteventDescOwnerID is the schema descriptor for owner_id field.
+	questionreporteventDescOwnerID := questionreporteventMixinFields0[2].Descriptor()
+	// questionreportevent.DefaultOwnerID holds the default value on creation for the owner_id field.
+	questionreportevent.DefaultOwnerID = questionreporteventDescOwnerID.Default.(string)
+	// questionreporteventDescSessionID is the schema descriptor for session_id field.
+	questionreporteventDescSessionID := questionreporteventFields[0].Descriptor()
+	// questionreportevent.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	questionreportevent.SessionIDValidator = questionreporteventDescSessionID.Validators[0].(func(string) error)
+	// questionreporteventDescSkillID is the schema descriptor for skill_id field.
+	questionreporteventDescSkillID := questionreporteventFields[1].Descriptor()
+	// questionreportevent.SkillIDValidator is a validator for the "skill_id" field. It is called by the builders before save.
+	questionreportevent.SkillIDValidator = questionreporteventDescSkillID.Validators[0].(func(string) error)
+	// questionreporteventDescTier is the schema descriptor for tier field.
+	questionreporteventDescTier := questionreporteventFields[2].Descriptor()
+	// questionreportevent.DefaultTier holds the default value on creation for the tier field.
+	questionreportevent.DefaultTier = questionreporteventDescTier.Default.(string)
+	// questionreporteventDescQuestionText is the schema descriptor for question_text field.
+	questionreporteventDescQuestionText := questionreporteventFields[3].Descriptor()
+	// questionreportevent.QuestionTextValidator is a validator for the "question_text" field. It is called by the builders before save.
+	questionreportevent.QuestionTextValidator = questionreporteventDescQuestionText.Validators[0].(func(string) error)
+	// questionreporteventDescAnswer is the schema descriptor for answer field.
+	questionreporteventDescAnswer := questionreporteventFields[4].Descriptor()
+	// questionreportevent.DefaultAnswer holds the default value on creation for the answer field.
+	questionreportevent.DefaultAnswer = questionreporteventDescAnswer.Default.(string)
+	// questionreporteventDescFormat is the schema descriptor for format field.
+	questionreporteventDescFormat := questionreporteventFields[5].Descriptor()
+	// questionreportevent.DefaultFormat holds the default value on creation for the format field.
+	questionreportevent.DefaultFormat = questionreporteventDescFormat.Default.(string)
+	// questionreporteventDescSource is the schema descriptor for source field.
+	questionreporteventDescSource := questionreporteventFields[7].Descriptor()
+	// questionreportevent.DefaultSource holds the default value on creation for the source field.
+	questionreportevent.DefaultSource = questionreporteventDescSource.Default.(string)
+	// questionreporteventDescLlmRequestID is the schema descriptor for llm_request_id field.
+	questionreporteventDescLlmRequestID := questionreporteventFields[8].Descriptor()
+	// questionreportevent.DefaultLlmRequestID holds the default value on creation for the llm_request_id field.
+	questionreportevent.DefaultLlmRequestID = questionreporteventDescLlmRequestID.Default.(int)
+	// questionreporteventDescLlmOwner is the schema descriptor for llm_owner field.
+	questionreporteventDescLlmOwner := questionreporteventFields[9].Descriptor()
+	// questionreportevent.DefaultLlmOwner holds the default value on creation for the llm_owner field.
+	questionreportevent.DefaultLlmOwner = questionreporteventDescLlmOwner.Default.(string)
+	// questionreporteventDescLearnerAnswer is the schema descriptor for learner_answer field.
+	questionreporteventDescLearnerAnswer := questionreporteventFields[10].Descriptor()
+	// questionreportevent.DefaultLearnerAnswer holds the default value on creation for the learner_answer field.
+	questionreportevent.DefaultLearnerAnswer = questionreporteventDescLearnerAnswer.Default.(string)
+	// questionreporteventDescReporter is the schema descriptor for reporter field.
+	questionreporteventDescReporter := questionreporteventFields[11].Descriptor()
+	// questionreportevent.ReporterValidator is a validator for the "reporter" field. It is called by the builders before save.
+	questionreportevent.ReporterValidator = questionreporteventDescReporter.Validators[0].(func(string) error)
+	// questionreporteventDescReason is the schema descriptor for reason field.
+	questionreporteventDescReason := questionreporteventFields[12].Descriptor()
+	// questionreportevent.DefaultReason holds the default value on creation for the reason field.
+	questionreportevent.DefaultReason = questionreporteventDescReason.Default.(string)
 	sessioneventMixin := schema.SessionEvent{}.Mixin()
 	sessioneventMixinFields0 := sessioneventMixin[0].Fields()
 	_ = sessioneventMixinFields0
@@ -522,11 +675,11 @@ func init() {
 	// sessionevent.DefaultDurationSecs holds the default value on creation for the duration_secs field.
 	sessionevent.DefaultDurationSecs = sessioneventDescDurationSecs.Default.(int)
 	// sessioneventDescQuestUID is the schema descriptor for quest_uid field.
-	sessioneventDescQuestUID := sessioneventFields[6].Descriptor()
+	sessioneventDescQuestUID := sessioneventFields[7].Descriptor()
 	// sessionevent.DefaultQuestUID holds the default value on creation for the quest_uid field.
 	sessionevent.DefaultQuestUID = sessioneventDescQuestUID.Default.(string)
 	// sessioneventDescQuestName is the schema descriptor for quest_name field.
-	sessioneventDescQuestName := sessioneventFields[7].Descriptor()
+	sessioneventDescQuestName := sessioneventFields[8].Descriptor()
 	// sessionevent.DefaultQuestName holds the default value on creation for the quest_name field.
 	sessionevent.DefaultQuestName = sessioneventDescQuestName.Default.(string)
 	snapshotFields := schema.Snapshot{}.Fields()
@@ -45,6 +45,15 @@ func (AnswerEvent) Fields() []ent.Field {
 		field.String("answer_format").
 			NotEmpty().
 			Comment("numeric or multiple_choice"),
+		field.String("source").
+			Default("").
+			Comment("Generator that produced the question: llm, offline, bank"),
+		field.Int("llm_request_id").
+			Default(0).
+			Comment("LLMRequestEvent that generated the question, 0 if none"),
+		field.String("llm_owner").
+			Default("").
+			Comment("Learner whose LLM log holds llm_request_id; empty for this one"),
 	}
 }
 
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BankQuestion is a validated generated question kept for reuse. The bank
+// is shared by every learner in the database: it holds curriculum content,
+// not learner data, so it has no owner_id. Answer statistics accumulate
+// across learners and retire questions that too few get right; reported
+// questions wait for triage.
+type BankQuestion struct {
+	ent.Schema
+}
+
+func (BankQuestion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("skill_id").
+			NotEmpty().
+			Comment("Skill the question was generated for"),
+		field.String("tier").
+			NotEmpty().
+			Comment("learn, prove, or challenge"),
+		field.Int("difficulty").
+			Default(0).
+			Comment("Generator-reported difficulty (1-5)"),
+		field.Text("text").
+			NotEmpty(),
+		field.String("answer"),
+		field.String("answer_type").
+			Comment("integer | decimal | fraction | text"),
+		field.String("format").
+			Comment("numeric | multiple_choice"),
+		field.JSON("choices", []string{}).
+			Optional().
+			Comment("Options for multiple_choice format"),
+		field.Text("diagram").
+			Default("").
+			Comment("JSON-encoded diagram drawn with the question; empty for none"),
+		field.Text("hint").
+			Default(""),
+		field.Text("explanation").
+			Default(""),
+		field.String("source").
+			Default("").
+			Comment("Generator that produced the question (llm)"),
+		field.Int("llm_request_id").
+			Default(0).
+			Comment("LLMRequestEvent that generated the question, 0 if none"),
+		field.String("llm_owner").
+			Default("").
+			Comment("Learner whose LLM log holds llm_request_id"),
+		field.String("locale").
+			Default("en").
+			Comment("Language the question is written in (i18n.Locale)"),
+		field.Int("times_served").
+			Default(0).
+			Comment("Times served from the bank"),
+		field.Int("times_answered").
+			Default(0).
+			Comment("Graded answers across all learners"),
+		field.Int("times_correct").
+			Default(0).
+			Comment("Correct answers across all learners"),
+		field.JSON("reported_by", []string{}).
+			Optional().
+			Comment("Learners who reported the question since it was last triaged"),
+		field.Int("reports").
+			Default(0).
+			Comment("Number of learners in reported_by; non-zero awaits triage"),
+		field.Bool("retired").
+			Default(false).
+			Comment("Retired questions are never served again"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (BankQuestion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("skill_id", "tier", "difficulty"),
+		index.Fields("skill_id", "text").Unique(),
+	}
+}
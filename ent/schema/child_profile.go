@@ -24,7 +24,13 @@ func (ChildProfile) Fields() []ent.Field {
 			Immutable(),
 		field.String("name"),
 		field.Int("grade").
-			Comment("School grade, 2-5"),
+			Comment("School grade, 0 (kindergarten) to 6"),
+		field.String("standard").
+			Default("ccss").
+			Comment("Curriculum standard whose skill codes the learner sees (skillgraph.Standard)"),
+		field.String("locale").
+			Default("en").
+			Comment("Language for the learner's questions and UI (i18n.Locale)"),
 		field.String("pin_hash").
 			Default("").
 			Sensitive().
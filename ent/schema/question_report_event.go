@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QuestionReportEvent records a question a learner or parent flagged as
+// wrong, confusing or inappropriate, with enough of the question to triage
+// it without the session.
+type QuestionReportEvent struct {
+	ent.Schema
+}
+
+func (QuestionReportEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{EventMixin{}}
+}
+
+func (QuestionReportEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("session_id").
+			NotEmpty().
+			Comment("Links to SessionEvent"),
+		field.String("skill_id").
+			NotEmpty().
+			Comment("Skill the question was for"),
+		field.String("tier").
+			Default("").
+			Comment("learn, prove or challenge"),
+		field.Text("question_text").
+			NotEmpty().
+			Comment("The question shown"),
+		field.String("answer").
+			Default("").
+			Comment("The question's answer key"),
+		field.String("format").
+			Default("").
+			Comment("Answer format, e.g. numeric or multiple_choice"),
+		field.JSON("choices", []string{}).
+			Optional().
+			Comment("Options shown for choice formats"),
+		field.String("source").
+			Default("").
+			Comment("Generator that produced the question: llm, offline, bank"),
+		field.Int("llm_request_id").
+			Default(0).
+			Comment("LLMRequestEvent that generated the question, 0 if none"),
+		field.String("llm_owner").
+			Default("").
+			Comment("Learner whose LLM log holds llm_request_id; empty for the reporter"),
+		field.String("learner_answer").
+			Default("").
+			Comment("What the learner entered, empty if unanswered"),
+		field.String("reporter").
+			NotEmpty().
+			Comment("learner or parent"),
+		field.Text("reason").
+			Default("").
+			Comment("Free-text reason, may be empty"),
+	}
+}
+
+func (QuestionReportEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id"),
+		index.Fields("skill_id"),
+	}
+}
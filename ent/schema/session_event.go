@@ -42,6 +42,9 @@ func (SessionEvent) Fields() []ent.Field {
 		field.JSON("plan_summary", []PlanSlotSummary{}).
 			Optional().
 			Comment("Serialized plan (on start only)"),
+		field.JSON("question_sources", map[string]int{}).
+			Optional().
+			Comment("Questions answered per generator source (on end only)"),
 		field.String("quest_uid").
 			Optional().
 			Default("").
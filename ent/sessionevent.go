@@ -37,6 +37,8 @@ type SessionEvent struct {
 	DurationSecs int `json:"duration_secs,omitempty"`
 	// Serialized plan (on start only)
 	PlanSummary []schema.PlanSlotSummary `json:"plan_summary,omitempty"`
+	// Questions answered per generator source (on end only)
+	QuestionSources map[string]int `json:"question_sources,omitempty"`
 	// Quest attribution: quest UID (on quest session start only)
 	QuestUID string `json:"quest_uid,omitempty"`
 	// Quest attribution: quest name as-of-play (on quest session start only)
@@ -49,7 +51,7 @@ func (*SessionEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case sessionevent.FieldPlanSummary:
+		case sessionevent.FieldPlanSummary, sessionevent.FieldQuestionSources:
 			values[i] = new([]byte)
 		case sessionevent.FieldID, sessionevent.FieldSequence, sessionevent.FieldQuestionsServed, sessionevent.FieldCorrectAnswers, sessionevent.FieldDurationSecs:
 			values[i] = new(sql.NullInt64)
@@ -134,6 +136,14 @@ func (_m *SessionEvent) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field plan_summary: %w", err)
 				}
 			}
+		case sessionevent.FieldQuestionSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field question_sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QuestionSources); err != nil {
+					return fmt.Errorf("unmarshal field question_sources: %w", err)
+				}
+			}
 		case sessionevent.FieldQuestUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field quest_uid", values[i])
@@ -209,6 +219,9 @@ func (_m *SessionEvent) String() string {
 	builder.WriteString("plan_summary=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PlanSummary))
 	builder.WriteString(", ")
+	builder.WriteString("question_sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuestionSources))
+	builder.WriteString(", ")
 	builder.WriteString("quest_uid=")
 	builder.WriteString(_m.QuestUID)
 	builder.WriteString(", ")
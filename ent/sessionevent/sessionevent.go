@@ -31,6 +31,8 @@ const (
 	FieldDurationSecs = "duration_secs"
 	// FieldPlanSummary holds the string denoting the plan_summary field in the database.
 	FieldPlanSummary = "plan_summary"
+	// FieldQuestionSources holds the string denoting the question_sources field in the database.
+	FieldQuestionSources = "question_sources"
 	// FieldQuestUID holds the string denoting the quest_uid field in the database.
 	FieldQuestUID = "quest_uid"
 	// FieldQuestName holds the string denoting the quest_name field in the database.
@@ -51,6 +53,7 @@ var Columns = []string{
 	FieldCorrectAnswers,
 	FieldDurationSecs,
 	FieldPlanSummary,
+	FieldQuestionSources,
 	FieldQuestUID,
 	FieldQuestName,
 }
@@ -509,6 +509,16 @@ func PlanSummaryNotNil() predicate.SessionEvent {
 	return predicate.SessionEvent(sql.FieldNotNull(FieldPlanSummary))
 }
 
+// QuestionSourcesIsNil applies the IsNil predicate on the "question_sources" field.
+func QuestionSourcesIsNil() predicate.SessionEvent {
+	return predicate.SessionEvent(sql.FieldIsNull(FieldQuestionSources))
+}
+
+// QuestionSourcesNotNil applies the NotNil predicate on the "question_sources" field.
+func QuestionSourcesNotNil() predicate.SessionEvent {
+	return predicate.SessionEvent(sql.FieldNotNull(FieldQuestionSources))
+}
+
 // QuestUIDEQ applies the EQ predicate on the "quest_uid" field.
 func QuestUIDEQ(v string) predicate.SessionEvent {
 	return predicate.SessionEvent(sql.FieldEQ(FieldQuestUID, v))
@@ -115,6 +115,12 @@ func (_c *SessionEventCreate) SetPlanSummary(v []schema.PlanSlotSummary) *Sessio
 	return _c
 }
 
+// SetQuestionSources sets the "question_sources" field.
+func (_c *SessionEventCreate) SetQuestionSources(v map[string]int) *SessionEventCreate {
+	_c.mutation.SetQuestionSources(v)
+	return _c
+}
+
 // SetQuestUID sets the "quest_uid" field.
 func (_c *SessionEventCreate) SetQuestUID(v string) *SessionEventCreate {
 	_c.mutation.SetQuestUID(v)
@@ -306,6 +312,10 @@ func (_c *SessionEventCreate) createSpec() (*SessionEvent, *sqlgraph.CreateSpec)
 		_spec.SetField(sessionevent.FieldPlanSummary, field.TypeJSON, value)
 		_node.PlanSummary = value
 	}
+	if value, ok := _c.mutation.QuestionSources(); ok {
+		_spec.SetField(sessionevent.FieldQuestionSources, field.TypeJSON, value)
+		_node.QuestionSources = value
+	}
 	if value, ok := _c.mutation.QuestUID(); ok {
 		_spec.SetField(sessionevent.FieldQuestUID, field.TypeString, value)
 		_node.QuestUID = value
@@ -138,6 +138,18 @@ func (_u *SessionEventUpdate) ClearPlanSummary() *SessionEventUpdate {
 	return _u
 }
 
+// SetQuestionSources sets the "question_sources" field.
+func (_u *SessionEventUpdate) SetQuestionSources(v map[string]int) *SessionEventUpdate {
+	_u.mutation.SetQuestionSources(v)
+	return _u
+}
+
+// ClearQuestionSources clears the value of the "question_sources" field.
+func (_u *SessionEventUpdate) ClearQuestionSources() *SessionEventUpdate {
+	_u.mutation.ClearQuestionSources()
+	return _u
+}
+
 // SetQuestUID sets the "quest_uid" field.
 func (_u *SessionEventUpdate) SetQuestUID(v string) *SessionEventUpdate {
 	_u.mutation.SetQuestUID(v)
@@ -272,6 +284,12 @@ func (_u *SessionEventUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.PlanSummaryCleared() {
 		_spec.ClearField(sessionevent.FieldPlanSummary, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.QuestionSources(); ok {
+		_spec.SetField(sessionevent.FieldQuestionSources, field.TypeJSON, value)
+	}
+	if _u.mutation.QuestionSourcesCleared() {
+		_spec.ClearField(sessionevent.FieldQuestionSources, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.QuestUID(); ok {
 		_spec.SetField(sessionevent.FieldQuestUID, field.TypeString, value)
 	}
@@ -413,6 +431,18 @@ func (_u *SessionEventUpdateOne) ClearPlanSummary() *SessionEventUpdateOne {
 	return _u
 }
 
+// SetQuestionSources sets the "question_sources" field.
+func (_u *SessionEventUpdateOne) SetQuestionSources(v map[string]int) *SessionEventUpdateOne {
+	_u.mutation.SetQuestionSources(v)
+	return _u
+}
+
+// ClearQuestionSources clears the value of the "question_sources" field.
+func (_u *SessionEventUpdateOne) ClearQuestionSources() *SessionEventUpdateOne {
+	_u.mutation.ClearQuestionSources()
+	return _u
+}
+
 // SetQuestUID sets the "quest_uid" field.
 func (_u *SessionEventUpdateOne) SetQuestUID(v string) *SessionEventUpdateOne {
 	_u.mutation.SetQuestUID(v)
@@ -577,6 +607,12 @@ func (_u *SessionEventUpdateOne) sqlSave(ctx context.Context) (_node *SessionEve
 	if _u.mutation.PlanSummaryCleared() {
 		_spec.ClearField(sessionevent.FieldPlanSummary, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.QuestionSources(); ok {
+		_spec.SetField(sessionevent.FieldQuestionSources, field.TypeJSON, value)
+	}
+	if _u.mutation.QuestionSourcesCleared() {
+		_spec.ClearField(sessionevent.FieldQuestionSources, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.QuestUID(); ok {
 		_spec.SetField(sessionevent.FieldQuestUID, field.TypeString, value)
 	}
@@ -16,6 +16,8 @@ type Tx struct {
 	Account *AccountClient
 	// AnswerEvent is the client for interacting with the AnswerEvent builders.
 	AnswerEvent *AnswerEventClient
+	// BankQuestion is the client for interacting with the BankQuestion builders.
+	BankQuestion *BankQuestionClient
 	// BillingState is the client for interacting with the BillingState builders.
 	BillingState *BillingStateClient
 	// ChildProfile is the client for interacting with the ChildProfile builders.
@@ -52,6 +54,8 @@ type Tx struct {
 	QuestProgress *QuestProgressClient
 	// QuestQuestion is the client for interacting with the QuestQuestion builders.
 	QuestQuestion *QuestQuestionClient
+	// QuestionReportEvent is the client for interacting with the QuestionReportEvent builders.
+	QuestionReportEvent *QuestionReportEventClient
 	// SessionEvent is the client for interacting with the SessionEvent builders.
 	SessionEvent *SessionEventClient
 	// Snapshot is the client for interacting with the Snapshot builders.
@@ -189,6 +193,7 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
 	tx.AnswerEvent = NewAnswerEventClient(tx.config)
+	tx.BankQuestion = NewBankQuestionClient(tx.config)
 	tx.BillingState = NewBillingStateClient(tx.config)
 	tx.ChildProfile = NewChildProfileClient(tx.config)
 	tx.CreditEntry = NewCreditEntryClient(tx.config)
@@ -207,6 +212,7 @@ func (tx *Tx) init() {
 	tx.Quest = NewQuestClient(tx.config)
 	tx.QuestProgress = NewQuestProgressClient(tx.config)
 	tx.QuestQuestion = NewQuestQuestionClient(tx.config)
+	tx.QuestionReportEvent = NewQuestionReportEventClient(tx.config)
 	tx.SessionEvent = NewSessionEventClient(tx.config)
 	tx.Snapshot = NewSnapshotClient(tx.config)
 }
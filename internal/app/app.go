@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -12,10 +13,12 @@ import (
 	"github.com/abhisek/mathiz/internal/gems"
 	"github.com/abhisek/mathiz/internal/lessons"
 	"github.com/abhisek/mathiz/internal/llm"
+	"github.com/abhisek/mathiz/internal/placement"
 	"github.com/abhisek/mathiz/internal/problemgen"
 	"github.com/abhisek/mathiz/internal/router"
 	"github.com/abhisek/mathiz/internal/screen"
 	"github.com/abhisek/mathiz/internal/screens/home"
+	placementscreen "github.com/abhisek/mathiz/internal/screens/placement"
 	sessionscreen "github.com/abhisek/mathiz/internal/screens/session"
 	"github.com/abhisek/mathiz/internal/screens/welcome"
 	"github.com/abhisek/mathiz/internal/selfupdate"
@@ -28,7 +31,8 @@ import (
 // Options holds dependencies injected into the app.
 type Options struct {
 	// LLMProvider is the LLM provider for AI features. May be nil if
-	// no API key is configured (AI features will be unavailable).
+	// no API key is configured (AI features will be unavailable, and
+	// BuildOptions falls back to the offline question generator).
 	LLMProvider llm.Provider
 
 	// EventRepo provides event persistence. Required for session tracking.
@@ -57,6 +61,10 @@ type Options struct {
 
 	// DirectSession skips welcome and home screens, launching a session immediately.
 	DirectSession bool
+
+	// Grade is the learner's school grade; the placement quiz probes no
+	// skills above it. Nil probes every grade.
+	Grade *int
 }
 
 // UpdateAvailableMsg is sent when an update check completes with a new version.
@@ -89,7 +97,17 @@ func newAppModel(opts Options) *AppModel {
 		homeFactory := func() screen.Screen {
 			return home.New(opts.Generator, opts.EventRepo, opts.SnapshotRepo, opts.DiagnosisService, opts.LessonService, opts.Compressor, opts.GemService, m.updateResult)
 		}
-		m.router = router.New(welcome.New(homeFactory))
+		// A learner with no saved progress is offered placement first.
+		firstFactory := homeFactory
+		if opts.Generator != nil && opts.EventRepo != nil && opts.SnapshotRepo != nil {
+			firstFactory = func() screen.Screen {
+				if needed, err := placement.Needed(context.Background(), opts.SnapshotRepo); err == nil && needed {
+					return placementscreen.New(opts.Generator, opts.EventRepo, opts.SnapshotRepo, opts.Grade, homeFactory)
+				}
+				return homeFactory()
+			}
+		}
+		m.router = router.New(welcome.New(firstFactory))
 	}
 	return m
 }
@@ -249,8 +267,11 @@ func NewModel(opts Options) tea.Model {
 // an optional LLM provider. The local CLI (cmd/run.go) uses this — add new
 // session dependencies here so every host of the app model gets them. The
 // returned cleanup releases per-session resources (the async diagnosis
-// worker); it is non-nil even when provider is nil.
-func BuildOptions(eventRepo store.EventRepo, snapRepo store.SnapshotRepo, provider llm.Provider) (Options, func()) {
+// worker); it is non-nil even when provider is nil. Without a provider,
+// questions come from the offline generator, which covers the computable
+// skills only. A non-nil bank serves previously generated questions first,
+// and a non-nil judge double-checks generated questions.
+func BuildOptions(eventRepo store.EventRepo, snapRepo store.SnapshotRepo, bank store.QuestionBankRepo, provider, judge llm.Provider) (Options, func()) {
 	opts := Options{
 		EventRepo:    eventRepo,
 		SnapshotRepo: snapRepo,
@@ -258,13 +279,21 @@ func BuildOptions(eventRepo store.EventRepo, snapRepo store.SnapshotRepo, provid
 	}
 	cleanup := func() {}
 	if provider != nil {
-		tools := tutor.New(provider)
+		tools := tutor.New(provider, judge)
+		if bank != nil {
+			tools.UseBank(bank)
+		}
 		opts.LLMProvider = provider
 		opts.Generator = tools.Generator
 		opts.DiagnosisService = tools.Diagnosis
 		opts.LessonService = tools.Lessons
 		opts.Compressor = tools.Compressor
-		cleanup = func() { tools.Diagnosis.Close() }
+		cleanup = tools.Close
+	} else {
+		opts.Generator = problemgen.NewOffline(uint64(time.Now().UnixNano()))
+		if bank != nil {
+			opts.Generator = problemgen.NewBanked(opts.Generator, bank)
+		}
 	}
 	return opts, cleanup
 }
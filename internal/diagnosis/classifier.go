@@ -8,10 +8,12 @@ type Classifier interface {
 }
 
 // DefaultClassifiers returns classifiers in priority order.
-// Speed-rush has highest priority since a fast wrong answer is more likely
-// a rush than a careless slip, even for high-accuracy learners.
+// Near-miss comes first: the grade already shows the slip. Speed-rush is
+// next since a fast wrong answer is more likely a rush than a careless
+// slip, even for high-accuracy learners.
 func DefaultClassifiers() []Classifier {
 	return []Classifier{
+		&NearMissClassifier{},
 		&SpeedRushClassifier{},
 		&CarelessClassifier{},
 	}
@@ -123,14 +123,33 @@ func TestRunClassifiers_NilQuestion(t *testing.T) {
 
 func TestDefaultClassifiers_Order(t *testing.T) {
 	classifiers := DefaultClassifiers()
-	if len(classifiers) != 2 {
-		t.Fatalf("got %d classifiers, want 2", len(classifiers))
+	if len(classifiers) != 3 {
+		t.Fatalf("got %d classifiers, want 3", len(classifiers))
 	}
-	if classifiers[0].Name() != "speed-rush" {
-		t.Errorf("first classifier is %q, want speed-rush", classifiers[0].Name())
+	if classifiers[0].Name() != "near-miss" {
+		t.Errorf("first classifier is %q, want near-miss", classifiers[0].Name())
 	}
-	if classifiers[1].Name() != "careless" {
-		t.Errorf("second classifier is %q, want careless", classifiers[1].Name())
+	if classifiers[1].Name() != "speed-rush" {
+		t.Errorf("second classifier is %q, want speed-rush", classifiers[1].Name())
+	}
+	if classifiers[2].Name() != "careless" {
+		t.Errorf("third classifier is %q, want careless", classifiers[2].Name())
+	}
+}
+
+func TestNearMissClassifier(t *testing.T) {
+	c := &NearMissClassifier{}
+	cat, _ := c.Classify(&ClassifyInput{Grade: problemgen.Grade{Kind: problemgen.GradeNearMiss}})
+	if cat != CategoryCareless {
+		t.Errorf("near miss: got %q, want %q", cat, CategoryCareless)
+	}
+	cat, _ = c.Classify(&ClassifyInput{Grade: problemgen.Grade{Kind: problemgen.GradeUnsimplified}})
+	if cat != CategoryCareless {
+		t.Errorf("unsimplified: got %q, want %q", cat, CategoryCareless)
+	}
+	cat, _ = c.Classify(&ClassifyInput{Grade: problemgen.Grade{Kind: problemgen.GradeWrong}})
+	if cat != "" {
+		t.Errorf("wrong: got %q, want empty", cat)
 	}
 }
 
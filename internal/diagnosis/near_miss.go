@@ -0,0 +1,16 @@
+package diagnosis
+
+// NearMissClassifier flags answers graded as a near miss or unsimplified
+// (a transposed digit, the wrong unit, an unreduced fraction) as careless
+// slips: the learner had the right idea, so there is no misconception to
+// look for.
+type NearMissClassifier struct{}
+
+func (c *NearMissClassifier) Name() string { return "near-miss" }
+
+func (c *NearMissClassifier) Classify(input *ClassifyInput) (ErrorCategory, float64) {
+	if input.Grade.Partial() {
+		return CategoryCareless, 0.9
+	}
+	return "", 0
+}
@@ -53,6 +53,7 @@ func (s *Service) Diagnose(
 		LearnerAnswer:  learnerAnswer,
 		ResponseTimeMs: responseTimeMs,
 		SkillAccuracy:  skillAccuracy,
+		Grade:          problemgen.GradeAnswer(learnerAnswer, question),
 	}
 
 	// Phase 1: Rule-based (synchronous).
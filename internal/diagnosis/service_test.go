@@ -57,6 +57,17 @@ func TestService_RuleBasedCareless(t *testing.T) {
 	svc.Close()
 }
 
+func TestService_NearMissIsCareless(t *testing.T) {
+	svc := NewService(nil)
+
+	// "58" for 85 is a digit swap: a slip even from a low-accuracy learner.
+	result := svc.Diagnose(context.Background(), testQuestion(), "58", 5000, 0.30, nil)
+	if result.Category != CategoryCareless || result.ClassifierName != "near-miss" {
+		t.Errorf("got %q from %q, want careless from near-miss", result.Category, result.ClassifierName)
+	}
+	svc.Close()
+}
+
 func TestService_UnclassifiedWithoutLLM(t *testing.T) {
 	svc := NewService(nil)
 
@@ -2,8 +2,8 @@ package diagnosis
 
 import "github.com/abhisek/mathiz/internal/skillgraph"
 
-// seedMisconceptions defines the MVP misconception taxonomy.
-// 19 misconceptions across 5 strands (3–4 each).
+// seedMisconceptions defines the misconception taxonomy.
+// 30 misconceptions across 8 strands (3–4 each).
 var seedMisconceptions = []Misconception{
 	// Number & Place Value (4)
 	{
@@ -147,4 +147,87 @@ var seedMisconceptions = []Misconception{
 		Description: "Computes perimeter when area is asked, or vice versa",
 		Examples:    []string{"area of 3×4 rectangle = 14 (perimeter)", "perimeter = 12 (area)"},
 	},
+
+	// Geometry (4)
+	{
+		ID:          "geo-orientation-bound",
+		Strand:      skillgraph.StrandGeometry,
+		Label:       "Orientation-bound shapes",
+		Description: "Judges a shape by how it is turned; thinks a tilted square is no longer a square",
+		Examples:    []string{"rotated square called a diamond, not a square", "upside-down triangle is not a triangle"},
+	},
+	{
+		ID:          "geo-angle-ray-length",
+		Strand:      skillgraph.StrandGeometry,
+		Label:       "Angle size from ray length",
+		Description: "Thinks an angle with longer arms is a bigger angle",
+		Examples:    []string{"30° angle with long rays called bigger than a 60° angle"},
+	},
+	{
+		ID:          "geo-protractor-scale",
+		Strand:      skillgraph.StrandGeometry,
+		Label:       "Wrong protractor scale",
+		Description: "Reads the inner instead of the outer protractor scale, giving the supplement",
+		Examples:    []string{"60° angle measured as 120°", "45° read as 135°"},
+	},
+	{
+		ID:          "geo-area-volume-units",
+		Strand:      skillgraph.StrandGeometry,
+		Label:       "Dimension mix-up",
+		Description: "Adds dimensions instead of multiplying, or gives square units for a volume",
+		Examples:    []string{"2×3×4 box volume = 9", "volume given in cm²"},
+	},
+
+	// Data & Statistics (3)
+	{
+		ID:          "data-scale-ignored",
+		Strand:      skillgraph.StrandData,
+		Label:       "Graph scale ignored",
+		Description: "Counts bars or pictures as ones when each stands for several",
+		Examples:    []string{"bar reaching the 4th line on a scale of 5 read as 4", "3 pictures at 10 each read as 3"},
+	},
+	{
+		ID:          "data-mean-median",
+		Strand:      skillgraph.StrandData,
+		Label:       "Mean/median confusion",
+		Description: "Uses the middle value for the mean, or forgets to sort before taking the median",
+		Examples:    []string{"median of 7, 2, 9 = 2", "mean given as the middle value"},
+	},
+	{
+		ID:          "data-mean-divisor",
+		Strand:      skillgraph.StrandData,
+		Label:       "Wrong divisor for mean",
+		Description: "Divides the total by the wrong count when finding the mean",
+		Examples:    []string{"mean of 4, 6, 8 = 18/2 = 9"},
+	},
+
+	// Ratios & Early Algebra (4)
+	{
+		ID:          "ratio-additive-thinking",
+		Strand:      skillgraph.StrandRatios,
+		Label:       "Additive ratio reasoning",
+		Description: "Scales a ratio by adding the same amount to both parts instead of multiplying",
+		Examples:    []string{"2:3 = 4:5", "3 cups for 2 people, so 5 cups for 4 people"},
+	},
+	{
+		ID:          "ratio-part-whole",
+		Strand:      skillgraph.StrandRatios,
+		Label:       "Part-part vs part-whole",
+		Description: "Confuses a part-to-part ratio with a part-to-whole fraction",
+		Examples:    []string{"3 red : 2 blue written as 3/2 of the total", "3:2 means red is 3/5, taken as 3/2"},
+	},
+	{
+		ID:          "ratio-percent-base",
+		Strand:      skillgraph.StrandRatios,
+		Label:       "Percent of the wrong base",
+		Description: "Takes the percent of the wrong quantity or treats a percent as a plain number",
+		Examples:    []string{"20% of 50 = 20", "50% of 30 = 80"},
+	},
+	{
+		ID:          "alg-equals-answer",
+		Strand:      skillgraph.StrandRatios,
+		Label:       "Equals sign as \"the answer\"",
+		Description: "Treats = as \"write the answer\" rather than \"same value\"",
+		Examples:    []string{"8 + 4 = _ + 5 answered 12", "3 + 5 = 8 + 2 = 10"},
+	},
 }
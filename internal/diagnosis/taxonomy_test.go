@@ -8,8 +8,8 @@ import (
 
 func TestAllMisconceptions_Count(t *testing.T) {
 	all := AllMisconceptions()
-	if len(all) != 19 {
-		t.Errorf("got %d misconceptions, want 19", len(all))
+	if len(all) != 30 {
+		t.Errorf("got %d misconceptions, want 30", len(all))
 	}
 }
 
@@ -46,6 +46,9 @@ func TestMisconceptionsByStrand(t *testing.T) {
 		{skillgraph.StrandMultDiv, 4},
 		{skillgraph.StrandFractions, 4},
 		{skillgraph.StrandMeasurement, 3},
+		{skillgraph.StrandGeometry, 4},
+		{skillgraph.StrandData, 3},
+		{skillgraph.StrandRatios, 4},
 	}
 
 	for _, tt := range tests {
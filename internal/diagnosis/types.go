@@ -17,7 +17,8 @@ type ClassifyInput struct {
 	Question       *problemgen.Question
 	LearnerAnswer  string
 	ResponseTimeMs int
-	SkillAccuracy  float64 // Historical accuracy for this skill (0.0–1.0)
+	SkillAccuracy  float64          // Historical accuracy for this skill (0.0–1.0)
+	Grade          problemgen.Grade // Graded result of LearnerAnswer
 }
 
 // DiagnosisResult is the output of classifying a wrong answer.
@@ -0,0 +1,36 @@
+package i18n
+
+import "fmt"
+
+// catalogs maps each non-English locale to its translations, keyed by the
+// English text.
+var catalogs = map[Locale]map[string]string{
+	Spanish: spanish,
+	French:  french,
+	German:  german,
+}
+
+// T translates msg, falling back to msg itself when the locale has no
+// translation for it.
+func (l Locale) T(msg string) string {
+	if t, ok := catalogs[l][msg]; ok {
+		return t
+	}
+	return msg
+}
+
+// Sprintf translates format, then formats it like fmt.Sprintf. Translations
+// may reorder the arguments with explicit indexes ("%[2]s").
+func (l Locale) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(l.T(format), args...)
+}
+
+// T translates msg into the process-wide locale.
+func T(msg string) string {
+	return active.T(msg)
+}
+
+// Sprintf formats a translated message in the process-wide locale.
+func Sprintf(format string, args ...any) string {
+	return active.Sprintf(format, args...)
+}
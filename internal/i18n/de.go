@@ -0,0 +1,180 @@
+package i18n
+
+var german = map[string]string{
+	// Session screen.
+	"Session":                      "Runde",
+	"Generating question...":       "Frage wird erstellt...",
+	"Preparing your session...":    "Deine Runde wird vorbereitet...",
+	"Slot %d/%d":                   "Abschnitt %d/%d",
+	"Review":                       "Wiederholung",
+	"Your Answer":                  "Deine Antwort",
+	"Answer:":                      "Antwort:",
+	"Blank %d":                     "Lücke %d",
+	"Correct!":                     "Richtig!",
+	"Not quite":                    "Nicht ganz",
+	"So close!":                    "Fast!",
+	"Correct answer: %s":           "Richtige Antwort: %s",
+	"Fluency: %.2f":                "Sicherheit: %.2f",
+	"Skill mastered!":              "Fähigkeit gemeistert!",
+	"\"%s\" — mastered!":           "„%s“ — gemeistert!",
+	"Skill recovered!":             "Fähigkeit zurückgewonnen!",
+	"\"%s\" — back to mastered!":   "„%s“ — wieder gemeistert!",
+	"Level up!":                    "Nächste Stufe!",
+	"\"%s\" — %s tier unlocked!":   "„%s“ — Stufe %s freigeschaltet!",
+	"\"%s\" — fully mastered!":     "„%s“ — vollständig gemeistert!",
+	"%s %s %s Gem":                 "%[1]s %[3]s-Edelstein (%[2]s)",
+	"Hint":                         "Tipp",
+	"Lesson: %s":                   "Lektion: %s",
+	"Worked Example":               "Durchgerechnetes Beispiel",
+	"Your Turn":                    "Du bist dran",
+	"End session early?":           "Runde vorzeitig beenden?",
+	"Your progress will be saved.": "Dein Fortschritt wird gespeichert.",
+	"[Y] Yes, end session":         "[Y] Ja, Runde beenden",
+	"[N] No, keep going":           "[N] Nein, weitermachen",
+	"Report this question?":        "Diese Frage melden?",
+	"[Y] Yes, report it":           "[Y] Ja, melden",
+	"[N] No, go back":              "[N] Nein, zurück",
+	"Error: %s":                    "Fehler: %s",
+	"Unable to Generate Problems":  "Aufgaben können nicht erstellt werden",
+	"Multiple attempts to generate a question have failed. This is likely due to an issue with the LLM provider. Your progress has been saved.": "Mehrere Versuche, eine Frage zu erstellen, sind fehlgeschlagen. Wahrscheinlich gibt es ein Problem beim LLM-Anbieter. Dein Fortschritt wurde gespeichert.",
+	"Could not generate question, trying next skill...":        "Frage konnte nicht erstellt werden, nächste Fähigkeit wird versucht...",
+	"Question reported — thanks for telling us!":               "Frage gemeldet — danke für den Hinweis!",
+	"Thanks for reporting that question — here's another one.": "Danke, dass du die Frage gemeldet hast — hier ist eine andere.",
+	"Couldn't send the report — please try again.":             "Die Meldung konnte nicht gesendet werden — versuch es noch einmal.",
+	"Only if it's wrong or confusing.":                         "Nur wenn sie falsch oder unklar ist.",
+	"Press any key to continue...":                             "Drücke eine beliebige Taste, um weiterzumachen...",
+	"Press any key to continue.":                               "Drücke eine beliebige Taste, um weiterzumachen.",
+	"Press any key to close...":                                "Drücke eine beliebige Taste zum Schließen...",
+	"Press any key to go back.":                                "Drücke eine beliebige Taste, um zurückzugehen.",
+	"Press any key to end the session.":                        "Drücke eine beliebige Taste, um die Runde zu beenden.",
+	"Select (1-%d) or use arrows + Enter":                      "Wähle (1-%d) oder nutze die Pfeiltasten + Enter",
+	"↑/↓ move · Space pick up/drop · Enter submit":             "↑/↓ bewegen · Leertaste aufnehmen/ablegen · Enter abgeben",
+	"Space or 1-9 toggle · Enter submit":                       "Leertaste oder 1-9 auswählen · Enter abgeben",
+	"Tab next blank · Enter submit":                            "Tab nächste Lücke · Enter abgeben",
+
+	// Key hints.
+	"any key":         "beliebige Taste",
+	"End session":     "Runde beenden",
+	"Keep going":      "Weitermachen",
+	"Close hint":      "Tipp schließen",
+	"Continue":        "Weiter",
+	"Submit":          "Abgeben",
+	"Skip":            "Überspringen",
+	"Report question": "Frage melden",
+	"Report & skip":   "Melden & überspringen",
+	"Go back":         "Zurück",
+	"Quit":            "Beenden",
+	"Home":            "Start",
+	"Choose":          "Auswählen",
+	"Select":          "Bestätigen",
+	"Finish early":    "Früher beenden",
+	"Pick up/drop":    "Aufnehmen/Ablegen",
+	"Toggle":          "An/Aus",
+	"Next blank":      "Nächste Lücke",
+
+	// Session summary.
+	"Session Summary":   "Rundenübersicht",
+	"Session complete!": "Runde geschafft!",
+	"Duration: %s":      "Dauer: %s",
+	"Questions: %d        Correct: %d        Accuracy: %s": "Fragen: %d        Richtig: %d        Genauigkeit: %s",
+	"Skills":        "Fähigkeiten",
+	"Gems":          "Edelsteine",
+	"%d/%d correct": "%d/%d richtig",
+	"frontier":      "Neuland",
+	"review":        "Wiederholung",
+	"booster":       "Auffrischung",
+
+	// Placement quiz.
+	"Placement":                       "Einstufung",
+	"Let's find your starting point!": "Finden wir deinen Startpunkt!",
+	"A quick quiz of up to %d questions skips the skills you already know.": "Ein kurzes Quiz mit bis zu %d Fragen überspringt die Fähigkeiten, die du schon kannst.",
+	"Take the placement quiz":                                    "Einstufungsquiz machen",
+	"Skip — start from the beginning":                            "Überspringen — von vorne beginnen",
+	"Placement quiz — question %d of up to %d":                   "Einstufungsquiz — Frage %d von bis zu %d",
+	"Having trouble generating a question, retrying...":          "Probleme beim Erstellen einer Frage, neuer Versuch...",
+	"Saving your placement...":                                   "Deine Einstufung wird gespeichert...",
+	"Could not save your placement: %s":                          "Deine Einstufung konnte nicht gespeichert werden: %s",
+	"No problem — you'll start from the beginning.":              "Kein Problem — du beginnst von vorne.",
+	"Thanks! You'll start from the first skills in each strand.": "Danke! Du beginnst mit den ersten Fähigkeiten jedes Bereichs.",
+	"Great work! %d skills marked as already known.":             "Toll gemacht! %d Fähigkeiten als schon bekannt markiert.",
+	"They'll come back now and then as quick reviews.":           "Sie kommen ab und zu als kurze Wiederholung zurück.",
+
+	// Home menu.
+	"START GAME": "SPIEL STARTEN",
+	"SKILL MAP":  "FÄHIGKEITEN",
+	"GEM VAULT":  "EDELSTEINE",
+	"HISTORY":    "VERLAUF",
+	"EXIT GAME":  "BEENDEN",
+
+	// Tiers, gems and answers.
+	"Learn":     "Lernen",
+	"Prove":     "Beweisen",
+	"Challenge": "Herausforderung",
+	"Mastery":   "Meisterschaft",
+	"Recovery":  "Comeback",
+	"Retention": "Gedächtnis",
+	"Streak":    "Serie",
+	"Common":    "Gewöhnlich",
+	"Rare":      "Selten",
+	"Epic":      "Episch",
+	"Legendary": "Legendär",
+	"True":      "Wahr",
+	"False":     "Falsch",
+
+	// Grade reasons.
+	"That's the right value, but it isn't in simplest form yet.":                "Das ist der richtige Wert, aber noch nicht vollständig gekürzt.",
+	"That's the right value — now write it as a mixed number in simplest form.": "Das ist der richtige Wert — schreib ihn jetzt als vollständig gekürzte gemischte Zahl.",
+	"Right number, but check the unit.":                                         "Die Zahl stimmt, aber prüfe die Einheit.",
+	"So close — check the order of your digits.":                                "Fast — prüfe die Reihenfolge deiner Ziffern.",
+
+	// Skill map.
+	"Skill Map":                 "Kompetenzkarte",
+	"Navigate":                  "Bewegen",
+	"Strand":                    "Bereich",
+	"Back":                      "Zurück",
+	"Locked":                    "Gesperrt",
+	"Available":                 "Verfügbar",
+	"Learning":                  "Lernphase",
+	"Proving":                   "Beweis",
+	"Mastered":                  "Gemeistert",
+	"Rusty":                     "Rostig",
+	"Unknown":                   "Unbekannt",
+	"Due":                       "Fällig",
+	"Shaky":                     "Wackelig",
+	"Graduated":                 "Absolviert",
+	"Grade %s":                  "Klasse %s",
+	"Strand:":                   "Bereich:",
+	"Grade:":                    "Klasse:",
+	"Standard:":                 "Standard:",
+	"Tier Requirements":         "Anforderungen je Stufe",
+	"%d questions, %s accuracy": "%d Fragen, %s Genauigkeit",
+	", %ds each":                ", je %d s",
+	"Prerequisites":             "Voraussetzungen",
+	"%s (rusty)":                "%s (rostig)",
+	"Unlocks":                   "Schaltet frei",
+	"Number & Place Value":      "Zahlen und Stellenwert",
+	"Addition & Subtraction":    "Addition und Subtraktion",
+	"Multiplication & Division": "Multiplikation und Division",
+	"Fractions":                 "Brüche",
+	"Measurement":               "Größen und Messen",
+	"Geometry":                  "Geometrie",
+	"Data & Statistics":         "Daten und Statistik",
+	"Ratios & Early Algebra":    "Verhältnisse und erste Algebra",
+	"Shaky foundation: a prerequisite has gone rusty, so a review is coming up": "Wackliges Fundament: Eine Voraussetzung ist eingerostet, bald steht eine Wiederholung an",
+
+	// Skill fluency.
+	"Fluency":                                "Rechenflüssigkeit",
+	"No answers yet.":                        "Noch keine Antworten.",
+	"Answers:":                               "Antworten:",
+	"Speed:":                                 "Tempo:",
+	"Accuracy:":                              "Genauigkeit:",
+	"Trend:":                                 "Trend:",
+	"%d, %.0f%% correct":                     "%d, %.0f%% richtig",
+	"typically %s":                           "meist %s",
+	"(fastest quarter %s, slowest tenth %s)": "(schnellstes Viertel %s, langsamstes Zehntel %s)",
+	"last %d practice days":                  "letzte %d Übungstage",
+	"Improving":                              "Wird besser",
+	"Slipping":                               "Lässt nach",
+	"Steady":                                 "Stabil",
+	"Needs %d answers":                       "Braucht %d Antworten",
+}
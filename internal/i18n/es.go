@@ -0,0 +1,180 @@
+package i18n
+
+var spanish = map[string]string{
+	// Session screen.
+	"Session":                      "Sesión",
+	"Generating question...":       "Creando la pregunta...",
+	"Preparing your session...":    "Preparando tu sesión...",
+	"Slot %d/%d":                   "Bloque %d/%d",
+	"Review":                       "Repaso",
+	"Your Answer":                  "Tu respuesta",
+	"Answer:":                      "Respuesta:",
+	"Blank %d":                     "Hueco %d",
+	"Correct!":                     "¡Correcto!",
+	"Not quite":                    "Casi, pero no",
+	"So close!":                    "¡Muy cerca!",
+	"Correct answer: %s":           "Respuesta correcta: %s",
+	"Fluency: %.2f":                "Fluidez: %.2f",
+	"Skill mastered!":              "¡Habilidad dominada!",
+	"\"%s\" — mastered!":           "\"%s\" — ¡dominada!",
+	"Skill recovered!":             "¡Habilidad recuperada!",
+	"\"%s\" — back to mastered!":   "\"%s\" — ¡dominada de nuevo!",
+	"Level up!":                    "¡Subes de nivel!",
+	"\"%s\" — %s tier unlocked!":   "\"%s\" — ¡nivel %s desbloqueado!",
+	"\"%s\" — fully mastered!":     "\"%s\" — ¡dominada por completo!",
+	"%s %s %s Gem":                 "%[1]s Gema de %[3]s (%[2]s)",
+	"Hint":                         "Pista",
+	"Lesson: %s":                   "Lección: %s",
+	"Worked Example":               "Ejemplo resuelto",
+	"Your Turn":                    "Te toca",
+	"End session early?":           "¿Terminar la sesión antes?",
+	"Your progress will be saved.": "Tu progreso se guardará.",
+	"[Y] Yes, end session":         "[Y] Sí, terminar la sesión",
+	"[N] No, keep going":           "[N] No, seguir jugando",
+	"Report this question?":        "¿Reportar esta pregunta?",
+	"[Y] Yes, report it":           "[Y] Sí, reportarla",
+	"[N] No, go back":              "[N] No, volver",
+	"Error: %s":                    "Error: %s",
+	"Unable to Generate Problems":  "No se pudieron crear problemas",
+	"Multiple attempts to generate a question have failed. This is likely due to an issue with the LLM provider. Your progress has been saved.": "Varios intentos de crear una pregunta han fallado. Seguramente hay un problema con el proveedor de LLM. Tu progreso se ha guardado.",
+	"Could not generate question, trying next skill...":        "No se pudo crear la pregunta, probando la siguiente habilidad...",
+	"Question reported — thanks for telling us!":               "Pregunta reportada — ¡gracias por avisarnos!",
+	"Thanks for reporting that question — here's another one.": "Gracias por reportar esa pregunta — aquí tienes otra.",
+	"Couldn't send the report — please try again.":             "No se pudo enviar el reporte — inténtalo de nuevo.",
+	"Only if it's wrong or confusing.":                         "Solo si está mal o no se entiende.",
+	"Press any key to continue...":                             "Pulsa cualquier tecla para continuar...",
+	"Press any key to continue.":                               "Pulsa cualquier tecla para continuar.",
+	"Press any key to close...":                                "Pulsa cualquier tecla para cerrar...",
+	"Press any key to go back.":                                "Pulsa cualquier tecla para volver.",
+	"Press any key to end the session.":                        "Pulsa cualquier tecla para terminar la sesión.",
+	"Select (1-%d) or use arrows + Enter":                      "Elige (1-%d) o usa las flechas + Enter",
+	"↑/↓ move · Space pick up/drop · Enter submit":             "↑/↓ mover · Espacio tomar/soltar · Enter enviar",
+	"Space or 1-9 toggle · Enter submit":                       "Espacio o 1-9 marcar · Enter enviar",
+	"Tab next blank · Enter submit":                            "Tab siguiente hueco · Enter enviar",
+
+	// Key hints.
+	"any key":         "cualquier tecla",
+	"End session":     "Terminar sesión",
+	"Keep going":      "Seguir",
+	"Close hint":      "Cerrar pista",
+	"Continue":        "Continuar",
+	"Submit":          "Enviar",
+	"Skip":            "Saltar",
+	"Report question": "Reportar pregunta",
+	"Report & skip":   "Reportar y saltar",
+	"Go back":         "Volver",
+	"Quit":            "Salir",
+	"Home":            "Inicio",
+	"Choose":          "Elegir",
+	"Select":          "Seleccionar",
+	"Finish early":    "Terminar antes",
+	"Pick up/drop":    "Tomar/soltar",
+	"Toggle":          "Marcar",
+	"Next blank":      "Siguiente hueco",
+
+	// Session summary.
+	"Session Summary":   "Resumen de la sesión",
+	"Session complete!": "¡Sesión completada!",
+	"Duration: %s":      "Duración: %s",
+	"Questions: %d        Correct: %d        Accuracy: %s": "Preguntas: %d        Correctas: %d        Precisión: %s",
+	"Skills":        "Habilidades",
+	"Gems":          "Gemas",
+	"%d/%d correct": "%d/%d correctas",
+	"frontier":      "nueva",
+	"review":        "repaso",
+	"booster":       "refuerzo",
+
+	// Placement quiz.
+	"Placement":                       "Nivelación",
+	"Let's find your starting point!": "¡Busquemos tu punto de partida!",
+	"A quick quiz of up to %d questions skips the skills you already know.": "Un test rápido de hasta %d preguntas salta las habilidades que ya sabes.",
+	"Take the placement quiz":                                    "Hacer el test de nivel",
+	"Skip — start from the beginning":                            "Saltar — empezar desde el principio",
+	"Placement quiz — question %d of up to %d":                   "Test de nivel — pregunta %d de hasta %d",
+	"Having trouble generating a question, retrying...":          "Cuesta crear la pregunta, reintentando...",
+	"Saving your placement...":                                   "Guardando tu nivel...",
+	"Could not save your placement: %s":                          "No se pudo guardar tu nivel: %s",
+	"No problem — you'll start from the beginning.":              "No pasa nada — empezarás desde el principio.",
+	"Thanks! You'll start from the first skills in each strand.": "¡Gracias! Empezarás por las primeras habilidades de cada área.",
+	"Great work! %d skills marked as already known.":             "¡Buen trabajo! %d habilidades marcadas como ya sabidas.",
+	"They'll come back now and then as quick reviews.":           "Volverán de vez en cuando como repasos rápidos.",
+
+	// Home menu.
+	"START GAME": "EMPEZAR",
+	"SKILL MAP":  "HABILIDADES",
+	"GEM VAULT":  "COFRE DE GEMAS",
+	"HISTORY":    "HISTORIAL",
+	"EXIT GAME":  "SALIR",
+
+	// Tiers, gems and answers.
+	"Learn":     "Aprender",
+	"Prove":     "Demostrar",
+	"Challenge": "Desafío",
+	"Mastery":   "Maestría",
+	"Recovery":  "Recuperación",
+	"Retention": "Retención",
+	"Streak":    "Racha",
+	"Common":    "Común",
+	"Rare":      "Rara",
+	"Epic":      "Épica",
+	"Legendary": "Legendaria",
+	"True":      "Verdadero",
+	"False":     "Falso",
+
+	// Grade reasons.
+	"That's the right value, but it isn't in simplest form yet.":                "Es el valor correcto, pero todavía no está simplificado.",
+	"That's the right value — now write it as a mixed number in simplest form.": "Es el valor correcto — ahora escríbelo como número mixto simplificado.",
+	"Right number, but check the unit.":                                         "El número es correcto, pero revisa la unidad.",
+	"So close — check the order of your digits.":                                "Muy cerca — revisa el orden de tus cifras.",
+
+	// Skill map.
+	"Skill Map":                 "Mapa de habilidades",
+	"Navigate":                  "Moverse",
+	"Strand":                    "Área",
+	"Back":                      "Volver",
+	"Locked":                    "Bloqueada",
+	"Available":                 "Disponible",
+	"Learning":                  "Aprendiendo",
+	"Proving":                   "Demostrando",
+	"Mastered":                  "Dominada",
+	"Rusty":                     "Oxidada",
+	"Unknown":                   "Desconocido",
+	"Due":                       "Repasar",
+	"Shaky":                     "Inestable",
+	"Graduated":                 "Graduada",
+	"Grade %s":                  "Curso %s",
+	"Strand:":                   "Área:",
+	"Grade:":                    "Curso:",
+	"Standard:":                 "Estándar:",
+	"Tier Requirements":         "Requisitos por nivel",
+	"%d questions, %s accuracy": "%d preguntas, %s de precisión",
+	", %ds each":                ", %d s cada una",
+	"Prerequisites":             "Requisitos previos",
+	"%s (rusty)":                "%s (oxidada)",
+	"Unlocks":                   "Desbloquea",
+	"Number & Place Value":      "Números y valor posicional",
+	"Addition & Subtraction":    "Suma y resta",
+	"Multiplication & Division": "Multiplicación y división",
+	"Fractions":                 "Fracciones",
+	"Measurement":               "Medición",
+	"Geometry":                  "Geometría",
+	"Data & Statistics":         "Datos y estadística",
+	"Ratios & Early Algebra":    "Razones y álgebra inicial",
+	"Shaky foundation: a prerequisite has gone rusty, so a review is coming up": "Base inestable: un requisito previo se ha oxidado, así que pronto toca un repaso",
+
+	// Skill fluency.
+	"Fluency":                                "Fluidez",
+	"No answers yet.":                        "Aún no hay respuestas.",
+	"Answers:":                               "Respuestas:",
+	"Speed:":                                 "Velocidad:",
+	"Accuracy:":                              "Precisión:",
+	"Trend:":                                 "Tendencia:",
+	"%d, %.0f%% correct":                     "%d, %.0f%% correctas",
+	"typically %s":                           "normalmente %s",
+	"(fastest quarter %s, slowest tenth %s)": "(el cuarto más rápido %s, la décima más lenta %s)",
+	"last %d practice days":                  "últimos %d días de práctica",
+	"Improving":                              "Mejorando",
+	"Slipping":                               "Empeorando",
+	"Steady":                                 "Estable",
+	"Needs %d answers":                       "Necesita %d respuestas",
+}
@@ -0,0 +1,180 @@
+package i18n
+
+var french = map[string]string{
+	// Session screen.
+	"Session":                      "Séance",
+	"Generating question...":       "Création de la question...",
+	"Preparing your session...":    "Préparation de ta séance...",
+	"Slot %d/%d":                   "Étape %d/%d",
+	"Review":                       "Révision",
+	"Your Answer":                  "Ta réponse",
+	"Answer:":                      "Réponse :",
+	"Blank %d":                     "Case %d",
+	"Correct!":                     "Bonne réponse !",
+	"Not quite":                    "Pas tout à fait",
+	"So close!":                    "Presque !",
+	"Correct answer: %s":           "Bonne réponse : %s",
+	"Fluency: %.2f":                "Aisance : %.2f",
+	"Skill mastered!":              "Compétence maîtrisée !",
+	"\"%s\" — mastered!":           "« %s » — maîtrisée !",
+	"Skill recovered!":             "Compétence retrouvée !",
+	"\"%s\" — back to mastered!":   "« %s » — de nouveau maîtrisée !",
+	"Level up!":                    "Niveau supérieur !",
+	"\"%s\" — %s tier unlocked!":   "« %s » — niveau %s débloqué !",
+	"\"%s\" — fully mastered!":     "« %s » — entièrement maîtrisée !",
+	"%s %s %s Gem":                 "%[1]s Gemme de %[3]s (%[2]s)",
+	"Hint":                         "Indice",
+	"Lesson: %s":                   "Leçon : %s",
+	"Worked Example":               "Exemple résolu",
+	"Your Turn":                    "À toi",
+	"End session early?":           "Terminer la séance maintenant ?",
+	"Your progress will be saved.": "Ta progression sera enregistrée.",
+	"[Y] Yes, end session":         "[Y] Oui, terminer la séance",
+	"[N] No, keep going":           "[N] Non, continuer",
+	"Report this question?":        "Signaler cette question ?",
+	"[Y] Yes, report it":           "[Y] Oui, la signaler",
+	"[N] No, go back":              "[N] Non, revenir",
+	"Error: %s":                    "Erreur : %s",
+	"Unable to Generate Problems":  "Impossible de créer des problèmes",
+	"Multiple attempts to generate a question have failed. This is likely due to an issue with the LLM provider. Your progress has been saved.": "Plusieurs tentatives de création de question ont échoué. C'est sans doute un problème chez le fournisseur de LLM. Ta progression a été enregistrée.",
+	"Could not generate question, trying next skill...":        "Impossible de créer la question, passage à la compétence suivante...",
+	"Question reported — thanks for telling us!":               "Question signalée — merci de nous avoir prévenus !",
+	"Thanks for reporting that question — here's another one.": "Merci d'avoir signalé cette question — en voici une autre.",
+	"Couldn't send the report — please try again.":             "Le signalement n'a pas pu être envoyé — réessaie.",
+	"Only if it's wrong or confusing.":                         "Seulement si elle est fausse ou pas claire.",
+	"Press any key to continue...":                             "Appuie sur une touche pour continuer...",
+	"Press any key to continue.":                               "Appuie sur une touche pour continuer.",
+	"Press any key to close...":                                "Appuie sur une touche pour fermer...",
+	"Press any key to go back.":                                "Appuie sur une touche pour revenir.",
+	"Press any key to end the session.":                        "Appuie sur une touche pour terminer la séance.",
+	"Select (1-%d) or use arrows + Enter":                      "Choisis (1-%d) ou utilise les flèches + Entrée",
+	"↑/↓ move · Space pick up/drop · Enter submit":             "↑/↓ déplacer · Espace prendre/poser · Entrée valider",
+	"Space or 1-9 toggle · Enter submit":                       "Espace ou 1-9 cocher · Entrée valider",
+	"Tab next blank · Enter submit":                            "Tab case suivante · Entrée valider",
+
+	// Key hints.
+	"any key":         "une touche",
+	"End session":     "Terminer",
+	"Keep going":      "Continuer",
+	"Close hint":      "Fermer l'indice",
+	"Continue":        "Continuer",
+	"Submit":          "Valider",
+	"Skip":            "Passer",
+	"Report question": "Signaler la question",
+	"Report & skip":   "Signaler et passer",
+	"Go back":         "Revenir",
+	"Quit":            "Quitter",
+	"Home":            "Accueil",
+	"Choose":          "Choisir",
+	"Select":          "Sélectionner",
+	"Finish early":    "Finir maintenant",
+	"Pick up/drop":    "Prendre/poser",
+	"Toggle":          "Cocher",
+	"Next blank":      "Case suivante",
+
+	// Session summary.
+	"Session Summary":   "Bilan de la séance",
+	"Session complete!": "Séance terminée !",
+	"Duration: %s":      "Durée : %s",
+	"Questions: %d        Correct: %d        Accuracy: %s": "Questions : %d        Justes : %d        Précision : %s",
+	"Skills":        "Compétences",
+	"Gems":          "Gemmes",
+	"%d/%d correct": "%d/%d justes",
+	"frontier":      "nouveauté",
+	"review":        "révision",
+	"booster":       "renfort",
+
+	// Placement quiz.
+	"Placement":                       "Positionnement",
+	"Let's find your starting point!": "Trouvons ton point de départ !",
+	"A quick quiz of up to %d questions skips the skills you already know.": "Un petit quiz de %d questions maximum saute les compétences que tu connais déjà.",
+	"Take the placement quiz":                                    "Faire le quiz de positionnement",
+	"Skip — start from the beginning":                            "Passer — commencer au début",
+	"Placement quiz — question %d of up to %d":                   "Quiz de positionnement — question %d sur %d maximum",
+	"Having trouble generating a question, retrying...":          "Difficile de créer une question, nouvel essai...",
+	"Saving your placement...":                                   "Enregistrement de ton niveau...",
+	"Could not save your placement: %s":                          "Impossible d'enregistrer ton niveau : %s",
+	"No problem — you'll start from the beginning.":              "Pas de souci — tu commenceras au début.",
+	"Thanks! You'll start from the first skills in each strand.": "Merci ! Tu commenceras par les premières compétences de chaque domaine.",
+	"Great work! %d skills marked as already known.":             "Super travail ! %d compétences marquées comme déjà acquises.",
+	"They'll come back now and then as quick reviews.":           "Elles reviendront de temps en temps en révision rapide.",
+
+	// Home menu.
+	"START GAME": "JOUER",
+	"SKILL MAP":  "COMPÉTENCES",
+	"GEM VAULT":  "COFFRE À GEMMES",
+	"HISTORY":    "HISTORIQUE",
+	"EXIT GAME":  "QUITTER",
+
+	// Tiers, gems and answers.
+	"Learn":     "Apprendre",
+	"Prove":     "Prouver",
+	"Challenge": "Défi",
+	"Mastery":   "Maîtrise",
+	"Recovery":  "Rattrapage",
+	"Retention": "Mémoire",
+	"Streak":    "Série",
+	"Common":    "Commune",
+	"Rare":      "Rare",
+	"Epic":      "Épique",
+	"Legendary": "Légendaire",
+	"True":      "Vrai",
+	"False":     "Faux",
+
+	// Grade reasons.
+	"That's the right value, but it isn't in simplest form yet.":                "C'est la bonne valeur, mais elle n'est pas encore simplifiée.",
+	"That's the right value — now write it as a mixed number in simplest form.": "C'est la bonne valeur — écris-la maintenant en nombre mixte simplifié.",
+	"Right number, but check the unit.":                                         "Le nombre est juste, mais vérifie l'unité.",
+	"So close — check the order of your digits.":                                "Presque — vérifie l'ordre de tes chiffres.",
+
+	// Skill map.
+	"Skill Map":                 "Carte des compétences",
+	"Navigate":                  "Naviguer",
+	"Strand":                    "Domaine",
+	"Back":                      "Retour",
+	"Locked":                    "Verrouillée",
+	"Available":                 "Disponible",
+	"Learning":                  "En cours",
+	"Proving":                   "Preuve",
+	"Mastered":                  "Maîtrisée",
+	"Rusty":                     "Rouillée",
+	"Unknown":                   "Inconnu",
+	"Due":                       "À revoir",
+	"Shaky":                     "Fragile",
+	"Graduated":                 "Diplômée",
+	"Grade %s":                  "Classe %s",
+	"Strand:":                   "Domaine :",
+	"Grade:":                    "Classe :",
+	"Standard:":                 "Norme :",
+	"Tier Requirements":         "Exigences par niveau",
+	"%d questions, %s accuracy": "%d questions, %s de réussite",
+	", %ds each":                ", %d s chacune",
+	"Prerequisites":             "Prérequis",
+	"%s (rusty)":                "%s (rouillée)",
+	"Unlocks":                   "Débloque",
+	"Number & Place Value":      "Nombres et numération",
+	"Addition & Subtraction":    "Addition et soustraction",
+	"Multiplication & Division": "Multiplication et division",
+	"Fractions":                 "Fractions",
+	"Measurement":               "Mesures",
+	"Geometry":                  "Géométrie",
+	"Data & Statistics":         "Données et statistiques",
+	"Ratios & Early Algebra":    "Proportions et pré-algèbre",
+	"Shaky foundation: a prerequisite has gone rusty, so a review is coming up": "Base fragile : un prérequis s'est rouillé, une révision arrive bientôt",
+
+	// Skill fluency.
+	"Fluency":                                "Aisance",
+	"No answers yet.":                        "Pas encore de réponses.",
+	"Answers:":                               "Réponses :",
+	"Speed:":                                 "Vitesse :",
+	"Accuracy:":                              "Précision :",
+	"Trend:":                                 "Tendance :",
+	"%d, %.0f%% correct":                     "%d, %.0f%% justes",
+	"typically %s":                           "en général %s",
+	"(fastest quarter %s, slowest tenth %s)": "(quart le plus rapide %s, dixième le plus lent %s)",
+	"last %d practice days":                  "%d derniers jours de pratique",
+	"Improving":                              "En progrès",
+	"Slipping":                               "En baisse",
+	"Steady":                                 "Stable",
+	"Needs %d answers":                       "Il faut %d réponses",
+}
@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Locale
+	}{
+		{"", English},
+		{"es", Spanish},
+		{"FR", French},
+		{"de-AT", German},
+		{"es_MX.UTF-8", Spanish},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := Parse("xx"); err == nil {
+		t.Error("Parse(xx) succeeded")
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	if got := Spanish.FormatNumbers("3.5 kg; $4.75; 3:45 pm."); got != "3,5 kg; $4,75; 3:45 pm." {
+		t.Errorf("FormatNumbers = %q", got)
+	}
+	if got := German.ParseNumbers("2,25 + 1, 2"); got != "2.25 + 1, 2" {
+		t.Errorf("ParseNumbers = %q", got)
+	}
+	if got := English.ParseNumbers("1,000"); got != "1,000" {
+		t.Errorf("English ParseNumbers = %q, want unchanged", got)
+	}
+}
+
+func TestT_FallsBackToEnglish(t *testing.T) {
+	if got := Spanish.T("Correct!"); got != "¡Correcto!" {
+		t.Errorf("T = %q", got)
+	}
+	if got := Spanish.T("no such message"); got != "no such message" {
+		t.Errorf("untranslated T = %q", got)
+	}
+	if got := Locale("").T("Correct!"); got != "Correct!" {
+		t.Errorf("zero locale T = %q", got)
+	}
+}
+
+// verb matches a fmt verb, with an optional argument index and precision.
+var verb = regexp.MustCompile(`%(\[\d+\])?(\.\d+)?[a-z%]`)
+
+// TestCatalogs checks that every locale translates the same messages, and
+// that each translation formats the arguments its English key takes.
+func TestCatalogs(t *testing.T) {
+	keys := slices.Sorted(maps.Keys(spanish))
+	for loc, catalog := range catalogs {
+		if got := slices.Sorted(maps.Keys(catalog)); !slices.Equal(got, keys) {
+			t.Errorf("%s catalog keys differ from Spanish", loc)
+		}
+		for key, msg := range catalog {
+			args := sampleArgs(key)
+			if out := fmt.Sprintf(msg, args...); strings.Contains(out, "%!") {
+				t.Errorf("%s %q formats badly: %q", loc, key, out)
+			}
+			if strings.TrimSpace(msg) == "" {
+				t.Errorf("%s %q is empty", loc, key)
+			}
+		}
+	}
+}
+
+// sampleArgs returns one argument of the right type for each verb in an
+// English format string.
+func sampleArgs(format string) []any {
+	var args []any
+	for _, v := range verb.FindAllString(format, -1) {
+		switch v[len(v)-1] {
+		case 'd':
+			args = append(args, 1)
+		case 'f':
+			args = append(args, 1.0)
+		case 's':
+			args = append(args, "x")
+		}
+	}
+	return args
+}
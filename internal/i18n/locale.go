@@ -0,0 +1,149 @@
+// Package i18n holds the learner's locale: the language questions, lessons
+// and the learner-facing UI are written in, and how numbers are written.
+//
+// Translations are keyed by their English text, so a string without a
+// translation falls back to English instead of showing a message ID.
+package i18n
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Locale identifies a learner language by its ISO 639-1 code, such as "es".
+// The zero value is English.
+type Locale string
+
+const (
+	English Locale = "en"
+	Spanish Locale = "es"
+	French  Locale = "fr"
+	German  Locale = "de"
+)
+
+// DefaultLocale is used when a learner has not picked a language.
+const DefaultLocale = English
+
+// All returns the supported locales in display order.
+func All() []Locale {
+	return []Locale{English, Spanish, French, German}
+}
+
+// Parse resolves a locale ID. Region and encoding suffixes are ignored, so
+// "es-MX" and "es_MX.UTF-8" are both Spanish. The empty string yields
+// DefaultLocale.
+func Parse(s string) (Locale, error) {
+	if s == "" {
+		return DefaultLocale, nil
+	}
+	code := strings.ToLower(s)
+	if i := strings.IndexAny(code, "-_."); i >= 0 {
+		code = code[:i]
+	}
+	l := Locale(code)
+	if !slices.Contains(All(), l) {
+		return "", fmt.Errorf("unknown language %q (available: %s)", s, localeList())
+	}
+	return l, nil
+}
+
+func localeList() string {
+	ids := make([]string, 0, len(All()))
+	for _, l := range All() {
+		ids = append(ids, string(l))
+	}
+	return strings.Join(ids, ", ")
+}
+
+// Name returns the language's English name, as used in LLM prompts.
+func (l Locale) Name() string {
+	switch l {
+	case Spanish:
+		return "Spanish"
+	case French:
+		return "French"
+	case German:
+		return "German"
+	case English, "":
+		return "English"
+	default:
+		return string(l)
+	}
+}
+
+// NativeName returns the language's name in itself, for language pickers.
+func (l Locale) NativeName() string {
+	switch l {
+	case Spanish:
+		return "Español"
+	case French:
+		return "Français"
+	case German:
+		return "Deutsch"
+	case English, "":
+		return "English"
+	default:
+		return string(l)
+	}
+}
+
+// DecimalComma reports whether the locale writes decimals with a comma
+// ("3,5") rather than a point ("3.5").
+func (l Locale) DecimalComma() bool {
+	switch l {
+	case Spanish, French, German:
+		return true
+	}
+	return false
+}
+
+// FormatNumbers rewrites the decimal points in s for display: "3.5 kg"
+// becomes "3,5 kg" in a decimal-comma locale. Only a point between two
+// digits is a decimal point; the rest of s is left alone.
+func (l Locale) FormatNumbers(s string) string {
+	if !l.DecimalComma() {
+		return s
+	}
+	return swapBetweenDigits(s, '.', ',')
+}
+
+// ParseNumbers is the inverse of FormatNumbers: it turns a learner's
+// decimal commas back into points, so "3,5" reads as 3.5. Thousands
+// separators are not supported in any locale, so the comma is never
+// ambiguous.
+func (l Locale) ParseNumbers(s string) string {
+	if !l.DecimalComma() {
+		return s
+	}
+	return swapBetweenDigits(s, ',', '.')
+}
+
+func swapBetweenDigits(s string, from, to byte) string {
+	if !strings.ContainsRune(s, rune(from)) {
+		return s
+	}
+	b := []byte(s)
+	for i := 1; i+1 < len(b); i++ {
+		if b[i] == from && isDigit(b[i-1]) && isDigit(b[i+1]) {
+			b[i] = to
+		}
+	}
+	return string(b)
+}
+
+func isDigit(b byte) bool { return b >= '0' && b <= '9' }
+
+// active is the process-wide locale, set once at startup. Hosted mode
+// stores a locale per child and passes it explicitly instead.
+var active = DefaultLocale
+
+// Use sets the process-wide locale. Call it once at startup.
+func Use(l Locale) {
+	active = l
+}
+
+// Active returns the process-wide locale.
+func Active() Locale {
+	return active
+}
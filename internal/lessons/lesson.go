@@ -13,6 +13,7 @@ func (l *Lesson) GradePractice(answer string) bool {
 		Answer:     l.PracticeQuestion.Answer,
 		AnswerType: problemgen.AnswerType(l.PracticeQuestion.AnswerType),
 		Format:     problemgen.FormatNumeric,
+		Locale:     l.Locale,
 	})
 }
 
@@ -3,16 +3,19 @@ package lessons
 import (
 	"fmt"
 	"strings"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
-const lessonSystemPrompt = `You are a patient, encouraging math tutor for children in grades 3-5. A student is struggling with a math concept and needs a short, clear lesson.`
+const lessonSystemPrompt = `You are a patient, encouraging math tutor for children in grades K-6. A student is struggling with a math concept and needs a short, clear lesson.`
 
 func buildLessonUserMessage(input LessonInput) string {
 	var b strings.Builder
 
 	b.WriteString(fmt.Sprintf("Skill: %s\n", input.Skill.Name))
 	b.WriteString(fmt.Sprintf("Description: %s\n", input.Skill.Description))
-	b.WriteString(fmt.Sprintf("Grade: %d\n", input.Skill.GradeLevel))
+	b.WriteString(fmt.Sprintf("Grade: %s\n", skillgraph.GradeLabel(input.Skill.GradeLevel)))
 	b.WriteString(fmt.Sprintf("Student accuracy on this skill: %.0f%%\n", input.Accuracy*100))
 
 	b.WriteString("\nRecent Errors:\n")
@@ -40,6 +43,11 @@ Create a micro-lesson that:
 4. The practice question must have a single correct answer. Provide a brief explanation for the practice answer.
 5. Use plain ASCII text for all math. No LaTeX, no Unicode symbols. Use / for fractions, * for multiplication.`)
 
+	if input.Locale != "" && input.Locale != i18n.English {
+		b.WriteString(fmt.Sprintf(`
+6. Write the title, explanation, worked example and practice question in %s, using its decimal separator in the text. Write the practice answer with a decimal point.`, input.Locale.Name()))
+	}
+
 	return b.String()
 }
 
@@ -65,7 +73,7 @@ Keep the summary concise and factual. Do not include encouragement or advice —
 	return b.String()
 }
 
-const profileSystemPrompt = `You are creating a learner profile for a math tutoring system. This profile helps personalize future practice sessions for a student in grades 3-5.`
+const profileSystemPrompt = `You are creating a learner profile for a math tutoring system. This profile helps personalize future practice sessions for a student in grades K-6.`
 
 func buildProfileUserMessage(input ProfileInput) string {
 	var b strings.Builder
@@ -104,5 +104,6 @@ func (s *Service) generate(ctx context.Context, input LessonInput) (*Lesson, err
 			AnswerType:  out.PracticeQuestion.AnswerType,
 			Explanation: out.PracticeQuestion.Explanation,
 		},
+		Locale: input.Locale,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/abhisek/mathiz/internal/diagnosis"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
@@ -14,6 +15,10 @@ type Lesson struct {
 	Explanation      string
 	WorkedExample    string
 	PracticeQuestion PracticeQuestion
+
+	// Locale is the language the lesson is written in. The practice answer
+	// uses a decimal point; answers are read in this locale.
+	Locale i18n.Locale
 }
 
 // PracticeQuestion is a mini-practice embedded in a lesson.
@@ -31,6 +36,7 @@ type LessonInput struct {
 	RecentErrors  []string
 	LastDiagnosis *diagnosis.DiagnosisResult
 	Accuracy      float64
+	Locale        i18n.Locale // language to write the lesson in; zero is English
 }
 
 // LearnerProfile is a holistic summary of the learner's patterns.
@@ -3,6 +3,8 @@ package llm
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -17,6 +19,7 @@ type Config struct {
 	Gemini     GeminiConfig
 	OpenRouter OpenRouterConfig
 	Retry      RetryConfig
+	Judge      JudgeConfig
 
 	// Timeout is the maximum duration for a single LLM request
 	// (including retries). Default: 30s.
@@ -49,6 +52,19 @@ type OpenRouterConfig struct {
 	BaseURL string // Default: "https://openrouter.ai/api/v1"
 }
 
+// JudgeConfig configures the optional judge: a second model that solves
+// generated questions independently before a learner sees them.
+type JudgeConfig struct {
+	// Purposes lists the generation purposes whose questions are judged,
+	// e.g. "question-gen" and "quest-gen". Empty disables the judge.
+	Purposes []string
+
+	// Provider and Model select the judge. Empty values reuse the main
+	// provider and its configured model.
+	Provider string
+	Model    string
+}
+
 // RetryConfig configures retry behavior for transient failures.
 type RetryConfig struct {
 	MaxAttempts int
@@ -123,6 +139,16 @@ func ConfigFromEnv() Config {
 		cfg.OpenRouter.Model = m
 	}
 
+	if p := os.Getenv("MATHIZ_JUDGE_PURPOSES"); p != "" {
+		for _, purpose := range strings.Split(p, ",") {
+			if purpose = strings.TrimSpace(purpose); purpose != "" {
+				cfg.Judge.Purposes = append(cfg.Judge.Purposes, purpose)
+			}
+		}
+	}
+	cfg.Judge.Provider = os.Getenv("MATHIZ_JUDGE_PROVIDER")
+	cfg.Judge.Model = os.Getenv("MATHIZ_JUDGE_MODEL")
+
 	return cfg
 }
 
@@ -137,24 +163,7 @@ func DiscoverConfig() (Config, bool) {
 	// env var — MATHIZ_LLM_PROVIDER=openai + OPENAI_API_KEY is documented
 	// and worked before this branch existed.
 	if os.Getenv("MATHIZ_LLM_PROVIDER") != "" {
-		switch cfg.Provider {
-		case "gemini":
-			if cfg.Gemini.APIKey == "" {
-				cfg.Gemini.APIKey = os.Getenv("GEMINI_API_KEY")
-			}
-		case "openai":
-			if cfg.OpenAI.APIKey == "" {
-				cfg.OpenAI.APIKey = os.Getenv("OPENAI_API_KEY")
-			}
-		case "anthropic":
-			if cfg.Anthropic.APIKey == "" {
-				cfg.Anthropic.APIKey = os.Getenv("ANTHROPIC_API_KEY")
-			}
-		case "openrouter":
-			if cfg.OpenRouter.APIKey == "" {
-				cfg.OpenRouter.APIKey = os.Getenv("OPENROUTER_API_KEY")
-			}
-		}
+		cfg.useBareKey()
 		if cfg.Provider == "mock" || cfg.Validate() == nil {
 			return cfg, true
 		}
@@ -185,6 +194,58 @@ func DiscoverConfig() (Config, bool) {
 	return Config{}, false
 }
 
+// useBareKey fills the selected provider's API key from its standard env
+// var (e.g. OPENAI_API_KEY) when no MATHIZ_* key is set.
+func (c *Config) useBareKey() {
+	switch c.Provider {
+	case "gemini":
+		if c.Gemini.APIKey == "" {
+			c.Gemini.APIKey = os.Getenv("GEMINI_API_KEY")
+		}
+	case "openai":
+		if c.OpenAI.APIKey == "" {
+			c.OpenAI.APIKey = os.Getenv("OPENAI_API_KEY")
+		}
+	case "anthropic":
+		if c.Anthropic.APIKey == "" {
+			c.Anthropic.APIKey = os.Getenv("ANTHROPIC_API_KEY")
+		}
+	case "openrouter":
+		if c.OpenRouter.APIKey == "" {
+			c.OpenRouter.APIKey = os.Getenv("OPENROUTER_API_KEY")
+		}
+	}
+}
+
+// JudgeEnabled reports whether questions generated for purpose are judged.
+func (c Config) JudgeEnabled(purpose string) bool {
+	return slices.Contains(c.Judge.Purposes, purpose)
+}
+
+// JudgeProviderConfig returns the provider configuration for the judge:
+// this config with the judge's provider and model swapped in.
+func (c Config) JudgeProviderConfig() Config {
+	j := c
+	j.Judge = JudgeConfig{}
+	if c.Judge.Provider != "" {
+		j.Provider = c.Judge.Provider
+		j.useBareKey()
+	}
+	if m := c.Judge.Model; m != "" {
+		switch j.Provider {
+		case "anthropic":
+			j.Anthropic.Model = m
+		case "openai":
+			j.OpenAI.Model = m
+		case "gemini":
+			j.Gemini.Model = m
+		case "openrouter":
+			j.OpenRouter.Model = m
+		}
+	}
+	return j
+}
+
 // Validate checks that the selected provider has its required API key set.
 func (c Config) Validate() error {
 	switch c.Provider {
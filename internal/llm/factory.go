@@ -56,3 +56,18 @@ func NewProviderFromEnv(ctx context.Context, eventRepo store.EventRepo) (Provide
 	}
 	return NewProvider(ctx, cfg, eventRepo)
 }
+
+// NewJudgeProviderFromEnv creates the judge provider for questions generated
+// for purpose (see JudgeConfig). It returns nil, nil when no judge is
+// configured for that purpose.
+func NewJudgeProviderFromEnv(ctx context.Context, eventRepo store.EventRepo, purpose string) (Provider, error) {
+	cfg, ok := DiscoverConfig()
+	if !ok || !cfg.JudgeEnabled(purpose) {
+		return nil, nil
+	}
+	judge := cfg.JudgeProviderConfig()
+	if err := judge.Validate(); err != nil {
+		return nil, fmt.Errorf("judge: %w", err)
+	}
+	return NewProvider(ctx, judge, eventRepo)
+}
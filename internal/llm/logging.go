@@ -65,8 +65,11 @@ func (l *LoggingProvider) Generate(ctx context.Context, req Request) (*Response,
 	// write gets a short deadline of its own so it can't hang either.
 	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), logWriteTimeout)
 	defer cancel()
-	if logErr := l.eventRepo.AppendLLMRequest(writeCtx, data); logErr != nil {
+	id, logErr := l.eventRepo.AppendLLMRequest(writeCtx, data)
+	if logErr != nil {
 		slog.Error("llm: log request event", "purpose", purpose, "err", logErr)
+	} else if resp != nil {
+		resp.RequestID = id
 	}
 
 	return resp, err
@@ -84,6 +84,10 @@ type Response struct {
 	// StopReason indicates why generation stopped.
 	// Normalized to: "end", "max_tokens", "error"
 	StopReason string
+
+	// RequestID is the ID of the LLM request event that logged this
+	// response, or 0 when the provider isn't wrapped WithLogging.
+	RequestID int
 }
 
 // Usage tracks token consumption for a single request.
@@ -148,3 +148,28 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Judge(t *testing.T) {
+	t.Setenv("MATHIZ_LLM_PROVIDER", "gemini")
+	t.Setenv("MATHIZ_GEMINI_API_KEY", "gm-test")
+	t.Setenv("MATHIZ_JUDGE_PURPOSES", "question-gen, quest-gen")
+	t.Setenv("MATHIZ_JUDGE_PROVIDER", "openai")
+	t.Setenv("MATHIZ_JUDGE_MODEL", "gpt-4o")
+	t.Setenv("OPENAI_API_KEY", "sk-test")
+
+	cfg := ConfigFromEnv()
+	if !cfg.JudgeEnabled(PurposeQuestionGen) || !cfg.JudgeEnabled(PurposeQuestGen) || cfg.JudgeEnabled(PurposeLesson) {
+		t.Errorf("judge purposes = %v", cfg.Judge.Purposes)
+	}
+
+	judge := cfg.JudgeProviderConfig()
+	if judge.Provider != "openai" || judge.OpenAI.Model != "gpt-4o" || judge.OpenAI.APIKey != "sk-test" {
+		t.Errorf("judge config = %+v", judge)
+	}
+	if err := judge.Validate(); err != nil {
+		t.Errorf("judge config invalid: %v", err)
+	}
+	if cfg.Provider != "gemini" {
+		t.Errorf("main provider changed to %q", cfg.Provider)
+	}
+}
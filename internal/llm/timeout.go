@@ -15,6 +15,7 @@ const (
 	PurposeDiagnosis       = "error-diagnosis"
 	PurposeSessionCompress = "session-compress"
 	PurposeProfile         = "profile"
+	PurposeJudge           = "question-judge"
 )
 
 // purposeTimeouts bounds a single LLM attempt, by what the call is for. One
@@ -28,6 +29,8 @@ const (
 //   - profile and session-compress must finish inside the caller's own 60s
 //     budget (session.profileTimeout) — a per-attempt cap at or above that
 //     would let the outer deadline fire mid-retry instead.
+//   - question-judge runs right after question-gen, on the same spinner, so
+//     it shares the fail-fast budget.
 //   - diagnosis and lesson are background work nobody waits on, but were
 //     previously unbounded: they run on context.Background().
 //
@@ -39,6 +42,7 @@ var purposeTimeouts = map[string]time.Duration{
 	PurposeDiagnosis:       30 * time.Second,
 	PurposeSessionCompress: 25 * time.Second,
 	PurposeProfile:         25 * time.Second,
+	PurposeJudge:           20 * time.Second,
 }
 
 // defaultTimeout applies when the configured fallback is unset. A zero
@@ -0,0 +1,183 @@
+package mastery
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// Trend is the direction a skill's fluency is moving in.
+type Trend string
+
+const (
+	TrendImproving Trend = "improving"
+	TrendStable    Trend = "stable"
+	TrendDeclining Trend = "declining"
+	TrendUnknown   Trend = "unknown" // fewer than MinTrendAnswers answers
+)
+
+const (
+	// TrendWindow is how many of a skill's latest answers the trend looks at.
+	TrendWindow = 20
+
+	// MinTrendAnswers is the fewest answers a trend is computed from.
+	MinTrendAnswers = 8
+
+	// trendThreshold is how far the fluency of the later half of the window
+	// must move from the earlier half to count as a change.
+	trendThreshold = 0.05
+)
+
+// SkillAnalytics summarises a skill's full answer history. FluencyMetrics
+// only keeps a short rolling window for scoring; this is the long view for
+// learners and parents.
+type SkillAnalytics struct {
+	SkillID string
+	Answers int
+	Correct int
+
+	// Response-time percentiles in milliseconds, over the answers with a
+	// recorded time. Zero when there are none.
+	P25Ms int
+	P50Ms int
+	P90Ms int
+
+	// Daily is the accuracy on each day the skill was practised, oldest
+	// first.
+	Daily []DailyAccuracy
+
+	// Trend compares the fluency of the older and newer halves of the last
+	// TrendWindow answers, each scored like FluencyScore from accuracy and
+	// speed.
+	Trend Trend
+}
+
+// DailyAccuracy is a skill's accuracy on one day.
+type DailyAccuracy struct {
+	Day     time.Time // midnight, in the answers' time zone
+	Answers int
+	Correct int
+}
+
+// Accuracy returns the fraction of the day's answers that were correct.
+func (d DailyAccuracy) Accuracy() float64 {
+	if d.Answers == 0 {
+		return 0
+	}
+	return float64(d.Correct) / float64(d.Answers)
+}
+
+// Accuracy returns the fraction of all answers that were correct.
+func (a *SkillAnalytics) Accuracy() float64 {
+	if a.Answers == 0 {
+		return 0
+	}
+	return float64(a.Correct) / float64(a.Answers)
+}
+
+// Analyze computes analytics per skill from answer events, oldest first.
+// Answers recorded against a skill ID the graph has since renamed, split or
+// merged count towards the skills that replaced it.
+func Analyze(answers []store.AnswerEventRecord) map[string]*SkillAnalytics {
+	bySkill := make(map[string][]store.AnswerEventRecord)
+	for _, a := range answers {
+		for _, id := range skillgraph.CurrentIDs(a.SkillID) {
+			a.SkillID = id
+			bySkill[id] = append(bySkill[id], a)
+		}
+	}
+	result := make(map[string]*SkillAnalytics, len(bySkill))
+	for id, history := range bySkill {
+		result[id] = analyzeSkill(id, history)
+	}
+	return result
+}
+
+func analyzeSkill(skillID string, answers []store.AnswerEventRecord) *SkillAnalytics {
+	a := &SkillAnalytics{SkillID: skillID, Answers: len(answers)}
+
+	var times []int
+	for _, ans := range answers {
+		if ans.Correct {
+			a.Correct++
+		}
+		if ans.TimeMs > 0 {
+			times = append(times, ans.TimeMs)
+		}
+
+		y, m, d := ans.Timestamp.Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, ans.Timestamp.Location())
+		if n := len(a.Daily); n == 0 || !a.Daily[n-1].Day.Equal(day) {
+			a.Daily = append(a.Daily, DailyAccuracy{Day: day})
+		}
+		last := &a.Daily[len(a.Daily)-1]
+		last.Answers++
+		if ans.Correct {
+			last.Correct++
+		}
+	}
+
+	slices.Sort(times)
+	a.P25Ms = percentile(times, 25)
+	a.P50Ms = percentile(times, 50)
+	a.P90Ms = percentile(times, 90)
+	a.Trend = fluencyTrend(answers)
+	return a
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted values.
+func percentile(sorted []int, p float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func fluencyTrend(answers []store.AnswerEventRecord) Trend {
+	recent := answers[len(answers)-min(len(answers), TrendWindow):]
+	if len(recent) < MinTrendAnswers {
+		return TrendUnknown
+	}
+	half := len(recent) / 2
+	delta := answerFluency(recent[half:]) - answerFluency(recent[:half])
+	switch {
+	case delta > trendThreshold:
+		return TrendImproving
+	case delta < -trendThreshold:
+		return TrendDeclining
+	}
+	return TrendStable
+}
+
+// answerFluency scores a run of answers with FluencyScore's accuracy and
+// speed weights, rescaled to leave out the streak-based consistency term.
+// Untimed tiers score speed as neutral, so there only accuracy moves it.
+func answerFluency(answers []store.AnswerEventRecord) float64 {
+	var correct, speed float64
+	for _, a := range answers {
+		if a.Correct {
+			correct++
+		}
+		speed += SpeedScore(a.TimeMs, answerTierConfig(a))
+	}
+	n := float64(len(answers))
+	return (0.6*correct/n + 0.2*speed/n) / 0.8
+}
+
+// answerTierConfig returns the tier an answer was scored against, falling
+// back to the skill's final tier when the recorded one no longer exists.
+func answerTierConfig(a store.AnswerEventRecord) skillgraph.TierConfig {
+	_, tiers := resolveSkill(a.SkillID)
+	tier := skillgraph.ParseTier(a.Tier)
+	if !tiers.Has(tier) {
+		tier = tiers.Final()
+	}
+	return tiers[tier]
+}
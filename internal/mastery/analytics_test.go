@@ -0,0 +1,98 @@
+package mastery
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// proveAnswers builds a prove-tier answer history for the test skill, one
+// answer a minute from start.
+func proveAnswers(start time.Time, correct []bool, timesMs []int) []store.AnswerEventRecord {
+	answers := make([]store.AnswerEventRecord, len(correct))
+	for i := range correct {
+		answers[i] = store.AnswerEventRecord{
+			Sequence:  int64(i + 1),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+			SkillID:   testSkillID(),
+			Tier:      "prove",
+			Correct:   correct[i],
+			TimeMs:    timesMs[i],
+		}
+	}
+	return answers
+}
+
+func TestAnalyze_PercentilesAndDailyAccuracy(t *testing.T) {
+	day1 := time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC)
+	answers := proveAnswers(day1,
+		[]bool{true, false, true, true, false},
+		[]int{4000, 9000, 2000, 10000, 6000})
+	answers = append(answers, proveAnswers(day1.AddDate(0, 0, 1),
+		[]bool{true, true, true, true, true},
+		[]int{1000, 3000, 5000, 7000, 8000})...)
+
+	a := Analyze(answers)[testSkillID()]
+	if a.Answers != 10 || a.Correct != 8 {
+		t.Errorf("Answers, Correct = %d, %d; want 10, 8", a.Answers, a.Correct)
+	}
+	if a.P25Ms != 3000 || a.P50Ms != 5000 || a.P90Ms != 9000 {
+		t.Errorf("percentiles = %d/%d/%d, want 3000/5000/9000", a.P25Ms, a.P50Ms, a.P90Ms)
+	}
+	got := make([]float64, len(a.Daily))
+	for i, d := range a.Daily {
+		got[i] = d.Accuracy()
+	}
+	if !slices.Equal(got, []float64{0.6, 1}) {
+		t.Errorf("daily accuracy = %v, want [0.6 1]", got)
+	}
+	if !a.Daily[1].Day.Equal(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("second day = %v, want 2026-03-03", a.Daily[1].Day)
+	}
+}
+
+func TestAnalyze_Trend(t *testing.T) {
+	start := time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC)
+	slowShaky := []bool{false, true, false, true, false, true}
+	fastSure := []bool{true, true, true, true, true, true}
+	slow := []int{40000, 40000, 40000, 40000, 40000, 40000}
+	fast := []int{5000, 5000, 5000, 5000, 5000, 5000}
+
+	tests := []struct {
+		name    string
+		correct []bool
+		times   []int
+		want    Trend
+	}{
+		{"improving", append(slowShaky, fastSure...), append(slow, fast...), TrendImproving},
+		{"declining", append(fastSure, slowShaky...), append(fast, slow...), TrendDeclining},
+		{"stable", append(fastSure, fastSure...), append(fast, fast...), TrendStable},
+		{"too few", fastSure[:5], fast[:5], TrendUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Analyze(proveAnswers(start, tt.correct, tt.times))[testSkillID()]
+			if a.Trend != tt.want {
+				t.Errorf("Trend = %s, want %s", a.Trend, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyze_MigratedSkills(t *testing.T) {
+	useMergeCurriculum(t)
+	start := time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC)
+	answers := proveAnswers(start, []bool{true, false, true}, []int{1000, 2000, 3000})
+	answers[0].SkillID, answers[1].SkillID, answers[2].SkillID = "sub-a", "sub-b", "sub"
+
+	result := Analyze(answers)
+	a := result["sub"]
+	if a == nil || a.Answers != 3 || a.Correct != 2 {
+		t.Fatalf("sub analytics = %+v, want 3 answers, 2 correct", a)
+	}
+	if _, ok := result["sub-a"]; ok {
+		t.Error("merged-away skill sub-a still analysed")
+	}
+}
@@ -0,0 +1,99 @@
+package mastery
+
+import "github.com/abhisek/mathiz/internal/skillgraph"
+
+// BKTParams are the parameters of a Bayesian knowledge tracing model.
+type BKTParams struct {
+	// PInit is the prior probability that the learner already knows a skill
+	// when starting a tier.
+	PInit float64
+	// PTransit is the probability of learning the skill from one question.
+	PTransit float64
+	// PSlip is the probability of answering wrongly despite knowing it.
+	PSlip float64
+	// PGuess is the probability of answering correctly without knowing it.
+	PGuess float64
+	// Threshold is the P(known) at which a tier is complete.
+	Threshold float64
+	// MinAttempts is the fewest answers that can complete a tier, so a lucky
+	// streak on the first questions is not enough. Tiers that require fewer
+	// problems use their own ProblemsRequired.
+	MinAttempts int
+}
+
+// DefaultBKTParams returns the parameters mathiz ships with. Three correct
+// answers in a row take a fresh tier past the threshold; a wrong answer
+// sets the estimate back by one to three correct answers.
+func DefaultBKTParams() BKTParams {
+	return BKTParams{
+		PInit:       0.2,
+		PTransit:    0.15,
+		PSlip:       0.1,
+		PGuess:      0.2,
+		Threshold:   0.95,
+		MinAttempts: 4,
+	}
+}
+
+// BKTModel estimates P(known) per skill with Bayesian knowledge tracing and
+// completes a tier once the estimate reaches the threshold. The estimate
+// restarts from the prior on each tier, since each tier asks harder
+// questions than the last. Every misconception diagnosed in the tier adds
+// one to the minimum number of answers.
+type BKTModel struct {
+	params BKTParams
+}
+
+// NewBKTModel creates a BKT model with the given parameters.
+func NewBKTModel(params BKTParams) *BKTModel {
+	return &BKTModel{params: params}
+}
+
+func (m *BKTModel) Kind() ModelKind { return ModelBKT }
+
+// Known returns the model's current P(known) for sm.
+func (m *BKTModel) Known(sm *SkillMastery) float64 {
+	if sm.PKnown == 0 {
+		return m.params.PInit
+	}
+	return sm.PKnown
+}
+
+// Observe applies Bayes' rule for the answer, then the chance the learner
+// learned the skill from the question.
+func (m *BKTModel) Observe(sm *SkillMastery, correct bool, _ skillgraph.TierConfig) {
+	p := m.params
+	known := m.Known(sm)
+
+	var posterior float64
+	if correct {
+		posterior = known * (1 - p.PSlip) / (known*(1-p.PSlip) + (1-known)*p.PGuess)
+	} else {
+		posterior = known * p.PSlip / (known*p.PSlip + (1-known)*(1-p.PGuess))
+	}
+	sm.PKnown = posterior + (1-posterior)*p.PTransit
+}
+
+func (m *BKTModel) TierComplete(sm *SkillMastery, cfg skillgraph.TierConfig) bool {
+	return sm.TotalAttempts >= m.minAttempts(sm, cfg) && m.Known(sm) >= m.params.Threshold
+}
+
+// Progress is how far P(known) has climbed from the prior to the threshold,
+// held back until the minimum number of answers is in.
+func (m *BKTModel) Progress(sm *SkillMastery, cfg skillgraph.TierConfig) float64 {
+	span := m.params.Threshold - m.params.PInit
+	if span <= 0 {
+		return 0
+	}
+	known := max(0, (m.Known(sm)-m.params.PInit)/span)
+	attempts := float64(sm.TotalAttempts) / max(1, float64(m.minAttempts(sm, cfg)))
+	return min(1, known, attempts)
+}
+
+func (m *BKTModel) minAttempts(sm *SkillMastery, cfg skillgraph.TierConfig) int {
+	n := m.params.MinAttempts
+	if cfg.ProblemsRequired > 0 && cfg.ProblemsRequired < n {
+		n = cfg.ProblemsRequired
+	}
+	return n + sm.MisconceptionPenalty
+}
@@ -16,8 +16,11 @@ func ResolveDisplayState(
 		}
 		return skillgraph.StateLocked
 	case StateLearning:
-		if currentTier == skillgraph.TierProve {
+		switch currentTier {
+		case skillgraph.TierProve:
 			return skillgraph.StateProving
+		case skillgraph.TierChallenge:
+			return skillgraph.StateChallenging
 		}
 		return skillgraph.StateLearning
 	case StateMastered:
@@ -20,6 +20,9 @@ type FluencyMetrics struct {
 	Streak int `json:"streak"`
 	// StreakCap is the maximum streak value for consistency scoring (default 8).
 	StreakCap int `json:"streak_cap"`
+	// RecentResults holds the last N answers in the current tier (true =
+	// correct) for rolling accuracy, over the same window as SpeedScores.
+	RecentResults []bool `json:"recent_results,omitempty"`
 }
 
 // DefaultFluencyMetrics returns a FluencyMetrics with default settings.
@@ -81,6 +84,34 @@ func RecordSpeed(metrics *FluencyMetrics, score float64) {
 	}
 }
 
+// RecordResult adds an answer to the rolling accuracy window.
+func RecordResult(metrics *FluencyMetrics, correct bool) {
+	metrics.RecentResults = append(metrics.RecentResults, correct)
+	window := metrics.SpeedWindow
+	if window <= 0 {
+		window = DefaultSpeedWindow
+	}
+	if len(metrics.RecentResults) > window {
+		metrics.RecentResults = metrics.RecentResults[len(metrics.RecentResults)-window:]
+	}
+}
+
+// RollingAccuracy returns the accuracy over the rolling window and the
+// number of answers it covers (0 accuracy for an empty window).
+func RollingAccuracy(metrics *FluencyMetrics) (float64, int) {
+	n := len(metrics.RecentResults)
+	if n == 0 {
+		return 0, 0
+	}
+	correct := 0
+	for _, ok := range metrics.RecentResults {
+		if ok {
+			correct++
+		}
+	}
+	return float64(correct) / float64(n), n
+}
+
 func averageSpeed(metrics *FluencyMetrics) float64 {
 	if len(metrics.SpeedScores) == 0 {
 		return 0.5 // Neutral default
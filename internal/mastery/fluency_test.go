@@ -181,3 +181,22 @@ func TestConsistencyScore_ZeroCap(t *testing.T) {
 		t.Errorf("ConsistencyScore = %f, want 0.0 (zero cap)", score)
 	}
 }
+
+func TestRollingAccuracy(t *testing.T) {
+	metrics := &FluencyMetrics{SpeedWindow: 4}
+	if acc, n := RollingAccuracy(metrics); acc != 0 || n != 0 {
+		t.Errorf("empty window = (%f, %d), want (0, 0)", acc, n)
+	}
+
+	for _, ok := range []bool{false, true, true, false, true} {
+		RecordResult(metrics, ok)
+	}
+	// The first answer has slid out of the window.
+	if len(metrics.RecentResults) != 4 {
+		t.Errorf("RecentResults length = %d, want 4", len(metrics.RecentResults))
+	}
+	acc, n := RollingAccuracy(metrics)
+	if n != 4 || !almostEqual(acc, 0.75) {
+		t.Errorf("rolling accuracy = (%f, %d), want (0.75, 4)", acc, n)
+	}
+}
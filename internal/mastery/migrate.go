@@ -0,0 +1,77 @@
+package mastery
+
+import (
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// MigrateSkills applies the skill graph's migrations newer than version
+// (the snapshot's GraphVersion) to mastery data, returning a migrated copy:
+//
+//   - alias, split: each new skill inherits the old record.
+//   - merge: the merged skill takes the least advanced record. When some
+//     source was never started, a mastered result drops back to learning at
+//     the final tier, so the learner still proves the part they skipped.
+//   - backfill: implied skills of a started skill are mastered. A learner
+//     part way through the skill has, like one who mastered it, already
+//     moved past the skills added beneath it.
+func MigrateSkills(data *store.MasterySnapshotData, version int) *store.MasterySnapshotData {
+	if data == nil {
+		return nil
+	}
+	return &store.MasterySnapshotData{
+		Skills: skillgraph.MigrateRecords(data.Skills, version, skillgraph.MigrationRules[*store.SkillMasteryData]{
+			Rename:   renameSkillData,
+			Merge:    mergeSkillData,
+			Backfill: backfillSkillData,
+		}),
+	}
+}
+
+func renameSkillData(rec *store.SkillMasteryData, id string) *store.SkillMasteryData {
+	c := *rec
+	c.SkillID = id
+	return &c
+}
+
+// stateRank orders mastery states from least to most advanced.
+var stateRank = map[MasteryState]int{
+	StateNew:      0,
+	StateLearning: 1,
+	StateRusty:    2,
+	StateMastered: 3,
+}
+
+func mergeSkillData(recs []*store.SkillMasteryData, id string, complete bool) *store.SkillMasteryData {
+	least := recs[0]
+	for _, r := range recs[1:] {
+		lr, rr := stateRank[MasteryState(least.State)], stateRank[MasteryState(r.State)]
+		if rr < lr || rr == lr && tierFromString(r.CurrentTier) < tierFromString(least.CurrentTier) {
+			least = r
+		}
+	}
+	c := renameSkillData(least, id)
+	if !complete && stateRank[MasteryState(c.State)] > stateRank[StateLearning] {
+		_, tiers := resolveSkill(id)
+		c.State = string(StateLearning)
+		c.CurrentTier = tierToString(tiers.Final())
+		c.TotalAttempts, c.CorrectCount = 0, 0
+		c.MasteredAt, c.RustyAt = nil, nil
+	}
+	return c
+}
+
+func backfillSkillData(src *store.SkillMasteryData, id string) (*store.SkillMasteryData, bool) {
+	if src.State == string(StateNew) && src.TotalAttempts == 0 {
+		return nil, false
+	}
+	_, tiers := resolveSkill(id)
+	return &store.SkillMasteryData{
+		SkillID:     id,
+		State:       string(StateMastered),
+		CurrentTier: tierToString(tiers.Final()),
+		SpeedWindow: DefaultSpeedWindow,
+		StreakCap:   DefaultStreakCap,
+		MasteredAt:  src.MasteredAt,
+	}, true
+}
@@ -0,0 +1,106 @@
+package mastery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// useMergeCurriculum activates a v2 curriculum where "sub-a" and "sub-b"
+// were merged into "sub", restoring the seed after the test.
+func useMergeCurriculum(t *testing.T) {
+	t.Helper()
+	c := &skillgraph.Curriculum{
+		Name:    "merge",
+		Strands: []skillgraph.StrandInfo{{ID: "counting", Name: "Counting"}},
+		Skills: []skillgraph.Skill{
+			{ID: "sub", Name: "Subtract", Strand: "counting", GradeLevel: 1, Tiers: skillgraph.DefaultTiers()},
+		},
+		Version: 2,
+		Migrations: []skillgraph.Migration{
+			{Version: 2, Kind: skillgraph.MigrateMerge, From: []string{"sub-a", "sub-b"}, To: []string{"sub"}},
+		},
+	}
+	if err := skillgraph.Use(c); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = skillgraph.Use(skillgraph.SeedCurriculum()) })
+}
+
+func TestNewService_BackfillsSeedK1Skills(t *testing.T) {
+	at := time.Now().UTC().Format(time.RFC3339)
+	snap := &store.SnapshotData{
+		Mastery: &store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+			"pv-hundreds": {SkillID: "pv-hundreds", State: "mastered", CurrentTier: "prove", MasteredAt: &at},
+		}},
+	}
+	svc := NewService(snap, nil)
+
+	for _, id := range []string{"count-to-20", "count-to-120", "pv-tens-ones", "add-sub-within-20"} {
+		if sm := svc.GetMastery(id); sm.State != StateMastered || sm.MasteredAt == nil {
+			t.Errorf("%s: state %s, MasteredAt %v; want mastered", id, sm.State, sm.MasteredAt)
+		}
+	}
+	// Fact skills master at their final (Challenge) tier.
+	if got := svc.GetMastery("add-sub-within-10").CurrentTier; got != skillgraph.TierChallenge {
+		t.Errorf("add-sub-within-10 tier = %v, want Challenge", got)
+	}
+	if snap.Mastery.Skills["count-to-20"] != nil {
+		t.Error("NewService modified the snapshot")
+	}
+
+	// A snapshot saved against the current graph is not migrated again.
+	snap.GraphVersion = skillgraph.GraphVersion()
+	if svc := NewService(snap, nil); svc.GetMastery("count-to-20").State != StateNew {
+		t.Error("current-version snapshot was migrated")
+	}
+}
+
+func TestNewService_BackfillKeepsInProgressSkillUnlocked(t *testing.T) {
+	snap := &store.SnapshotData{
+		Mastery: &store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+			"pv-hundreds": {SkillID: "pv-hundreds", State: "learning", CurrentTier: "prove", TotalAttempts: 9, CorrectCount: 7},
+		}},
+	}
+	svc := NewService(snap, nil)
+
+	if sm := svc.GetMastery("pv-hundreds"); sm.State != StateLearning || sm.CurrentTier != skillgraph.TierProve || sm.TotalAttempts != 9 {
+		t.Errorf("pv-hundreds = %s at %v after %d attempts, want its progress kept", sm.State, sm.CurrentTier, sm.TotalAttempts)
+	}
+	if sm := svc.GetMastery("pv-tens-ones"); sm.State != StateMastered {
+		t.Errorf("pv-tens-ones state = %s, want mastered", sm.State)
+	}
+	if !skillgraph.IsUnlocked("pv-hundreds", svc.MasteredSkills()) {
+		t.Error("pv-hundreds is locked for a learner already working on it")
+	}
+}
+
+func TestMigrateSkills_Merge(t *testing.T) {
+	useMergeCurriculum(t)
+
+	at := time.Now().UTC().Format(time.RFC3339)
+	mastered := &store.SkillMasteryData{SkillID: "sub-a", State: "mastered", CurrentTier: "prove", MasteredAt: &at}
+	learning := &store.SkillMasteryData{SkillID: "sub-b", State: "learning", CurrentTier: "learn", TotalAttempts: 4}
+
+	// Both started: the least advanced record wins.
+	got := MigrateSkills(&store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+		"sub-a": mastered, "sub-b": learning,
+	}}, 1)
+	if sub := got.Skills["sub"]; sub == nil || sub.State != "learning" || sub.TotalAttempts != 4 || sub.SkillID != "sub" {
+		t.Errorf("merged = %+v, want sub-b's learning record", sub)
+	}
+
+	// Only one started: mastery drops back to learning at the final tier.
+	got = MigrateSkills(&store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+		"sub-a": mastered,
+	}}, 1)
+	sub := got.Skills["sub"]
+	if sub == nil || sub.State != "learning" || sub.CurrentTier != "prove" || sub.MasteredAt != nil {
+		t.Errorf("merged = %+v, want learning at prove", sub)
+	}
+	if _, ok := got.Skills["sub-a"]; ok {
+		t.Error("merge source kept")
+	}
+}
@@ -0,0 +1,107 @@
+package mastery
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// ModelKind identifies a mastery model: the rule that decides when a tier
+// is complete.
+type ModelKind string
+
+const (
+	// ModelRules completes a tier after a fixed number of answers at an
+	// accuracy threshold (the tier's ProblemsRequired and AccuracyThreshold).
+	ModelRules ModelKind = "rules"
+
+	// ModelBKT completes a tier once Bayesian knowledge tracing estimates
+	// the learner knows the skill with high enough probability.
+	ModelBKT ModelKind = "bkt"
+)
+
+// DefaultModel is the mastery model used unless another is selected.
+const DefaultModel = ModelRules
+
+// AllModels returns the available mastery models.
+func AllModels() []ModelKind {
+	return []ModelKind{ModelRules, ModelBKT}
+}
+
+// ParseModel resolves a model ID. The empty string yields DefaultModel.
+func ParseModel(s string) (ModelKind, error) {
+	if s == "" {
+		return DefaultModel, nil
+	}
+	kind := ModelKind(strings.ToLower(s))
+	if !slices.Contains(AllModels(), kind) {
+		return "", fmt.Errorf("unknown mastery model %q (available: rules, bkt)", s)
+	}
+	return kind, nil
+}
+
+// NewModel returns the model for kind, falling back to the rule-based model
+// for unknown kinds.
+func NewModel(kind ModelKind) Model {
+	if kind == ModelBKT {
+		return NewBKTModel(DefaultBKTParams())
+	}
+	return RulesModel{}
+}
+
+// activeModel is the process-wide mastery model, set once at startup.
+var activeModel = DefaultModel
+
+// UseModel sets the process-wide mastery model that NewService uses. Call
+// it once at startup.
+func UseModel(kind ModelKind) {
+	activeModel = kind
+}
+
+// ActiveModel returns the process-wide mastery model.
+func ActiveModel() ModelKind {
+	return activeModel
+}
+
+// Model decides when a learner has completed the tier they are working on.
+// The service keeps the attempt counters and fluency metrics up to date
+// for every model; a model may keep its own estimate on the SkillMastery.
+type Model interface {
+	// Kind identifies the model.
+	Kind() ModelKind
+
+	// Observe updates the model's estimate after an answer. The service has
+	// already counted the answer in sm.TotalAttempts and sm.CorrectCount.
+	Observe(sm *SkillMastery, correct bool, cfg skillgraph.TierConfig)
+
+	// TierComplete reports whether sm has met the current tier's criteria.
+	TierComplete(sm *SkillMastery, cfg skillgraph.TierConfig) bool
+
+	// Progress returns how far sm is towards completing the current tier,
+	// from 0 to 1, for progress bars.
+	Progress(sm *SkillMastery, cfg skillgraph.TierConfig) float64
+}
+
+// RulesModel is the original rule-based model: a tier is complete after
+// ProblemsRequired answers with AccuracyThreshold of them correct, plus one
+// extra correct answer per misconception diagnosed in the tier.
+type RulesModel struct{}
+
+func (RulesModel) Kind() ModelKind { return ModelRules }
+
+func (RulesModel) Observe(*SkillMastery, bool, skillgraph.TierConfig) {}
+
+func (RulesModel) TierComplete(sm *SkillMastery, cfg skillgraph.TierConfig) bool {
+	return sm.IsTierComplete(cfg)
+}
+
+// Progress is the share of the correct answers the tier needs.
+func (RulesModel) Progress(sm *SkillMastery, cfg skillgraph.TierConfig) float64 {
+	needed := sm.RequiredCorrect(cfg)
+	if needed <= 0 {
+		return 0
+	}
+	return min(1, float64(sm.CorrectCount)/float64(needed))
+}
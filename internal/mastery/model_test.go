@@ -0,0 +1,141 @@
+package mastery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+func TestParseModel(t *testing.T) {
+	if kind, err := ParseModel(""); err != nil || kind != ModelRules {
+		t.Errorf("ParseModel(\"\") = %q, %v; want rules", kind, err)
+	}
+	if kind, err := ParseModel("BKT"); err != nil || kind != ModelBKT {
+		t.Errorf("ParseModel(BKT) = %q, %v; want bkt", kind, err)
+	}
+	if _, err := ParseModel("elo"); err == nil {
+		t.Error("expected error for unknown model, got nil")
+	}
+}
+
+func TestBKT_PosteriorMovesWithAnswers(t *testing.T) {
+	m := NewBKTModel(DefaultBKTParams())
+	sm := &SkillMastery{}
+	cfg := learnTierCfg()
+
+	prior := m.Known(sm)
+	m.Observe(sm, true, cfg)
+	afterCorrect := m.Known(sm)
+	if afterCorrect <= prior {
+		t.Errorf("P(known) after correct = %.3f, want above prior %.3f", afterCorrect, prior)
+	}
+	m.Observe(sm, false, cfg)
+	if got := m.Known(sm); got >= afterCorrect {
+		t.Errorf("P(known) after wrong = %.3f, want below %.3f", got, afterCorrect)
+	}
+}
+
+func TestBKT_CompletesTierOnThreshold(t *testing.T) {
+	svc := NewServiceWithModel(nil, nil, NewBKTModel(DefaultBKTParams()))
+	skillID := testSkillID()
+	cfg := learnTierCfg()
+
+	// Three correct answers pass the threshold, but the tier needs four.
+	for i := 0; i < 3; i++ {
+		if tr := svc.RecordAnswer(skillID, true, 5000, cfg); tr != nil && tr.Trigger == "tier-complete" {
+			t.Fatalf("tier completed after %d answers, want at least 4", i+1)
+		}
+	}
+	tr := svc.RecordAnswer(skillID, true, 5000, cfg)
+	if tr == nil || tr.Trigger != "tier-complete" {
+		t.Fatalf("transition = %+v, want tier-complete after 4 correct", tr)
+	}
+	if sm := svc.GetMastery(skillID); sm.PKnown != 0 {
+		t.Errorf("PKnown = %.3f after tier advance, want reset to 0", sm.PKnown)
+	}
+}
+
+func TestBKT_WrongAnswersDelayCompletion(t *testing.T) {
+	svc := NewServiceWithModel(nil, nil, NewBKTModel(DefaultBKTParams()))
+	skillID := testSkillID()
+	cfg := learnTierCfg()
+
+	answers := []bool{true, false, true, false, true}
+	for i, correct := range answers {
+		if tr := svc.RecordAnswer(skillID, correct, 5000, cfg); tr != nil && tr.Trigger == "tier-complete" {
+			t.Fatalf("tier completed at answer %d of a mixed run", i+1)
+		}
+	}
+	if p := svc.TierProgress(skillID, cfg); p <= 0 || p >= 1 {
+		t.Errorf("TierProgress = %.2f, want between 0 and 1", p)
+	}
+}
+
+func TestBKT_MisconceptionRaisesMinimum(t *testing.T) {
+	svc := NewServiceWithModel(nil, nil, NewBKTModel(DefaultBKTParams()))
+	skillID := testSkillID()
+	cfg := learnTierCfg()
+	svc.GetMastery(skillID).MisconceptionPenalty = 2
+
+	for i := 0; i < 5; i++ {
+		if tr := svc.RecordAnswer(skillID, true, 5000, cfg); tr != nil && tr.Trigger == "tier-complete" {
+			t.Fatalf("tier completed after %d answers, want 6 with a penalty of 2", i+1)
+		}
+	}
+	if tr := svc.RecordAnswer(skillID, true, 5000, cfg); tr == nil || tr.Trigger != "tier-complete" {
+		t.Fatalf("transition = %+v, want tier-complete", tr)
+	}
+}
+
+func TestRulesModel_Progress(t *testing.T) {
+	cfg := learnTierCfg() // 8 problems at 75%: 6 correct needed
+	sm := &SkillMastery{TotalAttempts: 4, CorrectCount: 3}
+	if got := (RulesModel{}).Progress(sm, cfg); got != 0.5 {
+		t.Errorf("Progress = %.2f, want 0.50", got)
+	}
+}
+
+func TestSnapshotRoundTrip_PKnown(t *testing.T) {
+	svc := NewServiceWithModel(nil, nil, NewBKTModel(DefaultBKTParams()))
+	skillID := testSkillID()
+	svc.RecordAnswer(skillID, true, 5000, learnTierCfg())
+	want := svc.GetMastery(skillID).PKnown
+
+	snap := &store.SnapshotData{Mastery: svc.SnapshotData()}
+	got := NewService(snap, nil).GetMastery(skillID).PKnown
+	if got != want {
+		t.Errorf("PKnown = %.3f after round trip, want %.3f", got, want)
+	}
+}
+
+func TestReplay_ComparesModels(t *testing.T) {
+	skillID := testSkillID()
+	start := time.Date(2026, 1, 5, 16, 0, 0, 0, time.UTC)
+	var answers []store.AnswerEventRecord
+	for i := 0; i < 8; i++ {
+		answers = append(answers, store.AnswerEventRecord{
+			Sequence:  int64(i + 1),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+			SkillID:   skillID,
+			Tier:      "learn",
+			Correct:   true,
+			TimeMs:    5000,
+		})
+	}
+
+	rules := Replay(answers, RulesModel{})[skillID]
+	bkt := Replay(answers, NewBKTModel(DefaultBKTParams()))[skillID]
+	if rules.Answers != 8 || bkt.Answers != 8 {
+		t.Fatalf("Answers = %d, %d; want 8 each", rules.Answers, bkt.Answers)
+	}
+	if rules.Transitions != 1 {
+		t.Errorf("rules Transitions = %d, want 1 (learn complete)", rules.Transitions)
+	}
+	if bkt.Transitions != 2 {
+		t.Errorf("bkt Transitions = %d, want 2 (learn and prove complete)", bkt.Transitions)
+	}
+	if bkt.State != StateMastered || bkt.MasteredAfter != 8 {
+		t.Errorf("bkt = %s after %d, want mastered after 8", bkt.State, bkt.MasteredAfter)
+	}
+}
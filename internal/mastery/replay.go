@@ -0,0 +1,96 @@
+package mastery
+
+import (
+	"time"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// ReplayOutcome is where a model left one skill after replaying a learner's
+// answers.
+type ReplayOutcome struct {
+	SkillID string
+	State   MasteryState
+	Tier    skillgraph.Tier
+	Answers int // answers replayed for the skill
+
+	// MasteredAfter is how many answers it took to first master the skill,
+	// or 0 if the model never mastered it.
+	MasteredAfter int
+
+	// Transitions counts the tier and state changes the model made.
+	Transitions int
+}
+
+// Replay feeds answer events, oldest first, through a fresh service that
+// completes tiers with model, and returns the outcome per skill. Each answer
+// is scored against the tier it was asked at, so the same history can be
+// replayed through different models to compare them. Decay, review
+// performance and misconception penalties are not replayed.
+func Replay(answers []store.AnswerEventRecord, model Model) map[string]*ReplayOutcome {
+	svc := NewServiceWithModel(nil, nil, model)
+	outcomes := make(map[string]*ReplayOutcome)
+
+	for _, a := range answers {
+		cfg := answerTierConfig(a)
+
+		o := outcomes[a.SkillID]
+		if o == nil {
+			o = &ReplayOutcome{SkillID: a.SkillID}
+			outcomes[a.SkillID] = o
+		}
+		o.Answers++
+
+		t := svc.RecordAnswer(a.SkillID, a.Correct, a.TimeMs, cfg)
+		if t != nil && t.Trigger != "first-attempt" {
+			o.Transitions++
+		}
+		if t != nil && t.To == StateMastered && o.MasteredAfter == 0 {
+			o.MasteredAfter = o.Answers
+		}
+	}
+
+	for id, o := range outcomes {
+		sm := svc.GetMastery(id)
+		o.State = sm.State
+		o.Tier = sm.CurrentTier
+	}
+	return outcomes
+}
+
+// ReplayAnswer counts a recorded answer towards a skill's counters, fluency
+// and model estimate without checking tier completion: when rebuilding
+// from the event log, the recorded mastery events say when tiers completed.
+func (s *Service) ReplayAnswer(skillID string, correct bool, responseTimeMs int, tierCfg skillgraph.TierConfig) {
+	sm := s.GetMastery(skillID)
+	if sm.State == StateNew {
+		sm.State = StateLearning
+	}
+	s.recordAttempt(sm, correct, responseTimeMs, tierCfg)
+}
+
+// ReplayTransition applies a recorded mastery event that happened at time
+// at, returning the transition it made, or nil when the event no longer
+// applies (such as decay recorded for a skill that isn't mastered).
+// First-attempt events are no-ops: ReplayAnswer starts a skill.
+func (s *Service) ReplayTransition(skillID, trigger string, at time.Time) *StateTransition {
+	switch trigger {
+	case "tier-complete", "prove-complete", "challenge-complete", "recovery-complete":
+		sm := s.GetMastery(skillID)
+		if sm.State != StateLearning && sm.State != StateRusty {
+			return nil
+		}
+		skillName, tiers := resolveSkill(skillID)
+		return s.advanceTier(sm, skillName, tiers, at)
+	case "time-decay", "review-performance":
+		t := s.markRusty(skillID, at)
+		if t != nil {
+			t.Trigger = trigger
+		}
+		return t
+	case "diagnostic":
+		return s.SeedMastered(skillID, at)
+	}
+	return nil
+}
@@ -12,13 +12,23 @@ import (
 type Service struct {
 	skills    map[string]*SkillMastery
 	eventRepo store.EventRepo
+	model     Model
 }
 
 // NewService creates a mastery service, loading state from the snapshot.
+// Skill IDs saved against an older graph version are migrated on load.
+// Tier completion follows the process-wide model (see UseModel).
 func NewService(snap *store.SnapshotData, eventRepo store.EventRepo) *Service {
+	return NewServiceWithModel(snap, eventRepo, NewModel(ActiveModel()))
+}
+
+// NewServiceWithModel creates a mastery service that completes tiers with
+// the given model, such as when replaying answers to compare models.
+func NewServiceWithModel(snap *store.SnapshotData, eventRepo store.EventRepo, model Model) *Service {
 	s := &Service{
 		skills:    make(map[string]*SkillMastery),
 		eventRepo: eventRepo,
+		model:     model,
 	}
 
 	if snap == nil {
@@ -27,14 +37,14 @@ func NewService(snap *store.SnapshotData, eventRepo store.EventRepo) *Service {
 
 	// Prefer new mastery format if available.
 	if snap.Mastery != nil {
-		s.loadFromMasterySnapshot(snap.Mastery)
+		s.loadFromMasterySnapshot(MigrateSkills(snap.Mastery, snap.GraphVersion))
 		return s
 	}
 
 	// Migrate from old format.
 	if len(snap.TierProgress) > 0 || len(snap.MasteredSet) > 0 {
 		migrated := MigrateSnapshot(snap)
-		s.loadFromMasterySnapshot(migrated)
+		s.loadFromMasterySnapshot(MigrateSkills(migrated, snap.GraphVersion))
 	}
 
 	return s
@@ -52,11 +62,13 @@ func (s *Service) loadFromMasterySnapshot(data *store.MasterySnapshotData) {
 			TotalAttempts:        sd.TotalAttempts,
 			CorrectCount:         sd.CorrectCount,
 			MisconceptionPenalty: sd.MisconceptionPenalty,
+			PKnown:               sd.PKnown,
 			Fluency: FluencyMetrics{
 				SpeedScores: sd.SpeedScores,
 				SpeedWindow: sd.SpeedWindow,
 				Streak:      sd.Streak,
 				StreakCap:    sd.StreakCap,
+				RecentResults: sd.RecentResults,
 			},
 		}
 		if sd.MasteredAt != nil {
@@ -78,6 +90,11 @@ func (s *Service) loadFromMasterySnapshot(data *store.MasterySnapshotData) {
 		if sm.Fluency.StreakCap == 0 {
 			sm.Fluency.StreakCap = DefaultStreakCap
 		}
+		// A curriculum change can drop the Challenge tier a learner was
+		// working on; carry on from the skill's final tier instead.
+		if _, tiers := resolveSkill(id); sm.CurrentTier > tiers.Final() {
+			sm.CurrentTier = tiers.Final()
+		}
 		s.skills[id] = sm
 	}
 }
@@ -113,7 +130,7 @@ func (s *Service) MasteredSkills() map[string]bool {
 // Returns a StateTransition if the answer caused a state change, nil otherwise.
 func (s *Service) RecordAnswer(skillID string, correct bool, responseTimeMs int, tierCfg skillgraph.TierConfig) *StateTransition {
 	sm := s.GetMastery(skillID)
-	skillName := resolveSkillName(skillID)
+	skillName, tiers := resolveSkill(skillID)
 
 	var transition *StateTransition
 
@@ -129,6 +146,21 @@ func (s *Service) RecordAnswer(skillID string, correct bool, responseTimeMs int,
 		sm.State = StateLearning
 	}
 
+	s.recordAttempt(sm, correct, responseTimeMs, tierCfg)
+
+	// Check tier completion.
+	if s.model.TierComplete(sm, tierCfg) {
+		if t := s.advanceTier(sm, skillName, tiers, time.Now()); t != nil {
+			transition = t // More significant transition overrides first-attempt.
+		}
+	}
+
+	return transition
+}
+
+// recordAttempt counts an answer towards the current tier: attempt
+// counters, fluency metrics and the model's estimate.
+func (s *Service) recordAttempt(sm *SkillMastery, correct bool, responseTimeMs int, tierCfg skillgraph.TierConfig) {
 	// Update attempt counters.
 	sm.TotalAttempts++
 	if correct {
@@ -138,6 +170,7 @@ func (s *Service) RecordAnswer(skillID string, correct bool, responseTimeMs int,
 	// Update fluency metrics.
 	speedScore := SpeedScore(responseTimeMs, tierCfg)
 	RecordSpeed(&sm.Fluency, speedScore)
+	RecordResult(&sm.Fluency, correct)
 
 	if correct {
 		sm.Fluency.Streak++
@@ -145,24 +178,23 @@ func (s *Service) RecordAnswer(skillID string, correct bool, responseTimeMs int,
 		sm.Fluency.Streak = 0
 	}
 
-	// Check tier completion.
-	if sm.IsTierComplete(tierCfg) {
-		if t := s.advanceTier(sm, skillName); t != nil {
-			transition = t // More significant transition overrides first-attempt.
-		}
-	}
-
-	return transition
+	s.model.Observe(sm, correct, tierCfg)
 }
 
-func (s *Service) advanceTier(sm *SkillMastery, skillName string) *StateTransition {
+// advanceTier moves a learner past a completed tier: on to the skill's next
+// tier (Learn → Prove, or Prove → Challenge when the skill has one), or to
+// mastered after its final tier at time now.
+func (s *Service) advanceTier(sm *SkillMastery, skillName string, tiers skillgraph.Tiers, now time.Time) *StateTransition {
+	next, hasNext := tiers.Next(sm.CurrentTier)
 	switch {
-	case sm.State == StateLearning && sm.CurrentTier == skillgraph.TierLearn:
-		// Learn → Prove: advance tier, reset counters.
-		sm.CurrentTier = skillgraph.TierProve
+	case sm.State == StateLearning && hasNext:
+		// Advance tier, reset counters.
+		sm.CurrentTier = next
 		sm.TotalAttempts = 0
 		sm.CorrectCount = 0
 		sm.MisconceptionPenalty = 0
+		sm.PKnown = 0
+		sm.Fluency.RecentResults = nil // each tier ramps from its own base difficulty
 		return &StateTransition{
 			SkillID:   sm.SkillID,
 			SkillName: skillName,
@@ -171,18 +203,22 @@ func (s *Service) advanceTier(sm *SkillMastery, skillName string) *StateTransiti
 			Trigger:   "tier-complete",
 		}
 
-	case sm.State == StateLearning && sm.CurrentTier == skillgraph.TierProve:
-		// Prove → Mastered.
-		now := time.Now()
+	case sm.State == StateLearning:
+		// Final tier → Mastered.
+		trigger := "prove-complete"
+		if sm.CurrentTier == skillgraph.TierChallenge {
+			trigger = "challenge-complete"
+		}
 		sm.State = StateMastered
 		sm.MasteredAt = &now
 		sm.MisconceptionPenalty = 0
+		sm.PKnown = 0
 		return &StateTransition{
 			SkillID:   sm.SkillID,
 			SkillName: skillName,
 			From:      StateLearning,
 			To:        StateMastered,
-			Trigger:   "prove-complete",
+			Trigger:   trigger,
 		}
 
 	case sm.State == StateRusty:
@@ -190,6 +226,7 @@ func (s *Service) advanceTier(sm *SkillMastery, skillName string) *StateTransiti
 		sm.State = StateMastered
 		sm.RustyAt = nil
 		sm.MisconceptionPenalty = 0
+		sm.PKnown = 0
 		return &StateTransition{
 			SkillID:   sm.SkillID,
 			SkillName: skillName,
@@ -201,15 +238,39 @@ func (s *Service) advanceTier(sm *SkillMastery, skillName string) *StateTransiti
 	return nil
 }
 
+// SeedMastered marks a skill mastered by diagnostic placement rather than
+// practice. Only skills the learner has never touched are seeded; returns
+// nil for anything else.
+func (s *Service) SeedMastered(skillID string, at time.Time) *StateTransition {
+	sm := s.GetMastery(skillID)
+	if sm.State != StateNew {
+		return nil
+	}
+	_, tiers := resolveSkill(skillID)
+	sm.State = StateMastered
+	sm.CurrentTier = tiers.Final()
+	sm.MasteredAt = &at
+	return &StateTransition{
+		SkillID:   skillID,
+		SkillName: resolveSkillName(skillID),
+		From:      StateNew,
+		To:        StateMastered,
+		Trigger:   "diagnostic",
+	}
+}
+
 // MarkRusty transitions a mastered skill to rusty state.
 // Returns a StateTransition, or nil if the skill is not currently mastered.
 func (s *Service) MarkRusty(skillID string) *StateTransition {
+	return s.markRusty(skillID, time.Now())
+}
+
+func (s *Service) markRusty(skillID string, now time.Time) *StateTransition {
 	sm := s.GetMastery(skillID)
 	if sm.State != StateMastered {
 		return nil
 	}
 
-	now := time.Now()
 	sm.State = StateRusty
 	sm.RustyAt = &now
 
@@ -218,6 +279,7 @@ func (s *Service) MarkRusty(skillID string) *StateTransition {
 	sm.CorrectCount = 0
 	sm.CurrentTier = skillgraph.TierLearn
 	sm.MisconceptionPenalty = 0
+	sm.PKnown = 0
 
 	return &StateTransition{
 		SkillID:   sm.SkillID,
@@ -272,7 +334,9 @@ func (s *Service) SnapshotData() *store.MasterySnapshotData {
 			SpeedWindow:          sm.Fluency.SpeedWindow,
 			Streak:               sm.Fluency.Streak,
 			StreakCap:             sm.Fluency.StreakCap,
+			RecentResults:        sm.Fluency.RecentResults,
 			MisconceptionPenalty: sm.MisconceptionPenalty,
+			PKnown:               sm.PKnown,
 		}
 		if sm.MasteredAt != nil {
 			s := sm.MasteredAt.Format(time.RFC3339)
@@ -288,6 +352,17 @@ func (s *Service) SnapshotData() *store.MasterySnapshotData {
 	return data
 }
 
+// Model returns the model the service completes tiers with.
+func (s *Service) Model() Model {
+	return s.model
+}
+
+// TierProgress returns how far a skill is towards completing its current
+// tier under the service's model, from 0 to 1.
+func (s *Service) TierProgress(skillID string, cfg skillgraph.TierConfig) float64 {
+	return s.model.Progress(s.GetMastery(skillID), cfg)
+}
+
 // AllSkillMasteries returns all skill mastery records (for stats/UI).
 func (s *Service) AllSkillMasteries() map[string]*SkillMastery {
 	result := make(map[string]*SkillMastery, len(s.skills))
@@ -298,23 +373,24 @@ func (s *Service) AllSkillMasteries() map[string]*SkillMastery {
 }
 
 func resolveSkillName(skillID string) string {
+	name, _ := resolveSkill(skillID)
+	return name
+}
+
+// resolveSkill returns a skill's name and tiers, falling back to the ID and
+// DefaultTiers for skills missing from the graph.
+func resolveSkill(skillID string) (string, skillgraph.Tiers) {
 	skill, err := skillgraph.GetSkill(skillID)
 	if err != nil {
-		return skillID
+		return skillID, skillgraph.DefaultTiers()
 	}
-	return skill.Name
+	return skill.Name, skill.Tiers
 }
 
 func tierFromString(s string) skillgraph.Tier {
-	if s == "prove" {
-		return skillgraph.TierProve
-	}
-	return skillgraph.TierLearn
+	return skillgraph.ParseTier(s)
 }
 
 func tierToString(t skillgraph.Tier) string {
-	if t == skillgraph.TierProve {
-		return "prove"
-	}
-	return "learn"
+	return t.String()
 }
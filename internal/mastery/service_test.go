@@ -16,8 +16,8 @@ type mockEventRepo struct {
 	reviewErr      error
 }
 
-func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) error {
-	return nil
+func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) (int, error) {
+	return 0, nil
 }
 func (m *mockEventRepo) AppendSessionEvent(_ context.Context, _ store.SessionEventData) error {
 	return nil
@@ -43,6 +43,12 @@ func (m *mockEventRepo) AppendDiagnosisEvent(_ context.Context, _ store.Diagnosi
 func (m *mockEventRepo) AppendHintEvent(_ context.Context, _ store.HintEventData) error {
 	return nil
 }
+func (m *mockEventRepo) AppendQuestionReport(_ context.Context, _ store.QuestionReportData) error {
+	return nil
+}
+func (m *mockEventRepo) QueryQuestionReports(_ context.Context, _ store.QueryOpts) ([]store.QuestionReportRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) AppendLessonEvent(_ context.Context, _ store.LessonEventData) error {
 	return nil
 }
@@ -90,6 +96,9 @@ func (m *mockEventRepo) QueryMasteryEvents(_ context.Context, _ store.QueryOpts)
 func (m *mockEventRepo) AnswersForSession(_ context.Context, _ string) ([]store.AnswerEventRecord, error) {
 	return nil, nil
 }
+func (m *mockEventRepo) AnswerHistory(_ context.Context, _ store.QueryOpts) ([]store.AnswerEventRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) HintCountForSession(_ context.Context, _ string) (int, error) {
 	return 0, nil
 }
@@ -177,6 +186,28 @@ func TestStateMachine_NewToLearning(t *testing.T) {
 	}
 }
 
+func TestStateMachine_SeedMastered(t *testing.T) {
+	svc := NewService(nil, nil)
+	skillID := testSkillID()
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	transition := svc.SeedMastered(skillID, at)
+	if transition == nil || transition.To != StateMastered || transition.Trigger != "diagnostic" {
+		t.Fatalf("transition = %+v, want new -> mastered (diagnostic)", transition)
+	}
+	sm := svc.GetMastery(skillID)
+	if sm.MasteredAt == nil || !sm.MasteredAt.Equal(at) {
+		t.Errorf("MasteredAt = %v, want %v", sm.MasteredAt, at)
+	}
+
+	// Practised skills are never overwritten by placement.
+	other := "other-skill"
+	svc.RecordAnswer(other, true, 5000, learnTierCfg())
+	if tr := svc.SeedMastered(other, at); tr != nil {
+		t.Errorf("seeding a learning skill returned %+v, want nil", tr)
+	}
+}
+
 func TestStateMachine_LearningToMastered(t *testing.T) {
 	svc := NewService(nil, nil)
 	skillID := testSkillID()
@@ -243,6 +274,57 @@ func TestStateMachine_TierComplete(t *testing.T) {
 	}
 }
 
+func TestStateMachine_ChallengeTier(t *testing.T) {
+	svc := NewService(nil, nil)
+	skill, err := skillgraph.GetSkill("mult-facts-2-5-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tiers := skill.Tiers
+
+	complete := func(tier skillgraph.Tier) *StateTransition {
+		var last *StateTransition
+		for i := 0; i < tiers[tier].ProblemsRequired; i++ {
+			if tr := svc.RecordAnswer(skill.ID, true, 2000, tiers[tier]); tr != nil {
+				last = tr
+			}
+		}
+		return last
+	}
+
+	complete(skillgraph.TierLearn)
+	// Completing Prove moves on to Challenge instead of mastering.
+	tr := complete(skillgraph.TierProve)
+	if tr == nil || tr.Trigger != "tier-complete" {
+		t.Fatalf("after prove: transition = %+v, want tier-complete", tr)
+	}
+	sm := svc.GetMastery(skill.ID)
+	if sm.CurrentTier != skillgraph.TierChallenge || sm.State != StateLearning {
+		t.Fatalf("after prove: tier = %v, state = %s", sm.CurrentTier, sm.State)
+	}
+
+	tr = complete(skillgraph.TierChallenge)
+	if tr == nil || tr.To != StateMastered || tr.Trigger != "challenge-complete" {
+		t.Fatalf("after challenge: transition = %+v, want challenge-complete to mastered", tr)
+	}
+}
+
+func TestService_ClampsRemovedChallengeTier(t *testing.T) {
+	// A default-tier skill has no Challenge tier; a learner saved on one
+	// resumes at Prove.
+	snap := &store.SnapshotData{
+		Mastery: &store.MasterySnapshotData{
+			Skills: map[string]*store.SkillMasteryData{
+				testSkillID(): {State: "learning", CurrentTier: "challenge"},
+			},
+		},
+	}
+	svc := NewService(snap, nil)
+	if got := svc.GetMastery(testSkillID()).CurrentTier; got != skillgraph.TierProve {
+		t.Errorf("CurrentTier = %v, want Prove", got)
+	}
+}
+
 func TestStateMachine_MasteredToRusty_TimeTrigger(t *testing.T) {
 	snap := &store.SnapshotData{
 		Mastery: &store.MasterySnapshotData{
@@ -21,6 +21,11 @@ type SkillMastery struct {
 	// to complete the current tier, incremented by misconception diagnoses.
 	// Reset to 0 on tier advancement.
 	MisconceptionPenalty int
+
+	// PKnown is the BKT model's estimate that the learner knows the skill at
+	// the current tier. 0 means no estimate yet (the model's prior). Reset
+	// to 0 on tier advancement.
+	PKnown float64
 }
 
 // Accuracy returns the current accuracy ratio.
@@ -43,7 +48,11 @@ func (sm *SkillMastery) IsTierComplete(cfg skillgraph.TierConfig) bool {
 	if sm.TotalAttempts < cfg.ProblemsRequired {
 		return false
 	}
-	requiredCorrect := int(float64(cfg.ProblemsRequired)*cfg.AccuracyThreshold + 0.5)
-	requiredCorrect += sm.MisconceptionPenalty
-	return sm.CorrectCount >= requiredCorrect
+	return sm.CorrectCount >= sm.RequiredCorrect(cfg)
+}
+
+// RequiredCorrect returns how many correct answers the current tier needs
+// under the rule-based model, including the misconception penalty.
+func (sm *SkillMastery) RequiredCorrect(cfg skillgraph.TierConfig) int {
+	return int(float64(cfg.ProblemsRequired)*cfg.AccuracyThreshold+0.5) + sm.MisconceptionPenalty
 }
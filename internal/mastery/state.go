@@ -16,5 +16,5 @@ type StateTransition struct {
 	SkillName string
 	From      MasteryState
 	To        MasteryState
-	Trigger   string // "first-attempt", "tier-complete", "prove-complete", "time-decay", "review-performance", "recovery-complete"
+	Trigger   string // "first-attempt", "tier-complete", "prove-complete", "challenge-complete", "time-decay", "review-performance", "recovery-complete"
 }
@@ -0,0 +1,174 @@
+// Package placement runs the diagnostic placement quiz for new learners
+// (specs/03-skill-graph.md): top-down probing across strands, with questions
+// from the regular problemgen.Generator. The outcome is persisted the same
+// way practice is — one mastery event per placed skill and a seeded
+// snapshot — so every later session starts from the placed frontier instead
+// of the graph roots.
+//
+// The terminal app and the treasure-map game drive the same Quiz; only the
+// presentation differs.
+package placement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/spacedrep"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// Category tags answer events recorded during placement.
+const Category = "placement"
+
+// ErrNoQuestion means Answer was called without an unanswered question.
+var ErrNoQuestion = errors.New("no placement question awaiting an answer")
+
+// Quiz is one placement run. It is not safe for concurrent use.
+type Quiz struct {
+	// Locale is the language questions are generated in. Set it before the
+	// first call to Next; the zero value is English.
+	Locale i18n.Locale
+
+	diag    *skillgraph.Diagnostic
+	gen     problemgen.Generator
+	current *problemgen.Question
+	prior   map[string][]string // question texts per skill, for dedup
+}
+
+// NewQuiz creates a placement quiz over the active skill graph. Skills gen
+// can't serve are not probed.
+func NewQuiz(gen problemgen.Generator, cfg skillgraph.DiagnosticConfig) *Quiz {
+	if l, ok := gen.(problemgen.SkillLimiter); ok && cfg.Filter == nil {
+		cfg.Filter = l.Supports
+	}
+	return &Quiz{
+		diag:  skillgraph.NewDiagnostic(cfg),
+		gen:   gen,
+		prior: make(map[string][]string),
+	}
+}
+
+// Next returns the question awaiting an answer, generating one when needed.
+// It returns (nil, nil) once the quiz is over. A generation error leaves the
+// quiz where it was, so the caller may retry.
+func (q *Quiz) Next(ctx context.Context) (*problemgen.Question, error) {
+	if q.current != nil {
+		return q.current, nil
+	}
+	skill, ok := q.diag.Next()
+	if !ok {
+		return nil, nil
+	}
+	// Probes use Learn-tier difficulty: placement asks "can they do it",
+	// not "can they do it fast".
+	question, err := q.gen.Generate(ctx, problemgen.GenerateInput{
+		Skill:          skill,
+		Tier:           skillgraph.TierLearn,
+		PriorQuestions: q.prior[skill.ID],
+		Locale:         q.Locale,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate placement question: %w", err)
+	}
+	q.prior[skill.ID] = append(q.prior[skill.ID], question.Text)
+	q.current = question
+	return question, nil
+}
+
+// Answer grades the learner's answer to the current question and records it
+// with the diagnostic.
+func (q *Quiz) Answer(answer string) (bool, error) {
+	if q.current == nil {
+		return false, ErrNoQuestion
+	}
+	correct := problemgen.CheckAnswer(answer, q.current)
+	q.current = nil
+	q.diag.Record(correct)
+	return correct, nil
+}
+
+// MaxQuestions returns the quiz's question budget.
+func (q *Quiz) MaxQuestions() int {
+	return q.diag.MaxQuestions()
+}
+
+// Done reports whether the quiz is over.
+func (q *Quiz) Done() bool {
+	return q.current == nil && q.diag.Done()
+}
+
+// Result returns the placement so far. An abandoned quiz still places the
+// learner on the probes they finished.
+func (q *Quiz) Result() skillgraph.DiagnosticResult {
+	return q.diag.Result()
+}
+
+// Needed reports whether a learner should be offered placement: they have
+// no saved state at all. Saving a placement — even a skipped one — writes a
+// snapshot, so the offer is made once.
+func Needed(ctx context.Context, snapRepo store.SnapshotRepo) (bool, error) {
+	snap, err := snapRepo.Latest(ctx)
+	if err != nil {
+		return false, fmt.Errorf("load snapshot: %w", err)
+	}
+	return snap == nil, nil
+}
+
+// Save persists a placement result: a "diagnostic" mastery event for every
+// placed skill and a snapshot seeded with those skills mastered and their
+// first reviews scheduled. Skills the learner already has progress on are
+// left alone. Pass an empty result to record a skipped placement.
+func Save(ctx context.Context, eventRepo store.EventRepo, snapRepo store.SnapshotRepo, res skillgraph.DiagnosticResult, sessionID string) error {
+	prev, err := snapRepo.Latest(ctx)
+	if err != nil {
+		return fmt.Errorf("load snapshot: %w", err)
+	}
+	var prevData *store.SnapshotData
+	if prev != nil {
+		prevData = &prev.Data
+	}
+
+	masterySvc := mastery.NewService(prevData, nil)
+	scheduler := spacedrep.NewScheduler(prevData, masterySvc, nil)
+	now := time.Now()
+	for _, id := range res.MasteredSkillIDs {
+		tr := masterySvc.SeedMastered(id, now)
+		if tr == nil {
+			continue
+		}
+		scheduler.InitSkill(id, now)
+		if eventRepo == nil {
+			continue
+		}
+		if err := eventRepo.AppendMasteryEvent(ctx, store.MasteryEventData{
+			SkillID:   id,
+			FromState: string(tr.From),
+			ToState:   string(tr.To),
+			Trigger:   tr.Trigger,
+			SessionID: sessionID,
+		}); err != nil {
+			return fmt.Errorf("append mastery event: %w", err)
+		}
+	}
+
+	data := store.SnapshotData{
+		Version:      4,
+		GraphVersion: skillgraph.GraphVersion(),
+		Mastery:      masterySvc.SnapshotData(),
+		SpacedRep:    scheduler.SnapshotData(),
+	}
+	if prevData != nil {
+		data.Gems = prevData.Gems
+		data.LearnerProfile = prevData.LearnerProfile
+	}
+	if err := snapRepo.Save(ctx, &store.Snapshot{Timestamp: now, Data: data}); err != nil {
+		return fmt.Errorf("save snapshot: %w", err)
+	}
+	return nil
+}
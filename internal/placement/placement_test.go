@@ -0,0 +1,174 @@
+package placement
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// fakeGenerator answers every question with the skill ID, so tests can
+// decide per skill whether the learner "knows" it.
+type fakeGenerator struct {
+	calls int
+	err   error
+}
+
+func (g *fakeGenerator) Generate(_ context.Context, in problemgen.GenerateInput) (*problemgen.Question, error) {
+	g.calls++
+	if g.err != nil {
+		return nil, g.err
+	}
+	return &problemgen.Question{
+		Text:       "question on " + in.Skill.ID,
+		Format:     problemgen.FormatNumeric,
+		AnswerType: problemgen.AnswerTypeText,
+		Answer:     in.Skill.ID,
+		SkillID:    in.Skill.ID,
+		Tier:       in.Tier,
+	}, nil
+}
+
+func (g *fakeGenerator) GenerateBatch(ctx context.Context, in problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	return problemgen.GenerateEach(ctx, g, in, n)
+}
+
+func newTestStore(t *testing.T) *store.Store {
+	t.Helper()
+	st, err := store.Open("file::memory:?cache=shared")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+// play runs a quiz to the end, answering correctly on skills in known.
+func play(t *testing.T, q *Quiz, known func(skillgraph.Skill) bool) {
+	t.Helper()
+	ctx := context.Background()
+	for {
+		question, err := q.Next(ctx)
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if question == nil {
+			return
+		}
+		skill, _ := skillgraph.GetSkill(question.SkillID)
+		answer := "wrong"
+		if known(skill) {
+			answer = question.Answer
+		}
+		if _, err := q.Answer(answer); err != nil {
+			t.Fatalf("Answer: %v", err)
+		}
+	}
+}
+
+func TestQuiz_PlacesKnownStrand(t *testing.T) {
+	gen := &fakeGenerator{}
+	q := NewQuiz(gen, skillgraph.DiagnosticConfig{})
+	play(t, q, func(s skillgraph.Skill) bool { return s.Strand == skillgraph.StrandAddSub })
+
+	if !q.Done() {
+		t.Error("quiz should be done")
+	}
+	res := q.Result()
+	if res.QuestionsAsked != gen.calls {
+		t.Errorf("asked %d, generated %d", res.QuestionsAsked, gen.calls)
+	}
+	for _, s := range skillgraph.ByStrand(skillgraph.StrandAddSub) {
+		if !slices.Contains(res.MasteredSkillIDs, s.ID) {
+			t.Errorf("addition/subtraction skill %s not placed", s.ID)
+		}
+	}
+}
+
+func TestQuiz_AnswerWithoutQuestion(t *testing.T) {
+	q := NewQuiz(&fakeGenerator{}, skillgraph.DiagnosticConfig{})
+	if _, err := q.Answer("1"); !errors.Is(err, ErrNoQuestion) {
+		t.Errorf("got %v, want ErrNoQuestion", err)
+	}
+}
+
+func TestQuiz_GenerationErrorIsRetryable(t *testing.T) {
+	gen := &fakeGenerator{err: errors.New("provider down")}
+	q := NewQuiz(gen, skillgraph.DiagnosticConfig{})
+	if _, err := q.Next(context.Background()); err == nil {
+		t.Fatal("expected generation error")
+	}
+	gen.err = nil
+	question, err := q.Next(context.Background())
+	if err != nil || question == nil {
+		t.Fatalf("retry: question=%v err=%v", question, err)
+	}
+	if q.Result().QuestionsAsked != 0 {
+		t.Error("a failed generation must not count as a question")
+	}
+}
+
+func TestSave_SeedsSnapshotAndEvents(t *testing.T) {
+	st := newTestStore(t)
+	ctx := context.Background()
+	owner := "placement-seed"
+	eventRepo, snapRepo := st.EventRepoFor(owner), st.SnapshotRepoFor(owner)
+
+	needed, err := Needed(ctx, snapRepo)
+	if err != nil || !needed {
+		t.Fatalf("Needed before placement = %v, %v; want true", needed, err)
+	}
+
+	res := skillgraph.DiagnosticResult{MasteredSkillIDs: []string{"pv-hundreds", "compare-1000"}}
+	if err := Save(ctx, eventRepo, snapRepo, res, "sess-1"); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	snap, err := snapRepo.Latest(ctx)
+	if err != nil || snap == nil {
+		t.Fatalf("Latest: %v, %v", snap, err)
+	}
+	for _, id := range res.MasteredSkillIDs {
+		if sm := snap.Data.Mastery.Skills[id]; sm == nil || sm.State != "mastered" {
+			t.Errorf("snapshot %s = %+v, want mastered", id, sm)
+		}
+		if snap.Data.SpacedRep.Reviews[id] == nil {
+			t.Errorf("no review scheduled for %s", id)
+		}
+	}
+
+	events, err := eventRepo.QueryMasteryEvents(ctx, store.QueryOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d mastery events, want 2", len(events))
+	}
+	for _, e := range events {
+		if e.Trigger != "diagnostic" || e.ToState != "mastered" || e.SessionID != "sess-1" {
+			t.Errorf("event = %+v", e)
+		}
+	}
+
+	if needed, _ := Needed(ctx, snapRepo); needed {
+		t.Error("placement offered again after saving")
+	}
+}
+
+func TestSave_SkipRecordsEmptySnapshot(t *testing.T) {
+	st := newTestStore(t)
+	ctx := context.Background()
+	owner := "placement-skip"
+	snapRepo := st.SnapshotRepoFor(owner)
+
+	if err := Save(ctx, st.EventRepoFor(owner), snapRepo, skillgraph.DiagnosticResult{}, "sess-2"); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if needed, _ := Needed(ctx, snapRepo); needed {
+		t.Error("a skipped placement should not be offered again")
+	}
+}
@@ -2,39 +2,61 @@ package problemgen
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 // CheckAnswer compares the learner's input against the correct answer.
-// Returns true if the answer is correct.
+// Returns true if the answer is correct. It is GradeAnswer(...).Correct();
+// use GradeAnswer to tell near misses and unsimplified answers apart.
 //
 // Normalization rules:
 // - Whitespace is trimmed
 // - Comparison is case-insensitive
-// - For fractions: equivalent fractions are accepted (e.g., "2/4" matches "1/2")
+// - For fractions: equivalent fractions are accepted (e.g., "2/4" matches "1/2") unless the question asks for simplest form
 // - For decimals: trailing zeros are ignored (e.g., "3.50" matches "3.5")
 // - For integers: leading zeros are ignored (e.g., "007" matches "7")
+// - For mixed numbers: any equal form (e.g., "9/4" or "2.25" matches "2 1/4")
+// - For measurements and time: unit spellings are interchangeable (e.g., "45 minutes" matches "45 min")
+// - For single-unit measurements, money and durations: the unit may be left off
+// - For money: the symbol is optional and may follow the amount (e.g., "4.75", "4.75 €" and "475 cents" match "$4.75"); a bare number is read in the answer's unit ("75" matches "75¢")
+// - For times of day: "3:45" matches "3:45 pm" and "15:45"
 // - For multiple choice: matches against the choice text or index (1-4)
+// - For true/false: "true"/"false", "t"/"f" or "yes"/"no"
+// - For ordering: the choice texts in the same order, separated by AnswerSeparator
+// - For multi-select: the same choice texts in any order
+// - For multi-blank: one part per blank, each normalized by answer type
 func CheckAnswer(learnerAnswer string, question *Question) bool {
+	return GradeAnswer(learnerAnswer, question).Correct()
+}
+
+// matchesAnswer reports whether the learner's answer equals the correct
+// one under the normalization rules of CheckAnswer, ignoring whether it is
+// in simplest form.
+func matchesAnswer(learnerAnswer string, question *Question) bool {
 	learnerAnswer = strings.TrimSpace(learnerAnswer)
 	if learnerAnswer == "" {
 		return false
 	}
 
-	if question.Format == FormatMultipleChoice {
+	switch question.Format {
+	case FormatMultipleChoice:
 		return checkMultipleChoice(learnerAnswer, question)
+	case FormatTrueFalse:
+		learner, ok := parseTrueFalse(learnerAnswer)
+		correct, okCorrect := parseTrueFalse(question.Answer)
+		return ok && okCorrect && learner == correct
+	case FormatOrdering:
+		return slices.EqualFunc(SplitAnswer(learnerAnswer), SplitAnswer(question.Answer), strings.EqualFold)
+	case FormatMultiSelect:
+		return sameSelection(SplitAnswer(learnerAnswer), SplitAnswer(question.Answer))
+	case FormatMultiBlank:
+		return slices.EqualFunc(SplitAnswer(learnerAnswer), SplitAnswer(question.Answer), func(l, c string) bool {
+			return answersEqual(l, c, question.AnswerType)
+		})
 	}
-
-	normalizedLearner, err := normalizeAnswer(learnerAnswer, question.AnswerType)
-	if err != nil {
-		return false
-	}
-	normalizedCorrect, err := normalizeAnswer(question.Answer, question.AnswerType)
-	if err != nil {
-		return false
-	}
-	return normalizedLearner == normalizedCorrect
+	return answersEqual(learnerAnswer, question.Answer, question.AnswerType)
 }
 
 // checkMultipleChoice checks the learner's answer against MC choices.
@@ -54,6 +76,56 @@ func checkMultipleChoice(learnerAnswer string, question *Question) bool {
 	)
 }
 
+// parseTrueFalse reads a true/false answer.
+func parseTrueFalse(s string) (value, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "yes", "y":
+		return true, true
+	case "false", "f", "no", "n":
+		return false, true
+	}
+	return false, false
+}
+
+// answersEqual compares the learner's answer (or one part of it) with the
+// correct one, normalized by answer type.
+func answersEqual(learner, correct string, answerType AnswerType) bool {
+	if learner == "" {
+		return false
+	}
+	if answerType.hasUnits() {
+		return unitsEqual(learner, correct, answerType)
+	}
+	l, err := normalizeAnswer(learner, answerType)
+	if err != nil {
+		return false
+	}
+	c, err := normalizeAnswer(correct, answerType)
+	return err == nil && l == c
+}
+
+// sameSelection reports whether two multi-select answers pick the same
+// options, in any order.
+func sameSelection(learner, correct []string) bool {
+	if len(learner) != len(correct) {
+		return false
+	}
+	used := make([]bool, len(correct))
+	for _, l := range learner {
+		found := false
+		for i, c := range correct {
+			if !used[i] && strings.EqualFold(l, c) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // normalizeAnswer normalizes an answer string for comparison.
 func normalizeAnswer(answer string, answerType AnswerType) (string, error) {
 	answer = strings.TrimSpace(answer)
@@ -92,6 +164,18 @@ func normalizeAnswer(answer string, answerType AnswerType) (string, error) {
 		den /= g
 		return fmt.Sprintf("%d/%d", num, den), nil
 
+	case AnswerTypeMixedNumber:
+		return normalizeMixedNumber(answer)
+
+	case AnswerTypeMeasurement:
+		return normalizeMeasurement(answer, measurementUnits)
+
+	case AnswerTypeMoney:
+		return normalizeMoney(answer)
+
+	case AnswerTypeTime:
+		return normalizeTime(answer)
+
 	default:
 		return answer, nil
 	}
@@ -3,105 +3,198 @@ package problemgen
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 var fractionPattern = regexp.MustCompile(`^-?\d+/\d+$`)
 
+// blankPattern matches a blank in multi-blank question text.
+var blankPattern = regexp.MustCompile(`_{2,}`)
+
+// Choice counts for the choice formats other than multiple choice.
+const (
+	minOrderingItems    = 3
+	maxOrderingItems    = 6
+	minMultiSelectItems = 4
+	maxMultiSelectItems = 6
+	minBlanks           = 2
+	maxBlanks           = 4
+)
+
 // AnswerFormatValidator checks that the answer string matches the declared
-// answer_type (integer, decimal, fraction) and that multiple choice
-// constraints are satisfied.
+// answer_type (integer, decimal, fraction) and that the constraints of the
+// answer format are satisfied: choice counts, the answer's relation to the
+// choices, and one answer per blank.
 type AnswerFormatValidator struct{}
 
 func (v *AnswerFormatValidator) Name() string { return "answer-format" }
 
 func (v *AnswerFormatValidator) Validate(q *Question, _ GenerateInput) *ValidationError {
 	// Validate answer matches declared type.
-	switch q.AnswerType {
-	case AnswerTypeInteger:
-		if err := validateInteger(q.Answer); err != nil {
-			return &ValidationError{
-				Validator: v.Name(),
-				Message:   fmt.Sprintf("invalid integer answer %q: %s", q.Answer, err),
-				Retryable: true,
-			}
+	switch q.Format {
+	case FormatNumeric, FormatMultipleChoice:
+		if err := validateTyped(q.Answer, q.AnswerType); err != nil {
+			return v.fail("invalid %s answer %q: %s", q.AnswerType, q.Answer, err)
 		}
-	case AnswerTypeDecimal:
-		if err := validateDecimal(q.Answer); err != nil {
-			return &ValidationError{
-				Validator: v.Name(),
-				Message:   fmt.Sprintf("invalid decimal answer %q: %s", q.Answer, err),
-				Retryable: true,
-			}
+	case FormatMultiBlank:
+		parts := SplitAnswer(q.Answer)
+		if len(parts) < minBlanks || len(parts) > maxBlanks {
+			return v.fail("multi-blank needs %d to %d answers, got %d", minBlanks, maxBlanks, len(parts))
 		}
-	case AnswerTypeFraction:
-		if err := validateFraction(q.Answer); err != nil {
-			return &ValidationError{
-				Validator: v.Name(),
-				Message:   fmt.Sprintf("invalid fraction answer %q: %s", q.Answer, err),
-				Retryable: true,
+		if n := len(blankPattern.FindAllString(q.Text, -1)); n != len(parts) {
+			return v.fail("question has %d blanks but %d answers", n, len(parts))
+		}
+		for i, p := range parts {
+			if err := validateTyped(p, q.AnswerType); err != nil {
+				return v.fail("invalid %s answer %q for blank %d: %s", q.AnswerType, p, i+1, err)
 			}
 		}
+	case FormatTrueFalse:
+		if _, ok := parseTrueFalse(q.Answer); !ok {
+			return v.fail("true/false answer must be \"True\" or \"False\", got %q", q.Answer)
+		}
 	}
 
-	// Validate multiple choice constraints.
-	if q.Format == FormatMultipleChoice {
+	// Validate choice constraints.
+	switch q.Format {
+	case FormatMultipleChoice:
 		if len(q.Choices) != 4 {
-			return &ValidationError{
-				Validator: v.Name(),
-				Message:   fmt.Sprintf("multiple choice must have exactly 4 choices, got %d", len(q.Choices)),
-				Retryable: true,
-			}
+			return v.fail("multiple choice must have exactly 4 choices, got %d", len(q.Choices))
 		}
-		// All choices must be non-empty and distinct.
-		seen := make(map[string]bool, 4)
-		for i, c := range q.Choices {
-			c = strings.TrimSpace(c)
-			if c == "" {
-				return &ValidationError{
-					Validator: v.Name(),
-					Message:   fmt.Sprintf("choice %d is empty", i+1),
-					Retryable: true,
-				}
-			}
-			key := strings.ToLower(c)
-			if seen[key] {
-				return &ValidationError{
-					Validator: v.Name(),
-					Message:   fmt.Sprintf("duplicate choice %q", c),
-					Retryable: true,
-				}
-			}
-			seen[key] = true
+		if verr := v.validateChoices(q.Choices); verr != nil {
+			return verr
 		}
 		// Exactly one choice must match the answer.
-		answerLower := strings.ToLower(strings.TrimSpace(q.Answer))
-		found := false
-		for _, c := range q.Choices {
-			if strings.ToLower(strings.TrimSpace(c)) == answerLower {
-				found = true
-				break
-			}
+		if !containsFold(q.Choices, q.Answer) {
+			return v.fail("answer %q not found in choices", q.Answer)
 		}
-		if !found {
-			return &ValidationError{
-				Validator: v.Name(),
-				Message:   fmt.Sprintf("answer %q not found in choices", q.Answer),
-				Retryable: true,
-			}
+	case FormatTrueFalse:
+		if len(q.Choices) > 0 && !slices.EqualFunc(q.Choices, TrueFalseChoices, strings.EqualFold) {
+			return v.fail("true/false choices must be %q", TrueFalseChoices)
+		}
+	case FormatOrdering:
+		if len(q.Choices) < minOrderingItems || len(q.Choices) > maxOrderingItems {
+			return v.fail("ordering needs %d to %d items, got %d", minOrderingItems, maxOrderingItems, len(q.Choices))
+		}
+		if verr := v.validateChoices(q.Choices); verr != nil {
+			return verr
+		}
+		order := SplitAnswer(q.Answer)
+		if len(order) != len(q.Choices) {
+			return v.fail("ordering answer has %d items, want all %d choices", len(order), len(q.Choices))
+		}
+		if verr := v.validateSubset(q.Choices, order); verr != nil {
+			return verr
+		}
+	case FormatMultiSelect:
+		if len(q.Choices) < minMultiSelectItems || len(q.Choices) > maxMultiSelectItems {
+			return v.fail("multi-select needs %d to %d choices, got %d", minMultiSelectItems, maxMultiSelectItems, len(q.Choices))
+		}
+		if verr := v.validateChoices(q.Choices); verr != nil {
+			return verr
+		}
+		picks := SplitAnswer(q.Answer)
+		if len(picks) >= len(q.Choices) {
+			return v.fail("multi-select must leave at least one choice unselected")
+		}
+		if verr := v.validateSubset(q.Choices, picks); verr != nil {
+			return verr
+		}
+	case FormatNumeric:
+		// Numeric format must have no choices.
+		if len(q.Choices) > 0 {
+			return v.fail("numeric format must have empty choices")
+		}
+	case FormatMultiBlank:
+		if len(q.Choices) > 0 {
+			return v.fail("multi-blank format must have empty choices")
+		}
+	}
+
+	return nil
+}
+
+func (v *AnswerFormatValidator) fail(format string, args ...any) *ValidationError {
+	return &ValidationError{
+		Validator: v.Name(),
+		Message:   fmt.Sprintf(format, args...),
+		Retryable: true,
+	}
+}
+
+// validateChoices checks that all choices are non-empty and distinct.
+func (v *AnswerFormatValidator) validateChoices(choices []string) *ValidationError {
+	seen := make(map[string]bool, len(choices))
+	for i, c := range choices {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return v.fail("choice %d is empty", i+1)
 		}
+		key := strings.ToLower(c)
+		if seen[key] {
+			return v.fail("duplicate choice %q", c)
+		}
+		seen[key] = true
 	}
+	return nil
+}
 
-	// Numeric format must have no choices.
-	if q.Format == FormatNumeric && len(q.Choices) > 0 {
-		return &ValidationError{
-			Validator: v.Name(),
-			Message:   "numeric format must have empty choices",
-			Retryable: true,
+// validateSubset checks that every answer part is a distinct choice.
+func (v *AnswerFormatValidator) validateSubset(choices, parts []string) *ValidationError {
+	seen := make(map[string]bool, len(parts))
+	for _, p := range parts {
+		if !containsFold(choices, p) {
+			return v.fail("answer %q not found in choices", p)
+		}
+		key := strings.ToLower(p)
+		if seen[key] {
+			return v.fail("answer %q appears twice", p)
 		}
+		seen[key] = true
 	}
+	return nil
+}
 
+// containsFold reports whether s is among choices, ignoring case and
+// surrounding space.
+func containsFold(choices []string, s string) bool {
+	s = strings.TrimSpace(s)
+	return slices.ContainsFunc(choices, func(c string) bool {
+		return strings.EqualFold(strings.TrimSpace(c), s)
+	})
+}
+
+// validateTyped checks s against the answer type. Text answers are not
+// checked.
+func validateTyped(s string, answerType AnswerType) error {
+	switch answerType {
+	case AnswerTypeInteger:
+		return validateInteger(s)
+	case AnswerTypeDecimal:
+		return validateDecimal(s)
+	case AnswerTypeFraction:
+		return validateFraction(s)
+	case AnswerTypeMixedNumber:
+		return validateMixedNumber(s)
+	case AnswerTypeMeasurement, AnswerTypeMoney, AnswerTypeTime:
+		_, err := normalizeAnswer(s, answerType)
+		return err
+	}
+	return nil
+}
+
+// validateMixedNumber checks that s is a mixed number in simplest form: a
+// whole number, a proper fraction in lowest terms, or both ("2 1/4").
+func validateMixedNumber(s string) error {
+	normalized, err := normalizeMixedNumber(s)
+	if err != nil {
+		return fmt.Errorf("not a valid mixed number")
+	}
+	if normalized != s {
+		return fmt.Errorf("not in simplest mixed form (expected %q)", normalized)
+	}
 	return nil
 }
 
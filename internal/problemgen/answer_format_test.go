@@ -123,3 +123,77 @@ func TestAnswerFormat_NumericWithChoices(t *testing.T) {
 		t.Error("expected error for numeric format with choices")
 	}
 }
+
+func TestAnswerFormat_NewFormats(t *testing.T) {
+	v := &AnswerFormatValidator{}
+	q := func(format AnswerFormat, answerType AnswerType, text, answer string, choices ...string) *Question {
+		base := validQuestion()
+		base.Format, base.AnswerType, base.Answer, base.Choices = format, answerType, answer, choices
+		if text != "" {
+			base.Text = text
+		}
+		return base
+	}
+
+	valid := []*Question{
+		q(FormatTrueFalse, AnswerTypeText, "True or false: 3/4 > 2/3", "True", "True", "False"),
+		q(FormatTrueFalse, AnswerTypeText, "True or false: 3/4 > 2/3", "False"),
+		q(FormatOrdering, AnswerTypeInteger, "Order from least to greatest.", "120; 201; 210", "210", "120", "201"),
+		q(FormatMultiSelect, AnswerTypeFraction, "Select all fractions equal to 1/2.", "2/4; 3/6", "2/4", "3/6", "2/3", "3/4"),
+		q(FormatMultiBlank, AnswerTypeInteger, "3 × __ = 12 and __ ÷ 2 = 6", "4; 12"),
+	}
+	for _, vq := range valid {
+		if err := v.Validate(vq, GenerateInput{}); err != nil {
+			t.Errorf("%s %q should be valid: %v", vq.Format, vq.Text, err)
+		}
+	}
+
+	invalid := []*Question{
+		q(FormatTrueFalse, AnswerTypeText, "", "Maybe"),
+		q(FormatOrdering, AnswerTypeInteger, "", "1; 2", "1", "2"),                    // too few items
+		q(FormatOrdering, AnswerTypeInteger, "", "1; 2; 3", "3", "1", "2", "4"),       // answer misses an item
+		q(FormatOrdering, AnswerTypeInteger, "", "1; 1; 2", "1", "2", "3"),            // repeats an item
+		q(FormatMultiSelect, AnswerTypeInteger, "", "1; 2; 3; 4", "1", "2", "3", "4"), // selects everything
+		q(FormatMultiSelect, AnswerTypeInteger, "", "5", "1", "2", "3", "4"),          // not a choice
+		q(FormatMultiBlank, AnswerTypeInteger, "3 × __ = 12", "4; 12"),                // blanks/answers mismatch
+		q(FormatMultiBlank, AnswerTypeInteger, "__ + __ = 5", "2; 3.5"),               // wrong type
+		q(FormatMultiBlank, AnswerTypeInteger, "__ + __ = 5", "2; 3", "2", "3"),       // has choices
+	}
+	for _, iq := range invalid {
+		if err := v.Validate(iq, GenerateInput{}); err == nil {
+			t.Errorf("%s answer %q with choices %q should be invalid", iq.Format, iq.Answer, iq.Choices)
+		}
+	}
+}
+
+func TestAnswerFormat_UnitTypes(t *testing.T) {
+	v := &AnswerFormatValidator{}
+	tests := []struct {
+		answerType AnswerType
+		answer     string
+		valid      bool
+	}{
+		{AnswerTypeMixedNumber, "2 1/4", true},
+		{AnswerTypeMixedNumber, "3/4", true},
+		{AnswerTypeMixedNumber, "5", true},
+		{AnswerTypeMixedNumber, "9/4", false},   // not in mixed form
+		{AnswerTypeMixedNumber, "2 2/8", false}, // not in lowest terms
+		{AnswerTypeMeasurement, "3 ft 4 in", true},
+		{AnswerTypeMeasurement, "3", false},         // no unit
+		{AnswerTypeMeasurement, "3 parsecs", false}, // unknown unit
+		{AnswerTypeMoney, "$4.75", true},
+		{AnswerTypeMoney, "$4.755", false},
+		{AnswerTypeTime, "45 min", true},
+		{AnswerTypeTime, "3:45 pm", true},
+		{AnswerTypeTime, "45 km", false},
+	}
+
+	for _, tc := range tests {
+		q := validQuestion()
+		q.Answer, q.AnswerType = tc.answer, tc.answerType
+		err := v.Validate(q, GenerateInput{})
+		if (err == nil) != tc.valid {
+			t.Errorf("%s answer %q: valid = %v, want %v (%v)", tc.answerType, tc.answer, err == nil, tc.valid, err)
+		}
+	}
+}
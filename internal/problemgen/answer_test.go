@@ -158,3 +158,152 @@ func TestCheckAnswer_TextMultipleChoice(t *testing.T) {
 		t.Error("expected wrong text not to match")
 	}
 }
+
+func TestCheckAnswer_TrueFalse(t *testing.T) {
+	q := &Question{Format: FormatTrueFalse, Answer: "True", AnswerType: AnswerTypeText}
+	for input, want := range map[string]bool{"True": true, "true": true, "t": true, "yes": true, "False": false, "no": false, "maybe": false} {
+		if got := CheckAnswer(input, q); got != want {
+			t.Errorf("CheckAnswer(%q, True) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestCheckAnswer_Ordering(t *testing.T) {
+	q := &Question{
+		Format:     FormatOrdering,
+		Answer:     "1,000; 1,010; 1,100",
+		AnswerType: AnswerTypeInteger,
+		Choices:    []string{"1,100", "1,000", "1,010"},
+	}
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"1,000; 1,010; 1,100", true},
+		{"1,000;1,010;1,100", true},
+		{"1,010; 1,000; 1,100", false},
+		{"1,000; 1,010", false},
+		{"1,000; 1,010; 1,100; 1,100", false},
+	}
+	for _, tc := range tests {
+		if got := CheckAnswer(tc.input, q); got != tc.want {
+			t.Errorf("CheckAnswer(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestCheckAnswer_MultiSelect(t *testing.T) {
+	q := &Question{
+		Format:     FormatMultiSelect,
+		Answer:     "1/2; 2/4",
+		AnswerType: AnswerTypeFraction,
+		Choices:    []string{"1/2", "2/4", "2/3", "3/4"},
+	}
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"1/2; 2/4", true},
+		{"2/4; 1/2", true},
+		{"1/2", false},
+		{"1/2; 2/4; 3/4", false},
+		{"1/2; 1/2", false},
+	}
+	for _, tc := range tests {
+		if got := CheckAnswer(tc.input, q); got != tc.want {
+			t.Errorf("CheckAnswer(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestCheckAnswer_MultiBlank(t *testing.T) {
+	q := &Question{
+		Format:     FormatMultiBlank,
+		Text:       "3 × __ = 12 and __ ÷ 2 = 6",
+		Answer:     "4; 12",
+		AnswerType: AnswerTypeInteger,
+	}
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"4; 12", true},
+		{"04;12", true},
+		{"12; 4", false},
+		{"4;", false},
+		{"4", false},
+	}
+	for _, tc := range tests {
+		if got := CheckAnswer(tc.input, q); got != tc.want {
+			t.Errorf("CheckAnswer(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestCheckAnswer_UnitTypes(t *testing.T) {
+	tests := []struct {
+		answerType AnswerType
+		answer     string
+		input      string
+		want       bool
+	}{
+		{AnswerTypeMixedNumber, "2 1/4", "2 1/4", true},
+		{AnswerTypeMixedNumber, "2 1/4", "9/4", true},
+		{AnswerTypeMixedNumber, "2 1/4", "2.25", true},
+		{AnswerTypeMixedNumber, "2 1/4", "2 and 1/4", true},
+		{AnswerTypeMixedNumber, "2 1/4", "2 2/8", true},
+		{AnswerTypeMixedNumber, "2 1/4", "2 1/2", false},
+		{AnswerTypeMixedNumber, "3/4", "0.75", true},
+
+		{AnswerTypeMeasurement, "3 ft 4 in", "3 feet 4 inches", true},
+		{AnswerTypeMeasurement, "3 ft 4 in", "3ft 4in", true},
+		{AnswerTypeMeasurement, "3 ft 4 in", "3 feet and 4 inches", true},
+		{AnswerTypeMeasurement, "3 ft 4 in", `3' 4"`, true},
+		{AnswerTypeMeasurement, "3 ft 4 in", "40 in", false}, // no conversion
+		{AnswerTypeMeasurement, "3 ft 4 in", "3", false},
+		{AnswerTypeMeasurement, "2.5 kg", "2 1/2 kilograms", true},
+		{AnswerTypeMeasurement, "2.5 kg", "2.5", true}, // bare number, one unit
+		{AnswerTypeMeasurement, "2.5 kg", "2.5 g", false},
+		{AnswerTypeMeasurement, "12 sq cm", "12 square centimeters", true},
+		{AnswerTypeMeasurement, "12 sq cm", "12 cm²", true},
+		{AnswerTypeMeasurement, "12 sq cm", "12 cm", false},
+
+		{AnswerTypeMoney, "$4.75", "4.75", true},
+		{AnswerTypeMoney, "$4.75", "$4.75", true},
+		{AnswerTypeMoney, "$4.75", "475 cents", true},
+		{AnswerTypeMoney, "$4.75", "475¢", true},
+		{AnswerTypeMoney, "$4.75", "4 dollars and 75 cents", true},
+		{AnswerTypeMoney, "$4.75", "$4.57", false},
+		{AnswerTypeMoney, "$0.50", "50c", true},
+		{AnswerTypeMoney, "$0.50", "$.5", true},
+		{AnswerTypeMoney, "$4.75", "4.75 €", true},
+		{AnswerTypeMoney, "$4.75", "4.75$", true},
+		{AnswerTypeMoney, "$4.75", "-4.75 €", false},
+		{AnswerTypeMoney, "75¢", "75", true}, // bare number in the answer's unit
+		{AnswerTypeMoney, "75 cents", "75", true},
+		{AnswerTypeMoney, "75 cents", "$0.75", true},
+		{AnswerTypeMoney, "75 cents", "$75", false},
+		{AnswerTypeMoney, "75¢", "0.75", false},
+		{AnswerTypeMoney, "$0.75", "75", false},
+
+		{AnswerTypeTime, "45 min", "45 minutes", true},
+		{AnswerTypeTime, "45 min", "45 mins", true},
+		{AnswerTypeTime, "45 min", "45", true},
+		{AnswerTypeTime, "45 min", "45 h", false},
+		{AnswerTypeTime, "1 h 30 min", "1 hour 30 minutes", true},
+		{AnswerTypeTime, "1 h 30 min", "90 min", false},
+		{AnswerTypeTime, "3:45 pm", "3:45", true},
+		{AnswerTypeTime, "3:45 pm", "15:45", true},
+		{AnswerTypeTime, "3:45 pm", "3:45 PM", true},
+		{AnswerTypeTime, "3:45 pm", "3:45 am", false},
+		{AnswerTypeTime, "12:00 pm", "noon", true},
+		{AnswerTypeTime, "3:45", "45 min", false},
+	}
+
+	for _, tc := range tests {
+		q := &Question{Format: FormatNumeric, Answer: tc.answer, AnswerType: tc.answerType}
+		if got := CheckAnswer(tc.input, q); got != tc.want {
+			t.Errorf("CheckAnswer(%q, %q/%s) = %v, want %v", tc.input, tc.answer, tc.answerType, got, tc.want)
+		}
+	}
+}
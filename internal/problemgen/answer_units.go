@@ -0,0 +1,403 @@
+package problemgen
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// quantity is one number-with-unit part of an answer: "3 ft 4 in" has two.
+type quantity struct {
+	value *big.Rat
+	unit  string // lower-cased unit phrase as written; "" when absent
+}
+
+// parseQuantities splits s into number-unit pairs. A unit runs from the end
+// of its number to the next digit, so "3ft4in", "3 feet 4 inches" and
+// "3 feet and 4 inches" all parse the same.
+func parseQuantities(s string) ([]quantity, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	var out []quantity
+	for s != "" {
+		neg := false
+		if s[0] == '-' {
+			neg, s = true, strings.TrimSpace(s[1:])
+		}
+		if len(s) > 1 && s[0] == '.' && isDigit(s[1]) {
+			s = "0" + s // ".5"
+		}
+		if s == "" || !isDigit(s[0]) {
+			return nil, fmt.Errorf("expected a number at %q", s)
+		}
+		tok, n := scanNumber(s)
+		v := new(big.Rat).Set(tok.num)
+		if neg {
+			v.Neg(v)
+		}
+		s = s[n:]
+
+		end := strings.IndexFunc(s, func(r rune) bool { return r >= '0' && r <= '9' || r == '-' })
+		if end < 0 {
+			end = len(s)
+		}
+		unit := strings.Trim(s[:end], " ,")
+		unit = strings.TrimSpace(strings.TrimSuffix(unit, " and"))
+		out = append(out, quantity{value: v, unit: unit})
+		s = strings.TrimSpace(s[end:])
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("empty answer")
+	}
+	return out, nil
+}
+
+// Canonical unit names by the spellings learners use. Plurals ending in "s"
+// are found by trimming the "s", so only irregular plurals are listed.
+var (
+	timeUnits = map[string]string{
+		"s": "s", "sec": "s", "secs": "s", "second": "s",
+		"min": "min", "mins": "min", "minute": "min",
+		"h": "h", "hr": "h", "hrs": "h", "hour": "h",
+		"d": "day", "day": "day",
+		"wk": "week", "week": "week",
+		"mo": "month", "month": "month",
+		"yr": "year", "yrs": "year", "year": "year",
+	}
+
+	lengthMassCapacityUnits = map[string]string{
+		"mm": "mm", "millimeter": "mm", "millimetre": "mm",
+		"cm": "cm", "centimeter": "cm", "centimetre": "cm",
+		"m": "m", "meter": "m", "metre": "m",
+		"km": "km", "kilometer": "km", "kilometre": "km",
+		"in": "in", "inch": "in", "inches": "in", `"`: "in",
+		"ft": "ft", "foot": "ft", "feet": "ft", "'": "ft",
+		"yd": "yd", "yard": "yd",
+		"mi": "mi", "mile": "mi",
+		"mg": "mg", "milligram": "mg",
+		"g": "g", "gm": "g", "gram": "g",
+		"kg": "kg", "kilogram": "kg", "kilo": "kg",
+		"oz": "oz", "ounce": "oz",
+		"lb": "lb", "lbs": "lb", "pound": "lb",
+		"ml": "ml", "milliliter": "ml", "millilitre": "ml",
+		"l": "l", "liter": "l", "litre": "l",
+		"cup": "cup", "c": "cup",
+		"pt": "pt", "pint": "pt",
+		"qt": "qt", "quart": "qt",
+		"gal": "gal", "gallon": "gal",
+		"°c": "°C", "degrees celsius": "°C", "degree celsius": "°C",
+		"°f": "°F", "degrees fahrenheit": "°F", "degree fahrenheit": "°F",
+	}
+
+	// measurementUnits is every unit a measurement answer may use.
+	measurementUnits = mergeUnits(lengthMassCapacityUnits, timeUnits)
+)
+
+func mergeUnits(tables ...map[string]string) map[string]string {
+	out := map[string]string{}
+	for _, t := range tables {
+		for k, v := range t {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// canonicalUnit looks up a unit phrase, including square and cubic forms
+// ("sq cm", "square centimeters", "cm²" → "sq cm").
+func canonicalUnit(phrase string, table map[string]string) (string, bool) {
+	phrase = strings.Join(strings.Fields(strings.ReplaceAll(phrase, ".", " ")), " ")
+	for _, p := range []struct{ prefix, canon string }{
+		{"square ", "sq "}, {"sq ", "sq "}, {"cubic ", "cu "}, {"cu ", "cu "},
+	} {
+		if rest, ok := strings.CutPrefix(phrase, p.prefix); ok {
+			u, ok := lookupUnit(rest, table)
+			return p.canon + u, ok
+		}
+	}
+	for _, p := range []struct{ suffix, canon string }{
+		{"²", "sq "}, {"^2", "sq "}, {"³", "cu "}, {"^3", "cu "},
+	} {
+		if rest, ok := strings.CutSuffix(phrase, p.suffix); ok {
+			u, ok := lookupUnit(strings.TrimSpace(rest), table)
+			return p.canon + u, ok
+		}
+	}
+	return lookupUnit(phrase, table)
+}
+
+func lookupUnit(phrase string, table map[string]string) (string, bool) {
+	if u, ok := table[phrase]; ok {
+		return u, true
+	}
+	if u, ok := table[strings.TrimSuffix(phrase, "s")]; ok && strings.HasSuffix(phrase, "s") {
+		return u, true
+	}
+	return "", false
+}
+
+// normalizeMixedNumber renders any form of a rational number ("9/4",
+// "2 1/4", "2.25", "2 and 1/4") as a mixed number: "2 1/4".
+func normalizeMixedNumber(s string) (string, error) {
+	s = strings.Join(strings.Fields(strings.ReplaceAll(s, " and ", " ")), " ")
+	v, ok := parseNumber(s)
+	if !ok {
+		return "", fmt.Errorf("invalid mixed number: %q", s)
+	}
+	return formatMixed(v), nil
+}
+
+// formatMixed renders v as a whole number, a proper fraction, or a whole
+// number and a proper fraction.
+func formatMixed(v *big.Rat) string {
+	if v.IsInt() {
+		return v.Num().String()
+	}
+	sign := ""
+	if v.Sign() < 0 {
+		sign = "-"
+		v = new(big.Rat).Abs(v)
+	}
+	whole, rem := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	frac := rem.String() + "/" + v.Denom().String()
+	if whole.Sign() == 0 {
+		return sign + frac
+	}
+	return sign + whole.String() + " " + frac
+}
+
+// normalizeMeasurement renders each number-unit part with its value in
+// canonical form and its unit's canonical name: "3 feet and 4 inches" →
+// "3 ft 4 in". Parts are not converted between units, so "40 in" is not
+// "3 ft 4 in" — a conversion question must be answered in the unit asked.
+func normalizeMeasurement(s string, table map[string]string) (string, error) {
+	qs, err := parseQuantities(s)
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, len(qs))
+	for i, q := range qs {
+		u, ok := canonicalUnit(q.unit, table)
+		if !ok {
+			if q.unit == "" {
+				return "", fmt.Errorf("missing unit in %q", s)
+			}
+			return "", fmt.Errorf("unknown unit %q", q.unit)
+		}
+		parts[i] = formatRat(q.value) + " " + u
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// currencyPrefixes are stripped from the front of money answers, and
+// currencySymbols from the back too: "4,75 €" is how much of Europe writes
+// it.
+var (
+	currencyPrefixes = []string{"$", "€", "£", "₹", "rs.", "rs", "usd", "inr"}
+	currencySymbols  = []string{"$", "€", "£", "₹"}
+)
+
+// moneyUnits maps money unit words to their worth in minor units (cents).
+// The empty unit is a bare number, worth a major unit unless the correct
+// answer says otherwise (see moneyUnitWorth).
+var moneyUnits = map[string]int64{
+	"": 100, "dollar": 100, "buck": 100, "rupee": 100, "pound": 100, "euro": 100,
+	"cent": 1, "¢": 1, "c": 1, "p": 1, "penny": 1, "pence": 1, "paise": 1,
+}
+
+// normalizeMoney renders an amount of money as major units with two
+// decimals: "$4.75", "4.75", "4.75 €", "475¢" and "4 dollars 75 cents" are
+// all "4.75". The currency symbol is not compared.
+func normalizeMoney(s string) (string, error) {
+	return normalizeMoneyIn(s, moneyUnits[""])
+}
+
+// normalizeMoneyIn is normalizeMoney with a bare number (no unit and no
+// currency symbol) worth bareWorth minor units.
+func normalizeMoneyIn(s string, bareWorth int64) (string, error) {
+	qs, symbol, err := parseMoney(s)
+	if err != nil {
+		return "", err
+	}
+	if symbol {
+		bareWorth = moneyUnits[""]
+	}
+	total := new(big.Rat)
+	for _, q := range qs {
+		worth, ok := moneyUnitValue(q.unit)
+		if !ok {
+			return "", fmt.Errorf("unknown money unit %q", q.unit)
+		}
+		if q.unit == "" {
+			worth = bareWorth
+		}
+		total.Add(total, new(big.Rat).Mul(q.value, big.NewRat(worth, 1)))
+	}
+	if !total.IsInt() || !total.Num().IsInt64() {
+		return "", fmt.Errorf("amount %q is not a whole number of cents", s)
+	}
+	cents := total.Num().Int64()
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100), nil
+}
+
+// parseMoney splits an amount of money into its parts, stripping the sign
+// onto the first part and the currency symbol, which it reports.
+func parseMoney(s string) (qs []quantity, symbol bool, err error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	neg := false
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		neg, s = true, strings.TrimSpace(rest)
+	}
+	for _, p := range currencyPrefixes {
+		if rest, ok := strings.CutPrefix(s, p); ok {
+			s, symbol = strings.TrimSpace(rest), true
+			break
+		}
+	}
+	if !symbol {
+		for _, c := range currencySymbols {
+			if rest, ok := strings.CutSuffix(s, c); ok {
+				s, symbol = strings.TrimSpace(rest), true
+				break
+			}
+		}
+	}
+	qs, err = parseQuantities(s)
+	if err != nil {
+		return nil, false, err
+	}
+	if neg {
+		for _, q := range qs {
+			q.value.Neg(q.value)
+		}
+	}
+	return qs, symbol, nil
+}
+
+// moneyUnitValue looks up a money unit word, singular or plural.
+func moneyUnitValue(unit string) (int64, bool) {
+	if unit == "pennies" {
+		unit = "penny"
+	}
+	worth, ok := moneyUnits[strings.TrimSuffix(unit, "s")]
+	return worth, ok
+}
+
+// moneyUnitWorth returns what a bare number is worth when answering with
+// the given correct amount: the unit it is written in when that is a single
+// number and unit word, as in "75¢" or "75 cents", and a major unit
+// otherwise. A learner asked for cents who types "75" means 75 cents.
+func moneyUnitWorth(correct string) int64 {
+	qs, symbol, err := parseMoney(correct)
+	if err != nil || symbol || len(qs) != 1 || qs[0].unit == "" {
+		return moneyUnits[""]
+	}
+	if worth, ok := moneyUnitValue(qs[0].unit); ok {
+		return worth
+	}
+	return moneyUnits[""]
+}
+
+// clockPattern matches a time of day: "3:45", "3:45 pm", "15:45", "3 pm".
+var clockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm|a\.m\.|p\.m\.)?$`)
+
+// clockTime is a parsed time of day.
+type clockTime struct {
+	minutes  int  // minutes after midnight, or after 12:00 for a bare "3:45"
+	meridiem bool // am/pm given, or a 24-hour time past 12:59
+}
+
+// parseClock parses a time of day. "noon" and "midnight" are accepted.
+func parseClock(s string) (clockTime, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "noon", "midday":
+		return clockTime{minutes: 12 * 60, meridiem: true}, true
+	case "midnight":
+		return clockTime{minutes: 0, meridiem: true}, true
+	}
+	m := clockPattern.FindStringSubmatch(s)
+	if m == nil || (m[2] == "" && m[3] == "") {
+		return clockTime{}, false
+	}
+	h, _ := strconv.Atoi(m[1])
+	minute := 0
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	if h > 23 || minute > 59 {
+		return clockTime{}, false
+	}
+	switch strings.ReplaceAll(m[3], ".", "") {
+	case "am", "pm":
+		if h < 1 || h > 12 {
+			return clockTime{}, false
+		}
+		h %= 12
+		if strings.HasPrefix(m[3], "p") {
+			h += 12
+		}
+		return clockTime{minutes: h*60 + minute, meridiem: true}, true
+	}
+	return clockTime{minutes: h*60 + minute, meridiem: h == 0 || h > 12}, true
+}
+
+func (c clockTime) String() string {
+	return fmt.Sprintf("%d:%02d", c.minutes/60, c.minutes%60)
+}
+
+// sameClock compares two times of day. A time given without am/pm matches
+// either half of the day: "3:45" matches "3:45 pm" and "15:45".
+func sameClock(a, b clockTime) bool {
+	if a.meridiem && b.meridiem {
+		return a.minutes == b.minutes
+	}
+	return a.minutes%720 == b.minutes%720
+}
+
+// normalizeTime renders a time answer: a time of day ("3:45 pm" → "15:45")
+// or a duration in time units ("1 hour 30 minutes" → "1 h 30 min").
+func normalizeTime(s string) (string, error) {
+	if c, ok := parseClock(s); ok {
+		return c.String(), nil
+	}
+	return normalizeMeasurement(s, timeUnits)
+}
+
+// unitsEqual compares the learner's answer with the correct one for the
+// answer types with units. Beyond equal canonical forms, it accepts a bare
+// number for a single-part answer (the question names the unit; for money,
+// in the correct answer's unit) and a time of day given without am/pm.
+func unitsEqual(learner, correct string, answerType AnswerType) bool {
+	if answerType == AnswerTypeMoney {
+		l, lerr := normalizeMoneyIn(learner, moneyUnitWorth(correct))
+		c, cerr := normalizeMoney(correct)
+		return lerr == nil && cerr == nil && l == c
+	}
+	if answerType == AnswerTypeTime {
+		lc, lok := parseClock(learner)
+		cc, cok := parseClock(correct)
+		if lok || cok {
+			return lok && cok && sameClock(lc, cc)
+		}
+	}
+	l, lerr := normalizeAnswer(learner, answerType)
+	c, cerr := normalizeAnswer(correct, answerType)
+	if cerr != nil {
+		return false
+	}
+	if lerr == nil {
+		return l == c
+	}
+	bare, ok := parseNumber(learner)
+	if !ok {
+		return false
+	}
+	qs, err := parseQuantities(correct)
+	return err == nil && len(qs) == 1 && qs[0].value.Cmp(bare) == 0
+}
@@ -0,0 +1,182 @@
+package problemgen
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// SourceBank marks questions served from the question bank.
+const SourceBank = "bank"
+
+// AnswerRecorder is implemented by generators that learn from graded
+// answers. Session drivers call RecordAnswer after grading a question the
+// generator served.
+type AnswerRecorder interface {
+	RecordAnswer(ctx context.Context, q *Question, correct bool)
+}
+
+// BankedGenerator implements Generator over a persistent question bank.
+// Before calling the wrapped generator it serves a banked question for the
+// same skill and tier, within the target difficulty band, that the learner
+// has never answered; every question the wrapped generator produces is
+// banked for other learners and later sessions. Offline questions are not
+// banked: they cost nothing to make.
+//
+// Inputs with RecentErrors always go to the wrapped generator, so targeted
+// follow-up questions stay targeted. Bank failures are never fatal: the
+// bank is a cache in front of the generator.
+type BankedGenerator struct {
+	gen  Generator
+	bank store.QuestionBankRepo
+}
+
+// NewBanked creates a BankedGenerator serving from bank before gen.
+func NewBanked(gen Generator, bank store.QuestionBankRepo) *BankedGenerator {
+	return &BankedGenerator{gen: gen, bank: bank}
+}
+
+// Generate serves a banked question when one fits, and otherwise generates
+// and banks a new one.
+func (b *BankedGenerator) Generate(ctx context.Context, input GenerateInput) (*Question, error) {
+	if len(input.RecentErrors) == 0 {
+		data, err := b.bank.PickQuestion(ctx, bankQuery(input))
+		if err == nil && data != nil {
+			q := questionFromBank(data)
+			q.ShuffleChoices()
+			return q, nil
+		}
+	}
+
+	q, err := b.gen.Generate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if q.Source != SourceOffline {
+		_ = b.bank.SaveQuestion(ctx, bankData(q))
+	}
+	return q, nil
+}
+
+// GenerateBatch serves as many banked questions as fit, like Generate, and
+// generates and banks the rest in one batch.
+func (b *BankedGenerator) GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	var qs []*Question
+	if len(input.RecentErrors) == 0 {
+		for len(qs) < n {
+			data, err := b.bank.PickQuestion(ctx, bankQuery(withAsked(input, qs...)))
+			if err != nil || data == nil {
+				break
+			}
+			q := questionFromBank(data)
+			q.ShuffleChoices()
+			qs = append(qs, q)
+		}
+	}
+	if len(qs) == n {
+		return qs, nil
+	}
+
+	generated, err := b.gen.GenerateBatch(ctx, withAsked(input, qs...), n-len(qs))
+	for _, q := range generated {
+		if q.Source != SourceOffline {
+			_ = b.bank.SaveQuestion(ctx, bankData(q))
+		}
+	}
+	qs = append(qs, generated...)
+	if len(qs) == 0 {
+		return nil, err
+	}
+	return qs, nil
+}
+
+// RecordAnswer adds a graded answer to the question's bank statistics.
+func (b *BankedGenerator) RecordAnswer(ctx context.Context, q *Question, correct bool) {
+	_ = b.bank.RecordAnswer(ctx, q.SkillID, q.Text, correct)
+}
+
+// Supports reports whether the wrapped generator covers skill.
+func (b *BankedGenerator) Supports(skill skillgraph.Skill) bool {
+	return Supports(b.gen, skill)
+}
+
+// Offline reports whether the wrapped generator is offline-only.
+func (b *BankedGenerator) Offline() bool {
+	return IsOffline(b.gen)
+}
+
+// Close closes the wrapped generator, if it has background work.
+func (b *BankedGenerator) Close() {
+	if c, ok := b.gen.(interface{ Close() }); ok {
+		c.Close()
+	}
+}
+
+// bankQuery picks a question for input's skill and tier within its
+// difficulty band, skipping the ones already asked.
+func bankQuery(input GenerateInput) store.BankQuery {
+	return store.BankQuery{
+		SkillID:       input.Skill.ID,
+		Tier:          input.Tier.String(),
+		Locale:        string(input.Locale),
+		MinDifficulty: input.TargetDifficulty.Min,
+		MaxDifficulty: input.TargetDifficulty.Max,
+		Exclude:       input.PriorQuestions,
+	}
+}
+
+func bankData(q *Question) store.BankQuestionData {
+	var diagram string
+	if q.Diagram != nil {
+		if b, err := json.Marshal(q.Diagram); err == nil {
+			diagram = string(b)
+		}
+	}
+	return store.BankQuestionData{
+		SkillID:      q.SkillID,
+		Tier:         q.Tier.String(),
+		Difficulty:   q.Difficulty,
+		Text:         q.Text,
+		Answer:       q.Answer,
+		AnswerType:   string(q.AnswerType),
+		Format:       string(q.Format),
+		Choices:      q.Choices,
+		Diagram:      diagram,
+		Hint:         q.Hint,
+		Explanation:  q.Explanation,
+		Source:       q.Source,
+		Locale:       string(q.Locale),
+		LLMRequestID: q.LLMRequestID,
+		LLMOwner:     q.LLMOwner,
+	}
+}
+
+func questionFromBank(d *store.BankQuestionData) *Question {
+	var diagram *Diagram
+	if d.Diagram != "" {
+		diagram = new(Diagram)
+		if err := json.Unmarshal([]byte(d.Diagram), diagram); err != nil {
+			diagram = nil
+		}
+	}
+	return &Question{
+		Text:         d.Text,
+		Format:       AnswerFormat(d.Format),
+		Answer:       d.Answer,
+		AnswerType:   AnswerType(d.AnswerType),
+		Choices:      d.Choices,
+		Diagram:      diagram,
+		Hint:         d.Hint,
+		Difficulty:   d.Difficulty,
+		Explanation:  d.Explanation,
+		SkillID:      d.SkillID,
+		Tier:         skillgraph.ParseTier(d.Tier),
+		Source:       SourceBank,
+		Locale:       i18n.Locale(d.Locale),
+		LLMRequestID: d.LLMRequestID,
+		LLMOwner:     d.LLMOwner,
+	}
+}
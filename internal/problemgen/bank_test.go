@@ -0,0 +1,192 @@
+package problemgen
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// memBank is an in-memory QuestionBankRepo.
+type memBank struct {
+	questions []store.BankQuestionData
+	answers   map[string]int
+}
+
+func (b *memBank) SaveQuestion(_ context.Context, data store.BankQuestionData) error {
+	b.questions = append(b.questions, data)
+	return nil
+}
+
+func (b *memBank) PickQuestion(_ context.Context, query store.BankQuery) (*store.BankQuestionData, error) {
+	for _, q := range b.questions {
+		if q.SkillID == query.SkillID && q.Tier == query.Tier && !slices.Contains(query.Exclude, q.Text) {
+			return &q, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *memBank) RecordAnswer(_ context.Context, _, text string, _ bool) error {
+	if b.answers == nil {
+		b.answers = make(map[string]int)
+	}
+	b.answers[text]++
+	return nil
+}
+
+func (b *memBank) ListQuestions(context.Context) ([]store.BankQuestionData, error) {
+	return b.questions, nil
+}
+
+func (b *memBank) ReportedQuestions(context.Context) ([]store.ReportedQuestion, error) {
+	return nil, nil
+}
+
+func (b *memBank) TriageQuestion(context.Context, int, bool) error { return nil }
+
+func TestBankedGenerator_BanksThenServes(t *testing.T) {
+	bank := &memBank{}
+	gen := &countingGenerator{}
+	b := NewBanked(gen, bank)
+	ctx := context.Background()
+	input := hybridInput(t, "add-3digit")
+
+	q, err := b.Generate(ctx, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Source != SourceLLM || len(bank.questions) != 1 {
+		t.Fatalf("first question %+v, bank %d", q, len(bank.questions))
+	}
+
+	// Another learner (or a later session) gets the banked copy.
+	q, err = b.Generate(ctx, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Source != SourceBank || q.Text != "q1" || q.Tier != input.Tier {
+		t.Errorf("second question %+v, want banked q1", q)
+	}
+	if gen.calls != 1 {
+		t.Errorf("generator called %d times, want 1", gen.calls)
+	}
+
+	// Prior questions are never served from the bank.
+	input.PriorQuestions = []string{"q1"}
+	if q, err = b.Generate(ctx, input); err != nil || q.Text != "q2" {
+		t.Fatalf("with q1 seen, got %v, %v", q, err)
+	}
+
+	b.RecordAnswer(ctx, q, true)
+	if bank.answers["q2"] != 1 {
+		t.Error("answer not recorded")
+	}
+}
+
+func TestBankedGenerator_BatchTopsUpFromGenerator(t *testing.T) {
+	bank := &memBank{}
+	gen := &countingGenerator{}
+	b := NewBanked(gen, bank)
+	ctx := context.Background()
+	input := hybridInput(t, "add-3digit")
+
+	if _, err := b.Generate(ctx, input); err != nil {
+		t.Fatal(err)
+	}
+
+	// One banked question, then two generated and banked.
+	qs, err := b.GenerateBatch(ctx, input, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sources []string
+	for _, q := range qs {
+		sources = append(sources, q.Source)
+	}
+	if want := []string{SourceBank, SourceLLM, SourceLLM}; !slices.Equal(sources, want) {
+		t.Errorf("sources = %v, want %v", sources, want)
+	}
+	if gen.calls != 3 || len(bank.questions) != 3 {
+		t.Errorf("generator calls %d, banked %d; want 3 and 3", gen.calls, len(bank.questions))
+	}
+}
+
+func TestBankedGenerator_RecentErrorsBypassBank(t *testing.T) {
+	bank := &memBank{}
+	gen := &countingGenerator{}
+	b := NewBanked(gen, bank)
+	input := hybridInput(t, "add-3digit")
+
+	if _, err := b.Generate(context.Background(), input); err != nil {
+		t.Fatal(err)
+	}
+	input.RecentErrors = []string{"Answered 5 for 2 + 2"}
+	q, err := b.Generate(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Source == SourceBank {
+		t.Error("served a banked question for a targeted follow-up")
+	}
+}
+
+func TestBankedGenerator_SkipsOfflineQuestions(t *testing.T) {
+	bank := &memBank{}
+	b := NewBanked(NewOffline(1), bank)
+	if _, err := b.Generate(context.Background(), hybridInput(t, "add-3digit")); err != nil {
+		t.Fatal(err)
+	}
+	if len(bank.questions) != 0 {
+		t.Errorf("banked %d offline questions", len(bank.questions))
+	}
+}
+
+func TestBankedGenerator_ForwardsOffline(t *testing.T) {
+	if !IsOffline(NewBanked(NewOffline(1), &memBank{})) {
+		t.Error("banked offline generator reports an LLM")
+	}
+	if IsOffline(NewBanked(&countingGenerator{}, &memBank{})) {
+		t.Error("banked LLM generator reports offline")
+	}
+}
+
+func TestGeneratorStats_ThroughBank(t *testing.T) {
+	h := NewHybrid(&countingGenerator{err: errors.New("provider down")}, NewOffline(1), HybridConfig{})
+	b := NewBanked(h, &memBank{})
+	if _, err := b.Generate(context.Background(), hybridInput(t, "add-3digit")); err != nil {
+		t.Fatal(err)
+	}
+	st, ok := GeneratorStats(b)
+	if !ok || st.Fallbacks != 1 {
+		t.Errorf("GeneratorStats = %+v, %v; want one fallback", st, ok)
+	}
+	if _, ok := GeneratorStats(NewBanked(NewOffline(1), &memBank{})); ok {
+		t.Error("offline generator reports hybrid stats")
+	}
+}
+
+func TestBankedGenerator_KeepsDiagram(t *testing.T) {
+	q := &Question{
+		Text:    "What time does the clock show?",
+		Format:  FormatNumeric,
+		Answer:  "3:45",
+		Diagram: &Diagram{Kind: DiagramClock, Hour: 3, Minute: 45},
+	}
+	data := bankData(q)
+	if data.Diagram == "" {
+		t.Fatal("diagram not banked")
+	}
+	got := questionFromBank(&data)
+	if got.Diagram == nil || !reflect.DeepEqual(got.Diagram, q.Diagram) {
+		t.Errorf("banked diagram = %+v, want %+v", got.Diagram, q.Diagram)
+	}
+
+	q.Diagram = nil
+	if data := bankData(q); data.Diagram != "" || questionFromBank(&data).Diagram != nil {
+		t.Errorf("question without a diagram banked %q", data.Diagram)
+	}
+}
@@ -20,6 +20,15 @@ type Config struct {
 	// MaxRecentErrors is the maximum number of recent errors
 	// to include in the prompt for context.
 	MaxRecentErrors int
+
+	// DifficultyRetries is how many times Generate asks again when the
+	// DifficultyValidator rejects a question, before returning the
+	// rejection. Other validation failures are returned straight away.
+	DifficultyRetries int
+
+	// BatchRetries is how many follow-up calls GenerateBatch makes for the
+	// questions of a batch that failed validation.
+	BatchRetries int
 }
 
 // DefaultConfig returns a Config with the standard validator chain
@@ -28,6 +37,7 @@ func DefaultConfig() Config {
 	return Config{
 		Validators: []Validator{
 			&StructuralValidator{},
+			&DifficultyValidator{},
 			&AnswerFormatValidator{},
 			&MathCheckValidator{},
 		},
@@ -35,5 +45,7 @@ func DefaultConfig() Config {
 		Temperature:       0.7,
 		MaxPriorQuestions: 8,
 		MaxRecentErrors:   5,
+		DifficultyRetries: 1,
+		BatchRetries:      1,
 	}
 }
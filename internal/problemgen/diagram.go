@@ -0,0 +1,199 @@
+package problemgen
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DiagramKind names the picture a Diagram draws.
+type DiagramKind string
+
+const (
+	// DiagramNumberLine is a number line from Min to Max cut into Intervals
+	// equal steps, with a dot at each of Points.
+	DiagramNumberLine DiagramKind = "number_line"
+
+	// DiagramFractionBar is a bar cut into Parts equal pieces with Shaded of
+	// them filled in.
+	DiagramFractionBar DiagramKind = "fraction_bar"
+
+	// DiagramAreaModel is a rectangle whose sides are split into the
+	// place-value parts of two factors (RowParts by ColParts, e.g. 20 and 3
+	// by 4 for 23 * 4).
+	DiagramAreaModel DiagramKind = "area_model"
+
+	// DiagramArray is Rows rows of Cols dots.
+	DiagramArray DiagramKind = "array"
+
+	// DiagramClock is an analog clock showing Hour:Minute.
+	DiagramClock DiagramKind = "clock"
+)
+
+// Diagram is an optional picture that goes with a question's text, for
+// skills that need one (reading a clock, placing a fraction on a number
+// line). It is structured data, not art: the TUI draws it with Lip Gloss
+// and the web game as SVG. Only the fields of its Kind are set.
+type Diagram struct {
+	Kind DiagramKind `json:"kind"`
+
+	// Number line. Endpoints are always labelled; LabelTicks labels every
+	// tick in between too.
+	Min        int       `json:"min,omitempty"`
+	Max        int       `json:"max,omitempty"`
+	Intervals  int       `json:"intervals,omitempty"`
+	Points     []float64 `json:"points,omitempty"`
+	LabelTicks bool      `json:"label_ticks,omitempty"`
+
+	// Fraction bar.
+	Parts  int `json:"parts,omitempty"`
+	Shaded int `json:"shaded,omitempty"`
+
+	// Array.
+	Rows int `json:"rows,omitempty"`
+	Cols int `json:"cols,omitempty"`
+
+	// Area model: the parts of the height and of the width.
+	RowParts []int `json:"row_parts,omitempty"`
+	ColParts []int `json:"col_parts,omitempty"`
+
+	// Clock: Hour 1-12, Minute 0-59.
+	Hour   int `json:"hour,omitempty"`
+	Minute int `json:"minute,omitempty"`
+}
+
+// Size limits keep a diagram readable in an 80-column terminal.
+const (
+	maxNumberLineIntervals = 20
+	maxNumberLinePoints    = 4
+	maxFractionBarParts    = 12
+	maxArrayRows           = 10
+	maxArrayCols           = 12
+	maxAreaModelParts      = 3
+)
+
+// Check returns why the diagram can't be drawn, or "" when it can.
+func (d *Diagram) Check() string {
+	switch d.Kind {
+	case DiagramNumberLine:
+		if d.Max <= d.Min {
+			return "number_line max must be greater than min"
+		}
+		if d.Intervals < 1 || d.Intervals > maxNumberLineIntervals {
+			return fmt.Sprintf("number_line intervals must be between 1 and %d", maxNumberLineIntervals)
+		}
+		if len(d.Points) > maxNumberLinePoints {
+			return fmt.Sprintf("number_line has more than %d points", maxNumberLinePoints)
+		}
+		for _, p := range d.Points {
+			if p < float64(d.Min) || p > float64(d.Max) {
+				return fmt.Sprintf("number_line point %g is outside %d to %d", p, d.Min, d.Max)
+			}
+		}
+	case DiagramFractionBar:
+		if d.Parts < 1 || d.Parts > maxFractionBarParts {
+			return fmt.Sprintf("fraction_bar parts must be between 1 and %d", maxFractionBarParts)
+		}
+		if d.Shaded < 0 || d.Shaded > d.Parts {
+			return "fraction_bar shaded must be between 0 and parts"
+		}
+	case DiagramArray:
+		if d.Rows < 1 || d.Rows > maxArrayRows || d.Cols < 1 || d.Cols > maxArrayCols {
+			return fmt.Sprintf("array must have 1-%d rows and 1-%d columns", maxArrayRows, maxArrayCols)
+		}
+	case DiagramAreaModel:
+		for _, parts := range [][]int{d.RowParts, d.ColParts} {
+			if len(parts) < 1 || len(parts) > maxAreaModelParts {
+				return fmt.Sprintf("area_model sides must have 1-%d parts", maxAreaModelParts)
+			}
+			if slices.ContainsFunc(parts, func(p int) bool { return p <= 0 }) {
+				return "area_model parts must be positive"
+			}
+		}
+	case DiagramClock:
+		if d.Hour < 1 || d.Hour > 12 || d.Minute < 0 || d.Minute > 59 {
+			return "clock must show hour 1-12 and minute 0-59"
+		}
+	default:
+		return fmt.Sprintf("unknown diagram kind %q", d.Kind)
+	}
+	return ""
+}
+
+// Tick is one tick mark on a number line.
+type Tick struct {
+	// Pos is the tick's position along the line, from 0 (Min) to 1 (Max).
+	Pos float64
+
+	// Label is the tick's value ("3", "1/4", "1 1/2"), or "" when the tick
+	// is unlabelled.
+	Label string
+}
+
+// Ticks returns the Intervals+1 tick marks of a number line.
+func (d *Diagram) Ticks() []Tick {
+	if d.Kind != DiagramNumberLine || d.Intervals < 1 {
+		return nil
+	}
+	span := big.NewRat(int64(d.Max-d.Min), int64(d.Intervals))
+	ticks := make([]Tick, d.Intervals+1)
+	for i := range ticks {
+		ticks[i].Pos = float64(i) / float64(d.Intervals)
+		if i == 0 || i == d.Intervals || d.LabelTicks {
+			v := new(big.Rat).Mul(span, big.NewRat(int64(i), 1))
+			v.Add(v, big.NewRat(int64(d.Min), 1))
+			ticks[i].Label = formatMixed(v)
+		}
+	}
+	return ticks
+}
+
+// PointPos returns where a number-line point sits along the line, from 0
+// (Min) to 1 (Max).
+func (d *Diagram) PointPos(p float64) float64 {
+	if d.Max <= d.Min {
+		return 0
+	}
+	return (p - float64(d.Min)) / float64(d.Max-d.Min)
+}
+
+// Describe spells the diagram out in words, for readers that can't see it:
+// the judge, and plain-text output like the preview command.
+func (d *Diagram) Describe() string {
+	switch d.Kind {
+	case DiagramNumberLine:
+		s := fmt.Sprintf("a number line from %d to %d in %d equal steps", d.Min, d.Max, d.Intervals)
+		if len(d.Points) > 0 {
+			s += fmt.Sprintf(", with dots at %s", joinFloats(d.Points))
+		}
+		return s
+	case DiagramFractionBar:
+		return fmt.Sprintf("a bar cut into %d equal parts with %d shaded", d.Parts, d.Shaded)
+	case DiagramAreaModel:
+		return fmt.Sprintf("an area model, %s by %s", joinParts(d.RowParts), joinParts(d.ColParts))
+	case DiagramArray:
+		return fmt.Sprintf("an array of %d rows of %d dots", d.Rows, d.Cols)
+	case DiagramClock:
+		return fmt.Sprintf("an analog clock showing %d:%02d", d.Hour, d.Minute)
+	}
+	return string(d.Kind)
+}
+
+func joinFloats(vs []float64) string {
+	parts := make([]string, len(vs))
+	for i, v := range vs {
+		parts[i] = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// joinParts writes area-model parts as a sum: "20 + 3".
+func joinParts(ps []int) string {
+	parts := make([]string, len(ps))
+	for i, p := range ps {
+		parts[i] = strconv.Itoa(p)
+	}
+	return strings.Join(parts, " + ")
+}
@@ -0,0 +1,87 @@
+package problemgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiagramCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       Diagram
+		wantErr string // substring; "" for a drawable diagram
+	}{
+		{"number line", Diagram{Kind: DiagramNumberLine, Min: 0, Max: 1, Intervals: 4, Points: []float64{0.75}}, ""},
+		{"number line backwards", Diagram{Kind: DiagramNumberLine, Min: 5, Max: 0, Intervals: 5}, "greater than min"},
+		{"number line too many steps", Diagram{Kind: DiagramNumberLine, Min: 0, Max: 100, Intervals: 100}, "intervals"},
+		{"point off the line", Diagram{Kind: DiagramNumberLine, Min: 0, Max: 1, Intervals: 4, Points: []float64{1.5}}, "outside"},
+		{"fraction bar", Diagram{Kind: DiagramFractionBar, Parts: 8, Shaded: 3}, ""},
+		{"fraction bar overfull", Diagram{Kind: DiagramFractionBar, Parts: 4, Shaded: 5}, "shaded"},
+		{"array", Diagram{Kind: DiagramArray, Rows: 3, Cols: 4}, ""},
+		{"array too wide", Diagram{Kind: DiagramArray, Rows: 3, Cols: 40}, "columns"},
+		{"area model", Diagram{Kind: DiagramAreaModel, RowParts: []int{4}, ColParts: []int{20, 3}}, ""},
+		{"area model missing side", Diagram{Kind: DiagramAreaModel, ColParts: []int{20, 3}}, "parts"},
+		{"area model zero part", Diagram{Kind: DiagramAreaModel, RowParts: []int{4}, ColParts: []int{20, 0}}, "positive"},
+		{"clock", Diagram{Kind: DiagramClock, Hour: 12, Minute: 0}, ""},
+		{"clock hour 0", Diagram{Kind: DiagramClock, Hour: 0, Minute: 30}, "hour 1-12"},
+		{"unknown kind", Diagram{Kind: "pie_chart"}, "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Check()
+			if tt.wantErr == "" && got != "" {
+				t.Errorf("Check() = %q, want drawable", got)
+			}
+			if tt.wantErr != "" && !strings.Contains(got, tt.wantErr) {
+				t.Errorf("Check() = %q, want it to mention %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiagramTicks(t *testing.T) {
+	d := &Diagram{Kind: DiagramNumberLine, Min: 0, Max: 2, Intervals: 4}
+	var labels []string
+	for _, tick := range d.Ticks() {
+		labels = append(labels, tick.Label)
+	}
+	if got := strings.Join(labels, ","); got != "0,,,,2" {
+		t.Errorf("end labels only: %q", got)
+	}
+
+	d.LabelTicks = true
+	labels = labels[:0]
+	for _, tick := range d.Ticks() {
+		labels = append(labels, tick.Label)
+	}
+	if got := strings.Join(labels, ","); got != "0,1/2,1,1 1/2,2" {
+		t.Errorf("every label: %q", got)
+	}
+	if pos := d.PointPos(1.5); pos != 0.75 {
+		t.Errorf("PointPos(1.5) = %v, want 0.75", pos)
+	}
+}
+
+func TestDiagramDescribe(t *testing.T) {
+	tests := []struct {
+		d    Diagram
+		want string
+	}{
+		{Diagram{Kind: DiagramClock, Hour: 3, Minute: 5}, "an analog clock showing 3:05"},
+		{Diagram{Kind: DiagramAreaModel, RowParts: []int{4}, ColParts: []int{20, 3}}, "an area model, 4 by 20 + 3"},
+		{Diagram{Kind: DiagramNumberLine, Min: 0, Max: 1, Intervals: 4, Points: []float64{0.25}}, "a number line from 0 to 1 in 4 equal steps, with dots at 0.25"},
+	}
+	for _, tt := range tests {
+		if got := tt.d.Describe(); got != tt.want {
+			t.Errorf("Describe() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNormalize_DropsNoneDiagram(t *testing.T) {
+	q := &Question{Format: FormatNumeric, Diagram: &Diagram{Kind: "none"}}
+	q.Normalize()
+	if q.Diagram != nil {
+		t.Errorf("diagram = %+v, want nil", q.Diagram)
+	}
+}
@@ -0,0 +1,21 @@
+package problemgen
+
+import "fmt"
+
+// DifficultyValidator rejects questions whose self-assessed difficulty is
+// outside the input's TargetDifficulty band. It passes everything when the
+// input has no target.
+type DifficultyValidator struct{}
+
+func (v *DifficultyValidator) Name() string { return "difficulty" }
+
+func (v *DifficultyValidator) Validate(q *Question, input GenerateInput) *ValidationError {
+	if input.TargetDifficulty.Contains(q.Difficulty) {
+		return nil
+	}
+	return &ValidationError{
+		Validator: v.Name(),
+		Message:   fmt.Sprintf("difficulty %d is outside the target band %s", q.Difficulty, input.TargetDifficulty),
+		Retryable: true,
+	}
+}
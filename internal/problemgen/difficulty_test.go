@@ -0,0 +1,59 @@
+package problemgen
+
+import "testing"
+
+func TestDifficultyBand(t *testing.T) {
+	tests := []struct {
+		band     DifficultyBand
+		set      bool
+		contains []int
+		excludes []int
+		str      string
+	}{
+		{DifficultyBand{}, false, []int{0, 1, 5}, nil, "0"},
+		{NewDifficultyBand(2, 4), true, []int{2, 3, 4}, []int{1, 5}, "2-4"},
+		{NewDifficultyBand(0, 2), true, []int{1, 2}, []int{3}, "1-2"},
+		{NewDifficultyBand(4, 6), true, []int{4, 5}, []int{3}, "4-5"},
+		{NewDifficultyBand(3, 3), true, []int{3}, []int{2, 4}, "3"},
+	}
+	for _, tt := range tests {
+		if got := tt.band.IsSet(); got != tt.set {
+			t.Errorf("%+v IsSet = %v, want %v", tt.band, got, tt.set)
+		}
+		for _, d := range tt.contains {
+			if !tt.band.Contains(d) {
+				t.Errorf("%+v should contain %d", tt.band, d)
+			}
+		}
+		for _, d := range tt.excludes {
+			if tt.band.Contains(d) {
+				t.Errorf("%+v should not contain %d", tt.band, d)
+			}
+		}
+		if got := tt.band.String(); got != tt.str {
+			t.Errorf("%+v String = %q, want %q", tt.band, got, tt.str)
+		}
+	}
+}
+
+func TestDifficultyValidator(t *testing.T) {
+	v := &DifficultyValidator{}
+	q := &Question{Difficulty: 5}
+
+	if err := v.Validate(q, GenerateInput{}); err != nil {
+		t.Errorf("no target: unexpected error %v", err)
+	}
+	if err := v.Validate(q, GenerateInput{TargetDifficulty: NewDifficultyBand(3, 5)}); err != nil {
+		t.Errorf("in band: unexpected error %v", err)
+	}
+	err := v.Validate(q, GenerateInput{TargetDifficulty: NewDifficultyBand(1, 3)})
+	if err == nil {
+		t.Fatal("out of band: expected error")
+	}
+	if !err.Retryable {
+		t.Error("expected retryable error")
+	}
+	if err.Message != "difficulty 5 is outside the target band 1-3" {
+		t.Errorf("message = %q", err.Message)
+	}
+}
@@ -0,0 +1,533 @@
+package problemgen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// This file is a small expression engine for MathCheckValidator. It finds
+// arithmetic in question text ("What is (3 + 4) × 5?", "__ + 7 = 15",
+// "25% of 80"), parses it, and evaluates it exactly over rationals. An
+// equation with one unknown (a blank or a single-letter variable) is solved
+// for that unknown.
+
+type tokKind int
+
+const (
+	tokNum    tokKind = iota // number literal, fraction literal or mixed number
+	tokOp                    // + - * /
+	tokLParen                // ( [
+	tokRParen                // ) ]
+	tokEq                    // =
+	tokCmp                   // < >
+	tokPct                   // %
+	tokBlank                 // __, □, or ? in operand position
+	tokQuery                 // ? anywhere else (punctuation)
+	tokVar                   // single lowercase letter
+	tokOf                    // "of"
+	tokWord                  // any other word: ends an expression
+	tokBreak                 // other punctuation: ends an expression
+)
+
+type token struct {
+	kind tokKind
+	text string
+	num  *big.Rat // tokNum
+	op   byte     // tokOp, tokCmp: '+', '-', '*', '/', '<', '>'
+	// frac marks a tight fraction literal ("3/4"), which binds before
+	// any operator and may be followed by "of".
+	frac bool
+}
+
+var wordOps = map[string]byte{
+	"plus":  '+',
+	"minus": '-',
+	"times": '*',
+}
+
+// tokenize splits question text into tokens.
+func tokenize(text string) []token {
+	var toks []token
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r >= '0' && r <= '9':
+			tok, n := scanNumber(text[i:])
+			toks = append(toks, tok)
+			i += n
+		case r == '_':
+			j := i
+			for j < len(text) && text[j] == '_' {
+				j++
+			}
+			toks = append(toks, token{kind: tokBlank, text: text[i:j]})
+			i = j
+		case r == '□' || r == '☐':
+			toks = append(toks, token{kind: tokBlank, text: string(r)})
+			i += size
+		case strings.ContainsRune("$€£₹", r):
+			i += size // "$3.50" is 3.50
+		case r == '?':
+			toks = append(toks, token{kind: tokQuery, text: "?"})
+			i += size
+		case strings.ContainsRune("+-−*×·÷/", r):
+			toks = append(toks, token{kind: tokOp, text: string(r), op: normalizeOpRune(r)})
+			i += size
+		case r == '(' || r == '[':
+			toks = append(toks, token{kind: tokLParen, text: string(r)})
+			i += size
+		case r == ')' || r == ']':
+			toks = append(toks, token{kind: tokRParen, text: string(r)})
+			i += size
+		case r == '=':
+			toks = append(toks, token{kind: tokEq, text: "="})
+			i += size
+		case r == '<' || r == '>':
+			toks = append(toks, token{kind: tokCmp, text: string(r), op: byte(r)})
+			i += size
+		case r == '%':
+			toks = append(toks, token{kind: tokPct, text: "%"})
+			i += size
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(text) {
+				r, size := utf8.DecodeRuneInString(text[j:])
+				if !unicode.IsLetter(r) && r != '\'' {
+					break
+				}
+				j += size
+			}
+			toks = append(toks, wordToken(text[i:j]))
+			i = j
+		default:
+			toks = append(toks, token{kind: tokBreak, text: string(r)})
+			i += size
+		}
+	}
+	return joinWordOps(toks)
+}
+
+func wordToken(w string) token {
+	lw := strings.ToLower(w)
+	switch {
+	case len(w) == 1 && w[0] >= 'a' && w[0] <= 'z':
+		return token{kind: tokVar, text: w}
+	case lw == "of":
+		return token{kind: tokOf, text: w}
+	case lw == "equals":
+		return token{kind: tokEq, text: w}
+	}
+	if op, ok := wordOps[lw]; ok {
+		return token{kind: tokOp, text: w, op: op}
+	}
+	return token{kind: tokWord, text: w}
+}
+
+// joinWordOps turns "divided by" and "multiplied by" into operators.
+func joinWordOps(toks []token) []token {
+	out := toks[:0:0]
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		if t.kind == tokWord && i+1 < len(toks) && toks[i+1].kind == tokWord && strings.EqualFold(toks[i+1].text, "by") {
+			switch strings.ToLower(t.text) {
+			case "divided":
+				out = append(out, token{kind: tokOp, text: "divided by", op: '/'})
+				i++
+				continue
+			case "multiplied":
+				out = append(out, token{kind: tokOp, text: "multiplied by", op: '*'})
+				i++
+				continue
+			}
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func normalizeOpRune(r rune) byte {
+	switch r {
+	case '−':
+		return '-'
+	case '*', '×', '·':
+		return '*'
+	case '÷', '/':
+		return '/'
+	default:
+		return byte(r)
+	}
+}
+
+// scanNumber reads a number at the start of s: digits with optional
+// thousands commas ("12,345") and decimals, a tight fraction ("3/4"), or a
+// mixed number ("2 1/2"). It returns the token and the bytes consumed.
+func scanNumber(s string) (token, int) {
+	n := scanDigits(s)
+	// Thousands separators: a comma followed by exactly three digits.
+	for n+4 <= len(s) && s[n] == ',' && scanDigits(s[n+1:]) == 3 {
+		n += 4
+	}
+	isInt := true
+	if n+1 < len(s) && s[n] == '.' && isDigit(s[n+1]) {
+		n += 1 + scanDigits(s[n+1:])
+		isInt = false
+	}
+	lit := strings.ReplaceAll(s[:n], ",", "")
+	v, _ := new(big.Rat).SetString(lit)
+
+	if !isInt {
+		return token{kind: tokNum, text: s[:n], num: v}, n
+	}
+	// Tight fraction: "3/4" with no spaces.
+	if n+1 < len(s) && s[n] == '/' && isDigit(s[n+1]) {
+		d := scanDigits(s[n+1:])
+		den, _ := new(big.Rat).SetString(s[n+1 : n+1+d])
+		if den.Sign() != 0 {
+			end := n + 1 + d
+			return token{kind: tokNum, text: s[:end], num: new(big.Rat).Quo(v, den), frac: true}, end
+		}
+	}
+	// Mixed number: "2 1/2" (one space, then a proper fraction).
+	if n+1 < len(s) && s[n] == ' ' && isDigit(s[n+1]) {
+		ft, fn := scanNumber(s[n+1:])
+		if ft.frac && ft.num.Sign() > 0 && ft.num.Cmp(big.NewRat(1, 1)) < 0 && !strings.Contains(ft.text, " ") {
+			end := n + 1 + fn
+			return token{kind: tokNum, text: s[:end], num: new(big.Rat).Add(v, ft.num), frac: true}, end
+		}
+	}
+	return token{kind: tokNum, text: s[:n], num: v}, n
+}
+
+func scanDigits(s string) int {
+	n := 0
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	return n
+}
+
+func isDigit(b byte) bool { return b >= '0' && b <= '9' }
+
+// exprRuns splits tokens into maximal runs that could form an expression.
+// Words and punctuation end a run; "?" stays in a run only as a blank (next
+// to an operator or "="); "of" stays only after a percent or a fraction.
+func exprRuns(toks []token) [][]token {
+	var runs [][]token
+	var cur []token
+	flush := func() {
+		if len(cur) > 0 {
+			runs = append(runs, cur)
+		}
+		cur = nil
+	}
+	for i, t := range toks {
+		switch t.kind {
+		case tokWord, tokBreak:
+			flush()
+			continue
+		case tokQuery:
+			prevOp := len(cur) > 0 && isOperatorTok(cur[len(cur)-1])
+			nextOp := i+1 < len(toks) && isOperatorTok(toks[i+1])
+			if !prevOp && !nextOp {
+				flush()
+				continue
+			}
+			t = token{kind: tokBlank, text: "?"}
+		case tokOf:
+			if len(cur) == 0 || !(cur[len(cur)-1].kind == tokPct || cur[len(cur)-1].frac) {
+				flush()
+				continue
+			}
+		}
+		cur = append(cur, t)
+	}
+	flush()
+	return runs
+}
+
+func isOperatorTok(t token) bool {
+	return t.kind == tokOp || t.kind == tokEq || t.kind == tokCmp
+}
+
+// linear is a*u + b, where u is the run's unknown (if any).
+type linear struct {
+	a, b *big.Rat
+}
+
+func constant(v *big.Rat) linear { return linear{a: new(big.Rat), b: v} }
+
+func (l linear) isConst() bool { return l.a.Sign() == 0 }
+
+var (
+	errNotExpression = errors.New("not an expression")
+	errNonLinear     = errors.New("unknown is not linear")
+	errDivZero       = errors.New("division by zero")
+)
+
+// exprKind classifies a parsed run.
+type exprKind int
+
+const (
+	exprValue   exprKind = iota // arithmetic with operators: "3 + 4 × 2"
+	exprSolve                   // equation with one unknown: "__ + 7 = 15"
+	exprStmt                    // equation or inequality without unknowns: "3 + 4 = 7"
+	exprCompare                 // comparison slot: "3/4 __ 2/3"
+	exprLiteral                 // a lone number, nothing to compute
+)
+
+// parsedExpr is the result of parsing one run.
+type parsedExpr struct {
+	kind  exprKind
+	value *big.Rat // exprValue, exprSolve: the result
+	cmp   int      // exprCompare: sign of left - right
+	holds bool     // exprStmt: whether the statement is true
+}
+
+// parser is a recursive-descent parser over one run:
+//
+//	run     := expr [ ("=" | "<" | ">" | blank) expr ]
+//	expr    := term { ("+" | "-") term }
+//	term    := factor { ("*" | "/" | "of") factor | implicit-mul factor }
+//	factor  := ("-" | "+") factor | primary [ "%" ]
+//	primary := number | unknown | "(" expr ")"
+type parser struct {
+	toks    []token
+	pos     int
+	unknown string // text of the unknown seen so far
+	ops     int    // operators parsed
+}
+
+// parseRun parses a run of tokens as a whole.
+func parseRun(toks []token) (*parsedExpr, error) {
+	toks = xAsTimes(toks)
+	p := &parser{toks: toks}
+	left, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.done() {
+		switch {
+		case !left.isConst():
+			return nil, errNotExpression // unknown without an equation
+		case p.ops == 0:
+			return &parsedExpr{kind: exprLiteral, value: left.b}, nil
+		default:
+			return &parsedExpr{kind: exprValue, value: left.b}, nil
+		}
+	}
+
+	rel := p.next()
+	if rel.kind == tokBlank {
+		// Comparison slot: both sides must be known.
+		if !left.isConst() {
+			return nil, errNotExpression
+		}
+		right, err := p.expr()
+		if err != nil || !p.done() || !right.isConst() {
+			return nil, errNotExpression
+		}
+		return &parsedExpr{kind: exprCompare, cmp: left.b.Cmp(right.b)}, nil
+	}
+	if rel.kind != tokEq && rel.kind != tokCmp {
+		return nil, errNotExpression
+	}
+	right, err := p.expr()
+	if err != nil || !p.done() {
+		return nil, errNotExpression
+	}
+
+	if left.isConst() && right.isConst() {
+		c := left.b.Cmp(right.b)
+		holds := c == 0
+		if rel.kind == tokCmp {
+			holds = (rel.op == '<' && c < 0) || (rel.op == '>' && c > 0)
+		}
+		return &parsedExpr{kind: exprStmt, holds: holds}, nil
+	}
+	if rel.kind != tokEq {
+		return nil, errNotExpression
+	}
+	// a1*u + b1 = a2*u + b2  =>  u = (b2 - b1) / (a1 - a2)
+	da := new(big.Rat).Sub(left.a, right.a)
+	if da.Sign() == 0 {
+		return nil, errNotExpression
+	}
+	db := new(big.Rat).Sub(right.b, left.b)
+	return &parsedExpr{kind: exprSolve, value: db.Quo(db, da)}, nil
+}
+
+// xAsTimes reads "x" between two numbers as multiplication ("3 x 4 = ?").
+func xAsTimes(toks []token) []token {
+	out := make([]token, len(toks))
+	copy(out, toks)
+	for i := 1; i+1 < len(out); i++ {
+		if out[i].kind == tokVar && out[i].text == "x" && out[i-1].kind == tokNum && out[i+1].kind == tokNum {
+			out[i] = token{kind: tokOp, text: "x", op: '*'}
+		}
+	}
+	return out
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.toks) }
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: tokBreak}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) expr() (linear, error) {
+	left, err := p.term()
+	if err != nil {
+		return linear{}, err
+	}
+	for t := p.peek(); t.kind == tokOp && (t.op == '+' || t.op == '-'); t = p.peek() {
+		p.pos++
+		p.ops++
+		right, err := p.term()
+		if err != nil {
+			return linear{}, err
+		}
+		if t.op == '+' {
+			left = linear{a: new(big.Rat).Add(left.a, right.a), b: new(big.Rat).Add(left.b, right.b)}
+		} else {
+			left = linear{a: new(big.Rat).Sub(left.a, right.a), b: new(big.Rat).Sub(left.b, right.b)}
+		}
+	}
+	return left, nil
+}
+
+func (p *parser) term() (linear, error) {
+	left, err := p.factor()
+	if err != nil {
+		return linear{}, err
+	}
+	for {
+		t := p.peek()
+		var op byte
+		switch {
+		case t.kind == tokOp && (t.op == '*' || t.op == '/'):
+			op = t.op
+			p.pos++
+		case t.kind == tokOf:
+			op = '*'
+			p.pos++
+		case t.kind == tokLParen || t.kind == tokVar:
+			op = '*' // implicit: "2(3 + 4)", "3x"
+		default:
+			return left, nil
+		}
+		p.ops++
+		right, err := p.factor()
+		if err != nil {
+			return linear{}, err
+		}
+		if left, err = mulDiv(left, right, op); err != nil {
+			return linear{}, err
+		}
+	}
+}
+
+func mulDiv(l, r linear, op byte) (linear, error) {
+	if op == '*' {
+		switch {
+		case l.isConst():
+			return linear{a: new(big.Rat).Mul(l.b, r.a), b: new(big.Rat).Mul(l.b, r.b)}, nil
+		case r.isConst():
+			return linear{a: new(big.Rat).Mul(r.b, l.a), b: new(big.Rat).Mul(r.b, l.b)}, nil
+		default:
+			return linear{}, errNonLinear
+		}
+	}
+	if !r.isConst() {
+		return linear{}, errNonLinear
+	}
+	if r.b.Sign() == 0 {
+		return linear{}, errDivZero
+	}
+	return linear{a: new(big.Rat).Quo(l.a, r.b), b: new(big.Rat).Quo(l.b, r.b)}, nil
+}
+
+func (p *parser) factor() (linear, error) {
+	if t := p.peek(); t.kind == tokOp && (t.op == '-' || t.op == '+') {
+		p.pos++
+		v, err := p.factor()
+		if err != nil || t.op == '+' {
+			return v, err
+		}
+		return linear{a: new(big.Rat).Neg(v.a), b: new(big.Rat).Neg(v.b)}, nil
+	}
+	v, err := p.primary()
+	if err != nil {
+		return linear{}, err
+	}
+	if p.peek().kind == tokPct {
+		p.pos++
+		p.ops++
+		hundred := big.NewRat(1, 100)
+		v = linear{a: new(big.Rat).Mul(v.a, hundred), b: new(big.Rat).Mul(v.b, hundred)}
+	}
+	return v, nil
+}
+
+func (p *parser) primary() (linear, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNum:
+		return constant(new(big.Rat).Set(t.num)), nil
+	case tokBlank, tokVar:
+		// One unknown per run. Every blank is a separate unknown; a
+		// variable may repeat ("x + x = 10").
+		if p.unknown != "" && (t.kind == tokBlank || p.unknown != t.text) {
+			return linear{}, errNotExpression
+		}
+		p.unknown = t.text
+		return linear{a: big.NewRat(1, 1), b: new(big.Rat)}, nil
+	case tokLParen:
+		v, err := p.expr()
+		if err != nil {
+			return linear{}, err
+		}
+		if p.next().kind != tokRParen {
+			return linear{}, errNotExpression
+		}
+		return v, nil
+	default:
+		return linear{}, fmt.Errorf("%w: unexpected %q", errNotExpression, t.text)
+	}
+}
+
+// parseNumber parses an answer string as an exact number: integers,
+// decimals, fractions, mixed numbers and thousands separators ("1,234").
+func parseNumber(s string) (*big.Rat, bool) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "−") {
+		_, size := utf8.DecodeRuneInString(s)
+		neg, s = true, strings.TrimSpace(s[size:])
+	}
+	if s == "" || !isDigit(s[0]) {
+		return nil, false
+	}
+	tok, n := scanNumber(s)
+	if n != len(s) {
+		return nil, false
+	}
+	if neg {
+		return new(big.Rat).Neg(tok.num), true
+	}
+	return tok.num, true
+}
@@ -0,0 +1,98 @@
+package problemgen
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func parseOne(t *testing.T, text string) (*parsedExpr, error) {
+	t.Helper()
+	runs := exprRuns(tokenize(text))
+	if len(runs) != 1 {
+		t.Fatalf("%q: got %d runs, want 1", text, len(runs))
+	}
+	return parseRun(runs[0])
+}
+
+func TestParseRun_Values(t *testing.T) {
+	tests := []struct {
+		text string
+		kind exprKind
+		want *big.Rat
+	}{
+		{"2 + 3 × 4", exprValue, big.NewRat(14, 1)},
+		{"(2 + 3) × 4", exprValue, big.NewRat(20, 1)},
+		{"10 - 4 - 3", exprValue, big.NewRat(3, 1)},
+		{"24 ÷ 4 ÷ 2", exprValue, big.NewRat(3, 1)},
+		{"1/2 + 1/3", exprValue, big.NewRat(5, 6)},
+		{"2 1/2 × 2", exprValue, big.NewRat(5, 1)},
+		{"50% of 30", exprValue, big.NewRat(15, 1)},
+		{"-3 + 5", exprValue, big.NewRat(2, 1)},
+		{"__ × 6 = 42", exprSolve, big.NewRat(7, 1)},
+		{"2(x + 1) = 10", exprSolve, big.NewRat(4, 1)},
+		{"x / 4 = 3", exprSolve, big.NewRat(12, 1)},
+		{"12", exprLiteral, big.NewRat(12, 1)},
+	}
+	for _, tc := range tests {
+		e, err := parseOne(t, tc.text)
+		if err != nil {
+			t.Errorf("%q: %v", tc.text, err)
+			continue
+		}
+		if e.kind != tc.kind || e.value == nil || e.value.Cmp(tc.want) != 0 {
+			t.Errorf("%q = kind %d value %v, want kind %d value %v", tc.text, e.kind, e.value, tc.kind, tc.want)
+		}
+	}
+}
+
+func TestParseRun_StatementsAndComparisons(t *testing.T) {
+	e, err := parseOne(t, "3 + 4 = 7")
+	if err != nil || e.kind != exprStmt {
+		t.Errorf("3 + 4 = 7: %+v, %v; want a statement", e, err)
+	}
+	e, err = parseOne(t, "3/4 __ 2/3")
+	if err != nil || e.kind != exprCompare || e.cmp != 1 {
+		t.Errorf("3/4 __ 2/3: %+v, %v; want comparison >", e, err)
+	}
+}
+
+func TestParseRun_Errors(t *testing.T) {
+	tests := []struct {
+		text string
+		want error
+	}{
+		{"5 ÷ 0", errDivZero},
+		{"x × x = 16", errNonLinear},
+		{"(2 + 3", errNotExpression},
+	}
+	for _, tc := range tests {
+		if _, err := parseOne(t, tc.text); !errors.Is(err, tc.want) {
+			t.Errorf("%q: err = %v, want %v", tc.text, err, tc.want)
+		}
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *big.Rat
+	}{
+		{"42", big.NewRat(42, 1)},
+		{"1,234", big.NewRat(1234, 1)},
+		{"-0.25", big.NewRat(-1, 4)},
+		{"3/4", big.NewRat(3, 4)},
+		{"2 1/2", big.NewRat(5, 2)},
+	}
+	for _, tc := range tests {
+		got, ok := parseNumber(tc.in)
+		if !ok || got.Cmp(tc.want) != 0 {
+			t.Errorf("parseNumber(%q) = %v, %v; want %v", tc.in, got, ok, tc.want)
+		}
+	}
+	for _, in := range []string{"", "abc", "3 apples", "1/"} {
+		if _, ok := parseNumber(in); ok {
+			t.Errorf("parseNumber(%q) should fail", in)
+		}
+	}
+}
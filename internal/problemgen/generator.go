@@ -1,11 +1,86 @@
 package problemgen
 
-import "context"
+import (
+	"context"
+	"slices"
 
-// Generator produces math questions using an LLM provider.
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// Generator produces math questions, from an LLM provider (LLMGenerator) or
+// procedural templates (OfflineGenerator).
 type Generator interface {
 	// Generate produces a single question for the given input context.
 	// Returns a validated Question or an error.
 	// All configured validators are run before returning.
 	Generate(ctx context.Context, input GenerateInput) (*Question, error)
+
+	// GenerateBatch produces up to n validated questions for the same input,
+	// different from each other and from PriorQuestions, for callers that
+	// know how many they need (a session slot, an expedition). It may
+	// return fewer than n when questions keep failing validation, and
+	// returns an error only when it has none.
+	GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error)
+}
+
+// GenerateEach implements GenerateBatch for generators without a cheaper
+// batch path: it calls gen.Generate up to n times, adding each question to
+// PriorQuestions for the next, and stops at the first error.
+func GenerateEach(ctx context.Context, gen Generator, input GenerateInput, n int) ([]*Question, error) {
+	var qs []*Question
+	for range n {
+		q, err := gen.Generate(ctx, withAsked(input, qs...))
+		if err != nil {
+			if len(qs) == 0 {
+				return nil, err
+			}
+			break
+		}
+		qs = append(qs, q)
+	}
+	return qs, nil
+}
+
+// withAsked returns input with the texts of qs added to PriorQuestions.
+func withAsked(input GenerateInput, qs ...*Question) GenerateInput {
+	if len(qs) == 0 {
+		return input
+	}
+	prior := slices.Clone(input.PriorQuestions)
+	for _, q := range qs {
+		prior = append(prior, q.Text)
+	}
+	input.PriorQuestions = prior
+	return input
+}
+
+// SkillLimiter is implemented by generators that cover only some skills.
+// Session planning and placement skip skills the generator can't serve.
+type SkillLimiter interface {
+	Supports(skill skillgraph.Skill) bool
+}
+
+// Supports reports whether gen can generate questions for skill. Generators
+// that don't implement SkillLimiter cover every skill.
+func Supports(gen Generator, skill skillgraph.Skill) bool {
+	if l, ok := gen.(SkillLimiter); ok {
+		return l.Supports(skill)
+	}
+	return true
+}
+
+// OfflineReporter is implemented by generators that may run without an LLM.
+// Wrappers forward it so callers can tell through them.
+type OfflineReporter interface {
+	Offline() bool
+}
+
+// IsOffline reports whether gen serves only offline template questions.
+// Generators that don't implement OfflineReporter are assumed to have an
+// LLM behind them.
+func IsOffline(gen Generator) bool {
+	if r, ok := gen.(OfflineReporter); ok {
+		return r.Offline()
+	}
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 
@@ -367,3 +368,153 @@ func TestGenerate_ProviderError(t *testing.T) {
 		t.Errorf("unexpected error message: %v", err)
 	}
 }
+
+func TestGenerate_Diagram(t *testing.T) {
+	withDiagram := func(diagram string) json.RawMessage {
+		return json.RawMessage(`{
+			"question_text": "What time does the clock show?",
+			"format": "numeric",
+			"answer": "3:45",
+			"answer_type": "time",
+			"choices": [],
+			"diagram": ` + diagram + `,
+			"hint": "The short hand shows the hour.",
+			"difficulty": 2,
+			"explanation": "The short hand is past 3 and the long hand points to 9, which is 45 minutes."
+		}`)
+	}
+
+	mock := llm.NewMockProvider(llm.MockResponse{
+		Content: withDiagram(`{"kind": "clock", "min": 0, "max": 0, "intervals": 0, "points": [], "label_ticks": false,
+			"parts": 0, "shaded": 0, "rows": 0, "cols": 0, "row_parts": [], "col_parts": [], "hour": 3, "minute": 45}`),
+	}, llm.MockResponse{
+		Content: withDiagram(`{"kind": "none", "min": 0, "max": 0, "intervals": 0, "points": [], "label_ticks": false,
+			"parts": 0, "shaded": 0, "rows": 0, "cols": 0, "row_parts": [], "col_parts": [], "hour": 0, "minute": 0}`),
+	})
+	gen := New(mock, Config{Validators: []Validator{&StructuralValidator{}}})
+	input := GenerateInput{Skill: testSkill(), Tier: skillgraph.TierLearn}
+
+	q, err := gen.Generate(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Diagram == nil || q.Diagram.Kind != DiagramClock || q.Diagram.Hour != 3 || q.Diagram.Minute != 45 {
+		t.Errorf("diagram = %+v, want a 3:45 clock", q.Diagram)
+	}
+
+	q, err = gen.Generate(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Diagram != nil {
+		t.Errorf("kind none kept as %+v", q.Diagram)
+	}
+}
+
+func TestGenerate_DifficultyRetry(t *testing.T) {
+	withDifficulty := func(d string) json.RawMessage {
+		return json.RawMessage(strings.Replace(string(validQuestionJSON()), `"difficulty": 3`, `"difficulty": `+d, 1))
+	}
+	input := GenerateInput{
+		Skill:            testSkill(),
+		Tier:             skillgraph.TierLearn,
+		TargetDifficulty: NewDifficultyBand(1, 3),
+	}
+
+	// Too hard, then in band: the retry is returned.
+	mock := llm.NewMockProvider(
+		llm.MockResponse{Content: withDifficulty("5")},
+		llm.MockResponse{Content: withDifficulty("2")},
+	)
+	q, err := New(mock, DefaultConfig()).Generate(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Difficulty != 2 {
+		t.Errorf("difficulty = %d, want 2", q.Difficulty)
+	}
+	if mock.CallCount() != 2 {
+		t.Errorf("calls = %d, want 2", mock.CallCount())
+	}
+	if !strings.Contains(mock.Calls[0].Messages[0].Content, "Target difficulty: 1-3") {
+		t.Error("expected target difficulty in prompt")
+	}
+
+	// Out of band on every attempt: rejected.
+	mock = llm.NewMockProvider(
+		llm.MockResponse{Content: withDifficulty("5")},
+		llm.MockResponse{Content: withDifficulty("4")},
+	)
+	_, err = New(mock, DefaultConfig()).Generate(context.Background(), input)
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) || valErr.Validator != "difficulty" {
+		t.Fatalf("expected difficulty validation error, got %v", err)
+	}
+	if mock.CallCount() != 2 {
+		t.Errorf("calls = %d, want 2", mock.CallCount())
+	}
+}
+
+func TestGenerateBatch_RegeneratesFailures(t *testing.T) {
+	question := func(text, answer string) string {
+		return `{"question_text": "` + text + `", "format": "numeric", "answer": "` + answer + `",
+			"answer_type": "integer", "choices": [], "hint": "Add the ones first.", "difficulty": 2,
+			"explanation": "Add the ones, then the tens."}`
+	}
+	batch := func(qs ...string) json.RawMessage {
+		return json.RawMessage(`{"questions": [` + strings.Join(qs, ",") + `]}`)
+	}
+	mock := llm.NewMockProvider(
+		// One bad answer and one repeat: only the first is kept.
+		llm.MockResponse{Content: batch(
+			question("What is 12 + 8?", "20"),
+			question("What is 15 + 7?", "abc"),
+			question("What is 12 + 8?", "20"),
+		)},
+		llm.MockResponse{Content: batch(question("What is 31 + 9?", "40"))},
+	)
+	gen := New(mock, DefaultConfig())
+
+	qs, err := gen.GenerateBatch(context.Background(), GenerateInput{Skill: testSkill(), Tier: skillgraph.TierLearn}, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var texts []string
+	for _, q := range qs {
+		texts = append(texts, q.Text)
+	}
+	// Retries are spent with one question still missing: the batch comes
+	// back short rather than failing.
+	if want := []string{"What is 12 + 8?", "What is 31 + 9?"}; !slices.Equal(texts, want) {
+		t.Errorf("texts = %q, want %q", texts, want)
+	}
+	if mock.CallCount() != 2 {
+		t.Fatalf("calls = %d, want 2", mock.CallCount())
+	}
+	if mock.Calls[0].Schema != QuestionBatchSchema || !strings.Contains(mock.Calls[0].Messages[0].Content, "Number of problems: 3") {
+		t.Error("first call is not a batch of 3")
+	}
+	// The follow-up asks only for the missing questions, with the kept ones
+	// as already asked.
+	followUp := mock.Calls[1].Messages[0].Content
+	if !strings.Contains(followUp, "Number of problems: 2") || !strings.Contains(followUp, "What is 12 + 8?") {
+		t.Errorf("follow-up message:\n%s", followUp)
+	}
+}
+
+func TestGenerateBatch_AllInvalid(t *testing.T) {
+	bad := json.RawMessage(`{"questions": [{"question_text": "What is 10 + 5?", "format": "numeric",
+		"answer": "abc", "answer_type": "integer", "choices": [], "hint": "", "difficulty": 1,
+		"explanation": "10 + 5 = 15"}]}`)
+	mock := llm.NewMockProvider(llm.MockResponse{Content: bad}, llm.MockResponse{Content: bad})
+	gen := New(mock, DefaultConfig())
+
+	_, err := gen.GenerateBatch(context.Background(), GenerateInput{Skill: testSkill(), Tier: skillgraph.TierLearn}, 2)
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+	if mock.CallCount() != 2 {
+		t.Errorf("calls = %d, want 2", mock.CallCount())
+	}
+}
@@ -0,0 +1,164 @@
+package problemgen
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GradeKind is the outcome of grading one answer.
+type GradeKind string
+
+const (
+	// GradeCorrect is a right answer in an accepted form.
+	GradeCorrect GradeKind = "correct"
+
+	// GradeUnsimplified is the right value in a form the question ruled
+	// out: an unreduced fraction when simplest form was asked, or an
+	// improper fraction when a mixed number was asked.
+	GradeUnsimplified GradeKind = "unsimplified"
+
+	// GradeNearMiss is a wrong answer one slip away from the right one: two
+	// adjacent digits swapped, or the right number with the wrong unit.
+	GradeNearMiss GradeKind = "near_miss"
+
+	// GradeWrong is any other wrong answer.
+	GradeWrong GradeKind = "wrong"
+)
+
+// Grade is the graded result of an answer. Reason is a short child-facing
+// sentence for the partial grades; it never gives the correct answer away.
+type Grade struct {
+	Kind   GradeKind
+	Reason string
+}
+
+// Correct reports whether the answer earns full credit.
+func (g Grade) Correct() bool { return g.Kind == GradeCorrect }
+
+// Partial reports whether the answer was wrong but close: unsimplified or
+// a near miss. Partial grades count as wrong for mastery, but they are
+// slips rather than gaps in understanding.
+func (g Grade) Partial() bool {
+	return g.Kind == GradeUnsimplified || g.Kind == GradeNearMiss
+}
+
+// simplestFormInstruction matches a question's instruction to give the
+// answer in simplest form, so an equal but unreduced answer is not yet
+// right. It matches the asking phrase, in the question's language, not any
+// mention of the words: "a reduced price" or "to simplify the recipe" in a
+// word problem ask for nothing. Simplify and reduce count only as the verb
+// that opens a sentence.
+var simplestFormInstruction = regexp.MustCompile(`(?i)` +
+	`\b(in|to|into) (its |their )?(simplest|lowest) (form|terms)\b` +
+	`|\bas a mixed number\b` +
+	`|(^|[.!?:]\s+)(simplify|reduce)\b` +
+	// Spanish, French and German.
+	`|forma más simple|fracción irreducible|como (un )?número mixto|(^|[.!?¿:¡]\s*)simplifica` +
+	`|forme (la plus simple|irréductible)|(en|sous forme de) nombre mixte|(^|[.!?:]\s+)simplifie` +
+	`|vollständig gekürzt|als gemischte zahl|(^|[.!?:]\s+)kürze`)
+
+// GradeAnswer grades the learner's input against the correct answer. On
+// top of CheckAnswer's equality, it tells apart an unsimplified answer when
+// the question asked for simplest form, and near misses: a transposed pair
+// of digits or the right number in the wrong unit. Answers are read in the
+// question's locale, so "3,5" is 3.5 for a Spanish question.
+func GradeAnswer(learnerAnswer string, question *Question) Grade {
+	learnerAnswer = question.delocalize(strings.TrimSpace(learnerAnswer))
+	if !matchesAnswer(learnerAnswer, question) {
+		return nearMiss(learnerAnswer, question)
+	}
+	if typedFormat(question.Format) && !inSimplestForm(learnerAnswer, question.AnswerType) && asksSimplestForm(question.Text) {
+		reason := "That's the right value, but it isn't in simplest form yet."
+		if question.AnswerType == AnswerTypeMixedNumber {
+			reason = "That's the right value — now write it as a mixed number in simplest form."
+		}
+		return Grade{Kind: GradeUnsimplified, Reason: reason}
+	}
+	return Grade{Kind: GradeCorrect}
+}
+
+// typedFormat reports whether the learner types a single answer, as
+// opposed to picking, arranging or filling several blanks. An unset format
+// grades as numeric, like CheckAnswer.
+func typedFormat(f AnswerFormat) bool {
+	return f == FormatNumeric || f == ""
+}
+
+func asksSimplestForm(text string) bool {
+	return simplestFormInstruction.MatchString(text)
+}
+
+// inSimplestForm reports whether a fraction or mixed-number answer is
+// written in lowest terms (and, for mixed numbers, as a mixed number).
+// Other answer types have no simpler form.
+func inSimplestForm(answer string, answerType AnswerType) bool {
+	switch answerType {
+	case AnswerTypeFraction:
+		num, den, err := parseFraction(answer)
+		return err != nil || den == 0 || gcd(abs(num), abs(den)) == 1
+	case AnswerTypeMixedNumber:
+		answer = strings.Join(strings.Fields(strings.ReplaceAll(answer, " and ", " ")), " ")
+		return validateMixedNumber(answer) == nil
+	}
+	return true
+}
+
+// nearMiss classifies a wrong single answer as a near miss or plain wrong.
+func nearMiss(learner string, q *Question) Grade {
+	wrong := Grade{Kind: GradeWrong}
+	if learner == "" || !typedFormat(q.Format) {
+		return wrong
+	}
+
+	if q.AnswerType.hasUnits() && sameNumberOtherUnit(learner, q.Answer, q.AnswerType) {
+		return Grade{Kind: GradeNearMiss, Reason: "Right number, but check the unit."}
+	}
+
+	l, err := normalizeAnswer(learner, q.AnswerType)
+	if err != nil {
+		return wrong
+	}
+	c, err := normalizeAnswer(q.Answer, q.AnswerType)
+	if err != nil {
+		return wrong
+	}
+	if transposed(l, c) {
+		return Grade{Kind: GradeNearMiss, Reason: "So close — check the order of your digits."}
+	}
+	return wrong
+}
+
+// transposed reports whether a and b differ only by two adjacent, distinct
+// digits swapped ("54" for "45", "4.57" for "4.75").
+func transposed(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	i := 0
+	for i < len(a) && a[i] == b[i] {
+		i++
+	}
+	if i+1 >= len(a) || !isDigit(a[i]) || !isDigit(a[i+1]) {
+		return false
+	}
+	return a[i] == b[i+1] && a[i+1] == b[i] && a[i+2:] == b[i+2:]
+}
+
+// sameNumberOtherUnit reports whether a one-part answer has the right
+// number in a different unit ("45 h" for "45 min").
+func sameNumberOtherUnit(learner, correct string, answerType AnswerType) bool {
+	if answerType == AnswerTypeMoney {
+		return false // dollars vs cents is a conversion, not a unit slip
+	}
+	lq, err := parseQuantities(learner)
+	if err != nil || len(lq) != 1 || lq[0].unit == "" {
+		return false
+	}
+	cq, err := parseQuantities(correct)
+	if err != nil || len(cq) != 1 {
+		return false
+	}
+	lu, lok := canonicalUnit(lq[0].unit, measurementUnits)
+	cu, cok := canonicalUnit(cq[0].unit, measurementUnits)
+	return lok && cok && lu != cu && lq[0].value.Cmp(cq[0].value) == 0
+}
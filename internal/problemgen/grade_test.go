@@ -0,0 +1,61 @@
+package problemgen
+
+import (
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+)
+
+func TestGradeAnswer(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     *Question
+		input string
+		want  GradeKind
+	}{
+		{"exact", &Question{Format: FormatNumeric, Answer: "45", AnswerType: AnswerTypeInteger}, "45", GradeCorrect},
+		{"transposed integer", &Question{Format: FormatNumeric, Answer: "45", AnswerType: AnswerTypeInteger}, "54", GradeNearMiss},
+		{"transposed decimal", &Question{Format: FormatNumeric, Answer: "4.75", AnswerType: AnswerTypeDecimal}, "4.57", GradeNearMiss},
+		{"two swaps is wrong", &Question{Format: FormatNumeric, Answer: "1234", AnswerType: AnswerTypeInteger}, "2143", GradeWrong},
+		{"plain wrong", &Question{Format: FormatNumeric, Answer: "45", AnswerType: AnswerTypeInteger}, "46", GradeWrong},
+
+		{"unreduced, simplest asked", &Question{Text: "Write 6/8 in simplest form.", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction}, "6/8", GradeUnsimplified},
+		{"reduced, simplest asked", &Question{Text: "Write 6/8 in simplest form.", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction}, "3/4", GradeCorrect},
+		{"unreduced, not asked", &Question{Text: "What is 1/8 + 5/8?", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction}, "6/8", GradeCorrect},
+		{"improper for mixed", &Question{Text: "Write 9/4 as a mixed number.", Format: FormatNumeric, Answer: "2 1/4", AnswerType: AnswerTypeMixedNumber}, "9/4", GradeUnsimplified},
+		{"mixed given", &Question{Text: "Write 9/4 as a mixed number.", Format: FormatNumeric, Answer: "2 1/4", AnswerType: AnswerTypeMixedNumber}, "2 1/4", GradeCorrect},
+		{"simplify instruction", &Question{Text: "What is 1/8 + 5/8? Simplify your answer.", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction}, "6/8", GradeUnsimplified},
+		{"translated instruction", &Question{Text: "Escribe 6/8 en su forma más simple.", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction, Locale: i18n.Spanish}, "6/8", GradeUnsimplified},
+		{"words in a story", &Question{Text: "A shop reduced the price of a cake by 1/8, then by 5/8 more. By what fraction was it reduced?", Format: FormatNumeric, Answer: "3/4", AnswerType: AnswerTypeFraction}, "6/8", GradeCorrect},
+		{"mixed number mentioned", &Question{Text: "Ana ate 2 1/4 pizzas. A mixed number is fine. How many quarters did she eat?", Format: FormatNumeric, Answer: "9/4", AnswerType: AnswerTypeFraction}, "18/8", GradeCorrect},
+
+		{"wrong unit", &Question{Format: FormatNumeric, Answer: "45 min", AnswerType: AnswerTypeTime}, "45 h", GradeNearMiss},
+		{"wrong unit measurement", &Question{Format: FormatNumeric, Answer: "12 cm", AnswerType: AnswerTypeMeasurement}, "12 m", GradeNearMiss},
+		{"wrong number right unit", &Question{Format: FormatNumeric, Answer: "12 cm", AnswerType: AnswerTypeMeasurement}, "13 cm", GradeWrong},
+
+		{"multiple choice wrong", &Question{Format: FormatMultipleChoice, Answer: "45", Choices: []string{"45", "54", "40", "50"}, AnswerType: AnswerTypeInteger}, "54", GradeWrong},
+
+		{"decimal comma", &Question{Format: FormatNumeric, Answer: "3.5", AnswerType: AnswerTypeDecimal, Locale: i18n.Spanish}, "3,5", GradeCorrect},
+		{"decimal comma near miss", &Question{Format: FormatNumeric, Answer: "4.75", AnswerType: AnswerTypeDecimal, Locale: i18n.German}, "4,57", GradeNearMiss},
+		{"euros after the amount", &Question{Format: FormatNumeric, Answer: "4.75", AnswerType: AnswerTypeMoney, Locale: i18n.French}, "4,75 €", GradeCorrect},
+		{"decimal comma choice", &Question{Format: FormatMultipleChoice, Answer: "0.5", Choices: []string{"0.5", "0.25", "5", "2"}, AnswerType: AnswerTypeDecimal, Locale: i18n.French}, "0,5", GradeCorrect},
+		{"translated true", &Question{Format: FormatTrueFalse, Answer: "True", Choices: TrueFalseChoices, AnswerType: AnswerTypeText, Locale: i18n.Spanish}, "Verdadero", GradeCorrect},
+		{"translated false", &Question{Format: FormatTrueFalse, Answer: "True", Choices: TrueFalseChoices, AnswerType: AnswerTypeText, Locale: i18n.French}, "Faux", GradeWrong},
+		{"comma in English", &Question{Format: FormatNumeric, Answer: "3.5", AnswerType: AnswerTypeDecimal}, "3,5", GradeWrong},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := GradeAnswer(tc.input, tc.q)
+			if g.Kind != tc.want {
+				t.Fatalf("GradeAnswer(%q) = %s (%q), want %s", tc.input, g.Kind, g.Reason, tc.want)
+			}
+			if g.Partial() && g.Reason == "" {
+				t.Error("partial grade without a reason")
+			}
+			if g.Partial() && g.Correct() {
+				t.Error("partial grade counted as correct")
+			}
+		})
+	}
+}
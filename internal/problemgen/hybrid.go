@@ -0,0 +1,370 @@
+package problemgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// Question sources, recorded on Question.Source.
+const (
+	SourceLLM     = "llm"
+	SourceOffline = "offline"
+)
+
+// HybridConfig controls a HybridGenerator.
+type HybridConfig struct {
+	// PrefetchDepth is how many questions to keep ready for the skill and
+	// tier last asked for. Zero disables prefetching.
+	PrefetchDepth int
+
+	// PrimaryTimeout bounds one primary attempt when the fallback can serve
+	// the skill; past it the fallback answers instead. Skills the fallback
+	// can't serve wait for the caller's context. A batch attempt gets one
+	// PrimaryTimeout too, however many questions it asks for.
+	PrimaryTimeout time.Duration
+}
+
+// DefaultHybridConfig returns the recommended HybridConfig: two questions
+// prefetched, and the fallback after 10 seconds.
+func DefaultHybridConfig() HybridConfig {
+	return HybridConfig{
+		PrefetchDepth:  2,
+		PrimaryTimeout: 10 * time.Second,
+	}
+}
+
+// SourceStats counts one source's activity in a HybridGenerator.
+type SourceStats struct {
+	Attempts int           // Generate calls, prefetches included
+	Failures int           // errors and timeouts
+	Served   int           // questions handed to the caller
+	Latency  time.Duration // total time spent in successful calls
+}
+
+// HybridStats is a snapshot of a HybridGenerator's metrics.
+type HybridStats struct {
+	Primary  SourceStats
+	Fallback SourceStats
+
+	// Fallbacks counts questions the fallback served because the primary
+	// failed or timed out.
+	Fallbacks int
+
+	// PrefetchHits and PrefetchMisses count Generate calls served from the
+	// prefetch queue and calls that had to generate on the spot.
+	PrefetchHits   int
+	PrefetchMisses int
+}
+
+// HybridGenerator implements Generator over a primary generator (the LLM)
+// and a local fallback (the offline templates). Questions come from the
+// primary; when it errors or exceeds PrimaryTimeout, the fallback serves
+// instead, so a flaky provider never leaves the learner at a spinner.
+//
+// After each call it prefetches the next PrefetchDepth questions for the
+// same skill and tier in the background, so the next call is usually
+// instant. Asking for a different skill or tier (the next plan slot, or a
+// tier-up) discards the queue. Inputs with RecentErrors never take from the
+// queue: it was filled before the mistake, and the follow-up question has
+// to target it. Close stops the background work.
+//
+// HybridGenerator is safe for concurrent use. It implements SkillLimiter
+// only through the primary: every skill the primary covers is served.
+type HybridGenerator struct {
+	primary  Generator
+	fallback Generator
+	cfg      HybridConfig
+
+	mu    sync.Mutex
+	queue *prefetchQueue
+	stats HybridStats
+}
+
+// prefetchQueue holds prefetched questions for one skill and tier.
+type prefetchQueue struct {
+	key     string
+	input   GenerateInput // latest input, PriorQuestions included
+	ready   []*Question
+	running bool
+	cancel  context.CancelFunc
+}
+
+// NewHybrid creates a HybridGenerator. fallback may be nil, in which case
+// primary errors are returned as-is.
+func NewHybrid(primary, fallback Generator, cfg HybridConfig) *HybridGenerator {
+	return &HybridGenerator{primary: primary, fallback: fallback, cfg: cfg}
+}
+
+// Supports reports whether the primary generator covers skill.
+func (h *HybridGenerator) Supports(skill skillgraph.Skill) bool {
+	return Supports(h.primary, skill)
+}
+
+// Generate serves a prefetched question when one is ready, and otherwise
+// generates one from the primary, falling back on failure. Either way it
+// then tops up the prefetch queue for input's skill and tier.
+func (h *HybridGenerator) Generate(ctx context.Context, input GenerateInput) (*Question, error) {
+	if q := h.pop(input); q != nil {
+		h.prefetch(input, q)
+		return q, nil
+	}
+
+	q, err := h.generate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	h.prefetch(input, q)
+	return q, nil
+}
+
+// GenerateBatch serves ready prefetched questions first and asks the
+// primary for the rest in one batch; when the primary fails or comes back
+// short, the fallback fills in. It does not prefetch: the batch already
+// covers what the caller is about to ask for.
+func (h *HybridGenerator) GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	var qs []*Question
+	for len(qs) < n {
+		q := h.pop(withAsked(input, qs...))
+		if q == nil {
+			break
+		}
+		qs = append(qs, q)
+	}
+	if len(qs) == n {
+		return qs, nil
+	}
+
+	canFallback := h.fallback != nil && Supports(h.fallback, input.Skill)
+
+	pctx := ctx
+	if canFallback && h.cfg.PrimaryTimeout > 0 {
+		var cancel context.CancelFunc
+		pctx, cancel = context.WithTimeout(ctx, h.cfg.PrimaryTimeout)
+		defer cancel()
+	}
+	got, perr := h.attemptBatch(pctx, h.primary, withAsked(input, qs...), n-len(qs), &h.stats.Primary)
+	h.servedBatch(&h.stats.Primary, len(got), false)
+	qs = append(qs, got...)
+	// The caller gave up: don't spend more of its time.
+	if len(qs) == n || !canFallback || ctx.Err() != nil {
+		if len(qs) == 0 {
+			return nil, perr
+		}
+		return qs, nil
+	}
+
+	got, ferr := h.attemptBatch(ctx, h.fallback, withAsked(input, qs...), n-len(qs), &h.stats.Fallback)
+	h.servedBatch(&h.stats.Fallback, len(got), true)
+	qs = append(qs, got...)
+	if len(qs) == 0 {
+		return nil, errors.Join(perr, fmt.Errorf("fallback: %w", ferr))
+	}
+	return qs, nil
+}
+
+// Stats returns a snapshot of the generator's metrics.
+func (h *HybridGenerator) Stats() HybridStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.stats
+}
+
+// GeneratorStats returns the metrics of the HybridGenerator gen is or
+// wraps in a BankedGenerator, and false when there is none.
+func GeneratorStats(gen Generator) (HybridStats, bool) {
+	switch g := gen.(type) {
+	case *HybridGenerator:
+		return g.Stats(), true
+	case *BankedGenerator:
+		return GeneratorStats(g.gen)
+	}
+	return HybridStats{}, false
+}
+
+// Close stops background prefetching and drops queued questions.
+func (h *HybridGenerator) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dropLocked()
+}
+
+// generate produces one question on the spot: primary first, then the
+// fallback.
+func (h *HybridGenerator) generate(ctx context.Context, input GenerateInput) (*Question, error) {
+	canFallback := h.fallback != nil && Supports(h.fallback, input.Skill)
+
+	pctx := ctx
+	if canFallback && h.cfg.PrimaryTimeout > 0 {
+		var cancel context.CancelFunc
+		pctx, cancel = context.WithTimeout(ctx, h.cfg.PrimaryTimeout)
+		defer cancel()
+	}
+	q, perr := h.attempt(pctx, h.primary, input, &h.stats.Primary)
+	if perr == nil {
+		h.served(&h.stats.Primary, false)
+		return q, nil
+	}
+	// The caller gave up: don't spend more of its time.
+	if !canFallback || ctx.Err() != nil {
+		return nil, perr
+	}
+
+	q, ferr := h.attempt(ctx, h.fallback, input, &h.stats.Fallback)
+	if ferr != nil {
+		return nil, errors.Join(perr, fmt.Errorf("fallback: %w", ferr))
+	}
+	h.served(&h.stats.Fallback, true)
+	return q, nil
+}
+
+// attempt runs one Generate call on gen, recording it in stats.
+func (h *HybridGenerator) attempt(ctx context.Context, gen Generator, input GenerateInput, stats *SourceStats) (*Question, error) {
+	start := time.Now()
+	q, err := gen.Generate(ctx, input)
+	return q, h.record(stats, start, err)
+}
+
+// attemptBatch runs one GenerateBatch call on gen, recording it in stats.
+func (h *HybridGenerator) attemptBatch(ctx context.Context, gen Generator, input GenerateInput, n int, stats *SourceStats) ([]*Question, error) {
+	start := time.Now()
+	qs, err := gen.GenerateBatch(ctx, input, n)
+	return qs, h.record(stats, start, err)
+}
+
+// record counts one call that started at start and returned err.
+func (h *HybridGenerator) record(stats *SourceStats, start time.Time, err error) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	stats.Attempts++
+	if err != nil {
+		stats.Failures++
+		return err
+	}
+	stats.Latency += time.Since(start)
+	return nil
+}
+
+func (h *HybridGenerator) served(stats *SourceStats, fallback bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	stats.Served++
+	h.stats.PrefetchMisses++
+	if fallback {
+		h.stats.Fallbacks++
+	}
+}
+
+func (h *HybridGenerator) servedBatch(stats *SourceStats, n int, fallback bool) {
+	for range n {
+		h.served(stats, fallback)
+	}
+}
+
+// pop returns a ready question for input's skill and tier that input hasn't
+// seen and that fits its difficulty band, or nil. A different skill or tier
+// discards the queue; the band can move between calls, so questions
+// prefetched for an older band are skipped. Inputs with RecentErrors get
+// nil, like BankedGenerator, so targeted follow-ups are generated fresh.
+func (h *HybridGenerator) pop(input GenerateInput) *Question {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.queue == nil || h.queue.key != queueKey(input) {
+		h.dropLocked()
+		return nil
+	}
+	if len(input.RecentErrors) > 0 {
+		return nil
+	}
+	for len(h.queue.ready) > 0 {
+		q := h.queue.ready[0]
+		h.queue.ready = h.queue.ready[1:]
+		if !slices.Contains(input.PriorQuestions, q.Text) && input.TargetDifficulty.Contains(q.Difficulty) {
+			h.stats.PrefetchHits++
+			h.stats.Primary.Served++
+			return q
+		}
+	}
+	return nil
+}
+
+// prefetch records the latest input (plus the question just served) and
+// starts the background worker if the queue is short.
+func (h *HybridGenerator) prefetch(input GenerateInput, served *Question) {
+	if h.cfg.PrefetchDepth <= 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := queueKey(input)
+	if h.queue == nil || h.queue.key != key {
+		h.dropLocked()
+		h.queue = &prefetchQueue{key: key}
+	}
+	pq := h.queue
+	pq.input = input
+	pq.input.PriorQuestions = append(slices.Clone(input.PriorQuestions), served.Text)
+
+	if pq.running || len(pq.ready) >= h.cfg.PrefetchDepth {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pq.running, pq.cancel = true, cancel
+	go h.fill(ctx, pq)
+}
+
+// fill generates questions from the primary into pq until it holds
+// PrefetchDepth of them, the queue is replaced, or the primary fails.
+// Prefetching never uses the fallback: it is cheap to run on demand.
+func (h *HybridGenerator) fill(ctx context.Context, pq *prefetchQueue) {
+	for {
+		h.mu.Lock()
+		if h.queue != pq || len(pq.ready) >= h.cfg.PrefetchDepth {
+			pq.running = false
+			h.mu.Unlock()
+			return
+		}
+		input := pq.input
+		for _, q := range pq.ready {
+			input.PriorQuestions = append(slices.Clone(input.PriorQuestions), q.Text)
+		}
+		h.mu.Unlock()
+
+		actx := ctx
+		var cancel context.CancelFunc = func() {}
+		if h.cfg.PrimaryTimeout > 0 {
+			actx, cancel = context.WithTimeout(ctx, h.cfg.PrimaryTimeout)
+		}
+		q, err := h.attempt(actx, h.primary, input, &h.stats.Primary)
+		cancel()
+
+		h.mu.Lock()
+		if err != nil || h.queue != pq {
+			pq.running = false
+			h.mu.Unlock()
+			return
+		}
+		pq.ready = append(pq.ready, q)
+		h.mu.Unlock()
+	}
+}
+
+// dropLocked discards the prefetch queue and cancels its worker. Caller
+// holds h.mu.
+func (h *HybridGenerator) dropLocked() {
+	if h.queue != nil && h.queue.cancel != nil {
+		h.queue.cancel()
+	}
+	h.queue = nil
+}
+
+func queueKey(input GenerateInput) string {
+	return input.Skill.ID + "|" + input.Tier.String()
+}
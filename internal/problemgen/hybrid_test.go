@@ -0,0 +1,243 @@
+package problemgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// countingGenerator numbers its questions ("q1", "q2", …) and fails while
+// err is set. A non-nil block holds every call until it is closed or the
+// context ends.
+type countingGenerator struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+	block chan struct{}
+}
+
+func (g *countingGenerator) Generate(ctx context.Context, input GenerateInput) (*Question, error) {
+	if g.block != nil {
+		select {
+		case <-g.block:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.err != nil {
+		return nil, g.err
+	}
+	g.calls++
+	return &Question{Text: fmt.Sprintf("q%d", g.calls), SkillID: input.Skill.ID, Tier: input.Tier, Source: SourceLLM}, nil
+}
+
+func (g *countingGenerator) GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	return GenerateEach(ctx, g, input, n)
+}
+
+func hybridInput(t *testing.T, id string) GenerateInput {
+	t.Helper()
+	skill, err := skillgraph.GetSkill(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return GenerateInput{Skill: skill, Tier: skillgraph.TierLearn}
+}
+
+// waitFor polls cond until it holds or a second passes.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for prefetch")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHybridGenerator_FallsBackOnError(t *testing.T) {
+	primary := &countingGenerator{err: errors.New("provider down")}
+	h := NewHybrid(primary, NewOffline(1), HybridConfig{PrimaryTimeout: time.Second})
+
+	q, err := h.Generate(context.Background(), hybridInput(t, "add-3digit"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Source != SourceOffline {
+		t.Errorf("source = %q, want offline", q.Source)
+	}
+	st := h.Stats()
+	if st.Fallbacks != 1 || st.Primary.Failures != 1 || st.Fallback.Served != 1 {
+		t.Errorf("stats = %+v", st)
+	}
+}
+
+func TestHybridGenerator_FallsBackOnTimeout(t *testing.T) {
+	primary := &countingGenerator{block: make(chan struct{})}
+	h := NewHybrid(primary, NewOffline(1), HybridConfig{PrimaryTimeout: 10 * time.Millisecond})
+
+	q, err := h.Generate(context.Background(), hybridInput(t, "add-3digit"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Source != SourceOffline {
+		t.Errorf("source = %q, want offline", q.Source)
+	}
+}
+
+func TestHybridGenerator_NoFallbackForUnsupportedSkill(t *testing.T) {
+	primary := &countingGenerator{err: errors.New("provider down")}
+	h := NewHybrid(primary, NewOffline(1), DefaultHybridConfig())
+
+	// The offline templates don't cover every skill; the primary's error
+	// stands for the rest.
+	var skill skillgraph.Skill
+	for _, s := range skillgraph.AllSkills() {
+		if !NewOffline(1).Supports(s) {
+			skill = s
+			break
+		}
+	}
+	if skill.ID == "" {
+		t.Skip("offline generator covers every skill")
+	}
+	_, err := h.Generate(context.Background(), GenerateInput{Skill: skill, Tier: skillgraph.TierLearn})
+	if err == nil || err.Error() != "provider down" {
+		t.Errorf("err = %v, want the primary's error", err)
+	}
+	if h.Stats().Fallback.Attempts != 0 {
+		t.Error("fallback attempted for an unsupported skill")
+	}
+}
+
+func TestHybridGenerator_Prefetch(t *testing.T) {
+	primary := &countingGenerator{}
+	h := NewHybrid(primary, nil, HybridConfig{PrefetchDepth: 2})
+	defer h.Close()
+	ctx := context.Background()
+	input := hybridInput(t, "add-3digit")
+
+	q, err := h.Generate(ctx, input)
+	if err != nil || q.Text != "q1" {
+		t.Fatalf("first = %v, %v", q, err)
+	}
+	waitFor(t, func() bool { return h.Stats().Primary.Attempts == 3 })
+
+	input.PriorQuestions = []string{"q1"}
+	q, err = h.Generate(ctx, input)
+	if err != nil || q.Text != "q2" {
+		t.Fatalf("second = %v, %v", q, err)
+	}
+	if st := h.Stats(); st.PrefetchHits != 1 || st.PrefetchMisses != 1 {
+		t.Errorf("stats = %+v", st)
+	}
+
+	// A new skill discards the queue and generates on the spot.
+	waitFor(t, func() bool { return h.Stats().Primary.Attempts == 4 })
+	q, err = h.Generate(ctx, hybridInput(t, "sub-3digit"))
+	if err != nil || q.Text != "q5" {
+		t.Fatalf("other skill = %v, %v", q, err)
+	}
+	if st := h.Stats(); st.PrefetchHits != 1 || st.PrefetchMisses != 2 {
+		t.Errorf("stats after skill change = %+v", st)
+	}
+}
+
+func TestHybridGenerator_PrefetchSkipsPriorQuestions(t *testing.T) {
+	primary := &countingGenerator{}
+	h := NewHybrid(primary, nil, HybridConfig{PrefetchDepth: 1})
+	defer h.Close()
+	ctx := context.Background()
+	input := hybridInput(t, "add-3digit")
+
+	if _, err := h.Generate(ctx, input); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return h.Stats().Primary.Attempts == 2 })
+
+	// The learner already saw q2 elsewhere: it is not served again.
+	input.PriorQuestions = []string{"q1", "q2"}
+	q, err := h.Generate(ctx, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Text == "q2" {
+		t.Error("served a prefetched question the learner has seen")
+	}
+}
+
+func TestHybridGenerator_RecentErrorsBypassPrefetch(t *testing.T) {
+	primary := &countingGenerator{}
+	h := NewHybrid(primary, nil, HybridConfig{PrefetchDepth: 1})
+	defer h.Close()
+	ctx := context.Background()
+	input := hybridInput(t, "add-3digit")
+
+	if _, err := h.Generate(ctx, input); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return h.Stats().Primary.Attempts == 2 })
+
+	// q2 was prefetched before the mistake: the follow-ups are generated.
+	input.PriorQuestions = []string{"q1"}
+	input.RecentErrors = []string{"Answered 5 for 2 + 2"}
+	q, err := h.Generate(ctx, input)
+	if err != nil || q.Text != "q3" {
+		t.Fatalf("follow-up = %v, %v; want q3", q, err)
+	}
+	input.PriorQuestions = append(input.PriorQuestions, "q3")
+	qs, err := h.GenerateBatch(ctx, input, 1)
+	if err != nil || len(qs) != 1 || qs[0].Text != "q4" {
+		t.Fatalf("batch follow-up = %v, %v; want q4", qs, err)
+	}
+	if st := h.Stats(); st.PrefetchHits != 0 {
+		t.Errorf("stats = %+v, want no prefetch hits", st)
+	}
+}
+
+func TestHybridGenerator_BatchFallsBack(t *testing.T) {
+	primary := &countingGenerator{err: errors.New("provider down")}
+	h := NewHybrid(primary, NewOffline(1), HybridConfig{PrimaryTimeout: time.Second})
+
+	qs, err := h.GenerateBatch(context.Background(), hybridInput(t, "add-3digit"), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(qs) != 3 {
+		t.Fatalf("got %d questions, want 3", len(qs))
+	}
+	for _, q := range qs {
+		if q.Source != SourceOffline {
+			t.Errorf("source = %q, want offline", q.Source)
+		}
+	}
+	st := h.Stats()
+	if st.Fallbacks != 3 || st.Primary.Attempts != 1 || st.Fallback.Served != 3 {
+		t.Errorf("stats = %+v", st)
+	}
+}
+
+func TestHybridGenerator_BatchTimeoutIsPerAttempt(t *testing.T) {
+	primary := &countingGenerator{block: make(chan struct{})}
+	h := NewHybrid(primary, NewOffline(1), HybridConfig{PrimaryTimeout: 100 * time.Millisecond})
+
+	// A caller budget shorter than PrimaryTimeout per question still leaves
+	// room for the fallback after one timed-out batch attempt.
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	qs, err := h.GenerateBatch(ctx, hybridInput(t, "add-3digit"), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(qs) != 3 || qs[0].Source != SourceOffline {
+		t.Errorf("got %d questions, first from %q; want 3 offline", len(qs), qs[0].Source)
+	}
+}
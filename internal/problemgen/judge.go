@@ -0,0 +1,143 @@
+package problemgen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/abhisek/mathiz/internal/llm"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// JudgeValidator asks a second model to solve the question independently,
+// without seeing the claimed answer, and rejects the question when the
+// judge's answer disagrees or the judge finds it ambiguous or not
+// age-appropriate. It is meant for the word problems and conceptual
+// questions MathCheckValidator can't compute: questions the math check
+// can recompute are not sent to the judge.
+//
+// A judge that fails to answer passes the question: the deterministic
+// validators have already run, and a flaky judge must not stall
+// generation. Verdicts are logged as LLM events with purpose
+// llm.PurposeJudge.
+type JudgeValidator struct {
+	provider llm.Provider
+}
+
+// NewJudge creates a JudgeValidator backed by provider.
+func NewJudge(provider llm.Provider) *JudgeValidator {
+	return &JudgeValidator{provider: provider}
+}
+
+func (v *JudgeValidator) Name() string { return "judge" }
+
+func (v *JudgeValidator) Validate(q *Question, input GenerateInput) *ValidationError {
+	return v.ValidateContext(context.Background(), q, input)
+}
+
+// judgeVerdict is the judge's structured response.
+type judgeVerdict struct {
+	Answer         string `json:"answer"`
+	Ambiguous      bool   `json:"ambiguous"`
+	AgeAppropriate bool   `json:"age_appropriate"`
+	Reason         string `json:"reason"`
+}
+
+func (v *JudgeValidator) ValidateContext(ctx context.Context, q *Question, input GenerateInput) *ValidationError {
+	if _, err := computeAnswer(q); err == nil {
+		return nil // the math check covers it
+	}
+
+	resp, err := v.provider.Generate(llm.WithPurpose(ctx, llm.PurposeJudge), llm.Request{
+		System:      judgeSystemPrompt,
+		Messages:    []llm.Message{{Role: llm.RoleUser, Content: buildJudgeMessage(q, input)}},
+		Schema:      judgeSchema,
+		MaxTokens:   256,
+		Temperature: 0,
+	})
+	if err != nil {
+		return nil
+	}
+	var verdict judgeVerdict
+	if err := json.Unmarshal(resp.Content, &verdict); err != nil {
+		return nil
+	}
+
+	switch {
+	case verdict.Ambiguous:
+		return v.fail("judge found the question ambiguous: %s", verdict.Reason)
+	case !verdict.AgeAppropriate:
+		return v.fail("judge found the question not age-appropriate: %s", verdict.Reason)
+	case !matchesAnswer(verdict.Answer, q):
+		return v.fail("judge answered %q but LLM claimed %q", verdict.Answer, q.Answer)
+	}
+	return nil
+}
+
+func (v *JudgeValidator) fail(format string, args ...any) *ValidationError {
+	return &ValidationError{
+		Validator: v.Name(),
+		Message:   fmt.Sprintf(format, args...),
+		Retryable: true,
+	}
+}
+
+const judgeSystemPrompt = `You are checking a math practice question written for a child before the child sees it.
+
+Solve the question yourself, step by step in your head, and report:
+- answer: your final answer. For multiple choice, the exact text of the correct option. For numeric answers, just the number in simplest form. For true/false, "True" or "False". For ordering, every option in order, separated by "; ". For select-all-that-apply, every correct option, separated by "; ". For several blanks, one value per blank in order, separated by "; ".
+- ambiguous: true if the question could reasonably have more than one answer, is missing information, or no option is correct.
+- age_appropriate: false if the wording, numbers or context are unsuitable for the stated grade.
+- reason: one short sentence explaining any problem, or empty.`
+
+// buildJudgeMessage presents the question as the learner sees it, without
+// the claimed answer, hint or explanation.
+func buildJudgeMessage(q *Question, input GenerateInput) string {
+	var b strings.Builder
+	if input.Skill.ID != "" {
+		fmt.Fprintf(&b, "Grade: %s\n", skillgraph.GradeLabel(input.Skill.GradeLevel))
+		fmt.Fprintf(&b, "Skill: %s\n", input.Skill.Name)
+	}
+	fmt.Fprintf(&b, "Format: %s\n", q.Format)
+	fmt.Fprintf(&b, "Question: %s\n", q.Text)
+	if q.Diagram != nil {
+		fmt.Fprintf(&b, "Diagram: %s\n", q.Diagram.Describe())
+	}
+	if q.Format.HasChoices() {
+		b.WriteString("Options:\n")
+		for _, c := range q.Choices {
+			fmt.Fprintf(&b, "- %s\n", c)
+		}
+	}
+	return b.String()
+}
+
+// judgeSchema is the JSON schema for judge verdicts.
+var judgeSchema = &llm.Schema{
+	Name:        "question-verdict",
+	Description: "An independent solution and quality verdict for a math question",
+	Definition: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"answer": map[string]any{
+				"type":        "string",
+				"description": "The judge's own final answer",
+			},
+			"ambiguous": map[string]any{
+				"type":        "boolean",
+				"description": "Whether the question is ambiguous or unanswerable as written",
+			},
+			"age_appropriate": map[string]any{
+				"type":        "boolean",
+				"description": "Whether the question suits the stated grade",
+			},
+			"reason": map[string]any{
+				"type":        "string",
+				"description": "One sentence explaining any problem, or empty",
+			},
+		},
+		"required":             []any{"answer", "ambiguous", "age_appropriate", "reason"},
+		"additionalProperties": false,
+	},
+}
@@ -0,0 +1,99 @@
+package problemgen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/llm"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+func wordProblem() *Question {
+	q := validQuestion()
+	q.Text = "Sam has 3 bags with 4 apples in each bag. How many apples does Sam have?"
+	q.Answer = "12"
+	return q
+}
+
+func verdict(t *testing.T, v judgeVerdict) llm.MockResponse {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return llm.MockResponse{Content: b}
+}
+
+func TestJudge_AgreesAndDisagrees(t *testing.T) {
+	mock := llm.NewMockProvider(
+		verdict(t, judgeVerdict{Answer: "12", AgeAppropriate: true}),
+		verdict(t, judgeVerdict{Answer: "7", AgeAppropriate: true}),
+	)
+	judge := NewJudge(mock)
+	ctx := context.Background()
+
+	if err := judge.ValidateContext(ctx, wordProblem(), GenerateInput{}); err != nil {
+		t.Errorf("agreeing judge rejected: %v", err)
+	}
+	err := judge.ValidateContext(ctx, wordProblem(), GenerateInput{})
+	if err == nil || err.Validator != "judge" || !err.Retryable {
+		t.Errorf("disagreeing judge = %v, want a retryable judge error", err)
+	}
+
+	// The judge never sees the claimed answer.
+	req := mock.Requests()[0]
+	if strings.Contains(req.Messages[0].Content, "12") {
+		t.Errorf("judge prompt leaks the answer: %q", req.Messages[0].Content)
+	}
+}
+
+func TestJudge_RejectsAmbiguousAndInappropriate(t *testing.T) {
+	for _, v := range []judgeVerdict{
+		{Answer: "12", Ambiguous: true, AgeAppropriate: true, Reason: "bag size unclear"},
+		{Answer: "12", AgeAppropriate: false, Reason: "too advanced"},
+	} {
+		judge := NewJudge(llm.NewMockProvider(verdict(t, v)))
+		if err := judge.ValidateContext(context.Background(), wordProblem(), GenerateInput{}); err == nil {
+			t.Errorf("verdict %+v should reject", v)
+		}
+	}
+}
+
+func TestJudge_SkipsComputableAndFailsOpen(t *testing.T) {
+	// Empty queue: every call errors.
+	mock := llm.NewMockProvider()
+	judge := NewJudge(mock)
+
+	computable := validQuestion() // "What is 345 + 278?"
+	if err := judge.ValidateContext(context.Background(), computable, GenerateInput{}); err != nil || mock.CallCount() != 0 {
+		t.Errorf("computable question: err %v, %d judge calls; want pass without a call", err, mock.CallCount())
+	}
+	if err := judge.ValidateContext(context.Background(), wordProblem(), GenerateInput{}); err != nil {
+		t.Errorf("unavailable judge rejected: %v", err)
+	}
+}
+
+func TestLLMGenerator_RunsJudge(t *testing.T) {
+	raw, _ := json.Marshal(questionOutput{
+		QuestionText: wordProblem().Text,
+		Format:       "numeric",
+		Answer:       "12",
+		AnswerType:   "integer",
+		Hint:         "Count the bags.",
+		Difficulty:   2,
+		Explanation:  "3 bags of 4 is 12.",
+	})
+	gen := llm.NewMockProvider(llm.MockResponse{Content: raw})
+	judge := llm.NewMockProvider(verdict(t, judgeVerdict{Answer: "10", AgeAppropriate: true}))
+
+	cfg := DefaultConfig()
+	cfg.Validators = append(cfg.Validators, NewJudge(judge))
+	_, err := New(gen, cfg).Generate(context.Background(), GenerateInput{Skill: testSkill(), Tier: skillgraph.TierLearn})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Validator != "judge" {
+		t.Fatalf("Generate error = %v, want the judge's rejection", err)
+	}
+}
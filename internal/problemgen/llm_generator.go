@@ -3,7 +3,9 @@ package problemgen
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/abhisek/mathiz/internal/llm"
 )
@@ -26,15 +28,30 @@ type questionOutput struct {
 	Answer       string   `json:"answer"`
 	AnswerType   string   `json:"answer_type"`
 	Choices      []string `json:"choices"`
+	Diagram      *Diagram `json:"diagram"`
 	Hint         string   `json:"hint"`
 	Difficulty   int      `json:"difficulty"`
 	Explanation  string   `json:"explanation"`
 }
 
-// Generate produces a single question for the given input context.
+// Generate produces a single question for the given input context. A
+// question that misses the input's TargetDifficulty is asked for again,
+// up to Config.DifficultyRetries times.
 func (g *LLMGenerator) Generate(ctx context.Context, input GenerateInput) (*Question, error) {
 	ctx = llm.WithPurpose(ctx, llm.PurposeQuestionGen)
 
+	for attempt := 0; ; attempt++ {
+		q, err := g.generate(ctx, input)
+		var verr *ValidationError
+		if attempt < g.config.DifficultyRetries && errors.As(err, &verr) && verr.Validator == (&DifficultyValidator{}).Name() {
+			continue
+		}
+		return q, err
+	}
+}
+
+// generate makes one LLM call and validates the result.
+func (g *LLMGenerator) generate(ctx context.Context, input GenerateInput) (*Question, error) {
 	userMsg := buildUserMessage(input, g.config)
 
 	req := llm.Request{
@@ -57,29 +74,115 @@ func (g *LLMGenerator) Generate(ctx context.Context, input GenerateInput) (*Ques
 		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
 	}
 
+	q := raw.question(input)
+	q.LLMRequestID = resp.RequestID
+
+	// Run validators in order.
+	if verr := RunValidators(ctx, g.config.Validators, q, input); verr != nil {
+		return nil, verr
+	}
+
+	// After validation so the checker sees exactly what the LLM claimed;
+	// see ShuffleChoices for why this is mandatory.
+	q.ShuffleChoices()
+
+	return q, nil
+}
+
+// GenerateBatch produces n questions from one LLM call. Each question is
+// validated on its own; the ones that fail, or repeat another, are asked
+// for again in a smaller follow-up call, up to Config.BatchRetries times.
+func (g *LLMGenerator) GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	ctx = llm.WithPurpose(ctx, llm.PurposeQuestionGen)
+
+	var qs []*Question
+	var lastErr error
+	for attempt := 0; len(qs) < n && attempt <= g.config.BatchRetries; attempt++ {
+		got, err := g.generateBatch(ctx, withAsked(input, qs...), n-len(qs))
+		qs = append(qs, got...)
+		if err == nil {
+			continue
+		}
+		lastErr = err
+		// Only validation failures are worth a follow-up; a failed call
+		// would fail the same way again.
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			break
+		}
+	}
+	if len(qs) == 0 {
+		if lastErr == nil {
+			lastErr = errors.New("LLM returned no questions")
+		}
+		return nil, lastErr
+	}
+	return qs, nil
+}
+
+// generateBatch makes one LLM call for n questions and returns the ones
+// that pass validation, with the last validation failure if any failed.
+func (g *LLMGenerator) generateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	req := llm.Request{
+		System: batchSystemPrompt,
+		Messages: []llm.Message{
+			{Role: llm.RoleUser, Content: buildBatchMessage(input, g.config, n)},
+		},
+		Schema:      QuestionBatchSchema,
+		MaxTokens:   g.config.MaxTokens * n,
+		Temperature: g.config.Temperature,
+	}
+
+	resp, err := g.provider.Generate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("LLM generation failed: %w", err)
+	}
+
+	var raw struct {
+		Questions []questionOutput `json:"questions"`
+	}
+	if err := json.Unmarshal(resp.Content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+	}
+
+	var qs []*Question
+	var lastErr error
+	for _, r := range raw.Questions {
+		if len(qs) == n {
+			break
+		}
+		q := r.question(input)
+		q.LLMRequestID = resp.RequestID
+		if slices.Contains(input.PriorQuestions, q.Text) || slices.ContainsFunc(qs, func(p *Question) bool { return p.Text == q.Text }) {
+			continue
+		}
+		if verr := RunValidators(ctx, g.config.Validators, q, input); verr != nil {
+			lastErr = verr
+			continue
+		}
+		q.ShuffleChoices()
+		qs = append(qs, q)
+	}
+	return qs, lastErr
+}
+
+// question converts the raw output into a normalized Question for input.
+func (raw *questionOutput) question(input GenerateInput) *Question {
 	q := &Question{
 		Text:        raw.QuestionText,
 		Format:      AnswerFormat(raw.Format),
 		Answer:      raw.Answer,
 		AnswerType:  AnswerType(raw.AnswerType),
 		Choices:     raw.Choices,
+		Diagram:     raw.Diagram,
 		Hint:        raw.Hint,
 		Difficulty:  raw.Difficulty,
 		Explanation: raw.Explanation,
 		SkillID:     input.Skill.ID,
 		Tier:        input.Tier,
+		Source:      SourceLLM,
+		Locale:      input.Locale,
 	}
-
-	// Run validators in order.
-	for _, v := range g.config.Validators {
-		if verr := v.Validate(q, input); verr != nil {
-			return nil, verr
-		}
-	}
-
-	// After validation so the checker sees exactly what the LLM claimed;
-	// see ShuffleChoices for why this is mandatory.
-	q.ShuffleChoices()
-
-	return q, nil
+	q.Normalize()
+	return q
 }
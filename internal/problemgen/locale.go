@@ -0,0 +1,62 @@
+package problemgen
+
+import (
+	"strings"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+)
+
+// DisplayChoice returns choice c as the learner sees it in the question's
+// locale: decimal commas where the locale uses them, and translated
+// True/False labels. Graders accept either form.
+func (q *Question) DisplayChoice(c string) string {
+	if q.Format == FormatTrueFalse {
+		return q.Locale.T(c)
+	}
+	return q.Locale.FormatNumbers(c)
+}
+
+// DisplayChoices returns every choice as the learner sees it.
+func (q *Question) DisplayChoices() []string {
+	out := make([]string, len(q.Choices))
+	for i, c := range q.Choices {
+		out[i] = q.DisplayChoice(c)
+	}
+	return out
+}
+
+// DisplayAnswer returns the correct answer as the learner sees it.
+func (q *Question) DisplayAnswer() string {
+	return q.DisplayChoice(q.Answer)
+}
+
+// delocalize turns an answer given in the question's locale back into the
+// form Answer and Choices use: displayed choices map to the choice they
+// show, and typed decimal commas become points.
+func (q *Question) delocalize(learner string) string {
+	if q.Locale == "" || q.Locale == i18n.English {
+		return learner
+	}
+	switch q.Format {
+	case FormatMultipleChoice, FormatTrueFalse:
+		return q.choiceFor(learner)
+	case FormatOrdering, FormatMultiSelect:
+		parts := SplitAnswer(learner)
+		for i, p := range parts {
+			parts[i] = q.choiceFor(p)
+		}
+		return JoinAnswer(parts)
+	}
+	return q.Locale.ParseNumbers(learner)
+}
+
+// choiceFor returns the choice displayed as s, or s itself when no choice
+// is, such as a choice number.
+func (q *Question) choiceFor(s string) string {
+	for _, c := range q.Choices {
+		if strings.EqualFold(s, q.DisplayChoice(c)) {
+			return c
+		}
+	}
+	return s
+}
@@ -1,197 +1,624 @@
 package problemgen
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
 	"regexp"
-	"strconv"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 )
 
-// MathCheckValidator attempts to independently recompute the answer from
-// the question text. Covers ~70% of MVP skills (pure arithmetic and
-// fraction operations). Non-computable questions pass through silently.
-type MathCheckValidator struct{}
+// MathCheckValidator independently recomputes the answer from the question
+// text with a small expression engine (expr.go) and rejects questions whose
+// answer disagrees. It covers arithmetic with any number of operands and
+// parentheses, fractions, mixed numbers, percentages, missing-number
+// equations ("__ + 7 = 15", "3x = 12"), comparisons, rounding, metric
+// and time unit conversions, and the named operations the seeded skills
+// ask about (mean, LCM, place value, area and so on). Other questions pass
+// through unchecked.
+//
+// The validator counts checked, failed and unchecked questions per skill;
+// Coverage reports them so unchecked skills stand out. The zero value is
+// ready to use and safe for concurrent use.
+type MathCheckValidator struct {
+	mu       sync.Mutex
+	coverage map[string]*SkillCoverage
+}
+
+// SkillCoverage counts MathCheckValidator outcomes for one skill.
+type SkillCoverage struct {
+	SkillID   string
+	Checked   int // recomputed and matched
+	Failed    int // recomputed and rejected
+	Unchecked int // not computable from the text
+}
 
 func (v *MathCheckValidator) Name() string { return "math-check" }
 
-func (v *MathCheckValidator) Validate(q *Question, _ GenerateInput) *ValidationError {
-	computed, err := computeAnswer(q.Text, q.AnswerType)
+func (v *MathCheckValidator) Validate(q *Question, input GenerateInput) *ValidationError {
+	skillID := q.SkillID
+	if skillID == "" {
+		skillID = input.Skill.ID
+	}
+
+	if q.Locale.DecimalComma() {
+		// Read "3,5" in the text as 3.5, not as a thousands separator.
+		local := *q
+		local.Text = q.Locale.ParseNumbers(q.Text)
+		q = &local
+	}
+	res, err := computeAnswer(q)
 	if err != nil {
-		// Question is not computable (word problem, comparison, etc.)
-		// This is fine — pass through silently.
+		v.record(skillID, func(c *SkillCoverage) { c.Unchecked++ })
+		return nil
+	}
+	match, known := res.check(q.Answer, q.AnswerType)
+	if !known {
+		v.record(skillID, func(c *SkillCoverage) { c.Unchecked++ })
 		return nil
 	}
-	if !answersEqual(computed, q.Answer, q.AnswerType) {
+	if !match {
+		v.record(skillID, func(c *SkillCoverage) { c.Failed++ })
 		return &ValidationError{
 			Validator: v.Name(),
-			Message:   fmt.Sprintf("computed %q but LLM claimed %q", computed, q.Answer),
+			Message:   fmt.Sprintf("computed %q but LLM claimed %q", res, q.Answer),
 			Retryable: true,
 		}
 	}
+	v.record(skillID, func(c *SkillCoverage) { c.Checked++ })
 	return nil
 }
 
-// Regex patterns for extracting arithmetic expressions from question text.
-var (
-	// Fraction arithmetic: "a/b + c/d", "a/b - c/d", "a/b * c/d", "a/b ÷ c/d"
-	fractionArithRe = regexp.MustCompile(`(-?\d+)\s*/\s*(\d+)\s*([+\-*×÷])\s*(-?\d+)\s*/\s*(\d+)`)
+func (v *MathCheckValidator) record(skillID string, f func(*SkillCoverage)) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.coverage == nil {
+		v.coverage = make(map[string]*SkillCoverage)
+	}
+	c := v.coverage[skillID]
+	if c == nil {
+		c = &SkillCoverage{SkillID: skillID}
+		v.coverage[skillID] = c
+	}
+	f(c)
+}
 
-	// Integer/decimal arithmetic with +, -, *, ×
-	intArithRe = regexp.MustCompile(`(?:^|[^\d/])(-?\d+(?:\.\d+)?)\s*([+\-*×])\s*(-?\d+(?:\.\d+)?)(?:[^\d/]|$)`)
+// Coverage returns the outcome counts for every skill validated so far,
+// sorted by skill ID.
+func (v *MathCheckValidator) Coverage() []SkillCoverage {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	out := make([]SkillCoverage, 0, len(v.coverage))
+	for _, c := range v.coverage {
+		out = append(out, *c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].SkillID < out[j].SkillID })
+	return out
+}
 
-	// Division requires spaces around the operator to distinguish from fractions (3/4 vs 144 / 12).
-	intDivRe = regexp.MustCompile(`(-?\d+(?:\.\d+)?)\s+[/÷]\s+(-?\d+(?:\.\d+)?)`)
-)
+// errNotComputable means the question's answer can't be derived from its
+// text.
+var errNotComputable = errors.New("not computable")
 
-// computeAnswer attempts to extract and compute the answer from question text.
-// Returns the computed answer as a string, or an error if not computable.
-func computeAnswer(text string, answerType AnswerType) (string, error) {
-	// Try fraction arithmetic first.
-	if answerType == AnswerTypeFraction || answerType == AnswerTypeInteger {
-		if result, err := tryFractionArith(text); err == nil {
-			return result, nil
-		}
+// mathResult is a recomputed answer: a number, a relation symbol for
+// comparison blanks, one of two compared operands, a true/false verdict or
+// an ordering.
+type mathResult struct {
+	value  *big.Rat
+	symbol string   // "<", ">" or "="
+	choice string   // text of the chosen operand (value is its value), or "True"/"False"
+	order  []string // ordering: the choices in order
+}
+
+func (r mathResult) String() string {
+	switch {
+	case r.symbol != "":
+		return r.symbol
+	case r.choice != "":
+		return r.choice
+	case r.order != nil:
+		return JoinAnswer(r.order)
+	default:
+		return formatRat(r.value)
 	}
+}
 
-	// Try integer/decimal arithmetic.
-	if answerType == AnswerTypeInteger || answerType == AnswerTypeDecimal {
-		if result, err := tryIntArith(text, answerType); err == nil {
-			return result, nil
+// check compares the result with a claimed answer. known is false when the
+// answer's form can't be compared (e.g. "7 apples").
+func (r mathResult) check(answer string, answerType AnswerType) (match, known bool) {
+	answer = strings.TrimSpace(answer)
+	if r.symbol != "" {
+		sym, ok := relationSymbol(answer)
+		return sym == r.symbol, ok
+	}
+	if r.order != nil {
+		return slices.EqualFunc(SplitAnswer(answer), r.order, strings.EqualFold), true
+	}
+	if r.choice != "" && strings.EqualFold(answer, r.choice) {
+		return true, true
+	}
+	if r.value == nil { // true/false
+		return false, true
+	}
+	claimed, ok := answerValue(answer, answerType)
+	if !ok {
+		return false, false
+	}
+	if claimed.Cmp(r.value) == 0 {
+		return true, true
+	}
+	// A recurring decimal can only be given rounded: accept the claimed
+	// answer if it is the exact value rounded to its own decimal places.
+	if answerType == AnswerTypeDecimal && !terminates(r.value) {
+		if i := strings.IndexByte(answer, '.'); i >= 0 {
+			unit := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(answer)-i-1)), nil))
+			return roundToUnit(r.value, unit).Cmp(claimed) == 0, true
 		}
 	}
-
-	return "", fmt.Errorf("not computable")
+	return false, true
 }
 
-// tryFractionArith tries to extract and compute fraction arithmetic.
-func tryFractionArith(text string) (string, error) {
-	matches := fractionArithRe.FindStringSubmatch(text)
-	if matches == nil {
-		return "", fmt.Errorf("no fraction expression found")
+// answerValue reads the number an answer states. Answers with a unit
+// ("45 min", "$4.75", "475 cents") give the number of the single unit they
+// are written in; compound answers ("3 ft 4 in") and times of day give none.
+func answerValue(answer string, answerType AnswerType) (*big.Rat, bool) {
+	answer = stripCurrency(answer)
+	if v, ok := parseNumber(answer); ok {
+		return v, true
+	}
+	if !answerType.hasUnits() {
+		return nil, false
+	}
+	qs, err := parseQuantities(answer)
+	if err != nil || len(qs) != 1 {
+		return nil, false
 	}
+	return qs[0].value, true
+}
 
-	aN, _ := strconv.ParseInt(matches[1], 10, 64)
-	aD, _ := strconv.ParseInt(matches[2], 10, 64)
-	op := normalizeOp(matches[3])
-	bN, _ := strconv.ParseInt(matches[4], 10, 64)
-	bD, _ := strconv.ParseInt(matches[5], 10, 64)
+func relationSymbol(s string) (string, bool) {
+	switch strings.ToLower(s) {
+	case "<", "less than", "is less than":
+		return "<", true
+	case ">", "greater than", "is greater than":
+		return ">", true
+	case "=", "equal", "equal to", "is equal to", "equals":
+		return "=", true
+	}
+	return "", false
+}
 
-	if aD == 0 || bD == 0 {
-		return "", fmt.Errorf("zero denominator")
+// computeAnswer derives the answer from the question text, trying each
+// question shape in turn.
+func computeAnswer(q *Question) (*mathResult, error) {
+	lower := strings.ToLower(q.Text)
+	// Quotient-and-remainder answers ("7 R 2") aren't single numbers.
+	if strings.Contains(lower, "remainder") {
+		return nil, errNotComputable
+	}
+	switch q.Format {
+	case FormatOrdering:
+		return orderingAnswer(q, lower)
+	case FormatTrueFalse:
+		return statementAnswer(q.Text)
+	case FormatMultiSelect, FormatMultiBlank:
+		return nil, errNotComputable
+	}
+	if r, ok := roundingAnswer(lower); ok {
+		return r, nil
+	}
+	if r, ok := conversionAnswer(lower); ok {
+		return r, nil
+	}
+	if r, ok := comparisonAnswer(q); ok {
+		return r, nil
 	}
+	if r, ok := namedAnswer(lower); ok {
+		return r, nil
+	}
+	return expressionAnswer(q.Text)
+}
 
-	var rN, rD int64
-	switch op {
-	case "+":
-		rN = aN*bD + bN*aD
-		rD = aD * bD
-	case "-":
-		rN = aN*bD - bN*aD
-		rD = aD * bD
-	case "*":
-		rN = aN * bN
-		rD = aD * bD
-	case "/":
-		if bN == 0 {
-			return "", fmt.Errorf("division by zero")
+// expressionAnswer evaluates the one expression or equation in text. Text
+// with none, several, or a statement to judge ("Is 3 + 4 = 7?") is not
+// computable.
+func expressionAnswer(text string) (*mathResult, error) {
+	var found *parsedExpr
+	for _, run := range exprRuns(tokenize(text)) {
+		e, err := parseRun(run)
+		if err != nil {
+			if errors.Is(err, errDivZero) {
+				return nil, errNotComputable
+			}
+			continue
+		}
+		switch e.kind {
+		case exprLiteral:
+			continue
+		case exprStmt:
+			return nil, errNotComputable
+		}
+		if found != nil {
+			return nil, errNotComputable // ambiguous
 		}
-		rN = aN * bD
-		rD = aD * bN
+		found = e
+	}
+	if found == nil {
+		return nil, errNotComputable
+	}
+	if found.kind == exprCompare {
+		return &mathResult{symbol: [...]string{"<", "=", ">"}[found.cmp+1]}, nil
+	}
+	return &mathResult{value: found.value}, nil
+}
+
+// orderingAnswer sorts the choices of an ordering question by value, in the
+// direction the text asks for.
+func orderingAnswer(q *Question, lower string) (*mathResult, error) {
+	var desc bool
+	switch {
+	case strings.Contains(lower, "least to greatest"), strings.Contains(lower, "smallest to largest"),
+		strings.Contains(lower, "smallest to biggest"), strings.Contains(lower, "ascending"):
+	case strings.Contains(lower, "greatest to least"), strings.Contains(lower, "largest to smallest"),
+		strings.Contains(lower, "biggest to smallest"), strings.Contains(lower, "descending"):
+		desc = true
 	default:
-		return "", fmt.Errorf("unsupported operator: %s", op)
+		return nil, errNotComputable
+	}
+	type item struct {
+		text  string
+		value *big.Rat
+	}
+	items := make([]item, len(q.Choices))
+	for i, c := range q.Choices {
+		v, ok := operandValue(c)
+		if !ok {
+			return nil, errNotComputable
+		}
+		items[i] = item{strings.TrimSpace(c), v}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if desc {
+			return items[i].value.Cmp(items[j].value) > 0
+		}
+		return items[i].value.Cmp(items[j].value) < 0
+	})
+	order := make([]string, len(items))
+	for i, it := range items {
+		if i > 0 && it.value.Cmp(items[i-1].value) == 0 {
+			return nil, errNotComputable // ties have no single order
+		}
+		order[i] = it.text
 	}
+	return &mathResult{order: order}, nil
+}
 
-	// Normalize sign.
-	if rD < 0 {
-		rN = -rN
-		rD = -rD
+// statementAnswer judges a true/false question holding exactly one
+// statement ("True or false: 3/4 > 2/3").
+func statementAnswer(text string) (*mathResult, error) {
+	var found *parsedExpr
+	for _, run := range exprRuns(tokenize(text)) {
+		e, err := parseRun(run)
+		if err != nil || e.kind == exprLiteral {
+			continue
+		}
+		if e.kind != exprStmt || found != nil {
+			return nil, errNotComputable
+		}
+		found = e
 	}
+	if found == nil {
+		return nil, errNotComputable
+	}
+	if found.holds {
+		return &mathResult{choice: TrueFalseChoices[0]}, nil
+	}
+	return &mathResult{choice: TrueFalseChoices[1]}, nil
+}
 
-	// Reduce.
-	g := gcd(abs(rN), rD)
-	rN /= g
-	rD /= g
+// Rounding: "Round 3,456 to the nearest hundred."
+var roundingRe = regexp.MustCompile(`round(?:ed)?\s+(-?\d[\d,]*(?:\.\d+)?)\s+to\s+the\s+nearest\s+(ten thousand|hundred thousand|whole number|thousandth|hundredth|thousand|hundred|tenth|whole|one|ten)\b`)
 
-	// If denominator is 1, return as integer.
-	if rD == 1 {
-		return strconv.FormatInt(rN, 10), nil
+var roundingUnits = map[string]*big.Rat{
+	"thousandth":       big.NewRat(1, 1000),
+	"hundredth":        big.NewRat(1, 100),
+	"tenth":            big.NewRat(1, 10),
+	"one":              big.NewRat(1, 1),
+	"whole":            big.NewRat(1, 1),
+	"whole number":     big.NewRat(1, 1),
+	"ten":              big.NewRat(10, 1),
+	"hundred":          big.NewRat(100, 1),
+	"thousand":         big.NewRat(1000, 1),
+	"ten thousand":     big.NewRat(10000, 1),
+	"hundred thousand": big.NewRat(100000, 1),
+}
+
+func roundingAnswer(lower string) (*mathResult, bool) {
+	m := roundingRe.FindStringSubmatch(lower)
+	if m == nil {
+		return nil, false
 	}
+	v, ok := parseNumber(m[1])
+	if !ok {
+		return nil, false
+	}
+	return &mathResult{value: roundToUnit(v, roundingUnits[m[2]])}, true
+}
 
-	return fmt.Sprintf("%d/%d", rN, rD), nil
+// roundToUnit rounds v to a multiple of unit, halves away from zero.
+func roundToUnit(v, unit *big.Rat) *big.Rat {
+	q := new(big.Rat).Quo(v, unit)
+	q.Abs(q)
+	half := new(big.Rat).Add(q, big.NewRat(1, 2))
+	n := new(big.Int).Quo(half.Num(), half.Denom()) // floor for non-negative
+	r := new(big.Rat).Mul(new(big.Rat).SetInt(n), unit)
+	if v.Sign() < 0 {
+		r.Neg(r)
+	}
+	return r
 }
 
-// tryIntArith tries to extract and compute integer/decimal arithmetic.
-func tryIntArith(text string, answerType AnswerType) (string, error) {
-	// Try +, -, *, × first.
-	matches := intArithRe.FindStringSubmatch(text)
-	if matches != nil {
-		return computeIntOp(matches[1], normalizeOp(matches[2]), matches[3], answerType)
+// Named operations from the seeded skills: counting on and back, the
+// mean, HCF and LCM, equivalent fractions, rectangle, triangle and box measures, unit rates,
+// improper fractions and place value.
+var (
+	countNextRe     = regexp.MustCompile(`what number comes (?:right |just )?(after|before) (\d+)\b`)
+	meanRe          = regexp.MustCompile(`\b(?:mean|average) of ((?:\d[\d.]*(?:, | and |, and ))+\d[\d.]*)`)
+	commonRe        = regexp.MustCompile(`\b(lcm|lowest common multiple|least common multiple|hcf|gcf|gcd|highest common factor|greatest common factor|greatest common divisor)\b(?: \([a-z]+\))? of (\d+) and (\d+)`)
+	improperRe      = regexp.MustCompile(`write (\d+ \d+/\d+) as an improper fraction`)
+	placeValueRe    = regexp.MustCompile(`in (\d[\d,]*), what is the (value|digit) (?:of the digit )?in the (ones|tens|hundreds|thousands|ten thousands|hundred thousands|millions) place`)
+	rectangleRe     = regexp.MustCompile(`rectangle is (\d[\d.]*) [a-z]+ long and (\d[\d.]*) [a-z]+ wide\. what is its (area|perimeter)\b`)
+	triangleRe      = regexp.MustCompile(`triangle has a base of (\d[\d.]*) [a-z]+ and a height of (\d[\d.]*) [a-z]+\. what is its area\b`)
+	equivalentRe    = regexp.MustCompile(`which fraction is equivalent to (\d+/\d+)\?`)
+	boxRe           = regexp.MustCompile(`is (\d[\d.]*) [a-z]+ long, (\d[\d.]*) [a-z]+ wide and (\d[\d.]*) [a-z]+ tall\. what is its volume\b`)
+	unitRateRe      = regexp.MustCompile(`^(\d+) ([a-z]+) cost \$(\d[\d.]*)\. how many dollars does 1 ([a-z]+) cost\?$`)
+	placeMultiplier = map[string]int64{
+		"ones": 1, "tens": 10, "hundreds": 100, "thousands": 1000,
+		"ten thousands": 10000, "hundred thousands": 100000, "millions": 1000000,
 	}
+)
 
-	// Try division (requires spaces around operator to avoid matching fractions).
-	divMatches := intDivRe.FindStringSubmatch(text)
-	if divMatches != nil {
-		return computeIntOp(divMatches[1], "/", divMatches[2], answerType)
+func namedAnswer(lower string) (*mathResult, bool) {
+	if m := countNextRe.FindStringSubmatch(lower); m != nil {
+		n, _ := parseNumber(m[2])
+		step := big.NewRat(1, 1)
+		if m[1] == "before" {
+			step.Neg(step)
+		}
+		return &mathResult{value: n.Add(n, step)}, true
+	}
+	if m := meanRe.FindStringSubmatch(lower); m != nil {
+		sum, count := new(big.Rat), 0
+		for _, f := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || r == ' ' }) {
+			if f == "and" {
+				continue
+			}
+			v, ok := parseNumber(f)
+			if !ok {
+				return nil, false
+			}
+			sum.Add(sum, v)
+			count++
+		}
+		return &mathResult{value: sum.Quo(sum, big.NewRat(int64(count), 1))}, true
+	}
+	if m := commonRe.FindStringSubmatch(lower); m != nil {
+		a, _ := new(big.Int).SetString(m[2], 10)
+		b, _ := new(big.Int).SetString(m[3], 10)
+		if a.Sign() == 0 || b.Sign() == 0 {
+			return nil, false
+		}
+		g := new(big.Int).GCD(nil, nil, a, b)
+		if strings.HasPrefix(m[1], "l") {
+			g = new(big.Int).Quo(new(big.Int).Mul(a, b), g)
+		}
+		return &mathResult{value: new(big.Rat).SetInt(g)}, true
+	}
+	if m := equivalentRe.FindStringSubmatch(lower); m != nil {
+		v, ok := parseNumber(m[1])
+		return &mathResult{value: v}, ok
 	}
+	if m := rectangleRe.FindStringSubmatch(lower); m != nil {
+		l, _ := parseNumber(m[1])
+		w, _ := parseNumber(m[2])
+		if m[3] == "area" {
+			return &mathResult{value: l.Mul(l, w)}, true
+		}
+		l.Add(l, w)
+		return &mathResult{value: l.Mul(l, big.NewRat(2, 1))}, true
+	}
+	if m := triangleRe.FindStringSubmatch(lower); m != nil {
+		b, _ := parseNumber(m[1])
+		h, _ := parseNumber(m[2])
+		b.Mul(b, h)
+		return &mathResult{value: b.Mul(b, big.NewRat(1, 2))}, true
+	}
+	if m := boxRe.FindStringSubmatch(lower); m != nil {
+		v := big.NewRat(1, 1)
+		for _, f := range m[1:] {
+			n, _ := parseNumber(f)
+			v.Mul(v, n)
+		}
+		return &mathResult{value: v}, true
+	}
+	if m := unitRateRe.FindStringSubmatch(lower); m != nil {
+		n, _ := parseNumber(m[1])
+		cost, _ := parseNumber(m[3])
+		if n.Sign() == 0 {
+			return nil, false
+		}
+		return &mathResult{value: cost.Quo(cost, n)}, true
+	}
+	if m := improperRe.FindStringSubmatch(lower); m != nil {
+		v, ok := parseNumber(m[1])
+		return &mathResult{value: v}, ok
+	}
+	if m := placeValueRe.FindStringSubmatch(lower); m != nil {
+		n, _ := new(big.Int).SetString(strings.ReplaceAll(m[1], ",", ""), 10)
+		place := big.NewInt(placeMultiplier[m[3]])
+		digit := new(big.Int).Mod(new(big.Int).Quo(n, place), big.NewInt(10))
+		if m[2] == "value" {
+			digit.Mul(digit, place)
+		}
+		return &mathResult{value: new(big.Rat).SetInt(digit)}, true
+	}
+	return nil, false
+}
 
-	return "", fmt.Errorf("no arithmetic expression found")
+// Unit conversion: metric length, mass and capacity, and time.
+type measureUnit struct {
+	dim    string
+	factor *big.Rat // in the dimension's base unit
 }
 
-// computeIntOp evaluates a binary arithmetic operation on two number strings.
-func computeIntOp(aStr, op, bStr string, answerType AnswerType) (string, error) {
-	a, err := strconv.ParseFloat(aStr, 64)
-	if err != nil {
-		return "", err
+var measureUnits = func() map[string]measureUnit {
+	m := make(map[string]measureUnit)
+	add := func(dim string, num, den int64, names ...string) {
+		for _, n := range names {
+			m[n] = measureUnit{dim: dim, factor: big.NewRat(num, den)}
+		}
 	}
-	b, err := strconv.ParseFloat(bStr, 64)
-	if err != nil {
-		return "", err
-	}
-
-	var result float64
-	switch op {
-	case "+":
-		result = a + b
-	case "-":
-		result = a - b
-	case "*":
-		result = a * b
-	case "/":
-		if b == 0 {
-			return "", fmt.Errorf("division by zero")
-		}
-		result = a / b
-	default:
-		return "", fmt.Errorf("unsupported operator: %s", op)
+	add("length", 1, 1000, "mm", "millimeter", "millimeters", "millimetre", "millimetres")
+	add("length", 1, 100, "cm", "centimeter", "centimeters", "centimetre", "centimetres")
+	add("length", 1, 1, "m", "meter", "meters", "metre", "metres")
+	add("length", 1000, 1, "km", "kilometer", "kilometers", "kilometre", "kilometres")
+	add("mass", 1, 1, "g", "gram", "grams")
+	add("mass", 1000, 1, "kg", "kilogram", "kilograms")
+	add("capacity", 1, 1000, "ml", "milliliter", "milliliters", "millilitre", "millilitres")
+	add("capacity", 1, 1, "l", "liter", "liters", "litre", "litres")
+	add("time", 1, 1, "s", "sec", "secs", "second", "seconds")
+	add("time", 60, 1, "min", "mins", "minute", "minutes")
+	add("time", 3600, 1, "h", "hr", "hrs", "hour", "hours")
+	add("time", 86400, 1, "day", "days")
+	add("time", 604800, 1, "week", "weeks")
+	return m
+}()
+
+const numPattern = `(\d[\d,]*(?:\.\d+)?(?: \d+/\d+)?)`
+
+// conversionRes match (value, from, to) in order.
+var conversionRes = []*regexp.Regexp{
+	// "How many cm are in 3 m?"
+	regexp.MustCompile(`how many ([a-z]+) (?:are |is )?(?:there )?in ` + numPattern + ` ?([a-z]+)\b`),
+	// "Convert 4 km to m", "Convert 4 km into metres"
+	regexp.MustCompile(`convert ` + numPattern + ` ?([a-z]+) (?:in)?to ([a-z]+)\b`),
+	// "3 m = __ cm", "2 hours = ? minutes"
+	regexp.MustCompile(numPattern + ` ?([a-z]+) ?= ?(?:_+|\?|□) ?([a-z]+)\b`),
+	// "2.5 kg is how many grams?"
+	regexp.MustCompile(numPattern + ` ?([a-z]+) (?:is|equals) how many ([a-z]+)\b`),
+}
+
+func conversionAnswer(lower string) (*mathResult, bool) {
+	for i, re := range conversionRes {
+		m := re.FindStringSubmatch(lower)
+		if m == nil {
+			continue
+		}
+		value, from, to := m[1], m[2], m[3]
+		if i == 0 {
+			value, from, to = m[2], m[3], m[1]
+		}
+		fu, ok1 := measureUnits[from]
+		tu, ok2 := measureUnits[to]
+		v, ok3 := parseNumber(value)
+		if !ok1 || !ok2 || !ok3 || fu.dim != tu.dim || from == to {
+			return nil, false
+		}
+		r := new(big.Rat).Mul(v, fu.factor)
+		return &mathResult{value: r.Quo(r, tu.factor)}, true
+	}
+	return nil, false
+}
+
+// Comparisons: "Which is greater: 3/4 or 2/3?", and multiple choice
+// "Which number is the largest?" over numeric choices.
+var (
+	compareOrRe     = regexp.MustCompile(`(?i)which (?:[a-z]+ )?is (?:the )?(greater|larger|bigger|more|greatest|largest|biggest|smaller|less|lesser|smallest|least)\b\s*[:,]?\s*(.+?),?\s+or\s+(.+?)\s*\?`)
+	compareChoiceRe = regexp.MustCompile(`(?i)which (?:[a-z]+ )?(?:is|has) (?:the )?(greatest|largest|biggest|smallest|least)\b`)
+)
+
+func comparisonAnswer(q *Question) (*mathResult, bool) {
+	var sides []string
+	var word string
+	if m := compareOrRe.FindStringSubmatch(q.Text); m != nil {
+		word, sides = strings.ToLower(m[1]), []string{m[2], m[3]}
+	} else if m := compareChoiceRe.FindStringSubmatch(q.Text); m != nil && q.Format == FormatMultipleChoice {
+		word, sides = strings.ToLower(m[1]), q.Choices
+	} else {
+		return nil, false
 	}
+	wantMax := !strings.Contains("smaller less lesser smallest least", word)
 
-	if answerType == AnswerTypeInteger {
-		return strconv.FormatInt(int64(result), 10), nil
+	var best *mathResult
+	tie := false
+	for _, s := range sides {
+		v, ok := operandValue(s)
+		if !ok {
+			return nil, false
+		}
+		if best == nil {
+			best = &mathResult{value: v, choice: strings.TrimSpace(s)}
+			continue
+		}
+		c := v.Cmp(best.value)
+		if c == 0 {
+			tie = true
+		} else if (c > 0) == wantMax {
+			best, tie = &mathResult{value: v, choice: strings.TrimSpace(s)}, false
+		}
+	}
+	if best == nil || tie {
+		return nil, false
 	}
-	return strconv.FormatFloat(result, 'f', -1, 64), nil
+	return best, true
 }
 
-// normalizeOp normalizes multiplication and division symbols.
-func normalizeOp(op string) string {
-	switch op {
-	case "×":
-		return "*"
-	case "÷":
-		return "/"
-	default:
-		return op
+// operandValue evaluates s as a single number or expression.
+func operandValue(s string) (*big.Rat, bool) {
+	runs := exprRuns(tokenize(stripCurrency(s)))
+	if len(runs) != 1 {
+		return nil, false
+	}
+	e, err := parseRun(runs[0])
+	if err != nil || (e.kind != exprValue && e.kind != exprLiteral) {
+		return nil, false
 	}
+	return e.value, true
 }
 
-// answersEqual compares two answer strings for equality, with normalization.
-func answersEqual(a, b string, answerType AnswerType) bool {
-	na, err := normalizeAnswer(a, answerType)
-	if err != nil {
-		return strings.TrimSpace(a) == strings.TrimSpace(b)
+func stripCurrency(s string) string {
+	return strings.NewReplacer("$", "", "€", "", "£", "", "₹", "").Replace(s)
+}
+
+// terminates reports whether v has a finite decimal expansion.
+func terminates(v *big.Rat) bool {
+	d := new(big.Int).Set(v.Denom())
+	for _, p := range []int64{2, 5} {
+		bp := big.NewInt(p)
+		m := new(big.Int)
+		for {
+			q, r := new(big.Int).QuoRem(d, bp, m)
+			if r.Sign() != 0 {
+				break
+			}
+			d = q
+		}
 	}
-	nb, err := normalizeAnswer(b, answerType)
-	if err != nil {
-		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	return d.Cmp(big.NewInt(1)) == 0
+}
+
+// formatRat renders v as an integer, a terminating decimal, or a fraction.
+func formatRat(v *big.Rat) string {
+	switch {
+	case v.IsInt():
+		return v.Num().String()
+	case terminates(v):
+		s := v.FloatString(20)
+		return strings.TrimRight(s, "0")
+	default:
+		return v.RatString()
 	}
-	return na == nb
 }
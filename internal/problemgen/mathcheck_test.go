@@ -1,6 +1,12 @@
 package problemgen
 
-import "testing"
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
 
 func TestMathCheck_Addition(t *testing.T) {
 	v := &MathCheckValidator{}
@@ -111,7 +117,6 @@ func TestMathCheck_NonComputable(t *testing.T) {
 	v := &MathCheckValidator{}
 
 	texts := []string{
-		"Which fraction is larger: 3/4 or 2/3?",
 		"A farmer has 345 apples and gives away 123. How many are left?",
 		"What place value does 5 have in 5,432?",
 	}
@@ -146,3 +151,210 @@ func TestMathCheck_LargeNumbers(t *testing.T) {
 		}
 	}
 }
+
+func TestMathCheck_Shapes(t *testing.T) {
+	tests := []struct {
+		text       string
+		answer     string
+		answerType AnswerType
+	}{
+		// Multiple operands, precedence and parentheses.
+		{"What is 12 + 8 - 5?", "15", AnswerTypeInteger},
+		{"What is 2 + 3 × 4?", "14", AnswerTypeInteger},
+		{"What is (2 + 3) × 4?", "20", AnswerTypeInteger},
+		{"Calculate 3 x 7 + 1.", "22", AnswerTypeInteger},
+		{"What is 7 times 8?", "56", AnswerTypeInteger},
+		{"What is 45 divided by 9?", "5", AnswerTypeInteger},
+		{"What is 1,250 + 2,750?", "4000", AnswerTypeInteger},
+		{"What is 0.1 + 0.2?", "0.3", AnswerTypeDecimal},
+		{"What is $3.50 + $1.25?", "4.75", AnswerTypeDecimal},
+		{"What is 10 ÷ 3? Round to 2 decimal places.", "3.33", AnswerTypeDecimal},
+		// Fractions, mixed numbers, percentages.
+		{"What is 1 1/2 + 2 1/4?", "15/4", AnswerTypeFraction},
+		{"What is 3/4 of 20?", "15", AnswerTypeInteger},
+		{"What is 25% of 80?", "20", AnswerTypeInteger},
+		// Missing numbers and one-step equations.
+		{"Fill in the blank: __ + 7 = 15", "8", AnswerTypeInteger},
+		{"567 - 289 = ?", "278", AnswerTypeInteger},
+		{"What number goes in the box? 6 × □ = 42", "7", AnswerTypeInteger},
+		{"Solve for x: 3x + 2 = 14", "4", AnswerTypeInteger},
+		{"If n - 5 = 10, what is n?", "15", AnswerTypeInteger},
+		// Rounding.
+		{"Round 3,456 to the nearest hundred.", "3500", AnswerTypeInteger},
+		{"Round 2.45 to the nearest tenth.", "2.5", AnswerTypeDecimal},
+		{"Round 749 to the nearest thousand.", "1000", AnswerTypeInteger},
+		// Unit conversions.
+		{"How many centimeters are in 3 meters?", "300", AnswerTypeInteger},
+		{"Convert 2.5 kg to grams.", "2500", AnswerTypeInteger},
+		{"3 hours = __ minutes", "180", AnswerTypeInteger},
+		{"1500 ml is how many liters?", "1.5", AnswerTypeDecimal},
+		// Comparisons.
+		{"Which fraction is larger: 3/4 or 2/3?", "3/4", AnswerTypeFraction},
+		{"Which is smaller, 0.5 or 0.45?", "0.45", AnswerTypeDecimal},
+		{"Compare using <, > or =: 3/4 __ 2/3", ">", AnswerTypeText},
+		{"Fill in the blank with <, > or =: 45 __ 54", "<", AnswerTypeText},
+		// Named operations.
+		{"What number comes right after 15 when you count?", "16", AnswerTypeInteger},
+		{"What is the mean of 8, 13, 13, 4, 22?", "12", AnswerTypeInteger},
+		{"What is the lowest common multiple (LCM) of 12 and 16?", "48", AnswerTypeInteger},
+		{"What is the HCF of 12 and 16?", "4", AnswerTypeInteger},
+		{"Write 5 1/2 as an improper fraction.", "11/2", AnswerTypeFraction},
+		{"In 217, what is the value of the digit in the tens place?", "10", AnswerTypeInteger},
+		{"Which fraction is equivalent to 4/5?", "8/10", AnswerTypeFraction},
+		{"A rectangle is 17 cm long and 10 cm wide. What is its perimeter in cm?", "54", AnswerTypeInteger},
+		{"A triangle has a base of 20 cm and a height of 12 cm. What is its area in square cm?", "120", AnswerTypeInteger},
+		{"A box is 8 cm long, 5 cm wide and 5 cm tall. What is its volume in cubic cm?", "200", AnswerTypeInteger},
+		{"9 notebooks cost $36. How many dollars does 1 notebook cost?", "4", AnswerTypeInteger},
+	}
+
+	for _, tc := range tests {
+		v := &MathCheckValidator{}
+		q := validQuestion()
+		q.Text = tc.text
+		q.Answer = tc.answer
+		q.AnswerType = tc.answerType
+		if err := v.Validate(q, GenerateInput{}); err != nil {
+			t.Errorf("%q with answer %q should pass: %v", tc.text, tc.answer, err)
+			continue
+		}
+		if c := v.Coverage(); len(c) != 1 || c[0].Checked != 1 {
+			t.Errorf("%q was not checked: %+v", tc.text, c)
+		}
+	}
+}
+
+func TestMathCheck_ShapesWrongAnswer(t *testing.T) {
+	tests := []struct {
+		text   string
+		answer string
+	}{
+		{"What is (2 + 3) × 4?", "14"},
+		{"__ + 7 = 15", "22"},
+		{"Round 3,456 to the nearest hundred.", "3400"},
+		{"How many centimeters are in 3 meters?", "30"},
+		{"Which is greater: 3/4 or 2/3?", "2/3"},
+		{"Compare using <, > or =: 3/4 __ 2/3", "<"},
+		{"What is 10 ÷ 3? Round to 2 decimal places.", "3.34"},
+		{"What is the mean of 8, 13, 13, 4, 22?", "13"},
+		{"What is the HCF of 12 and 16?", "48"},
+		{"In 217, what is the value of the digit in the tens place?", "1"},
+	}
+	for _, tc := range tests {
+		q := validQuestion()
+		q.Text = tc.text
+		q.Answer = tc.answer
+		if err := (&MathCheckValidator{}).Validate(q, GenerateInput{}); err == nil {
+			t.Errorf("%q with answer %q should fail", tc.text, tc.answer)
+		}
+	}
+}
+
+func TestMathCheck_MultipleChoiceComparison(t *testing.T) {
+	q := validQuestion()
+	q.Text = "Which number is the greatest?"
+	q.Format = FormatMultipleChoice
+	q.Choices = []string{"4,099", "4,909", "4,990", "4,009"}
+	q.Answer = "4,990"
+	q.AnswerType = AnswerTypeText
+	if err := (&MathCheckValidator{}).Validate(q, GenerateInput{}); err != nil {
+		t.Errorf("correct choice rejected: %v", err)
+	}
+	q.Answer = "4,909"
+	if err := (&MathCheckValidator{}).Validate(q, GenerateInput{}); err == nil {
+		t.Error("wrong choice accepted")
+	}
+}
+
+func TestMathCheck_Ambiguous(t *testing.T) {
+	texts := []string{
+		"Is 3 + 4 = 7 true or false?",              // a statement to judge
+		"Which is bigger: 3 + 4 or 2 + 5?",         // a tie
+		"What is 3 + 4? Then what is 5 + 6?",       // two expressions
+		"What is 17 ÷ 5? Give the remainder too.",  // quotient and remainder
+		"A 3-digit number has 4 tens. What is it?", // a hyphen, not a minus
+		"What is 7 ÷ 0?",
+	}
+	for _, text := range texts {
+		q := validQuestion()
+		q.Text = text
+		v := &MathCheckValidator{}
+		if err := v.Validate(q, GenerateInput{}); err != nil {
+			t.Errorf("%q should pass unchecked: %v", text, err)
+		}
+		if c := v.Coverage(); c[0].Unchecked != 1 {
+			t.Errorf("%q: coverage %+v, want unchecked", text, c)
+		}
+	}
+}
+
+func TestMathCheck_Coverage(t *testing.T) {
+	v := &MathCheckValidator{}
+	q := validQuestion()
+	_ = v.Validate(q, GenerateInput{}) // checked
+	q.Answer = "1"
+	_ = v.Validate(q, GenerateInput{}) // failed
+	q.Text = "What place value does 5 have in 5,432?"
+	q.SkillID = ""
+	_ = v.Validate(q, GenerateInput{Skill: skillgraph.Skill{ID: "place-value"}})
+
+	got := v.Coverage()
+	want := []SkillCoverage{
+		{SkillID: "add-3digit", Checked: 1, Failed: 1},
+		{SkillID: "place-value", Unchecked: 1},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("coverage = %+v, want %+v", got, want)
+	}
+}
+
+// Offline templates compute their own answers, so the math check must never
+// reject one.
+func TestMathCheck_AgreesWithOfflineTemplates(t *testing.T) {
+	gen := NewOffline(7)
+	v := &MathCheckValidator{}
+	for id := range offlineTemplates {
+		skill, err := skillgraph.GetSkill(id)
+		if err != nil {
+			continue
+		}
+		for range 50 {
+			input := GenerateInput{Skill: skill, Tier: skillgraph.TierLearn}
+			q, err := gen.Generate(context.Background(), input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verr := v.Validate(q, input); verr != nil {
+				t.Errorf("%s: %q (answer %q): %v", id, q.Text, q.Answer, verr)
+			}
+		}
+	}
+}
+
+func TestMathCheck_OrderingAndTrueFalse(t *testing.T) {
+	tests := []struct {
+		text    string
+		format  AnswerFormat
+		answer  string
+		choices []string
+		want    bool
+	}{
+		{"Order these numbers from least to greatest.", FormatOrdering, "98; 189; 201", []string{"201", "98", "189"}, true},
+		{"Order these numbers from least to greatest.", FormatOrdering, "98; 201; 189", []string{"201", "98", "189"}, false},
+		{"Put these fractions in order from greatest to least.", FormatOrdering, "3/4; 2/3; 1/2", []string{"1/2", "3/4", "2/3"}, true},
+		{"True or false: 3/4 > 2/3", FormatTrueFalse, "True", TrueFalseChoices, true},
+		{"True or false: 3/4 > 2/3", FormatTrueFalse, "False", TrueFalseChoices, false},
+		{"True or false: 12 × 3 = 36", FormatTrueFalse, "True", TrueFalseChoices, true},
+	}
+	for _, tc := range tests {
+		v := &MathCheckValidator{}
+		q := validQuestion()
+		q.Text, q.Format, q.Answer, q.Choices, q.AnswerType = tc.text, tc.format, tc.answer, tc.choices, AnswerTypeText
+		err := v.Validate(q, GenerateInput{})
+		if (err == nil) != tc.want {
+			t.Errorf("%q with answer %q: err = %v, want pass=%v", tc.text, tc.answer, err, tc.want)
+		}
+		if c := v.Coverage(); len(c) != 1 || c[0].Unchecked != 0 {
+			t.Errorf("%q was not checked: %+v", tc.text, c)
+		}
+	}
+}
@@ -0,0 +1,636 @@
+package problemgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// ErrUnsupportedSkill means the offline generator has no template for the
+// requested skill.
+var ErrUnsupportedSkill = errors.New("no offline question template for skill")
+
+// maxOfflineAttempts bounds how often Generate re-rolls a template to avoid
+// repeating one of the input's PriorQuestions.
+const maxOfflineAttempts = 10
+
+// OfflineGenerator implements Generator without an LLM: each computable
+// built-in skill (place value, rounding, multi-digit add/sub, times tables,
+// division, fraction arithmetic, …) has a procedural template that draws
+// numbers from a seeded source and computes the answer, hint and worked
+// explanation itself. It implements SkillLimiter; skills without a template
+// (word problems, geometry, explanations) return ErrUnsupportedSkill.
+//
+// Output is a pure function of the seed and the sequence of calls, so a
+// fixed seed reproduces a session exactly.
+type OfflineGenerator struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewOffline creates an OfflineGenerator seeded with seed.
+func NewOffline(seed uint64) *OfflineGenerator {
+	return &OfflineGenerator{rng: rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))}
+}
+
+// Offline reports true: every question comes from a template.
+func (g *OfflineGenerator) Offline() bool {
+	return true
+}
+
+// Supports reports whether the generator has a template for skill.
+func (g *OfflineGenerator) Supports(skill skillgraph.Skill) bool {
+	_, ok := offlineTemplates[skill.ID]
+	return ok
+}
+
+// GenerateBatch produces n questions one template roll at a time.
+func (g *OfflineGenerator) GenerateBatch(ctx context.Context, input GenerateInput, n int) ([]*Question, error) {
+	return GenerateEach(ctx, g, input, n)
+}
+
+// Generate produces a question for input.Skill from its template. Templates
+// are re-rolled a few times to avoid repeating a prior question; small
+// skills (e.g. counting to 20) may still repeat.
+func (g *OfflineGenerator) Generate(_ context.Context, input GenerateInput) (*Question, error) {
+	tmpl, ok := offlineTemplates[input.Skill.ID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedSkill, input.Skill.ID)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var q *Question
+	for range maxOfflineAttempts {
+		q = tmpl(g.rng)
+		if !slices.Contains(input.PriorQuestions, q.Text) {
+			break
+		}
+	}
+	q.SkillID = input.Skill.ID
+	q.Tier = input.Tier
+	q.Source = SourceOffline
+	q.Locale = input.Locale
+	q.Difficulty = offlineDifficulty(input.Tier)
+
+	// Same reason as ShuffleChoices, but drawn from the seeded source so
+	// the output stays reproducible.
+	if q.Format == FormatMultipleChoice {
+		g.rng.Shuffle(len(q.Choices), func(i, j int) {
+			q.Choices[i], q.Choices[j] = q.Choices[j], q.Choices[i]
+		})
+	}
+	return q, nil
+}
+
+func offlineDifficulty(tier skillgraph.Tier) int {
+	switch tier {
+	case skillgraph.TierProve:
+		return 3
+	case skillgraph.TierChallenge:
+		return 4
+	default:
+		return 2
+	}
+}
+
+// offlineTemplate draws one question. Templates fill Text, Format, Answer,
+// AnswerType, Choices, Hint and Explanation; Generate fills the rest.
+type offlineTemplate func(r *rand.Rand) *Question
+
+// offlineTemplates maps built-in skill IDs to their templates. Custom
+// curricula only get offline questions for skills that reuse these IDs.
+var offlineTemplates = map[string]offlineTemplate{
+	// Number & place value.
+	"count-to-20":      countAfter(0, 19),
+	"count-to-120":     countAfter(20, 119),
+	"pv-tens-ones":     placeValue(10, 99),
+	"pv-hundreds":      placeValue(100, 999),
+	"pv-ten-thousands": placeValue(10000, 99999),
+	"pv-millions":      placeValue(1000000, 9999999),
+	"compare-1000":     greatest(100, 999),
+	"compare-10000":    greatest(1000, 9999),
+	"compare-millions": greatest(100000, 9999999),
+	"round-nearest-10-100": func(r *rand.Rand) *Question {
+		if r.IntN(2) == 0 {
+			return roundTo(between(r, 11, 999), 10, "ten")
+		}
+		return roundTo(between(r, 101, 999), 100, "hundred")
+	},
+	"round-nearest-1000": func(r *rand.Rand) *Question {
+		return roundTo(between(r, 1001, 99999), 1000, "thousand")
+	},
+
+	// Addition & subtraction.
+	"add-sub-within-10": addOrSub(0, 10),
+	"add-sub-within-20": addOrSub(0, 20),
+	"add-2digit":        add(10, 99),
+	"sub-2digit":        sub(10, 99),
+	"add-3digit":        add(100, 999),
+	"sub-3digit":        sub(100, 999),
+	"add-4digit":        add(1000, 9999),
+	"sub-4digit":        sub(1000, 9999),
+	"add-5digit":        add(10000, 99999),
+	"sub-5digit":        sub(10000, 99999),
+	"add-multi-2digit": func(r *rand.Rand) *Question {
+		a, b, c := between(r, 10, 99), between(r, 10, 99), between(r, 10, 99)
+		return intQuestion(
+			fmt.Sprintf("What is %d + %d + %d?", a, b, c), a+b+c,
+			"Add the first two numbers, then add the third.",
+			fmt.Sprintf("%d + %d = %d, and %d + %d = %d.", a, b, a+b, a+b, c, a+b+c))
+	},
+
+	// Multiplication & division.
+	"mult-facts-2-5-10": multFacts(2, 5, 10),
+	"mult-facts-3-4-6":  multFacts(3, 4, 6),
+	"mult-facts-7-8-9":  multFacts(7, 8, 9),
+	"div-facts": func(r *rand.Rand) *Question {
+		return divide(between(r, 2, 10), between(r, 2, 10))
+	},
+	"mult-2d-by-1d": multiply(10, 99, 2, 9),
+	"mult-2d-by-2d": multiply(10, 99, 10, 99),
+	"mult-3d-by-2d": multiply(100, 999, 10, 99),
+	"div-2d-by-1d": func(r *rand.Rand) *Question {
+		d := between(r, 2, 9)
+		return divide(d, between(r, 10/d+1, 99/d))
+	},
+	"div-3d-by-1d": func(r *rand.Rand) *Question {
+		d := between(r, 2, 9)
+		return divide(d, between(r, 100/d+1, 999/d))
+	},
+	"div-4d-by-2d": func(r *rand.Rand) *Question {
+		d := between(r, 11, 99)
+		return divide(d, between(r, 1000/d+1, 9999/d))
+	},
+	"hcf-lcm": func(r *rand.Rand) *Question {
+		f := between(r, 2, 6)
+		a, b := f*between(r, 2, 6), f*between(r, 2, 6)
+		for a == b {
+			b = f * between(r, 2, 6)
+		}
+		h := int(gcd(int64(a), int64(b)))
+		if r.IntN(2) == 0 {
+			return intQuestion(
+				fmt.Sprintf("What is the highest common factor (HCF) of %d and %d?", a, b), h,
+				"List the factors of each number and find the biggest one they share.",
+				fmt.Sprintf("%d is the largest number that divides both %d and %d.", h, a, b))
+		}
+		l := a * b / h
+		return intQuestion(
+			fmt.Sprintf("What is the lowest common multiple (LCM) of %d and %d?", a, b), l,
+			"Count up in multiples of the larger number until you reach one the smaller number divides.",
+			fmt.Sprintf("%d is the smallest number that both %d and %d divide into.", l, a, b))
+	},
+
+	// Fractions.
+	"frac-equivalent": func(r *rand.Rand) *Question {
+		n, d := properFraction(r, 2, 9)
+		k := between(r, 2, 5)
+		answer := fmt.Sprintf("%d/%d", n*k, d*k)
+		// Near misses: adding instead of multiplying, or off by one.
+		var distractors []string
+		for _, c := range []string{
+			fmt.Sprintf("%d/%d", n+k, d+k),
+			fmt.Sprintf("%d/%d", n*k, d*k+1),
+			fmt.Sprintf("%d/%d", n*k+1, d*k),
+			fmt.Sprintf("%d/%d", n*k, d*k-1),
+		} {
+			if len(distractors) < 3 && !slices.Contains(distractors, c) {
+				distractors = append(distractors, c)
+			}
+		}
+		return choiceQuestion(
+			fmt.Sprintf("Which fraction is equivalent to %d/%d?", n, d), answer,
+			distractors,
+			AnswerTypeText,
+			"Multiply the top and bottom by the same number.",
+			fmt.Sprintf("%d/%d × %d/%d = %s.", n, d, k, k, answer))
+	},
+	"frac-compare": func(r *rand.Rand) *Question {
+		// Fractions in lowest terms are equal only when identical.
+		var choices []string
+		var best float64
+		var answer string
+		for len(choices) < 4 {
+			n, d := properFraction(r, 2, 12)
+			s := fmt.Sprintf("%d/%d", n, d)
+			if slices.Contains(choices, s) {
+				continue
+			}
+			v := float64(n) / float64(d)
+			choices = append(choices, s)
+			if v > best {
+				best, answer = v, s
+			}
+		}
+		return choiceQuestion(
+			"Which fraction is the largest?", answer,
+			slices.DeleteFunc(choices, func(c string) bool { return c == answer }),
+			AnswerTypeFraction,
+			"Rewrite the fractions with a common denominator, or compare each one to 1/2.",
+			fmt.Sprintf("%s is the largest: it is closest to one whole.", answer))
+	},
+	"frac-add-same-denom": func(r *rand.Rand) *Question {
+		d := between(r, 3, 12)
+		a, b := between(r, 1, d-1), between(r, 1, d-1)
+		return fracQuestion(
+			fmt.Sprintf("What is %d/%d + %d/%d?", a, d, b, d), a+b, d,
+			"The denominators match, so add the numerators and keep the denominator.",
+			fmt.Sprintf("%d/%d + %d/%d = %d/%d", a, d, b, d, a+b, d))
+	},
+	"frac-sub-same-denom": func(r *rand.Rand) *Question {
+		d := between(r, 3, 12)
+		a := between(r, 2, d-1)
+		b := between(r, 1, a-1)
+		return fracQuestion(
+			fmt.Sprintf("What is %d/%d - %d/%d?", a, d, b, d), a-b, d,
+			"The denominators match, so subtract the numerators and keep the denominator.",
+			fmt.Sprintf("%d/%d - %d/%d = %d/%d", a, d, b, d, a-b, d))
+	},
+	"frac-mixed-numbers": func(r *rand.Rand) *Question {
+		w := between(r, 1, 5)
+		n, d := properFraction(r, 2, 9)
+		return fracQuestion(
+			fmt.Sprintf("Write %d %d/%d as an improper fraction.", w, n, d), w*d+n, d,
+			"Multiply the whole number by the denominator, then add the numerator.",
+			fmt.Sprintf("%d × %d + %d = %d, so %d %d/%d = %d/%d", w, d, n, w*d+n, w, n, d, w*d+n, d))
+	},
+	"frac-add-diff-denom": fracArith("+"),
+	"frac-sub-diff-denom": fracArith("-"),
+	"frac-mult":           fracArith("×"),
+	"frac-div":            fracArith("÷"),
+
+	// Measurement & geometry.
+	"meas-perimeter": func(r *rand.Rand) *Question {
+		l, w := between(r, 3, 20), between(r, 2, 15)
+		return intQuestion(
+			fmt.Sprintf("A rectangle is %d cm long and %d cm wide. What is its perimeter in cm?", l, w), 2*(l+w),
+			"Add all four sides: two lengths and two widths.",
+			fmt.Sprintf("%d + %d + %d + %d = %d cm.", l, w, l, w, 2*(l+w)))
+	},
+	"meas-area-formula": func(r *rand.Rand) *Question {
+		l, w := between(r, 3, 15), between(r, 2, 12)
+		return intQuestion(
+			fmt.Sprintf("A rectangle is %d cm long and %d cm wide. What is its area in square cm?", l, w), l*w,
+			"Area of a rectangle = length × width.",
+			fmt.Sprintf("%d × %d = %d square cm.", l, w, l*w))
+	},
+	"meas-unit-conversion": func(r *rand.Rand) *Question {
+		units := []struct {
+			big, small string
+			factor     int
+		}{
+			{"meters", "centimeters", 100},
+			{"kilometers", "meters", 1000},
+			{"kilograms", "grams", 1000},
+			{"liters", "milliliters", 1000},
+			{"hours", "minutes", 60},
+		}
+		u := units[r.IntN(len(units))]
+		n := between(r, 2, 12)
+		return intQuestion(
+			fmt.Sprintf("How many %s are in %d %s?", u.small, n, u.big), n*u.factor,
+			fmt.Sprintf("There are %d %s in 1 %s.", u.factor, u.small, strings.TrimSuffix(u.big, "s")),
+			fmt.Sprintf("%d × %d = %d %s.", n, u.factor, n*u.factor, u.small))
+	},
+	"geo-area-triangles": func(r *rand.Rand) *Question {
+		b, h := 2*between(r, 2, 10), between(r, 2, 15)
+		return intQuestion(
+			fmt.Sprintf("A triangle has a base of %d cm and a height of %d cm. What is its area in square cm?", b, h), b*h/2,
+			"Area of a triangle = half of base × height.",
+			fmt.Sprintf("%d × %d = %d, and half of %d is %d square cm.", b, h, b*h, b*h, b*h/2))
+	},
+	"geo-volume": func(r *rand.Rand) *Question {
+		l, w, h := between(r, 2, 10), between(r, 2, 10), between(r, 2, 10)
+		return intQuestion(
+			fmt.Sprintf("A box is %d cm long, %d cm wide and %d cm tall. What is its volume in cubic cm?", l, w, h), l*w*h,
+			"Volume = length × width × height.",
+			fmt.Sprintf("%d × %d = %d, and %d × %d = %d cubic cm.", l, w, l*w, l*w, h, l*w*h))
+	},
+
+	// Data, ratios & early algebra.
+	"data-mean-median-mode": func(r *rand.Rand) *Question {
+		nums := make([]int, 5)
+		sum := 0
+		for i := range nums {
+			nums[i] = between(r, 1, 20)
+			sum += nums[i]
+		}
+		// Nudge the last number so the mean is whole.
+		if rem := sum % 5; rem != 0 {
+			nums[4] += 5 - rem
+			sum += 5 - rem
+		}
+		return intQuestion(
+			fmt.Sprintf("What is the mean of %s?", joinInts(nums)), sum/5,
+			"Add the numbers, then divide by how many there are.",
+			fmt.Sprintf("The numbers add up to %d, and %d ÷ 5 = %d.", sum, sum, sum/5))
+	},
+	"alg-missing-number": func(r *rand.Rand) *Question {
+		a, x := between(r, 2, 50), between(r, 2, 50)
+		return intQuestion(
+			fmt.Sprintf("What number goes in the blank? %d + ___ = %d", a, a+x), x,
+			"Think: what do you add to the first number to reach the total?",
+			fmt.Sprintf("%d - %d = %d, so %d + %d = %d.", a+x, a, x, a, x, a+x))
+	},
+	"alg-numerical-expressions": func(r *rand.Rand) *Question {
+		a, b, c := between(r, 2, 20), between(r, 2, 9), between(r, 2, 9)
+		return intQuestion(
+			fmt.Sprintf("What is the value of %d + %d × %d?", a, b, c), a+b*c,
+			"Multiply before you add.",
+			fmt.Sprintf("%d × %d = %d first, then %d + %d = %d.", b, c, b*c, a, b*c, a+b*c))
+	},
+	"alg-one-step-equations": func(r *rand.Rand) *Question {
+		x := between(r, 2, 15)
+		if r.IntN(2) == 0 {
+			a := between(r, 2, 30)
+			return intQuestion(
+				fmt.Sprintf("Solve for x: x + %d = %d", a, x+a), x,
+				"Do the opposite: subtract the same number from both sides.",
+				fmt.Sprintf("x = %d - %d = %d.", x+a, a, x))
+		}
+		a := between(r, 2, 9)
+		return intQuestion(
+			fmt.Sprintf("Solve for x: %dx = %d", a, a*x), x,
+			"Do the opposite: divide both sides by the number next to x.",
+			fmt.Sprintf("x = %d ÷ %d = %d.", a*x, a, x))
+	},
+	"ratio-unit-rate": func(r *rand.Rand) *Question {
+		n, each := between(r, 2, 9), between(r, 2, 12)
+		return intQuestion(
+			fmt.Sprintf("%d notebooks cost $%d. How many dollars does 1 notebook cost?", n, n*each), each,
+			"Divide the total cost by the number of notebooks.",
+			fmt.Sprintf("$%d ÷ %d = $%d each.", n*each, n, each))
+	},
+	"ratio-percent": func(r *rand.Rand) *Question {
+		pcts := []int{10, 20, 25, 50, 75}
+		p := pcts[r.IntN(len(pcts))]
+		n := 20 * between(r, 1, 10)
+		return intQuestion(
+			fmt.Sprintf("What is %d%% of %d?", p, n), n*p/100,
+			fmt.Sprintf("%d%% means %d out of every 100.", p, p),
+			fmt.Sprintf("%d ÷ 100 × %d = %d.", n, p, n*p/100))
+	},
+}
+
+// between returns a uniform integer in [lo, hi].
+func between(r *rand.Rand, lo, hi int) int {
+	if hi <= lo {
+		return lo
+	}
+	return lo + r.IntN(hi-lo+1)
+}
+
+// properFraction returns n/d in lowest terms with 0 < n < d and
+// minDen <= d <= maxDen.
+func properFraction(r *rand.Rand, minDen, maxDen int) (int, int) {
+	for {
+		d := between(r, minDen, maxDen)
+		n := between(r, 1, d-1)
+		if gcd(int64(n), int64(d)) == 1 {
+			return n, d
+		}
+	}
+}
+
+func intQuestion(text string, answer int, hint, explanation string) *Question {
+	return &Question{
+		Text:        text,
+		Format:      FormatNumeric,
+		Answer:      strconv.Itoa(answer),
+		AnswerType:  AnswerTypeInteger,
+		Hint:        hint,
+		Explanation: explanation,
+	}
+}
+
+// fracQuestion builds a numeric question whose answer is n/d in lowest
+// terms, or a whole number when it divides evenly. working is the worked
+// solution ending in n/d; the simplified form is appended when it differs.
+func fracQuestion(text string, n, d int, hint, working string) *Question {
+	g := int(gcd(int64(n), int64(d)))
+	rn, rd := n/g, d/g
+	switch {
+	case rd == 1:
+		return intQuestion(text, rn, hint, fmt.Sprintf("%s = %d.", working, rn))
+	case g > 1:
+		working = fmt.Sprintf("%s = %d/%d", working, rn, rd)
+	}
+	q := intQuestion(text, 0, hint, working+".")
+	q.Answer = fmt.Sprintf("%d/%d", rn, rd)
+	q.AnswerType = AnswerTypeFraction
+	return q
+}
+
+func choiceQuestion(text, answer string, distractors []string, at AnswerType, hint, explanation string) *Question {
+	return &Question{
+		Text:        text,
+		Format:      FormatMultipleChoice,
+		Answer:      answer,
+		AnswerType:  at,
+		Choices:     append([]string{answer}, distractors...),
+		Hint:        hint,
+		Explanation: explanation,
+	}
+}
+
+func countAfter(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		n := between(r, lo, hi)
+		return intQuestion(
+			fmt.Sprintf("What number comes right after %d when you count?", n), n+1,
+			"Count on by one from the number.",
+			fmt.Sprintf("After %d comes %d.", n, n+1))
+	}
+}
+
+var placeNames = []string{"ones", "tens", "hundreds", "thousands", "ten thousands", "hundred thousands", "millions"}
+
+func placeValue(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		n := between(r, lo, hi)
+		digits := len(strconv.Itoa(n))
+		place := between(r, 1, digits-1) // never the ones place: its value is the digit
+		pow := 1
+		for range place {
+			pow *= 10
+		}
+		digit := n / pow % 10
+		for digit == 0 {
+			n += pow
+			digit = n / pow % 10
+		}
+		return intQuestion(
+			fmt.Sprintf("In %d, what is the value of the digit in the %s place?", n, placeNames[place]), digit*pow,
+			fmt.Sprintf("Find the %s digit, then think about how much it is worth.", placeNames[place]),
+			fmt.Sprintf("The %s digit of %d is %d, so its value is %d × %d = %d.", placeNames[place], n, digit, digit, pow, digit*pow))
+	}
+}
+
+func greatest(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		// Distractors share the leading digits so the comparison is real.
+		base := between(r, lo, hi)
+		var nums []int
+		for len(nums) < 4 {
+			n := base + between(r, -base/10, base/10)
+			if n < lo || n > hi || slices.Contains(nums, n) {
+				n = between(r, lo, hi)
+			}
+			if !slices.Contains(nums, n) {
+				nums = append(nums, n)
+			}
+		}
+		answer := slices.Max(nums)
+		var distractors []string
+		for _, n := range nums {
+			if n != answer {
+				distractors = append(distractors, strconv.Itoa(n))
+			}
+		}
+		return choiceQuestion(
+			"Which number is the greatest?", strconv.Itoa(answer), distractors, AnswerTypeInteger,
+			"Compare the digits from the left: the first place where they differ decides.",
+			fmt.Sprintf("%d is the greatest of %s.", answer, joinInts(nums)))
+	}
+}
+
+func roundTo(n, unit int, name string) *Question {
+	down := n / unit * unit
+	up := down + unit
+	answer := down
+	if n-down >= unit/2 {
+		answer = up
+	}
+	return intQuestion(
+		fmt.Sprintf("Round %d to the nearest %s.", n, name), answer,
+		fmt.Sprintf("Is %d closer to %d or to %d? Halfway rounds up.", n, down, up),
+		fmt.Sprintf("%d is between %d and %d and rounds to %d.", n, down, up, answer))
+}
+
+func addOrSub(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		a := between(r, lo, hi)
+		b := between(r, lo, hi-a)
+		if r.IntN(2) == 0 {
+			return intQuestion(
+				fmt.Sprintf("What is %d + %d?", a, b), a+b,
+				"Start at the bigger number and count on.",
+				fmt.Sprintf("%d + %d = %d.", a, b, a+b))
+		}
+		return intQuestion(
+			fmt.Sprintf("What is %d - %d?", a+b, b), a,
+			"Count back, or think of the addition fact that matches.",
+			fmt.Sprintf("%d - %d = %d, because %d + %d = %d.", a+b, b, a, a, b, a+b))
+	}
+}
+
+func add(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		a, b := between(r, lo, hi), between(r, lo, hi)
+		return intQuestion(
+			fmt.Sprintf("What is %d + %d?", a, b), a+b,
+			"Line up the place values and add from the ones, carrying when a column passes 9.",
+			fmt.Sprintf("%d + %d = %d.", a, b, a+b))
+	}
+}
+
+func sub(lo, hi int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		a, b := between(r, lo, hi), between(r, lo, hi)
+		for a == b {
+			b = between(r, lo, hi)
+		}
+		if a < b {
+			a, b = b, a
+		}
+		return intQuestion(
+			fmt.Sprintf("What is %d - %d?", a, b), a-b,
+			"Line up the place values and subtract from the ones, regrouping when you need to.",
+			fmt.Sprintf("%d - %d = %d. Check: %d + %d = %d.", a, b, a-b, a-b, b, a))
+	}
+}
+
+func multFacts(tables ...int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		table := tables[r.IntN(len(tables))]
+		n := between(r, 2, 10)
+		a, b := table, n
+		if r.IntN(2) == 0 {
+			a, b = b, a
+		}
+		return intQuestion(
+			fmt.Sprintf("What is %d × %d?", a, b), a*b,
+			fmt.Sprintf("Skip count by %d, %d times.", table, n),
+			fmt.Sprintf("%d × %d = %d.", a, b, a*b))
+	}
+}
+
+func multiply(loA, hiA, loB, hiB int) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		a, b := between(r, loA, hiA), between(r, loB, hiB)
+		return intQuestion(
+			fmt.Sprintf("What is %d × %d?", a, b), a*b,
+			"Split the numbers into tens and ones, multiply each part, then add.",
+			fmt.Sprintf("%d × %d = %d.", a, b, a*b))
+	}
+}
+
+// divide builds an exact division: (divisor × quotient) ÷ divisor.
+func divide(divisor, quotient int) *Question {
+	n := divisor * quotient
+	return intQuestion(
+		fmt.Sprintf("What is %d ÷ %d?", n, divisor), quotient,
+		fmt.Sprintf("Think: %d times what makes %d?", divisor, n),
+		fmt.Sprintf("%d ÷ %d = %d, because %d × %d = %d.", n, divisor, quotient, divisor, quotient, n))
+}
+
+func fracArith(op string) offlineTemplate {
+	return func(r *rand.Rand) *Question {
+		a, b := properFraction(r, 2, 10)
+		c, d := properFraction(r, 2, 10)
+		for b == d {
+			c, d = properFraction(r, 2, 10)
+		}
+		var n, den int
+		var hint string
+		switch op {
+		case "+":
+			n, den = a*d+c*b, b*d
+			hint = "Rewrite both fractions with a common denominator, then add the numerators."
+		case "-":
+			if a*d < c*b {
+				a, b, c, d = c, d, a, b
+			}
+			n, den = a*d-c*b, b*d
+			hint = "Rewrite both fractions with a common denominator, then subtract the numerators."
+		case "×":
+			n, den = a*c, b*d
+			hint = "Multiply the numerators together and the denominators together."
+		case "÷":
+			n, den = a*d, b*c
+			hint = "Keep the first fraction, flip the second, and multiply."
+		}
+		expl := fmt.Sprintf("%d/%d %s %d/%d = %d/%d", a, b, op, c, d, n, den)
+		if op == "+" || op == "-" {
+			expl = fmt.Sprintf("%d/%d %s %d/%d = %d/%d %s %d/%d = %d/%d", a, b, op, c, d, a*d, b*d, op, c*b, b*d, n, den)
+		}
+		return fracQuestion(fmt.Sprintf("What is %d/%d %s %d/%d?", a, b, op, c, d), n, den, hint, expl)
+	}
+}
+
+func joinInts(nums []int) string {
+	parts := make([]string, len(nums))
+	for i, n := range nums {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,112 @@
+package problemgen
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/llm"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+func TestOfflineGenerator_TemplatesProduceValidQuestions(t *testing.T) {
+	gen := NewOffline(1)
+	validators := []Validator{&StructuralValidator{}, &AnswerFormatValidator{}}
+
+	for id := range offlineTemplates {
+		skill, err := skillgraph.GetSkill(id)
+		if err != nil {
+			t.Errorf("template for unknown skill %q", id)
+			continue
+		}
+		for range 200 {
+			input := GenerateInput{Skill: skill, Tier: skillgraph.TierProve}
+			q, err := gen.Generate(context.Background(), input)
+			if err != nil {
+				t.Fatalf("%s: %v", id, err)
+			}
+			for _, v := range validators {
+				if verr := v.Validate(q, input); verr != nil {
+					t.Fatalf("%s: %q: %v", id, q.Text, verr)
+				}
+			}
+			if q.Hint == "" {
+				t.Fatalf("%s: %q has no hint", id, q.Text)
+			}
+			if !CheckAnswer(q.Answer, q) {
+				t.Fatalf("%s: %q: own answer %q rejected", id, q.Text, q.Answer)
+			}
+			if q.SkillID != id || q.Tier != skillgraph.TierProve || q.Difficulty != 3 {
+				t.Fatalf("%s: metadata %q %v %d", id, q.SkillID, q.Tier, q.Difficulty)
+			}
+		}
+	}
+}
+
+func TestOfflineTemplates_Answers(t *testing.T) {
+	tests := []struct {
+		name string
+		q    *Question
+		want string
+	}{
+		{"round up at half", roundTo(250, 100, "hundred"), "300"},
+		{"round down", roundTo(4499, 1000, "thousand"), "4000"},
+		{"exact division", divide(7, 8), "8"},
+		{"fraction reduces", fracQuestion("x", 6, 8, "", "6/8"), "3/4"},
+		{"fraction whole", fracQuestion("x", 8, 4, "", "8/4"), "2"},
+	}
+	for _, tt := range tests {
+		if tt.q.Answer != tt.want {
+			t.Errorf("%s: answer %q, want %q", tt.name, tt.q.Answer, tt.want)
+		}
+	}
+	if q := divide(7, 8); q.Text != "What is 56 ÷ 7?" {
+		t.Errorf("division text = %q", q.Text)
+	}
+	if q := fracQuestion("x", 6, 8, "", "6/8"); q.Explanation != "6/8 = 3/4." {
+		t.Errorf("explanation = %q", q.Explanation)
+	}
+}
+
+func TestOfflineGenerator_Deterministic(t *testing.T) {
+	skill, _ := skillgraph.GetSkill("frac-compare")
+	a, b := NewOffline(42), NewOffline(42)
+	for range 20 {
+		qa, _ := a.Generate(context.Background(), GenerateInput{Skill: skill})
+		qb, _ := b.Generate(context.Background(), GenerateInput{Skill: skill})
+		if qa.Text != qb.Text || !slices.Equal(qa.Choices, qb.Choices) {
+			t.Fatalf("same seed diverged: %v vs %v", qa.Choices, qb.Choices)
+		}
+	}
+}
+
+func TestOfflineGenerator_AvoidsPriorQuestions(t *testing.T) {
+	skill, _ := skillgraph.GetSkill("mult-facts-7-8-9")
+	gen := NewOffline(7)
+	var prior []string
+	for range 10 {
+		q, err := gen.Generate(context.Background(), GenerateInput{Skill: skill, PriorQuestions: prior})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slices.Contains(prior, q.Text) {
+			t.Fatalf("repeated %q", q.Text)
+		}
+		prior = append(prior, q.Text)
+	}
+}
+
+func TestOfflineGenerator_UnsupportedSkill(t *testing.T) {
+	skill, _ := skillgraph.GetSkill("add-sub-word-3digit")
+	gen := NewOffline(1)
+	if gen.Supports(skill) || Supports(gen, skill) {
+		t.Error("word problems should not be supported offline")
+	}
+	if _, err := gen.Generate(context.Background(), GenerateInput{Skill: skill}); !errors.Is(err, ErrUnsupportedSkill) {
+		t.Errorf("err = %v, want ErrUnsupportedSkill", err)
+	}
+	if !Supports(New(llm.NewMockProvider(), DefaultConfig()), skill) {
+		t.Error("the LLM generator covers every skill")
+	}
+}
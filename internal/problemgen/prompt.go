@@ -3,9 +3,12 @@ package problemgen
 import (
 	"fmt"
 	"strings"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
-const systemPrompt = `You are a math tutor creating practice problems for children in grades 3-5.
+const systemPrompt = `You are a math tutor creating practice problems for children in grades K-6.
 
 Rules:
 - Generate a single math problem appropriate for the given skill, grade, and difficulty tier.
@@ -18,27 +21,51 @@ Rules:
 - For multiple choice, provide exactly 4 options where exactly one is correct. Distractors should reflect common mistakes, not random values.
 - Options are shuffled before display, so hints and explanations must refer to options by their content, never by their position (no "the first option" or "option A").
 - Never use options whose meaning depends on the other options, like "all of the above" or "none of the above" — every option must stand alone.
-- Use answer_type "text" for conceptual reasoning or explanation questions (e.g. "why does carrying work?"). Text answer type must always use "multiple_choice" format with 4 English explanation options.
-- If the difficulty tier is "learn", include a helpful hint. If "prove", leave the hint empty.
-- Do not repeat any question from the "already asked" list.`
+- Other formats, when they suit the skill better:
+  - "true_false": the question is a statement to judge; the answer is "True" or "False".
+  - "ordering": the learner puts 3-6 items in order (e.g. least to greatest); the answer lists every item in the correct order, separated by "; ", and the question says which order.
+  - "multi_select": "select all that apply" over 4-6 options; the answer lists every correct option separated by "; ", and at least one option is wrong.
+  - "multi_blank": the question text has 2-4 blanks written as "__"; the answer gives one value per blank, in order, separated by "; ".
+- For measurement answers use answer_type "mixed_number" ("2 1/4"), "measurement" (a number and unit, e.g. "3 ft 4 in", "2.5 kg", "12 sq cm"), "money" ("$4.75") or "time" (a duration like "1 h 30 min" or a time of day like "3:45 pm"). Give the answer in the unit the question asks for.
+- Use answer_type "text" for conceptual reasoning or explanation questions (e.g. "why does carrying work?"). Text answer type must always use a format with choices (never numeric or multi_blank).
+- Add a diagram only when the skill is about reading a picture: a clock for telling time, a fraction bar for naming a fraction of a whole, a number line for locating a number or fraction, an array for multiplication as rows, or an area model for multi-digit multiplication. The question text must refer to the diagram (e.g. "What time does the clock show?"), and the diagram must never show the answer outright. Otherwise set the diagram kind to "none".
+- If the difficulty tier is "learn", include a helpful hint. If "prove" or "challenge", leave the hint empty.
+- A "challenge" tier problem is a notch harder than "prove": larger numbers, an extra step, or a less familiar context — but still squarely within the skill.
+- If a target difficulty is given, pitch the problem at it (1 = easiest, 5 = hardest for the skill and tier) and report a difficulty inside that range.
+- Do not repeat any question from the "already asked" list.
+- If a language is given, write the question text, hint, explanation and any worded options in that language, using its decimal separator in the question text. Keep the answer and numeric options in the plain form above (decimal point, "True" or "False" for true_false): the app formats them for the learner.`
+
+// batchSystemPrompt is systemPrompt for GenerateBatch: the same rules,
+// applied to each problem of the batch.
+var batchSystemPrompt = strings.Replace(systemPrompt,
+	"- Generate a single math problem appropriate",
+	"- Generate the requested number of math problems, each appropriate", 1) + `
+- Every problem in the batch must be different: no two may repeat each other or use the same numbers.`
+
+// buildBatchMessage is buildUserMessage for a batch of n questions.
+func buildBatchMessage(input GenerateInput, cfg Config, n int) string {
+	return fmt.Sprintf("Number of problems: %d\n", n) + buildUserMessage(input, cfg)
+}
 
 // buildUserMessage constructs the user message from GenerateInput and Config limits.
 func buildUserMessage(input GenerateInput, cfg Config) string {
-	tierLabel := "learn"
-	hintsAllowed := true
-	if input.Tier == 1 { // TierProve
-		tierLabel = "prove"
-		hintsAllowed = false
-	}
+	tierLabel := input.Tier.String()
+	hintsAllowed := input.Tier == skillgraph.TierLearn
 
 	var b strings.Builder
 
 	fmt.Fprintf(&b, "Skill: %s\n", input.Skill.Name)
 	fmt.Fprintf(&b, "Description: %s\n", input.Skill.Description)
-	fmt.Fprintf(&b, "Grade: %d\n", input.Skill.GradeLevel)
+	fmt.Fprintf(&b, "Grade: %s\n", skillgraph.GradeLabel(input.Skill.GradeLevel))
 	fmt.Fprintf(&b, "Keywords: %s\n", strings.Join(input.Skill.Keywords, ", "))
 	fmt.Fprintf(&b, "Tier: %s\n", tierLabel)
 	fmt.Fprintf(&b, "Hints allowed: %t\n", hintsAllowed)
+	if input.TargetDifficulty.IsSet() {
+		fmt.Fprintf(&b, "Target difficulty: %s\n", input.TargetDifficulty)
+	}
+	if input.Locale != "" && input.Locale != i18n.English {
+		fmt.Fprintf(&b, "Language: %s\n", input.Locale.Name())
+	}
 
 	b.WriteString("\nAlready asked in this session:\n")
 	b.WriteString(buildDedup(input.PriorQuestions, cfg.MaxPriorQuestions))
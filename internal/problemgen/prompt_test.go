@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
@@ -58,6 +59,17 @@ func TestBuildUserMessage_ProveTier(t *testing.T) {
 	}
 }
 
+func TestBuildUserMessage_Language(t *testing.T) {
+	input := GenerateInput{Skill: skillgraph.Skill{Name: "Test"}, Tier: skillgraph.TierLearn}
+	if msg := buildUserMessage(input, DefaultConfig()); strings.Contains(msg, "Language:") {
+		t.Error("English input should not name a language")
+	}
+	input.Locale = i18n.Spanish
+	if msg := buildUserMessage(input, DefaultConfig()); !strings.Contains(msg, "Language: Spanish\n") {
+		t.Errorf("missing language line:\n%s", msg)
+	}
+}
+
 func TestBuildUserMessage_WithHistory(t *testing.T) {
 	input := GenerateInput{
 		Skill: skillgraph.Skill{
@@ -158,3 +170,12 @@ func TestBuildUserMessage_CustomLimits(t *testing.T) {
 		}
 	}
 }
+
+func TestBatchSystemPrompt(t *testing.T) {
+	if strings.Contains(batchSystemPrompt, "a single math problem") {
+		t.Error("batch prompt still asks for a single problem")
+	}
+	if !strings.Contains(batchSystemPrompt, "Generate the requested number of math problems") {
+		t.Error("batch prompt does not ask for the requested number")
+	}
+}
@@ -15,28 +15,95 @@ var QuestionSchema = &llm.Schema{
 			},
 			"format": map[string]any{
 				"type":        "string",
-				"enum":        []any{"numeric", "multiple_choice"},
-				"description": "How the learner answers: type a number or pick from choices",
+				"enum":        []any{"numeric", "multiple_choice", "true_false", "ordering", "multi_select", "multi_blank"},
+				"description": "How the learner answers: type a number, pick one choice, judge a statement true or false, put the choices in order, select every correct choice, or fill in several __ blanks",
 			},
 			"answer": map[string]any{
 				"type":        "string",
-				"description": "The correct answer. For numeric: the number as a string. For MC: the text of the correct option.",
+				"description": "The correct answer. For numeric: the number as a string. For MC: the text of the correct option. For true_false: \"True\" or \"False\". For ordering: all choices in the correct order, separated by \"; \". For multi_select: the correct choices separated by \"; \". For multi_blank: one answer per blank in order, separated by \"; \".",
 			},
 			"answer_type": map[string]any{
 				"type":        "string",
-				"enum":        []any{"integer", "decimal", "fraction", "text"},
-				"description": "The type of the answer: numeric types for computation, or text for conceptual reasoning",
+				"enum":        []any{"integer", "decimal", "fraction", "mixed_number", "measurement", "money", "time", "text"},
+				"description": "The type of the answer: numeric types for computation; mixed_number (\"2 1/4\"), measurement with units (\"3 ft 4 in\", \"2.5 kg\"), money (\"$4.75\") or time (\"45 min\", \"3:45 pm\") for the measurement strand; or text for conceptual reasoning",
 			},
 			"choices": map[string]any{
 				"type": "array",
 				"items": map[string]any{
 					"type": "string",
 				},
-				"description": "Exactly 4 options for multiple_choice format. Empty array for numeric format.",
+				"description": "Exactly 4 options for multiple_choice. 3-6 items to arrange for ordering. 4-6 options for multi_select. [\"True\", \"False\"] for true_false. Empty array for numeric and multi_blank.",
+			},
+			"diagram": map[string]any{
+				"type":        "object",
+				"description": "An optional picture drawn with the question, for skills that need one (telling time, fractions of a whole, number lines, arrays, area models). Use kind \"none\" when the text stands alone. Set only the fields of the chosen kind; use 0, false or an empty array for the rest.",
+				"properties": map[string]any{
+					"kind": map[string]any{
+						"type": "string",
+						"enum": []any{"none", "number_line", "fraction_bar", "area_model", "array", "clock"},
+					},
+					"min": map[string]any{
+						"type":        "integer",
+						"description": "number_line: the value at the left end",
+					},
+					"max": map[string]any{
+						"type":        "integer",
+						"description": "number_line: the value at the right end",
+					},
+					"intervals": map[string]any{
+						"type":        "integer",
+						"description": "number_line: how many equal steps between min and max (1-20); 4 steps from 0 to 1 gives fourths",
+					},
+					"points": map[string]any{
+						"type":        "array",
+						"items":       map[string]any{"type": "number"},
+						"description": "number_line: values marked with a dot (at most 4)",
+					},
+					"label_ticks": map[string]any{
+						"type":        "boolean",
+						"description": "number_line: label every tick, not just the two ends",
+					},
+					"parts": map[string]any{
+						"type":        "integer",
+						"description": "fraction_bar: number of equal pieces (1-12)",
+					},
+					"shaded": map[string]any{
+						"type":        "integer",
+						"description": "fraction_bar: number of pieces shaded",
+					},
+					"rows": map[string]any{
+						"type":        "integer",
+						"description": "array: number of rows of dots (1-10)",
+					},
+					"cols": map[string]any{
+						"type":        "integer",
+						"description": "array: number of dots in each row (1-12)",
+					},
+					"row_parts": map[string]any{
+						"type":        "array",
+						"items":       map[string]any{"type": "integer"},
+						"description": "area_model: the height split into place-value parts, e.g. [4]",
+					},
+					"col_parts": map[string]any{
+						"type":        "array",
+						"items":       map[string]any{"type": "integer"},
+						"description": "area_model: the width split into place-value parts, e.g. [20, 3] for 23",
+					},
+					"hour": map[string]any{
+						"type":        "integer",
+						"description": "clock: the hour shown (1-12)",
+					},
+					"minute": map[string]any{
+						"type":        "integer",
+						"description": "clock: the minute shown (0-59)",
+					},
+				},
+				"required":             []any{"kind", "min", "max", "intervals", "points", "label_ticks", "parts", "shaded", "rows", "cols", "row_parts", "col_parts", "hour", "minute"},
+				"additionalProperties": false,
 			},
 			"hint": map[string]any{
 				"type":        "string",
-				"description": "A short scaffolding hint for the learner. Non-empty for learn tier, empty for prove tier.",
+				"description": "A short scaffolding hint for the learner. Non-empty for learn tier, empty for prove and challenge tiers.",
 			},
 			"difficulty": map[string]any{
 				"type":        "integer",
@@ -49,7 +116,24 @@ var QuestionSchema = &llm.Schema{
 				"description": "Step-by-step worked solution, age-appropriate for a child",
 			},
 		},
-		"required":             []any{"question_text", "format", "answer", "answer_type", "choices", "hint", "difficulty", "explanation"},
+		"required":             []any{"question_text", "format", "answer", "answer_type", "choices", "diagram", "hint", "difficulty", "explanation"},
+		"additionalProperties": false,
+	},
+}
+
+// QuestionBatchSchema wraps QuestionSchema in an array, for GenerateBatch.
+var QuestionBatchSchema = &llm.Schema{
+	Name:        "math-questions",
+	Description: "A batch of math practice questions for one skill",
+	Definition: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"questions": map[string]any{
+				"type":  "array",
+				"items": QuestionSchema.Definition,
+			},
+		},
+		"required":             []any{"questions"},
 		"additionalProperties": false,
 	},
 }
@@ -1,5 +1,7 @@
 package problemgen
 
+import "slices"
+
 // StructuralValidator checks that required fields are present, within
 // length limits, and have valid enum values.
 type StructuralValidator struct{}
@@ -42,26 +44,35 @@ func (v *StructuralValidator) Validate(q *Question, _ GenerateInput) *Validation
 			Retryable: true,
 		}
 	}
-	if q.Format != FormatNumeric && q.Format != FormatMultipleChoice {
+	if !slices.Contains(Formats, q.Format) {
 		return &ValidationError{
 			Validator: v.Name(),
-			Message:   "format must be \"numeric\" or \"multiple_choice\"",
+			Message:   "format must be \"numeric\", \"multiple_choice\", \"true_false\", \"ordering\", \"multi_select\" or \"multi_blank\"",
 			Retryable: true,
 		}
 	}
-	if q.AnswerType != AnswerTypeInteger && q.AnswerType != AnswerTypeDecimal && q.AnswerType != AnswerTypeFraction && q.AnswerType != AnswerTypeText {
+	if !slices.Contains(AnswerTypes, q.AnswerType) {
 		return &ValidationError{
 			Validator: v.Name(),
-			Message:   "answer_type must be \"integer\", \"decimal\", \"fraction\", or \"text\"",
+			Message:   "answer_type must be \"integer\", \"decimal\", \"fraction\", \"mixed_number\", \"measurement\", \"money\", \"time\", or \"text\"",
 			Retryable: true,
 		}
 	}
-	if q.AnswerType == AnswerTypeText && q.Format != FormatMultipleChoice {
+	if q.AnswerType == AnswerTypeText && !q.Format.HasChoices() {
 		return &ValidationError{
 			Validator: v.Name(),
-			Message:   "answer_type \"text\" must use \"multiple_choice\" format",
+			Message:   "answer_type \"text\" must use a format with choices",
 			Retryable: true,
 		}
 	}
+	if q.Diagram != nil {
+		if msg := q.Diagram.Check(); msg != "" {
+			return &ValidationError{
+				Validator: v.Name(),
+				Message:   msg,
+				Retryable: true,
+			}
+		}
+	}
 	return nil
 }
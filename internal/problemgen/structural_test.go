@@ -144,3 +144,18 @@ func TestStructural_TextAnswerType_NumericFails(t *testing.T) {
 		t.Errorf("expected validator %q, got %q", "structural", err.Validator)
 	}
 }
+
+func TestStructural_Diagram(t *testing.T) {
+	v := &StructuralValidator{}
+	q := validQuestion()
+	q.Diagram = &Diagram{Kind: DiagramClock, Hour: 3, Minute: 45}
+	if err := v.Validate(q, GenerateInput{}); err != nil {
+		t.Fatalf("expected nil for a valid clock, got %v", err)
+	}
+
+	q.Diagram.Minute = 60
+	err := v.Validate(q, GenerateInput{})
+	if err == nil || !err.Retryable {
+		t.Fatalf("expected a retryable error for minute 60, got %v", err)
+	}
+}
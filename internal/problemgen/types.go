@@ -1,8 +1,13 @@
 package problemgen
 
 import (
+	"fmt"
 	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
@@ -18,21 +23,33 @@ type Question struct {
 	// Answer is the canonical correct answer as a string.
 	// For numeric: "623", "0.75", "3/4"
 	// For multiple choice: the text of the correct option (e.g. "3/4")
+	// For true/false: "True" or "False"
+	// For ordering: every option in the correct order, joined by
+	// AnswerSeparator (e.g. "120; 201; 210")
+	// For multi-select: the correct options joined by AnswerSeparator
+	// For multi-blank: one answer per blank, in order, joined by
+	// AnswerSeparator (e.g. "4; 12")
 	Answer string
 
 	// AnswerType describes the numeric type of the answer for validation.
 	AnswerType AnswerType
 
-	// Choices is populated only when Format is FormatMultipleChoice.
-	// Contains exactly 4 options, one of which matches Answer.
+	// Choices holds the options for the choice formats (see
+	// AnswerFormat.HasChoices): exactly 4 for multiple choice, "True" and
+	// "False" for true/false, the items to arrange for ordering, and the
+	// candidates for multi-select. Empty for numeric and multi-blank.
 	Choices []string
 
+	// Diagram is an optional picture drawn with the question (a number
+	// line, fraction bar, clock, …). Nil when the text stands alone.
+	Diagram *Diagram
+
 	// Hint is an optional short hint the learner can request (Learn tier only).
 	// Empty string if no hint was generated.
 	Hint string
 
-	// Difficulty is the LLM's self-assessed difficulty (1-5).
-	// Used for analytics, not for gating.
+	// Difficulty is the LLM's self-assessed difficulty (1-5). LLM questions
+	// outside GenerateInput.TargetDifficulty are regenerated or rejected.
 	Difficulty int
 
 	// Explanation is a brief worked solution shown after the learner answers.
@@ -44,6 +61,27 @@ type Question struct {
 
 	// Tier is the tier this question was generated for.
 	Tier skillgraph.Tier
+
+	// Source names the generator that produced the question (SourceLLM,
+	// SourceOffline). Empty for parent-authored quest questions.
+	Source string
+
+	// LLMRequestID is the logged LLM request that produced the question
+	// (see `mathiz llm view`), or 0 when it didn't come from a logged LLM
+	// call: offline and quest questions. A banked question keeps the
+	// request that first generated it, which LLMOwner names the log of.
+	LLMRequestID int
+
+	// LLMOwner is the learner whose LLM log holds LLMRequestID, set for
+	// banked questions; empty means the learner being served.
+	LLMOwner string
+
+	// Locale is the learner locale the question was made for. LLM
+	// questions are written in its language (offline templates are
+	// English only); for every question it sets how the learner writes
+	// decimals. Answer and Choices always use a decimal point: show them
+	// with DisplayAnswer and DisplayChoice.
+	Locale i18n.Locale
 }
 
 // ShuffleChoices randomizes multiple-choice option order in place. LLMs
@@ -53,31 +91,113 @@ type Question struct {
 // Answer TEXT, never a position. Every generation path must call this after
 // validation; parent-authored quest questions are deliberately exempt (the
 // author's ordering — e.g. "all of the above" last — is intentional).
+//
+// Ordering items are shuffled until they are out of order: presented
+// already sorted, the question answers itself. True/false keeps its fixed
+// True, False order.
 func (q *Question) ShuffleChoices() {
-	if q.Format != FormatMultipleChoice || len(q.Choices) < 2 {
+	switch q.Format {
+	case FormatMultipleChoice, FormatMultiSelect, FormatOrdering:
+	default:
+		return
+	}
+	if len(q.Choices) < 2 {
 		return
 	}
-	rand.Shuffle(len(q.Choices), func(i, j int) {
-		q.Choices[i], q.Choices[j] = q.Choices[j], q.Choices[i]
-	})
+	order := SplitAnswer(q.Answer)
+	for range 10 {
+		rand.Shuffle(len(q.Choices), func(i, j int) {
+			q.Choices[i], q.Choices[j] = q.Choices[j], q.Choices[i]
+		})
+		if q.Format != FormatOrdering || !slices.EqualFunc(q.Choices, order, strings.EqualFold) {
+			return
+		}
+	}
 }
 
+// Normalize fills in what the format implies: the fixed True/False choices
+// and a canonical "True"/"False" answer for true/false questions. It also
+// drops a diagram of kind "none", the schema's way of saying there is
+// none. Every path that builds a Question from LLM output calls it before
+// validation.
+func (q *Question) Normalize() {
+	if q.Diagram != nil && (q.Diagram.Kind == "" || q.Diagram.Kind == "none") {
+		q.Diagram = nil
+	}
+	if q.Format != FormatTrueFalse {
+		return
+	}
+	q.Choices = slices.Clone(TrueFalseChoices)
+	if v, ok := parseTrueFalse(q.Answer); ok {
+		q.Answer = TrueFalseChoices[1]
+		if v {
+			q.Answer = TrueFalseChoices[0]
+		}
+	}
+}
+
+// AnswerSeparator joins the parts of an answer with several parts:
+// ordering, multi-select and multi-blank answers, and learner answers to
+// them. A semicolon, because commas appear inside numbers ("1,000").
+const AnswerSeparator = ";"
+
+// SplitAnswer splits an answer into its trimmed parts.
+func SplitAnswer(answer string) []string {
+	parts := strings.Split(answer, AnswerSeparator)
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
+
+// JoinAnswer joins answer parts with AnswerSeparator.
+func JoinAnswer(parts []string) string {
+	return strings.Join(parts, AnswerSeparator+" ")
+}
+
+// BlankCount is the number of blanks a multi-blank question asks for.
+func (q *Question) BlankCount() int {
+	if q.Format != FormatMultiBlank {
+		return 0
+	}
+	return len(SplitAnswer(q.Answer))
+}
+
+// TrueFalseChoices are the options of every true/false question.
+var TrueFalseChoices = []string{"True", "False"}
+
 // Position-dependent options ("all of the above") are handled upstream, not
 // here: the prompts forbid them, and quest AI drafts additionally pass
 // parent review before a child sees them. A string-matching guard at this
 // layer was considered and rejected — it misses paraphrases ("both A and
 // B") while implying a reliability it doesn't have.
 
-// AnswerType describes the numeric representation of the correct answer.
+// AnswerType describes the representation of the correct answer.
 type AnswerType string
 
 const (
-	AnswerTypeInteger  AnswerType = "integer"  // e.g. "623", "-15"
-	AnswerTypeDecimal  AnswerType = "decimal"  // e.g. "3.75", "0.5"
-	AnswerTypeFraction AnswerType = "fraction" // e.g. "3/4", "7/2"
-	AnswerTypeText     AnswerType = "text"     // e.g. conceptual reasoning explanations
+	AnswerTypeInteger     AnswerType = "integer"      // e.g. "623", "-15"
+	AnswerTypeDecimal     AnswerType = "decimal"      // e.g. "3.75", "0.5"
+	AnswerTypeFraction    AnswerType = "fraction"     // e.g. "3/4", "7/2"
+	AnswerTypeText        AnswerType = "text"         // e.g. conceptual reasoning explanations
+	AnswerTypeMixedNumber AnswerType = "mixed_number" // e.g. "2 1/4", "3/4", "5"
+	AnswerTypeMeasurement AnswerType = "measurement"  // e.g. "3 ft 4 in", "2.5 kg", "12 sq cm"
+	AnswerTypeMoney       AnswerType = "money"        // e.g. "$4.75", "4.75", "75 cents"
+	AnswerTypeTime        AnswerType = "time"         // e.g. "45 min", "1 h 30 min", "3:45 pm"
 )
 
+// AnswerTypes lists every answer type.
+var AnswerTypes = []AnswerType{
+	AnswerTypeInteger, AnswerTypeDecimal, AnswerTypeFraction, AnswerTypeText,
+	AnswerTypeMixedNumber, AnswerTypeMeasurement, AnswerTypeMoney, AnswerTypeTime,
+}
+
+// hasUnits reports whether answers of type t carry a unit, which
+// CheckAnswer lets the learner leave off a single-part answer.
+func (t AnswerType) hasUnits() bool {
+	return t == AnswerTypeMeasurement || t == AnswerTypeMoney || t == AnswerTypeTime
+}
+
 // AnswerFormat describes how the learner provides their answer.
 type AnswerFormat string
 
@@ -87,8 +207,36 @@ const (
 
 	// FormatMultipleChoice means the learner picks from 4 choices.
 	FormatMultipleChoice AnswerFormat = "multiple_choice"
+
+	// FormatTrueFalse means the learner judges a statement true or false.
+	FormatTrueFalse AnswerFormat = "true_false"
+
+	// FormatOrdering means the learner arranges the choices in order.
+	FormatOrdering AnswerFormat = "ordering"
+
+	// FormatMultiSelect means the learner selects every correct choice.
+	FormatMultiSelect AnswerFormat = "multi_select"
+
+	// FormatMultiBlank means the learner fills in several blanks ("__") in
+	// the question text.
+	FormatMultiBlank AnswerFormat = "multi_blank"
 )
 
+// Formats lists every answer format.
+var Formats = []AnswerFormat{
+	FormatNumeric, FormatMultipleChoice, FormatTrueFalse,
+	FormatOrdering, FormatMultiSelect, FormatMultiBlank,
+}
+
+// HasChoices reports whether the learner answers by picking from Choices.
+func (f AnswerFormat) HasChoices() bool {
+	switch f {
+	case FormatMultipleChoice, FormatTrueFalse, FormatOrdering, FormatMultiSelect:
+		return true
+	}
+	return false
+}
+
 // GenerateInput holds all context needed to generate a question.
 type GenerateInput struct {
 	// Skill is the target skill for the question.
@@ -109,4 +257,39 @@ type GenerateInput struct {
 	// LearnerProfile is an optional AI-generated summary of the learner.
 	// Included in the prompt when available for better personalization.
 	LearnerProfile string
+
+	// TargetDifficulty is the difficulty band to pitch the question at,
+	// computed from the learner's recent accuracy and fluency on the skill
+	// (session.TargetDifficulty). The zero band has no target.
+	TargetDifficulty DifficultyBand
+
+	// Locale is the learner's language. Questions are generated in it, and
+	// only banked questions in it are served. The zero value is English.
+	Locale i18n.Locale
+}
+
+// DifficultyBand is an inclusive range of Question.Difficulty values.
+type DifficultyBand struct {
+	Min, Max int
+}
+
+// NewDifficultyBand returns the band from lo to hi, clamped to 1-5.
+func NewDifficultyBand(lo, hi int) DifficultyBand {
+	return DifficultyBand{Min: min(max(lo, 1), 5), Max: min(max(hi, 1), 5)}
+}
+
+// IsSet reports whether the band targets a difficulty at all.
+func (b DifficultyBand) IsSet() bool { return b.Min > 0 && b.Max >= b.Min }
+
+// Contains reports whether difficulty d is in the band. Every difficulty
+// is in the zero band.
+func (b DifficultyBand) Contains(d int) bool {
+	return !b.IsSet() || (d >= b.Min && d <= b.Max)
+}
+
+func (b DifficultyBand) String() string {
+	if b.Min == b.Max {
+		return strconv.Itoa(b.Min)
+	}
+	return fmt.Sprintf("%d-%d", b.Min, b.Max)
 }
@@ -56,3 +56,40 @@ func TestShuffleChoicesNoOpCases(t *testing.T) {
 		t.Errorf("single-choice list mutated: %v", single.Choices)
 	}
 }
+
+// Ordering items must never be presented already in the answer's order.
+func TestShuffleChoicesOrderingNeverSorted(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		q := &Question{
+			Format:  FormatOrdering,
+			Answer:  "1; 2; 3",
+			Choices: []string{"1", "2", "3"},
+		}
+		q.ShuffleChoices()
+		if q.Choices[0] == "1" && q.Choices[1] == "2" && q.Choices[2] == "3" {
+			t.Fatal("ordering question presented in the correct order")
+		}
+	}
+}
+
+func TestSplitJoinAnswer(t *testing.T) {
+	parts := SplitAnswer(" 1,000 ;2/3;  x ")
+	if len(parts) != 3 || parts[0] != "1,000" || parts[1] != "2/3" || parts[2] != "x" {
+		t.Errorf("SplitAnswer = %q", parts)
+	}
+	if got := JoinAnswer(parts); got != "1,000; 2/3; x" {
+		t.Errorf("JoinAnswer = %q", got)
+	}
+	q := &Question{Format: FormatMultiBlank, Answer: "4; 12"}
+	if q.BlankCount() != 2 {
+		t.Errorf("BlankCount = %d, want 2", q.BlankCount())
+	}
+}
+
+func TestNormalizeTrueFalse(t *testing.T) {
+	q := &Question{Format: FormatTrueFalse, Answer: "false"}
+	q.Normalize()
+	if q.Answer != "False" || len(q.Choices) != 2 || q.Choices[0] != "True" {
+		t.Errorf("normalized true/false = %q %q", q.Answer, q.Choices)
+	}
+}
@@ -1,6 +1,9 @@
 package problemgen
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Validator checks a generated question for correctness.
 // Implementations should be stateless and safe for concurrent use.
@@ -26,3 +29,28 @@ type ValidationError struct {
 func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validator %q: %s", e.Validator, e.Message)
 }
+
+// ContextValidator is implemented by validators that do I/O, such as
+// calling an LLM, and need the caller's context. RunValidators calls
+// ValidateContext in place of Validate when it is available.
+type ContextValidator interface {
+	Validator
+	ValidateContext(ctx context.Context, q *Question, input GenerateInput) *ValidationError
+}
+
+// RunValidators runs the validators in order and returns the first failure,
+// or nil if the question passes them all.
+func RunValidators(ctx context.Context, validators []Validator, q *Question, input GenerateInput) *ValidationError {
+	for _, v := range validators {
+		var verr *ValidationError
+		if cv, ok := v.(ContextValidator); ok {
+			verr = cv.ValidateContext(ctx, q, input)
+		} else {
+			verr = v.Validate(q, input)
+		}
+		if verr != nil {
+			return verr
+		}
+	}
+	return nil
+}
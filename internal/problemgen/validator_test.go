@@ -16,10 +16,10 @@ func TestValidationError_Error(t *testing.T) {
 
 func TestDefaultConfig_ValidatorChain(t *testing.T) {
 	cfg := DefaultConfig()
-	if len(cfg.Validators) != 3 {
-		t.Fatalf("expected 3 validators, got %d", len(cfg.Validators))
+	if len(cfg.Validators) != 4 {
+		t.Fatalf("expected 4 validators, got %d", len(cfg.Validators))
 	}
-	names := []string{"structural", "answer-format", "math-check"}
+	names := []string{"structural", "difficulty", "answer-format", "math-check"}
 	for i, v := range cfg.Validators {
 		if v.Name() != names[i] {
 			t.Errorf("validator %d: expected %q, got %q", i, names[i], v.Name())
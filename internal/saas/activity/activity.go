@@ -274,7 +274,8 @@ func (r *Reader) expeditionItem(ctx context.Context, sum store.SessionSummaryRec
 			}
 			continue
 		}
-		if seen[slot.SkillID] {
+		// Placement sessions plan no single skill.
+		if slot.SkillID == "" || seen[slot.SkillID] {
 			continue
 		}
 		seen[slot.SkillID] = true
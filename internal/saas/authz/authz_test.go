@@ -35,12 +35,12 @@ func newFixture(t *testing.T) *fixture {
 	owner, _ := svc.EnsureAccount(ctx, "sb-owner", "o@example.com", "Owner")
 	other, _ := svc.EnsureAccount(ctx, "sb-other", "x@example.com", "Other")
 	sp, _ := svc.CreateSpace(ctx, owner.UID, "Family A")
-	child, _ := svc.AddChild(ctx, sp.UID, "Alice", 3, "")
+	child, _ := svc.AddChild(ctx, sp.UID, "Alice", 3, "", "", "")
 	inv, _ := svc.CreateInvite(ctx, sp.UID, 0)
 	_, dt, _ := svc.RedeemInvite(ctx, inv.Code, child.UID, "", "dev")
 
 	sp2, _ := svc.CreateSpace(ctx, other.UID, "Family B")
-	child2, _ := svc.AddChild(ctx, sp2.UID, "Zed", 4, "")
+	child2, _ := svc.AddChild(ctx, sp2.UID, "Zed", 4, "", "", "")
 
 	return &fixture{
 		checker:    NewChecker(svc),
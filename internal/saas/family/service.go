@@ -28,6 +28,8 @@ import (
 	"github.com/abhisek/mathiz/ent/devicetoken"
 	"github.com/abhisek/mathiz/ent/familyspace"
 	"github.com/abhisek/mathiz/ent/invite"
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
 var (
@@ -37,8 +39,10 @@ var (
 	ErrPINRequired   = errors.New("this profile requires a PIN")
 	ErrPINMismatch   = errors.New("incorrect PIN")
 	ErrBadPIN        = errors.New("PIN must be 4-6 digits")
-	ErrBadGrade      = errors.New("grade must be between 2 and 5")
+	ErrBadGrade      = errors.New("grade must be between K (0) and 6")
 	ErrBadName       = errors.New("name must not be empty")
+	ErrBadStandard   = errors.New("unknown curriculum standard")
+	ErrBadLocale     = errors.New("unsupported language")
 	ErrArchived      = errors.New("child profile is archived")
 	ErrTokenInvalid  = errors.New("device token is invalid or revoked")
 )
@@ -56,6 +60,16 @@ const MaxInviteTTL = 90 * 24 * time.Hour
 
 var pinPattern = regexp.MustCompile(`^\d{4,6}$`)
 
+// Child profiles cover the skill graph's grades: kindergarten (0) to 6.
+const (
+	MinGrade = 0
+	MaxGrade = 6
+)
+
+func validGrade(grade int) bool {
+	return grade >= MinGrade && grade <= MaxGrade
+}
+
 // Service implements control-plane operations on top of the ent client.
 type Service struct {
 	client *ent.Client
@@ -187,14 +201,24 @@ func (s *Service) RenameSpace(ctx context.Context, spaceUID, name string) (*ent.
 
 // ---- Child profiles ----
 
-// AddChild creates a child profile in a space. pin may be empty (no PIN).
-func (s *Service) AddChild(ctx context.Context, spaceUID, name string, grade int, pin string) (*ent.ChildProfile, error) {
+// AddChild creates a child profile in a space. pin may be empty (no PIN);
+// standard may be empty (skillgraph.DefaultStandard), and so may locale
+// (i18n.DefaultLocale).
+func (s *Service) AddChild(ctx context.Context, spaceUID, name string, grade int, pin, standard, locale string) (*ent.ChildProfile, error) {
 	if name == "" {
 		return nil, ErrBadName
 	}
-	if grade < 2 || grade > 5 {
+	if !validGrade(grade) {
 		return nil, ErrBadGrade
 	}
+	std, err := skillgraph.ParseStandard(standard)
+	if err != nil {
+		return nil, ErrBadStandard
+	}
+	loc, err := i18n.Parse(locale)
+	if err != nil {
+		return nil, ErrBadLocale
+	}
 	pinHash, err := hashPIN(pin)
 	if err != nil {
 		return nil, err
@@ -207,6 +231,8 @@ func (s *Service) AddChild(ctx context.Context, spaceUID, name string, grade int
 		SetFamilySpaceID(spaceUID).
 		SetName(name).
 		SetGrade(grade).
+		SetStandard(string(std)).
+		SetLocale(string(loc)).
 		SetPinHash(pinHash).
 		Save(ctx)
 }
@@ -235,6 +261,8 @@ func (s *Service) Children(ctx context.Context, spaceUID string) ([]*ent.ChildPr
 type UpdateChildOpts struct {
 	Name     *string
 	Grade    *int
+	Standard *string
+	Locale   *string
 	PIN      *string
 	Archived *bool
 }
@@ -253,11 +281,25 @@ func (s *Service) UpdateChild(ctx context.Context, childUID string, opts UpdateC
 		upd.SetName(*opts.Name)
 	}
 	if opts.Grade != nil {
-		if *opts.Grade < 2 || *opts.Grade > 5 {
+		if !validGrade(*opts.Grade) {
 			return nil, ErrBadGrade
 		}
 		upd.SetGrade(*opts.Grade)
 	}
+	if opts.Standard != nil {
+		std, err := skillgraph.ParseStandard(*opts.Standard)
+		if err != nil {
+			return nil, ErrBadStandard
+		}
+		upd.SetStandard(string(std))
+	}
+	if opts.Locale != nil {
+		loc, err := i18n.Parse(*opts.Locale)
+		if err != nil {
+			return nil, ErrBadLocale
+		}
+		upd.SetLocale(string(loc))
+	}
 	if opts.PIN != nil {
 		pinHash, err := hashPIN(*opts.PIN)
 		if err != nil {
@@ -32,7 +32,7 @@ func bootstrap(t *testing.T, svc *Service, pin string) (accountUID, spaceUID, ch
 	if err != nil {
 		t.Fatalf("create space: %v", err)
 	}
-	child, err := svc.AddChild(ctx, sp.UID, "Alice", 3, pin)
+	child, err := svc.AddChild(ctx, sp.UID, "Alice", 3, pin, "", "")
 	if err != nil {
 		t.Fatalf("add child: %v", err)
 	}
@@ -78,17 +78,45 @@ func TestAddChildValidation(t *testing.T) {
 	_, spaceUID, _ := bootstrap(t, svc, "")
 	ctx := context.Background()
 
-	if _, err := svc.AddChild(ctx, spaceUID, "", 3, ""); !errors.Is(err, ErrBadName) {
+	if _, err := svc.AddChild(ctx, spaceUID, "", 3, "", "", ""); !errors.Is(err, ErrBadName) {
 		t.Errorf("empty name: got %v", err)
 	}
-	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 9, ""); !errors.Is(err, ErrBadGrade) {
-		t.Errorf("bad grade: got %v", err)
+	for _, grade := range []int{-1, 7, 9} {
+		if _, err := svc.AddChild(ctx, spaceUID, "Bob", grade, "", "", ""); !errors.Is(err, ErrBadGrade) {
+			t.Errorf("bad grade %d: got %v", grade, err)
+		}
+	}
+	for _, grade := range []int{MinGrade, MaxGrade} {
+		if _, err := svc.AddChild(ctx, spaceUID, "Kid", grade, "", "", ""); err != nil {
+			t.Errorf("grade %d: %v", grade, err)
+		}
 	}
-	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "12ab"); !errors.Is(err, ErrBadPIN) {
+	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "12ab", "", ""); !errors.Is(err, ErrBadPIN) {
 		t.Errorf("bad pin: got %v", err)
 	}
-	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "1234"); err != nil {
-		t.Errorf("valid child: %v", err)
+	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "", "ib-pyp", ""); !errors.Is(err, ErrBadStandard) {
+		t.Errorf("bad standard: got %v", err)
+	}
+	if _, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "", "", "xx"); !errors.Is(err, ErrBadLocale) {
+		t.Errorf("bad locale: got %v", err)
+	}
+	c, err := svc.AddChild(ctx, spaceUID, "Bob", 4, "1234", "", "")
+	if err != nil {
+		t.Fatalf("valid child: %v", err)
+	}
+	if c.Standard != "ccss" || c.Locale != "en" {
+		t.Errorf("defaults = %q, %q, want ccss, en", c.Standard, c.Locale)
+	}
+
+	std := "cbse"
+	c, err = svc.UpdateChild(ctx, c.UID, UpdateChildOpts{Standard: &std})
+	if err != nil || c.Standard != "cbse" {
+		t.Errorf("update standard: %v, got %q", err, c.Standard)
+	}
+	loc := "es-MX"
+	c, err = svc.UpdateChild(ctx, c.UID, UpdateChildOpts{Locale: &loc})
+	if err != nil || c.Locale != "es" {
+		t.Errorf("update locale: %v, got %q", err, c.Locale)
 	}
 }
 
@@ -211,7 +239,7 @@ func TestRedeemCrossFamilyDenied(t *testing.T) {
 	// Second family with its own child.
 	acct2, _ := svc.EnsureAccount(ctx, "sb-user-2", "other@example.com", "Other")
 	sp2, _ := svc.CreateSpace(ctx, acct2.UID, "The Others")
-	otherChild, _ := svc.AddChild(ctx, sp2.UID, "Zed", 4, "")
+	otherChild, _ := svc.AddChild(ctx, sp2.UID, "Zed", 4, "", "", "")
 
 	inv, _ := svc.CreateInvite(ctx, spaceUID, 0)
 	// Family-1 code must not redeem for a family-2 profile.
@@ -20,6 +20,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/lessons"
 	"github.com/abhisek/mathiz/internal/llm"
 	"github.com/abhisek/mathiz/internal/mastery"
@@ -56,6 +57,11 @@ const QuestionsPerExpedition = 5
 // generation failures (mirrors the TUI's circuit breaker).
 const maxGenFailures = 3
 
+// maxReportSkips caps how many dig questions a report can skip per
+// expedition. Past it, reports are still recorded but the question stays
+// to answer, so reporting can't become a way to dodge hard questions.
+const maxReportSkips = 2
+
 // questionGenBudget caps the total wait for one question, retries included
 // (mirrors the TUI's generateTimeout). The provider bounds each attempt on its
 // own; this bounds the chain.
@@ -76,7 +82,11 @@ func NewLLMToolset(ctx context.Context, eventRepo store.EventRepo) (*Toolset, er
 	if err != nil {
 		return nil, fmt.Errorf("LLM provider: %w", err)
 	}
-	return tutor.New(provider), nil
+	judge, err := llm.NewJudgeProviderFromEnv(ctx, eventRepo, llm.PurposeQuestionGen)
+	if err != nil {
+		return nil, fmt.Errorf("LLM judge: %w", err)
+	}
+	return tutor.New(provider, judge), nil
 }
 
 // Config configures a Manager.
@@ -99,6 +109,14 @@ type Config struct {
 	// Quests serves parent-authored quests (specs/15-quests.md). Nil =
 	// quests disabled: no quest cards on the map, StartQuest refuses.
 	Quests QuestSource
+
+	// Locale looks up the child's language, which questions, lessons and
+	// answer feedback are written in. Nil = English for everyone.
+	Locale func(ctx context.Context, childUID string) i18n.Locale
+
+	// Grade looks up the child's school grade, which the placement quiz
+	// probes no higher than. Nil, or a nil result, probes every grade.
+	Grade func(ctx context.Context, childUID string) *int
 }
 
 // Manager owns all live expeditions (one per child).
@@ -153,6 +171,7 @@ type expedition struct {
 	childUID string
 	skill    skillgraph.Skill
 	category sess.PlanCategory
+	locale   i18n.Locale
 
 	// quest is set for quest expeditions (see quest.go); nil for normal
 	// dig-spot expeditions. origSnap is the snapshot the expedition loaded
@@ -161,6 +180,10 @@ type expedition struct {
 	quest    *questRun
 	origSnap *store.SnapshotData
 
+	// placement is set for the placement quiz (see placement.go); it
+	// replaces the session engine for grading and saving.
+	placement *placementRun
+
 	state      *sess.SessionState
 	masterySvc *mastery.Service
 	scheduler  *spacedrep.Scheduler
@@ -171,8 +194,11 @@ type expedition struct {
 
 	learnerProfile string // cached summary for question generation
 
-	questionsAsked int  // questions generated so far
-	answered       bool // current question already graded
+	questionsAsked int    // questions generated so far
+	answered       bool   // current question already graded
+	lastAnswer     string // the graded answer, kept for reports
+	reported       bool   // current question already reported
+	reportSkips    int    // questions skipped by a report so far
 	genFailures    int
 	lesson         *lessons.Lesson // delivered lesson awaiting practice
 	finished       bool
@@ -188,11 +214,15 @@ type expedition struct {
 func (e *expedition) touch() { e.lastActivity.Store(time.Now().UnixNano()) }
 
 // totalQuestions is how many questions this expedition serves: the standard
-// 5 for dig spots, min(5, remaining) for quest expeditions.
+// 5 for dig spots, min(5, remaining) for quest expeditions, and the question
+// budget for placement (an upper bound — the quiz may end sooner).
 func (e *expedition) totalQuestions() int {
 	if e.quest != nil {
 		return e.quest.total
 	}
+	if e.placement != nil {
+		return e.placement.quiz.MaxQuestions()
+	}
 	return QuestionsPerExpedition
 }
 
@@ -225,7 +255,7 @@ func (m *Manager) Start(ctx context.Context, childUID, skillID string) (*Expedit
 	m.mu.Unlock()
 	if prev != nil {
 		prev.mu.Lock()
-		reuse := !prev.finished && prev.quest == nil && prev.skill.ID == skillID && prev.questionsAsked == 0
+		reuse := !prev.finished && prev.quest == nil && prev.placement == nil && prev.skill.ID == skillID && prev.questionsAsked == 0
 		var view *ExpeditionView
 		if reuse {
 			prev.touch()
@@ -326,13 +356,14 @@ func (m *Manager) Start(ctx context.Context, childUID, skillID string) (*Expedit
 	if err != nil {
 		return nil, err
 	}
+	if m.cfg.Store != nil {
+		tools.UseBank(m.cfg.Store.QuestionBankFor(childUID))
+	}
 
 	sessionID := uuid.NewString()
 	if m.cfg.Charge != nil {
 		if err := m.cfg.Charge(ctx, childUID, sessionID); err != nil {
-			if tools.Diagnosis != nil {
-				tools.Diagnosis.Close()
-			}
+			tools.Close()
 			return nil, err
 		}
 	}
@@ -378,6 +409,7 @@ func (m *Manager) Start(ctx context.Context, childUID, skillID string) (*Expedit
 		childUID:       childUID,
 		skill:          skill,
 		category:       category,
+		locale:         m.locale(ctx, childUID),
 		state:          state,
 		masterySvc:     masterySvc,
 		scheduler:      scheduler,
@@ -435,6 +467,10 @@ func (m *Manager) Question(ctx context.Context, childUID, expID string) (*Questi
 	if exp.state.CurrentQuestion != nil && !exp.answered {
 		return exp.questionView(), nil
 	}
+	exp.reported = false
+	if exp.placement != nil {
+		return m.placementQuestion(ctx, exp)
+	}
 	if exp.questionsAsked >= exp.totalQuestions() {
 		return nil, ErrExpeditionOver
 	}
@@ -443,8 +479,10 @@ func (m *Manager) Question(ctx context.Context, childUID, expID string) (*Questi
 	// skip the lookup: their synthetic skill ID must not seed a phantom
 	// mastery entry.
 	tier := skillgraph.TierLearn
+	var band problemgen.DifficultyBand
 	if exp.quest == nil || exp.quest.tagged {
-		tier = exp.masterySvc.GetMastery(exp.skill.ID).CurrentTier
+		sm := exp.masterySvc.GetMastery(exp.skill.ID)
+		tier, band = sm.CurrentTier, sess.TargetDifficulty(sm)
 	}
 
 	exp.state.ErrorMu.Lock()
@@ -458,13 +496,15 @@ func (m *Manager) Question(ctx context.Context, childUID, expID string) (*Questi
 	genCtx, cancel := context.WithTimeout(ctx, questionGenBudget)
 	defer cancel()
 
-	q, err := exp.tools.Generator.Generate(genCtx, problemgen.GenerateInput{
-		Skill:          exp.skill,
-		Tier:           tier,
-		PriorQuestions: exp.state.PriorQuestions[exp.skill.ID],
-		RecentErrors:   recentErrors,
-		LearnerProfile: exp.learnerProfile,
-	})
+	q, err := sess.NextQuestion(genCtx, exp.tools.Generator, exp.state, problemgen.GenerateInput{
+		Skill:            exp.skill,
+		Tier:             tier,
+		PriorQuestions:   exp.state.PriorQuestions[exp.skill.ID],
+		RecentErrors:     recentErrors,
+		LearnerProfile:   exp.learnerProfile,
+		TargetDifficulty: band,
+		Locale:           exp.locale,
+	}, exp.totalQuestions()-exp.questionsAsked)
 	if err != nil {
 		exp.genFailures++
 		if exp.genFailures >= maxGenFailures {
@@ -492,15 +532,43 @@ func (e *expedition) questionView() *QuestionView {
 		Total:      e.totalQuestions(),
 		Text:       q.Text,
 		Format:     string(q.Format),
-		Choices:    q.Choices,
+		Choices:    q.DisplayChoices(),
+		Blanks:     q.BlankCount(),
 		AnswerType: string(q.AnswerType),
 		Tier:       sess.TierString(q.Tier),
+		Diagram:    diagramView(q.Diagram),
 	}
-	// Prove-tier questions are timed in spirit: the client shows a countdown
+	// Prove- and challenge-tier questions are timed in spirit: the client shows a countdown
 	// (speed feeds the fluency score via server-side timing; nothing is
 	// force-submitted — this is a nudge, not a guillotine).
-	if q.Tier == skillgraph.TierProve {
-		v.TimeLimitSecs = e.skill.Tiers[skillgraph.TierProve].TimeLimitSecs
+	if q.Tier != skillgraph.TierLearn {
+		v.TimeLimitSecs = e.skill.Tiers[q.Tier].TimeLimitSecs
+	}
+	return v
+}
+
+// diagramView translates a question diagram for the client, placing the
+// number-line ticks and points along the line.
+func diagramView(d *problemgen.Diagram) *DiagramView {
+	if d == nil {
+		return nil
+	}
+	v := &DiagramView{
+		Kind:     string(d.Kind),
+		Parts:    d.Parts,
+		Shaded:   d.Shaded,
+		Rows:     d.Rows,
+		Cols:     d.Cols,
+		RowParts: d.RowParts,
+		ColParts: d.ColParts,
+		Hour:     d.Hour,
+		Minute:   d.Minute,
+	}
+	for _, t := range d.Ticks() {
+		v.Ticks = append(v.Ticks, TickView{Pos: t.Pos, Label: t.Label})
+	}
+	for _, p := range d.Points {
+		v.Points = append(v.Points, d.PointPos(p))
 	}
 	return v
 }
@@ -521,6 +589,9 @@ func (m *Manager) Answer(ctx context.Context, childUID, expID, answer string) (*
 	if exp.state.CurrentQuestion == nil || exp.answered {
 		return nil, ErrNoQuestion
 	}
+	if exp.placement != nil {
+		return m.placementAnswer(ctx, exp, answer)
+	}
 
 	state := exp.state
 	q := state.CurrentQuestion
@@ -528,6 +599,10 @@ func (m *Manager) Answer(ctx context.Context, childUID, expID, answer string) (*
 
 	adv := sess.HandleAnswer(state, answer)
 	exp.answered = true
+	exp.lastAnswer = answer
+	if r, ok := exp.tools.Generator.(problemgen.AnswerRecorder); ok {
+		r.RecordAnswer(ctx, q, state.LastAnswerCorrect)
+	}
 
 	// Persist exactly what the terminal driver persists.
 	if state.MasteryTransition != nil {
@@ -553,6 +628,9 @@ func (m *Manager) Answer(ctx context.Context, childUID, expID, answer string) (*
 		Correct:       state.LastAnswerCorrect,
 		TimeMs:        timeMs,
 		AnswerFormat:  string(q.Format),
+		Source:        q.Source,
+		LLMRequestID:  q.LLMRequestID,
+		LLMOwner:      q.LLMOwner,
 	})
 
 	// Quest progress is control-plane (specs/15-quests.md): one upsert per
@@ -562,7 +640,9 @@ func (m *Manager) Answer(ctx context.Context, childUID, expID, answer string) (*
 
 	result := &AnswerResultView{
 		Correct:           state.LastAnswerCorrect,
-		CorrectAnswer:     q.Answer,
+		Grade:             string(state.LastGrade.Kind),
+		Reason:            exp.locale.T(state.LastGrade.Reason),
+		CorrectAnswer:     q.DisplayAnswer(),
 		Explanation:       q.Explanation,
 		HintAvailable:     state.HintAvailable && !state.HintShown,
 		Streak:            state.ConsecutiveCorrect,
@@ -586,6 +666,9 @@ func (m *Manager) Answer(ctx context.Context, childUID, expID, answer string) (*
 	}
 	if tr := state.MasteryTransition; tr != nil {
 		result.Mastery = &MasteryChangeView{From: string(tr.From), To: string(tr.To)}
+		if tr.Trigger == "tier-complete" {
+			result.Mastery.Tier = sess.TierString(exp.masterySvc.GetMastery(tr.SkillID).CurrentTier)
+		}
 	}
 
 	// Fog lifts: skills newly unlocked by this answer's mastery change.
@@ -680,7 +763,7 @@ func (m *Manager) AnswerLesson(ctx context.Context, childUID, expID, answer stri
 	_ = exp.eventRepo.AppendLessonEvent(ctx, lesson.EventData(exp.state.SessionID, lesson.SkillID, !skip, correct, skip))
 	return &LessonAnswerView{
 		Correct:       correct,
-		CorrectAnswer: lesson.PracticeQuestion.Answer,
+		CorrectAnswer: lesson.Locale.FormatNumbers(lesson.PracticeQuestion.Answer),
 		Explanation:   lesson.PracticeQuestion.Explanation,
 	}, nil
 }
@@ -711,6 +794,50 @@ func (m *Manager) Hint(ctx context.Context, childUID, expID string) (*HintView,
 	return &HintView{Hint: q.Hint}, nil
 }
 
+// Report flags the current question as wrong or confusing. An unanswered
+// dig question is skipped without grading and the next Question call
+// brings a fresh one in its place, up to maxReportSkips per expedition.
+// Quest and placement questions come from fixed lists, so reporting one
+// records it but leaves it to answer. Reporting the same question twice is
+// a no-op.
+func (m *Manager) Report(ctx context.Context, childUID, expID, reason string) (*ReportView, error) {
+	exp, err := m.lookup(childUID, expID)
+	if err != nil {
+		return nil, err
+	}
+	exp.mu.Lock()
+	defer exp.mu.Unlock()
+	exp.touch()
+
+	if exp.finished {
+		return nil, ErrExpeditionOver
+	}
+	if exp.state.CurrentQuestion == nil {
+		return nil, ErrNoQuestion
+	}
+	if exp.reported {
+		return &ReportView{}, nil
+	}
+
+	learnerAnswer := ""
+	if exp.answered {
+		learnerAnswer = exp.lastAnswer
+	}
+	if err := sess.ReportQuestion(ctx, exp.eventRepo, exp.state, learnerAnswer, reason); err != nil {
+		return nil, err
+	}
+	exp.reported = true
+
+	view := &ReportView{}
+	if !exp.answered && exp.quest == nil && exp.placement == nil && exp.reportSkips < maxReportSkips {
+		exp.answered = true
+		exp.questionsAsked--
+		exp.reportSkips++
+		view.Skipped = true
+	}
+	return view, nil
+}
+
 // End finishes an expedition early (kid sails home).
 func (m *Manager) End(ctx context.Context, childUID, expID string) (*SummaryView, error) {
 	exp, err := m.lookup(childUID, expID)
@@ -723,6 +850,23 @@ func (m *Manager) End(ctx context.Context, childUID, expID string) (*SummaryView
 
 // ---- internal plumbing ----
 
+// locale returns the child's language through the Locale hook.
+func (m *Manager) locale(ctx context.Context, childUID string) i18n.Locale {
+	if m.cfg.Locale == nil {
+		return i18n.DefaultLocale
+	}
+	return m.cfg.Locale(ctx, childUID)
+}
+
+// grade returns the child's school grade through the Grade hook, or nil
+// when it is unknown.
+func (m *Manager) grade(ctx context.Context, childUID string) *int {
+	if m.cfg.Grade == nil {
+		return nil
+	}
+	return m.cfg.Grade(ctx, childUID)
+}
+
 func (m *Manager) remove(exp *expedition) {
 	m.mu.Lock()
 	m.removeLocked(exp)
@@ -777,18 +921,29 @@ func (e *expedition) finishLocked(ctx context.Context, completed bool) *SummaryV
 		QuestionsServed: state.TotalQuestions,
 		CorrectAnswers:  state.TotalCorrect,
 		DurationSecs:    int(time.Since(state.StartTime).Seconds()),
+		QuestionSources: state.QuestionSources,
 	})
 
-	if completed && state.TotalQuestions > 0 {
+	if completed && state.TotalQuestions > 0 && e.placement == nil {
 		accuracy := float64(state.TotalCorrect) / float64(state.TotalQuestions)
 		// Awarded for its side effect; summaryLocked reads SessionGems.
 		e.gemSvc.AwardSession(ctx, accuracy, state.SessionID)
 	}
 
-	e.saveSnapshot(ctx)
+	if e.placement != nil {
+		e.savePlacement(ctx)
+	} else {
+		e.saveSnapshot(ctx)
+	}
 
-	if e.tools != nil && e.tools.Diagnosis != nil {
-		e.tools.Diagnosis.Close()
+	if e.tools != nil {
+		if st, ok := problemgen.GeneratorStats(e.tools.Generator); ok {
+			slog.Info("game: question sources", "child_uid", e.childUID,
+				"llm_served", st.Primary.Served, "llm_failures", st.Primary.Failures,
+				"offline_served", st.Fallback.Served, "fallbacks", st.Fallbacks,
+				"prefetch_hits", st.PrefetchHits, "prefetch_misses", st.PrefetchMisses)
+		}
+		e.tools.Close()
 	}
 	// Free the cross-surface play slot only now, after the final snapshot
 	// save — earlier would reopen the concurrent-write window.
@@ -813,6 +968,9 @@ func (e *expedition) summaryLocked() *SummaryView {
 		s.QuestID = e.quest.uid
 		s.QuestComplete = e.quest.complete
 	}
+	if e.placement != nil && e.placement.result != nil {
+		s.PlacedSkills = len(e.placement.result.MasteredSkillIDs)
+	}
 	for _, g := range e.gemSvc.SessionGems {
 		s.Gems = append(s.Gems, GemAwardView{Type: string(g.Type), Rarity: string(g.Rarity), Reason: g.Reason})
 	}
@@ -823,7 +981,7 @@ func (e *expedition) summaryLocked() *SummaryView {
 // end-of-session path (sess.SaveSnapshotWithProfile) — the same code the
 // terminal session screen runs.
 func (e *expedition) saveSnapshot(ctx context.Context) {
-	snapData := store.SnapshotData{Version: 4}
+	snapData := store.SnapshotData{Version: 4, GraphVersion: skillgraph.GraphVersion()}
 	snapData.Mastery = e.masterySvc.SnapshotData()
 	snapData.SpacedRep = e.scheduler.SnapshotData()
 	snapData.Gems = e.gemSvc.SnapshotData(ctx)
@@ -833,6 +991,8 @@ func (e *expedition) saveSnapshot(ctx context.Context) {
 	// data the expedition loaded, not the services' in-memory state.
 	if e.quest != nil && !e.quest.tagged {
 		if e.origSnap != nil {
+			// Saved as loaded, so still against the loaded graph version.
+			snapData.GraphVersion = e.origSnap.GraphVersion
 			snapData.Mastery = e.origSnap.Mastery
 			snapData.SpacedRep = e.origSnap.SpacedRep
 			// Legacy (pre-Mastery) snapshots keep their migration fields —
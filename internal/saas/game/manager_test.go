@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/lessons"
 	"github.com/abhisek/mathiz/internal/llm"
 	"github.com/abhisek/mathiz/internal/problemgen"
@@ -28,8 +29,9 @@ func rootSkillID(t *testing.T) string {
 
 // fakeGenerator returns deterministic questions; the answer is always "4".
 type fakeGenerator struct {
-	calls int
-	fail  bool
+	calls   int
+	batches int
+	fail    bool
 }
 
 func (f *fakeGenerator) Generate(_ context.Context, input problemgen.GenerateInput) (*problemgen.Question, error) {
@@ -43,12 +45,18 @@ func (f *fakeGenerator) Generate(_ context.Context, input problemgen.GenerateInp
 		Answer:      "4",
 		AnswerType:  problemgen.AnswerTypeInteger,
 		Hint:        "Count on your fingers!",
+		Difficulty:  2,
 		Explanation: "2 and 2 together make 4.",
 		SkillID:     input.Skill.ID,
 		Tier:        input.Tier,
 	}, nil
 }
 
+func (f *fakeGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	f.batches++
+	return problemgen.GenerateEach(ctx, f, input, n)
+}
+
 func newTestManager(t *testing.T, gen problemgen.Generator) *Manager {
 	t.Helper()
 	st, err := store.Open("file::memory:?cache=shared")
@@ -79,7 +87,8 @@ func answerCurrent(t *testing.T, m *Manager, child, expID, answer string) *Answe
 }
 
 func TestExpeditionHappyPath(t *testing.T) {
-	m := newTestManager(t, &fakeGenerator{})
+	gen := &fakeGenerator{}
+	m := newTestManager(t, gen)
 	ctx := context.Background()
 	root := rootSkillID(t)
 
@@ -104,6 +113,10 @@ func TestExpeditionHappyPath(t *testing.T) {
 	if !last.Done || last.Summary == nil {
 		t.Fatalf("expedition should be done: %+v", last)
 	}
+	// All five questions came from the batch generated for the first.
+	if gen.batches != 1 || gen.calls != QuestionsPerExpedition {
+		t.Errorf("batches = %d, calls = %d; want 1 batch of %d", gen.batches, gen.calls, QuestionsPerExpedition)
+	}
 	if last.Summary.Questions != 5 || last.Summary.Correct != 5 {
 		t.Errorf("summary = %+v", last.Summary)
 	}
@@ -128,7 +141,7 @@ func TestExpeditionHappyPath(t *testing.T) {
 	}
 
 	// Progress persisted: the map shows the root as digging with progress.
-	mv, err := m.Map(ctx, "child-1")
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -176,7 +189,7 @@ func TestMasteryOpensChestAndLiftsFog(t *testing.T) {
 	}
 
 	// Map: chest open, dependents ready.
-	mv, err := m.Map(ctx, "child-1")
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -252,6 +265,96 @@ func TestWrongAnswerFlow(t *testing.T) {
 	}
 }
 
+func TestReportQuestion(t *testing.T) {
+	m := newTestManager(t, &fakeGenerator{})
+	ctx := context.Background()
+	root := rootSkillID(t)
+
+	exp, err := m.Start(ctx, "child-1", root)
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	q1, err := m.Question(ctx, "child-1", exp.ID)
+	if err != nil {
+		t.Fatalf("question: %v", err)
+	}
+
+	// Reported before answering: skipped, and replaced by a fresh question
+	// in the same position.
+	rep, err := m.Report(ctx, "child-1", exp.ID, "the numbers don't add up")
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	if !rep.Skipped {
+		t.Error("unanswered question should be skipped")
+	}
+	if _, err := m.Answer(ctx, "child-1", exp.ID, "4"); !errors.Is(err, ErrNoQuestion) {
+		t.Errorf("answering a skipped question: got %v", err)
+	}
+	q2, err := m.Question(ctx, "child-1", exp.ID)
+	if err != nil {
+		t.Fatalf("question after report: %v", err)
+	}
+	if q2.Text == q1.Text || q2.Index != q1.Index {
+		t.Errorf("replacement = %q #%d, reported %q #%d", q2.Text, q2.Index, q1.Text, q1.Index)
+	}
+
+	// Reported after answering: kept, with the answer, and only once.
+	if _, err := m.Answer(ctx, "child-1", exp.ID, "7"); err != nil {
+		t.Fatalf("answer: %v", err)
+	}
+	for range 2 {
+		rep, err = m.Report(ctx, "child-1", exp.ID, "")
+		if err != nil {
+			t.Fatalf("report answered: %v", err)
+		}
+		if rep.Skipped {
+			t.Error("answered question should not be skipped")
+		}
+	}
+
+	reports, err := m.cfg.Store.EventRepoFor("child-1").QueryQuestionReports(ctx, store.QueryOpts{})
+	if err != nil {
+		t.Fatalf("query reports: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("reports = %d, want 2", len(reports))
+	}
+	// Newest first.
+	if r := reports[1]; r.QuestionText != q1.Text || r.LearnerAnswer != "" || r.Reason != "the numbers don't add up" || r.Reporter != store.ReporterLearner {
+		t.Errorf("skipped report = %+v", r)
+	}
+	if r := reports[0]; r.QuestionText != q2.Text || r.LearnerAnswer != "7" || r.SkillID != root {
+		t.Errorf("answered report = %+v", r)
+	}
+}
+
+func TestReportQuestion_CapsSkips(t *testing.T) {
+	m := newTestManager(t, &fakeGenerator{})
+	ctx := context.Background()
+
+	exp, err := m.Start(ctx, "child-1", rootSkillID(t))
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	for i := range maxReportSkips + 1 {
+		if _, err := m.Question(ctx, "child-1", exp.ID); err != nil {
+			t.Fatalf("question %d: %v", i, err)
+		}
+		rep, err := m.Report(ctx, "child-1", exp.ID, "")
+		if err != nil {
+			t.Fatalf("report %d: %v", i, err)
+		}
+		if want := i < maxReportSkips; rep.Skipped != want {
+			t.Errorf("report %d: Skipped = %v, want %v", i, rep.Skipped, want)
+		}
+	}
+	// Past the cap the reported question is still there to answer.
+	if _, err := m.Answer(ctx, "child-1", exp.ID, "4"); err != nil {
+		t.Errorf("answering past the skip cap: %v", err)
+	}
+}
+
 func TestMicroLessonFlow(t *testing.T) {
 	// A lessons service backed by the mock LLM provider delivers one lesson.
 	lessonJSON := `{
@@ -407,7 +510,7 @@ func TestStartReplacesActiveExpedition(t *testing.T) {
 		t.Errorf("first expedition still live: %v", err)
 	}
 	// Its answer survived into mastery progress.
-	mv, err := m.Map(ctx, "child-1")
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -485,7 +588,7 @@ func TestExpeditionChargeGate(t *testing.T) {
 
 func TestFreshMapState(t *testing.T) {
 	m := newTestManager(t, &fakeGenerator{})
-	mv, err := m.Map(context.Background(), "child-new")
+	mv, err := m.Map(context.Background(), "child-new", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -695,3 +798,56 @@ func TestExpeditionProfileRefreshVersionsProfile(t *testing.T) {
 		time.Sleep(20 * time.Millisecond)
 	}
 }
+
+// decimalGenerator asks for 2.5, in the requested locale.
+type decimalGenerator struct{ locales []i18n.Locale }
+
+func (g *decimalGenerator) Generate(_ context.Context, input problemgen.GenerateInput) (*problemgen.Question, error) {
+	g.locales = append(g.locales, input.Locale)
+	return &problemgen.Question{
+		Text:       fmt.Sprintf("¿Cuánto es 1,5 + 1? (v%d)", len(g.locales)),
+		Format:     problemgen.FormatNumeric,
+		Answer:     "2.5",
+		AnswerType: problemgen.AnswerTypeDecimal,
+		SkillID:    input.Skill.ID,
+		Tier:       input.Tier,
+		Locale:     input.Locale,
+	}, nil
+}
+
+func (g *decimalGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	return problemgen.GenerateEach(ctx, g, input, n)
+}
+
+func TestChildLocale(t *testing.T) {
+	gen := &decimalGenerator{}
+	m := newTestManager(t, gen)
+	m.cfg.Locale = func(_ context.Context, childUID string) i18n.Locale {
+		return i18n.Spanish
+	}
+	ctx := context.Background()
+
+	exp, err := m.Start(ctx, "child-1", rootSkillID(t))
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if res := answerCurrent(t, m, "child-1", exp.ID, "2,5"); !res.Correct {
+		t.Errorf("decimal comma graded wrong: %+v", res)
+	}
+	res := answerCurrent(t, m, "child-1", exp.ID, "3")
+	if res.Correct || res.CorrectAnswer != "2,5" {
+		t.Errorf("feedback = %+v, want correct answer 2,5", res)
+	}
+	for _, l := range gen.locales {
+		if l != i18n.Spanish {
+			t.Fatalf("generated for locale %q, want es", l)
+		}
+	}
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+	if mv.Locale != i18n.Spanish {
+		t.Errorf("map locale = %q, want es", mv.Locale)
+	}
+}
@@ -6,17 +6,19 @@ import (
 	"time"
 
 	"github.com/abhisek/mathiz/internal/mastery"
+	sess "github.com/abhisek/mathiz/internal/session"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 	"github.com/abhisek/mathiz/internal/spacedrep"
 	"github.com/abhisek/mathiz/internal/store"
 )
 
-// Map builds the full treasure-map view for a child. It is strictly
+// Map builds the full treasure-map view for a child, labelling spots with
+// their codes in the child's curriculum standard. It is strictly
 // read-only: mastery and spaced-rep services are constructed with nil event
 // repos so the decay check can't persist anything from a map render — decay
 // transitions are persisted when an expedition starts, like session start
 // in the terminal app.
-func (m *Manager) Map(ctx context.Context, childUID string) (*MapView, error) {
+func (m *Manager) Map(ctx context.Context, childUID string, std skillgraph.Standard) (*MapView, error) {
 	snapRepo := m.cfg.Store.SnapshotRepoFor(childUID)
 	snap, err := snapRepo.Latest(ctx)
 	if err != nil {
@@ -37,14 +39,14 @@ func (m *Manager) Map(ctx context.Context, childUID string) (*MapView, error) {
 		due[id] = true
 	}
 
-	view := &MapView{}
+	view := &MapView{PlacementOffered: snap == nil, Locale: m.locale(ctx, childUID)}
 	for _, strand := range skillgraph.AllStrands() {
 		island := IslandView{
 			ID:   string(strand),
 			Name: skillgraph.StrandDisplayName(strand),
 		}
 		for _, skill := range skillgraph.ByStrand(strand) {
-			island.Spots = append(island.Spots, spotView(skill, masterySvc, mastered, due))
+			island.Spots = append(island.Spots, spotView(skill, std, masterySvc, mastered, due))
 		}
 		view.Islands = append(view.Islands, island)
 	}
@@ -106,7 +108,7 @@ func (m *Manager) Notebook(ctx context.Context, childUID string) (*NotebookView,
 	return view, nil
 }
 
-func spotView(skill skillgraph.Skill, svc *mastery.Service, mastered, due map[string]bool) SpotView {
+func spotView(skill skillgraph.Skill, std skillgraph.Standard, svc *mastery.Service, mastered, due map[string]bool) SpotView {
 	sm := svc.GetMastery(skill.ID)
 
 	spot := SpotView{
@@ -115,6 +117,7 @@ func spotView(skill skillgraph.Skill, svc *mastery.Service, mastered, due map[st
 		Description:   skill.Description,
 		Grade:         skill.GradeLevel,
 		Prerequisites: skill.Prerequisites,
+		Code:          skill.Code(std),
 		ReviewDue:     due[skill.ID],
 	}
 
@@ -126,17 +129,21 @@ func spotView(skill skillgraph.Skill, svc *mastery.Service, mastered, due map[st
 			spot.State = "treasure"
 		}
 		spot.Progress = 1
+		spot.ShakyFoundation = spacedrep.ShakyFoundation(svc, skill.ID)
 	case mastery.StateRusty:
 		spot.State = "sinking"
 		spot.Progress = 1
 	case mastery.StateLearning:
-		if sm.CurrentTier == skillgraph.TierProve {
+		spot.Tier = sess.TierString(sm.CurrentTier)
+		if sm.CurrentTier != skillgraph.TierLearn {
 			spot.State = "proving"
 		} else {
 			spot.State = "digging"
 		}
 		cfg := skill.Tiers[sm.CurrentTier]
-		if cfg.ProblemsRequired > 0 {
+		if svc.Model().Kind() != mastery.ModelRules {
+			spot.Progress = svc.TierProgress(skill.ID, cfg)
+		} else if cfg.ProblemsRequired > 0 {
 			spot.Progress = min(1, float64(sm.TotalAttempts)/float64(cfg.ProblemsRequired))
 		}
 	default: // StateNew
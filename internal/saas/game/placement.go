@@ -0,0 +1,269 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/placement"
+	sess "github.com/abhisek/mathiz/internal/session"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/spacedrep"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// Placement on the map (specs/03-skill-graph.md): a child's first
+// expedition can be the diagnostic placement quiz instead of a dig. It rides
+// the SAME expedition machinery — play slot, charge, question/answer/end
+// endpoints — but the skill for each question comes from the adaptive
+// placement.Quiz, answers only steer the quiz (no mastery, gems, hints or
+// lessons), and the finish saves the placement result instead of the
+// session's snapshot.
+
+// ErrPlacementDone means the child already has progress (or already took or
+// skipped placement) — it is offered once.
+var ErrPlacementDone = errors.New("your map is already charted")
+
+// placementSkill stands in for the plan's skill: placement hops between
+// skills per question, so the plan carries no real one.
+var placementSkill = skillgraph.Skill{ID: "", Name: "Placement quiz"}
+
+// placementRun is the placement-specific state carried by a placement
+// expedition.
+type placementRun struct {
+	quiz   *placement.Quiz
+	result *skillgraph.DiagnosticResult // set once saved
+}
+
+// StartPlacement begins a placement expedition, mirroring Start: one start
+// at a time per child, double-click reuse, cross-surface play slot, and the
+// same 1-credit charge keyed by the session ID.
+func (m *Manager) StartPlacement(ctx context.Context, childUID string) (*ExpeditionView, error) {
+	start := m.childStartLock(childUID)
+	start.Lock()
+	defer start.Unlock()
+
+	m.reapIdle(ctx)
+
+	m.mu.Lock()
+	prev := m.byChild[childUID]
+	m.mu.Unlock()
+	if prev != nil {
+		prev.mu.Lock()
+		reuse := !prev.finished && prev.placement != nil && prev.questionsAsked == 0
+		var view *ExpeditionView
+		if reuse {
+			prev.touch()
+			view = prev.expeditionView()
+		}
+		prev.mu.Unlock()
+		if reuse {
+			return view, nil
+		}
+		m.remove(prev)
+		prev.finish(ctx, false)
+	}
+
+	eventRepo := m.cfg.Store.EventRepoFor(childUID)
+	snapRepo := m.cfg.Store.SnapshotRepoFor(childUID)
+
+	needed, err := placement.Needed(ctx, snapRepo)
+	if err != nil {
+		return nil, err
+	}
+	if !needed {
+		return nil, ErrPlacementDone
+	}
+
+	releaseSlot, err := m.cfg.Slots.Acquire(childUID, "the treasure map")
+	if err != nil {
+		return nil, ErrElsewhere
+	}
+	registered := false
+	defer func() {
+		if !registered {
+			releaseSlot()
+		}
+	}()
+
+	tools, err := m.cfg.Toolset(ctx, eventRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID := uuid.NewString()
+	if m.cfg.Charge != nil {
+		if err := m.cfg.Charge(ctx, childUID, sessionID); err != nil {
+			tools.Close()
+			return nil, err
+		}
+	}
+
+	// The session state only carries the bookkeeping the shared plumbing
+	// reads (session ID, current question, counters); grading goes through
+	// the quiz. Mastery and spaced-rep run over nil event repos so nothing
+	// can leak from them — placement persists through placement.Save.
+	masterySvc := mastery.NewService(nil, nil)
+	scheduler := spacedrep.NewScheduler(nil, masterySvc, nil)
+	plan := &sess.Plan{
+		Slots: []sess.PlanSlot{{
+			Skill:    placementSkill,
+			Tier:     skillgraph.TierLearn,
+			Category: placement.Category,
+		}},
+		Duration: sess.DefaultSessionDuration,
+	}
+	state := sess.NewSessionState(plan, sessionID, map[string]bool{}, map[string]*sess.TierProgress{})
+
+	_ = eventRepo.AppendSessionEvent(ctx, store.SessionEventData{
+		SessionID: sessionID,
+		Action:    "start",
+		PlanSummary: []store.PlanSlotSummaryData{{
+			Tier:     sess.TierString(skillgraph.TierLearn),
+			Category: placement.Category,
+		}},
+	})
+
+	quiz := placement.NewQuiz(tools.Generator, skillgraph.DiagnosticConfig{MaxGrade: m.grade(ctx, childUID)})
+	quiz.Locale = m.locale(ctx, childUID)
+	exp := &expedition{
+		id:          uuid.NewString(),
+		childUID:    childUID,
+		skill:       placementSkill,
+		category:    placement.Category,
+		locale:      quiz.Locale,
+		placement:   &placementRun{quiz: quiz},
+		state:       state,
+		masterySvc:  masterySvc,
+		scheduler:   scheduler,
+		gemSvc:      gems.NewService(eventRepo),
+		tools:       tools,
+		eventRepo:   eventRepo,
+		snapRepo:    snapRepo,
+		releaseSlot: releaseSlot,
+	}
+	exp.touch()
+
+	view := exp.expeditionView()
+
+	m.mu.Lock()
+	m.byID[exp.id] = exp
+	m.byChild[childUID] = exp
+	m.mu.Unlock()
+	registered = true
+
+	return view, nil
+}
+
+// SkipPlacement records that the child chose to start from the beginning,
+// so the map stops offering placement. It is free — nothing is generated.
+func (m *Manager) SkipPlacement(ctx context.Context, childUID string) error {
+	start := m.childStartLock(childUID)
+	start.Lock()
+	defer start.Unlock()
+
+	snapRepo := m.cfg.Store.SnapshotRepoFor(childUID)
+	needed, err := placement.Needed(ctx, snapRepo)
+	if err != nil {
+		return err
+	}
+	if !needed {
+		return ErrPlacementDone
+	}
+
+	// Hold the play slot across the save: another surface must not be
+	// writing the first snapshot at the same time.
+	releaseSlot, err := m.cfg.Slots.Acquire(childUID, "the treasure map")
+	if err != nil {
+		return ErrElsewhere
+	}
+	defer releaseSlot()
+	return placement.Save(ctx, m.cfg.Store.EventRepoFor(childUID), snapRepo, skillgraph.DiagnosticResult{}, uuid.NewString())
+}
+
+// placementQuestion serves the next placement question. Caller holds e.mu.
+func (m *Manager) placementQuestion(ctx context.Context, exp *expedition) (*QuestionView, error) {
+	genCtx, cancel := context.WithTimeout(ctx, questionGenBudget)
+	defer cancel()
+
+	q, err := exp.placement.quiz.Next(genCtx)
+	if err != nil {
+		exp.genFailures++
+		if exp.genFailures >= maxGenFailures {
+			m.remove(exp)
+			exp.finishLocked(ctx, false)
+		}
+		return nil, ErrGeneration
+	}
+	exp.genFailures = 0
+	if q == nil {
+		return nil, ErrExpeditionOver
+	}
+
+	exp.state.CurrentQuestion = q
+	exp.state.QuestionStartTime = time.Now()
+	exp.answered = false
+	exp.questionsAsked++
+	return exp.questionView(), nil
+}
+
+// placementAnswer grades a placement answer. Placement is a check-up, not a
+// lesson: the result carries no reveal, hint, gem or mastery change. Caller
+// holds e.mu.
+func (m *Manager) placementAnswer(ctx context.Context, exp *expedition, answer string) (*AnswerResultView, error) {
+	state := exp.state
+	q := state.CurrentQuestion
+	correct, err := exp.placement.quiz.Answer(answer)
+	if err != nil {
+		return nil, ErrNoQuestion
+	}
+	exp.answered = true
+	state.TotalQuestions++
+	if correct {
+		state.TotalCorrect++
+	}
+
+	_ = exp.eventRepo.AppendAnswerEvent(ctx, store.AnswerEventData{
+		SessionID:     state.SessionID,
+		SkillID:       q.SkillID,
+		Tier:          sess.TierString(q.Tier),
+		Category:      placement.Category,
+		QuestionText:  q.Text,
+		CorrectAnswer: q.Answer,
+		LearnerAnswer: answer,
+		Correct:       correct,
+		TimeMs:        int(time.Since(state.QuestionStartTime).Milliseconds()),
+		AnswerFormat:  string(q.Format),
+		Source:        q.Source,
+		LLMRequestID:  q.LLMRequestID,
+		LLMOwner:      q.LLMOwner,
+	})
+
+	result := &AnswerResultView{
+		Correct:           correct,
+		QuestionsAnswered: exp.questionsAsked,
+		TotalQuestions:    exp.totalQuestions(),
+	}
+	if exp.placement.quiz.Done() {
+		m.remove(exp)
+		result.Done = true
+		result.Summary = exp.finishLocked(ctx, true)
+	}
+	return result, nil
+}
+
+// savePlacement persists the placement — whatever the child finished, so
+// ending early still places them on the completed probes. Caller holds e.mu.
+func (e *expedition) savePlacement(ctx context.Context) {
+	res := e.placement.quiz.Result()
+	if err := placement.Save(ctx, e.eventRepo, e.snapRepo, res, e.state.SessionID); err != nil {
+		slog.Error("game: save placement", "child_uid", e.childUID, "err", err)
+		return
+	}
+	e.placement.result = &res
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+func TestPlacementPlacesChild(t *testing.T) {
+	m := newTestManager(t, &fakeGenerator{})
+	ctx := context.Background()
+	child := "child-placement"
+
+	mv, err := m.Map(ctx, child, skillgraph.DefaultStandard)
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+	if !mv.PlacementOffered {
+		t.Fatal("a fresh child should be offered placement")
+	}
+
+	exp, err := m.StartPlacement(ctx, child)
+	if err != nil {
+		t.Fatalf("start placement: %v", err)
+	}
+	if !exp.Placement || exp.TotalQuestions != skillgraph.DefaultDiagnosticQuestions {
+		t.Errorf("expedition = %+v", exp)
+	}
+
+	// Every answer is right: the quiz should place the child on the whole graph.
+	var last *AnswerResultView
+	for i := 0; i < exp.TotalQuestions; i++ {
+		last = answerCurrent(t, m, child, exp.ID, "4")
+		if last.CorrectAnswer != "" || last.Gem != nil || last.Mastery != nil {
+			t.Fatalf("placement answers must not reveal or reward: %+v", last)
+		}
+		if last.Done {
+			break
+		}
+	}
+	if last == nil || !last.Done || last.Summary == nil {
+		t.Fatalf("placement should be done: %+v", last)
+	}
+	if last.Summary.PlacedSkills != len(skillgraph.AllSkills()) {
+		t.Errorf("placed %d skills, want %d", last.Summary.PlacedSkills, len(skillgraph.AllSkills()))
+	}
+
+	mv, err = m.Map(ctx, child, skillgraph.DefaultStandard)
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+	if mv.PlacementOffered {
+		t.Error("placement still offered after taking it")
+	}
+	if spot := findSpot(t, mv, rootSkillID(t)); spot.State != "treasure" {
+		t.Errorf("root spot after placement = %+v, want treasure", spot)
+	}
+	if _, err := m.StartPlacement(ctx, child); !errors.Is(err, ErrPlacementDone) {
+		t.Errorf("second placement: got %v, want ErrPlacementDone", err)
+	}
+}
+
+func TestPlacementSkip(t *testing.T) {
+	m := newTestManager(t, &fakeGenerator{})
+	ctx := context.Background()
+	child := "child-placement-skip"
+
+	if err := m.SkipPlacement(ctx, child); err != nil {
+		t.Fatalf("skip: %v", err)
+	}
+	mv, err := m.Map(ctx, child, skillgraph.DefaultStandard)
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+	if mv.PlacementOffered {
+		t.Error("placement still offered after skipping")
+	}
+	if spot := findSpot(t, mv, rootSkillID(t)); spot.State != "ready" {
+		t.Errorf("root spot after skip = %+v, want ready", spot)
+	}
+	if err := m.SkipPlacement(ctx, child); !errors.Is(err, ErrPlacementDone) {
+		t.Errorf("second skip: got %v, want ErrPlacementDone", err)
+	}
+}
+
+func TestPlacementStopsAtChildGrade(t *testing.T) {
+	m := newTestManager(t, &fakeGenerator{})
+	grade := 1
+	m.cfg.Grade = func(context.Context, string) *int { return &grade }
+	ctx := context.Background()
+	child := "child-placement-grade"
+
+	exp, err := m.StartPlacement(ctx, child)
+	if err != nil {
+		t.Fatalf("start placement: %v", err)
+	}
+	var last *AnswerResultView
+	for i := 0; i < exp.TotalQuestions; i++ {
+		if last = answerCurrent(t, m, child, exp.ID, "4"); last.Done {
+			break
+		}
+	}
+	if last == nil || last.Summary == nil {
+		t.Fatalf("placement should be done: %+v", last)
+	}
+	if n := last.Summary.PlacedSkills; n == 0 || n >= len(skillgraph.AllSkills()) {
+		t.Errorf("placed %d skills, want some but not the whole graph for a grade 1 child", n)
+	}
+}
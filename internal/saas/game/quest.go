@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -100,12 +101,12 @@ func (g *questGenerator) Generate(_ context.Context, input problemgen.GenerateIn
 	}
 	qq := g.questions[g.next]
 	g.next++
-	return &problemgen.Question{
+	q := &problemgen.Question{
 		Text:        qq.Text,
 		Format:      problemgen.AnswerFormat(qq.Format),
 		Answer:      qq.Answer,
 		AnswerType:  problemgen.AnswerType(qq.AnswerType),
-		Choices:     qq.Choices,
+		Choices:     slices.Clone(qq.Choices),
 		Hint:        qq.Hint,
 		Difficulty:  3,
 		Explanation: qq.Explanation,
@@ -114,7 +115,22 @@ func (g *questGenerator) Generate(_ context.Context, input problemgen.GenerateIn
 		// skill graph, so the session engine skips mastery/spaced-rep for it.
 		SkillID: input.Skill.ID,
 		Tier:    input.Tier,
-	}, nil
+		Locale:  input.Locale,
+	}
+	// Parents enter ordering items in the correct order; serve them, like
+	// every choice list, shuffled so the layout never gives the answer away.
+	q.ShuffleChoices()
+	return q, nil
+}
+
+// GenerateBatch serves one authored question at a time: a batch dropped
+// after a mistake would skip the rest of it.
+func (g *questGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, _ int) ([]*problemgen.Question, error) {
+	q, err := g.Generate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return []*problemgen.Question{q}, nil
 }
 
 // StartQuest begins a quest expedition, mirroring Start: one start at a time
@@ -261,9 +277,7 @@ func (m *Manager) StartQuest(ctx context.Context, childUID, questUID string) (*E
 	sessionID := uuid.NewString()
 	if m.cfg.Charge != nil {
 		if err := m.cfg.Charge(ctx, childUID, sessionID); err != nil {
-			if tools.Diagnosis != nil {
-				tools.Diagnosis.Close()
-			}
+			tools.Close()
 			return nil, err
 		}
 	}
@@ -329,6 +343,7 @@ func (m *Manager) StartQuest(ctx context.Context, childUID, questUID string) (*E
 		childUID:       childUID,
 		skill:          skill,
 		category:       category,
+		locale:         m.locale(ctx, childUID),
 		quest:          quest,
 		origSnap:       snapData,
 		state:          state,
@@ -374,6 +389,7 @@ func (e *expedition) expeditionView() *ExpeditionView {
 			v.SkillID = ""
 		}
 	}
+	v.Placement = e.placement != nil
 	return v
 }
 
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 	"github.com/abhisek/mathiz/internal/store"
 )
 
@@ -179,6 +183,25 @@ func TestQuestExpeditionServesAuthoredQuestionsInChunks(t *testing.T) {
 	}
 }
 
+func TestQuestGeneratorShufflesAuthoredOrder(t *testing.T) {
+	items := []string{"1/8", "1/4", "3/8", "1/2", "3/4", "7/8"}
+	g := &questGenerator{questions: []QuestPlayQuestion{{
+		UID: "q1", Text: "Order from least to greatest", Format: "ordering",
+		Answer: strings.Join(items, ", "), Choices: slices.Clone(items),
+	}}}
+
+	q, err := g.Generate(context.Background(), problemgen.GenerateInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Equal(q.Choices, items) {
+		t.Errorf("Choices = %v, served in the authored (correct) order", q.Choices)
+	}
+	if !slices.Equal(g.questions[0].Choices, items) {
+		t.Errorf("authored choices = %v, want them left untouched", g.questions[0].Choices)
+	}
+}
+
 func TestQuestWrongAnswerKeepsQuestionInPlay(t *testing.T) {
 	src := newFakeQuestSource("", 2)
 	m := newQuestTestManager(t, src)
@@ -372,7 +395,7 @@ func TestUntaggedQuestLeavesGraphUntouched(t *testing.T) {
 
 	// The skill graph is untouched: the map looks factory-fresh apart from
 	// the gems earned.
-	mv, err := m.Map(ctx, "child-1")
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -409,7 +432,7 @@ func TestTaggedQuestAdvancesMastery(t *testing.T) {
 	}
 
 	// Quest practice pushed the main map forward.
-	mv, err := m.Map(ctx, "child-1")
+	mv, err := m.Map(ctx, "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -426,7 +449,7 @@ func TestMapListsActiveQuests(t *testing.T) {
 	}}
 	m := newQuestTestManager(t, src)
 
-	mv, err := m.Map(context.Background(), "child-1")
+	mv, err := m.Map(context.Background(), "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map: %v", err)
 	}
@@ -436,7 +459,7 @@ func TestMapListsActiveQuests(t *testing.T) {
 
 	// Without a quest source the map simply has no quests.
 	m2 := newTestManager(t, &fakeGenerator{})
-	mv2, err := m2.Map(context.Background(), "child-1")
+	mv2, err := m2.Map(context.Background(), "child-1", skillgraph.DefaultStandard)
 	if err != nil {
 		t.Fatalf("map without quests: %v", err)
 	}
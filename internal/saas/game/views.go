@@ -4,6 +4,8 @@ package game
 // language (islands, digging, chests); the engine's mastery/tier vocabulary
 // is translated here and nowhere else.
 
+import "github.com/abhisek/mathiz/internal/i18n"
+
 // MapView is the full map state for a child.
 type MapView struct {
 	Islands []IslandView `json:"islands"`
@@ -12,6 +14,14 @@ type MapView struct {
 	// Quests are the active parent-authored quests targeted at this child,
 	// with progress (specs/15-quests.md). Absent when quests are disabled.
 	Quests []QuestMapItem `json:"quests,omitempty"`
+
+	// PlacementOffered means the child has no progress yet: the client
+	// offers the placement quiz (or a skip) before the first dig.
+	PlacementOffered bool `json:"placementOffered,omitempty"`
+
+	// Locale is the child's language; the client shows its own strings in
+	// it.
+	Locale i18n.Locale `json:"locale"`
 }
 
 // IslandView is one strand rendered as an island.
@@ -29,11 +39,15 @@ type SpotView struct {
 	Grade         int      `json:"grade"`
 	Prerequisites []string `json:"prerequisites"`
 
+	// Code is the skill's code in the child's curriculum standard (e.g.
+	// "3.NF.A.1"); empty when the skill isn't mapped to it.
+	Code string `json:"code,omitempty"`
+
 	// State: "locked" | "ready" | "digging" | "proving" | "treasure" | "sinking"
 	// locked   = fog (prerequisites unmet)
 	// ready    = unlocked, never attempted
 	// digging  = learn tier in progress
-	// proving  = prove tier in progress
+	// proving  = prove or challenge tier in progress (see Tier)
 	// treasure = mastered (chest open)
 	// sinking  = rusty or review due — go back!
 	State string `json:"state"`
@@ -41,8 +55,15 @@ type SpotView struct {
 	// Progress is 0..1 within the current tier (digging/proving).
 	Progress float64 `json:"progress"`
 
+	// Tier is the tier in progress for digging/proving spots:
+	// "learn" | "prove" | "challenge".
+	Tier string `json:"tier,omitempty"`
+
 	// ReviewDue marks a mastered spot whose treasure needs re-securing.
 	ReviewDue bool `json:"reviewDue"`
+	// ShakyFoundation lists the rusty prerequisites of a mastered spot when
+	// decay propagation is on: its treasure rests on crumbling ground.
+	ShakyFoundation []string `json:"shakyFoundation,omitempty"`
 }
 
 // GemsView is the child's gem collection.
@@ -57,29 +78,65 @@ type ExpeditionView struct {
 	SkillID        string `json:"skillId"`
 	SkillName      string `json:"skillName"`
 	TotalQuestions int    `json:"totalQuestions"`
-	Tier           string `json:"tier"`     // "learn" | "prove"
+	Tier           string `json:"tier"`     // "learn" | "prove" | "challenge"
 	Category       string `json:"category"` // "frontier" | "review" | "booster"
 
 	// QuestID is set for quest expeditions; SkillName then carries the
 	// quest name (SkillID is empty for untagged quests).
 	QuestID string `json:"questId,omitempty"`
+
+	// Placement marks the placement quiz; TotalQuestions is then an upper
+	// bound and answers are not revealed.
+	Placement bool `json:"placement,omitempty"`
 }
 
-// QuestionView is one question presented to the kid.
+// QuestionView is one question presented to the kid. Multi-part answers
+// (ordering, multi_select, multi_blank) come back as one string with the
+// parts joined by "; ".
 type QuestionView struct {
 	Index      int      `json:"index"` // 1-based
 	Total      int      `json:"total"`
 	Text       string   `json:"text"`
-	Format     string   `json:"format"` // "numeric" | "multiple_choice"
+	Format     string   `json:"format"` // numeric | multiple_choice | true_false | ordering | multi_select | multi_blank
 	Choices    []string `json:"choices,omitempty"`
-	AnswerType string   `json:"answerType"` // integer | decimal | fraction | text
+	Blanks     int      `json:"blanks,omitempty"` // multi_blank only
+	AnswerType string   `json:"answerType"`       // integer | decimal | fraction | mixed_number | measurement | money | time | text
 	Tier       string   `json:"tier"`
 
-	// TimeLimitSecs is set for prove-tier questions: the client shows a
+	// Diagram is a picture to draw with the question; absent when the text
+	// stands alone.
+	Diagram *DiagramView `json:"diagram,omitempty"`
+
+	// TimeLimitSecs is set for timed (prove and challenge) tier questions:
+	// the client shows a
 	// countdown (advisory — answers are accepted after it runs out).
 	TimeLimitSecs int `json:"timeLimitSecs,omitempty"`
 }
 
+// DiagramView is a question's picture, drawn by the client as SVG. Only the
+// fields of its Kind are set. Number-line ticks and points arrive already
+// placed along the line (0 = left end, 1 = right end) and labelled, so the
+// client does no fraction arithmetic.
+type DiagramView struct {
+	Kind     string     `json:"kind"` // number_line | fraction_bar | area_model | array | clock
+	Ticks    []TickView `json:"ticks,omitempty"`
+	Points   []float64  `json:"points,omitempty"`
+	Parts    int        `json:"parts,omitempty"`
+	Shaded   int        `json:"shaded,omitempty"`
+	Rows     int        `json:"rows,omitempty"`
+	Cols     int        `json:"cols,omitempty"`
+	RowParts []int      `json:"rowParts,omitempty"`
+	ColParts []int      `json:"colParts,omitempty"`
+	Hour     int        `json:"hour,omitempty"`
+	Minute   int        `json:"minute,omitempty"`
+}
+
+// TickView is one number-line tick; Label is empty for unlabelled ticks.
+type TickView struct {
+	Pos   float64 `json:"pos"`
+	Label string  `json:"label,omitempty"`
+}
+
 // GemAwardView is a gem earned during play.
 type GemAwardView struct {
 	Type   string `json:"type"`
@@ -91,6 +148,10 @@ type GemAwardView struct {
 type MasteryChangeView struct {
 	From string `json:"from"`
 	To   string `json:"to"`
+
+	// Tier is set on a tier-up (From and To are then both "learning"): the
+	// tier the kid moved on to.
+	Tier string `json:"tier,omitempty"`
 }
 
 // AnswerResultView is the grading response for one answer.
@@ -100,6 +161,8 @@ type MasteryChangeView struct {
 // until the question can never gate again (see Manager.Answer).
 type AnswerResultView struct {
 	Correct       bool   `json:"correct"`
+	Grade         string `json:"grade"`            // correct | unsimplified | near_miss | wrong
+	Reason        string `json:"reason,omitempty"` // why a near miss or unsimplified answer fell short
 	CorrectAnswer string `json:"correctAnswer,omitempty"`
 	Explanation   string `json:"explanation,omitempty"`
 	HintAvailable bool   `json:"hintAvailable"`
@@ -171,6 +234,12 @@ type HintView struct {
 	Hint string `json:"hint"`
 }
 
+// ReportView acknowledges a reported question. Skipped means the question
+// was taken away unanswered; ask for the next one.
+type ReportView struct {
+	Skipped bool `json:"skipped"`
+}
+
 // SummaryView wraps up an expedition.
 type SummaryView struct {
 	Questions int            `json:"questions"`
@@ -184,4 +253,8 @@ type SummaryView struct {
 	// "Quest complete!" celebration.
 	QuestID       string `json:"questId,omitempty"`
 	QuestComplete bool   `json:"questComplete,omitempty"`
+
+	// PlacedSkills is set when a placement quiz ends: how many skills the
+	// child skipped ahead on.
+	PlacedSkills int `json:"placedSkills,omitempty"`
 }
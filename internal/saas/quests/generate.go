@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -39,10 +40,12 @@ Rules:
 - Each explanation shows the solution step by step, suitable for a child.
 - Choose "numeric" format for computation problems (the student types the answer).
 - Choose "multiple_choice" format for conceptual, comparison, or identification problems (the student picks from 4 options).
+- "true_false", "ordering", "multi_select" and "multi_blank" work as in the schema: multi-part answers are separated by "; ", and multi_blank questions mark each blank with "__".
 - For multiple choice, provide exactly 4 options where exactly one is correct. Distractors should reflect common mistakes, not random values.
 - Options are shuffled before display, so hints and explanations must refer to options by their content, never by their position (no "the first option" or "option A").
 - Never use options whose meaning depends on the other options, like "all of the above" or "none of the above" — every option must stand alone.
-- Use answer_type "text" only for conceptual reasoning questions, always with "multiple_choice" format.
+- For measurement answers use answer_type "mixed_number", "measurement", "money" or "time" as described in the schema, in the unit the question asks for.
+- Use answer_type "text" only for conceptual reasoning questions, always with a format that has choices (never numeric or multi_blank).
 - Include a short helpful hint for every question.
 - Vary the questions: no two questions in the batch may be near-duplicates.`
 
@@ -76,8 +79,8 @@ type genQuestion struct {
 }
 
 // genValidators is the problemgen validation chain generated questions must
-// pass. Failing questions are dropped, not retried — the parent reviews the
-// batch anyway.
+// pass, plus the judge when one is configured (Service.UseJudge). Failing
+// questions are dropped, not retried — the parent reviews the batch anyway.
 var genValidators = []problemgen.Validator{
 	&problemgen.StructuralValidator{},
 	&problemgen.AnswerFormatValidator{},
@@ -163,6 +166,19 @@ func (s *Service) Generate(ctx context.Context, questUID, brief string, count in
 		return nil, fmt.Errorf("%w: %v", ErrGeneration, err)
 	}
 
+	validators := genValidators
+	if s.judge != nil {
+		// A judge that can't be built is skipped, like one that fails to
+		// answer: it only ever filters the batch further.
+		if judge, err := s.judge(ctx, q.FamilySpaceID); err == nil && judge != nil {
+			validators = append(slices.Clone(genValidators), problemgen.NewJudge(judge))
+		}
+	}
+	input := problemgen.GenerateInput{}
+	if skill, err := skillgraph.GetSkill(q.SkillID); err == nil {
+		input.Skill = skill
+	}
+
 	valid := make([]*problemgen.Question, 0, len(raw.Questions))
 	for _, g := range raw.Questions {
 		pq := &problemgen.Question{
@@ -176,7 +192,8 @@ func (s *Service) Generate(ctx context.Context, questUID, brief string, count in
 			Explanation: g.Explanation,
 			SkillID:     q.SkillID,
 		}
-		if !passesValidation(pq) {
+		pq.Normalize()
+		if problemgen.RunValidators(genCtx, validators, pq, input) != nil {
 			continue
 		}
 		// Mandatory for AI-generated questions (LLMs put the right answer
@@ -225,15 +242,6 @@ func (s *Service) Generate(ctx context.Context, questUID, brief string, count in
 	return &GenerateResult{Questions: saved}, nil
 }
 
-func passesValidation(pq *problemgen.Question) bool {
-	for _, v := range genValidators {
-		if verr := v.Validate(pq, problemgen.GenerateInput{}); verr != nil {
-			return false
-		}
-	}
-	return true
-}
-
 // buildGenerateMessage assembles the user prompt: the parent's brief plus
 // skill context when the quest is tagged.
 func buildGenerateMessage(q *ent.Quest, brief string, count int) string {
@@ -244,7 +252,7 @@ func buildGenerateMessage(q *ent.Quest, brief string, count int) string {
 		if skill, err := skillgraph.GetSkill(q.SkillID); err == nil {
 			fmt.Fprintf(&b, "\nTarget skill: %s\n", skill.Name)
 			fmt.Fprintf(&b, "Skill description: %s\n", skill.Description)
-			fmt.Fprintf(&b, "Grade: %d\n", skill.GradeLevel)
+			fmt.Fprintf(&b, "Grade: %s\n", skillgraph.GradeLabel(skill.GradeLevel))
 			if len(skill.Keywords) > 0 {
 				fmt.Fprintf(&b, "Keywords: %s\n", strings.Join(skill.Keywords, ", "))
 			}
@@ -77,12 +77,20 @@ type Service struct {
 	client   *ent.Client
 	credits  *credits.Service
 	provider ProviderFactory
+	judge    ProviderFactory
 }
 
 func New(client *ent.Client, creditsSvc *credits.Service, provider ProviderFactory) *Service {
 	return &Service{client: client, credits: creditsSvc, provider: provider}
 }
 
+// UseJudge adds an LLM judge (problemgen.JudgeValidator) to the validation
+// of generated questions. The factory may return a nil provider when no
+// judge is configured; questions are then validated without one.
+func (s *Service) UseJudge(judge ProviderFactory) {
+	s.judge = judge
+}
+
 // ---- Quest CRUD ----
 
 // QuestInput carries the mutable quest fields.
@@ -438,17 +446,32 @@ func validateQuestion(in *QuestionInput) (warning string, err error) {
 		if !found {
 			return "", fmt.Errorf("%w: the answer must be one of the choices", ErrBadQuestion)
 		}
+	case problemgen.FormatTrueFalse, problemgen.FormatOrdering, problemgen.FormatMultiSelect, problemgen.FormatMultiBlank:
+		// The newer formats have no authoring history to stay lenient
+		// for: they get the generator's format rules.
+		pq := questionForCheck(in)
+		pq.Normalize()
+		if verr := (&problemgen.AnswerFormatValidator{}).Validate(pq, problemgen.GenerateInput{}); verr != nil {
+			return "", fmt.Errorf("%w: %s", ErrBadQuestion, verr.Message)
+		}
+		in.Answer, in.Choices = pq.Answer, pq.Choices
 	default:
-		return "", fmt.Errorf("%w: format must be numeric or multiple_choice", ErrBadQuestion)
+		return "", fmt.Errorf("%w: format must be numeric, multiple_choice, true_false, ordering, multi_select or multi_blank", ErrBadQuestion)
 	}
 	switch problemgen.AnswerType(in.AnswerType) {
 	case problemgen.AnswerTypeInteger, problemgen.AnswerTypeDecimal, problemgen.AnswerTypeFraction:
+	case problemgen.AnswerTypeMixedNumber, problemgen.AnswerTypeMeasurement, problemgen.AnswerTypeMoney, problemgen.AnswerTypeTime:
+		// Any readable form is fine ("9/4" for a mixed number): an answer
+		// that doesn't match itself didn't parse.
+		if in.Format == string(problemgen.FormatNumeric) && problemgen.GradeAnswer(in.Answer, questionForCheck(in)).Kind == problemgen.GradeWrong {
+			return "", fmt.Errorf("%w: %q is not a valid %s answer", ErrBadQuestion, in.Answer, in.AnswerType)
+		}
 	case problemgen.AnswerTypeText:
-		if problemgen.AnswerFormat(in.Format) != problemgen.FormatMultipleChoice {
-			return "", fmt.Errorf("%w: text answers must use multiple_choice format", ErrBadQuestion)
+		if !problemgen.AnswerFormat(in.Format).HasChoices() {
+			return "", fmt.Errorf("%w: text answers must use a format with choices", ErrBadQuestion)
 		}
 	default:
-		return "", fmt.Errorf("%w: answer type must be integer, decimal, fraction, or text", ErrBadQuestion)
+		return "", fmt.Errorf("%w: answer type must be integer, decimal, fraction, mixed_number, measurement, money, time, or text", ErrBadQuestion)
 	}
 
 	// Soft check: recompute the answer from the question text where the
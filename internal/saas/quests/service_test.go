@@ -45,7 +45,7 @@ func newQuestEnv(t *testing.T) *questEnv {
 		if err != nil {
 			t.Fatalf("space: %v", err)
 		}
-		child, err := e.family.AddChild(ctx, sp.UID, "Kid of "+name, 3, "")
+		child, err := e.family.AddChild(ctx, sp.UID, "Kid of "+name, 3, "", "", "")
 		if err != nil {
 			t.Fatalf("child: %v", err)
 		}
@@ -347,6 +347,32 @@ func TestGenerateFreeWhenBillingOff(t *testing.T) {
 	}
 }
 
+func TestGenerateWithJudge(t *testing.T) {
+	e := newQuestEnv(t)
+	ctx := context.Background()
+	wordBatch := `{"questions":[
+	{"question_text":"Mia has 3 boxes with 5 pencils in each box. How many pencils does she have?","format":"numeric","answer":"15","answer_type":"integer","choices":[],"hint":"Count by fives.","difficulty":2,"explanation":"Three fives make 15."},
+	{"question_text":"Leo has 4 bags with 6 marbles in each bag. How many marbles does he have?","format":"numeric","answer":"20","answer_type":"integer","choices":[],"hint":"Count by sixes.","difficulty":2,"explanation":"Four sixes make 20."}
+]}`
+	svc := New(e.client, nil, mockProviderFactory(llm.MockResponse{Content: []byte(wordBatch)}))
+	svc.UseJudge(mockProviderFactory(
+		llm.MockResponse{Content: []byte(`{"answer":"15","ambiguous":false,"age_appropriate":true,"reason":""}`)},
+		llm.MockResponse{Content: []byte(`{"answer":"24","ambiguous":false,"age_appropriate":true,"reason":""}`)},
+	))
+
+	q, err := svc.Create(ctx, e.spaceA, "", QuestInput{Name: "Judged quest"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	res, err := svc.Generate(ctx, q.UID, "multiplication word problems", 2, "click-1")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(res.Questions) != 1 || res.Questions[0].Answer != "15" {
+		t.Fatalf("generate = %d questions, want only the one the judge agreed with", len(res.Questions))
+	}
+}
+
 func TestPlayableQuestTargetingAndProgress(t *testing.T) {
 	e := newQuestEnv(t)
 	svc := New(e.client, nil, nil)
@@ -378,7 +404,7 @@ func TestPlayableQuestTargetingAndProgress(t *testing.T) {
 		t.Errorf("cross-family playable: %v", err)
 	}
 	// Same family but not the targeted child.
-	otherKid, err := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "")
+	otherKid, err := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "", "", "")
 	if err != nil {
 		t.Fatalf("sibling: %v", err)
 	}
@@ -459,7 +485,7 @@ func TestActiveQuestsListing(t *testing.T) {
 	mk(e.spaceA, "For everyone", "", true)
 	mk(e.spaceA, "Draft", "", false)
 	mk(e.spaceB, "Other family", "", true)
-	otherKid, _ := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "")
+	otherKid, _ := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "", "", "")
 	mk(e.spaceA, "For sibling", otherKid.UID, true)
 
 	items, err := svc.ActiveQuests(ctx, e.childA)
@@ -545,7 +571,7 @@ func TestAuthzQuestChecks(t *testing.T) {
 	}
 
 	// Same family, different target child.
-	sibling, err := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "")
+	sibling, err := e.family.AddChild(ctx, e.spaceA, "Sibling", 4, "", "", "")
 	if err != nil {
 		t.Fatalf("sibling: %v", err)
 	}
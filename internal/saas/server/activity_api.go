@@ -3,6 +3,7 @@ package server
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/abhisek/mathiz/ent"
 	"github.com/abhisek/mathiz/internal/saas/activity"
 	"github.com/abhisek/mathiz/internal/saas/authz"
+	"github.com/abhisek/mathiz/internal/store"
 )
 
 // Activity timeline API (read model) — parent-only, same authz as stats:
@@ -218,3 +220,52 @@ func (s *Server) handleChildActivitySession(w http.ResponseWriter, r *http.Reque
 		"hintCount": detail.HintCount,
 	})
 }
+
+// handleChildActivityReport lets a parent report a question from a
+// session's drill-down. The answer is picked by its seq, so the report
+// carries exactly what the child was shown and entered.
+func (s *Server) handleChildActivityReport(w http.ResponseWriter, r *http.Request, p authz.Principal, acct *ent.Account) {
+	childID := r.PathValue("id")
+	if err := s.checker.CanManageChild(r.Context(), p, childID); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	var req struct {
+		Seq    int64  `json:"seq"`
+		Reason string `json:"reason"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	repo := s.st.EventRepoFor(childID)
+	answers, err := repo.AnswersForSession(r.Context(), r.PathValue("sessionId"))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	i := slices.IndexFunc(answers, func(a store.AnswerEventRecord) bool { return a.Sequence == req.Seq })
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	a := answers[i]
+	err = repo.AppendQuestionReport(r.Context(), store.QuestionReportData{
+		SessionID:     a.SessionID,
+		SkillID:       a.SkillID,
+		Tier:          a.Tier,
+		QuestionText:  a.QuestionText,
+		Answer:        a.CorrectAnswer,
+		Format:        a.AnswerFormat,
+		Source:        a.Source,
+		LLMRequestID:  a.LLMRequestID,
+		LLMOwner:      a.LLMOwner,
+		LearnerAnswer: a.LearnerAnswer,
+		Reporter:      store.ReporterParent,
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -63,6 +63,7 @@ func newActivityFixture(t *testing.T) *activityFixture {
 		SessionID: "sess-a", SkillID: "pv-hundreds", Tier: "learn", Category: "frontier",
 		QuestionText: "2+2?", CorrectAnswer: "4", LearnerAnswer: "4",
 		Correct: true, TimeMs: 1500, AnswerFormat: "integer",
+		Source: "bank", LLMRequestID: 7, LLMOwner: "child-z",
 	}))
 	must(repo.AppendHintEvent(ctx, store.HintEventData{
 		SessionID: "sess-a", SkillID: "pv-hundreds", QuestionText: "2+2?", HintText: "count",
@@ -262,3 +263,37 @@ func TestActivitySessionDetail(t *testing.T) {
 		f.owner, nil, nil)
 	expectStatus(t, resp, 404, "cross-child session")
 }
+
+func TestActivityReportAnswer(t *testing.T) {
+	f := newActivityFixture(t)
+
+	var detail struct {
+		Answers []answerDetailJSON `json:"answers"`
+	}
+	resp := f.e.call(t, "GET", "/api/v1/children/"+f.childA.ID+"/activity/sessions/sess-a",
+		f.owner, nil, &detail)
+	expectStatus(t, resp, 200, "session detail")
+	seq := detail.Answers[0].Seq
+
+	path := "/api/v1/children/" + f.childA.ID + "/activity/sessions/sess-a/report"
+	body := map[string]any{"seq": seq, "reason": "two answers fit"}
+	resp = f.e.call(t, "POST", path, f.stranger, body, nil)
+	expectStatus(t, resp, 404, "stranger report")
+	resp = f.e.call(t, "POST", path, f.coParent, map[string]any{"seq": seq + 1000}, nil)
+	expectStatus(t, resp, 404, "unknown answer")
+	resp = f.e.call(t, "POST", path, f.coParent, body, nil)
+	expectStatus(t, resp, 204, "co-parent report")
+
+	reports, err := f.e.st.EventRepoFor(f.childA.ID).QueryQuestionReports(t.Context(), store.QueryOpts{})
+	if err != nil {
+		t.Fatalf("query reports: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("reports = %d, want 1", len(reports))
+	}
+	if r := reports[0]; r.QuestionText != "2+2?" || r.Answer != "4" || r.LearnerAnswer != "4" ||
+		r.Reporter != store.ReporterParent || r.Reason != "two answers fit" || r.SessionID != "sess-a" ||
+		r.Source != "bank" || r.LLMRequestID != 7 || r.LLMOwner != "child-z" {
+		t.Errorf("report = %+v", r)
+	}
+}
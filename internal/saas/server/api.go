@@ -29,6 +29,8 @@ type childJSON struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
 	Grade     int    `json:"grade"`
+	Standard  string `json:"standard"`
+	Locale    string `json:"locale"`
 	HasPIN    bool   `json:"hasPin"`
 	Archived  bool   `json:"archived"`
 	CreatedAt string `json:"createdAt"`
@@ -71,7 +73,7 @@ func toSpaceJSON(sp *ent.FamilySpace) spaceJSON {
 
 func toChildJSON(c *ent.ChildProfile) childJSON {
 	return childJSON{
-		ID: c.UID, Name: c.Name, Grade: c.Grade,
+		ID: c.UID, Name: c.Name, Grade: c.Grade, Standard: c.Standard, Locale: c.Locale,
 		HasPIN: c.PinHash != "", Archived: c.Archived,
 		CreatedAt: rfc3339(c.CreatedAt),
 	}
@@ -263,14 +265,21 @@ func (s *Server) handleAddChild(w http.ResponseWriter, r *http.Request, p authz.
 		return
 	}
 	var req struct {
-		Name  string `json:"name"`
-		Grade int    `json:"grade"`
-		PIN   string `json:"pin"`
+		Name     string `json:"name"`
+		Grade    *int   `json:"grade"`
+		PIN      string `json:"pin"`
+		Standard string `json:"standard"`
+		Locale   string `json:"locale"`
 	}
 	if !decodeJSON(w, r, &req) {
 		return
 	}
-	child, err := s.family.AddChild(r.Context(), spaceID, req.Name, req.Grade, req.PIN)
+	// Grade 0 is kindergarten, so a missing grade must not default to it.
+	if req.Grade == nil {
+		writeServiceError(w, family.ErrBadGrade)
+		return
+	}
+	child, err := s.family.AddChild(r.Context(), spaceID, req.Name, *req.Grade, req.PIN, req.Standard, req.Locale)
 	if err != nil {
 		writeServiceError(w, err)
 		return
@@ -328,6 +337,8 @@ func (s *Server) handleUpdateChild(w http.ResponseWriter, r *http.Request, p aut
 	var req struct {
 		Name     *string `json:"name"`
 		Grade    *int    `json:"grade"`
+		Standard *string `json:"standard"`
+		Locale   *string `json:"locale"`
 		PIN      *string `json:"pin"`
 		Archived *bool   `json:"archived"`
 	}
@@ -335,7 +346,8 @@ func (s *Server) handleUpdateChild(w http.ResponseWriter, r *http.Request, p aut
 		return
 	}
 	child, err := s.family.UpdateChild(r.Context(), childID, family.UpdateChildOpts{
-		Name: req.Name, Grade: req.Grade, PIN: req.PIN, Archived: req.Archived,
+		Name: req.Name, Grade: req.Grade, Standard: req.Standard, Locale: req.Locale,
+		PIN: req.PIN, Archived: req.Archived,
 	})
 	if err != nil {
 		writeServiceError(w, err)
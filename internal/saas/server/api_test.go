@@ -43,6 +43,10 @@ func (stubGenerator) Generate(_ context.Context, input problemgen.GenerateInput)
 	}, nil
 }
 
+func (g stubGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	return problemgen.GenerateEach(ctx, g, input, n)
+}
+
 const testJWTSecret = "test-secret-value-with-enough-length!!"
 
 type testEnv struct {
@@ -310,16 +314,45 @@ func TestFullOnboardingFlow(t *testing.T) {
 	}); err != nil {
 		t.Fatalf("append session: %v", err)
 	}
+	for _, ms := range []int{3000, 5000, 9000} {
+		if err := eventRepo.AppendAnswerEvent(t.Context(), store.AnswerEventData{
+			SessionID: "s1", SkillID: "count-to-20", Tier: "learn", Category: "frontier",
+			QuestionText: "What comes after 7?", CorrectAnswer: "8", LearnerAnswer: "8",
+			Correct: true, TimeMs: ms, AnswerFormat: "numeric",
+		}); err != nil {
+			t.Fatalf("append answer: %v", err)
+		}
+	}
+	if err := e.st.SnapshotRepoFor(child.ID).Save(t.Context(), &store.Snapshot{
+		Timestamp: time.Now(),
+		Data: store.SnapshotData{Mastery: &store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+			"count-to-20": {SkillID: "count-to-20", State: "learning", CurrentTier: "learn", TotalAttempts: 3, CorrectCount: 3},
+		}}},
+	}); err != nil {
+		t.Fatalf("save snapshot: %v", err)
+	}
 
 	// Parent A sees the child's stats; parent B gets 404.
 	var stats struct {
 		RecentSessions []map[string]any `json:"recentSessions"`
+		Mastery        struct {
+			Skills []struct {
+				ID      string       `json:"id"`
+				Fluency *fluencyStat `json:"fluency"`
+			} `json:"skills"`
+		} `json:"mastery"`
 	}
 	resp = e.call(t, "GET", "/api/v1/children/"+child.ID+"/stats", parentA, nil, &stats)
 	expectStatus(t, resp, 200, "stats")
 	if len(stats.RecentSessions) != 1 {
 		t.Fatalf("recent sessions = %d, want 1", len(stats.RecentSessions))
 	}
+	if len(stats.Mastery.Skills) != 1 || stats.Mastery.Skills[0].Fluency == nil {
+		t.Fatalf("skills = %+v, want count-to-20 with fluency", stats.Mastery.Skills)
+	}
+	if f := stats.Mastery.Skills[0].Fluency; f.P50Ms != 5000 || f.Trend != "unknown" || len(f.AccuracyByDay) != 1 {
+		t.Errorf("fluency = %+v, want median 5000ms, unknown trend, one day", f)
+	}
 	resp = e.call(t, "GET", "/api/v1/children/"+child.ID+"/stats", parentB, nil, nil)
 	expectStatus(t, resp, 404, "cross-tenant stats")
 
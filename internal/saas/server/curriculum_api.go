@@ -2,6 +2,7 @@ package server
 
 import (
 	"net/http"
+	"slices"
 	"sort"
 	"sync"
 
@@ -10,15 +11,16 @@ import (
 
 // Public curriculum API — the static skill graph, served unauthenticated so
 // the marketing/parent surfaces can render "what Mathiz teaches" without an
-// account. The payload is fixed per binary (the graph is a package-level
-// singleton seeded at init), so it is built once and cached.
+// account. The payload is fixed per process (the graph is a package-level
+// singleton set at startup), so each variant is built once and cached.
 
 type curriculumSkillJSON struct {
 	ID       string   `json:"id"`
 	Name     string   `json:"name"`
 	Grade    int      `json:"grade"`
-	Prereqs  []string `json:"prereqs"`  // always present, may be empty
-	Keywords []string `json:"keywords"` // search aliases (e.g. "GCF" for HCF & LCM); always present, may be empty
+	Code     string   `json:"code,omitempty"` // code in the requested standard
+	Prereqs  []string `json:"prereqs"`        // always present, may be empty
+	Keywords []string `json:"keywords"`       // search aliases (e.g. "GCF" for HCF & LCM); always present, may be empty
 }
 
 type curriculumIslandJSON struct {
@@ -28,51 +30,86 @@ type curriculumIslandJSON struct {
 }
 
 type curriculumJSON struct {
-	Islands []curriculumIslandJSON `json:"islands"`
+	Standard string                 `json:"standard"`
+	Islands  []curriculumIslandJSON `json:"islands"`
 }
 
 var (
 	curriculumOnce   sync.Once
-	curriculumCached curriculumJSON
+	curriculumCached map[skillgraph.Standard]curriculumJSON
+	curriculumAll    curriculumJSON
 )
 
-// curriculumPayload builds the response once from the skill graph: islands in
-// canonical strand order, skills within an island by grade then name.
-func curriculumPayload() curriculumJSON {
+// curriculumPayload returns the response for a standard, building every
+// variant once from the skill graph. filter drops skills without a code in
+// std — the unfiltered variant (no ?standard=) keeps the whole graph.
+func curriculumPayload(std skillgraph.Standard, filter bool) curriculumJSON {
 	curriculumOnce.Do(func() {
-		out := curriculumJSON{Islands: make([]curriculumIslandJSON, 0, len(skillgraph.AllStrands()))}
-		for _, strand := range skillgraph.AllStrands() {
-			skills := skillgraph.ByStrand(strand)
-			sort.Slice(skills, func(i, j int) bool {
-				if skills[i].GradeLevel != skills[j].GradeLevel {
-					return skills[i].GradeLevel < skills[j].GradeLevel
-				}
-				return skills[i].Name < skills[j].Name
-			})
-			island := curriculumIslandJSON{
-				ID:     string(strand),
-				Name:   skillgraph.StrandDisplayName(strand),
-				Skills: make([]curriculumSkillJSON, len(skills)),
+		curriculumCached = make(map[skillgraph.Standard]curriculumJSON, len(skillgraph.AllStandards()))
+		for _, s := range skillgraph.AllStandards() {
+			curriculumCached[s] = buildCurriculum(s, true)
+		}
+		curriculumAll = buildCurriculum(skillgraph.DefaultStandard, false)
+	})
+	if !filter {
+		return curriculumAll
+	}
+	return curriculumCached[std]
+}
+
+// buildCurriculum lays out islands in canonical strand order, skills within
+// an island by grade then name. Islands left empty by the filter are dropped.
+func buildCurriculum(std skillgraph.Standard, filter bool) curriculumJSON {
+	out := curriculumJSON{
+		Standard: string(std),
+		Islands:  make([]curriculumIslandJSON, 0, len(skillgraph.AllStrands())),
+	}
+	for _, strand := range skillgraph.AllStrands() {
+		skills := skillgraph.ByStrand(strand)
+		if filter {
+			skills = slices.DeleteFunc(skills, func(sk skillgraph.Skill) bool { return sk.Code(std) == "" })
+			if len(skills) == 0 {
+				continue
 			}
-			for i, sk := range skills {
-				island.Skills[i] = curriculumSkillJSON{
-					ID:       sk.ID,
-					Name:     sk.Name,
-					Grade:    sk.GradeLevel,
-					Prereqs:  append([]string{}, sk.Prerequisites...),
-					Keywords: append([]string{}, sk.Keywords...),
-				}
+		}
+		sort.Slice(skills, func(i, j int) bool {
+			if skills[i].GradeLevel != skills[j].GradeLevel {
+				return skills[i].GradeLevel < skills[j].GradeLevel
 			}
-			out.Islands = append(out.Islands, island)
+			return skills[i].Name < skills[j].Name
+		})
+		island := curriculumIslandJSON{
+			ID:     string(strand),
+			Name:   skillgraph.StrandDisplayName(strand),
+			Skills: make([]curriculumSkillJSON, len(skills)),
 		}
-		curriculumCached = out
-	})
-	return curriculumCached
+		for i, sk := range skills {
+			island.Skills[i] = curriculumSkillJSON{
+				ID:       sk.ID,
+				Name:     sk.Name,
+				Grade:    sk.GradeLevel,
+				Code:     sk.Code(std),
+				Prereqs:  append([]string{}, sk.Prerequisites...),
+				Keywords: append([]string{}, sk.Keywords...),
+			}
+		}
+		out.Islands = append(out.Islands, island)
+	}
+	return out
 }
 
 // handleCurriculum serves the public skill-graph curriculum. Registered
 // unconditionally; the content is static, so clients may cache it.
+//
+// ?standard= (ccss, uk-nc, cbse) limits the graph to skills mapped to that
+// standard. Without it every skill is listed with its Common Core code.
 func (s *Server) handleCurriculum(w http.ResponseWriter, r *http.Request) {
+	param := r.URL.Query().Get("standard")
+	std, err := skillgraph.ParseStandard(param)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	w.Header().Set("Cache-Control", "public, max-age=3600")
-	writeJSON(w, http.StatusOK, curriculumPayload())
+	writeJSON(w, http.StatusOK, curriculumPayload(std, param != ""))
 }
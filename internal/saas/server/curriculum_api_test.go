@@ -81,3 +81,50 @@ func TestCurriculumIsPublic(t *testing.T) {
 		}
 	}
 }
+
+func TestCurriculumStandardFilter(t *testing.T) {
+	e := newTestEnv(t)
+
+	var cur struct {
+		Standard string `json:"standard"`
+		Islands  []struct {
+			Skills []struct {
+				ID   string `json:"id"`
+				Code string `json:"code"`
+			} `json:"skills"`
+		} `json:"islands"`
+	}
+	resp := e.call(t, "GET", "/api/v1/curriculum?standard=ccss", "", nil, &cur)
+	expectStatus(t, resp, 200, "curriculum ccss")
+	if cur.Standard != "ccss" {
+		t.Errorf("standard = %q, want ccss", cur.Standard)
+	}
+	found := false
+	for _, island := range cur.Islands {
+		for _, sk := range island.Skills {
+			if sk.Code == "" {
+				t.Errorf("skill %s has no ccss code but was not filtered out", sk.ID)
+			}
+			if sk.ID == "frac-concept" {
+				found = true
+				if sk.Code != "3.NF.A.1" {
+					t.Errorf("frac-concept ccss code = %q, want 3.NF.A.1", sk.Code)
+				}
+			}
+		}
+	}
+	if !found {
+		t.Error("frac-concept missing from the ccss curriculum")
+	}
+
+	// The seed maps no skill to the UK curriculum: everything is filtered out.
+	cur.Islands = nil
+	resp = e.call(t, "GET", "/api/v1/curriculum?standard=uk-nc", "", nil, &cur)
+	expectStatus(t, resp, 200, "curriculum uk-nc")
+	if cur.Standard != "uk-nc" || len(cur.Islands) != 0 {
+		t.Errorf("uk-nc curriculum = %q with %d islands, want uk-nc with none", cur.Standard, len(cur.Islands))
+	}
+
+	resp = e.call(t, "GET", "/api/v1/curriculum?standard=nope", "", nil, nil)
+	expectStatus(t, resp, 400, "unknown standard")
+}
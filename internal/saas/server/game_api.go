@@ -7,6 +7,7 @@ import (
 	"github.com/abhisek/mathiz/ent"
 	"github.com/abhisek/mathiz/internal/saas/authz"
 	"github.com/abhisek/mathiz/internal/saas/game"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 )
 
 // Game API — the treasure-map play experience. All endpoints are child
@@ -14,7 +15,7 @@ import (
 // game manager on every call.
 
 func (s *Server) handleGameMap(w http.ResponseWriter, r *http.Request, p authz.Principal, child *ent.ChildProfile) {
-	view, err := s.game.Map(r.Context(), child.UID)
+	view, err := s.game.Map(r.Context(), child.UID, skillgraph.Standard(child.Standard))
 	if err != nil {
 		writeGameError(w, err)
 		return
@@ -79,6 +80,21 @@ func (s *Server) handleExpeditionHint(w http.ResponseWriter, r *http.Request, p
 	writeJSON(w, http.StatusOK, view)
 }
 
+func (s *Server) handleExpeditionReport(w http.ResponseWriter, r *http.Request, p authz.Principal, child *ent.ChildProfile) {
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	view, err := s.game.Report(r.Context(), child.UID, r.PathValue("id"), req.Reason)
+	if err != nil {
+		writeGameError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, view)
+}
+
 func (s *Server) handleExpeditionLesson(w http.ResponseWriter, r *http.Request, p authz.Principal, child *ent.ChildProfile) {
 	view, err := s.game.Lesson(r.Context(), child.UID, r.PathValue("id"))
 	if err != nil {
@@ -113,6 +129,25 @@ func (s *Server) handleExpeditionEnd(w http.ResponseWriter, r *http.Request, p a
 	writeJSON(w, http.StatusOK, view)
 }
 
+// handlePlacementStart begins the placement quiz. It plays through the
+// regular expedition question/answer/end endpoints.
+func (s *Server) handlePlacementStart(w http.ResponseWriter, r *http.Request, p authz.Principal, child *ent.ChildProfile) {
+	view, err := s.game.StartPlacement(r.Context(), child.UID)
+	if err != nil {
+		writeGameError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, view)
+}
+
+func (s *Server) handlePlacementSkip(w http.ResponseWriter, r *http.Request, p authz.Principal, child *ent.ChildProfile) {
+	if err := s.game.SkipPlacement(r.Context(), child.UID); err != nil {
+		writeGameError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // writeGameError maps game errors onto kid-safe HTTP responses.
 func writeGameError(w http.ResponseWriter, err error) {
 	switch {
@@ -127,6 +162,7 @@ func writeGameError(w http.ResponseWriter, err error) {
 		errors.Is(err, game.ErrNoHint),
 		errors.Is(err, game.ErrNoLesson),
 		errors.Is(err, game.ErrQuestDone),
+		errors.Is(err, game.ErrPlacementDone),
 		errors.Is(err, game.ErrElsewhere):
 		writeError(w, http.StatusConflict, err.Error())
 	case errors.Is(err, game.ErrNoCredits):
@@ -133,6 +133,8 @@ func writeServiceError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusUnprocessableEntity, err.Error())
 	case errors.Is(err, family.ErrBadPIN),
 		errors.Is(err, family.ErrBadGrade),
+		errors.Is(err, family.ErrBadStandard),
+		errors.Is(err, family.ErrBadLocale),
 		errors.Is(err, family.ErrBadName),
 		errors.Is(err, family.ErrBadEmail),
 		errors.Is(err, family.ErrOwnerRemoval):
@@ -117,6 +117,7 @@ func (s *Server) routes() http.Handler {
 	if s.activity != nil {
 		mux.Handle("GET /api/v1/children/{id}/activity", s.withParent(s.handleChildActivity))
 		mux.Handle("GET /api/v1/children/{id}/activity/sessions/{sessionId}", s.withParent(s.handleChildActivitySession))
+		mux.Handle("POST /api/v1/children/{id}/activity/sessions/{sessionId}/report", s.withParent(s.handleChildActivityReport))
 	}
 	mux.Handle("GET /api/v1/children/{id}/devices", s.withParent(s.handleListDevices))
 	mux.Handle("DELETE /api/v1/devices/{id}", s.withParent(s.handleRevokeDevice))
@@ -132,9 +133,12 @@ func (s *Server) routes() http.Handler {
 		mux.Handle("POST /api/v1/game/expeditions/{id}/question", s.withChild(s.handleExpeditionQuestion))
 		mux.Handle("POST /api/v1/game/expeditions/{id}/answer", s.withChild(s.handleExpeditionAnswer))
 		mux.Handle("POST /api/v1/game/expeditions/{id}/hint", s.withChild(s.handleExpeditionHint))
+		mux.Handle("POST /api/v1/game/expeditions/{id}/report", s.withChild(s.handleExpeditionReport))
 		mux.Handle("POST /api/v1/game/expeditions/{id}/lesson", s.withChild(s.handleExpeditionLesson))
 		mux.Handle("POST /api/v1/game/expeditions/{id}/lesson/answer", s.withChild(s.handleExpeditionLessonAnswer))
 		mux.Handle("POST /api/v1/game/expeditions/{id}/end", s.withChild(s.handleExpeditionEnd))
+		mux.Handle("POST /api/v1/game/placement", s.withChild(s.handlePlacementStart))
+		mux.Handle("POST /api/v1/game/placement/skip", s.withChild(s.handlePlacementSkip))
 	}
 
 	// Parent quests (specs/15-quests.md).
@@ -2,8 +2,11 @@ package server
 
 import (
 	"context"
+	"time"
 
+	"github.com/abhisek/mathiz/internal/mastery"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/spacedrep"
 	"github.com/abhisek/mathiz/internal/store"
 )
 
@@ -41,6 +44,9 @@ func (s *Server) childSummary(ctx context.Context, childUID string) (map[string]
 	if err != nil {
 		return nil, err
 	}
+	if snap != nil {
+		spacedrep.MigrateSnapshotSkills(&snap.Data)
+	}
 	tally := tallyMastery(snap)
 
 	_, gemTotal, err := eventRepo.GemCounts(ctx)
@@ -66,6 +72,45 @@ func (s *Server) childSummary(ctx context.Context, childUID string) (map[string]
 	}, nil
 }
 
+// statsAccuracyDays is how many of a skill's latest practice days the
+// per-day accuracy in the stats view covers.
+const statsAccuracyDays = 30
+
+// statsHistoryDays bounds the answer history the stats view analyses, so a
+// long-time learner's view doesn't load every answer they ever gave.
+const statsHistoryDays = 180
+
+// fluencyStat is a skill's fluency analytics from its answer history.
+type fluencyStat struct {
+	P25Ms         int       `json:"p25Ms"`
+	P50Ms         int       `json:"p50Ms"`
+	P90Ms         int       `json:"p90Ms"`
+	Trend         string    `json:"trend"`
+	AccuracyByDay []dayStat `json:"accuracyByDay"`
+}
+
+type dayStat struct {
+	Day     string `json:"day"` // YYYY-MM-DD
+	Answers int    `json:"answers"`
+	Correct int    `json:"correct"`
+}
+
+func toFluencyStat(a *mastery.SkillAnalytics) *fluencyStat {
+	if a == nil {
+		return nil
+	}
+	days := a.Daily[len(a.Daily)-min(len(a.Daily), statsAccuracyDays):]
+	out := &fluencyStat{
+		P25Ms: a.P25Ms, P50Ms: a.P50Ms, P90Ms: a.P90Ms,
+		Trend:         string(a.Trend),
+		AccuracyByDay: make([]dayStat, len(days)),
+	}
+	for i, d := range days {
+		out.AccuracyByDay[i] = dayStat{Day: d.Day.Format(time.DateOnly), Answers: d.Answers, Correct: d.Correct}
+	}
+	return out
+}
+
 // childStats is the full per-child progress view.
 func (s *Server) childStats(ctx context.Context, childUID string) (map[string]any, error) {
 	snapRepo := s.st.SnapshotRepoFor(childUID)
@@ -80,13 +125,27 @@ func (s *Server) childStats(ctx context.Context, childUID string) (map[string]an
 		State    string  `json:"state"`
 		Accuracy float64 `json:"accuracy"`
 		Attempts int     `json:"attempts"`
+
+		Fluency *fluencyStat `json:"fluency,omitempty"`
 	}
 	var skills []skillStat
 
+	// Response times, accuracy over time and fluency trend per skill.
+	answers, err := eventRepo.AnswerHistory(ctx, store.QueryOpts{
+		From: time.Now().AddDate(0, 0, -statsHistoryDays),
+	})
+	if err != nil {
+		return nil, err
+	}
+	analytics := mastery.Analyze(answers)
+
 	snap, err := snapRepo.Latest(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if snap != nil {
+		spacedrep.MigrateSnapshotSkills(&snap.Data)
+	}
 	tally := tallyMastery(snap)
 	if snap != nil && snap.Data.Mastery != nil {
 		for id, sk := range snap.Data.Mastery.Skills {
@@ -102,6 +161,7 @@ func (s *Server) childStats(ctx context.Context, childUID string) (map[string]an
 				ID: id, Name: meta.Name, Strand: skillgraph.StrandDisplayName(meta.Strand),
 				Grade: meta.GradeLevel, State: sk.State,
 				Accuracy: acc, Attempts: sk.TotalAttempts,
+				Fluency: toFluencyStat(analytics[id]),
 			})
 		}
 	}
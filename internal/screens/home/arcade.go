@@ -177,13 +177,14 @@ func renderArcadeMenuCompact(items []string, selected int, cw int, disabled map[
 		Render(block)
 }
 
-// renderLLMBanner renders a warning banner when no LLM API key is configured.
+// renderLLMBanner renders a notice when no LLM API key is configured and
+// questions come from the offline generator.
 func renderLLMBanner(cw int) string {
 	return lipgloss.NewStyle().
 		Foreground(theme.Accent).
 		Width(cw).
 		Align(lipgloss.Center).
-		Render("⚠ Set an LLM API key to start playing (see mathiz --help)")
+		Render("⚠ Offline: arithmetic skills only. Set an LLM API key for the rest (see mathiz --help)")
 }
 
 // renderMascotBox renders the mascot centered in a box matching content width.
@@ -10,7 +10,9 @@ import (
 
 	"github.com/abhisek/mathiz/internal/diagnosis"
 	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/lessons"
+	"github.com/abhisek/mathiz/internal/mastery"
 	"github.com/abhisek/mathiz/internal/problemgen"
 	"github.com/abhisek/mathiz/internal/router"
 	"github.com/abhisek/mathiz/internal/screen"
@@ -21,6 +23,7 @@ import (
 	"github.com/abhisek/mathiz/internal/screens/skillmap"
 	"github.com/abhisek/mathiz/internal/selfupdate"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/spacedrep"
 	"github.com/abhisek/mathiz/internal/store"
 	"github.com/abhisek/mathiz/internal/ui/components"
 )
@@ -46,6 +49,9 @@ func New(generator problemgen.Generator, eventRepo store.EventRepo, snapRepo sto
 	if snapRepo != nil {
 		snap, _ = snapRepo.Latest(context.Background())
 	}
+	if snap != nil {
+		spacedrep.MigrateSnapshotSkills(&snap.Data)
+	}
 
 	var gemCount int
 	if snap != nil && snap.Data.Gems != nil {
@@ -93,11 +99,13 @@ func New(generator problemgen.Generator, eventRepo store.EventRepo, snapRepo sto
 	skillStates := computeSkillStates(snap)
 	reviewBadges := computeReviewBadges(snap)
 
-	llmMissing := generator == nil
-	menuLabels := []string{"START GAME", "SKILL MAP", "GEM VAULT", "HISTORY", "EXIT GAME"}
+	// Without an LLM the offline generator still serves arithmetic skills;
+	// only a missing generator disables play.
+	llmMissing := generator == nil || problemgen.IsOffline(generator)
+	menuLabels := []string{i18n.T("START GAME"), i18n.T("SKILL MAP"), i18n.T("GEM VAULT"), i18n.T("HISTORY"), i18n.T("EXIT GAME")}
 
 	items := []components.MenuItem{
-		{Label: menuLabels[0], Disabled: llmMissing, Action: func() tea.Cmd {
+		{Label: menuLabels[0], Disabled: generator == nil, Action: func() tea.Cmd {
 			if generator == nil || eventRepo == nil || snapRepo == nil {
 				return nil
 			}
@@ -109,7 +117,7 @@ func New(generator problemgen.Generator, eventRepo store.EventRepo, snapRepo sto
 		}},
 		{Label: menuLabels[1], Action: func() tea.Cmd {
 			return func() tea.Msg {
-				return router.PushScreenMsg{Screen: skillmap.New(skillStates, reviewBadges)}
+				return router.PushScreenMsg{Screen: skillmap.New(skillStates, reviewBadges, eventRepo)}
 			}
 		}},
 		{Label: menuLabels[2], Action: func() tea.Cmd {
@@ -307,7 +315,7 @@ func (h *HomeScreen) View(width, height int) string {
 }
 
 func (h *HomeScreen) Title() string {
-	return "Home"
+	return i18n.T("Home")
 }
 
 // computeReviewBadges extracts review schedule badges from snapshot spaced rep data.
@@ -317,15 +325,23 @@ func computeReviewBadges(snap *store.Snapshot) map[string]skillmap.ReviewBadge {
 		return badges
 	}
 	now := time.Now()
+	var svc *mastery.Service
+	if spacedrep.Propagation() {
+		svc = mastery.NewService(&snap.Data, nil)
+	}
 	for id, rs := range snap.Data.SpacedRep.Reviews {
 		nextReview, err := time.Parse(time.RFC3339, rs.NextReviewDate)
 		if err != nil {
 			continue
 		}
-		badges[id] = skillmap.ReviewBadge{
+		badge := skillmap.ReviewBadge{
 			Due:       !now.Before(nextReview),
 			Graduated: rs.Graduated,
 		}
+		if svc != nil {
+			badge.Shaky = spacedrep.ShakyFoundation(svc, id)
+		}
+		badges[id] = badge
 	}
 	return badges
 }
@@ -343,9 +359,12 @@ func computeSkillStates(snap *store.Snapshot) map[string]skillgraph.SkillState {
 		case "rusty":
 			states[id] = skillgraph.StateRusty
 		case "learning":
-			if sm.CurrentTier == "prove" {
+			switch skillgraph.ParseTier(sm.CurrentTier) {
+			case skillgraph.TierProve:
 				states[id] = skillgraph.StateProving
-			} else {
+			case skillgraph.TierChallenge:
+				states[id] = skillgraph.StateChallenging
+			default:
 				states[id] = skillgraph.StateLearning
 			}
 		// "new" → skip, same as no entry
@@ -0,0 +1,358 @@
+// Package placement is the terminal front end of the diagnostic placement
+// quiz (internal/placement). It is shown once, before the home screen, to a
+// learner with no saved progress.
+package placement
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/placement"
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/router"
+	"github.com/abhisek/mathiz/internal/screen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+	"github.com/abhisek/mathiz/internal/ui/components"
+	"github.com/abhisek/mathiz/internal/ui/layout"
+
+	"github.com/google/uuid"
+)
+
+type phase int
+
+const (
+	phaseIntro phase = iota
+	phaseAsking
+	phaseSaving
+	phaseDone
+)
+
+// generateTimeout is the maximum time allowed for a single question generation.
+const generateTimeout = 30 * time.Second
+
+// maxConsecutiveGenErrors ends the quiz early, keeping what was placed so
+// far, when the generator keeps failing.
+const maxConsecutiveGenErrors = 3
+
+type questionReadyMsg struct {
+	Question *problemgen.Question
+	Err      error
+}
+
+type savedMsg struct {
+	Result skillgraph.DiagnosticResult
+	Err    error
+}
+
+type spinnerTickMsg time.Time
+
+// PlacementScreen runs the placement quiz, then replaces itself with the
+// screen produced by next.
+type PlacementScreen struct {
+	quiz      *placement.Quiz
+	eventRepo store.EventRepo
+	snapRepo  store.SnapshotRepo
+	next      func() screen.Screen
+	sessionID string
+
+	phase      phase
+	menu       int // intro selection: 0 = take quiz, 1 = skip
+	question   *problemgen.Question
+	askedAt    time.Time
+	input      components.TextInput
+	mcActive   bool
+	mcSelected int
+	order      components.OrderList
+	multi      components.MultiSelect
+	blanks     components.BlankInputs
+
+	spinnerFrame         int
+	consecutiveGenErrors int
+	genErrMsg            string
+
+	result  skillgraph.DiagnosticResult
+	skipped bool
+	errMsg  string
+}
+
+var _ screen.Screen = (*PlacementScreen)(nil)
+var _ screen.KeyHintProvider = (*PlacementScreen)(nil)
+
+// New creates a PlacementScreen. grade, when set, is the learner's school
+// grade, the highest the quiz probes; next builds the screen shown
+// afterwards.
+func New(generator problemgen.Generator, eventRepo store.EventRepo, snapRepo store.SnapshotRepo, grade *int, next func() screen.Screen) *PlacementScreen {
+	quiz := placement.NewQuiz(generator, skillgraph.DiagnosticConfig{MaxGrade: grade})
+	quiz.Locale = i18n.Active()
+	return &PlacementScreen{
+		quiz:      quiz,
+		eventRepo: eventRepo,
+		snapRepo:  snapRepo,
+		next:      next,
+		sessionID: uuid.New().String(),
+		input:     components.NewTextInput("", false, 20),
+	}
+}
+
+func (p *PlacementScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (p *PlacementScreen) Title() string {
+	return i18n.T("Placement")
+}
+
+func (p *PlacementScreen) KeyHints() []layout.KeyHint {
+	switch p.phase {
+	case phaseIntro:
+		return []layout.KeyHint{
+			{Key: "↑↓", Description: i18n.T("Choose")},
+			{Key: "Enter", Description: i18n.T("Select")},
+		}
+	case phaseAsking:
+		hints := []layout.KeyHint{
+			{Key: "Enter", Description: i18n.T("Submit")},
+			{Key: "Esc", Description: i18n.T("Finish early")},
+		}
+		if p.question != nil {
+			switch p.question.Format {
+			case problemgen.FormatOrdering:
+				hints = append(hints, layout.KeyHint{Key: "Space", Description: i18n.T("Pick up/drop")})
+			case problemgen.FormatMultiSelect:
+				hints = append(hints, layout.KeyHint{Key: "Space", Description: i18n.T("Toggle")})
+			case problemgen.FormatMultiBlank:
+				hints = append(hints, layout.KeyHint{Key: "Tab", Description: i18n.T("Next blank")})
+			}
+		}
+		return hints
+	case phaseDone:
+		return []layout.KeyHint{
+			{Key: i18n.T("any key"), Description: i18n.T("Continue")},
+		}
+	}
+	return nil
+}
+
+func (p *PlacementScreen) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case questionReadyMsg:
+		return p.handleQuestionReady(msg)
+	case savedMsg:
+		p.phase = phaseDone
+		p.result = msg.Result
+		if msg.Err != nil {
+			p.errMsg = msg.Err.Error()
+		}
+		return p, nil
+	case spinnerTickMsg:
+		p.spinnerFrame++
+		if p.phase == phaseAsking && p.question == nil || p.phase == phaseSaving {
+			return p, spinnerTickCmd()
+		}
+		return p, nil
+	case tea.KeyPressMsg:
+		return p.handleKey(msg)
+	}
+	return p, nil
+}
+
+func (p *PlacementScreen) handleKey(msg tea.KeyPressMsg) (screen.Screen, tea.Cmd) {
+	key := msg.String()
+	switch p.phase {
+	case phaseIntro:
+		switch key {
+		case "up", "k", "down", "j", "tab":
+			p.menu = 1 - p.menu
+		case "enter":
+			if p.menu == 1 {
+				p.skipped = true
+				return p.finish()
+			}
+			p.phase = phaseAsking
+			return p, tea.Batch(p.nextQuestion(), spinnerTickCmd())
+		case "esc":
+			p.skipped = true
+			return p.finish()
+		}
+		return p, nil
+
+	case phaseAsking:
+		// Keys wait while a question is generating: the quiz is not safe
+		// to touch until the generation command returns.
+		if p.question == nil {
+			return p, nil
+		}
+		if key == "esc" {
+			return p.finish()
+		}
+		if key == "enter" {
+			return p.submitAnswer()
+		}
+		if p.mcActive {
+			switch key {
+			case "1", "2", "3", "4":
+				if i := int(key[0] - '1'); i < len(p.question.Choices) {
+					p.mcSelected = i
+					return p.submitAnswer()
+				}
+			case "up", "k":
+				if p.mcSelected > 0 {
+					p.mcSelected--
+				}
+			case "down", "j":
+				if p.mcSelected < len(p.question.Choices)-1 {
+					p.mcSelected++
+				}
+			}
+			return p, nil
+		}
+		var cmd tea.Cmd
+		switch p.question.Format {
+		case problemgen.FormatOrdering:
+			p.order, cmd = p.order.Update(msg)
+		case problemgen.FormatMultiSelect:
+			p.multi, cmd = p.multi.Update(msg)
+		case problemgen.FormatMultiBlank:
+			p.blanks, cmd = p.blanks.Update(msg)
+		default:
+			p.input, cmd = p.input.Update(msg)
+		}
+		return p, cmd
+
+	case phaseDone:
+		return p, func() tea.Msg { return router.ReplaceScreenMsg{Screen: p.next()} }
+	}
+	return p, nil
+}
+
+func (p *PlacementScreen) handleQuestionReady(msg questionReadyMsg) (screen.Screen, tea.Cmd) {
+	if p.phase != phaseAsking {
+		return p, nil
+	}
+	if msg.Err != nil {
+		p.consecutiveGenErrors++
+		if p.consecutiveGenErrors >= maxConsecutiveGenErrors {
+			return p.finish()
+		}
+		p.genErrMsg = i18n.T("Having trouble generating a question, retrying...")
+		return p, tea.Batch(p.nextQuestion(), spinnerTickCmd())
+	}
+	if msg.Question == nil {
+		return p.finish()
+	}
+
+	p.consecutiveGenErrors = 0
+	p.genErrMsg = ""
+	p.question = msg.Question
+	p.askedAt = time.Now()
+	p.mcActive = false
+	switch msg.Question.Format {
+	case problemgen.FormatMultipleChoice, problemgen.FormatTrueFalse:
+		p.mcActive = true
+		p.mcSelected = 0
+	case problemgen.FormatOrdering:
+		p.order = components.NewOrderList(msg.Question.DisplayChoices())
+	case problemgen.FormatMultiSelect:
+		p.multi = components.NewMultiSelect(msg.Question.DisplayChoices())
+	case problemgen.FormatMultiBlank:
+		p.blanks = components.NewBlankInputs(msg.Question.BlankCount(), 20)
+		return p, p.blanks.Init()
+	default:
+		p.input = components.NewTextInput("", false, 20)
+	}
+	return p, p.input.Init()
+}
+
+// submitAnswer grades the current answer and moves straight on. Placement
+// gives no per-question feedback: it is a check-up, not a lesson.
+func (p *PlacementScreen) submitAnswer() (screen.Screen, tea.Cmd) {
+	var answer string
+	switch {
+	case p.mcActive:
+		if p.mcSelected < len(p.question.Choices) {
+			answer = p.question.Choices[p.mcSelected]
+		}
+	case p.question.Format == problemgen.FormatOrdering:
+		answer = problemgen.JoinAnswer(p.order.Values())
+	case p.question.Format == problemgen.FormatMultiSelect:
+		selected := p.multi.Selected()
+		if len(selected) == 0 {
+			return p, nil
+		}
+		answer = problemgen.JoinAnswer(selected)
+	case p.question.Format == problemgen.FormatMultiBlank:
+		if !p.blanks.Filled() {
+			return p, nil
+		}
+		answer = problemgen.JoinAnswer(p.blanks.Values())
+	default:
+		answer = p.input.Value()
+		if answer == "" {
+			return p, nil
+		}
+	}
+
+	q := p.question
+	correct, err := p.quiz.Answer(answer)
+	if err != nil {
+		return p, nil
+	}
+	_ = p.eventRepo.AppendAnswerEvent(context.Background(), store.AnswerEventData{
+		SessionID:     p.sessionID,
+		SkillID:       q.SkillID,
+		Tier:          "learn",
+		Category:      placement.Category,
+		QuestionText:  q.Text,
+		CorrectAnswer: q.Answer,
+		LearnerAnswer: answer,
+		Correct:       correct,
+		TimeMs:        int(time.Since(p.askedAt).Milliseconds()),
+		AnswerFormat:  string(q.Format),
+		Source:        q.Source,
+		LLMRequestID:  q.LLMRequestID,
+		LLMOwner:      q.LLMOwner,
+	})
+
+	p.question = nil
+	if p.quiz.Done() {
+		return p.finish()
+	}
+	return p, tea.Batch(p.nextQuestion(), spinnerTickCmd())
+}
+
+// finish saves the placement — whatever was completed, or nothing when
+// skipped — so the offer is not repeated.
+func (p *PlacementScreen) finish() (screen.Screen, tea.Cmd) {
+	p.phase = phaseSaving
+	p.question = nil
+	var res skillgraph.DiagnosticResult
+	if !p.skipped {
+		res = p.quiz.Result()
+	}
+	eventRepo, snapRepo, sessionID := p.eventRepo, p.snapRepo, p.sessionID
+	return p, tea.Batch(func() tea.Msg {
+		err := placement.Save(context.Background(), eventRepo, snapRepo, res, sessionID)
+		return savedMsg{Result: res, Err: err}
+	}, spinnerTickCmd())
+}
+
+func (p *PlacementScreen) nextQuestion() tea.Cmd {
+	quiz := p.quiz
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), generateTimeout)
+		defer cancel()
+		q, err := quiz.Next(ctx)
+		return questionReadyMsg{Question: q, Err: err}
+	}
+}
+
+// spinnerTickCmd returns a fast tick for spinner animation.
+func spinnerTickCmd() tea.Cmd {
+	return tea.Tick(150*time.Millisecond, func(t time.Time) tea.Msg {
+		return spinnerTickMsg(t)
+	})
+}
@@ -0,0 +1,128 @@
+package placement
+
+import (
+	"context"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/router"
+	"github.com/abhisek/mathiz/internal/screen"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+type fakeGenerator struct{}
+
+func (fakeGenerator) Generate(_ context.Context, in problemgen.GenerateInput) (*problemgen.Question, error) {
+	return &problemgen.Question{
+		Text:       "What is 2 + 2?",
+		Format:     problemgen.FormatNumeric,
+		AnswerType: problemgen.AnswerTypeInteger,
+		Answer:     "4",
+		SkillID:    in.Skill.ID,
+		Tier:       in.Tier,
+	}, nil
+}
+
+func (g fakeGenerator) GenerateBatch(ctx context.Context, in problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	return problemgen.GenerateEach(ctx, g, in, n)
+}
+
+type stubScreen struct{}
+
+func (stubScreen) Init() tea.Cmd                             { return nil }
+func (s stubScreen) Update(tea.Msg) (screen.Screen, tea.Cmd) { return s, nil }
+func (stubScreen) View(int, int) string                      { return "" }
+func (stubScreen) Title() string                             { return "stub" }
+
+func newTestScreen(t *testing.T, owner string) (*PlacementScreen, store.SnapshotRepo) {
+	t.Helper()
+	st, err := store.Open("file::memory:?cache=shared")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() { st.Close() })
+	snapRepo := st.SnapshotRepoFor(owner)
+	next := func() screen.Screen { return stubScreen{} }
+	return New(fakeGenerator{}, st.EventRepoFor(owner), snapRepo, nil, next), snapRepo
+}
+
+func key(code rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg{Code: code}
+}
+
+func keyText(r rune) tea.KeyPressMsg {
+	return tea.KeyPressMsg{Code: r, Text: string(r)}
+}
+
+// run executes cmd and feeds its message back into the screen, returning the
+// next command. Batched commands are run in order.
+func run(p *PlacementScreen, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var next tea.Cmd
+		for _, c := range batch {
+			// Spinner ticks only animate; drop them.
+			if c == nil {
+				continue
+			}
+			if m := c(); m != nil {
+				if _, tick := m.(spinnerTickMsg); tick {
+					continue
+				}
+				_, next = p.Update(m)
+			}
+		}
+		return next
+	}
+	_, next := p.Update(msg)
+	return next
+}
+
+func TestPlacementScreen_Skip(t *testing.T) {
+	p, snapRepo := newTestScreen(t, "placement-screen-skip")
+
+	p.Update(key(tea.KeyDown))
+	_, cmd := p.Update(key(tea.KeyEnter))
+	run(p, cmd)
+	if p.phase != phaseDone || !p.skipped {
+		t.Fatalf("phase = %v, skipped = %v; want done and skipped", p.phase, p.skipped)
+	}
+	if snap, _ := snapRepo.Latest(context.Background()); snap == nil {
+		t.Error("skip should save a snapshot")
+	}
+
+	_, cmd = p.Update(key(tea.KeyEnter))
+	if _, ok := cmd().(router.ReplaceScreenMsg); !ok {
+		t.Error("continuing should replace the placement screen")
+	}
+}
+
+func TestPlacementScreen_TakeQuiz(t *testing.T) {
+	p, snapRepo := newTestScreen(t, "placement-screen-quiz")
+
+	_, cmd := p.Update(key(tea.KeyEnter))
+	run(p, cmd)
+	for i := 0; p.phase == phaseAsking && i < 20; i++ {
+		if p.question == nil {
+			t.Fatal("no question after generation")
+		}
+		p.Update(keyText('4'))
+		_, cmd = p.Update(key(tea.KeyEnter))
+		run(p, cmd)
+	}
+	if p.phase != phaseDone || p.errMsg != "" {
+		t.Fatalf("phase = %v, err = %q; want done", p.phase, p.errMsg)
+	}
+	if len(p.result.MasteredSkillIDs) == 0 {
+		t.Error("acing the quiz should place some skills")
+	}
+	snap, _ := snapRepo.Latest(context.Background())
+	if snap == nil || len(snap.Data.Mastery.Skills) == 0 {
+		t.Error("placement should seed the snapshot")
+	}
+}
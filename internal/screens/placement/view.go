@@ -0,0 +1,191 @@
+package placement
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/ui/diagram"
+	"github.com/abhisek/mathiz/internal/ui/theme"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+func (p *PlacementScreen) View(width, height int) string {
+	switch p.phase {
+	case phaseIntro:
+		return p.renderIntro(width)
+	case phaseAsking:
+		return p.renderQuestion(width)
+	case phaseSaving:
+		return centered(width, theme.TextDim, fmt.Sprintf("\n\n\n  %s %s", p.frame(), i18n.T("Saving your placement...")))
+	default:
+		return p.renderDone(width)
+	}
+}
+
+func (p *PlacementScreen) renderIntro(width int) string {
+	var b strings.Builder
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(theme.Primary).
+		Bold(true).
+		Render(i18n.T("Let's find your starting point!")))
+	b.WriteString("\n\n")
+	b.WriteString(centered(width, theme.TextDim,
+		i18n.Sprintf("A quick quiz of up to %d questions skips the skills you already know.", p.quiz.MaxQuestions())))
+	b.WriteString("\n\n")
+
+	options := []string{i18n.T("Take the placement quiz"), i18n.T("Skip — start from the beginning")}
+	optionWidth := min(width-12, 44)
+	for i, label := range options {
+		style := lipgloss.NewStyle().
+			Width(optionWidth).
+			Foreground(theme.Text).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(theme.Border).
+			Padding(0, 1)
+		if i == p.menu {
+			style = style.
+				Background(theme.BgCard).
+				Foreground(theme.Primary).
+				Bold(true).
+				BorderForeground(theme.Primary)
+		}
+		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, style.Render(label)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (p *PlacementScreen) renderQuestion(width int) string {
+	if p.question == nil {
+		var b strings.Builder
+		b.WriteString("\n")
+		if p.genErrMsg != "" {
+			b.WriteString(centered(width, theme.Error, "\n  "+p.genErrMsg))
+		}
+		b.WriteString("\n")
+		b.WriteString(centered(width, theme.TextDim, fmt.Sprintf("\n  %s Generating question...", p.frame())))
+		return b.String()
+	}
+
+	var b strings.Builder
+	asked := p.quiz.Result().QuestionsAsked
+	b.WriteString(lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Render("  " + i18n.Sprintf("Placement quiz — question %d of up to %d", asked+1, p.quiz.MaxQuestions())))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(theme.Border).Render(strings.Repeat("─", max(width-4, 0))))
+	b.WriteString("\n\n")
+
+	contentWidth := min(width-8, 60)
+	questionBlock := lipgloss.NewStyle().
+		Width(contentWidth).
+		Align(lipgloss.Center).
+		Foreground(theme.Text).
+		Bold(true).
+		Padding(1, 2).
+		Render(p.question.Text)
+	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, questionBlock))
+	b.WriteString("\n")
+	if pic := diagram.Render(p.question.Diagram); pic != "" {
+		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, pic))
+		b.WriteString("\n\n")
+	}
+
+	if p.mcActive {
+		optionWidth := min(width-12, 50)
+		for i, choice := range p.question.Choices {
+			style := lipgloss.NewStyle().
+				Width(optionWidth).
+				Foreground(theme.Text).
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(theme.Border).
+				Padding(0, 1)
+			if i == p.mcSelected {
+				style = style.
+					Background(theme.BgCard).
+					Foreground(theme.Primary).
+					Bold(true).
+					BorderForeground(theme.Primary)
+			}
+			b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, style.Render(fmt.Sprintf(" %d)  %s ", i+1, p.question.DisplayChoice(choice)))))
+			b.WriteString("\n")
+		}
+		return b.String()
+	}
+
+	var panel string
+	switch p.question.Format {
+	case problemgen.FormatOrdering:
+		panel = p.order.View()
+	case problemgen.FormatMultiSelect:
+		panel = p.multi.View()
+	case problemgen.FormatMultiBlank:
+		panel = p.blanks.View()
+	}
+	if panel != "" {
+		box := lipgloss.NewStyle().
+			Width(min(width-12, 50)).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(theme.Primary).
+			Padding(0, 2).
+			Render(panel)
+		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, box))
+		return b.String()
+	}
+
+	boxWidth := min(width-12, 40)
+	inputBox := lipgloss.NewStyle().
+		Width(boxWidth).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(0, 2).
+		Render(lipgloss.NewStyle().Foreground(theme.TextDim).Render(i18n.T("Your Answer")) + "  " + p.input.View())
+	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, inputBox))
+	return b.String()
+}
+
+func (p *PlacementScreen) renderDone(width int) string {
+	var b strings.Builder
+	b.WriteString("\n\n")
+	switch {
+	case p.errMsg != "":
+		b.WriteString(centered(width, theme.Error, i18n.Sprintf("Could not save your placement: %s", p.errMsg)))
+	case p.skipped:
+		b.WriteString(centered(width, theme.Text, i18n.T("No problem — you'll start from the beginning.")))
+	case len(p.result.MasteredSkillIDs) == 0:
+		b.WriteString(centered(width, theme.Text, i18n.T("Thanks! You'll start from the first skills in each strand.")))
+	default:
+		b.WriteString(lipgloss.NewStyle().
+			Width(width).
+			Align(lipgloss.Center).
+			Foreground(theme.Success).
+			Bold(true).
+			Render(i18n.Sprintf("Great work! %d skills marked as already known.", len(p.result.MasteredSkillIDs))))
+		b.WriteString("\n\n")
+		b.WriteString(centered(width, theme.TextDim, i18n.T("They'll come back now and then as quick reviews.")))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(centered(width, theme.TextDim, i18n.T("Press any key to continue.")))
+	return b.String()
+}
+
+func (p *PlacementScreen) frame() string {
+	return spinnerFrames[p.spinnerFrame%len(spinnerFrames)]
+}
+
+func centered(width int, fg color.Color, text string) string {
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(fg).
+		Render(text)
+}
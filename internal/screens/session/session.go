@@ -9,12 +9,14 @@ import (
 
 	"github.com/abhisek/mathiz/internal/diagnosis"
 	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/lessons"
 	"github.com/abhisek/mathiz/internal/mastery"
 	"github.com/abhisek/mathiz/internal/problemgen"
 	"github.com/abhisek/mathiz/internal/router"
 	"github.com/abhisek/mathiz/internal/screen"
 	sess "github.com/abhisek/mathiz/internal/session"
+	"github.com/abhisek/mathiz/internal/skillgraph"
 	"github.com/abhisek/mathiz/internal/spacedrep"
 	"github.com/abhisek/mathiz/internal/store"
 	"github.com/abhisek/mathiz/internal/ui/components"
@@ -45,13 +47,33 @@ type SessionScreen struct {
 	planner       sess.Planner
 	scheduler     *spacedrep.Scheduler
 	input         components.TextInput
-	mcActive      bool // true when showing multiple choice
+	mcActive      bool // true when showing multiple choice or true/false
 	mcSelected    int
+	order         components.OrderList   // ordering questions
+	multi         components.MultiSelect // multi-select questions
+	blanks        components.BlankInputs // multi-blank questions
 	errMsg        string
 
 	// Hint overlay.
 	showingHint bool
 
+	// lastAnswer is the answer shown in feedback, kept for reporting.
+	lastAnswer string
+
+	// reported is set once the question in feedback has been reported.
+	reported bool
+
+	// confirmingReport asks before reporting the current question, so a
+	// stray key press doesn't report it.
+	confirmingReport bool
+
+	// reportErr says a report failed to save, so the learner can retry.
+	reportErr string
+
+	// reportNote confirms a question skipped by reporting it, shown while
+	// the next one generates.
+	reportNote string
+
 	// Lesson view.
 	showingLesson   bool
 	currentLesson   *lessons.Lesson
@@ -99,13 +121,13 @@ func (s *SessionScreen) Init() tea.Cmd {
 }
 
 func (s *SessionScreen) Title() string {
-	return "Session"
+	return i18n.T("Session")
 }
 
 func (s *SessionScreen) KeyHints() []layout.KeyHint {
 	if s.llmFatalError {
 		return []layout.KeyHint{
-			{Key: "any key", Description: "End session"},
+			{Key: i18n.T("any key"), Description: i18n.T("End session")},
 		}
 	}
 	if s.state == nil {
@@ -113,38 +135,51 @@ func (s *SessionScreen) KeyHints() []layout.KeyHint {
 	}
 	if s.state.ShowingQuitConfirm {
 		return []layout.KeyHint{
-			{Key: "Y", Description: "End session"},
-			{Key: "N", Description: "Keep going"},
+			{Key: "Y", Description: i18n.T("End session")},
+			{Key: "N", Description: i18n.T("Keep going")},
+		}
+	}
+	if s.confirmingReport {
+		return []layout.KeyHint{
+			{Key: "Y", Description: i18n.T("Report question")},
+			{Key: "N", Description: i18n.T("Go back")},
 		}
 	}
 	if s.showingHint {
 		return []layout.KeyHint{
-			{Key: "any key", Description: "Close hint"},
+			{Key: i18n.T("any key"), Description: i18n.T("Close hint")},
 		}
 	}
 	if s.showingLesson {
 		if s.practicePhase == practiceShowingResult {
 			return []layout.KeyHint{
-				{Key: "any key", Description: "Continue"},
+				{Key: i18n.T("any key"), Description: i18n.T("Continue")},
 			}
 		}
 		return []layout.KeyHint{
-			{Key: "Enter", Description: "Submit"},
-			{Key: "q", Description: "Skip"},
+			{Key: "Enter", Description: i18n.T("Submit")},
+			{Key: "q", Description: i18n.T("Skip")},
 		}
 	}
 	if s.state.ShowingFeedback {
-		return []layout.KeyHint{
-			{Key: "any key", Description: "Continue"},
+		hints := []layout.KeyHint{
+			{Key: i18n.T("any key"), Description: i18n.T("Continue")},
+		}
+		if !s.reported {
+			hints = append(hints, layout.KeyHint{Key: "r", Description: i18n.T("Report question")})
 		}
+		return hints
 	}
 	hints := []layout.KeyHint{
-		{Key: "Enter", Description: "Submit"},
+		{Key: "Enter", Description: i18n.T("Submit")},
 	}
 	if s.state.HintAvailable && !s.state.HintShown {
-		hints = append(hints, layout.KeyHint{Key: "h", Description: "Hint"})
+		hints = append(hints, layout.KeyHint{Key: "h", Description: i18n.T("Hint")})
 	}
-	hints = append(hints, layout.KeyHint{Key: "Esc", Description: "Quit"})
+	if s.state.CurrentQuestion != nil {
+		hints = append(hints, layout.KeyHint{Key: "Ctrl+R", Description: i18n.T("Report & skip")})
+	}
+	hints = append(hints, layout.KeyHint{Key: "Esc", Description: i18n.T("Quit")})
 	return hints
 }
 
@@ -167,6 +202,9 @@ func (s *SessionScreen) View(width, height int) string {
 	if s.state.ShowingQuitConfirm {
 		return renderQuitConfirm(width, height)
 	}
+	if s.confirmingReport {
+		return renderReportConfirm(width, height)
+	}
 	if s.state.ShowingFeedback {
 		return s.renderFeedback(width, height)
 	}
@@ -208,9 +246,16 @@ func (s *SessionScreen) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
 	}
 
 	// Forward to input if active.
-	if s.state != nil && s.state.Phase == sess.PhaseActive && !s.state.ShowingFeedback && !s.state.ShowingQuitConfirm && !s.mcActive {
+	if s.state != nil && s.state.Phase == sess.PhaseActive && !s.state.ShowingFeedback && !s.state.ShowingQuitConfirm && !s.confirmingReport && !s.mcActive {
 		var cmd tea.Cmd
-		s.input, cmd = s.input.Update(msg)
+		switch s.answerFormat() {
+		case problemgen.FormatMultiBlank:
+			s.blanks, cmd = s.blanks.Update(msg)
+		case problemgen.FormatOrdering, problemgen.FormatMultiSelect:
+			// Key-driven only; nothing to forward.
+		default:
+			s.input, cmd = s.input.Update(msg)
+		}
 		return s, cmd
 	}
 
@@ -246,6 +291,10 @@ func (s *SessionScreen) initSession() tea.Cmd {
 		// Wire scheduler into planner if it supports it.
 		if dp, ok := s.planner.(*sess.DefaultPlanner); ok {
 			dp.SetScheduler(scheduler)
+			// Plan only skills the generator can serve (offline mode).
+			if l, ok := s.generator.(problemgen.SkillLimiter); ok {
+				dp.SetSkillFilter(l.Supports)
+			}
 		}
 
 		// Derive mastered set and tier progress from mastery service.
@@ -333,7 +382,7 @@ func (s *SessionScreen) handleQuestionReady(msg questionReadyMsg) (screen.Screen
 		}
 
 		// Show inline error and skip to next slot.
-		s.genErrMsg = "Could not generate question, trying next skill..."
+		s.genErrMsg = i18n.T("Could not generate question, trying next skill...")
 		if s.state != nil {
 			if !sess.AdvanceSlot(s.state) {
 				return s, func() tea.Msg { return sessionEndMsg{} }
@@ -347,6 +396,8 @@ func (s *SessionScreen) handleQuestionReady(msg questionReadyMsg) (screen.Screen
 	// Reset consecutive error count on success.
 	s.consecutiveGenErrors = 0
 	s.genErrMsg = ""
+	s.reportNote = ""
+	s.reportErr = ""
 
 	s.state.CurrentQuestion = msg.Question
 	s.state.QuestionsInSlot++
@@ -355,17 +406,33 @@ func (s *SessionScreen) handleQuestionReady(msg questionReadyMsg) (screen.Screen
 	s.state.HintAvailable = false
 
 	// Setup input based on question format.
-	if msg.Question.Format == problemgen.FormatMultipleChoice {
+	s.mcActive = false
+	switch msg.Question.Format {
+	case problemgen.FormatMultipleChoice, problemgen.FormatTrueFalse:
 		s.mcActive = true
 		s.mcSelected = 0
-	} else {
-		s.mcActive = false
+	case problemgen.FormatOrdering:
+		s.order = components.NewOrderList(msg.Question.DisplayChoices())
+	case problemgen.FormatMultiSelect:
+		s.multi = components.NewMultiSelect(msg.Question.DisplayChoices())
+	case problemgen.FormatMultiBlank:
+		s.blanks = components.NewBlankInputs(msg.Question.BlankCount(), 20)
+		return s, s.blanks.Init()
+	default:
 		s.input = components.NewTextInput("", false, 20)
 	}
 
 	return s, s.input.Init()
 }
 
+// answerFormat returns the format of the current question.
+func (s *SessionScreen) answerFormat() problemgen.AnswerFormat {
+	if s.state == nil || s.state.CurrentQuestion == nil {
+		return ""
+	}
+	return s.state.CurrentQuestion.Format
+}
+
 func (s *SessionScreen) handleQuestionFailed(msg questionGenFailedMsg) (screen.Screen, tea.Cmd) {
 	s.consecutiveGenErrors++
 
@@ -375,7 +442,7 @@ func (s *SessionScreen) handleQuestionFailed(msg questionGenFailedMsg) (screen.S
 		return s, nil
 	}
 
-	s.genErrMsg = "Could not generate question, trying next skill..."
+	s.genErrMsg = i18n.T("Could not generate question, trying next skill...")
 	if s.state != nil {
 		if !sess.AdvanceSlot(s.state) {
 			return s, func() tea.Msg { return sessionEndMsg{} }
@@ -419,6 +486,8 @@ func (s *SessionScreen) handleFeedbackDone() (screen.Screen, tea.Cmd) {
 	s.state.ShowingFeedback = false
 	s.state.Phase = sess.PhaseActive
 	s.state.CurrentQuestion = nil
+	s.reported = false
+	s.reportErr = ""
 	s.state.TierAdvanced = nil
 	s.state.MasteryTransition = nil
 	s.state.PendingGemAward = nil
@@ -468,6 +537,7 @@ func (s *SessionScreen) handleSessionEnd() (screen.Screen, tea.Cmd) {
 		QuestionsServed: s.state.TotalQuestions,
 		CorrectAnswers:  s.state.TotalCorrect,
 		DurationSecs:    durationSecs,
+		QuestionSources: s.state.QuestionSources,
 	})
 
 	// Award session gem if timer expired (not early quit).
@@ -537,8 +607,27 @@ func (s *SessionScreen) handleKey(msg tea.KeyMsg) (screen.Screen, tea.Cmd) {
 		return s, nil
 	}
 
-	// Feedback overlay — any key dismisses.
+	// Report confirmation dialog.
+	if s.confirmingReport {
+		switch key {
+		case "y", "Y":
+			s.confirmingReport = false
+			if s.state.ShowingFeedback {
+				return s.reportAnswered()
+			}
+			return s.reportAndSkip()
+		case "n", "N", "esc":
+			s.confirmingReport = false
+		}
+		return s, nil
+	}
+
+	// Feedback overlay — "r" reports the question, any other key dismisses.
 	if s.state.ShowingFeedback {
+		if key == "r" && !s.reported {
+			s.confirmingReport = true
+			return s, nil
+		}
 		return s, func() tea.Msg { return feedbackDoneMsg{} }
 	}
 
@@ -550,6 +639,11 @@ func (s *SessionScreen) handleKey(msg tea.KeyMsg) (screen.Screen, tea.Cmd) {
 			return s, nil
 		case "enter":
 			return s.submitAnswer()
+		case "ctrl+r":
+			if s.state.CurrentQuestion != nil {
+				s.confirmingReport = true
+			}
+			return s, nil
 		case "h":
 			if s.state.HintAvailable && !s.state.HintShown {
 				s.state.HintShown = true
@@ -602,10 +696,19 @@ func (s *SessionScreen) handleKey(msg tea.KeyMsg) (screen.Screen, tea.Cmd) {
 			}
 		}
 
-		// Forward to text input.
+		// Forward to the format's input component.
 		if !s.mcActive {
 			var cmd tea.Cmd
-			s.input, cmd = s.input.Update(msg)
+			switch s.answerFormat() {
+			case problemgen.FormatOrdering:
+				s.order, cmd = s.order.Update(msg)
+			case problemgen.FormatMultiSelect:
+				s.multi, cmd = s.multi.Update(msg)
+			case problemgen.FormatMultiBlank:
+				s.blanks, cmd = s.blanks.Update(msg)
+			default:
+				s.input, cmd = s.input.Update(msg)
+			}
 			return s, cmd
 		}
 	}
@@ -613,6 +716,40 @@ func (s *SessionScreen) handleKey(msg tea.KeyMsg) (screen.Screen, tea.Cmd) {
 	return s, nil
 }
 
+// reportAnswered reports the question shown in feedback, keeping the
+// overlay open.
+func (s *SessionScreen) reportAnswered() (screen.Screen, tea.Cmd) {
+	if err := sess.ReportQuestion(context.Background(), s.eventRepo, s.state, s.lastAnswer, ""); err != nil {
+		s.reportErr = i18n.T("Couldn't send the report — please try again.")
+		return s, nil
+	}
+	s.reported = true
+	s.reportErr = ""
+	return s, nil
+}
+
+// reportAndSkip reports the unanswered current question and moves on
+// without grading it: a broken question shouldn't cost the learner. If the
+// report can't be saved the question stays, so the learner can retry.
+func (s *SessionScreen) reportAndSkip() (screen.Screen, tea.Cmd) {
+	if s.state.CurrentQuestion == nil {
+		return s, nil
+	}
+	if err := sess.ReportQuestion(context.Background(), s.eventRepo, s.state, "", ""); err != nil {
+		s.reportErr = i18n.T("Couldn't send the report — please try again.")
+		return s, nil
+	}
+	s.state.CurrentQuestion = nil
+	s.reportNote = i18n.T("Thanks for reporting that question — here's another one.")
+
+	if sess.ShouldAdvanceSlot(s.state) {
+		if !sess.AdvanceSlot(s.state) {
+			return s, func() tea.Msg { return sessionEndMsg{} }
+		}
+	}
+	return s, tea.Batch(s.generateNextQuestion(), spinnerTickCmd())
+}
+
 // handleLessonKey processes key presses during lesson view.
 func (s *SessionScreen) handleLessonKey(key string, msg tea.KeyMsg) (screen.Screen, tea.Cmd) {
 	if s.practicePhase == practiceShowingResult {
@@ -683,11 +820,25 @@ func (s *SessionScreen) submitAnswer() (screen.Screen, tea.Cmd) {
 	}
 
 	var learnerAnswer string
-	if s.mcActive {
+	switch {
+	case s.mcActive:
 		if s.mcSelected >= 0 && s.mcSelected < len(s.state.CurrentQuestion.Choices) {
 			learnerAnswer = s.state.CurrentQuestion.Choices[s.mcSelected]
 		}
-	} else {
+	case s.answerFormat() == problemgen.FormatOrdering:
+		learnerAnswer = problemgen.JoinAnswer(s.order.Values())
+	case s.answerFormat() == problemgen.FormatMultiSelect:
+		selected := s.multi.Selected()
+		if len(selected) == 0 {
+			return s, nil
+		}
+		learnerAnswer = problemgen.JoinAnswer(selected)
+	case s.answerFormat() == problemgen.FormatMultiBlank:
+		if !s.blanks.Filled() {
+			return s, nil
+		}
+		learnerAnswer = problemgen.JoinAnswer(s.blanks.Values())
+	default:
 		learnerAnswer = s.input.Value()
 		if learnerAnswer == "" {
 			return s, nil
@@ -696,6 +847,7 @@ func (s *SessionScreen) submitAnswer() (screen.Screen, tea.Cmd) {
 
 	// Record answer time.
 	timeMs := int(time.Since(s.state.QuestionStartTime).Milliseconds())
+	s.lastAnswer = learnerAnswer
 
 	// Handle the answer (updates state, checks tier advancement).
 	adv := sess.HandleAnswer(s.state, learnerAnswer)
@@ -703,6 +855,11 @@ func (s *SessionScreen) submitAnswer() (screen.Screen, tea.Cmd) {
 
 	ctx := context.Background()
 
+	// Feed the question bank's answer statistics.
+	if r, ok := s.generator.(problemgen.AnswerRecorder); ok {
+		r.RecordAnswer(ctx, s.state.CurrentQuestion, s.state.LastAnswerCorrect)
+	}
+
 	// Persist mastery transition event if applicable.
 	if s.state.MasteryTransition != nil && s.state.MasteryService != nil {
 		t := s.state.MasteryTransition
@@ -735,6 +892,9 @@ func (s *SessionScreen) submitAnswer() (screen.Screen, tea.Cmd) {
 		Correct:       s.state.LastAnswerCorrect,
 		TimeMs:        timeMs,
 		AnswerFormat:  string(s.state.CurrentQuestion.Format),
+		Source:        s.state.CurrentQuestion.Source,
+		LLMRequestID:  s.state.CurrentQuestion.LLMRequestID,
+		LLMOwner:      s.state.CurrentQuestion.LLMOwner,
 	})
 
 	// If tier advanced mid-block, mark slot as completed.
@@ -768,17 +928,21 @@ func (s *SessionScreen) generateNextQuestion() tea.Cmd {
 
 		// Use the actual current tier from mastery service, not the fixed plan tier.
 		tier := slot.Tier
+		var band problemgen.DifficultyBand
 		if state.MasteryService != nil {
 			if sm := state.MasteryService.GetMastery(slot.Skill.ID); sm != nil {
 				tier = sm.CurrentTier
+				band = sess.TargetDifficulty(sm)
 			}
 		}
 
 		input := problemgen.GenerateInput{
-			Skill:          slot.Skill,
-			Tier:           tier,
-			PriorQuestions: state.PriorQuestions[slot.Skill.ID],
-			RecentErrors:   state.RecentErrors[slot.Skill.ID],
+			Skill:            slot.Skill,
+			Tier:             tier,
+			PriorQuestions:   state.PriorQuestions[slot.Skill.ID],
+			RecentErrors:     state.RecentErrors[slot.Skill.ID],
+			TargetDifficulty: band,
+			Locale:           i18n.Active(),
 		}
 
 		// Include learner profile if available from snapshot.
@@ -792,7 +956,8 @@ func (s *SessionScreen) generateNextQuestion() tea.Cmd {
 		ctx, cancel := context.WithTimeout(context.Background(), generateTimeout)
 		defer cancel()
 
-		q, err := s.generator.Generate(ctx, input)
+		n := sess.QuestionsPerSlot - state.QuestionsInSlot
+		q, err := sess.NextQuestion(ctx, s.generator, state, input, n)
 		if err != nil {
 			return questionReadyMsg{Err: err}
 		}
@@ -804,7 +969,7 @@ func (s *SessionScreen) generateNextQuestion() tea.Cmd {
 // generation through the shared end-of-session path
 // (sess.SaveSnapshotWithProfile) — the same code the game manager runs.
 func (s *SessionScreen) saveSnapshotWithProfile(ctx context.Context) {
-	snapData := store.SnapshotData{Version: 4}
+	snapData := store.SnapshotData{Version: 4, GraphVersion: skillgraph.GraphVersion()}
 
 	if s.state.MasteryService != nil {
 		snapData.Mastery = s.state.MasteryService.SnapshotData()
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	sess "github.com/abhisek/mathiz/internal/session"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 	"github.com/abhisek/mathiz/internal/store"
+	"github.com/abhisek/mathiz/internal/ui/components"
 )
 
 // mockGenerator implements problemgen.Generator for testing.
@@ -30,14 +32,20 @@ func (m *mockGenerator) Generate(_ context.Context, input problemgen.GenerateInp
 	return &q, nil
 }
 
+func (m *mockGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	return problemgen.GenerateEach(ctx, m, input, n)
+}
+
 // mockEventRepo implements store.EventRepo for testing.
 type mockEventRepo struct {
 	sessionEvents []store.SessionEventData
 	answerEvents  []store.AnswerEventData
+	reports       []store.QuestionReportData
+	reportErr     error
 }
 
-func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) error {
-	return nil
+func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) (int, error) {
+	return 0, nil
 }
 func (m *mockEventRepo) AppendSessionEvent(_ context.Context, data store.SessionEventData) error {
 	m.sessionEvents = append(m.sessionEvents, data)
@@ -65,6 +73,16 @@ func (m *mockEventRepo) AppendDiagnosisEvent(_ context.Context, _ store.Diagnosi
 func (m *mockEventRepo) AppendHintEvent(_ context.Context, _ store.HintEventData) error {
 	return nil
 }
+func (m *mockEventRepo) AppendQuestionReport(_ context.Context, data store.QuestionReportData) error {
+	if m.reportErr != nil {
+		return m.reportErr
+	}
+	m.reports = append(m.reports, data)
+	return nil
+}
+func (m *mockEventRepo) QueryQuestionReports(_ context.Context, _ store.QueryOpts) ([]store.QuestionReportRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) AppendLessonEvent(_ context.Context, _ store.LessonEventData) error {
 	return nil
 }
@@ -112,6 +130,9 @@ func (m *mockEventRepo) QueryMasteryEvents(_ context.Context, _ store.QueryOpts)
 func (m *mockEventRepo) AnswersForSession(_ context.Context, _ string) ([]store.AnswerEventRecord, error) {
 	return nil, nil
 }
+func (m *mockEventRepo) AnswerHistory(_ context.Context, _ store.QueryOpts) ([]store.AnswerEventRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) HintCountForSession(_ context.Context, _ string) (int, error) {
 	return 0, nil
 }
@@ -263,6 +284,89 @@ func TestSessionScreen_FeedbackDismiss(t *testing.T) {
 	}
 }
 
+func TestSessionScreen_ReportInFeedback(t *testing.T) {
+	s, eventRepo, _ := testSessionScreen()
+	setupActiveSession(s)
+
+	s.input.Model.SetValue("3")
+	var scr screen.Screen = s
+	scr, _ = scr.Update(specialKey(tea.KeyEnter))
+
+	// "r" asks first; "n" backs out without reporting.
+	scr, _ = scr.Update(keyPress('r'))
+	scr, cmd := scr.Update(keyPress('n'))
+	if cmd != nil || len(eventRepo.reports) != 0 {
+		t.Fatalf("declined report: cmd = %v, reports = %d", cmd, len(eventRepo.reports))
+	}
+
+	// "r" then "y" reports instead of dismissing; a second "r" dismisses.
+	scr, _ = scr.Update(keyPress('r'))
+	scr, cmd = scr.Update(keyPress('y'))
+	if cmd != nil {
+		t.Error("report should keep the feedback open")
+	}
+	if len(eventRepo.reports) != 1 {
+		t.Fatalf("reports = %d, want 1", len(eventRepo.reports))
+	}
+	if got := eventRepo.reports[0]; got.QuestionText != "What is 1 + 1?" || got.LearnerAnswer != "3" || got.Reporter != store.ReporterLearner {
+		t.Errorf("report = %+v", got)
+	}
+	if _, cmd := scr.Update(keyPress('r')); cmd == nil {
+		t.Error("expected a second r to dismiss feedback")
+	}
+	if len(eventRepo.reports) != 1 {
+		t.Errorf("reports = %d, want 1", len(eventRepo.reports))
+	}
+}
+
+func TestSessionScreen_ReportAndSkip(t *testing.T) {
+	s, eventRepo, _ := testSessionScreen()
+	setupActiveSession(s)
+
+	var scr screen.Screen = s
+	scr, _ = scr.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	scr, cmd := scr.Update(keyPress('y'))
+	ss := scr.(*SessionScreen)
+
+	if cmd == nil {
+		t.Error("expected the next question to generate")
+	}
+	if len(eventRepo.reports) != 1 || eventRepo.reports[0].LearnerAnswer != "" {
+		t.Fatalf("reports = %+v, want one unanswered report", eventRepo.reports)
+	}
+	if len(eventRepo.answerEvents) != 0 || ss.state.TotalQuestions != 0 {
+		t.Error("a skipped question must not be graded")
+	}
+	if ss.state.CurrentQuestion != nil {
+		t.Error("expected the reported question to be cleared")
+	}
+	skillID := ss.state.Plan.Slots[0].Skill.ID
+	if prior := ss.state.PriorQuestions[skillID]; len(prior) != 1 {
+		t.Errorf("prior questions = %v, want the reported one", prior)
+	}
+}
+
+func TestSessionScreen_ReportFailureKeepsQuestion(t *testing.T) {
+	s, eventRepo, _ := testSessionScreen()
+	setupActiveSession(s)
+	eventRepo.reportErr = errors.New("disk full")
+
+	var scr screen.Screen = s
+	scr, _ = scr.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	scr, cmd := scr.Update(keyPress('y'))
+	ss := scr.(*SessionScreen)
+
+	if cmd != nil {
+		t.Error("a failed report must not move on")
+	}
+	if ss.state.CurrentQuestion == nil {
+		t.Error("a failed report must keep the question")
+	}
+	if ss.reportErr == "" {
+		t.Error("expected a failure message")
+	}
+}
+
 func TestSessionScreen_AnswerSubmit(t *testing.T) {
 	s, eventRepo, _ := testSessionScreen()
 	setupActiveSession(s)
@@ -312,6 +416,54 @@ func TestSessionScreen_MultipleChoice(t *testing.T) {
 	}
 }
 
+func TestSessionScreen_Ordering(t *testing.T) {
+	s, _, _ := testSessionScreen()
+	setupActiveSession(s)
+
+	s.state.CurrentQuestion.Format = problemgen.FormatOrdering
+	s.state.CurrentQuestion.Choices = []string{"5", "2", "9"}
+	s.state.CurrentQuestion.Answer = "2; 5; 9"
+	s.order = components.NewOrderList(s.state.CurrentQuestion.Choices)
+
+	// Pick up "5", carry it down one slot, drop it, then submit.
+	var scr screen.Screen = s
+	for _, msg := range []tea.Msg{keyPress(' '), specialKey(tea.KeyDown), keyPress(' '), specialKey(tea.KeyEnter)} {
+		scr, _ = scr.Update(msg)
+	}
+	ss := scr.(*SessionScreen)
+
+	if !ss.state.ShowingFeedback {
+		t.Fatal("expected feedback after ordering answer")
+	}
+	if !ss.state.LastAnswerCorrect {
+		t.Error("expected 2; 5; 9 to be correct")
+	}
+}
+
+func TestSessionScreen_MultiBlankNeedsEveryBlank(t *testing.T) {
+	s, _, _ := testSessionScreen()
+	setupActiveSession(s)
+
+	s.state.CurrentQuestion.Format = problemgen.FormatMultiBlank
+	s.state.CurrentQuestion.Text = "__ + __ = 10"
+	s.state.CurrentQuestion.Answer = "4; 6"
+	s.blanks = components.NewBlankInputs(2, 20)
+	s.blanks.Inputs[0].Model.SetValue("4")
+
+	var scr screen.Screen = s
+	scr, _ = scr.Update(specialKey(tea.KeyEnter))
+	if scr.(*SessionScreen).state.ShowingFeedback {
+		t.Fatal("submitted with an empty blank")
+	}
+
+	s.blanks.Inputs[1].Model.SetValue("6")
+	scr, _ = scr.Update(specialKey(tea.KeyEnter))
+	ss := scr.(*SessionScreen)
+	if !ss.state.ShowingFeedback || !ss.state.LastAnswerCorrect {
+		t.Error("expected 4; 6 to be accepted")
+	}
+}
+
 func TestSessionScreen_KeyHints(t *testing.T) {
 	s, _, _ := testSessionScreen()
 	setupActiveSession(s)
@@ -9,9 +9,13 @@ import (
 	"charm.land/lipgloss/v2"
 
 	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/problemgen"
 	sess "github.com/abhisek/mathiz/internal/session"
 	"github.com/abhisek/mathiz/internal/skillgraph"
 	"github.com/abhisek/mathiz/internal/ui/components"
+	"github.com/abhisek/mathiz/internal/ui/diagram"
 	"github.com/abhisek/mathiz/internal/ui/theme"
 )
 
@@ -31,6 +35,13 @@ func (s *SessionScreen) renderQuestionView(width, height int) string {
 				Foreground(theme.Error).
 				Render(fmt.Sprintf("\n  %s", s.genErrMsg)))
 			b.WriteString("\n")
+		} else if s.reportNote != "" {
+			b.WriteString(lipgloss.NewStyle().
+				Width(width).
+				Align(lipgloss.Center).
+				Foreground(theme.Text).
+				Render(fmt.Sprintf("\n  %s", s.reportNote)))
+			b.WriteString("\n")
 		} else {
 			b.WriteString("\n")
 		}
@@ -38,7 +49,7 @@ func (s *SessionScreen) renderQuestionView(width, height int) string {
 			Width(width).
 			Align(lipgloss.Center).
 			Foreground(theme.TextDim).
-			Render(fmt.Sprintf("\n  %s Generating question...", frame)))
+			Render(fmt.Sprintf("\n  %s %s", frame, i18n.T("Generating question..."))))
 		return b.String()
 	}
 
@@ -65,7 +76,7 @@ func (s *SessionScreen) renderQuestionView(width, height int) string {
 		Bold(true).
 		Render(fmt.Sprintf("  %s", skillName))
 
-	slotStr := fmt.Sprintf("Slot %d/%d", state.CurrentSlotIndex+1, len(state.Plan.Slots))
+	slotStr := i18n.Sprintf("Slot %d/%d", state.CurrentSlotIndex+1, len(state.Plan.Slots))
 	infoRight := lipgloss.NewStyle().
 		Foreground(theme.TextDim).
 		Render(fmt.Sprintf("%s  %s %d  %s %s",
@@ -105,16 +116,27 @@ func (s *SessionScreen) renderQuestionView(width, height int) string {
 	questionBlock := questionStyle.Render(q.Text)
 	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, questionBlock))
 	b.WriteString("\n")
+	if pic := diagram.Render(q.Diagram); pic != "" {
+		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, pic))
+		b.WriteString("\n\n")
+	}
 
 	// Input area.
-	if s.mcActive {
+	switch {
+	case s.mcActive:
 		b.WriteString(s.renderMultipleChoice(width))
-	} else {
+	case q.Format == problemgen.FormatOrdering:
+		b.WriteString(renderAnswerPanel(s.order.View(), i18n.T("↑/↓ move · Space pick up/drop · Enter submit"), width))
+	case q.Format == problemgen.FormatMultiSelect:
+		b.WriteString(renderAnswerPanel(s.multi.View(), i18n.T("Space or 1-9 toggle · Enter submit"), width))
+	case q.Format == problemgen.FormatMultiBlank:
+		b.WriteString(renderAnswerPanel(s.blanks.View(), i18n.T("Tab next blank · Enter submit"), width))
+	default:
 		// Framed answer input — fixed width.
 		boxWidth := min(width-12, 40)
 		inputLabel := lipgloss.NewStyle().
 			Foreground(theme.TextDim).
-			Render("Your Answer")
+			Render(i18n.T("Your Answer"))
 		inputView := s.input.View()
 		inputLine := inputLabel + "  " + inputView
 		inputBox := lipgloss.NewStyle().
@@ -126,6 +148,15 @@ func (s *SessionScreen) renderQuestionView(width, height int) string {
 		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, inputBox))
 	}
 
+	if s.reportErr != "" {
+		b.WriteString("\n\n")
+		b.WriteString(lipgloss.NewStyle().
+			Width(width).
+			Align(lipgloss.Center).
+			Foreground(theme.Error).
+			Render(s.reportErr))
+	}
+
 	return b.String()
 }
 
@@ -144,11 +175,9 @@ func (s *SessionScreen) renderTierProgress(slot *sess.PlanSlot, width int) strin
 		}
 	}
 
-	tierLabel := "Learn"
+	tierLabel := i18n.T(currentTier.DisplayName())
 	if slot.Category == sess.CategoryReview {
-		tierLabel = "Review"
-	} else if currentTier == skillgraph.TierProve {
-		tierLabel = "Prove"
+		tierLabel = i18n.T("Review")
 	}
 
 	tierRendered := lipgloss.NewStyle().
@@ -156,36 +185,31 @@ func (s *SessionScreen) renderTierProgress(slot *sess.PlanSlot, width int) strin
 		Bold(true).
 		Render(fmt.Sprintf("  %s", tierLabel))
 
-	// Calculate correct/needed from mastery service using the actual current tier.
-	var correct, needed int
+	// Progress towards completing the actual current tier. The rule-based
+	// model shows correct/needed; other models show a percentage.
 	cfg := slot.Skill.Tiers[currentTier]
-
-	if s.state.MasteryService != nil {
-		sm := s.state.MasteryService.GetMastery(slot.Skill.ID)
-		if sm != nil {
-			needed = int(math.Ceil(float64(cfg.ProblemsRequired)*cfg.AccuracyThreshold)) + sm.MisconceptionPenalty
-			correct = sm.CorrectCount
-			if correct > needed {
-				correct = needed
-			}
+	var pct float64
+	var fraction string
+	if svc := s.state.MasteryService; svc != nil && svc.Model().Kind() != mastery.ModelRules {
+		pct = svc.TierProgress(slot.Skill.ID, cfg)
+		fraction = fmt.Sprintf(" %d%%", int(pct*100))
+	} else {
+		needed := int(math.Ceil(float64(cfg.ProblemsRequired) * cfg.AccuracyThreshold))
+		var correct int
+		if svc != nil {
+			sm := svc.GetMastery(slot.Skill.ID)
+			needed = sm.RequiredCorrect(cfg)
+			correct = min(sm.CorrectCount, needed)
 		}
-	}
-	if needed == 0 {
-		needed = int(math.Ceil(float64(cfg.ProblemsRequired) * cfg.AccuracyThreshold))
+		if needed > 0 {
+			pct = float64(correct) / float64(needed)
+		}
+		fraction = fmt.Sprintf(" %d/%d", correct, needed)
 	}
 
 	fractionStr := lipgloss.NewStyle().
 		Foreground(theme.TextDim).
-		Render(fmt.Sprintf(" %d/%d", correct, needed))
-
-	// Progress bar.
-	var pct float64
-	if needed > 0 {
-		pct = float64(correct) / float64(needed)
-	}
-	if pct > 1.0 {
-		pct = 1.0
-	}
+		Render(fraction)
 
 	labelWidth := lipgloss.Width(tierRendered) + lipgloss.Width(fractionStr) + 2
 	barWidth := width - labelWidth - 6
@@ -208,7 +232,7 @@ func (s *SessionScreen) renderMultipleChoice(width int) string {
 
 	var b strings.Builder
 	for i, choice := range q.Choices {
-		label := fmt.Sprintf(" %d)  %s ", i+1, choice)
+		label := fmt.Sprintf(" %d)  %s ", i+1, q.DisplayChoice(choice))
 
 		var option string
 		if i == s.mcSelected {
@@ -237,13 +261,33 @@ func (s *SessionScreen) renderMultipleChoice(width int) string {
 	hint := lipgloss.NewStyle().
 		Foreground(theme.TextDim).
 		Italic(true).
-		Render("Select (1-4) or use arrows + Enter")
+		Render(i18n.Sprintf("Select (1-%d) or use arrows + Enter", len(q.Choices)))
 	b.WriteString("\n")
 	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, hint))
 
 	return b.String()
 }
 
+// renderAnswerPanel frames a multi-part answer component with a key hint.
+func renderAnswerPanel(body, hint string, width int) string {
+	boxWidth := min(width-12, 50)
+	box := lipgloss.NewStyle().
+		Width(boxWidth).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(0, 2).
+		Render(body)
+
+	var b strings.Builder
+	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, box))
+	b.WriteString("\n\n")
+	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Italic(true).
+		Render(hint)))
+	return b.String()
+}
+
 // renderFeedback renders the feedback overlay.
 func (s *SessionScreen) renderFeedback(width, height int) string {
 	state := s.state
@@ -258,21 +302,33 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 			Align(lipgloss.Center).
 			Foreground(theme.Success).
 			Bold(true).
-			Render("Correct!"))
+			Render(i18n.T("Correct!")))
 	} else {
+		headline, color := i18n.T("Not quite"), theme.Error
+		if state.LastGrade.Partial() {
+			headline, color = i18n.T("So close!"), theme.Accent
+		}
 		b.WriteString(lipgloss.NewStyle().
 			Width(width).
 			Align(lipgloss.Center).
-			Foreground(theme.Error).
+			Foreground(color).
 			Bold(true).
-			Render("Not quite"))
+			Render(headline))
+		if reason := state.LastGrade.Reason; reason != "" {
+			b.WriteString("\n")
+			b.WriteString(lipgloss.NewStyle().
+				Width(width).
+				Align(lipgloss.Center).
+				Foreground(theme.Text).
+				Render(i18n.T(reason)))
+		}
 		if q != nil {
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.TextDim).
-				Render(fmt.Sprintf("Correct answer: %s", q.Answer)))
+				Render(i18n.Sprintf("Correct answer: %s", q.DisplayAnswer())))
 		}
 	}
 
@@ -292,24 +348,24 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 	if state.MasteryTransition != nil {
 		t := state.MasteryTransition
 		switch t.Trigger {
-		case "prove-complete":
+		case "prove-complete", "challenge-complete":
 			fluencyStr := ""
 			if state.MasteryService != nil {
 				sm := state.MasteryService.GetMastery(t.SkillID)
-				fluencyStr = fmt.Sprintf(" Fluency: %.2f", sm.FluencyScore())
+				fluencyStr = " " + i18n.Sprintf("Fluency: %.2f", sm.FluencyScore())
 			}
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Accent).
 				Bold(true).
-				Render("Skill mastered!"))
+				Render(i18n.T("Skill mastered!")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Text).
-				Render(fmt.Sprintf("\"%s\" — mastered!%s", t.SkillName, fluencyStr)))
+				Render(i18n.Sprintf("\"%s\" — mastered!", t.SkillName) + fluencyStr))
 			b.WriteString("\n\n")
 		case "recovery-complete":
 			b.WriteString(lipgloss.NewStyle().
@@ -317,13 +373,13 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 				Align(lipgloss.Center).
 				Foreground(theme.Success).
 				Bold(true).
-				Render("Skill recovered!"))
+				Render(i18n.T("Skill recovered!")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Text).
-				Render(fmt.Sprintf("\"%s\" — back to mastered!", t.SkillName)))
+				Render(i18n.Sprintf("\"%s\" — back to mastered!", t.SkillName)))
 			b.WriteString("\n\n")
 		case "tier-complete":
 			b.WriteString(lipgloss.NewStyle().
@@ -331,13 +387,13 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 				Align(lipgloss.Center).
 				Foreground(theme.Accent).
 				Bold(true).
-				Render("Level up!"))
+				Render(i18n.T("Level up!")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Text).
-				Render(fmt.Sprintf("\"%s\" — Prove tier unlocked!", t.SkillName)))
+				Render(i18n.Sprintf("\"%s\" — %s tier unlocked!", t.SkillName, i18n.T(unlockedTier(state, t.SkillID).DisplayName()))))
 			b.WriteString("\n\n")
 		}
 	} else if state.TierAdvanced != nil {
@@ -349,26 +405,26 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 				Align(lipgloss.Center).
 				Foreground(theme.Accent).
 				Bold(true).
-				Render("Skill mastered!"))
+				Render(i18n.T("Skill mastered!")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Text).
-				Render(fmt.Sprintf("\"%s\" — fully mastered!", adv.SkillName)))
+				Render(i18n.Sprintf("\"%s\" — fully mastered!", adv.SkillName)))
 		} else {
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Accent).
 				Bold(true).
-				Render("Level up!"))
+				Render(i18n.T("Level up!")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Text).
-				Render(fmt.Sprintf("\"%s\" — Prove tier unlocked!", adv.SkillName)))
+				Render(i18n.Sprintf("\"%s\" — %s tier unlocked!", adv.SkillName, i18n.T(adv.ToTier.DisplayName()))))
 		}
 		b.WriteString("\n\n")
 	}
@@ -376,10 +432,10 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 	// Inline gem notification.
 	if state.PendingGemAward != nil {
 		award := state.PendingGemAward
-		gemLine := fmt.Sprintf("%s %s %s Gem",
+		gemLine := i18n.Sprintf("%s %s %s Gem",
 			award.Type.Icon(),
-			award.Rarity.DisplayName(),
-			award.Type.DisplayName())
+			i18n.T(award.Rarity.DisplayName()),
+			i18n.T(award.Type.DisplayName()))
 		reasonLine := fmt.Sprintf("\"%s\"", award.Reason)
 
 		b.WriteString(lipgloss.NewStyle().
@@ -398,11 +454,27 @@ func (s *SessionScreen) renderFeedback(width, height int) string {
 		b.WriteString("\n\n")
 	}
 
+	if s.reported {
+		b.WriteString(lipgloss.NewStyle().
+			Width(width).
+			Align(lipgloss.Center).
+			Foreground(theme.Text).
+			Render(i18n.T("Question reported — thanks for telling us!")))
+		b.WriteString("\n\n")
+	} else if s.reportErr != "" {
+		b.WriteString(lipgloss.NewStyle().
+			Width(width).
+			Align(lipgloss.Center).
+			Foreground(theme.Error).
+			Render(s.reportErr))
+		b.WriteString("\n\n")
+	}
+
 	b.WriteString(lipgloss.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render("Press any key to continue..."))
+		Render(i18n.T("Press any key to continue...")))
 
 	return b.String()
 }
@@ -433,26 +505,60 @@ func renderQuitConfirm(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Text).
 		Bold(true).
-		Render("End session early?"))
+		Render(i18n.T("End session early?")))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(theme.TextDim).
+		Render(i18n.T("Your progress will be saved.")))
+	b.WriteString("\n\n")
+
+	b.WriteString(lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(theme.Success).
+		Render(i18n.T("[Y] Yes, end session")))
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(theme.Primary).
+		Render(i18n.T("[N] No, keep going")))
+
+	return b.String()
+}
+
+// renderReportConfirm asks the learner to confirm reporting a question.
+func renderReportConfirm(width, height int) string {
+	var b strings.Builder
+	b.WriteString("\n\n\n")
+
+	b.WriteString(lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(theme.Text).
+		Bold(true).
+		Render(i18n.T("Report this question?")))
 	b.WriteString("\n")
 	b.WriteString(lipgloss.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render("Your progress will be saved."))
+		Render(i18n.T("Only if it's wrong or confusing.")))
 	b.WriteString("\n\n")
 
 	b.WriteString(lipgloss.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.Success).
-		Render("[Y] Yes, end session"))
+		Render(i18n.T("[Y] Yes, report it")))
 	b.WriteString("\n")
 	b.WriteString(lipgloss.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.Primary).
-		Render("[N] No, keep going"))
+		Render(i18n.T("[N] No, go back")))
 
 	return b.String()
 }
@@ -463,7 +569,7 @@ func renderLoading(width, height int) string {
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render("\n\n\n  Preparing your session...")
+		Render("\n\n\n  " + i18n.T("Preparing your session..."))
 }
 
 // renderError renders an error message.
@@ -472,7 +578,7 @@ func renderError(width, height int, errMsg string) string {
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.Error).
-		Render(fmt.Sprintf("\n\n\n  Error: %s\n\n  Press any key to go back.", errMsg))
+		Render(fmt.Sprintf("\n\n\n  %s\n\n  %s", i18n.Sprintf("Error: %s", errMsg), i18n.T("Press any key to go back.")))
 }
 
 // renderLLMFatalError renders the fatal LLM error screen when consecutive
@@ -486,13 +592,13 @@ func renderLLMFatalError(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Error).
 		Bold(true).
-		Render("Unable to Generate Problems"))
+		Render(i18n.T("Unable to Generate Problems")))
 	b.WriteString("\n\n")
 
 	contentWidth := min(width-8, 60)
-	msg := "Multiple attempts to generate a question have failed. " +
+	msg := i18n.T("Multiple attempts to generate a question have failed. " +
 		"This is likely due to an issue with the LLM provider. " +
-		"Your progress has been saved."
+		"Your progress has been saved.")
 	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center,
 		lipgloss.NewStyle().
 			Width(contentWidth).
@@ -505,7 +611,7 @@ func renderLLMFatalError(width, height int) string {
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render("Press any key to end the session."))
+		Render(i18n.T("Press any key to end the session.")))
 
 	return b.String()
 }
@@ -528,7 +634,7 @@ func (s *SessionScreen) renderHintOverlay(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Accent).
 		Bold(true).
-		Render("Hint"))
+		Render(i18n.T("Hint")))
 	b.WriteString("\n\n")
 
 	// Hint text.
@@ -551,7 +657,7 @@ func (s *SessionScreen) renderHintOverlay(width, height int) string {
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render("Press any key to close..."))
+		Render(i18n.T("Press any key to close...")))
 
 	return b.String()
 }
@@ -574,7 +680,7 @@ func (s *SessionScreen) renderLessonView(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Accent).
 		Bold(true).
-		Render(fmt.Sprintf("Lesson: %s", lesson.Title)))
+		Render(i18n.Sprintf("Lesson: %s", lesson.Title)))
 	b.WriteString("\n\n")
 
 	// Explanation.
@@ -590,7 +696,7 @@ func (s *SessionScreen) renderLessonView(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Secondary).
 		Bold(true).
-		Render("Worked Example"))
+		Render(i18n.T("Worked Example")))
 	b.WriteString("\n\n")
 
 	// Worked example.
@@ -606,7 +712,7 @@ func (s *SessionScreen) renderLessonView(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Secondary).
 		Bold(true).
-		Render("Your Turn"))
+		Render(i18n.T("Your Turn")))
 	b.WriteString("\n\n")
 
 	// Practice question.
@@ -625,20 +731,20 @@ func (s *SessionScreen) renderLessonView(width, height int) string {
 				Align(lipgloss.Center).
 				Foreground(theme.Success).
 				Bold(true).
-				Render("Correct!"))
+				Render(i18n.T("Correct!")))
 		} else {
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.Error).
 				Bold(true).
-				Render("Not quite"))
+				Render(i18n.T("Not quite")))
 			b.WriteString("\n")
 			b.WriteString(lipgloss.NewStyle().
 				Width(width).
 				Align(lipgloss.Center).
 				Foreground(theme.TextDim).
-				Render(fmt.Sprintf("Correct answer: %s", lesson.PracticeQuestion.Answer)))
+				Render(i18n.Sprintf("Correct answer: %s", lesson.Locale.FormatNumbers(lesson.PracticeQuestion.Answer))))
 		}
 		b.WriteString("\n")
 		b.WriteString(lipgloss.NewStyle().
@@ -650,13 +756,13 @@ func (s *SessionScreen) renderLessonView(width, height int) string {
 			Width(width).
 			Align(lipgloss.Center).
 			Foreground(theme.TextDim).
-			Render("Press any key to continue..."))
+			Render(i18n.T("Press any key to continue...")))
 	} else {
 		// Show answer input.
 		answerLine := lipgloss.NewStyle().
 			Width(width).
 			Align(lipgloss.Center).
-			Render("Answer: " + s.practiceInput.View())
+			Render(i18n.T("Answer:") + " " + s.practiceInput.View())
 		b.WriteString(answerLine)
 	}
 
@@ -669,3 +775,12 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+// unlockedTier is the tier a learner just moved into on a tier-complete
+// transition.
+func unlockedTier(state *sess.SessionState, skillID string) skillgraph.Tier {
+	if state.MasteryService == nil {
+		return skillgraph.TierProve
+	}
+	return state.MasteryService.GetMastery(skillID).CurrentTier
+}
@@ -1,42 +1,81 @@
 package skillmap
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/mastery"
 	"github.com/abhisek/mathiz/internal/screen"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
 	"github.com/abhisek/mathiz/internal/ui/layout"
 	"github.com/abhisek/mathiz/internal/ui/theme"
 )
 
+// accuracyDays is how many practice days the accuracy sparkline covers.
+const accuracyDays = 14
+
+type analyticsLoadedMsg struct {
+	SkillID   string
+	Analytics *mastery.SkillAnalytics // nil if the skill has no answers
+	Err       error
+}
+
 // SkillDetailScreen shows details for a single skill.
 type SkillDetailScreen struct {
-	skill    skillgraph.Skill
-	state    skillgraph.SkillState
-	mastered map[string]bool
+	skill     skillgraph.Skill
+	state     skillgraph.SkillState
+	mastered  map[string]bool
+	shaky     []string // rusty prerequisites of a mastered skill
+	eventRepo store.EventRepo
+	analytics *mastery.SkillAnalytics
+	loaded    bool
 }
 
 var _ screen.Screen = (*SkillDetailScreen)(nil)
 var _ screen.KeyHintProvider = (*SkillDetailScreen)(nil)
 
-func newSkillDetail(skill skillgraph.Skill, state skillgraph.SkillState, mastered map[string]bool) *SkillDetailScreen {
-	return &SkillDetailScreen{skill: skill, state: state, mastered: mastered}
+func newSkillDetail(skill skillgraph.Skill, state skillgraph.SkillState, mastered map[string]bool, shaky []string, eventRepo store.EventRepo) *SkillDetailScreen {
+	return &SkillDetailScreen{skill: skill, state: state, mastered: mastered, shaky: shaky, eventRepo: eventRepo}
 }
 
-func (d *SkillDetailScreen) Init() tea.Cmd  { return nil }
-func (d *SkillDetailScreen) Title() string  { return d.skill.Name }
+// Init loads the skill's answer history for the fluency panel, including
+// answers recorded under the IDs it had before a graph migration.
+func (d *SkillDetailScreen) Init() tea.Cmd {
+	if d.eventRepo == nil {
+		return nil
+	}
+	skillID := d.skill.ID
+	opts := store.QueryOpts{SkillIDs: append([]string{skillID}, skillgraph.FormerIDs(skillID)...)}
+	return func() tea.Msg {
+		answers, err := d.eventRepo.AnswerHistory(context.Background(), opts)
+		if err != nil {
+			return analyticsLoadedMsg{SkillID: skillID, Err: err}
+		}
+		return analyticsLoadedMsg{SkillID: skillID, Analytics: mastery.Analyze(answers)[skillID]}
+	}
+}
+
+func (d *SkillDetailScreen) Title() string { return d.skill.Name }
 
 func (d *SkillDetailScreen) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	if msg, ok := msg.(analyticsLoadedMsg); ok && msg.SkillID == d.skill.ID {
+		d.analytics = msg.Analytics
+		d.loaded = msg.Err == nil
+	}
 	return d, nil
 }
 
 func (d *SkillDetailScreen) KeyHints() []layout.KeyHint {
 	return []layout.KeyHint{
-		{Key: "Esc", Description: "Back"},
+		{Key: "Esc", Description: i18n.T("Back")},
 	}
 }
 
@@ -57,8 +96,15 @@ func (d *SkillDetailScreen) View(width, height int) string {
 	b.WriteString("\n")
 	b.WriteString(lipgloss.NewStyle().
 		Foreground(theme.TextDim).
-		Render(fmt.Sprintf("  %s", d.state.Label())))
-	b.WriteString("\n\n")
+		Render("  " + i18n.T(d.state.Label())))
+	b.WriteString("\n")
+	if len(d.shaky) > 0 {
+		b.WriteString(lipgloss.NewStyle().
+			Foreground(theme.Accent).
+			Render("  🧱 " + i18n.T("Shaky foundation: a prerequisite has gone rusty, so a review is coming up")))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
 
 	// Description.
 	if sk.Description != "" {
@@ -74,10 +120,10 @@ func (d *SkillDetailScreen) View(width, height int) string {
 	dimStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
 	valStyle := lipgloss.NewStyle().Foreground(theme.Text)
 
-	b.WriteString(dimStyle.Render("  Strand:    ") + valStyle.Render(skillgraph.StrandDisplayName(sk.Strand)) + "\n")
-	b.WriteString(dimStyle.Render("  Grade:     ") + valStyle.Render(fmt.Sprintf("%d", sk.GradeLevel)) + "\n")
-	if sk.CommonCoreID != "" {
-		b.WriteString(dimStyle.Render("  Standard:  ") + valStyle.Render(sk.CommonCoreID) + "\n")
+	b.WriteString(dimStyle.Render(fieldLabel("Strand:")) + valStyle.Render(i18n.T(skillgraph.StrandDisplayName(sk.Strand))) + "\n")
+	b.WriteString(dimStyle.Render(fieldLabel("Grade:")) + valStyle.Render(skillgraph.GradeLabel(sk.GradeLevel)) + "\n")
+	if std := skillgraph.ActiveStandard(); sk.Code(std) != "" {
+		b.WriteString(dimStyle.Render(fieldLabel("Standard:")) + valStyle.Render(sk.Code(std)+" ("+std.ShortName()+")") + "\n")
 	}
 	b.WriteString("\n")
 
@@ -85,37 +131,51 @@ func (d *SkillDetailScreen) View(width, height int) string {
 	b.WriteString(lipgloss.NewStyle().
 		Foreground(theme.Secondary).
 		Bold(true).
-		Render("  Tier Requirements"))
+		Render("  " + i18n.T("Tier Requirements")))
 	b.WriteString("\n")
 
-	for _, tier := range sk.Tiers {
-		tierName := "Learn"
-		if tier.Tier == skillgraph.TierProve {
-			tierName = "Prove"
+	for i, tier := range sk.Tiers {
+		if !sk.Tiers.Has(skillgraph.Tier(i)) {
+			continue // an unset Challenge tier is all zero, so its Tier reads as Learn
 		}
 		acc := fmt.Sprintf("%.0f%%", tier.AccuracyThreshold*100)
-		line := fmt.Sprintf("  %-6s  %d questions, %s accuracy", tierName, tier.ProblemsRequired, acc)
+		line := fmt.Sprintf("  %-9s  ", i18n.T(tier.Tier.DisplayName())) +
+			i18n.Sprintf("%d questions, %s accuracy", tier.ProblemsRequired, acc)
+		if tier.TimeLimitSecs > 0 {
+			line += i18n.Sprintf(", %ds each", tier.TimeLimitSecs)
+		}
 		b.WriteString(dimStyle.Render(line))
 		b.WriteString("\n")
 	}
 	b.WriteString("\n")
 
+	// Fluency, from the answer history.
+	if d.loaded {
+		b.WriteString(d.renderFluency(dimStyle, valStyle))
+	}
+
 	// Prerequisites.
 	prereqs := skillgraph.Prerequisites(sk.ID)
 	if len(prereqs) > 0 {
 		b.WriteString(lipgloss.NewStyle().
 			Foreground(theme.Secondary).
 			Bold(true).
-			Render("  Prerequisites"))
+			Render("  " + i18n.T("Prerequisites")))
 		b.WriteString("\n")
 		for _, p := range prereqs {
 			icon := "○"
 			style := dimStyle
-			if d.mastered[p.ID] {
+			name := p.Name
+			switch {
+			case slices.Contains(d.shaky, p.ID):
+				icon = "◐"
+				name = i18n.Sprintf("%s (rusty)", name)
+				style = lipgloss.NewStyle().Foreground(theme.Accent)
+			case d.mastered[p.ID]:
 				icon = "●"
 				style = lipgloss.NewStyle().Foreground(theme.Success)
 			}
-			b.WriteString(style.Render(fmt.Sprintf("  %s %s", icon, p.Name)))
+			b.WriteString(style.Render(fmt.Sprintf("  %s %s", icon, name)))
 			b.WriteString("\n")
 		}
 		b.WriteString("\n")
@@ -127,7 +187,7 @@ func (d *SkillDetailScreen) View(width, height int) string {
 		b.WriteString(lipgloss.NewStyle().
 			Foreground(theme.Secondary).
 			Bold(true).
-			Render("  Unlocks"))
+			Render("  " + i18n.T("Unlocks")))
 		b.WriteString("\n")
 		for _, dep := range deps {
 			b.WriteString(dimStyle.Render(fmt.Sprintf("  → %s", dep.Name)))
@@ -138,3 +198,76 @@ func (d *SkillDetailScreen) View(width, height int) string {
 	return lipgloss.Place(width, height, lipgloss.Left, lipgloss.Top,
 		"\n"+b.String())
 }
+
+// renderFluency renders the fluency panel: response-time percentiles,
+// accuracy per practice day and the fluency trend.
+func (d *SkillDetailScreen) renderFluency(dimStyle, valStyle lipgloss.Style) string {
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().
+		Foreground(theme.Secondary).
+		Bold(true).
+		Render("  " + i18n.T("Fluency")))
+	b.WriteString("\n")
+
+	a := d.analytics
+	if a == nil {
+		b.WriteString(dimStyle.Render("  " + i18n.T("No answers yet.")))
+		b.WriteString("\n\n")
+		return b.String()
+	}
+
+	b.WriteString(dimStyle.Render(fieldLabel("Answers:")) +
+		valStyle.Render(i18n.Sprintf("%d, %.0f%% correct", a.Answers, a.Accuracy()*100)) + "\n")
+	if a.P50Ms > 0 {
+		b.WriteString(dimStyle.Render(fieldLabel("Speed:")) +
+			valStyle.Render(i18n.Sprintf("typically %s", formatSecs(a.P50Ms))) +
+			dimStyle.Render("  "+i18n.Sprintf("(fastest quarter %s, slowest tenth %s)", formatSecs(a.P25Ms), formatSecs(a.P90Ms))) + "\n")
+	}
+
+	days := a.Daily[len(a.Daily)-min(len(a.Daily), accuracyDays):]
+	b.WriteString(dimStyle.Render(fieldLabel("Accuracy:")) + valStyle.Render(accuracySparkline(days)) +
+		dimStyle.Render("  "+i18n.Sprintf("last %d practice days", len(days))) + "\n")
+
+	trendStyle := valStyle
+	var trend string
+	switch a.Trend {
+	case mastery.TrendImproving:
+		trend = "↑ " + i18n.T("Improving")
+		trendStyle = lipgloss.NewStyle().Foreground(theme.Success)
+	case mastery.TrendDeclining:
+		trend = "↓ " + i18n.T("Slipping")
+		trendStyle = lipgloss.NewStyle().Foreground(theme.Accent)
+	case mastery.TrendStable:
+		trend = "→ " + i18n.T("Steady")
+	default:
+		trend = i18n.Sprintf("Needs %d answers", mastery.MinTrendAnswers)
+		trendStyle = dimStyle
+	}
+	b.WriteString(dimStyle.Render(fieldLabel("Trend:")) + trendStyle.Render(trend) + "\n\n")
+	return b.String()
+}
+
+// sparkBlocks are the sparkline levels, from 0% to 100% accuracy.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// accuracySparkline draws one block per day, its height the day's accuracy.
+func accuracySparkline(days []mastery.DailyAccuracy) string {
+	var b strings.Builder
+	for _, day := range days {
+		level := int(day.Accuracy() * float64(len(sparkBlocks)-1))
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
+
+// formatSecs formats a response time in milliseconds as seconds, with the
+// locale's decimal separator.
+func formatSecs(ms int) string {
+	return i18n.Active().FormatNumbers(fmt.Sprintf("%.1fs", (time.Duration(ms) * time.Millisecond).Seconds()))
+}
+
+// fieldLabel translates a metadata label and pads it to line up the values
+// after it.
+func fieldLabel(label string) string {
+	return fmt.Sprintf("  %-13s", i18n.T(label))
+}
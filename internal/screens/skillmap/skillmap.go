@@ -7,17 +7,20 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/router"
 	"github.com/abhisek/mathiz/internal/screen"
 	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
 	"github.com/abhisek/mathiz/internal/ui/layout"
 	"github.com/abhisek/mathiz/internal/ui/theme"
 )
 
 // ReviewBadge holds review schedule display info for a mastered skill.
 type ReviewBadge struct {
-	Due       bool // at or past review date
-	Graduated bool // completed all 6 review stages
+	Due       bool     // at or past review date
+	Graduated bool     // completed all 6 review stages
+	Shaky     []string // prerequisites gone rusty, with decay propagation on
 }
 
 type rowKind int
@@ -41,12 +44,14 @@ type SkillMapScreen struct {
 	skillStates  map[string]skillgraph.SkillState
 	mastered     map[string]bool
 	reviews      map[string]ReviewBadge
+	eventRepo    store.EventRepo
 }
 
 var _ screen.Screen = (*SkillMapScreen)(nil)
 
-// New creates a new SkillMapScreen.
-func New(skillStates map[string]skillgraph.SkillState, reviews map[string]ReviewBadge) *SkillMapScreen {
+// New creates a new SkillMapScreen. eventRepo, when set, feeds the fluency
+// panel on each skill's detail screen.
+func New(skillStates map[string]skillgraph.SkillState, reviews map[string]ReviewBadge, eventRepo store.EventRepo) *SkillMapScreen {
 	if skillStates == nil {
 		skillStates = make(map[string]skillgraph.SkillState)
 	}
@@ -78,6 +83,7 @@ func New(skillStates map[string]skillgraph.SkillState, reviews map[string]Review
 		skillStates: skillStates,
 		mastered:    mastered,
 		reviews:     reviews,
+		eventRepo:   eventRepo,
 	}
 
 	// Set cursor to first skill row
@@ -148,16 +154,16 @@ func (s *SkillMapScreen) View(width, height int) string {
 }
 
 func (s *SkillMapScreen) Title() string {
-	return "Skill Map"
+	return i18n.T("Skill Map")
 }
 
 // KeyHints returns the key binding hints for the footer.
 func (s *SkillMapScreen) KeyHints() []layout.KeyHint {
 	return []layout.KeyHint{
-		{Key: "↑↓", Description: "Navigate"},
-		{Key: "Tab", Description: "Strand"},
-		{Key: "Enter", Description: "Select"},
-		{Key: "Esc", Description: "Back"},
+		{Key: "↑↓", Description: i18n.T("Navigate")},
+		{Key: "Tab", Description: i18n.T("Strand")},
+		{Key: "Enter", Description: i18n.T("Select")},
+		{Key: "Esc", Description: i18n.T("Back")},
 	}
 }
 
@@ -242,7 +248,7 @@ func (s *SkillMapScreen) selectSkill() tea.Cmd {
 	}
 
 	state := s.skillState(r.skill.ID)
-	detail := newSkillDetail(*r.skill, state, s.mastered)
+	detail := newSkillDetail(*r.skill, state, s.mastered, s.reviews[r.skill.ID].Shaky, s.eventRepo)
 	return func() tea.Msg {
 		return router.PushScreenMsg{Screen: detail}
 	}
@@ -261,7 +267,7 @@ func (s *SkillMapScreen) skillState(id string) skillgraph.SkillState {
 
 // renderStrandHeader renders a strand section header.
 func (s *SkillMapScreen) renderStrandHeader(strand skillgraph.Strand, width int) string {
-	name := strings.ToUpper(skillgraph.StrandDisplayName(strand))
+	name := strings.ToUpper(i18n.T(skillgraph.StrandDisplayName(strand)))
 	styled := lipgloss.NewStyle().
 		Foreground(theme.Secondary).
 		Bold(true).
@@ -279,22 +285,25 @@ func (s *SkillMapScreen) renderSkillRow(r row, selected bool, width int) string
 
 	state := s.skillState(r.skill.ID)
 	icon := state.Icon()
-	label := state.Label()
+	label := i18n.T(state.Label())
 
 	// Override icon/label for mastered skills with review badges.
 	if state == skillgraph.StateMastered {
 		if badge, ok := s.reviews[r.skill.ID]; ok {
 			switch {
 			case badge.Due:
-				label = "Due"
+				label = i18n.T("Due")
+			case len(badge.Shaky) > 0:
+				icon = "🧱"
+				label = i18n.T("Shaky")
 			case badge.Graduated:
 				icon = "🎓"
-				label = "Graduated"
+				label = i18n.T("Graduated")
 			}
 		}
 	}
 
-	grade := fmt.Sprintf("Grade %d", r.skill.GradeLevel)
+	grade := i18n.Sprintf("Grade %s", skillgraph.GradeLabel(r.skill.GradeLevel))
 
 	// Calculate column widths
 	padding := 4 // left indent
@@ -325,11 +334,11 @@ func (s *SkillMapScreen) renderSkillRow(r row, selected bool, width int) string
 			nameStyle = lipgloss.NewStyle().Foreground(theme.Success)
 			gradeStyle = lipgloss.NewStyle().Foreground(theme.TextDim)
 			labelStyle = lipgloss.NewStyle().Foreground(theme.Success)
-			// Due review badge gets attention color.
-			if badge, ok := s.reviews[r.skill.ID]; ok && badge.Due {
+			// Due and shaky badges get attention color.
+			if badge, ok := s.reviews[r.skill.ID]; ok && (badge.Due || len(badge.Shaky) > 0) {
 				labelStyle = lipgloss.NewStyle().Foreground(theme.Accent)
 			}
-		case skillgraph.StateLearning, skillgraph.StateProving:
+		case skillgraph.StateLearning, skillgraph.StateProving, skillgraph.StateChallenging:
 			nameStyle = lipgloss.NewStyle().Foreground(theme.Text)
 			gradeStyle = lipgloss.NewStyle().Foreground(theme.TextDim)
 			labelStyle = lipgloss.NewStyle().Foreground(theme.Secondary)
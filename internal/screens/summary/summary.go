@@ -9,6 +9,7 @@ import (
 	"charm.land/lipgloss/v2"
 
 	"github.com/abhisek/mathiz/internal/gems"
+	"github.com/abhisek/mathiz/internal/i18n"
 	"github.com/abhisek/mathiz/internal/router"
 	"github.com/abhisek/mathiz/internal/screen"
 	"github.com/abhisek/mathiz/internal/session"
@@ -34,13 +35,13 @@ func (s *SummaryScreen) Init() tea.Cmd {
 }
 
 func (s *SummaryScreen) Title() string {
-	return "Session Summary"
+	return i18n.T("Session Summary")
 }
 
 func (s *SummaryScreen) KeyHints() []layout.KeyHint {
 	return []layout.KeyHint{
-		{Key: "Enter", Description: "Continue"},
-		{Key: "Esc", Description: "Home"},
+		{Key: "Enter", Description: i18n.T("Continue")},
+		{Key: "Esc", Description: i18n.T("Home")},
 	}
 }
 
@@ -69,7 +70,7 @@ func (s *SummaryScreen) View(width, height int) string {
 		Align(lipgloss.Center).
 		Foreground(theme.Primary).
 		Bold(true).
-		Render("Session complete!"))
+		Render(i18n.T("Session complete!")))
 	b.WriteString("\n\n")
 
 	// Duration.
@@ -80,12 +81,12 @@ func (s *SummaryScreen) View(width, height int) string {
 		Width(width).
 		Align(lipgloss.Center).
 		Foreground(theme.TextDim).
-		Render(fmt.Sprintf("Duration: %s", durationStr)))
+		Render(i18n.Sprintf("Duration: %s", durationStr)))
 	b.WriteString("\n\n")
 
 	// Stats line.
 	accuracy := fmt.Sprintf("%.0f%%", sum.Accuracy*100)
-	statsLine := fmt.Sprintf("Questions: %d        Correct: %d        Accuracy: %s",
+	statsLine := i18n.Sprintf("Questions: %d        Correct: %d        Accuracy: %s",
 		sum.TotalQuestions, sum.TotalCorrect, accuracy)
 	b.WriteString(lipgloss.NewStyle().
 		Width(width).
@@ -98,7 +99,7 @@ func (s *SummaryScreen) View(width, height int) string {
 	divider := lipgloss.NewStyle().Foreground(theme.Border).Render(
 		strings.Repeat("─", min(width-8, 60)))
 	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center,
-		lipgloss.NewStyle().Foreground(theme.TextDim).Render("Skills")))
+		lipgloss.NewStyle().Foreground(theme.TextDim).Render(i18n.T("Skills"))))
 	b.WriteString("\n")
 	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, divider))
 	b.WriteString("\n\n")
@@ -108,8 +109,8 @@ func (s *SummaryScreen) View(width, height int) string {
 		if sr.Attempted == 0 {
 			continue
 		}
-		catStr := string(sr.Category)
-		scoreStr := fmt.Sprintf("%d/%d correct", sr.Correct, sr.Attempted)
+		catStr := i18n.T(string(sr.Category))
+		scoreStr := i18n.Sprintf("%d/%d correct", sr.Correct, sr.Attempted)
 
 		tierStr := session.TierString(sr.TierAfter)
 		if sr.TierBefore != sr.TierAfter {
@@ -139,17 +140,16 @@ func (s *SummaryScreen) View(width, height int) string {
 	if len(sum.GemsEarned) > 0 {
 		b.WriteString("\n")
 		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center,
-			lipgloss.NewStyle().Foreground(theme.TextDim).Render("Gems")))
+			lipgloss.NewStyle().Foreground(theme.TextDim).Render(i18n.T("Gems"))))
 		b.WriteString("\n")
 		b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, divider))
 		b.WriteString("\n\n")
 
 		for _, gem := range sum.GemsEarned {
-			line := fmt.Sprintf("  %s %s %s Gem — %s",
+			line := "  " + i18n.Sprintf("%s %s %s Gem",
 				gem.Type.Icon(),
-				gem.Rarity.DisplayName(),
-				gem.Type.DisplayName(),
-				gem.Reason)
+				i18n.T(gem.Rarity.DisplayName()),
+				i18n.T(gem.Type.DisplayName())) + " — " + gem.Reason
 			style := lipgloss.NewStyle().Foreground(rarityColor(gem.Rarity))
 			b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center,
 				style.Render(line)))
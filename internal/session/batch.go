@@ -0,0 +1,65 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+)
+
+// QuestionBatch holds the questions left from one GenerateBatch call, for
+// the skill, tier and recent errors they were generated for.
+type QuestionBatch struct {
+	skillID   string
+	tier      string
+	errors    []string
+	questions []*problemgen.Question
+}
+
+// NextQuestion returns the next question for input. It serves from
+// state.Batch while the batch still fits, and otherwise generates the n
+// questions left in the slot with one GenerateBatch call, serving the
+// first and keeping the rest.
+//
+// A batch is dropped when the skill or tier changes or the learner makes
+// a new mistake: the follow-up to a mistake is generated with the error in
+// context. Batched questions the learner has since seen, or outside
+// input's difficulty band, are skipped.
+func NextQuestion(ctx context.Context, gen problemgen.Generator, state *SessionState, input problemgen.GenerateInput, n int) (*problemgen.Question, error) {
+	if q := state.Batch.next(input); q != nil {
+		return q, nil
+	}
+
+	qs, err := gen.GenerateBatch(ctx, input, max(n, 1))
+	if err != nil {
+		return nil, err
+	}
+	if len(qs) == 0 {
+		return nil, errors.New("generator returned no questions")
+	}
+	state.Batch = QuestionBatch{
+		skillID:   input.Skill.ID,
+		tier:      input.Tier.String(),
+		errors:    slices.Clone(input.RecentErrors),
+		questions: qs[1:],
+	}
+	return qs[0], nil
+}
+
+// next pops the first batched question that still fits input, or returns
+// nil, emptying a batch that no longer applies.
+func (b *QuestionBatch) next(input problemgen.GenerateInput) *problemgen.Question {
+	if b.skillID != input.Skill.ID || b.tier != input.Tier.String() || !slices.Equal(b.errors, input.RecentErrors) {
+		b.questions = nil
+		return nil
+	}
+	for len(b.questions) > 0 {
+		q := b.questions[0]
+		b.questions = b.questions[1:]
+		if !slices.Contains(input.PriorQuestions, q.Text) && input.TargetDifficulty.Contains(q.Difficulty) {
+			return q
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// batchGenerator counts GenerateBatch calls and numbers its questions.
+type batchGenerator struct {
+	batches int
+	next    int
+}
+
+func (g *batchGenerator) Generate(ctx context.Context, input problemgen.GenerateInput) (*problemgen.Question, error) {
+	g.next++
+	return &problemgen.Question{Text: fmt.Sprintf("q%d", g.next), Difficulty: 3}, nil
+}
+
+func (g *batchGenerator) GenerateBatch(ctx context.Context, input problemgen.GenerateInput, n int) ([]*problemgen.Question, error) {
+	g.batches++
+	return problemgen.GenerateEach(ctx, g, input, n)
+}
+
+func TestNextQuestion_ServesBatch(t *testing.T) {
+	state := testState()
+	gen := &batchGenerator{}
+	ctx := context.Background()
+	input := problemgen.GenerateInput{Skill: state.Plan.Slots[0].Skill, Tier: skillgraph.TierLearn}
+
+	var texts []string
+	for i := range QuestionsPerSlot {
+		q, err := NextQuestion(ctx, gen, state, input, QuestionsPerSlot-i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		texts = append(texts, q.Text)
+		input.PriorQuestions = append(input.PriorQuestions, q.Text)
+	}
+	if gen.batches != 1 {
+		t.Errorf("batches = %d, want 1", gen.batches)
+	}
+	if fmt.Sprint(texts) != "[q1 q2 q3]" {
+		t.Errorf("texts = %v", texts)
+	}
+}
+
+func TestNextQuestion_DropsStaleBatch(t *testing.T) {
+	ctx := context.Background()
+	skill := testPlan().Slots[0].Skill
+
+	tests := []struct {
+		name   string
+		change func(*problemgen.GenerateInput)
+	}{
+		{"new mistake", func(in *problemgen.GenerateInput) { in.RecentErrors = []string{"answered 5 for 2 + 2"} }},
+		{"tier change", func(in *problemgen.GenerateInput) { in.Tier = skillgraph.TierProve }},
+		{"band moved", func(in *problemgen.GenerateInput) { in.TargetDifficulty = problemgen.NewDifficultyBand(4, 5) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testState()
+			gen := &batchGenerator{}
+			input := problemgen.GenerateInput{Skill: skill, Tier: skillgraph.TierLearn}
+			if _, err := NextQuestion(ctx, gen, state, input, 3); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.change(&input)
+			if _, err := NextQuestion(ctx, gen, state, input, 2); err != nil {
+				t.Fatal(err)
+			}
+			if gen.batches != 2 {
+				t.Errorf("batches = %d, want a fresh batch", gen.batches)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package session
+
+import (
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/problemgen"
+)
+
+// Difficulty targeting thresholds. Below minTargetingResults answers in the
+// tier the band stays at the tier's base; after that the band steps down
+// when rolling accuracy is below strugglingAccuracy, and up when it is at
+// least fluentAccuracy and the fluency score agrees.
+const (
+	minTargetingResults = 3
+	strugglingAccuracy  = 0.5
+	fluentAccuracy      = 0.8
+	fluentScore         = 0.75
+)
+
+// TargetDifficulty returns the difficulty band for the learner's next
+// question on a skill: a band of three around 2 for Learn, 3 for Prove and
+// 4 for Challenge, shifted one step by how the learner is doing in the
+// tier. Rolling accuracy resets on tier-up, so every tier starts at its
+// base and ramps from there.
+func TargetDifficulty(sm *mastery.SkillMastery) problemgen.DifficultyBand {
+	center := 2 + int(sm.CurrentTier)
+	if acc, n := mastery.RollingAccuracy(&sm.Fluency); n >= minTargetingResults {
+		switch {
+		case acc < strugglingAccuracy:
+			center--
+		case acc >= fluentAccuracy && mastery.FluencyScore(&sm.Fluency, acc) >= fluentScore:
+			center++
+		}
+	}
+	return problemgen.NewDifficultyBand(center-1, center+1)
+}
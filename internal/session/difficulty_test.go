@@ -0,0 +1,48 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+func TestTargetDifficulty(t *testing.T) {
+	results := func(pattern string) []bool {
+		out := make([]bool, len(pattern))
+		for i, c := range pattern {
+			out[i] = c == 'y'
+		}
+		return out
+	}
+	tests := []struct {
+		name    string
+		tier    skillgraph.Tier
+		results string // y = correct, n = wrong
+		streak  int
+		speed   float64
+		want    problemgen.DifficultyBand
+	}{
+		{"learn base", skillgraph.TierLearn, "", 0, 0.5, problemgen.DifficultyBand{Min: 1, Max: 3}},
+		{"too few answers to move", skillgraph.TierLearn, "yy", 2, 1, problemgen.DifficultyBand{Min: 1, Max: 3}},
+		{"learn fluent ramps up", skillgraph.TierLearn, "yyyyy", 5, 1, problemgen.DifficultyBand{Min: 2, Max: 4}},
+		{"accurate but slow stays", skillgraph.TierLearn, "yyyyy", 1, 0, problemgen.DifficultyBand{Min: 1, Max: 3}},
+		{"prove struggling eases off", skillgraph.TierProve, "nnyn", 0, 0.5, problemgen.DifficultyBand{Min: 1, Max: 3}},
+		{"prove base", skillgraph.TierProve, "yyny", 1, 0.5, problemgen.DifficultyBand{Min: 2, Max: 4}},
+		{"challenge fluent caps at 5", skillgraph.TierChallenge, "yyyyyyyy", 8, 1, problemgen.DifficultyBand{Min: 4, Max: 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := &mastery.SkillMastery{CurrentTier: tt.tier, Fluency: mastery.DefaultFluencyMetrics()}
+			sm.Fluency.RecentResults = results(tt.results)
+			sm.Fluency.Streak = tt.streak
+			for range tt.results {
+				mastery.RecordSpeed(&sm.Fluency, tt.speed)
+			}
+			if got := TargetDifficulty(sm); got != tt.want {
+				t.Errorf("TargetDifficulty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
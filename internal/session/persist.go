@@ -200,8 +200,9 @@ func recoverProfile(ctx context.Context, ownerID string, eventRepo store.EventRe
 // curriculumSkill reports whether an ID names a real skill in the graph.
 //
 // Not everything the session engine tracks is curriculum: an untagged quest
-// plays under a synthetic "quest:<uid>" skill (specs/15-quests.md), and stale
-// IDs can survive in old snapshots. Feeding those to the profiler asks the
+// plays under a synthetic "quest:<uid>" skill (specs/15-quests.md), and
+// removed skills no graph migration carried over (skillgraph.Migration) can
+// survive in old snapshots. Feeding those to the profiler asks the
 // model to reason about an opaque token. Testing graph membership — rather
 // than matching a prefix — covers both cases and needs no shared magic string.
 func curriculumSkill(id string) bool {
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"time"
 
@@ -26,6 +27,7 @@ type DefaultPlanner struct {
 	EventRepo store.EventRepo
 	Ctx       context.Context
 	scheduler SchedulerDueSkills
+	filter    func(skillgraph.Skill) bool
 }
 
 // SetScheduler sets the spaced repetition scheduler for review selection.
@@ -33,6 +35,17 @@ func (p *DefaultPlanner) SetScheduler(s SchedulerDueSkills) {
 	p.scheduler = s
 }
 
+// SetSkillFilter restricts every slot to skills f accepts, e.g. the skills
+// an offline question generator can serve.
+func (p *DefaultPlanner) SetSkillFilter(f func(skillgraph.Skill) bool) {
+	p.filter = f
+}
+
+// allowed reports whether skill passes the planner's skill filter.
+func (p *DefaultPlanner) allowed(skill skillgraph.Skill) bool {
+	return p.filter == nil || p.filter(skill)
+}
+
 // NewPlanner creates a new DefaultPlanner.
 func NewPlanner(ctx context.Context, eventRepo store.EventRepo) *DefaultPlanner {
 	return &DefaultPlanner{
@@ -57,6 +70,9 @@ func (p *DefaultPlanner) BuildPlan(mastered map[string]bool, tierProgress map[st
 	// Get mastered skill IDs.
 	var masteredIDs []string
 	for id := range mastered {
+		if skill, err := skillgraph.GetSkill(id); err == nil && !p.allowed(skill) {
+			continue
+		}
 		masteredIDs = append(masteredIDs, id)
 	}
 	sort.Strings(masteredIDs)
@@ -71,7 +87,7 @@ func (p *DefaultPlanner) BuildPlan(mastered map[string]bool, tierProgress map[st
 	}
 
 	// Select frontier skills.
-	frontierSkills := selectFrontierSkills(mastered, frontierCount)
+	frontierSkills := selectFrontierSkills(mastered, frontierCount, p.allowed)
 
 	// If no frontier skills available, redistribute to review/booster.
 	if len(frontierSkills) == 0 && hasMastered {
@@ -163,8 +179,12 @@ func (p *DefaultPlanner) BuildPlan(mastered map[string]bool, tierProgress map[st
 // 1. Lowest grade first
 // 2. Most dependents within same grade
 // 3. Alphabetical ID tiebreaker
-func selectFrontierSkills(mastered map[string]bool, count int) []skillgraph.Skill {
-	available := skillgraph.AvailableSkills(mastered)
+//
+// Only skills allowed accepts are considered.
+func selectFrontierSkills(mastered map[string]bool, count int, allowed func(skillgraph.Skill) bool) []skillgraph.Skill {
+	available := slices.DeleteFunc(skillgraph.AvailableSkills(mastered), func(s skillgraph.Skill) bool {
+		return !allowed(s)
+	})
 	if len(available) == 0 {
 		return nil
 	}
@@ -194,13 +214,13 @@ func selectFrontierSkills(mastered map[string]bool, count int) []skillgraph.Skil
 func (p *DefaultPlanner) selectReviewSkills(masteredIDs []string, count int) []skillgraph.Skill {
 	if p.scheduler != nil {
 		due := p.scheduler.DueSkills(time.Now())
-		if len(due) > count {
-			due = due[:count]
-		}
 		var result []skillgraph.Skill
 		for _, id := range due {
+			if len(result) == count {
+				break
+			}
 			skill, err := skillgraph.GetSkill(id)
-			if err != nil {
+			if err != nil || !p.allowed(skill) {
 				continue
 			}
 			result = append(result, skill)
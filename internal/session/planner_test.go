@@ -22,8 +22,8 @@ func newMockEventRepo() *mockEventRepo {
 	}
 }
 
-func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) error {
-	return nil
+func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) (int, error) {
+	return 0, nil
 }
 func (m *mockEventRepo) AppendSessionEvent(_ context.Context, _ store.SessionEventData) error {
 	return nil
@@ -49,6 +49,12 @@ func (m *mockEventRepo) AppendDiagnosisEvent(_ context.Context, _ store.Diagnosi
 func (m *mockEventRepo) AppendHintEvent(_ context.Context, _ store.HintEventData) error {
 	return nil
 }
+func (m *mockEventRepo) AppendQuestionReport(_ context.Context, _ store.QuestionReportData) error {
+	return nil
+}
+func (m *mockEventRepo) QueryQuestionReports(_ context.Context, _ store.QueryOpts) ([]store.QuestionReportRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) AppendLessonEvent(_ context.Context, _ store.LessonEventData) error {
 	return nil
 }
@@ -96,6 +102,9 @@ func (m *mockEventRepo) QueryMasteryEvents(_ context.Context, _ store.QueryOpts)
 func (m *mockEventRepo) AnswersForSession(_ context.Context, _ string) ([]store.AnswerEventRecord, error) {
 	return nil, nil
 }
+func (m *mockEventRepo) AnswerHistory(_ context.Context, _ store.QueryOpts) ([]store.AnswerEventRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) HintCountForSession(_ context.Context, _ string) (int, error) {
 	return 0, nil
 }
@@ -290,3 +299,22 @@ func TestBuildPlan_Duration(t *testing.T) {
 		t.Errorf("Duration = %v, want 15m", plan.Duration)
 	}
 }
+
+func TestBuildPlan_SkillFilter(t *testing.T) {
+	repo := newMockEventRepo()
+	planner := NewPlanner(context.Background(), repo)
+	planner.SetSkillFilter(func(s skillgraph.Skill) bool { return s.ID != "count-to-120" })
+
+	plan, err := planner.BuildPlan(map[string]bool{"count-to-20": true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Slots) == 0 {
+		t.Fatal("expected slots")
+	}
+	for _, slot := range plan.Slots {
+		if slot.Skill.ID == "count-to-120" {
+			t.Error("planned a filtered-out skill")
+		}
+	}
+}
@@ -30,16 +30,10 @@ func (tp *TierProgress) Record(correct bool) {
 
 // TierString returns the string representation of the current tier.
 func TierString(t skillgraph.Tier) string {
-	if t == skillgraph.TierProve {
-		return "prove"
-	}
-	return "learn"
+	return t.String()
 }
 
 // TierFromString parses a tier string back to the Tier type.
 func TierFromString(s string) skillgraph.Tier {
-	if s == "prove" {
-		return skillgraph.TierProve
-	}
-	return skillgraph.TierLearn
+	return skillgraph.ParseTier(s)
 }
@@ -130,6 +130,9 @@ func TestTierFromString(t *testing.T) {
 	if TierFromString("prove") != skillgraph.TierProve {
 		t.Error("TierFromString(prove) != TierProve")
 	}
+	if TierFromString("challenge") != skillgraph.TierChallenge {
+		t.Error("TierFromString(challenge) != TierChallenge")
+	}
 	if TierFromString("unknown") != skillgraph.TierLearn {
 		t.Error("TierFromString(unknown) should default to TierLearn")
 	}
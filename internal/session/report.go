@@ -0,0 +1,39 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// ReportQuestion records the current question as reported by the learner:
+// wrong, confusing, or otherwise not worth asking. The store queues it for
+// triage in the question bank, and it joins PriorQuestions so this session
+// never serves it again. learnerAnswer is empty when the question
+// is reported instead of answered.
+func ReportQuestion(ctx context.Context, repo store.EventRepo, state *SessionState, learnerAnswer, reason string) error {
+	q := state.CurrentQuestion
+	if q == nil {
+		return errors.New("no question to report")
+	}
+	if !slices.Contains(state.PriorQuestions[q.SkillID], q.Text) {
+		state.PriorQuestions[q.SkillID] = append(state.PriorQuestions[q.SkillID], q.Text)
+	}
+	return repo.AppendQuestionReport(ctx, store.QuestionReportData{
+		SessionID:     state.SessionID,
+		SkillID:       q.SkillID,
+		Tier:          TierString(q.Tier),
+		QuestionText:  q.Text,
+		Answer:        q.Answer,
+		Format:        string(q.Format),
+		Choices:       q.Choices,
+		Source:        q.Source,
+		LLMRequestID:  q.LLMRequestID,
+		LLMOwner:      q.LLMOwner,
+		LearnerAnswer: learnerAnswer,
+		Reporter:      store.ReporterLearner,
+		Reason:        reason,
+	})
+}
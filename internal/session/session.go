@@ -30,13 +30,18 @@ func HandleAnswer(state *SessionState, learnerAnswer string) *TierAdvancement {
 		return nil
 	}
 
-	correct := problemgen.CheckAnswer(learnerAnswer, q)
+	grade := problemgen.GradeAnswer(learnerAnswer, q)
+	correct := grade.Correct()
+	state.LastGrade = grade
 	state.LastAnswerCorrect = correct
 	state.TotalQuestions++
 
 	if correct {
 		state.TotalCorrect++
 	}
+	if q.Source != "" && state.QuestionSources != nil {
+		state.QuestionSources[q.Source]++
+	}
 
 	// Update per-skill results.
 	sr := state.PerSkillResults[q.SkillID]
@@ -53,8 +58,11 @@ func HandleAnswer(state *SessionState, learnerAnswer string) *TierAdvancement {
 	// Track errors for LLM context and run diagnosis.
 	state.LastDiagnosis = nil
 	if !correct {
-		// Track per-skill wrong count.
-		state.WrongCountBySkill[q.SkillID]++
+		// Track per-skill wrong count. Near misses and unsimplified answers
+		// are slips, not gaps, so they don't push toward a micro-lesson.
+		if !grade.Partial() {
+			state.WrongCountBySkill[q.SkillID]++
+		}
 
 		var diag *diagnosis.DiagnosisResult
 		if state.DiagnosisService != nil {
@@ -126,6 +134,7 @@ func HandleAnswer(state *SessionState, learnerAnswer string) *TierAdvancement {
 					RecentErrors:  state.RecentErrors[q.SkillID],
 					LastDiagnosis: state.LastDiagnosis,
 					Accuracy:      accuracy,
+					Locale:        q.Locale,
 				})
 			}
 		}
@@ -185,6 +194,9 @@ func handleAnswerWithMastery(state *SessionState, q *problemgen.Question, correc
 
 	tierCfg := skill.Tiers[q.Tier]
 	transition := state.MasteryService.RecordAnswer(q.SkillID, correct, responseTimeMs, tierCfg)
+	// The tier the answer counted toward: questions generated before a tier
+	// change in the same session still carry the old one.
+	fromTier := q.Tier
 
 	// Update spaced rep schedule for review answers.
 	if state.SpacedRepSched != nil {
@@ -209,6 +221,7 @@ func handleAnswerWithMastery(state *SessionState, q *problemgen.Question, correc
 			// Check if poor review performance should mark skill as rusty.
 			if t := state.MasteryService.CheckReviewPerformance(context.Background(), q.SkillID); t != nil {
 				transition = t
+				state.SpacedRepSched.FlagDependents(q.SkillID, time.Now())
 			}
 		}
 	}
@@ -255,7 +268,7 @@ func handleAnswerWithMastery(state *SessionState, q *problemgen.Question, correc
 
 	// Convert to TierAdvancement for backward compatibility.
 	if transition != nil {
-		return masteryTransitionToTierAdvancement(transition)
+		return masteryTransitionToTierAdvancement(transition, fromTier, sm.CurrentTier)
 	}
 	return nil
 }
@@ -284,22 +297,25 @@ func handleAnswerLegacy(state *SessionState, q *problemgen.Question, correct boo
 	return nil
 }
 
-func masteryTransitionToTierAdvancement(t *mastery.StateTransition) *TierAdvancement {
+// masteryTransitionToTierAdvancement converts a mastery transition into the
+// session's tier advancement. from is the tier the answer counted toward and
+// to the learner's tier afterwards.
+func masteryTransitionToTierAdvancement(t *mastery.StateTransition, from, to skillgraph.Tier) *TierAdvancement {
 	switch t.Trigger {
 	case "tier-complete":
 		return &TierAdvancement{
 			SkillID:   t.SkillID,
 			SkillName: t.SkillName,
-			FromTier:  skillgraph.TierLearn,
-			ToTier:    skillgraph.TierProve,
+			FromTier:  from,
+			ToTier:    to,
 			Mastered:  false,
 		}
-	case "prove-complete":
+	case "prove-complete", "challenge-complete":
 		return &TierAdvancement{
 			SkillID:   t.SkillID,
 			SkillName: t.SkillName,
-			FromTier:  skillgraph.TierProve,
-			ToTier:    skillgraph.TierProve,
+			FromTier:  to,
+			ToTier:    to,
 			Mastered:  true,
 		}
 	case "recovery-complete":
@@ -322,27 +338,24 @@ func advanceTier(state *SessionState, tp *TierProgress, skill skillgraph.Skill)
 		FromTier:  tp.CurrentTier,
 	}
 
-	if tp.CurrentTier == skillgraph.TierLearn {
-		// Learn → Prove
-		adv.ToTier = skillgraph.TierProve
-		tp.CurrentTier = skillgraph.TierProve
+	if next, ok := skill.Tiers.Next(tp.CurrentTier); ok {
+		// Learn → Prove, or Prove → Challenge
+		adv.ToTier = next
+		tp.CurrentTier = next
 		tp.TotalAttempts = 0
 		tp.CorrectCount = 0
 		tp.Accuracy = 0
 	} else {
-		// Prove → Mastered
-		adv.ToTier = skillgraph.TierProve
+		// Final tier → Mastered
+		adv.ToTier = tp.CurrentTier
 		adv.Mastered = true
 		state.Mastered[skill.ID] = true
 	}
 
-	// Update per-skill result.
+	// Update per-skill result: the tier reached, or for mastery the
+	// highest tier completed.
 	if sr := state.PerSkillResults[skill.ID]; sr != nil {
-		if adv.Mastered {
-			sr.TierAfter = skillgraph.TierProve // highest tier completed
-		} else {
-			sr.TierAfter = skillgraph.TierProve
-		}
+		sr.TierAfter = adv.ToTier
 	}
 
 	return adv
@@ -325,3 +325,46 @@ func TestNewSessionState(t *testing.T) {
 		t.Error("expected TierProgress map to be initialized")
 	}
 }
+
+func TestHandleAnswer_ProveToChallenge(t *testing.T) {
+	skill, err := skillgraph.GetSkill("mult-facts-2-5-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := &Plan{
+		Slots:    []PlanSlot{{Skill: skill, Tier: skillgraph.TierProve, Category: CategoryFrontier}},
+		Duration: DefaultSessionDuration,
+	}
+	state := NewSessionState(plan, "test-session-id", nil, nil)
+	prove := skill.Tiers[skillgraph.TierProve]
+	state.TierProgress[skill.ID] = &TierProgress{
+		SkillID:       skill.ID,
+		CurrentTier:   skillgraph.TierProve,
+		TotalAttempts: prove.ProblemsRequired - 1,
+		CorrectCount:  prove.ProblemsRequired - 1,
+		Accuracy:      1.0,
+	}
+	state.CurrentQuestion = &problemgen.Question{
+		Text:       "What is 2 x 5?",
+		Format:     problemgen.FormatNumeric,
+		Answer:     "10",
+		AnswerType: problemgen.AnswerTypeInteger,
+		SkillID:    skill.ID,
+		Tier:       skillgraph.TierProve,
+	}
+
+	adv := HandleAnswer(state, "10")
+
+	if adv == nil {
+		t.Fatal("expected tier advancement")
+	}
+	if adv.Mastered {
+		t.Error("fact skills need the Challenge tier before mastery")
+	}
+	if adv.ToTier != skillgraph.TierChallenge {
+		t.Errorf("ToTier = %d, want TierChallenge", adv.ToTier)
+	}
+	if tp := state.TierProgress[skill.ID]; tp.CurrentTier != skillgraph.TierChallenge {
+		t.Errorf("CurrentTier = %d, want TierChallenge", tp.CurrentTier)
+	}
+}
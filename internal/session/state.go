@@ -20,6 +20,7 @@ type SpacedRepScheduler interface {
 	InitSkill(skillID string, masteredAt time.Time)
 	ReInitSkill(skillID string, now time.Time)
 	GetReviewState(skillID string) *spacedrep.ReviewState
+	FlagDependents(skillID string, now time.Time) []string
 }
 
 // SessionPhase represents the current phase of the session.
@@ -53,6 +54,10 @@ type SessionState struct {
 	// TotalCorrect is the count of correct answers so far.
 	TotalCorrect int
 
+	// QuestionSources counts answered questions by generator source
+	// (problemgen.SourceLLM, problemgen.SourceOffline).
+	QuestionSources map[string]int
+
 	// PerSkillResults tracks per-skill stats for the summary screen.
 	PerSkillResults map[string]*SkillResult
 
@@ -86,6 +91,10 @@ type SessionState struct {
 	// LastAnswerCorrect records whether the most recent answer was correct.
 	LastAnswerCorrect bool
 
+	// LastGrade is the graded result of the most recent answer, which tells
+	// near misses and unsimplified answers apart from plain wrong ones.
+	LastGrade problemgen.Grade
+
 	// TierAdvanced is set when a tier advancement happens, for feedback display.
 	TierAdvanced *TierAdvancement
 
@@ -151,6 +160,10 @@ type SessionState struct {
 
 	// PendingGemAward is set when a gem is earned, for inline display on the feedback screen.
 	PendingGemAward *gems.GemAward
+
+	// Batch holds questions generated ahead at the start of a slot and not
+	// yet served (see NextQuestion).
+	Batch QuestionBatch
 }
 
 // SkillResult tracks per-skill performance within a single session.
@@ -170,7 +183,7 @@ type TierAdvancement struct {
 	SkillID   string
 	SkillName string
 	FromTier  skillgraph.Tier
-	ToTier    skillgraph.Tier // TierProve or TierLearn+1 sentinel for mastered
+	ToTier    skillgraph.Tier // tier reached; the final tier when Mastered
 	Mastered  bool            // true if this advancement means mastery
 }
 
@@ -215,6 +228,7 @@ func NewSessionState(plan *Plan, sessionID string, mastered map[string]bool, tie
 		Phase:               PhaseActive,
 		CompletedSlots:      make(map[int]bool),
 		WrongCountBySkill:   make(map[string]int),
+		QuestionSources:     make(map[string]int),
 		NextStreakThreshold: gems.BaseStreakThreshold,
 	}
 }
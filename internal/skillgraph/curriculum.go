@@ -0,0 +1,282 @@
+package skillgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Curriculum is a complete skill graph definition: the strands it covers in
+// display order, and every skill node within them. The embedded seed is one
+// curriculum (SeedCurriculum); LoadCurriculumFile reads others from disk so
+// custom or regional curricula ship without rebuilding the binary.
+type Curriculum struct {
+	Name    string
+	Strands []StrandInfo
+	Skills  []Skill
+
+	// Version increases whenever skill IDs change; Migrations say how
+	// progress saved against older versions maps onto the current skills.
+	Version    int
+	Migrations []Migration
+}
+
+// StrandInfo declares a strand of a curriculum and its display name.
+type StrandInfo struct {
+	ID   Strand `json:"id"`
+	Name string `json:"name"`
+}
+
+// Use validates a curriculum and makes it the active skill graph.
+//
+// The graph is a package-level singleton and swapping it is not synchronized:
+// call Use once at startup, before anything queries the graph. An invalid
+// curriculum leaves the active graph untouched.
+func Use(c *Curriculum) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	g = buildGraph(c)
+	return nil
+}
+
+// Validate runs the structural checks Use applies, without activating c.
+func (c *Curriculum) Validate() error {
+	strands := make([]Strand, len(c.Strands))
+	for i, si := range c.Strands {
+		strands[i] = si.ID
+	}
+	if err := validateCurriculum(strands, c.Skills); err != nil {
+		return err
+	}
+	if errs := validateMigrations(c.Version, c.Migrations, c.Skills); len(errs) > 0 {
+		return fmt.Errorf("skill graph migrations invalid:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// CurriculumName returns the name of the active curriculum.
+func CurriculumName() string {
+	return g.name
+}
+
+// LoadCurriculumFile reads and decodes a curriculum file. The format follows
+// the extension: .yaml or .yml for YAML, .json for JSON. Structural checks
+// run in Use, so a file that loads here can still be rejected there.
+func LoadCurriculumFile(path string) (*Curriculum, error) {
+	var format string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = "yaml"
+	case ".json":
+		format = "json"
+	default:
+		return nil, fmt.Errorf("curriculum %s: unsupported file extension (want .yaml, .yml or .json)", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read curriculum: %w", err)
+	}
+	c, err := ParseCurriculum(data, format)
+	if err != nil {
+		return nil, fmt.Errorf("curriculum %s: %w", path, err)
+	}
+	if c.Name == "" {
+		c.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return c, nil
+}
+
+// ParseCurriculum decodes a curriculum document in the given format ("yaml"
+// or "json"). Unknown keys are rejected so a misspelled field fails loudly
+// instead of silently falling back to a default.
+//
+// Omitted strands default to the built-in five; omitted tiers default to the
+// file-level tiers block, then to DefaultTiers. Tier blocks may be partial —
+// only the fields present override the defaults.
+func ParseCurriculum(data []byte, format string) (*Curriculum, error) {
+	var f curriculumFile
+	switch format {
+	case "yaml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&f); err != nil {
+			return nil, fmt.Errorf("decode yaml: %w", err)
+		}
+	case "json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&f); err != nil {
+			return nil, fmt.Errorf("decode json: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported curriculum format %q", format)
+	}
+	return f.curriculum()
+}
+
+// curriculumFile is the on-disk shape of a curriculum.
+type curriculumFile struct {
+	Name       string          `json:"name" yaml:"name"`
+	Version    int             `json:"version" yaml:"version"`
+	Migrations []migrationFile `json:"migrations" yaml:"migrations"`
+	Tiers      *tiersFile      `json:"tiers" yaml:"tiers"`
+	Strands    []strandFile    `json:"strands" yaml:"strands"`
+	Skills     []skillFile     `json:"skills" yaml:"skills"`
+}
+
+type migrationFile struct {
+	Version int      `json:"version" yaml:"version"`
+	Kind    string   `json:"kind" yaml:"kind"`
+	From    []string `json:"from" yaml:"from"`
+	To      []string `json:"to" yaml:"to"`
+}
+
+type strandFile struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+}
+
+type skillFile struct {
+	ID            string            `json:"id" yaml:"id"`
+	Name          string            `json:"name" yaml:"name"`
+	Description   string            `json:"description" yaml:"description"`
+	Strand        string            `json:"strand" yaml:"strand"`
+	Grade         *int              `json:"grade" yaml:"grade"` // nil when missing, which 0 (kindergarten) can't signal
+	Standards     map[string]string `json:"standards" yaml:"standards"`
+	EstimatedMins int               `json:"estimated_mins" yaml:"estimated_mins"`
+	Keywords      []string          `json:"keywords" yaml:"keywords"`
+	Prerequisites []string          `json:"prerequisites" yaml:"prerequisites"`
+	Tiers         *tiersFile        `json:"tiers" yaml:"tiers"`
+}
+
+type tiersFile struct {
+	Learn     *tierFile `json:"learn" yaml:"learn"`
+	Prove     *tierFile `json:"prove" yaml:"prove"`
+	Challenge *tierFile `json:"challenge" yaml:"challenge"`
+}
+
+// tierFile holds a partial tier config; nil fields keep the base value.
+type tierFile struct {
+	ProblemsRequired  *int     `json:"problems_required" yaml:"problems_required"`
+	AccuracyThreshold *float64 `json:"accuracy_threshold" yaml:"accuracy_threshold"`
+	TimeLimitSecs     *int     `json:"time_limit_secs" yaml:"time_limit_secs"`
+	HintsAllowed      *bool    `json:"hints_allowed" yaml:"hints_allowed"`
+}
+
+func (f *curriculumFile) curriculum() (*Curriculum, error) {
+	c := &Curriculum{Name: f.Name, Version: f.Version}
+	if c.Version == 0 {
+		c.Version = 1
+	}
+	for _, mf := range f.Migrations {
+		c.Migrations = append(c.Migrations, Migration{
+			Version: mf.Version,
+			Kind:    MigrationKind(mf.Kind),
+			From:    mf.From,
+			To:      mf.To,
+		})
+	}
+
+	if len(f.Strands) == 0 {
+		for _, s := range builtinStrands() {
+			c.Strands = append(c.Strands, StrandInfo{ID: s, Name: builtinStrandName(s)})
+		}
+	}
+	for i, sf := range f.Strands {
+		if sf.ID == "" {
+			return nil, fmt.Errorf("strand %d: id is required", i)
+		}
+		name := sf.Name
+		if name == "" {
+			name = builtinStrandName(Strand(sf.ID))
+		}
+		c.Strands = append(c.Strands, StrandInfo{ID: Strand(sf.ID), Name: name})
+	}
+
+	defaults := f.Tiers.apply(DefaultTiers())
+	for i, sf := range f.Skills {
+		if sf.ID == "" {
+			return nil, fmt.Errorf("skill %d: id is required", i)
+		}
+		if sf.Name == "" {
+			return nil, fmt.Errorf("skill %q: name is required", sf.ID)
+		}
+		if sf.Grade == nil {
+			return nil, fmt.Errorf("skill %q: grade is required (0 = kindergarten)", sf.ID)
+		}
+		if *sf.Grade < 0 {
+			return nil, fmt.Errorf("skill %q: grade must be >= 0 (0 = kindergarten), got %d", sf.ID, *sf.Grade)
+		}
+		var standards map[Standard]string
+		for key, code := range sf.Standards {
+			std, err := ParseStandard(key)
+			if err != nil || key == "" {
+				return nil, fmt.Errorf("skill %q: unknown standard %q (available: %s)", sf.ID, key, standardList())
+			}
+			if standards == nil {
+				standards = make(map[Standard]string, len(sf.Standards))
+			}
+			standards[std] = code
+		}
+		c.Skills = append(c.Skills, Skill{
+			ID:            sf.ID,
+			Name:          sf.Name,
+			Description:   sf.Description,
+			Strand:        Strand(sf.Strand),
+			GradeLevel:    *sf.Grade,
+			Standards:     standards,
+			EstimatedMins: sf.EstimatedMins,
+			Keywords:      sf.Keywords,
+			Prerequisites: sf.Prerequisites,
+			Tiers:         sf.Tiers.apply(defaults),
+		})
+	}
+	return c, nil
+}
+
+// apply overlays the present tier fields onto base. A challenge block on a
+// base without a Challenge tier starts from DefaultChallengeTier; setting
+// its problems_required to 0 removes the tier again.
+func (t *tiersFile) apply(base Tiers) Tiers {
+	if t == nil {
+		return base
+	}
+	base[TierLearn] = t.Learn.apply(base[TierLearn])
+	base[TierProve] = t.Prove.apply(base[TierProve])
+	if t.Challenge != nil {
+		if !base.Has(TierChallenge) {
+			base[TierChallenge] = DefaultChallengeTier()
+		}
+		base[TierChallenge] = t.Challenge.apply(base[TierChallenge])
+		if base[TierChallenge].ProblemsRequired == 0 {
+			base[TierChallenge] = TierConfig{}
+		}
+	}
+	return base
+}
+
+func (t *tierFile) apply(base TierConfig) TierConfig {
+	if t == nil {
+		return base
+	}
+	if t.ProblemsRequired != nil {
+		base.ProblemsRequired = *t.ProblemsRequired
+	}
+	if t.AccuracyThreshold != nil {
+		base.AccuracyThreshold = *t.AccuracyThreshold
+	}
+	if t.TimeLimitSecs != nil {
+		base.TimeLimitSecs = *t.TimeLimitSecs
+	}
+	if t.HintsAllowed != nil {
+		base.HintsAllowed = *t.HintsAllowed
+	}
+	return base
+}
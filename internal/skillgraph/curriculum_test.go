@@ -0,0 +1,227 @@
+package skillgraph
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCurriculumYAML = `
+name: tiny
+tiers:
+  prove:
+    time_limit_secs: 45
+strands:
+  - id: counting
+    name: Counting
+  - id: adding
+skills:
+  - id: count-10
+    name: Count to 10
+    strand: counting
+    grade: 1
+  - id: add-10
+    name: Add within 10
+    strand: adding
+    grade: 1
+    prerequisites: [count-10]
+    tiers:
+      learn:
+        problems_required: 4
+`
+
+// useTestCurriculum activates c and restores the seed graph after the test.
+func useTestCurriculum(t *testing.T, c *Curriculum) {
+	t.Helper()
+	t.Cleanup(func() { g = buildGraph(SeedCurriculum()) })
+	if err := Use(c); err != nil {
+		t.Fatalf("Use: %v", err)
+	}
+}
+
+func TestParseCurriculum_YAML(t *testing.T) {
+	c, err := ParseCurriculum([]byte(testCurriculumYAML), "yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "tiny" || len(c.Strands) != 2 || len(c.Skills) != 2 {
+		t.Fatalf("got %+v", c)
+	}
+	// Unnamed strands fall back to their ID.
+	if c.Strands[1].Name != "adding" {
+		t.Errorf("strand name = %q, want %q", c.Strands[1].Name, "adding")
+	}
+
+	// File-level tiers overlay the defaults; skill-level tiers overlay those.
+	count := c.Skills[0]
+	if count.Tiers[TierProve].TimeLimitSecs != 45 || count.Tiers[TierProve].ProblemsRequired != 6 {
+		t.Errorf("count-10 prove tier = %+v", count.Tiers[TierProve])
+	}
+	add := c.Skills[1]
+	if add.Tiers[TierLearn].ProblemsRequired != 4 || add.Tiers[TierLearn].AccuracyThreshold != 0.75 {
+		t.Errorf("add-10 learn tier = %+v", add.Tiers[TierLearn])
+	}
+	if add.Tiers[TierProve].TimeLimitSecs != 45 {
+		t.Errorf("add-10 prove time limit = %d, want 45", add.Tiers[TierProve].TimeLimitSecs)
+	}
+}
+
+func TestParseCurriculum_ChallengeTier(t *testing.T) {
+	src := `
+name: challenge
+tiers:
+  challenge:
+    time_limit_secs: 15
+skills:
+  - id: a
+    name: A
+    strand: number-and-place-value
+    grade: 1
+  - id: b
+    name: B
+    strand: number-and-place-value
+    grade: 1
+    tiers:
+      challenge:
+        problems_required: 0
+`
+	c, err := ParseCurriculum([]byte(src), "yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A file-level challenge block opts every skill in from the defaults.
+	a := c.Skills[0].Tiers
+	want := DefaultChallengeTier()
+	want.TimeLimitSecs = 15
+	if a[TierChallenge] != want {
+		t.Errorf("a challenge tier = %+v, want %+v", a[TierChallenge], want)
+	}
+	// problems_required 0 opts a skill back out.
+	if b := c.Skills[1].Tiers; b.Has(TierChallenge) {
+		t.Errorf("b challenge tier = %+v, want none", b[TierChallenge])
+	}
+	if err := validateCurriculum([]Strand{StrandNumberPlace}, c.Skills); err != nil {
+		t.Errorf("validateCurriculum: %v", err)
+	}
+}
+
+func TestParseCurriculum_JSONDefaultsToBuiltinStrands(t *testing.T) {
+	doc := `{"skills": [{"id": "a", "name": "A", "strand": "fractions", "grade": 3}]}`
+	c, err := ParseCurriculum([]byte(doc), "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(c.Strands) != len(builtinStrands()) {
+		t.Errorf("got %d strands, want the %d built-in ones", len(c.Strands), len(builtinStrands()))
+	}
+	if c.Skills[0].Tiers != DefaultTiers() {
+		t.Errorf("tiers = %+v, want defaults", c.Skills[0].Tiers)
+	}
+}
+
+func TestParseCurriculum_RejectsUnknownFields(t *testing.T) {
+	doc := "skills:\n  - id: a\n    name: A\n    grade: 3\n    prereqs: [b]\n"
+	if _, err := ParseCurriculum([]byte(doc), "yaml"); err == nil {
+		t.Fatal("expected error for misspelled field, got nil")
+	}
+}
+
+func TestParseCurriculum_RequiresSkillFields(t *testing.T) {
+	doc := `{"skills": [{"id": "a", "strand": "fractions", "grade": 3}]}`
+	_, err := ParseCurriculum([]byte(doc), "json")
+	if err == nil || !strings.Contains(err.Error(), "name is required") {
+		t.Fatalf("expected missing-name error, got %v", err)
+	}
+}
+
+func TestParseCurriculum_RequiresGrade(t *testing.T) {
+	doc := `{"skills": [{"id": "a", "name": "A", "strand": "fractions"}]}`
+	_, err := ParseCurriculum([]byte(doc), "json")
+	if err == nil || !strings.Contains(err.Error(), "grade is required") {
+		t.Fatalf("expected missing-grade error, got %v", err)
+	}
+
+	doc = `{"skills": [{"id": "a", "name": "A", "strand": "fractions", "grade": 0}]}`
+	c, err := ParseCurriculum([]byte(doc), "json")
+	if err != nil {
+		t.Fatalf("kindergarten skill: %v", err)
+	}
+	if c.Skills[0].GradeLevel != 0 {
+		t.Errorf("grade = %d, want 0", c.Skills[0].GradeLevel)
+	}
+}
+
+func TestLoadCurriculumFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "regional.yml")
+	if err := os.WriteFile(path, []byte(strings.Replace(testCurriculumYAML, "name: tiny\n", "", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadCurriculumFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Unnamed curricula take the file's base name.
+	if c.Name != "regional" {
+		t.Errorf("name = %q, want %q", c.Name, "regional")
+	}
+
+	if _, err := LoadCurriculumFile(filepath.Join(dir, "curriculum.toml")); err == nil {
+		t.Error("expected error for unsupported extension, got nil")
+	}
+}
+
+func TestUse_SwapsActiveGraph(t *testing.T) {
+	c, err := ParseCurriculum([]byte(testCurriculumYAML), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	useTestCurriculum(t, c)
+
+	if CurriculumName() != "tiny" {
+		t.Errorf("CurriculumName() = %q, want tiny", CurriculumName())
+	}
+	if len(AllSkills()) != 2 {
+		t.Errorf("got %d skills, want 2", len(AllSkills()))
+	}
+	if got := AllStrands(); len(got) != 2 || got[0] != "counting" {
+		t.Errorf("AllStrands() = %v", got)
+	}
+	if StrandDisplayName("counting") != "Counting" {
+		t.Errorf("StrandDisplayName(counting) = %q", StrandDisplayName("counting"))
+	}
+	if _, err := GetSkill("pv-hundreds"); err == nil {
+		t.Error("seed skill still present after Use")
+	}
+	if !IsUnlocked("add-10", map[string]bool{"count-10": true}) {
+		t.Error("add-10 should unlock once count-10 is mastered")
+	}
+}
+
+func TestUse_InvalidCurriculumKeepsActiveGraph(t *testing.T) {
+	c := &Curriculum{
+		Name:    "broken",
+		Strands: []StrandInfo{{ID: "counting"}},
+		Skills: []Skill{
+			{ID: "a", Strand: "counting", Prerequisites: []string{"missing"}, Tiers: DefaultTiers()},
+		},
+	}
+	if err := Use(c); err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if CurriculumName() != SeedCurriculumName {
+		t.Errorf("active curriculum = %q, want the seed", CurriculumName())
+	}
+}
+
+func TestValidateCurriculum_UndeclaredStrand(t *testing.T) {
+	skills := []Skill{
+		{ID: "a", Strand: "counting", Tiers: DefaultTiers()},
+		{ID: "b", Strand: "geometry", Tiers: DefaultTiers()},
+	}
+	err := validateCurriculum([]Strand{"counting"}, skills)
+	if err == nil || !strings.Contains(err.Error(), "undeclared strand") {
+		t.Fatalf("expected undeclared strand error, got %v", err)
+	}
+}
@@ -1,10 +1,208 @@
 package skillgraph
 
+import "slices"
+
 // DiagnosticResult holds the outcome of a diagnostic placement quiz.
-// Diagnostic placement logic will be implemented in a future spec.
-// The algorithm (top-down probing across strands) requires the LLM
-// problem generation module (specs 04/05) to generate diagnostic questions.
 type DiagnosticResult struct {
-	MasteredSkillIDs []string
+	MasteredSkillIDs []string // placed skills plus their transitive prerequisites
+	PassedSkillIDs   []string // skills the learner passed a probe on
 	QuestionsAsked   int
 }
+
+// Diagnostic placement defaults (specs/03-skill-graph.md).
+const (
+	DefaultDiagnosticQuestions = 20 // total question budget across strands
+	diagnosticProbeSize        = 2  // questions per probe before a tie-break
+	diagnosticMaxProbeSize     = 3  // a 1-of-2 split earns a third question
+	diagnosticPassCorrect      = 2  // correct answers needed to pass a probe
+	diagnosticProbesPerStrand  = 3
+)
+
+// DiagnosticConfig bounds a placement quiz.
+type DiagnosticConfig struct {
+	// MaxQuestions caps the quiz length. Zero means DefaultDiagnosticQuestions.
+	MaxQuestions int
+	// MaxGrade skips skills above the learner's grade. Nil probes every
+	// grade; 0 is kindergarten.
+	MaxGrade *int
+	// Filter, when set, skips skills it rejects (e.g. ones the question
+	// generator can't serve).
+	Filter func(Skill) bool
+}
+
+// Diagnostic runs top-down probing across strands. Within a strand, skills
+// are ordered easiest to hardest; the first probe is the hardest skill, and
+// each later probe bisects the range between the highest pass and the lowest
+// fail. Strands take turns so the question budget spreads evenly.
+//
+// Diagnostic is pure bookkeeping: call Next for the skill to ask about, then
+// Record the learner's answer. It generates no questions itself.
+type Diagnostic struct {
+	maxQuestions int
+	strands      []*strandProbe
+	turn         int // index into strands of the current probe
+	asked        int
+	passed       []string
+}
+
+// strandProbe is the binary-search state for one strand. lo is the index of
+// the highest passed skill (-1 for none), hi the lowest failed (len for none).
+type strandProbe struct {
+	skills  []Skill
+	lo, hi  int
+	probes  int
+	cur     int // index being probed, -1 between probes
+	asked   int // questions asked on the current probe
+	correct int
+}
+
+// NewDiagnostic creates a placement quiz over the active skill graph.
+func NewDiagnostic(cfg DiagnosticConfig) *Diagnostic {
+	d := &Diagnostic{maxQuestions: cfg.MaxQuestions}
+	if d.maxQuestions <= 0 {
+		d.maxQuestions = DefaultDiagnosticQuestions
+	}
+	for _, strand := range AllStrands() {
+		skills := ByStrand(strand)
+		if cfg.MaxGrade != nil {
+			skills = slices.DeleteFunc(skills, func(s Skill) bool { return s.GradeLevel > *cfg.MaxGrade })
+		}
+		if cfg.Filter != nil {
+			skills = slices.DeleteFunc(skills, func(s Skill) bool { return !cfg.Filter(s) })
+		}
+		if len(skills) == 0 {
+			continue
+		}
+		d.strands = append(d.strands, &strandProbe{skills: skills, lo: -1, hi: len(skills), cur: -1})
+	}
+	return d
+}
+
+// Next returns the skill the next question should probe, or false when the
+// quiz is over.
+func (d *Diagnostic) Next() (Skill, bool) {
+	if sp := d.current(); sp != nil {
+		return sp.skills[sp.cur], true
+	}
+	// Start a new probe on the next strand with room to narrow, as long as
+	// the budget can fit a whole probe.
+	if d.maxQuestions-d.asked < diagnosticProbeSize {
+		return Skill{}, false
+	}
+	for range d.strands {
+		sp := d.strands[d.turn]
+		if sp.open() {
+			sp.cur = sp.nextIndex()
+			sp.asked, sp.correct = 0, 0
+			return sp.skills[sp.cur], true
+		}
+		d.turn = (d.turn + 1) % len(d.strands)
+	}
+	return Skill{}, false
+}
+
+// Record scores the answer to the question on the skill Next returned.
+func (d *Diagnostic) Record(correct bool) {
+	sp := d.current()
+	if sp == nil {
+		return
+	}
+	d.asked++
+	sp.asked++
+	if correct {
+		sp.correct++
+	}
+
+	wrong := sp.asked - sp.correct
+	pass := sp.correct >= diagnosticPassCorrect
+	fail := wrong > diagnosticMaxProbeSize-diagnosticPassCorrect ||
+		// A split probe the budget can't tie-break counts as a fail:
+		// placement errs on the side of practising.
+		(d.asked >= d.maxQuestions && !pass)
+	if !pass && !fail {
+		return
+	}
+
+	if pass {
+		sp.lo = sp.cur
+		d.passed = append(d.passed, sp.skills[sp.cur].ID)
+	} else {
+		sp.hi = sp.cur
+	}
+	sp.cur = -1
+	sp.probes++
+	if len(d.strands) > 0 {
+		d.turn = (d.turn + 1) % len(d.strands)
+	}
+}
+
+// MaxQuestions returns the question budget.
+func (d *Diagnostic) MaxQuestions() int {
+	return d.maxQuestions
+}
+
+// Done reports whether the quiz is over.
+func (d *Diagnostic) Done() bool {
+	_, ok := d.Next()
+	return !ok
+}
+
+// Result returns the placement. Within a strand the probes assume skills
+// are ordered by difficulty, so everything at or below the highest passed
+// skill counts as mastered — together with all of their transitive
+// prerequisites, which may sit in other strands.
+func (d *Diagnostic) Result() DiagnosticResult {
+	mastered := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		if mastered[id] {
+			return
+		}
+		mastered[id] = true
+		for _, p := range Prerequisites(id) {
+			visit(p.ID)
+		}
+	}
+	for _, sp := range d.strands {
+		for i := 0; i <= sp.lo; i++ {
+			visit(sp.skills[i].ID)
+		}
+	}
+
+	res := DiagnosticResult{
+		PassedSkillIDs: slices.Clone(d.passed),
+		QuestionsAsked: d.asked,
+	}
+	// Topological order keeps the result deterministic and readable.
+	for _, s := range TopologicalOrder() {
+		if mastered[s.ID] {
+			res.MasteredSkillIDs = append(res.MasteredSkillIDs, s.ID)
+		}
+	}
+	return res
+}
+
+// current returns the strand with a probe in progress, if any.
+func (d *Diagnostic) current() *strandProbe {
+	if len(d.strands) == 0 {
+		return nil
+	}
+	if sp := d.strands[d.turn]; sp.cur >= 0 {
+		return sp
+	}
+	return nil
+}
+
+// open reports whether the strand still has an unresolved range to probe.
+func (sp *strandProbe) open() bool {
+	return sp.probes < diagnosticProbesPerStrand && sp.hi-sp.lo > 1
+}
+
+// nextIndex picks the next skill to probe: the hardest skill first, then the
+// midpoint of the unresolved range.
+func (sp *strandProbe) nextIndex() int {
+	if sp.probes == 0 {
+		return sp.hi - 1
+	}
+	return (sp.lo + sp.hi) / 2
+}
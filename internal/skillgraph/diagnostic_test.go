@@ -0,0 +1,158 @@
+package skillgraph
+
+import (
+	"slices"
+	"testing"
+)
+
+// runDiagnostic answers every probe with answer(skill) until the quiz ends.
+func runDiagnostic(d *Diagnostic, answer func(Skill) bool) DiagnosticResult {
+	for {
+		s, ok := d.Next()
+		if !ok {
+			return d.Result()
+		}
+		d.Record(answer(s))
+	}
+}
+
+func TestDiagnostic_FullSkip(t *testing.T) {
+	res := runDiagnostic(NewDiagnostic(DiagnosticConfig{}), func(Skill) bool { return true })
+
+	// Acing the hardest skill of every strand masters the whole graph.
+	if len(res.MasteredSkillIDs) != len(AllSkills()) {
+		t.Errorf("mastered %d skills, want all %d", len(res.MasteredSkillIDs), len(AllSkills()))
+	}
+	if want := len(AllStrands()) * diagnosticProbeSize; res.QuestionsAsked != want {
+		t.Errorf("asked %d questions, want %d", res.QuestionsAsked, want)
+	}
+}
+
+func TestDiagnostic_NoSkip(t *testing.T) {
+	res := runDiagnostic(NewDiagnostic(DiagnosticConfig{}), func(Skill) bool { return false })
+
+	if len(res.MasteredSkillIDs) != 0 {
+		t.Errorf("mastered %v, want none", res.MasteredSkillIDs)
+	}
+	if res.QuestionsAsked < 10 || res.QuestionsAsked > DefaultDiagnosticQuestions {
+		t.Errorf("asked %d questions, want 10-%d", res.QuestionsAsked, DefaultDiagnosticQuestions)
+	}
+}
+
+func TestDiagnostic_PartialPlacement(t *testing.T) {
+	res := runDiagnostic(NewDiagnostic(DiagnosticConfig{}), func(s Skill) bool {
+		return s.Strand == StrandAddSub
+	})
+
+	for _, id := range res.MasteredSkillIDs {
+		s, _ := GetSkill(id)
+		if s.Strand != StrandAddSub && !isPrereqOfStrand(id, StrandAddSub) {
+			t.Errorf("mastered %s (%s), want only addition/subtraction and its prerequisites", id, s.Strand)
+		}
+	}
+	for _, s := range ByStrand(StrandAddSub) {
+		if !slices.Contains(res.MasteredSkillIDs, s.ID) {
+			t.Errorf("addition/subtraction skill %s not mastered", s.ID)
+		}
+	}
+}
+
+func TestDiagnostic_TransitiveClosure(t *testing.T) {
+	d := NewDiagnostic(DiagnosticConfig{MaxQuestions: diagnosticProbeSize})
+	s, ok := d.Next()
+	if !ok {
+		t.Fatal("expected a first probe")
+	}
+	d.Record(true)
+	d.Record(true)
+	res := d.Result()
+
+	if !slices.Equal(res.PassedSkillIDs, []string{s.ID}) {
+		t.Fatalf("passed = %v, want [%s]", res.PassedSkillIDs, s.ID)
+	}
+	for _, p := range Prerequisites(s.ID) {
+		if !slices.Contains(res.MasteredSkillIDs, p.ID) {
+			t.Errorf("prerequisite %s of passed %s not mastered", p.ID, s.ID)
+		}
+	}
+	if _, ok := d.Next(); ok {
+		t.Error("quiz should end once the budget is spent")
+	}
+}
+
+func TestDiagnostic_SplitProbeAsksThird(t *testing.T) {
+	d := NewDiagnostic(DiagnosticConfig{})
+	first, _ := d.Next()
+	d.Record(true)
+	d.Record(false)
+	if s, _ := d.Next(); s.ID != first.ID {
+		t.Fatalf("after a 1-of-2 split, next probe = %s, want a third question on %s", s.ID, first.ID)
+	}
+	d.Record(true)
+	if res := d.Result(); !slices.Contains(res.PassedSkillIDs, first.ID) {
+		t.Errorf("2 of 3 correct should pass %s", first.ID)
+	}
+}
+
+func TestDiagnostic_MaxGrade(t *testing.T) {
+	for _, grade := range []int{0, 3} {
+		d := NewDiagnostic(DiagnosticConfig{MaxGrade: &grade})
+		asked := 0
+		for {
+			s, ok := d.Next()
+			if !ok {
+				break
+			}
+			if s.GradeLevel > grade {
+				t.Fatalf("probed grade %d skill %s with MaxGrade %d", s.GradeLevel, s.ID, grade)
+			}
+			asked++
+			d.Record(false)
+		}
+		if asked == 0 {
+			t.Errorf("MaxGrade %d asked nothing", grade)
+		}
+	}
+}
+
+func TestDiagnostic_Filter(t *testing.T) {
+	onlyFractions := func(s Skill) bool { return s.Strand == StrandFractions }
+	d := NewDiagnostic(DiagnosticConfig{Filter: onlyFractions})
+	for {
+		s, ok := d.Next()
+		if !ok {
+			break
+		}
+		if !onlyFractions(s) {
+			t.Fatalf("probed filtered-out skill %s", s.ID)
+		}
+		d.Record(true)
+	}
+}
+
+// isPrereqOfStrand reports whether id is a transitive prerequisite of any
+// skill in strand.
+func isPrereqOfStrand(id string, strand Strand) bool {
+	seen := map[string]bool{}
+	var walk func(string) bool
+	walk = func(cur string) bool {
+		for _, p := range Prerequisites(cur) {
+			if p.ID == id {
+				return true
+			}
+			if !seen[p.ID] {
+				seen[p.ID] = true
+				if walk(p.ID) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, s := range ByStrand(strand) {
+		if walk(s.ID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+package skillgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ExportFormat selects the output of WriteGraph.
+type ExportFormat string
+
+const (
+	ExportDOT     ExportFormat = "dot"     // Graphviz
+	ExportMermaid ExportFormat = "mermaid" // Mermaid flowchart
+	ExportJSON    ExportFormat = "json"    // nodes and edges
+)
+
+// AllExportFormats returns the supported export formats.
+func AllExportFormats() []ExportFormat {
+	return []ExportFormat{ExportDOT, ExportMermaid, ExportJSON}
+}
+
+// ParseExportFormat parses a format name, case-insensitively.
+func ParseExportFormat(s string) (ExportFormat, error) {
+	f := ExportFormat(strings.ToLower(s))
+	if !slices.Contains(AllExportFormats(), f) {
+		names := make([]string, 0, len(AllExportFormats()))
+		for _, f := range AllExportFormats() {
+			names = append(names, string(f))
+		}
+		return "", fmt.Errorf("unknown format %q (available: %s)", s, strings.Join(names, ", "))
+	}
+	return f, nil
+}
+
+// ExportOptions configures WriteGraph.
+type ExportOptions struct {
+	// Standard picks the skill codes shown on nodes.
+	Standard Standard
+	// States maps skill IDs to a learner's mastery state ("new", "learning",
+	// "mastered" or "rusty"). Nodes with a state are coloured; nil exports
+	// the bare curriculum.
+	States map[string]string
+}
+
+// stateColors are the node fills per mastery state, matching the skill map.
+var stateColors = map[string]string{
+	"new":      "#f2f2f2",
+	"learning": "#fff2a8",
+	"mastered": "#b7e4a8",
+	"rusty":    "#f5c28b",
+}
+
+// GraphExport is the JSON form of the exported graph.
+type GraphExport struct {
+	Curriculum string       `json:"curriculum"`
+	Standard   Standard     `json:"standard"`
+	Strands    []StrandInfo `json:"strands"`
+	Nodes      []GraphNode  `json:"nodes"`
+	Edges      []GraphEdge  `json:"edges"`
+}
+
+// GraphNode is one skill in a GraphExport.
+type GraphNode struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Strand Strand `json:"strand"`
+	Grade  int    `json:"grade"`
+	Code   string `json:"code,omitempty"`
+	State  string `json:"state,omitempty"`
+}
+
+// GraphEdge is a prerequisite link: From must be mastered to unlock To.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ExportGraph builds the active skill graph's export: nodes in topological
+// order, edges from each skill to its dependents.
+func ExportGraph(opts ExportOptions) GraphExport {
+	out := GraphExport{
+		Curriculum: CurriculumName(),
+		Standard:   opts.Standard,
+		Nodes:      []GraphNode{},
+		Edges:      []GraphEdge{},
+	}
+	for _, s := range AllStrands() {
+		out.Strands = append(out.Strands, StrandInfo{ID: s, Name: StrandDisplayName(s)})
+	}
+	for _, s := range TopologicalOrder() {
+		out.Nodes = append(out.Nodes, GraphNode{
+			ID:     s.ID,
+			Name:   s.Name,
+			Strand: s.Strand,
+			Grade:  s.GradeLevel,
+			Code:   s.Code(opts.Standard),
+			State:  opts.States[s.ID],
+		})
+		for _, d := range Dependents(s.ID) {
+			out.Edges = append(out.Edges, GraphEdge{From: s.ID, To: d.ID})
+		}
+	}
+	return out
+}
+
+// WriteGraph writes the active skill graph to w in the given format.
+func WriteGraph(w io.Writer, format ExportFormat, opts ExportOptions) error {
+	g := ExportGraph(opts)
+	switch format {
+	case ExportDOT:
+		return writeDOT(w, g)
+	case ExportMermaid:
+		return writeMermaid(w, g)
+	case ExportJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(g)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// nodeLabel is the two-line node caption: name, then grade and code.
+func nodeLabel(n GraphNode) (name, detail string) {
+	detail = "Grade " + GradeLabel(n.Grade)
+	if n.Code != "" {
+		detail += " · " + n.Code
+	}
+	return n.Name, detail
+}
+
+func writeDOT(w io.Writer, g GraphExport) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(g.Curriculum))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=\"#ffffff\", fontname=\"Helvetica\"];\n")
+	for i, si := range g.Strands {
+		fmt.Fprintf(&b, "\n  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(si.Name))
+		for _, n := range g.Nodes {
+			if n.Strand != si.ID {
+				continue
+			}
+			name, detail := nodeLabel(n)
+			fmt.Fprintf(&b, "    %s [label=%s", dotQuote(n.ID), dotQuote(name+"\n"+detail))
+			if c, ok := stateColors[n.State]; ok {
+				fmt.Fprintf(&b, ", fillcolor=%s", dotQuote(c))
+			}
+			b.WriteString("];\n")
+		}
+		b.WriteString("  }\n")
+	}
+	b.WriteString("\n")
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func writeMermaid(w io.Writer, g GraphExport) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for i, si := range g.Strands {
+		fmt.Fprintf(&b, "  subgraph strand%d[\"%s\"]\n", i, mermaidText(si.Name))
+		for _, n := range g.Nodes {
+			if n.Strand != si.ID {
+				continue
+			}
+			name, detail := nodeLabel(n)
+			fmt.Fprintf(&b, "    %s[\"%s<br/><small>%s</small>\"]\n", mermaidID(n.ID), mermaidText(name), mermaidText(detail))
+		}
+		b.WriteString("  end\n")
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(e.From), mermaidID(e.To))
+	}
+
+	// Colour by state: one class per state in use.
+	byState := map[string][]string{}
+	for _, n := range g.Nodes {
+		if _, ok := stateColors[n.State]; ok {
+			byState[n.State] = append(byState[n.State], mermaidID(n.ID))
+		}
+	}
+	for _, state := range []string{"new", "learning", "mastered", "rusty"} {
+		ids := byState[state]
+		if len(ids) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", state, stateColors[state])
+		fmt.Fprintf(&b, "  class %s %s\n", strings.Join(ids, ","), state)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidID turns a skill ID into a safe Mermaid node ID, and a prefix keeps
+// keywords like "end" from colliding. Lowercase letters and digits are kept
+// and hyphens become underscores; any other rune, uppercase included, is
+// written as its hex code between X's. Every escape is self-delimiting, so
+// distinct IDs such as "add-3d" and "add_3d" never share a node.
+func mermaidID(id string) string {
+	var b strings.Builder
+	b.WriteString("s_")
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '-':
+			b.WriteByte('_')
+		default:
+			fmt.Fprintf(&b, "X%xX", r)
+		}
+	}
+	return b.String()
+}
+
+func mermaidText(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
@@ -0,0 +1,118 @@
+package skillgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	if f, err := ParseExportFormat("Mermaid"); err != nil || f != ExportMermaid {
+		t.Errorf("ParseExportFormat(Mermaid) = %q, %v", f, err)
+	}
+	if _, err := ParseExportFormat("png"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func TestExportGraph(t *testing.T) {
+	g := ExportGraph(ExportOptions{Standard: DefaultStandard})
+	if len(g.Nodes) != len(AllSkills()) {
+		t.Errorf("got %d nodes, want %d", len(g.Nodes), len(AllSkills()))
+	}
+
+	wantEdges := 0
+	for _, s := range AllSkills() {
+		wantEdges += len(s.Prerequisites)
+	}
+	if len(g.Edges) != wantEdges {
+		t.Errorf("got %d edges, want %d", len(g.Edges), wantEdges)
+	}
+
+	// Nodes are in topological order: every edge points forward.
+	pos := make(map[string]int, len(g.Nodes))
+	for i, n := range g.Nodes {
+		pos[n.ID] = i
+	}
+	for _, e := range g.Edges {
+		if pos[e.From] >= pos[e.To] {
+			t.Errorf("edge %s -> %s points backwards", e.From, e.To)
+		}
+	}
+}
+
+func TestWriteGraph_DOT(t *testing.T) {
+	var buf bytes.Buffer
+	opts := ExportOptions{Standard: DefaultStandard, States: map[string]string{"pv-hundreds": "mastered"}}
+	if err := WriteGraph(&buf, ExportDOT, opts); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"digraph ",
+		`"pv-tens-ones" -> "pv-hundreds";`,
+		`"pv-hundreds" [label="Place Value to 1,000\nGrade 3 · 2.NBT.A.1", fillcolor="#b7e4a8"];`,
+		`"count-to-20" [label="Count to 20\nGrade K · K.CC.B.5"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT output missing %q", want)
+		}
+	}
+}
+
+func TestWriteGraph_Mermaid(t *testing.T) {
+	var buf bytes.Buffer
+	opts := ExportOptions{Standard: DefaultStandard, States: map[string]string{"pv-hundreds": "mastered", "add-2digit": "learning"}}
+	if err := WriteGraph(&buf, ExportMermaid, opts); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"flowchart LR\n",
+		"s_pv_tens_ones --> s_pv_hundreds\n",
+		"class s_pv_hundreds mastered\n",
+		"class s_add_2digit learning\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid output missing %q", want)
+		}
+	}
+}
+
+func TestMermaidID(t *testing.T) {
+	if got := mermaidID("pv-tens-ones"); got != "s_pv_tens_ones" {
+		t.Errorf("mermaidID(pv-tens-ones) = %q", got)
+	}
+	seen := map[string]string{}
+	for _, id := range []string{"add-3d", "add_3d", "add-_3d", "add_-3d", "Add-3d", "addX5fX3d", "end"} {
+		got := mermaidID(id)
+		if prev, ok := seen[got]; ok {
+			t.Errorf("mermaidID(%q) = mermaidID(%q) = %q", id, prev, got)
+		}
+		seen[got] = id
+	}
+}
+
+func TestWriteGraph_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	opts := ExportOptions{Standard: DefaultStandard, States: map[string]string{"pv-hundreds": "rusty"}}
+	if err := WriteGraph(&buf, ExportJSON, opts); err != nil {
+		t.Fatal(err)
+	}
+	var g GraphExport
+	if err := json.Unmarshal(buf.Bytes(), &g); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(g.Strands) != len(AllStrands()) {
+		t.Errorf("got %d strands, want %d", len(g.Strands), len(AllStrands()))
+	}
+	for _, n := range g.Nodes {
+		if n.ID == "pv-hundreds" && n.State != "rusty" {
+			t.Errorf("pv-hundreds state = %q, want rusty", n.State)
+		}
+		if n.ID == "count-to-20" && n.State != "" {
+			t.Errorf("count-to-20 state = %q, want none", n.State)
+		}
+	}
+}
@@ -8,29 +8,44 @@ import (
 
 // graph holds the skill DAG with precomputed indices.
 type graph struct {
-	skills     []Skill
-	byID       map[string]*Skill
-	byStrand   map[Strand][]Skill
-	byGrade    map[int][]Skill
-	roots      []Skill
-	dependents map[string][]string
-	topoOrder  []Skill
-	topoIndex  map[string]int
+	name        string
+	version     int
+	migrations  []Migration
+	strands     []Strand
+	strandNames map[Strand]string
+	skills      []Skill
+	byID        map[string]*Skill
+	byStrand    map[Strand][]Skill
+	byGrade     map[int][]Skill
+	roots       []Skill
+	dependents  map[string][]string
+	topoOrder   []Skill
+	topoIndex   map[string]int
 }
 
-// g is the package-level graph singleton, set by init() in seed.go.
+// g is the package-level graph singleton, set by init() in seed.go and
+// replaced by Use when an external curriculum is loaded.
 var g *graph
 
-// buildGraph constructs the graph from a slice of skills.
+// buildGraph constructs the graph from a curriculum.
 // It builds all indices including topological order (Kahn's algorithm).
-func buildGraph(skills []Skill) *graph {
+func buildGraph(c *Curriculum) *graph {
+	skills := c.Skills
 	gr := &graph{
-		skills:     skills,
-		byID:       make(map[string]*Skill, len(skills)),
-		byStrand:   make(map[Strand][]Skill),
-		byGrade:    make(map[int][]Skill),
-		dependents: make(map[string][]string),
-		topoIndex:  make(map[string]int, len(skills)),
+		name:        c.Name,
+		version:     c.Version,
+		migrations:  c.Migrations,
+		strandNames: make(map[Strand]string, len(c.Strands)),
+		skills:      skills,
+		byID:        make(map[string]*Skill, len(skills)),
+		byStrand:    make(map[Strand][]Skill),
+		byGrade:     make(map[int][]Skill),
+		dependents:  make(map[string][]string),
+		topoIndex:   make(map[string]int, len(skills)),
+	}
+	for _, si := range c.Strands {
+		gr.strands = append(gr.strands, si.ID)
+		gr.strandNames[si.ID] = si.Name
 	}
 
 	// Build ID index
@@ -93,8 +108,8 @@ func buildGraph(skills []Skill) *graph {
 		}
 	}
 
-	// Strand ordering: all strands in defined order
-	strandOrder := AllStrands()
+	// Strand ordering: all strands in curriculum order
+	strandOrder := gr.strands
 	strandIdx := make(map[Strand]int, len(strandOrder))
 	for i, s := range strandOrder {
 		strandIdx[s] = i
@@ -264,7 +279,7 @@ func TopologicalOrder() []Skill {
 }
 
 // Validate checks the graph for structural issues.
-// It delegates to validateSkills with the graph's skill set.
+// It delegates to Curriculum.Validate on the active curriculum.
 func Validate() error {
-	return validateSkills(g.skills)
+	return ActiveCurriculum().Validate()
 }
@@ -29,8 +29,8 @@ func TestGetSkill_NotFound(t *testing.T) {
 
 func TestAllSkills_Count(t *testing.T) {
 	all := AllSkills()
-	if len(all) != 56 {
-		t.Errorf("got %d skills, want 56", len(all))
+	if len(all) != 85 {
+		t.Errorf("got %d skills, want 85", len(all))
 	}
 }
 
@@ -39,11 +39,14 @@ func TestByStrand(t *testing.T) {
 		strand Strand
 		want   int
 	}{
-		{StrandNumberPlace, 8},
-		{StrandAddSub, 12},
+		{StrandNumberPlace, 11},
+		{StrandAddSub, 14},
 		{StrandMultDiv, 16},
 		{StrandFractions, 12},
 		{StrandMeasurement, 8},
+		{StrandGeometry, 10},
+		{StrandData, 6},
+		{StrandRatios, 8},
 	}
 	for _, tt := range tests {
 		skills := ByStrand(tt.strand)
@@ -68,15 +71,22 @@ func TestByStrand_SortedByGrade(t *testing.T) {
 func TestByGrade(t *testing.T) {
 	grade3 := ByGrade(3)
 	grade4 := ByGrade(4)
-	grade5 := ByGrade(5)
-	grade6 := ByGrade(6)
 
-	total := len(grade3) + len(grade4) + len(grade5)
-	if total != 56 {
-		t.Errorf("grade 3+4+5 total: got %d, want 56", total)
+	total := 0
+	for grade := 0; grade <= 6; grade++ {
+		total += len(ByGrade(grade))
 	}
-	if len(grade6) != 0 {
-		t.Errorf("ByGrade(6): got %d skills, want 0", len(grade6))
+	if total != 85 {
+		t.Errorf("grade K-6 total: got %d, want 85", total)
+	}
+	if n := len(ByGrade(0)); n != 4 {
+		t.Errorf("ByGrade(0): got %d skills, want 4", n)
+	}
+	if n := len(ByGrade(6)); n != 9 {
+		t.Errorf("ByGrade(6): got %d skills, want 9", n)
+	}
+	if n := len(ByGrade(7)); n != 0 {
+		t.Errorf("ByGrade(7): got %d skills, want 0", n)
 	}
 
 	// Verify all skills in each result are the correct grade
@@ -102,16 +112,9 @@ func TestRootSkills(t *testing.T) {
 			t.Errorf("root skill %q has prerequisites: %v", s.ID, s.Prerequisites)
 		}
 	}
-	// pv-hundreds should be a root
-	found := false
-	for _, s := range roots {
-		if s.ID == "pv-hundreds" {
-			found = true
-			break
-		}
-	}
-	if !found {
-		t.Error("pv-hundreds should be a root skill")
+	// Kindergarten counting is the single root of the graph
+	if len(roots) != 1 || roots[0].ID != "count-to-20" {
+		t.Errorf("roots = %v, want [count-to-20]", roots)
 	}
 }
 
@@ -139,9 +142,15 @@ func TestPrerequisites(t *testing.T) {
 	}
 
 	// Root skill has no prerequisites
-	prereqs = Prerequisites("pv-hundreds")
+	prereqs = Prerequisites("count-to-20")
 	if len(prereqs) != 0 {
-		t.Errorf("pv-hundreds: got %d prereqs, want 0", len(prereqs))
+		t.Errorf("count-to-20: got %d prereqs, want 0", len(prereqs))
+	}
+
+	// The K-1 chain joins the original graph through a single edge
+	prereqs = Prerequisites("pv-hundreds")
+	if len(prereqs) != 1 || prereqs[0].ID != "pv-tens-ones" {
+		t.Errorf("pv-hundreds prereqs: got %v, want [pv-tens-ones]", prereqs)
 	}
 }
 
@@ -167,8 +176,11 @@ func TestIsUnlocked(t *testing.T) {
 	empty := map[string]bool{}
 
 	// Root skill is always unlocked
-	if !IsUnlocked("pv-hundreds", empty) {
-		t.Error("pv-hundreds should be unlocked with empty mastered set")
+	if !IsUnlocked("count-to-20", empty) {
+		t.Error("count-to-20 should be unlocked with empty mastered set")
+	}
+	if IsUnlocked("pv-hundreds", empty) {
+		t.Error("pv-hundreds should be locked with empty mastered set")
 	}
 
 	// add-3digit requires add-2digit
@@ -275,7 +287,7 @@ func TestBlockedSkills_EmptyMastered(t *testing.T) {
 	roots := RootSkills()
 
 	// Everything except roots should be blocked
-	expectedBlocked := 56 - len(roots)
+	expectedBlocked := 85 - len(roots)
 	if len(blocked) != expectedBlocked {
 		t.Errorf("got %d blocked skills, want %d", len(blocked), expectedBlocked)
 	}
@@ -296,8 +308,8 @@ func TestBlockedSkills_AllMastered(t *testing.T) {
 
 func TestTopologicalOrder(t *testing.T) {
 	topo := TopologicalOrder()
-	if len(topo) != 56 {
-		t.Fatalf("got %d skills in topo order, want 56", len(topo))
+	if len(topo) != 85 {
+		t.Fatalf("got %d skills in topo order, want 85", len(topo))
 	}
 
 	// Verify topological property: every skill appears after all its prerequisites
@@ -335,3 +347,49 @@ func TestAllSkills_ReturnsCopy(t *testing.T) {
 		t.Error("AllSkills did not return a defensive copy")
 	}
 }
+
+func TestTiers_NextAndFinal(t *testing.T) {
+	def := DefaultTiers()
+	if def.Has(TierChallenge) || def.Final() != TierProve {
+		t.Errorf("default tiers: Has(Challenge)=%v Final=%v", def.Has(TierChallenge), def.Final())
+	}
+	if next, ok := def.Next(TierLearn); !ok || next != TierProve {
+		t.Errorf("Next(Learn) = %v, %v; want Prove, true", next, ok)
+	}
+	if _, ok := def.Next(TierProve); ok {
+		t.Error("Prove should be final without a Challenge tier")
+	}
+
+	facts := FactFluencyTiers()
+	if facts.Final() != TierChallenge {
+		t.Errorf("fact tiers Final = %v, want Challenge", facts.Final())
+	}
+	if next, ok := facts.Next(TierProve); !ok || next != TierChallenge {
+		t.Errorf("Next(Prove) = %v, %v; want Challenge, true", next, ok)
+	}
+	if _, ok := facts.Next(TierChallenge); ok {
+		t.Error("Challenge should be final")
+	}
+}
+
+func TestParseTier_RoundTrip(t *testing.T) {
+	for _, tier := range []Tier{TierLearn, TierProve, TierChallenge} {
+		if got := ParseTier(tier.String()); got != tier {
+			t.Errorf("ParseTier(%q) = %v, want %v", tier.String(), got, tier)
+		}
+	}
+	if ParseTier("bogus") != TierLearn {
+		t.Error("unknown tier names should parse as Learn")
+	}
+}
+
+func TestSeedTierPresets(t *testing.T) {
+	facts, _ := GetSkill("mult-facts-2-5-10")
+	if !facts.Tiers.Has(TierChallenge) {
+		t.Error("mult-facts-2-5-10 should have a Challenge tier")
+	}
+	words, _ := GetSkill("mult-div-word")
+	if words.Tiers.Has(TierChallenge) || words.Tiers[TierProve].TimeLimitSecs <= DefaultTiers()[TierProve].TimeLimitSecs {
+		t.Errorf("mult-div-word tiers = %+v, want word-problem preset", words.Tiers)
+	}
+}
@@ -0,0 +1,294 @@
+package skillgraph
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// LintKind classifies a LintIssue.
+type LintKind string
+
+const (
+	LintOrphan          LintKind = "orphan"                 // no prerequisites and no dependents
+	LintRedundantPrereq LintKind = "redundant-prerequisite" // implied by another prerequisite
+	LintGradeInversion  LintKind = "grade-inversion"        // depends on a higher-grade skill
+	LintDepthGap        LintKind = "depth-gap"              // strand skips a grade
+)
+
+// LintIssue is a curriculum authoring problem. Unlike validation errors,
+// lint issues don't stop a curriculum from loading.
+type LintIssue struct {
+	Kind LintKind
+	// SkillID is the skill the issue is about; empty for strand-level issues.
+	SkillID string
+	Strand  Strand
+	Message string
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Kind, i.Message)
+}
+
+// ActiveCurriculum returns the curriculum behind the active skill graph.
+func ActiveCurriculum() *Curriculum {
+	c := &Curriculum{
+		Name:       g.name,
+		Skills:     slices.Clone(g.skills),
+		Version:    g.version,
+		Migrations: slices.Clone(g.migrations),
+	}
+	for _, s := range g.strands {
+		c.Strands = append(c.Strands, StrandInfo{ID: s, Name: g.strandNames[s]})
+	}
+	return c
+}
+
+// Lint checks a structurally valid curriculum for authoring smells: orphan
+// skills, prerequisites already implied by another prerequisite, skills that
+// depend on a higher-grade skill, and strands that skip a grade. Issues are
+// sorted by kind, then skill or strand.
+func Lint(c *Curriculum) []LintIssue {
+	gr := buildGraph(c)
+	var issues []LintIssue
+
+	ancestors := gr.ancestors()
+	for _, s := range gr.topoOrder {
+		// Orphans: only meaningful when there is something to connect to.
+		if len(s.Prerequisites) == 0 && len(gr.dependents[s.ID]) == 0 && len(gr.skills) > 1 {
+			issues = append(issues, LintIssue{
+				Kind:    LintOrphan,
+				SkillID: s.ID,
+				Strand:  s.Strand,
+				Message: fmt.Sprintf("%s has no prerequisites and nothing depends on it", s.ID),
+			})
+		}
+
+		for _, p := range s.Prerequisites {
+			for _, q := range s.Prerequisites {
+				if q != p && ancestors[q][p] {
+					issues = append(issues, LintIssue{
+						Kind:    LintRedundantPrereq,
+						SkillID: s.ID,
+						Strand:  s.Strand,
+						Message: fmt.Sprintf("%s: prerequisite %s is already required by %s", s.ID, p, q),
+					})
+					break
+				}
+			}
+
+			pre := gr.byID[p]
+			if pre != nil && pre.GradeLevel > s.GradeLevel {
+				issues = append(issues, LintIssue{
+					Kind:    LintGradeInversion,
+					SkillID: s.ID,
+					Strand:  s.Strand,
+					Message: fmt.Sprintf("%s (grade %s) depends on %s (grade %s)",
+						s.ID, GradeLabel(s.GradeLevel), p, GradeLabel(pre.GradeLevel)),
+				})
+			}
+		}
+	}
+
+	for _, strand := range gr.strands {
+		var grades []int
+		for _, s := range gr.byStrand[strand] {
+			if !slices.Contains(grades, s.GradeLevel) {
+				grades = append(grades, s.GradeLevel)
+			}
+		}
+		sort.Ints(grades)
+		for i := 1; i < len(grades); i++ {
+			if grades[i]-grades[i-1] <= 1 {
+				continue
+			}
+			var missing []string
+			for grade := grades[i-1] + 1; grade < grades[i]; grade++ {
+				missing = append(missing, GradeLabel(grade))
+			}
+			issues = append(issues, LintIssue{
+				Kind:   LintDepthGap,
+				Strand: strand,
+				Message: fmt.Sprintf("strand %s has no grade %s skills between grade %s and %s",
+					strand, strings.Join(missing, ", "), GradeLabel(grades[i-1]), GradeLabel(grades[i])),
+			})
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Kind != issues[j].Kind {
+			return lintKindOrder(issues[i].Kind) < lintKindOrder(issues[j].Kind)
+		}
+		if issues[i].SkillID != issues[j].SkillID {
+			return issues[i].SkillID < issues[j].SkillID
+		}
+		return issues[i].Strand < issues[j].Strand
+	})
+	return issues
+}
+
+func lintKindOrder(k LintKind) int {
+	return slices.Index([]LintKind{LintOrphan, LintRedundantPrereq, LintGradeInversion, LintDepthGap}, k)
+}
+
+// ancestors maps each skill ID to the set of skills it transitively depends
+// on.
+func (gr *graph) ancestors() map[string]map[string]bool {
+	out := make(map[string]map[string]bool, len(gr.skills))
+	for _, s := range gr.topoOrder {
+		set := make(map[string]bool)
+		for _, p := range s.Prerequisites {
+			set[p] = true
+			for a := range out[p] {
+				set[a] = true
+			}
+		}
+		out[s.ID] = set
+	}
+	return out
+}
+
+// CurriculumDiff is the difference between two curricula, by skill ID.
+type CurriculumDiff struct {
+	FromVersion, ToVersion int
+
+	Added   []string
+	Removed []string
+	Changed []SkillChange
+
+	// Unmigrated lists removed skills that no newer migration in the new
+	// curriculum carries over: learner progress on them would be lost.
+	Unmigrated []string
+}
+
+// SkillChange lists what changed on a skill present in both curricula.
+type SkillChange struct {
+	ID      string
+	Changes []string // e.g. "grade: 3 → 4"
+}
+
+// Empty reports whether the curricula have the same skills and edges.
+func (d CurriculumDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffCurricula compares two curricula: added and removed skills, the name,
+// strand, grade and prerequisite changes of the rest, and removed skills
+// without a migration. Results are sorted by skill ID.
+func DiffCurricula(from, to *Curriculum) CurriculumDiff {
+	d := CurriculumDiff{FromVersion: from.Version, ToVersion: to.Version}
+	before := skillIndex(from)
+	after := skillIndex(to)
+
+	for id, b := range before {
+		a, ok := after[id]
+		if !ok {
+			d.Removed = append(d.Removed, id)
+			continue
+		}
+		var changes []string
+		if a.Name != b.Name {
+			changes = append(changes, fmt.Sprintf("name: %q → %q", b.Name, a.Name))
+		}
+		if a.Strand != b.Strand {
+			changes = append(changes, fmt.Sprintf("strand: %s → %s", b.Strand, a.Strand))
+		}
+		if a.GradeLevel != b.GradeLevel {
+			changes = append(changes, fmt.Sprintf("grade: %s → %s", GradeLabel(b.GradeLevel), GradeLabel(a.GradeLevel)))
+		}
+		if added, removed := setDiff(b.Prerequisites, a.Prerequisites); len(added)+len(removed) > 0 {
+			for _, p := range added {
+				changes = append(changes, "prerequisite added: "+p)
+			}
+			for _, p := range removed {
+				changes = append(changes, "prerequisite removed: "+p)
+			}
+		}
+		if len(changes) > 0 {
+			d.Changed = append(d.Changed, SkillChange{ID: id, Changes: changes})
+		}
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			d.Added = append(d.Added, id)
+		}
+	}
+
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+	for _, id := range d.Removed {
+		migrated := slices.ContainsFunc(to.Migrations, func(m Migration) bool {
+			return m.Version > from.Version && m.Kind != MigrateBackfill && slices.Contains(m.From, id)
+		})
+		if !migrated {
+			d.Unmigrated = append(d.Unmigrated, id)
+		}
+	}
+	sort.Slice(d.Changed, func(i, j int) bool { return d.Changed[i].ID < d.Changed[j].ID })
+	return d
+}
+
+// UnlockChange is a skill whose availability flips for a learner when the
+// curriculum changes.
+type UnlockChange struct {
+	SkillID string
+	// Unlocked is true for locked → available, false for available → locked.
+	Unlocked bool
+}
+
+// UnlockChanges reports the skills present in both curricula whose
+// locked/available state changes for a learner with the given mastered set.
+// Mastered skills are never reported: they stay mastered either way.
+func UnlockChanges(from, to *Curriculum, mastered map[string]bool) []UnlockChange {
+	before := skillIndex(from)
+	var out []UnlockChange
+	for _, s := range to.Skills {
+		b, ok := before[s.ID]
+		if !ok || mastered[s.ID] {
+			continue
+		}
+		was := allMastered(b.Prerequisites, mastered)
+		now := allMastered(s.Prerequisites, mastered)
+		if was != now {
+			out = append(out, UnlockChange{SkillID: s.ID, Unlocked: now})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].SkillID < out[j].SkillID })
+	return out
+}
+
+func skillIndex(c *Curriculum) map[string]Skill {
+	m := make(map[string]Skill, len(c.Skills))
+	for _, s := range c.Skills {
+		m[s.ID] = s
+	}
+	return m
+}
+
+func allMastered(ids []string, mastered map[string]bool) bool {
+	for _, id := range ids {
+		if !mastered[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// setDiff returns the elements of b missing from a (added) and of a missing
+// from b (removed), sorted.
+func setDiff(a, b []string) (added, removed []string) {
+	for _, x := range b {
+		if !slices.Contains(a, x) {
+			added = append(added, x)
+		}
+	}
+	for _, x := range a {
+		if !slices.Contains(b, x) {
+			removed = append(removed, x)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,137 @@
+package skillgraph
+
+import (
+	"slices"
+	"testing"
+)
+
+func lintTestCurriculum(skills ...Skill) *Curriculum {
+	return &Curriculum{
+		Name:    "lint",
+		Strands: []StrandInfo{{ID: "counting", Name: "Counting"}},
+		Skills:  skills,
+	}
+}
+
+func lintSkill(id string, grade int, prereqs ...string) Skill {
+	return Skill{ID: id, Name: id, Strand: "counting", GradeLevel: grade, Prerequisites: prereqs, Tiers: DefaultTiers()}
+}
+
+func lintKinds(issues []LintIssue) map[LintKind][]string {
+	out := make(map[LintKind][]string)
+	for _, i := range issues {
+		out[i.Kind] = append(out[i.Kind], i.SkillID)
+	}
+	return out
+}
+
+func TestLint_Clean(t *testing.T) {
+	c := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 1, "a"),
+		lintSkill("c", 2, "b"),
+	)
+	if issues := Lint(c); len(issues) != 0 {
+		t.Errorf("unexpected issues: %v", issues)
+	}
+}
+
+func TestLint_FindsSmells(t *testing.T) {
+	c := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 3, "a"),
+		lintSkill("c", 2, "a", "b"), // a is implied by b; b is a higher grade
+		lintSkill("lonely", 1),
+	)
+	kinds := lintKinds(Lint(c))
+
+	if got := kinds[LintOrphan]; !slices.Equal(got, []string{"lonely"}) {
+		t.Errorf("orphans = %v, want [lonely]", got)
+	}
+	if got := kinds[LintRedundantPrereq]; !slices.Equal(got, []string{"c"}) {
+		t.Errorf("redundant prerequisites = %v, want [c]", got)
+	}
+	if got := kinds[LintGradeInversion]; !slices.Equal(got, []string{"c"}) {
+		t.Errorf("grade inversions = %v, want [c]", got)
+	}
+	if got := kinds[LintDepthGap]; len(got) != 0 {
+		t.Errorf("depth gaps = %v, want none (grades 1-3 are all covered)", got)
+	}
+}
+
+func TestLint_DepthGap(t *testing.T) {
+	c := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 4, "a"),
+	)
+	issues := Lint(c)
+	if len(issues) != 1 || issues[0].Kind != LintDepthGap || issues[0].Strand != "counting" {
+		t.Fatalf("issues = %v, want one depth gap", issues)
+	}
+	if want := "strand counting has no grade 2, 3 skills between grade 1 and 4"; issues[0].Message != want {
+		t.Errorf("message = %q, want %q", issues[0].Message, want)
+	}
+}
+
+func TestDiffCurricula(t *testing.T) {
+	from := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 1, "a"),
+		lintSkill("gone", 1, "a"),
+	)
+	to := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 2),
+		lintSkill("new", 1, "a"),
+	)
+	d := DiffCurricula(from, to)
+
+	if !slices.Equal(d.Added, []string{"new"}) || !slices.Equal(d.Removed, []string{"gone"}) {
+		t.Errorf("added = %v, removed = %v", d.Added, d.Removed)
+	}
+	if len(d.Changed) != 1 || d.Changed[0].ID != "b" {
+		t.Fatalf("changed = %+v, want b", d.Changed)
+	}
+	want := []string{"grade: 1 → 2", "prerequisite removed: a"}
+	if !slices.Equal(d.Changed[0].Changes, want) {
+		t.Errorf("b changes = %q, want %q", d.Changed[0].Changes, want)
+	}
+	if !DiffCurricula(from, from).Empty() {
+		t.Error("a curriculum should not differ from itself")
+	}
+}
+
+func TestUnlockChanges(t *testing.T) {
+	from := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 1),
+		lintSkill("c", 2, "a"),
+		lintSkill("d", 2, "b"),
+	)
+	to := lintTestCurriculum(
+		lintSkill("a", 1),
+		lintSkill("b", 1),
+		lintSkill("c", 2, "a", "b"), // now also needs b
+		lintSkill("d", 2, "a"),      // now needs a instead of b
+	)
+	changes := UnlockChanges(from, to, map[string]bool{"a": true})
+
+	want := []UnlockChange{
+		{SkillID: "c", Unlocked: false},
+		{SkillID: "d", Unlocked: true},
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes = %+v, want %+v", changes, want)
+	}
+
+	// Mastered skills never flip.
+	if changes := UnlockChanges(from, to, map[string]bool{"a": true, "c": true, "d": true}); len(changes) != 0 {
+		t.Errorf("changes = %+v, want none", changes)
+	}
+}
+
+func TestActiveCurriculum_RoundTrips(t *testing.T) {
+	if d := DiffCurricula(SeedCurriculum(), ActiveCurriculum()); !d.Empty() {
+		t.Errorf("active curriculum differs from the seed: %+v", d)
+	}
+}
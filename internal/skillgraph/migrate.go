@@ -0,0 +1,238 @@
+package skillgraph
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// MigrationKind is how a Migration maps old skill IDs to new ones.
+type MigrationKind string
+
+const (
+	MigrateAlias    MigrationKind = "alias"    // one skill renamed: From[0] → To[0]
+	MigrateSplit    MigrationKind = "split"    // one skill split: each To skill inherits From[0]
+	MigrateMerge    MigrationKind = "merge"    // several skills merged into To[0]
+	MigrateBackfill MigrationKind = "backfill" // To skills added beneath From: starting any From implies them
+)
+
+// Migration records a change to a curriculum's skill IDs, so learner
+// progress saved against an older graph version carries over. Version is
+// the graph version that introduced the change.
+type Migration struct {
+	Version int
+	Kind    MigrationKind
+	From    []string
+	To      []string
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("v%d %s %s → %s", m.Version, m.Kind, strings.Join(m.From, ","), strings.Join(m.To, ","))
+}
+
+// GraphVersion returns the active curriculum's version. Snapshots record it
+// so later loads know which migrations they still need.
+func GraphVersion() int {
+	return g.version
+}
+
+// MigrationsSince returns the active curriculum's migrations newer than
+// version, oldest first.
+func MigrationsSince(version int) []Migration {
+	var out []Migration
+	for _, m := range g.migrations {
+		if m.Version > version {
+			out = append(out, m)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out
+}
+
+// renames reports whether a migration carries records over to new IDs, as
+// alias, split and merge do. A backfill adds skills without replacing any.
+func (m Migration) renames() bool {
+	return m.Kind != MigrateBackfill
+}
+
+// CurrentIDs returns the skills that history recorded against id counts
+// towards after the active curriculum's renames, splits and merges: just id
+// when none touched it. Answer events carry no graph version, so every
+// migration applies.
+func CurrentIDs(id string) []string {
+	ids := []string{id}
+	for _, m := range MigrationsSince(0) {
+		if !m.renames() {
+			continue
+		}
+		var next []string
+		for _, cur := range ids {
+			to := []string{cur}
+			if slices.Contains(m.From, cur) {
+				to = m.To
+			}
+			for _, t := range to {
+				if !slices.Contains(next, t) {
+					next = append(next, t)
+				}
+			}
+		}
+		ids = next
+	}
+	return ids
+}
+
+// FormerIDs returns the older skill IDs whose history now counts towards
+// id, the reverse of CurrentIDs, newest first.
+func FormerIDs(id string) []string {
+	migrations := MigrationsSince(0)
+	ids := []string{id}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if !m.renames() || !slices.ContainsFunc(m.To, func(t string) bool { return slices.Contains(ids, t) }) {
+			continue
+		}
+		for _, from := range m.From {
+			if !slices.Contains(ids, from) {
+				ids = append(ids, from)
+			}
+		}
+	}
+	return ids[1:]
+}
+
+// MigrationRules supplies the record-specific steps of MigrateRecords.
+// Functions must not modify the records they are given.
+type MigrationRules[T any] struct {
+	// Rename returns rec carried over to skill id (alias and split).
+	Rename func(rec T, id string) T
+	// Merge combines the present source records of a merge into one for
+	// skill id. complete is false when some source had no record.
+	Merge func(recs []T, id string, complete bool) T
+	// Backfill returns the record for implied skill id given a source
+	// record, or false to add none. Nil skips backfills.
+	Backfill func(src T, id string) (T, bool)
+}
+
+// MigrateRecords applies the active curriculum's migrations newer than
+// version to per-skill records keyed by skill ID, returning a new map;
+// records is not modified. An existing record for a target skill always
+// wins over a migrated one.
+func MigrateRecords[T any](records map[string]T, version int, rules MigrationRules[T]) map[string]T {
+	migrations := MigrationsSince(version)
+	if len(migrations) == 0 || len(records) == 0 {
+		return records
+	}
+	out := make(map[string]T, len(records))
+	for id, rec := range records {
+		out[id] = rec
+	}
+
+	for _, m := range migrations {
+		switch m.Kind {
+		case MigrateAlias, MigrateSplit:
+			src, ok := out[m.From[0]]
+			if !ok {
+				continue
+			}
+			delete(out, m.From[0])
+			for _, id := range m.To {
+				if _, exists := out[id]; !exists {
+					out[id] = rules.Rename(src, id)
+				}
+			}
+		case MigrateMerge:
+			var srcs []T
+			for _, id := range m.From {
+				if rec, ok := out[id]; ok {
+					srcs = append(srcs, rec)
+					delete(out, id)
+				}
+			}
+			if _, exists := out[m.To[0]]; exists || len(srcs) == 0 {
+				continue
+			}
+			out[m.To[0]] = rules.Merge(srcs, m.To[0], len(srcs) == len(m.From))
+		case MigrateBackfill:
+			if rules.Backfill == nil {
+				continue
+			}
+			for _, from := range m.From {
+				src, ok := out[from]
+				if !ok {
+					continue
+				}
+				for _, id := range m.To {
+					if _, exists := out[id]; exists {
+						continue
+					}
+					if rec, ok := rules.Backfill(src, id); ok {
+						out[id] = rec
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// validateMigrations checks a curriculum's migrations against its version
+// and skills. Targets must be live skills, or renamed again by a later
+// migration; alias, split and merge sources must no longer be skills.
+func validateMigrations(version int, migrations []Migration, skills []Skill) []string {
+	var errs []string
+	live := make(map[string]bool, len(skills))
+	for _, s := range skills {
+		live[s.ID] = true
+	}
+
+	for i, m := range migrations {
+		prefix := fmt.Sprintf("migration %d (%s)", i, m.Kind)
+		if m.Version < 1 || m.Version > version {
+			errs = append(errs, fmt.Sprintf("%s: version %d must be between 1 and the curriculum version %d", prefix, m.Version, version))
+		}
+
+		var fromOK, toOK bool
+		switch m.Kind {
+		case MigrateAlias:
+			fromOK, toOK = len(m.From) == 1, len(m.To) == 1
+		case MigrateSplit:
+			fromOK, toOK = len(m.From) == 1, len(m.To) >= 2
+		case MigrateMerge:
+			fromOK, toOK = len(m.From) >= 2, len(m.To) == 1
+		case MigrateBackfill:
+			fromOK, toOK = len(m.From) >= 1, len(m.To) >= 1
+		default:
+			errs = append(errs, fmt.Sprintf("%s: unknown kind (want alias, split, merge or backfill)", prefix))
+			continue
+		}
+		if !fromOK || !toOK {
+			errs = append(errs, fmt.Sprintf("%s: wrong number of skills: from %d, to %d", prefix, len(m.From), len(m.To)))
+			continue
+		}
+
+		for _, id := range m.To {
+			if !live[id] && !renamedLater(migrations, m.Version, id) {
+				errs = append(errs, fmt.Sprintf("%s: target %q is not a skill", prefix, id))
+			}
+		}
+		if m.Kind == MigrateBackfill {
+			continue
+		}
+		for _, id := range m.From {
+			if live[id] {
+				errs = append(errs, fmt.Sprintf("%s: source %q is still a skill", prefix, id))
+			}
+		}
+	}
+	return errs
+}
+
+// renamedLater reports whether id is the source of an alias, split or merge
+// newer than version.
+func renamedLater(migrations []Migration, version int, id string) bool {
+	return slices.ContainsFunc(migrations, func(m Migration) bool {
+		return m.Version > version && m.Kind != MigrateBackfill && slices.Contains(m.From, id)
+	})
+}
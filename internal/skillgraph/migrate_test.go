@@ -0,0 +1,234 @@
+package skillgraph
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// migrationTestCurriculum is version 3 of a tiny graph: "count" was renamed
+// to "count-10" in v2, "add" split into "add-1" and "add-2" in v2, and
+// "sub-a" and "sub-b" merged into "sub" in v3. "count-0" was added beneath
+// "count-10" in v3.
+func migrationTestCurriculum() *Curriculum {
+	return &Curriculum{
+		Name:    "migrate",
+		Strands: []StrandInfo{{ID: "counting", Name: "Counting"}},
+		Skills: []Skill{
+			lintSkill("count-0", 0),
+			lintSkill("count-10", 1, "count-0"),
+			lintSkill("add-1", 1, "count-10"),
+			lintSkill("add-2", 1, "add-1"),
+			lintSkill("sub", 1, "add-2"),
+		},
+		Version: 3,
+		Migrations: []Migration{
+			{Version: 3, Kind: MigrateMerge, From: []string{"sub-a", "sub-b"}, To: []string{"sub"}},
+			{Version: 2, Kind: MigrateAlias, From: []string{"count"}, To: []string{"count-10"}},
+			{Version: 2, Kind: MigrateSplit, From: []string{"add"}, To: []string{"add-1", "add-2"}},
+			{Version: 3, Kind: MigrateBackfill, From: []string{"count-10"}, To: []string{"count-0"}},
+		},
+	}
+}
+
+// testRules migrates plain strings: renames keep the value, merges join
+// them, backfills mark "implied".
+var testRules = MigrationRules[string]{
+	Rename: func(rec, _ string) string { return rec },
+	Merge: func(recs []string, _ string, complete bool) string {
+		s := strings.Join(recs, "+")
+		if !complete {
+			s += "?"
+		}
+		return s
+	},
+	Backfill: func(src, _ string) (string, bool) { return "implied", src == "mastered" },
+}
+
+func TestMigrateRecords(t *testing.T) {
+	useTestCurriculum(t, migrationTestCurriculum())
+
+	in := map[string]string{
+		"count": "mastered",
+		"add":   "learning",
+		"sub-a": "a",
+		"sub-b": "b",
+	}
+	got := MigrateRecords(in, 0, testRules)
+	want := map[string]string{
+		"count-10": "mastered",
+		"count-0":  "implied",
+		"add-1":    "learning",
+		"add-2":    "learning",
+		"sub":      "a+b",
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("migrated = %v, want %v", got, want)
+	}
+	if _, ok := in["count-10"]; ok {
+		t.Error("MigrateRecords modified its input")
+	}
+}
+
+func TestMigrateRecords_VersionAndExistingTargets(t *testing.T) {
+	useTestCurriculum(t, migrationTestCurriculum())
+
+	// Saved at v2: only the v3 merge and backfill apply. An existing record
+	// for the merge target wins; a half-present merge is incomplete.
+	got := MigrateRecords(map[string]string{"count": "stale", "sub-a": "a"}, 2, testRules)
+	want := map[string]string{"count": "stale", "sub": "a?"}
+	if !maps.Equal(got, want) {
+		t.Errorf("from v2 = %v, want %v", got, want)
+	}
+
+	got = MigrateRecords(map[string]string{"sub": "kept", "sub-a": "a", "sub-b": "b"}, 2, testRules)
+	if want := map[string]string{"sub": "kept"}; !maps.Equal(got, want) {
+		t.Errorf("existing target = %v, want %v", got, want)
+	}
+
+	// Current snapshots are untouched.
+	in := map[string]string{"count": "x"}
+	if got := MigrateRecords(in, 3, testRules); !maps.Equal(got, in) {
+		t.Errorf("from v3 = %v, want unchanged", got)
+	}
+}
+
+func TestMigrationsSince(t *testing.T) {
+	useTestCurriculum(t, migrationTestCurriculum())
+
+	var versions []int
+	for _, m := range MigrationsSince(1) {
+		versions = append(versions, m.Version)
+	}
+	if !slices.Equal(versions, []int{2, 2, 3, 3}) {
+		t.Errorf("versions = %v, want oldest first", versions)
+	}
+	if GraphVersion() != 3 {
+		t.Errorf("GraphVersion() = %d, want 3", GraphVersion())
+	}
+}
+
+func TestCurrentAndFormerIDs(t *testing.T) {
+	useTestCurriculum(t, migrationTestCurriculum())
+
+	current := map[string][]string{
+		"count":    {"count-10"},
+		"add":      {"add-1", "add-2"},
+		"sub-b":    {"sub"},
+		"count-10": {"count-10"},
+		"count-0":  {"count-0"},
+	}
+	for id, want := range current {
+		if got := CurrentIDs(id); !slices.Equal(got, want) {
+			t.Errorf("CurrentIDs(%q) = %v, want %v", id, got, want)
+		}
+	}
+
+	former := map[string][]string{
+		"count-10": {"count"},
+		"add-2":    {"add"},
+		"sub":      {"sub-a", "sub-b"},
+		"count-0":  nil,
+	}
+	for id, want := range former {
+		if got := FormerIDs(id); !slices.Equal(got, want) {
+			t.Errorf("FormerIDs(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestValidateMigrations(t *testing.T) {
+	c := migrationTestCurriculum()
+	if err := c.Validate(); err != nil {
+		t.Fatalf("valid migrations rejected: %v", err)
+	}
+
+	c.Migrations = append(c.Migrations,
+		Migration{Version: 4, Kind: MigrateAlias, From: []string{"x"}, To: []string{"add-1"}},     // newer than the curriculum
+		Migration{Version: 2, Kind: MigrateAlias, From: []string{"add-1"}, To: []string{"add-2"}}, // source still a skill
+		Migration{Version: 2, Kind: MigrateSplit, From: []string{"y"}, To: []string{"add-1"}},     // split needs 2+ targets
+		Migration{Version: 2, Kind: MigrateAlias, From: []string{"z"}, To: []string{"missing"}},   // unknown target
+		Migration{Version: 2, Kind: "rename", From: []string{"w"}, To: []string{"add-1"}},         // unknown kind
+	)
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"version 4", `source "add-1"`, "wrong number", `target "missing"`, "unknown kind"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestValidateMigrations_ChainedRename(t *testing.T) {
+	// v2 renamed a → b, v3 renamed b → c: b is no longer a skill, but it is
+	// a valid v2 target because v3 renames it again.
+	c := &Curriculum{
+		Name:    "chain",
+		Strands: []StrandInfo{{ID: "counting"}},
+		Skills:  []Skill{lintSkill("c", 1)},
+		Version: 3,
+		Migrations: []Migration{
+			{Version: 2, Kind: MigrateAlias, From: []string{"a"}, To: []string{"b"}},
+			{Version: 3, Kind: MigrateAlias, From: []string{"b"}, To: []string{"c"}},
+		},
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("chained rename rejected: %v", err)
+	}
+}
+
+func TestParseCurriculum_Migrations(t *testing.T) {
+	src := `
+name: renamed
+version: 2
+migrations:
+  - version: 2
+    kind: alias
+    from: [count]
+    to: [count-10]
+strands:
+  - id: counting
+skills:
+  - id: count-10
+    name: Count to 10
+    strand: counting
+    grade: 1
+`
+	c, err := ParseCurriculum([]byte(src), "yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Migration{Version: 2, Kind: MigrateAlias, From: []string{"count"}, To: []string{"count-10"}}
+	if c.Version != 2 || len(c.Migrations) != 1 || c.Migrations[0].String() != want.String() {
+		t.Errorf("version %d, migrations %v", c.Version, c.Migrations)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	// Files without a version are version 1.
+	c, err = ParseCurriculum([]byte(testCurriculumYAML), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Version != 1 {
+		t.Errorf("default version = %d, want 1", c.Version)
+	}
+}
+
+func TestSeedMigrations(t *testing.T) {
+	// Learners who mastered pv-hundreds before the K–1 expansion keep
+	// their place.
+	got := MigrateRecords(map[string]string{"pv-hundreds": "mastered"}, 0, testRules)
+	for _, id := range []string{"count-to-20", "pv-tens-ones", "add-sub-within-20"} {
+		if got[id] != "implied" {
+			t.Errorf("%s = %q, want implied", id, got[id])
+		}
+	}
+	if got := MigrateRecords(map[string]string{"pv-hundreds": "learning"}, 0, testRules); len(got) != 1 {
+		t.Errorf("learning pv-hundreds backfilled %v", got)
+	}
+}
@@ -1,22 +1,67 @@
 package skillgraph
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
-// skills contains all 54 skill definitions for the MVP.
+// skills contains all 85 skill definitions of the built-in curriculum,
+// grades K–6 (kindergarten is grade 0). Most use DefaultTiers; fact-fluency
+// skills use FactFluencyTiers (with a Challenge tier) and multi-step word
+// problems WordProblemTiers.
 var skills = []Skill{
 
-	// ── Number & Place Value (8 nodes) ────────────────────────
+	// ── Number & Place Value (11 nodes) ───────────────────────
 
+	{
+		ID:            "count-to-20",
+		Name:          "Count to 20",
+		Description:   "Count objects and say how many, up to 20",
+		Strand:        StrandNumberPlace,
+		GradeLevel:    0,
+		Standards:     map[Standard]string{StandardCommonCore: "K.CC.B.5"},
+		EstimatedMins: 6,
+		Keywords:      []string{"count", "how many", "one more"},
+		Prerequisites: nil,
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "count-to-120",
+		Name:          "Count to 120",
+		Description:   "Count, read, and write numbers up to 120 starting from any number",
+		Strand:        StrandNumberPlace,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.NBT.A.1"},
+		EstimatedMins: 8,
+		Keywords:      []string{"count on", "number line", "read and write numbers"},
+		Prerequisites: []string{"count-to-20"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "pv-tens-ones",
+		Name:          "Tens & Ones",
+		Description:   "Understand two-digit numbers as tens and ones",
+		Strand:        StrandNumberPlace,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.NBT.B.2"},
+		EstimatedMins: 8,
+		Keywords:      []string{"tens", "ones", "bundles of ten"},
+		Prerequisites: []string{"count-to-120", "add-sub-within-20"},
+		Tiers:         DefaultTiers(),
+	},
 	{
 		ID:            "pv-hundreds",
 		Name:          "Place Value to 1,000",
 		Description:   "Understand ones, tens, and hundreds places up to 1,000",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.A.1"},
 		EstimatedMins: 10,
 		Keywords:      []string{"ones", "tens", "hundreds", "place value"},
-		Prerequisites: nil,
+		// Grades K–1 join the original grade 3–5 graph through this one
+		// edge. The v2 migration backfills it for learners who had started
+		// pv-hundreds, so they keep every unlock they had.
+		Prerequisites: []string{"pv-tens-ones"},
 		Tiers:         DefaultTiers(),
 	},
 	{
@@ -25,7 +70,7 @@ var skills = []Skill{
 		Description:   "Compare and order numbers up to 1,000 using place value",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.A.4",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.A.4"},
 		EstimatedMins: 8,
 		Keywords:      []string{"greater than", "less than", "order"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -37,7 +82,7 @@ var skills = []Skill{
 		Description:   "Round whole numbers to the nearest 10 or 100",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NBT.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NBT.A.1"},
 		EstimatedMins: 8,
 		Keywords:      []string{"round", "nearest", "estimate"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -49,7 +94,7 @@ var skills = []Skill{
 		Description:   "Understand place value through the ten-thousands place",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.A.1"},
 		EstimatedMins: 10,
 		Keywords:      []string{"thousands", "ten-thousands", "expanded form"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -61,7 +106,7 @@ var skills = []Skill{
 		Description:   "Compare and order numbers up to 10,000 using place value",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.A.2"},
 		EstimatedMins: 8,
 		Keywords:      []string{"compare", "order", "place value"},
 		Prerequisites: []string{"pv-ten-thousands"},
@@ -73,7 +118,7 @@ var skills = []Skill{
 		Description:   "Round whole numbers to the nearest 1,000",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.A.3",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.A.3"},
 		EstimatedMins: 8,
 		Keywords:      []string{"round", "estimate", "nearest thousand"},
 		Prerequisites: []string{"pv-ten-thousands", "round-nearest-10-100"},
@@ -85,7 +130,7 @@ var skills = []Skill{
 		Description:   "Understand place value through the millions place with expanded form",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NBT.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NBT.A.1"},
 		EstimatedMins: 12,
 		Keywords:      []string{"hundred-thousands", "millions", "expanded form"},
 		Prerequisites: []string{"pv-ten-thousands"},
@@ -97,22 +142,46 @@ var skills = []Skill{
 		Description:   "Compare and order numbers up to 1,000,000",
 		Strand:        StrandNumberPlace,
 		GradeLevel:    5,
-		CommonCoreID:  "4.NBT.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.A.2"},
 		EstimatedMins: 8,
 		Keywords:      []string{"compare", "order", "large numbers"},
 		Prerequisites: []string{"pv-millions"},
 		Tiers:         DefaultTiers(),
 	},
 
-	// ── Addition & Subtraction (12 nodes) ─────────────────────
+	// ── Addition & Subtraction (14 nodes) ─────────────────────
 
+	{
+		ID:            "add-sub-within-10",
+		Name:          "Add & Subtract Within 10",
+		Description:   "Add and subtract within 10 using objects and drawings",
+		Strand:        StrandAddSub,
+		GradeLevel:    0,
+		Standards:     map[Standard]string{StandardCommonCore: "K.OA.A.5"},
+		EstimatedMins: 6,
+		Keywords:      []string{"add", "take away", "within 10"},
+		Prerequisites: []string{"count-to-20"},
+		Tiers:         FactFluencyTiers(),
+	},
+	{
+		ID:            "add-sub-within-20",
+		Name:          "Add & Subtract Within 20",
+		Description:   "Fluently add and subtract within 20 using strategies like making ten",
+		Strand:        StrandAddSub,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.OA.C.6"},
+		EstimatedMins: 8,
+		Keywords:      []string{"make ten", "doubles", "within 20"},
+		Prerequisites: []string{"add-sub-within-10"},
+		Tiers:         FactFluencyTiers(),
+	},
 	{
 		ID:            "add-2digit",
 		Name:          "Add 2-Digit Numbers",
 		Description:   "Add two 2-digit numbers with and without regrouping",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.B.5"},
 		EstimatedMins: 10,
 		Keywords:      []string{"addition", "carry", "regrouping"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -124,7 +193,7 @@ var skills = []Skill{
 		Description:   "Subtract 2-digit numbers with and without borrowing",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.B.5"},
 		EstimatedMins: 10,
 		Keywords:      []string{"subtraction", "borrow", "regrouping"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -136,7 +205,7 @@ var skills = []Skill{
 		Description:   "Add up to four 2-digit numbers using place value strategies",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.B.6",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.B.6"},
 		EstimatedMins: 10,
 		Keywords:      []string{"addition", "multiple addends", "two-digit", "regroup"},
 		Prerequisites: []string{"add-2digit"},
@@ -148,7 +217,7 @@ var skills = []Skill{
 		Description:   "Add two 3-digit numbers with and without regrouping",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NBT.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NBT.A.2"},
 		EstimatedMins: 12,
 		Keywords:      []string{"addition", "carry", "three-digit"},
 		Prerequisites: []string{"add-2digit"},
@@ -160,7 +229,7 @@ var skills = []Skill{
 		Description:   "Subtract 3-digit numbers with and without borrowing",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NBT.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NBT.A.2"},
 		EstimatedMins: 12,
 		Keywords:      []string{"subtraction", "borrow", "three-digit"},
 		Prerequisites: []string{"sub-2digit"},
@@ -172,7 +241,7 @@ var skills = []Skill{
 		Description:   "Estimate sums and differences by rounding before computing",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.D.8",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.D.8"},
 		EstimatedMins: 8,
 		Keywords:      []string{"estimate", "round", "approximate"},
 		Prerequisites: []string{"round-nearest-10-100", "add-2digit", "sub-2digit"},
@@ -184,7 +253,7 @@ var skills = []Skill{
 		Description:   "Add two 4-digit numbers with regrouping across multiple places",
 		Strand:        StrandAddSub,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.4"},
 		EstimatedMins: 12,
 		Keywords:      []string{"addition", "four-digit", "carry"},
 		Prerequisites: []string{"add-3digit", "pv-ten-thousands"},
@@ -196,7 +265,7 @@ var skills = []Skill{
 		Description:   "Subtract 4-digit numbers with borrowing across multiple places",
 		Strand:        StrandAddSub,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.4"},
 		EstimatedMins: 12,
 		Keywords:      []string{"subtraction", "four-digit", "borrow"},
 		Prerequisites: []string{"sub-3digit", "pv-ten-thousands"},
@@ -208,11 +277,11 @@ var skills = []Skill{
 		Description:   "Solve multi-step word problems involving 3-digit addition and subtraction",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.D.8",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.D.8"},
 		EstimatedMins: 15,
 		Keywords:      []string{"word problem", "context", "multi-step"},
 		Prerequisites: []string{"add-3digit", "sub-3digit"},
-		Tiers:         DefaultTiers(),
+		Tiers:         WordProblemTiers(),
 	},
 	{
 		ID:            "add-5digit",
@@ -220,7 +289,7 @@ var skills = []Skill{
 		Description:   "Add numbers with five or more digits fluently",
 		Strand:        StrandAddSub,
 		GradeLevel:    5,
-		CommonCoreID:  "4.NBT.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.4"},
 		EstimatedMins: 12,
 		Keywords:      []string{"large number addition", "carry"},
 		Prerequisites: []string{"add-4digit", "pv-millions"},
@@ -232,7 +301,7 @@ var skills = []Skill{
 		Description:   "Subtract numbers with five or more digits fluently",
 		Strand:        StrandAddSub,
 		GradeLevel:    5,
-		CommonCoreID:  "4.NBT.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.4"},
 		EstimatedMins: 12,
 		Keywords:      []string{"large number subtraction", "borrow"},
 		Prerequisites: []string{"sub-4digit", "pv-millions"},
@@ -245,7 +314,7 @@ var skills = []Skill{
 		Description:   "Explain why addition and subtraction strategies work, using place value understanding",
 		Strand:        StrandAddSub,
 		GradeLevel:    3,
-		CommonCoreID:  "2.NBT.B.9",
+		Standards:     map[Standard]string{StandardCommonCore: "2.NBT.B.9"},
 		EstimatedMins: 10,
 		Keywords:      []string{"explain", "reasoning", "regrouping", "place value", "strategy"},
 		Prerequisites: []string{"add-2digit", "sub-2digit"},
@@ -260,7 +329,7 @@ var skills = []Skill{
 		Description:   "Understand multiplication as groups of and repeated addition",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.A.1"},
 		EstimatedMins: 10,
 		Keywords:      []string{"groups of", "repeated addition", "array"},
 		Prerequisites: []string{"add-2digit"},
@@ -272,11 +341,11 @@ var skills = []Skill{
 		Description:   "Recall multiplication facts for 2, 5, and 10",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.C.7",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.C.7"},
 		EstimatedMins: 12,
 		Keywords:      []string{"times tables", "skip counting", "facts"},
 		Prerequisites: []string{"mult-concept"},
-		Tiers:         DefaultTiers(),
+		Tiers:         FactFluencyTiers(),
 	},
 	{
 		ID:            "mult-facts-3-4-6",
@@ -284,11 +353,11 @@ var skills = []Skill{
 		Description:   "Recall multiplication facts for 3, 4, and 6",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.C.7",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.C.7"},
 		EstimatedMins: 12,
 		Keywords:      []string{"times tables", "facts", "memorization"},
 		Prerequisites: []string{"mult-facts-2-5-10"},
-		Tiers:         DefaultTiers(),
+		Tiers:         FactFluencyTiers(),
 	},
 	{
 		ID:            "mult-facts-7-8-9",
@@ -296,11 +365,11 @@ var skills = []Skill{
 		Description:   "Recall multiplication facts for 7, 8, and 9",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.C.7",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.C.7"},
 		EstimatedMins: 15,
 		Keywords:      []string{"times tables", "facts", "memorization"},
 		Prerequisites: []string{"mult-facts-3-4-6"},
-		Tiers:         DefaultTiers(),
+		Tiers:         FactFluencyTiers(),
 	},
 	{
 		ID:            "mult-properties",
@@ -308,7 +377,7 @@ var skills = []Skill{
 		Description:   "Apply commutative, associative, and distributive properties of multiplication",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.B.5"},
 		EstimatedMins: 10,
 		Keywords:      []string{"commutative", "associative", "distributive"},
 		Prerequisites: []string{"mult-facts-2-5-10"},
@@ -320,7 +389,7 @@ var skills = []Skill{
 		Description:   "Understand division as sharing equally and grouping",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.A.2"},
 		EstimatedMins: 10,
 		Keywords:      []string{"sharing", "grouping", "inverse of multiplication"},
 		Prerequisites: []string{"mult-concept"},
@@ -332,11 +401,11 @@ var skills = []Skill{
 		Description:   "Recall division facts using the inverse of multiplication",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.C.7",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.C.7"},
 		EstimatedMins: 15,
 		Keywords:      []string{"division facts", "inverse", "fluency"},
 		Prerequisites: []string{"div-concept", "mult-facts-7-8-9"},
-		Tiers:         DefaultTiers(),
+		Tiers:         FactFluencyTiers(),
 	},
 	{
 		ID:            "mult-div-word",
@@ -344,11 +413,11 @@ var skills = []Skill{
 		Description:   "Solve word problems requiring multiplication or division",
 		Strand:        StrandMultDiv,
 		GradeLevel:    3,
-		CommonCoreID:  "3.OA.A.3",
+		Standards:     map[Standard]string{StandardCommonCore: "3.OA.A.3"},
 		EstimatedMins: 15,
 		Keywords:      []string{"word problem", "context", "operation choice"},
 		Prerequisites: []string{"mult-facts-7-8-9", "div-facts"},
-		Tiers:         DefaultTiers(),
+		Tiers:         WordProblemTiers(),
 	},
 	{
 		ID:            "mult-2d-by-1d",
@@ -356,7 +425,7 @@ var skills = []Skill{
 		Description:   "Multiply a 2-digit number by a 1-digit number using partial products or area model",
 		Strand:        StrandMultDiv,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.5"},
 		EstimatedMins: 12,
 		Keywords:      []string{"partial products", "area model"},
 		Prerequisites: []string{"mult-facts-7-8-9", "pv-ten-thousands"},
@@ -368,7 +437,7 @@ var skills = []Skill{
 		Description:   "Multiply two 2-digit numbers using partial products or standard algorithm",
 		Strand:        StrandMultDiv,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.5"},
 		EstimatedMins: 15,
 		Keywords:      []string{"partial products", "standard algorithm"},
 		Prerequisites: []string{"mult-2d-by-1d"},
@@ -380,7 +449,7 @@ var skills = []Skill{
 		Description:   "Divide a 2-digit number by a 1-digit number with or without remainders",
 		Strand:        StrandMultDiv,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.6",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.6"},
 		EstimatedMins: 12,
 		Keywords:      []string{"long division", "remainder"},
 		Prerequisites: []string{"div-facts", "pv-ten-thousands"},
@@ -392,7 +461,7 @@ var skills = []Skill{
 		Description:   "Divide a 3-digit number by a 1-digit number using long division",
 		Strand:        StrandMultDiv,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NBT.B.6",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NBT.B.6"},
 		EstimatedMins: 15,
 		Keywords:      []string{"long division", "multi-step"},
 		Prerequisites: []string{"div-2d-by-1d"},
@@ -404,7 +473,7 @@ var skills = []Skill{
 		Description:   "Multiply a 3-digit number by a 2-digit number using the standard algorithm",
 		Strand:        StrandMultDiv,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NBT.B.5",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NBT.B.5"},
 		EstimatedMins: 15,
 		Keywords:      []string{"standard algorithm", "large multiplication"},
 		Prerequisites: []string{"mult-2d-by-2d"},
@@ -416,7 +485,7 @@ var skills = []Skill{
 		Description:   "Divide a 4-digit number by a 2-digit divisor using long division",
 		Strand:        StrandMultDiv,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NBT.B.6",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NBT.B.6"},
 		EstimatedMins: 15,
 		Keywords:      []string{"long division", "two-digit divisor"},
 		Prerequisites: []string{"div-3d-by-1d", "mult-2d-by-2d"},
@@ -428,7 +497,7 @@ var skills = []Skill{
 		Description:   "Find all factor pairs of a number up to 100, list multiples, and tell prime from composite numbers",
 		Strand:        StrandMultDiv,
 		GradeLevel:    4,
-		CommonCoreID:  "4.OA.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "4.OA.B.4"},
 		EstimatedMins: 15,
 		Keywords:      []string{"factor pairs", "multiples", "prime", "composite", "divisibility"},
 		Prerequisites: []string{"mult-facts-7-8-9", "div-facts"},
@@ -440,7 +509,7 @@ var skills = []Skill{
 		Description:   "Find the highest common factor (HCF, also called GCF) and lowest common multiple (LCM) of two numbers up to 100",
 		Strand:        StrandMultDiv,
 		GradeLevel:    5,
-		CommonCoreID:  "6.NS.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "6.NS.B.4"},
 		EstimatedMins: 15,
 		Keywords:      []string{"HCF", "GCF", "greatest common factor", "LCM", "least common multiple", "common denominators"},
 		Prerequisites: []string{"factors-multiples-primes"},
@@ -455,7 +524,7 @@ var skills = []Skill{
 		Description:   "Understand fractions as parts of a whole using numerator and denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NF.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NF.A.1"},
 		EstimatedMins: 12,
 		Keywords:      []string{"numerator", "denominator", "part of whole"},
 		Prerequisites: []string{"div-concept"},
@@ -467,7 +536,7 @@ var skills = []Skill{
 		Description:   "Locate and represent fractions on a number line",
 		Strand:        StrandFractions,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NF.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NF.A.2"},
 		EstimatedMins: 10,
 		Keywords:      []string{"number line", "position", "unit fraction"},
 		Prerequisites: []string{"frac-concept"},
@@ -479,7 +548,7 @@ var skills = []Skill{
 		Description:   "Identify and generate simple equivalent fractions",
 		Strand:        StrandFractions,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NF.A.3",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NF.A.3"},
 		EstimatedMins: 12,
 		Keywords:      []string{"same value", "simplify", "multiply numerator denominator"},
 		Prerequisites: []string{"frac-concept"},
@@ -491,7 +560,7 @@ var skills = []Skill{
 		Description:   "Compare fractions with same or different denominators using benchmarks",
 		Strand:        StrandFractions,
 		GradeLevel:    3,
-		CommonCoreID:  "3.NF.A.3",
+		Standards:     map[Standard]string{StandardCommonCore: "3.NF.A.3"},
 		EstimatedMins: 10,
 		Keywords:      []string{"greater than", "less than", "same denominator", "benchmark"},
 		Prerequisites: []string{"frac-equivalent", "frac-on-number-line"},
@@ -503,7 +572,7 @@ var skills = []Skill{
 		Description:   "Create equivalent fractions by multiplying or dividing numerator and denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NF.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NF.A.1"},
 		EstimatedMins: 12,
 		Keywords:      []string{"multiply", "divide", "visual model"},
 		Prerequisites: []string{"frac-equivalent", "mult-facts-7-8-9"},
@@ -515,7 +584,7 @@ var skills = []Skill{
 		Description:   "Add fractions that share the same denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NF.B.3",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NF.B.3"},
 		EstimatedMins: 10,
 		Keywords:      []string{"add numerators", "same denominator"},
 		Prerequisites: []string{"frac-compare"},
@@ -527,7 +596,7 @@ var skills = []Skill{
 		Description:   "Subtract fractions that share the same denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NF.B.3",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NF.B.3"},
 		EstimatedMins: 10,
 		Keywords:      []string{"subtract numerators", "same denominator"},
 		Prerequisites: []string{"frac-compare"},
@@ -539,7 +608,7 @@ var skills = []Skill{
 		Description:   "Convert between mixed numbers and improper fractions",
 		Strand:        StrandFractions,
 		GradeLevel:    4,
-		CommonCoreID:  "4.NF.B.3",
+		Standards:     map[Standard]string{StandardCommonCore: "4.NF.B.3"},
 		EstimatedMins: 12,
 		Keywords:      []string{"mixed number", "improper", "convert"},
 		Prerequisites: []string{"frac-add-same-denom"},
@@ -551,7 +620,7 @@ var skills = []Skill{
 		Description:   "Add fractions with unlike denominators by finding a common denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NF.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NF.A.1"},
 		EstimatedMins: 15,
 		Keywords:      []string{"common denominator", "LCD", "unlike fractions"},
 		Prerequisites: []string{"frac-add-same-denom", "frac-equiv-generate"},
@@ -563,7 +632,7 @@ var skills = []Skill{
 		Description:   "Subtract fractions with unlike denominators by finding a common denominator",
 		Strand:        StrandFractions,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NF.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NF.A.1"},
 		EstimatedMins: 15,
 		Keywords:      []string{"common denominator", "LCD", "unlike fractions"},
 		Prerequisites: []string{"frac-sub-same-denom", "frac-equiv-generate"},
@@ -575,7 +644,7 @@ var skills = []Skill{
 		Description:   "Multiply fractions and mixed numbers",
 		Strand:        StrandFractions,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NF.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NF.B.4"},
 		EstimatedMins: 12,
 		Keywords:      []string{"multiply numerators", "multiply denominators"},
 		Prerequisites: []string{"frac-mixed-numbers", "mult-facts-7-8-9"},
@@ -587,7 +656,7 @@ var skills = []Skill{
 		Description:   "Divide fractions using the reciprocal (invert and multiply)",
 		Strand:        StrandFractions,
 		GradeLevel:    5,
-		CommonCoreID:  "5.NF.B.7",
+		Standards:     map[Standard]string{StandardCommonCore: "5.NF.B.7"},
 		EstimatedMins: 12,
 		Keywords:      []string{"reciprocal", "invert and multiply"},
 		Prerequisites: []string{"frac-mult", "div-facts"},
@@ -602,7 +671,7 @@ var skills = []Skill{
 		Description:   "Measure and estimate lengths in metric and customary units",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.B.4",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.B.4"},
 		EstimatedMins: 10,
 		Keywords:      []string{"ruler", "centimeter", "meter", "inch", "foot"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -614,7 +683,7 @@ var skills = []Skill{
 		Description:   "Read clocks and calculate elapsed time in hours and minutes",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.A.1"},
 		EstimatedMins: 12,
 		Keywords:      []string{"clock", "hour", "minute", "elapsed", "duration"},
 		Prerequisites: []string{"add-2digit", "sub-2digit"},
@@ -626,7 +695,7 @@ var skills = []Skill{
 		Description:   "Measure and estimate mass and liquid volume in metric units",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.A.2",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.A.2"},
 		EstimatedMins: 10,
 		Keywords:      []string{"gram", "kilogram", "liter", "milliliter", "measure"},
 		Prerequisites: []string{"pv-hundreds"},
@@ -638,7 +707,7 @@ var skills = []Skill{
 		Description:   "Calculate the perimeter of polygons by adding side lengths",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.D.8",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.D.8"},
 		EstimatedMins: 10,
 		Keywords:      []string{"perimeter", "side lengths", "add sides"},
 		Prerequisites: []string{"add-3digit"},
@@ -650,7 +719,7 @@ var skills = []Skill{
 		Description:   "Understand area by counting unit squares",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.C.5",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.C.5"},
 		EstimatedMins: 10,
 		Keywords:      []string{"area", "square unit", "count", "cover"},
 		Prerequisites: []string{"mult-concept"},
@@ -662,7 +731,7 @@ var skills = []Skill{
 		Description:   "Calculate the area of rectangles using length times width",
 		Strand:        StrandMeasurement,
 		GradeLevel:    3,
-		CommonCoreID:  "3.MD.C.7",
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.C.7"},
 		EstimatedMins: 10,
 		Keywords:      []string{"length times width", "rectangle", "formula"},
 		Prerequisites: []string{"meas-area-concept", "mult-facts-2-5-10"},
@@ -674,7 +743,7 @@ var skills = []Skill{
 		Description:   "Convert between metric and customary measurement units using tables",
 		Strand:        StrandMeasurement,
 		GradeLevel:    4,
-		CommonCoreID:  "4.MD.A.1",
+		Standards:     map[Standard]string{StandardCommonCore: "4.MD.A.1"},
 		EstimatedMins: 12,
 		Keywords:      []string{"convert", "metric", "customary", "table"},
 		Prerequisites: []string{"meas-length", "meas-mass-volume", "mult-2d-by-1d"},
@@ -686,16 +755,348 @@ var skills = []Skill{
 		Description:   "Solve real-world word problems involving area and perimeter",
 		Strand:        StrandMeasurement,
 		GradeLevel:    4,
-		CommonCoreID:  "4.MD.A.3",
+		Standards:     map[Standard]string{StandardCommonCore: "4.MD.A.3"},
 		EstimatedMins: 15,
 		Keywords:      []string{"word problem", "real-world", "area", "perimeter"},
 		Prerequisites: []string{"meas-area-formula", "meas-perimeter", "mult-2d-by-1d"},
+		Tiers:         WordProblemTiers(),
+	},
+
+	// ── Geometry (10 nodes) ───────────────────────────────────
+
+	{
+		ID:            "geo-shapes-2d-3d",
+		Name:          "Name 2D & 3D Shapes",
+		Description:   "Identify and name circles, triangles, squares, cubes, cones, and spheres",
+		Strand:        StrandGeometry,
+		GradeLevel:    0,
+		Standards:     map[Standard]string{StandardCommonCore: "K.G.A.2"},
+		EstimatedMins: 6,
+		Keywords:      []string{"circle", "triangle", "cube", "sphere"},
+		Prerequisites: []string{"count-to-20"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-shape-attributes",
+		Name:          "Shape Attributes",
+		Description:   "Tell shapes apart by sides and corners rather than color or size",
+		Strand:        StrandGeometry,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.G.A.1"},
+		EstimatedMins: 8,
+		Keywords:      []string{"sides", "corners", "vertices"},
+		Prerequisites: []string{"geo-shapes-2d-3d"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-lines-angles",
+		Name:          "Lines & Angles",
+		Description:   "Identify points, lines, rays, and right, acute, and obtuse angles",
+		Strand:        StrandGeometry,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.G.A.1"},
+		EstimatedMins: 10,
+		Keywords:      []string{"ray", "right angle", "acute", "obtuse", "parallel"},
+		Prerequisites: []string{"geo-shape-attributes", "pv-hundreds"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-measure-angles",
+		Name:          "Measure Angles",
+		Description:   "Measure and add angles in whole-number degrees",
+		Strand:        StrandGeometry,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.MD.C.6"},
+		EstimatedMins: 10,
+		Keywords:      []string{"degrees", "protractor", "angle"},
+		Prerequisites: []string{"geo-lines-angles", "add-3digit"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-classify-shapes",
+		Name:          "Classify 2D Shapes",
+		Description:   "Classify shapes by parallel and perpendicular sides and angle types",
+		Strand:        StrandGeometry,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.G.A.2"},
+		EstimatedMins: 10,
+		Keywords:      []string{"quadrilateral", "perpendicular", "right triangle"},
+		Prerequisites: []string{"geo-lines-angles"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-symmetry",
+		Name:          "Lines of Symmetry",
+		Description:   "Find lines of symmetry in 2D figures",
+		Strand:        StrandGeometry,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.G.A.3"},
+		EstimatedMins: 8,
+		Keywords:      []string{"symmetry", "mirror", "fold"},
+		Prerequisites: []string{"geo-classify-shapes"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-coordinate-plane",
+		Name:          "Coordinate Plane",
+		Description:   "Plot and read points in the first quadrant of the coordinate plane",
+		Strand:        StrandGeometry,
+		GradeLevel:    5,
+		Standards:     map[Standard]string{StandardCommonCore: "5.G.A.1"},
+		EstimatedMins: 10,
+		Keywords:      []string{"coordinates", "x-axis", "y-axis", "ordered pair"},
+		Prerequisites: []string{"geo-classify-shapes", "compare-1000"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-volume",
+		Name:          "Volume of Rectangular Prisms",
+		Description:   "Find volume by counting unit cubes and using length × width × height",
+		Strand:        StrandGeometry,
+		GradeLevel:    5,
+		Standards:     map[Standard]string{StandardCommonCore: "5.MD.C.5"},
+		EstimatedMins: 12,
+		Keywords:      []string{"volume", "cubic units", "prism"},
+		Prerequisites: []string{"meas-area-formula", "mult-2d-by-1d"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-area-triangles",
+		Name:          "Area of Triangles & Polygons",
+		Description:   "Find the area of triangles and other polygons by composing and decomposing",
+		Strand:        StrandGeometry,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.G.A.1"},
+		EstimatedMins: 12,
+		Keywords:      []string{"triangle area", "base", "height", "polygon"},
+		Prerequisites: []string{"meas-area-formula", "frac-mult"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "geo-surface-area",
+		Name:          "Surface Area with Nets",
+		Description:   "Use nets to find the surface area of prisms and pyramids",
+		Strand:        StrandGeometry,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.G.A.4"},
+		EstimatedMins: 12,
+		Keywords:      []string{"net", "surface area", "faces"},
+		Prerequisites: []string{"geo-area-triangles", "geo-volume"},
+		Tiers:         DefaultTiers(),
+	},
+
+	// ── Data & Statistics (6 nodes) ───────────────────────────
+
+	{
+		ID:            "data-sort-count",
+		Name:          "Sort & Count",
+		Description:   "Sort objects into groups and count how many are in each",
+		Strand:        StrandData,
+		GradeLevel:    0,
+		Standards:     map[Standard]string{StandardCommonCore: "K.MD.B.3"},
+		EstimatedMins: 6,
+		Keywords:      []string{"sort", "group", "count"},
+		Prerequisites: []string{"count-to-20"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "data-tally-charts",
+		Name:          "Tally Charts",
+		Description:   "Organize and read data in tally charts with up to three categories",
+		Strand:        StrandData,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.MD.C.4"},
+		EstimatedMins: 8,
+		Keywords:      []string{"tally", "how many more", "category"},
+		Prerequisites: []string{"data-sort-count", "count-to-120"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "data-bar-graphs",
+		Name:          "Picture & Bar Graphs",
+		Description:   "Read and draw scaled picture and bar graphs",
+		Strand:        StrandData,
+		GradeLevel:    3,
+		Standards:     map[Standard]string{StandardCommonCore: "3.MD.B.3"},
+		EstimatedMins: 10,
+		Keywords:      []string{"bar graph", "pictograph", "scale"},
+		Prerequisites: []string{"data-tally-charts", "mult-facts-2-5-10"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "data-line-plots",
+		Name:          "Line Plots with Fractions",
+		Description:   "Make and read line plots with fractional measurements",
+		Strand:        StrandData,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.MD.B.4"},
+		EstimatedMins: 10,
+		Keywords:      []string{"line plot", "data", "fractions"},
+		Prerequisites: []string{"data-bar-graphs", "frac-add-same-denom"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "data-dot-plots-histograms",
+		Name:          "Dot Plots & Histograms",
+		Description:   "Display numerical data in dot plots, histograms, and box plots",
+		Strand:        StrandData,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.SP.B.4"},
+		EstimatedMins: 10,
+		Keywords:      []string{"dot plot", "histogram", "box plot"},
+		Prerequisites: []string{"data-line-plots"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "data-mean-median-mode",
+		Name:          "Mean, Median & Mode",
+		Description:   "Summarize data sets with mean, median, mode, and range",
+		Strand:        StrandData,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.SP.B.5c"},
+		EstimatedMins: 12,
+		Keywords:      []string{"mean", "median", "mode", "range", "average"},
+		Prerequisites: []string{"data-line-plots", "div-3d-by-1d"},
+		Tiers:         DefaultTiers(),
+	},
+
+	// ── Ratios & Early Algebra (8 nodes) ──────────────────────
+
+	{
+		ID:            "alg-missing-number",
+		Name:          "Missing Numbers in Equations",
+		Description:   "Find the unknown number in addition and subtraction equations",
+		Strand:        StrandRatios,
+		GradeLevel:    1,
+		Standards:     map[Standard]string{StandardCommonCore: "1.OA.D.8"},
+		EstimatedMins: 8,
+		Keywords:      []string{"missing number", "unknown", "equation"},
+		Prerequisites: []string{"add-sub-within-20"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "alg-number-patterns",
+		Name:          "Number Patterns",
+		Description:   "Generate and extend number patterns that follow a rule",
+		Strand:        StrandRatios,
+		GradeLevel:    4,
+		Standards:     map[Standard]string{StandardCommonCore: "4.OA.C.5"},
+		EstimatedMins: 8,
+		Keywords:      []string{"pattern", "rule", "sequence"},
+		Prerequisites: []string{"alg-missing-number", "mult-facts-2-5-10"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "alg-numerical-expressions",
+		Name:          "Numerical Expressions",
+		Description:   "Write and evaluate expressions with parentheses",
+		Strand:        StrandRatios,
+		GradeLevel:    5,
+		Standards:     map[Standard]string{StandardCommonCore: "5.OA.A.1"},
+		EstimatedMins: 10,
+		Keywords:      []string{"parentheses", "order of operations", "expression"},
+		Prerequisites: []string{"alg-number-patterns", "mult-div-word"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "ratio-concept",
+		Name:          "Ratios",
+		Description:   "Understand ratios and use ratio language to compare quantities",
+		Strand:        StrandRatios,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.RP.A.1"},
+		EstimatedMins: 10,
+		Keywords:      []string{"ratio", "for every", "to"},
+		Prerequisites: []string{"frac-equiv-generate"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "ratio-unit-rate",
+		Name:          "Unit Rates",
+		Description:   "Find unit rates and use them to solve problems",
+		Strand:        StrandRatios,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.RP.A.2"},
+		EstimatedMins: 10,
+		Keywords:      []string{"unit rate", "per", "price per"},
+		Prerequisites: []string{"ratio-concept", "div-2d-by-1d"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "ratio-percent",
+		Name:          "Percents",
+		Description:   "Find a percent of a quantity as a rate per 100",
+		Strand:        StrandRatios,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.RP.A.3c"},
+		EstimatedMins: 10,
+		Keywords:      []string{"percent", "per hundred", "%"},
+		Prerequisites: []string{"ratio-unit-rate"},
 		Tiers:         DefaultTiers(),
 	},
+	{
+		ID:            "alg-expressions-variables",
+		Name:          "Expressions with Variables",
+		Description:   "Write, read, and evaluate expressions in which letters stand for numbers",
+		Strand:        StrandRatios,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.EE.A.2"},
+		EstimatedMins: 10,
+		Keywords:      []string{"variable", "expression", "evaluate"},
+		Prerequisites: []string{"alg-numerical-expressions"},
+		Tiers:         DefaultTiers(),
+	},
+	{
+		ID:            "alg-one-step-equations",
+		Name:          "One-Step Equations",
+		Description:   "Solve one-step equations like x + 7 = 15 and 4x = 28",
+		Strand:        StrandRatios,
+		GradeLevel:    6,
+		Standards:     map[Standard]string{StandardCommonCore: "6.EE.B.7"},
+		EstimatedMins: 10,
+		Keywords:      []string{"equation", "solve", "variable"},
+		Prerequisites: []string{"alg-expressions-variables"},
+		Tiers:         DefaultTiers(),
+	},
+}
+
+// SeedCurriculumName names the embedded curriculum.
+const SeedCurriculumName = "mathiz-common-core"
+
+// SeedGraphVersion is the embedded curriculum's version. Bump it, and add
+// to seedMigrations, whenever a skill is renamed, split or merged.
+const SeedGraphVersion = 2
+
+// seedMigrations carry learner progress across seed changes.
+var seedMigrations = []Migration{
+	// v2 added kindergarten and grade 1 skills beneath pv-hundreds, which
+	// used to be the root. Learners who had already started it, mastered or
+	// not, keep their place instead of being sent back to counting.
+	{
+		Version: 2,
+		Kind:    MigrateBackfill,
+		From:    []string{"pv-hundreds"},
+		To:      []string{"count-to-20", "count-to-120", "add-sub-within-10", "add-sub-within-20", "pv-tens-ones"},
+	},
+}
+
+// SeedCurriculum returns the embedded curriculum compiled into the binary.
+// It is the fallback whenever no external curriculum file is configured.
+func SeedCurriculum() *Curriculum {
+	c := &Curriculum{
+		Name:       SeedCurriculumName,
+		Skills:     slices.Clone(skills),
+		Version:    SeedGraphVersion,
+		Migrations: slices.Clone(seedMigrations),
+	}
+	for _, s := range builtinStrands() {
+		c.Strands = append(c.Strands, StrandInfo{ID: s, Name: builtinStrandName(s)})
+	}
+	return c
 }
 
 func init() {
-	g = buildGraph(skills)
+	g = buildGraph(SeedCurriculum())
 	if err := Validate(); err != nil {
 		panic(fmt.Sprintf("skillgraph: %v", err))
 	}
@@ -1,5 +1,10 @@
 package skillgraph
 
+import (
+	"slices"
+	"strconv"
+)
+
 // Strand represents a math content strand.
 type Strand string
 
@@ -9,21 +14,42 @@ const (
 	StrandMultDiv     Strand = "multiplication-and-division"
 	StrandFractions   Strand = "fractions"
 	StrandMeasurement Strand = "measurement"
+	StrandGeometry    Strand = "geometry"
+	StrandData        Strand = "data-and-statistics"
+	StrandRatios      Strand = "ratios-and-algebra"
 )
 
-// AllStrands returns all strands in display order.
-func AllStrands() []Strand {
+// builtinStrands returns the strands of the embedded seed graph in display order.
+func builtinStrands() []Strand {
 	return []Strand{
 		StrandNumberPlace,
 		StrandAddSub,
 		StrandMultDiv,
 		StrandFractions,
 		StrandMeasurement,
+		StrandGeometry,
+		StrandData,
+		StrandRatios,
 	}
 }
 
-// StrandDisplayName returns a human-readable name for a strand.
+// AllStrands returns all strands of the active curriculum in display order.
+func AllStrands() []Strand {
+	return slices.Clone(g.strands)
+}
+
+// StrandDisplayName returns a human-readable name for a strand. Names declared
+// by the active curriculum win over the built-in ones.
 func StrandDisplayName(s Strand) string {
+	if name := g.strandNames[s]; name != "" {
+		return name
+	}
+	return builtinStrandName(s)
+}
+
+// builtinStrandName returns the display name of a built-in strand, or the
+// strand ID itself for strands the binary doesn't know.
+func builtinStrandName(s Strand) string {
 	switch s {
 	case StrandNumberPlace:
 		return "Number & Place Value"
@@ -35,6 +61,12 @@ func StrandDisplayName(s Strand) string {
 		return "Fractions"
 	case StrandMeasurement:
 		return "Measurement"
+	case StrandGeometry:
+		return "Geometry"
+	case StrandData:
+		return "Data & Statistics"
+	case StrandRatios:
+		return "Ratios & Early Algebra"
 	default:
 		return string(s)
 	}
@@ -44,10 +76,48 @@ func StrandDisplayName(s Strand) string {
 type Tier int
 
 const (
-	TierLearn Tier = iota // Practice with hints available, untimed
-	TierProve             // Timed assessment without hints, demonstrates mastery
+	TierLearn     Tier = iota // Practice with hints available, untimed
+	TierProve                 // Timed assessment without hints, demonstrates mastery
+	TierChallenge             // Optional harder, faster round; when configured, mastery needs it too
 )
 
+// String returns the tier's persisted name: "learn", "prove" or "challenge".
+func (t Tier) String() string {
+	switch t {
+	case TierProve:
+		return "prove"
+	case TierChallenge:
+		return "challenge"
+	default:
+		return "learn"
+	}
+}
+
+// ParseTier parses a tier name written by String. Unknown names are the
+// Learn tier, so old or hand-edited records start over gently.
+func ParseTier(s string) Tier {
+	switch s {
+	case "prove":
+		return TierProve
+	case "challenge":
+		return TierChallenge
+	default:
+		return TierLearn
+	}
+}
+
+// DisplayName returns the tier's title-case label for UIs.
+func (t Tier) DisplayName() string {
+	switch t {
+	case TierProve:
+		return "Prove"
+	case TierChallenge:
+		return "Challenge"
+	default:
+		return "Learn"
+	}
+}
+
 // TierConfig holds the configuration for a skill tier.
 type TierConfig struct {
 	Tier              Tier
@@ -57,38 +127,100 @@ type TierConfig struct {
 	HintsAllowed      bool
 }
 
-// DefaultTiers returns the default Learn and Prove tier configurations.
-func DefaultTiers() [2]TierConfig {
-	return [2]TierConfig{
+// Tiers holds a skill's tier configurations, indexed by Tier. Learn and
+// Prove are always set; an unset Challenge entry (ProblemsRequired 0) means
+// the skill has no Challenge tier.
+type Tiers [3]TierConfig
+
+// DefaultTiers returns the default Learn and Prove tier configurations, with
+// no Challenge tier.
+func DefaultTiers() Tiers {
+	return Tiers{
 		{Tier: TierLearn, ProblemsRequired: 8, AccuracyThreshold: 0.75, TimeLimitSecs: 0, HintsAllowed: true},
 		{Tier: TierProve, ProblemsRequired: 6, AccuracyThreshold: 0.85, TimeLimitSecs: 30, HintsAllowed: false},
 	}
 }
 
+// DefaultChallengeTier returns the Challenge tier configuration a skill or
+// curriculum gets when it opts in without overriding every field.
+func DefaultChallengeTier() TierConfig {
+	return TierConfig{Tier: TierChallenge, ProblemsRequired: 5, AccuracyThreshold: 0.9, TimeLimitSecs: 20, HintsAllowed: false}
+}
+
+// FactFluencyTiers suits facts that should become automatic: a longer, much
+// faster Prove tier and a Challenge tier at near-instant recall.
+func FactFluencyTiers() Tiers {
+	t := DefaultTiers()
+	t[TierProve] = TierConfig{Tier: TierProve, ProblemsRequired: 10, AccuracyThreshold: 0.9, TimeLimitSecs: 10, HintsAllowed: false}
+	t[TierChallenge] = TierConfig{Tier: TierChallenge, ProblemsRequired: 10, AccuracyThreshold: 0.9, TimeLimitSecs: 6, HintsAllowed: false}
+	return t
+}
+
+// WordProblemTiers suits multi-step word problems: fewer questions, a
+// slightly lower bar, and time to read and plan in the Prove tier.
+func WordProblemTiers() Tiers {
+	return Tiers{
+		{Tier: TierLearn, ProblemsRequired: 6, AccuracyThreshold: 0.7, TimeLimitSecs: 0, HintsAllowed: true},
+		{Tier: TierProve, ProblemsRequired: 5, AccuracyThreshold: 0.8, TimeLimitSecs: 120, HintsAllowed: false},
+	}
+}
+
+// Has reports whether tier t is configured.
+func (ts Tiers) Has(t Tier) bool {
+	return t >= TierLearn && int(t) < len(ts) && ts[t].ProblemsRequired > 0
+}
+
+// Final returns the tier whose completion masters the skill: Challenge when
+// configured, otherwise Prove.
+func (ts Tiers) Final() Tier {
+	if ts.Has(TierChallenge) {
+		return TierChallenge
+	}
+	return TierProve
+}
+
+// Next returns the tier after t, and false when t is the final tier.
+func (ts Tiers) Next(t Tier) (Tier, bool) {
+	if t >= ts.Final() {
+		return t, false
+	}
+	return t + 1, true
+}
+
+// GradeLabel formats a grade level for display: "K" for kindergarten,
+// otherwise the number.
+func GradeLabel(grade int) string {
+	if grade == 0 {
+		return "K"
+	}
+	return strconv.Itoa(grade)
+}
+
 // Skill represents a single math skill node in the graph.
 type Skill struct {
 	ID            string
 	Name          string
 	Description   string
 	Strand        Strand
-	GradeLevel    int
-	CommonCoreID  string
+	GradeLevel    int                 // 0 = kindergarten
+	Standards     map[Standard]string // code per standard, e.g. {ccss: "3.NF.A.1"}
 	EstimatedMins int
 	Keywords      []string
 	Prerequisites []string
-	Tiers         [2]TierConfig
+	Tiers         Tiers
 }
 
 // SkillState represents a skill's state relative to the learner.
 type SkillState int
 
 const (
-	StateLocked    SkillState = iota // One or more prerequisites not yet mastered
-	StateAvailable                   // All prerequisites mastered; skill not yet started
-	StateLearning                    // Learn tier in progress
-	StateProving                     // Learn tier passed; Prove tier in progress
-	StateMastered                    // Prove tier passed
-	StateRusty                       // Previously mastered but flagged by spaced repetition
+	StateLocked      SkillState = iota // One or more prerequisites not yet mastered
+	StateAvailable                     // All prerequisites mastered; skill not yet started
+	StateLearning                      // Learn tier in progress
+	StateProving                       // Learn tier passed; Prove tier in progress
+	StateMastered                      // Final tier passed
+	StateRusty                         // Previously mastered but flagged by spaced repetition
+	StateChallenging                   // Prove tier passed; Challenge tier in progress
 )
 
 // Icon returns the display icon for a skill state.
@@ -102,6 +234,8 @@ func (s SkillState) Icon() string {
 		return "📖"
 	case StateProving:
 		return "📝"
+	case StateChallenging:
+		return "⚡"
 	case StateMastered:
 		return "✅"
 	case StateRusty:
@@ -122,6 +256,8 @@ func (s SkillState) Label() string {
 		return "Learning"
 	case StateProving:
 		return "Proving"
+	case StateChallenging:
+		return "Challenge"
 	case StateMastered:
 		return "Mastered"
 	case StateRusty:
@@ -0,0 +1,121 @@
+package skillgraph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Standard identifies a curriculum standard a skill can be mapped to, such as
+// Common Core. A skill carries one code per standard it covers.
+type Standard string
+
+const (
+	StandardCommonCore Standard = "ccss"
+	StandardUK         Standard = "uk-nc"
+	StandardCBSE       Standard = "cbse"
+)
+
+// DefaultStandard is used when a learner has not picked one.
+const DefaultStandard = StandardCommonCore
+
+// AllStandards returns the known standards in display order.
+func AllStandards() []Standard {
+	return []Standard{StandardCommonCore, StandardUK, StandardCBSE}
+}
+
+// StandardDisplayName returns a human-readable name for a standard.
+func StandardDisplayName(s Standard) string {
+	switch s {
+	case StandardCommonCore:
+		return "Common Core"
+	case StandardUK:
+		return "UK National Curriculum"
+	case StandardCBSE:
+		return "CBSE"
+	default:
+		return string(s)
+	}
+}
+
+// ShortName returns a compact label for table headers.
+func (s Standard) ShortName() string {
+	switch s {
+	case StandardCommonCore:
+		return "CCSS"
+	case StandardUK:
+		return "UK"
+	case StandardCBSE:
+		return "CBSE"
+	default:
+		return strings.ToUpper(string(s))
+	}
+}
+
+// ParseStandard resolves a standard ID. The empty string yields DefaultStandard.
+func ParseStandard(s string) (Standard, error) {
+	if s == "" {
+		return DefaultStandard, nil
+	}
+	std := Standard(strings.ToLower(s))
+	if !slices.Contains(AllStandards(), std) {
+		return "", fmt.Errorf("unknown standard %q (available: %s)", s, standardList())
+	}
+	return std, nil
+}
+
+func standardList() string {
+	ids := make([]string, 0, len(AllStandards()))
+	for _, s := range AllStandards() {
+		ids = append(ids, string(s))
+	}
+	return strings.Join(ids, ", ")
+}
+
+// Code returns the skill's code in the given standard, or "" if the skill
+// is not mapped to it.
+func (s Skill) Code(std Standard) string {
+	return s.Standards[std]
+}
+
+// ByStandard returns all skills mapped to the given standard, in graph order.
+func ByStandard(std Standard) []Skill {
+	var result []Skill
+	for _, s := range g.skills {
+		if s.Code(std) != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// ByCode returns the skills whose code in any standard equals code
+// (case-insensitive). Coarse standards may map one code to several skills.
+func ByCode(code string) []Skill {
+	var result []Skill
+	for _, s := range g.skills {
+		for _, c := range s.Standards {
+			if strings.EqualFold(c, code) {
+				result = append(result, s)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// activeStandard is the process-wide default standard for display, set once
+// at startup alongside the curriculum. Hosted mode stores a standard per
+// child and passes it explicitly instead.
+var activeStandard = DefaultStandard
+
+// UseStandard sets the process-wide default standard. Like Use, call it once
+// at startup.
+func UseStandard(std Standard) {
+	activeStandard = std
+}
+
+// ActiveStandard returns the process-wide default standard.
+func ActiveStandard() Standard {
+	return activeStandard
+}
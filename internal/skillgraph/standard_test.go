@@ -0,0 +1,62 @@
+package skillgraph
+
+import "testing"
+
+func TestParseStandard(t *testing.T) {
+	if std, err := ParseStandard(""); err != nil || std != DefaultStandard {
+		t.Errorf("ParseStandard(\"\") = %q, %v; want default", std, err)
+	}
+	if std, err := ParseStandard("UK-NC"); err != nil || std != StandardUK {
+		t.Errorf("ParseStandard(UK-NC) = %q, %v; want uk-nc", std, err)
+	}
+	if _, err := ParseStandard("ib-pyp"); err == nil {
+		t.Error("expected error for unknown standard, got nil")
+	}
+}
+
+func TestSeedSkillStandards(t *testing.T) {
+	// The seed maps every skill to Common Core and nothing else: UK and CBSE
+	// codes come from curriculum files.
+	if got := len(ByStandard(StandardCommonCore)); got != len(AllSkills()) {
+		t.Errorf("ccss: %d skills mapped, want all %d", got, len(AllSkills()))
+	}
+	for _, std := range []Standard{StandardUK, StandardCBSE} {
+		if got := ByStandard(std); len(got) != 0 {
+			t.Errorf("%s: %d seed skills mapped, want none", std, len(got))
+		}
+	}
+
+	s, err := GetSkill("frac-concept")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Code(StandardCommonCore) != "3.NF.A.1" {
+		t.Errorf("frac-concept ccss code = %q, want 3.NF.A.1", s.Code(StandardCommonCore))
+	}
+}
+
+func TestByCode(t *testing.T) {
+	matches := ByCode("3.nf.a.1")
+	if len(matches) != 1 || matches[0].ID != "frac-concept" {
+		t.Errorf("ByCode(3.nf.a.1) = %v, want [frac-concept]", matches)
+	}
+	if got := ByCode("nope"); len(got) != 0 {
+		t.Errorf("ByCode(nope) = %v, want none", got)
+	}
+}
+
+func TestParseCurriculum_Standards(t *testing.T) {
+	doc := `{"skills": [{"id": "a", "name": "A", "strand": "fractions", "grade": 3, "standards": {"cbse": "M3.4"}}]}`
+	c, err := ParseCurriculum([]byte(doc), "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Skills[0].Code(StandardCBSE) != "M3.4" {
+		t.Errorf("cbse code = %q, want M3.4", c.Skills[0].Code(StandardCBSE))
+	}
+
+	doc = `{"skills": [{"id": "a", "name": "A", "strand": "fractions", "grade": 3, "standards": {"ib": "x"}}]}`
+	if _, err := ParseCurriculum([]byte(doc), "json"); err == nil {
+		t.Error("expected error for unknown standard key, got nil")
+	}
+}
@@ -5,9 +5,17 @@ import (
 	"strings"
 )
 
-// validateSkills performs all structural checks on the given skill set.
-// Returns a combined error describing all problems found, or nil if valid.
+// validateSkills performs all structural checks on the given skill set
+// against the built-in strands.
 func validateSkills(skills []Skill) error {
+	return validateCurriculum(builtinStrands(), skills)
+}
+
+// validateCurriculum performs all structural checks on the given skill set.
+// Every declared strand must be populated and every skill must belong to a
+// declared strand. Returns a combined error describing all problems found,
+// or nil if valid.
+func validateCurriculum(strands []Strand, skills []Skill) error {
 	var errs []string
 
 	idSet := make(map[string]bool, len(skills))
@@ -84,15 +92,28 @@ func validateSkills(skills []Skill) error {
 	}
 
 	// Check all declared strands are populated
-	for _, strand := range AllStrands() {
+	declared := make(map[Strand]bool, len(strands))
+	for _, strand := range strands {
+		declared[strand] = true
 		if !strandSet[strand] {
 			errs = append(errs, fmt.Sprintf("strand %q has no skills", strand))
 		}
 	}
 
+	// Check every skill belongs to a declared strand
+	for _, s := range skills {
+		if !declared[s.Strand] {
+			errs = append(errs, fmt.Sprintf("skill %q uses undeclared strand %q", s.ID, s.Strand))
+		}
+	}
+
 	// Check tier configs are valid
 	for _, s := range skills {
 		for i, tc := range s.Tiers {
+			// The Challenge tier is optional: unset means the skill has none.
+			if Tier(i) == TierChallenge && tc == (TierConfig{}) {
+				continue
+			}
 			prefix := fmt.Sprintf("skill %q tier %d", s.ID, i)
 			if tc.ProblemsRequired <= 0 {
 				errs = append(errs, fmt.Sprintf("%s: ProblemsRequired must be > 0, got %d", prefix, tc.ProblemsRequired))
@@ -0,0 +1,65 @@
+package spacedrep
+
+import (
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// MigrateReviews applies the skill graph's migrations newer than version to
+// review data, returning a migrated copy. Renamed and split skills keep
+// their schedule; a merged skill takes its most overdue source's schedule.
+// Backfilled skills get none: reviewing the skill that implies them covers
+// them.
+func MigrateReviews(data *store.SpacedRepSnapshotData, version int) *store.SpacedRepSnapshotData {
+	if data == nil {
+		return nil
+	}
+	return &store.SpacedRepSnapshotData{
+		Reviews: skillgraph.MigrateRecords(data.Reviews, version, skillgraph.MigrationRules[*store.ReviewStateData]{
+			Rename: renameReviewData,
+			Merge:  mergeReviewData,
+		}),
+	}
+}
+
+// MigrateSnapshotSkills applies the skill graph's migrations to a loaded
+// snapshot's mastery and review data in place and stamps the current graph
+// version. It is for code that reads snapshot data directly rather than
+// through mastery.Service and Scheduler, which migrate on load.
+func MigrateSnapshotSkills(snap *store.SnapshotData) {
+	if snap == nil || snap.GraphVersion >= skillgraph.GraphVersion() {
+		return
+	}
+	snap.Mastery = mastery.MigrateSkills(snap.Mastery, snap.GraphVersion)
+	snap.SpacedRep = MigrateReviews(snap.SpacedRep, snap.GraphVersion)
+	snap.GraphVersion = skillgraph.GraphVersion()
+}
+
+func renameReviewData(rec *store.ReviewStateData, id string) *store.ReviewStateData {
+	c := *rec
+	c.SkillID = id
+	return &c
+}
+
+// mergeReviewData picks the most overdue schedule among a merge's sources.
+// Unparseable dates lose: the loader would drop them anyway.
+func mergeReviewData(recs []*store.ReviewStateData, id string, _ bool) *store.ReviewStateData {
+	var due *store.ReviewStateData
+	var dueAt time.Time
+	for _, r := range recs {
+		at, err := time.Parse(time.RFC3339, r.NextReviewDate)
+		if err != nil {
+			continue
+		}
+		if due == nil || at.Before(dueAt) {
+			due, dueAt = r, at
+		}
+	}
+	if due == nil {
+		due = recs[0]
+	}
+	return renameReviewData(due, id)
+}
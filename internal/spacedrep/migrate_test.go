@@ -0,0 +1,59 @@
+package spacedrep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+func TestNewScheduler_MigratesRenamedSkill(t *testing.T) {
+	c := &skillgraph.Curriculum{
+		Name:    "renamed",
+		Strands: []skillgraph.StrandInfo{{ID: "counting", Name: "Counting"}},
+		Skills: []skillgraph.Skill{
+			{ID: "count-10", Name: "Count to 10", Strand: "counting", GradeLevel: 1, Tiers: skillgraph.DefaultTiers()},
+		},
+		Version: 2,
+		Migrations: []skillgraph.Migration{
+			{Version: 2, Kind: skillgraph.MigrateAlias, From: []string{"count"}, To: []string{"count-10"}},
+		},
+	}
+	if err := skillgraph.Use(c); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = skillgraph.Use(skillgraph.SeedCurriculum()) })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	at := now.Format(time.RFC3339)
+	snap := &store.SnapshotData{
+		GraphVersion: 1,
+		Mastery: &store.MasterySnapshotData{Skills: map[string]*store.SkillMasteryData{
+			"count": {SkillID: "count", State: "mastered", CurrentTier: "prove", MasteredAt: &at},
+		}},
+		SpacedRep: &store.SpacedRepSnapshotData{Reviews: map[string]*store.ReviewStateData{
+			"count": {SkillID: "count", Stage: 2, NextReviewDate: at, LastReviewDate: at},
+		}},
+	}
+	svc := mastery.NewService(snap, nil)
+	sched := NewScheduler(snap, svc, nil)
+
+	if svc.GetMastery("count-10").State != mastery.StateMastered {
+		t.Error("mastery did not carry over to count-10")
+	}
+	rs := sched.GetReviewState("count-10")
+	if rs == nil || rs.Stage != 2 || rs.SkillID != "count-10" {
+		t.Fatalf("review state = %+v, want stage 2 for count-10", rs)
+	}
+	if sched.GetReviewState("count") != nil {
+		t.Error("old skill ID still scheduled")
+	}
+
+	// Readers of raw snapshot data migrate in place.
+	MigrateSnapshotSkills(snap)
+	if snap.GraphVersion != 2 || snap.Mastery.Skills["count-10"] == nil || snap.SpacedRep.Reviews["count-10"] == nil {
+		t.Errorf("MigrateSnapshotSkills: %+v", snap)
+	}
+}
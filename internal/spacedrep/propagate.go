@@ -0,0 +1,69 @@
+package spacedrep
+
+import (
+	"sort"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+)
+
+// propagation is whether decay reaches the dependents of a rusty skill,
+// set once at startup.
+var propagation bool
+
+// UsePropagation turns prerequisite-aware decay on or off process-wide.
+// Call it once at startup.
+func UsePropagation(on bool) {
+	propagation = on
+}
+
+// Propagation reports whether prerequisite-aware decay is on.
+func Propagation() bool {
+	return propagation
+}
+
+// FlagDependents brings forward the review of every mastered skill that
+// directly depends on skillID, which has just gone rusty, so the planner
+// checks them at the next session. Review stages are kept: a dependent that
+// is still solid passes its early review and carries on as before. Returns
+// the flagged skill IDs, sorted; does nothing unless propagation is on.
+func (s *Scheduler) FlagDependents(skillID string, now time.Time) []string {
+	if !propagation {
+		return nil
+	}
+	var flagged []string
+	for _, dep := range skillgraph.Dependents(skillID) {
+		rs := s.reviews[dep.ID]
+		if rs == nil || s.mastery.GetMastery(dep.ID).State != mastery.StateMastered {
+			continue
+		}
+		if rs.NextReviewDate.After(now) {
+			rs.NextReviewDate = now
+			flagged = append(flagged, dep.ID)
+		}
+	}
+	sort.Strings(flagged)
+	return flagged
+}
+
+// ShakyFoundation returns the direct prerequisites of a mastered skill that
+// have gone rusty, in graph order. The skill map shows such a skill as
+// resting on a shaky foundation. Returns nil unless propagation is on.
+func ShakyFoundation(svc *mastery.Service, skillID string) []string {
+	if !propagation {
+		return nil
+	}
+	// Look skills up without GetMastery, which would start tracking them.
+	skills := svc.AllSkillMasteries()
+	if sm := skills[skillID]; sm == nil || sm.State != mastery.StateMastered {
+		return nil
+	}
+	var rusty []string
+	for _, p := range skillgraph.Prerequisites(skillID) {
+		if sm := skills[p.ID]; sm != nil && sm.State == mastery.StateRusty {
+			rusty = append(rusty, p.ID)
+		}
+	}
+	return rusty
+}
@@ -0,0 +1,91 @@
+package spacedrep
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// withPropagation turns decay propagation on for the duration of a test.
+func withPropagation(t *testing.T) {
+	t.Helper()
+	UsePropagation(true)
+	t.Cleanup(func() { UsePropagation(false) })
+}
+
+// foundationScheduler masters pv-hundreds and two of its dependents,
+// compare-1000 and round-nearest-10-100. pv-hundreds is far past its rusty
+// threshold at now; the dependents are not due for another week.
+func foundationScheduler(now time.Time) (*Scheduler, *mastery.Service) {
+	masteredStr := now.AddDate(0, 0, -30).Format(time.RFC3339)
+	snap := masterySnap(map[string]*store.SkillMasteryData{
+		"pv-hundreds":          {SkillID: "pv-hundreds", State: "mastered", CurrentTier: "learn", MasteredAt: &masteredStr},
+		"compare-1000":         {SkillID: "compare-1000", State: "mastered", CurrentTier: "learn", MasteredAt: &masteredStr},
+		"round-nearest-10-100": {SkillID: "round-nearest-10-100", State: "mastered", CurrentTier: "learn", MasteredAt: &masteredStr},
+	})
+	svc := mastery.NewService(snap, nil)
+	reviews := map[string]*ReviewState{
+		"pv-hundreds":          {SkillID: "pv-hundreds", Stage: 0, NextReviewDate: now.AddDate(0, 0, -5)},
+		"compare-1000":         {SkillID: "compare-1000", Stage: 3, NextReviewDate: now.AddDate(0, 0, 7)},
+		"round-nearest-10-100": {SkillID: "round-nearest-10-100", Stage: 3, NextReviewDate: now.AddDate(0, 0, 7)},
+	}
+	return newTestScheduler(reviews, svc, &mockEventRepo{}), svc
+}
+
+func TestRunDecayCheck_Propagation_FlagsDependents(t *testing.T) {
+	withPropagation(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sched, svc := foundationScheduler(now)
+
+	if transitions := sched.RunDecayCheck(context.Background(), now); len(transitions) != 1 {
+		t.Fatalf("expected 1 transition, got %d", len(transitions))
+	}
+
+	due := sched.DueSkills(now)
+	if !slices.Equal(due, []string{"compare-1000", "round-nearest-10-100"}) {
+		t.Errorf("DueSkills = %v, want both dependents of pv-hundreds", due)
+	}
+	if rs := sched.GetReviewState("compare-1000"); rs.Stage != 3 {
+		t.Errorf("Stage = %d, want 3 (early review keeps the stage)", rs.Stage)
+	}
+	if svc.GetMastery("compare-1000").State != mastery.StateMastered {
+		t.Error("dependent should stay mastered")
+	}
+	if got := ShakyFoundation(svc, "compare-1000"); !slices.Equal(got, []string{"pv-hundreds"}) {
+		t.Errorf("ShakyFoundation = %v, want [pv-hundreds]", got)
+	}
+}
+
+func TestRunDecayCheck_NoPropagation_LeavesDependents(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sched, svc := foundationScheduler(now)
+
+	sched.RunDecayCheck(context.Background(), now)
+
+	if due := sched.DueSkills(now); len(due) != 0 {
+		t.Errorf("DueSkills = %v, want none with propagation off", due)
+	}
+	if got := ShakyFoundation(svc, "compare-1000"); got != nil {
+		t.Errorf("ShakyFoundation = %v, want nil with propagation off", got)
+	}
+}
+
+func TestFlagDependents_KeepsEarlierReview(t *testing.T) {
+	withPropagation(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sched, _ := foundationScheduler(now)
+	earlier := now.Add(-time.Hour)
+	sched.GetReviewState("compare-1000").NextReviewDate = earlier
+
+	flagged := sched.FlagDependents("pv-hundreds", now)
+	if !slices.Equal(flagged, []string{"round-nearest-10-100"}) {
+		t.Errorf("FlagDependents = %v, want only the dependent not already due", flagged)
+	}
+	if got := sched.GetReviewState("compare-1000").NextReviewDate; !got.Equal(earlier) {
+		t.Errorf("NextReviewDate = %v, want the earlier %v kept", got, earlier)
+	}
+}
@@ -0,0 +1,243 @@
+package spacedrep
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// placementCategory is placement.Category, which imports this package.
+const placementCategory = "placement"
+
+// answerTriggers are the mastery event triggers caused by an answer. The
+// session appends such an event just before the answer event, so the
+// rebuild holds it back until that answer has been counted.
+var answerTriggers = map[string]bool{
+	"first-attempt":      true,
+	"tier-complete":      true,
+	"prove-complete":     true,
+	"challenge-complete": true,
+	"recovery-complete":  true,
+	"review-performance": true,
+}
+
+// Rebuild replays a learner's event log into fresh mastery and review
+// state, without reading a snapshot. answers and transitions must be oldest
+// first. Answers drive the attempt counters, fluency and review schedule;
+// the recorded mastery events drive every state change, so the result does
+// not depend on the mastery model or tier thresholds in force today.
+//
+// Decay is replayed under the propagation mode in force today, so a log
+// recorded with a different mode rebuilds with different review dates.
+//
+// Misconception penalties are not in either log, so a tier in progress
+// restarts its penalty at 0. Skill IDs are kept as recorded; when some no
+// longer exist in the graph, the result is tagged GraphVersion 0 so loading
+// it applies the graph's migrations.
+func Rebuild(answers []store.AnswerEventRecord, transitions []store.MasteryEventRecord) *store.SnapshotData {
+	svc := mastery.NewService(nil, nil)
+	sched := NewScheduler(nil, svc, nil)
+	migrated := false
+
+	apply := func(e store.MasteryEventRecord) {
+		t := svc.ReplayTransition(e.SkillID, e.Trigger, e.Timestamp)
+		if t == nil {
+			return
+		}
+		if t.To == mastery.StateRusty {
+			sched.FlagDependents(e.SkillID, e.Timestamp)
+			return
+		}
+		if t.To != mastery.StateMastered {
+			return
+		}
+		if t.From == mastery.StateRusty {
+			sched.ReInitSkill(e.SkillID, e.Timestamp)
+		} else {
+			sched.InitSkill(e.SkillID, e.Timestamp)
+		}
+	}
+
+	pending := make(map[string]store.MasteryEventRecord)
+	key := func(sessionID, skillID string) string { return sessionID + "\x00" + skillID }
+
+	i, j := 0, 0
+	for i < len(answers) || j < len(transitions) {
+		if j < len(transitions) && (i == len(answers) || transitions[j].Sequence < answers[i].Sequence) {
+			e := transitions[j]
+			j++
+			if !answerTriggers[e.Trigger] || e.SessionID == "" {
+				apply(e)
+				continue
+			}
+			k := key(e.SessionID, e.SkillID)
+			if prev, ok := pending[k]; ok {
+				apply(prev)
+			}
+			pending[k] = e
+			continue
+		}
+
+		a := answers[i]
+		i++
+		if a.Category == placementCategory {
+			continue // placement answers seed skills through "diagnostic" events
+		}
+		tiers, known := replayTiers(a.SkillID)
+		if !known {
+			continue // e.g. untagged quest questions, which never touch mastery
+		}
+		if _, err := skillgraph.GetSkill(a.SkillID); err != nil {
+			migrated = true
+		}
+		tier := skillgraph.ParseTier(a.Tier)
+		if !tiers.Has(tier) {
+			tier = tiers.Final()
+		}
+		svc.ReplayAnswer(a.SkillID, a.Correct, a.TimeMs, tiers[tier])
+		if a.Category == "review" {
+			sched.RecordReview(a.SkillID, a.Correct, a.Timestamp)
+		}
+		k := key(a.SessionID, a.SkillID)
+		if e, ok := pending[k]; ok {
+			delete(pending, k)
+			apply(e)
+		}
+	}
+
+	// Events whose answer was never recorded, such as after a crash.
+	rest := make([]store.MasteryEventRecord, 0, len(pending))
+	for _, e := range pending {
+		rest = append(rest, e)
+	}
+	sort.Slice(rest, func(a, b int) bool { return rest[a].Sequence < rest[b].Sequence })
+	for _, e := range rest {
+		apply(e)
+	}
+
+	version := skillgraph.GraphVersion()
+	if migrated {
+		version = 0
+	}
+	return &store.SnapshotData{
+		Version:      4,
+		GraphVersion: version,
+		Mastery:      svc.SnapshotData(),
+		SpacedRep:    sched.SnapshotData(),
+	}
+}
+
+// replayTiers returns the tiers to score a skill's answers against, and
+// whether the skill is one mastery tracks: in the graph, or migrated out
+// of it.
+func replayTiers(skillID string) (skillgraph.Tiers, bool) {
+	if skill, err := skillgraph.GetSkill(skillID); err == nil {
+		return skill.Tiers, true
+	}
+	for _, m := range skillgraph.MigrationsSince(0) {
+		if slices.Contains(m.From, skillID) {
+			return skillgraph.DefaultTiers(), true
+		}
+	}
+	return skillgraph.Tiers{}, false
+}
+
+// timeSlack is how far apart two recorded times may be and still match:
+// the live code stamps state a moment before appending the event the
+// rebuild takes the time from.
+const timeSlack = time.Minute
+
+// Diff compares the mastery and review state of two snapshots, after
+// loading each as the app would (migrating skill IDs), and returns one line
+// per difference, sorted by skill. Skills missing from one side compare as
+// new and unscheduled.
+func Diff(replayed, snapshot *store.SnapshotData) []string {
+	gotSvc := mastery.NewService(replayed, nil)
+	wantSvc := mastery.NewService(snapshot, nil)
+	got := NewScheduler(replayed, gotSvc, nil).AllReviewStates()
+	want := NewScheduler(snapshot, wantSvc, nil).AllReviewStates()
+
+	ids := make(map[string]bool)
+	for id := range gotSvc.AllSkillMasteries() {
+		ids[id] = true
+	}
+	for id := range wantSvc.AllSkillMasteries() {
+		ids[id] = true
+	}
+	for id := range got {
+		ids[id] = true
+	}
+	for id := range want {
+		ids[id] = true
+	}
+
+	var diffs []string
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		diffs = append(diffs, diffMastery(id, gotSvc.GetMastery(id), wantSvc.GetMastery(id))...)
+		diffs = append(diffs, diffReview(id, got[id], want[id])...)
+	}
+	return diffs
+}
+
+func diffMastery(id string, got, want *mastery.SkillMastery) []string {
+	var d differ
+	d.add(id, "state", got.State, want.State)
+	d.add(id, "tier", got.CurrentTier, want.CurrentTier)
+	d.add(id, "attempts", got.TotalAttempts, want.TotalAttempts)
+	d.add(id, "correct", got.CorrectCount, want.CorrectCount)
+	d.add(id, "streak", got.Fluency.Streak, want.Fluency.Streak)
+	if g, w := got.FluencyScore(), want.FluencyScore(); math.Abs(g-w) > 0.01 {
+		d.add(id, "fluency", fmt.Sprintf("%.2f", g), fmt.Sprintf("%.2f", w))
+	}
+	d.time(id, "mastered at", got.MasteredAt, want.MasteredAt)
+	d.time(id, "rusty at", got.RustyAt, want.RustyAt)
+	return d.lines
+}
+
+func diffReview(id string, got, want *ReviewState) []string {
+	var d differ
+	switch {
+	case got == nil && want == nil:
+	case got == nil:
+		d.add(id, "review", "unscheduled", "scheduled")
+	case want == nil:
+		d.add(id, "review", "scheduled", "unscheduled")
+	default:
+		d.add(id, "review stage", got.Stage, want.Stage)
+		d.add(id, "review hits", got.ConsecutiveHits, want.ConsecutiveHits)
+		d.add(id, "graduated", got.Graduated, want.Graduated)
+		d.time(id, "next review", &got.NextReviewDate, &want.NextReviewDate)
+		d.time(id, "last review", &got.LastReviewDate, &want.LastReviewDate)
+	}
+	return d.lines
+}
+
+// differ collects "skill: field: replayed X, snapshot Y" lines.
+type differ struct {
+	lines []string
+}
+
+func (d *differ) add(id, field string, got, want any) {
+	if got != want {
+		d.lines = append(d.lines, fmt.Sprintf("%s: %s: replayed %v, snapshot %v", id, field, got, want))
+	}
+}
+
+func (d *differ) time(id, field string, got, want *time.Time) {
+	switch {
+	case got == nil && want == nil:
+	case got == nil:
+		d.add(id, field, "none", want.Format(time.RFC3339))
+	case want == nil:
+		d.add(id, field, got.Format(time.RFC3339), "none")
+	case got.Sub(*want).Abs() > timeSlack:
+		d.add(id, field, got.Format(time.RFC3339), want.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,130 @@
+package spacedrep
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abhisek/mathiz/internal/mastery"
+	"github.com/abhisek/mathiz/internal/skillgraph"
+	"github.com/abhisek/mathiz/internal/store"
+)
+
+// liveLog drives a mastery service and scheduler the way the session does,
+// recording the answer and mastery events it would append.
+type liveLog struct {
+	svc         *mastery.Service
+	sched       *Scheduler
+	seq         int64
+	answers     []store.AnswerEventRecord
+	transitions []store.MasteryEventRecord
+}
+
+func newLiveLog() *liveLog {
+	svc := mastery.NewService(nil, nil)
+	return &liveLog{svc: svc, sched: NewScheduler(nil, svc, nil)}
+}
+
+func (l *liveLog) next() int64 {
+	l.seq++
+	return l.seq
+}
+
+func (l *liveLog) answer(skill skillgraph.Skill, category string, correct bool) {
+	tier := l.svc.GetMastery(skill.ID).CurrentTier
+	now := time.Now()
+	t := l.svc.RecordAnswer(skill.ID, correct, 4000, skill.Tiers[tier])
+	if category == "review" {
+		l.sched.RecordReview(skill.ID, correct, now)
+	}
+	if t != nil {
+		if t.To == mastery.StateMastered {
+			l.sched.InitSkill(skill.ID, now)
+		}
+		l.transitions = append(l.transitions, store.MasteryEventRecord{
+			Sequence: l.next(), Timestamp: now, SkillID: skill.ID,
+			FromState: string(t.From), ToState: string(t.To), Trigger: t.Trigger, SessionID: "s1",
+		})
+	}
+	l.answers = append(l.answers, store.AnswerEventRecord{
+		Sequence: l.next(), Timestamp: now, SessionID: "s1", SkillID: skill.ID,
+		Tier: tier.String(), Category: category, Correct: correct, TimeMs: 4000,
+	})
+}
+
+func (l *liveLog) event(skillID string, t *mastery.StateTransition) {
+	if t == nil {
+		return
+	}
+	l.transitions = append(l.transitions, store.MasteryEventRecord{
+		Sequence: l.next(), Timestamp: time.Now(), SkillID: skillID,
+		FromState: string(t.From), ToState: string(t.To), Trigger: t.Trigger,
+	})
+}
+
+func (l *liveLog) snapshot() *store.SnapshotData {
+	return &store.SnapshotData{
+		Version:      4,
+		GraphVersion: skillgraph.GraphVersion(),
+		Mastery:      l.svc.SnapshotData(),
+		SpacedRep:    l.sched.SnapshotData(),
+	}
+}
+
+func TestRebuild_MatchesLiveState(t *testing.T) {
+	skills := skillgraph.AllSkills()
+	learned, seeded, started := skills[0], skills[1], skills[2]
+	l := newLiveLog()
+
+	// Placement seeds one skill; its answers don't count towards mastery.
+	l.answers = append(l.answers, store.AnswerEventRecord{
+		Sequence: l.next(), Timestamp: time.Now(), SkillID: seeded.ID,
+		Tier: "learn", Category: placementCategory, Correct: true,
+	})
+	now := time.Now()
+	l.event(seeded.ID, l.svc.SeedMastered(seeded.ID, now))
+	l.sched.InitSkill(seeded.ID, now)
+
+	// Master a skill, mixing in a wrong answer, then review it.
+	for !l.svc.MasteredSkills()[learned.ID] {
+		l.answer(learned, "frontier", l.seq != 5)
+	}
+	l.answer(learned, "review", true)
+	l.answer(learned, "review", false)
+
+	// Start another skill, and let the seeded one decay.
+	l.answer(started, "frontier", true)
+	l.answer(started, "frontier", false)
+	l.event(seeded.ID, l.svc.MarkRusty(seeded.ID))
+
+	// A quest question outside the graph never touches mastery.
+	l.answers = append(l.answers, store.AnswerEventRecord{
+		Sequence: l.next(), Timestamp: time.Now(), SkillID: "quest:abc", Category: "frontier", Correct: true,
+	})
+
+	rebuilt := Rebuild(l.answers, l.transitions)
+	if diffs := Diff(rebuilt, l.snapshot()); len(diffs) > 0 {
+		t.Errorf("rebuilt state differs from live state:\n%s", strings.Join(diffs, "\n"))
+	}
+	if got := rebuilt.Mastery.Skills[seeded.ID].State; got != string(mastery.StateRusty) {
+		t.Errorf("seeded skill state = %s, want rusty", got)
+	}
+	if _, ok := rebuilt.Mastery.Skills["quest:abc"]; ok {
+		t.Error("rebuilt state tracks a quest question's synthetic skill")
+	}
+}
+
+func TestDiff_ReportsDifferences(t *testing.T) {
+	skill := skillgraph.AllSkills()[0]
+	l := newLiveLog()
+	for range 3 {
+		l.answer(skill, "frontier", true)
+	}
+	snap := l.snapshot()
+	snap.Mastery.Skills[skill.ID].CurrentTier = "prove"
+
+	diffs := Diff(Rebuild(l.answers, l.transitions), snap)
+	if len(diffs) != 1 || !strings.Contains(diffs[0], "tier: replayed learn, snapshot prove") {
+		t.Errorf("Diff = %q, want one tier difference", diffs)
+	}
+}
@@ -18,7 +18,8 @@ type Scheduler struct {
 
 // NewScheduler creates a scheduler, loading review state from the snapshot.
 // If the snapshot has mastery data but no spaced rep data, it bootstraps
-// review states from the mastery snapshot (migration path).
+// review states from the mastery snapshot (migration path). Skill IDs saved
+// against an older graph version are migrated on load.
 func NewScheduler(snap *store.SnapshotData, masterySvc *mastery.Service, eventRepo store.EventRepo) *Scheduler {
 	s := &Scheduler{
 		reviews:   make(map[string]*ReviewState),
@@ -32,14 +33,14 @@ func NewScheduler(snap *store.SnapshotData, masterySvc *mastery.Service, eventRe
 
 	// Load existing spaced rep data if available.
 	if snap.SpacedRep != nil {
-		s.loadFromSnapshot(snap.SpacedRep)
+		s.loadFromSnapshot(MigrateReviews(snap.SpacedRep, snap.GraphVersion))
 		return s
 	}
 
 	// Bootstrap from mastery data if no spaced rep data exists.
 	if snap.Mastery != nil {
 		bootstrapped := BootstrapFromMastery(snap.Mastery)
-		s.loadFromSnapshot(bootstrapped)
+		s.loadFromSnapshot(MigrateReviews(bootstrapped, snap.GraphVersion))
 	}
 
 	return s
@@ -70,7 +71,9 @@ func (s *Scheduler) loadFromSnapshot(data *store.SpacedRepSnapshotData) {
 }
 
 // RunDecayCheck scans all mastered skills and marks overdue ones as rusty.
-// Called at session start. Returns the list of skills that transitioned to rusty.
+// With propagation on, the mastered dependents of each newly rusty skill
+// come up for an early review. Called at session start. Returns the list of
+// skills that transitioned to rusty.
 func (s *Scheduler) RunDecayCheck(ctx context.Context, now time.Time) []*mastery.StateTransition {
 	var transitions []*mastery.StateTransition
 
@@ -92,6 +95,7 @@ func (s *Scheduler) RunDecayCheck(ctx context.Context, now time.Time) []*mastery
 						FluencyScore: sm.FluencyScore(),
 					})
 				}
+				s.FlagDependents(skillID, now)
 			}
 		}
 	}
@@ -14,8 +14,8 @@ type mockEventRepo struct {
 	masteryEvents []store.MasteryEventData
 }
 
-func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) error {
-	return nil
+func (m *mockEventRepo) AppendLLMRequest(_ context.Context, _ store.LLMRequestEventData) (int, error) {
+	return 0, nil
 }
 func (m *mockEventRepo) AppendSessionEvent(_ context.Context, _ store.SessionEventData) error {
 	return nil
@@ -42,6 +42,12 @@ func (m *mockEventRepo) AppendDiagnosisEvent(_ context.Context, _ store.Diagnosi
 func (m *mockEventRepo) AppendHintEvent(_ context.Context, _ store.HintEventData) error {
 	return nil
 }
+func (m *mockEventRepo) AppendQuestionReport(_ context.Context, _ store.QuestionReportData) error {
+	return nil
+}
+func (m *mockEventRepo) QueryQuestionReports(_ context.Context, _ store.QueryOpts) ([]store.QuestionReportRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) AppendLessonEvent(_ context.Context, _ store.LessonEventData) error {
 	return nil
 }
@@ -89,6 +95,9 @@ func (m *mockEventRepo) QueryMasteryEvents(_ context.Context, _ store.QueryOpts)
 func (m *mockEventRepo) AnswersForSession(_ context.Context, _ string) ([]store.AnswerEventRecord, error) {
 	return nil, nil
 }
+func (m *mockEventRepo) AnswerHistory(_ context.Context, _ store.QueryOpts) ([]store.AnswerEventRecord, error) {
+	return nil, nil
+}
 func (m *mockEventRepo) HintCountForSession(_ context.Context, _ string) (int, error) {
 	return 0, nil
 }
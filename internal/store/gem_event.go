@@ -204,6 +204,7 @@ func (r *eventRepo) QuerySessionSummaries(ctx context.Context, opts QueryOpts) (
 			Plan:            planBySession[e.SessionID],
 			QuestUID:        questBySession[e.SessionID][0],
 			QuestName:       questBySession[e.SessionID][1],
+			QuestionSources: e.QuestionSources,
 		}
 	}
 	return records, nil
@@ -39,14 +39,14 @@ func (r *eventRepo) ownerPlaceholder() string {
 	return "?"
 }
 
-func (r *eventRepo) AppendLLMRequest(ctx context.Context, data LLMRequestEventData) error {
+func (r *eventRepo) AppendLLMRequest(ctx context.Context, data LLMRequestEventData) (int, error) {
 	ctx = r.scope(ctx)
 	seqNum, err := r.seq.Next(ctx)
 	if err != nil {
-		return fmt.Errorf("next sequence: %w", err)
+		return 0, fmt.Errorf("next sequence: %w", err)
 	}
 
-	_, err = r.client.LLMRequestEvent.Create().
+	row, err := r.client.LLMRequestEvent.Create().
 		SetSequence(seqNum).
 		SetOwnerID(r.owner).
 		SetProvider(data.Provider).
@@ -61,10 +61,10 @@ func (r *eventRepo) AppendLLMRequest(ctx context.Context, data LLMRequestEventDa
 		SetResponseBody(data.ResponseBody).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("save LLM request event: %w", err)
+		return 0, fmt.Errorf("save LLM request event: %w", err)
 	}
 
-	return nil
+	return row.ID, nil
 }
 
 func (r *eventRepo) QueryLLMEvents(ctx context.Context, opts QueryOpts) ([]LLMRequestEventRecord, error) {
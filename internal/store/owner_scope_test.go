@@ -205,7 +205,7 @@ func TestOwnerIsolationLLMUsage(t *testing.T) {
 	alice := s.EventRepoFor(testOwner(t, "alice"))
 	bob := s.EventRepoFor(testOwner(t, "bob"))
 
-	if err := alice.AppendLLMRequest(ctx, LLMRequestEventData{
+	if _, err := alice.AppendLLMRequest(ctx, LLMRequestEventData{
 		Provider: "anthropic", Model: "haiku", Purpose: "problemgen",
 		InputTokens: 100, OutputTokens: 50, LatencyMs: 800, Success: true,
 	}); err != nil {
@@ -34,6 +34,7 @@ const ownerIDColumn = "owner_id"
 // Family-scoped control-plane types (Account, FamilySpace, ChildProfile,
 // Invite, DeviceToken, CreditEntry, BillingState) are intentionally NOT
 // here — they have no owner_id column and are scoped by the authz layer.
+// BankQuestion is shared curriculum content, readable by every learner.
 var ownerScopedTypes = map[string]bool{
 	ent.TypeAnswerEvent:         true,
 	ent.TypeDiagnosisEvent:      true,
@@ -43,6 +44,7 @@ var ownerScopedTypes = map[string]bool{
 	ent.TypeLessonEvent:         true,
 	ent.TypeLLMRequestEvent:     true,
 	ent.TypeMasteryEvent:        true,
+	ent.TypeQuestionReportEvent: true,
 	ent.TypeSessionEvent:        true,
 	ent.TypeSnapshot:            true,
 }
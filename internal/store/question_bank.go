@@ -0,0 +1,242 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/abhisek/mathiz/ent"
+	"github.com/abhisek/mathiz/ent/answerevent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+// Retirement thresholds for banked questions: once at least
+// BankRetireMinAnswers answers are graded, a question answered correctly
+// less than BankRetireAccuracy of the time is retired. Questions that
+// almost nobody gets right are usually ambiguous or carry a wrong answer
+// key.
+const (
+	BankRetireMinAnswers = 20
+	BankRetireAccuracy   = 0.15
+)
+
+// BankRetireReports is how many different learners must report a banked
+// question before it is retired without waiting for triage. Fewer reports
+// only hold it back in the triage queue, so a single child can't retire a
+// question from every learner's bank; keeping it at triage puts it back into
+// rotation.
+const BankRetireReports = 3
+
+// questionBank implements QuestionBankRepo using ent.
+type questionBank struct {
+	client *ent.Client
+	owner  string
+}
+
+// QuestionBankFor returns the shared question bank, scoped to the given
+// learner for picking unseen questions.
+func (s *Store) QuestionBankFor(owner string) QuestionBankRepo {
+	return &questionBank{client: s.client, owner: owner}
+}
+
+// QuestionBank returns the question bank for the local single-user owner.
+func (s *Store) QuestionBank() QuestionBankRepo {
+	return s.QuestionBankFor(LocalOwner)
+}
+
+func (b *questionBank) SaveQuestion(ctx context.Context, data BankQuestionData) error {
+	exists, err := b.client.BankQuestion.Query().
+		Where(bankquestion.SkillID(data.SkillID), bankquestion.Text(data.Text)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query bank question: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	llmOwner := data.LLMOwner
+	if data.LLMRequestID > 0 && llmOwner == "" {
+		llmOwner = b.owner
+	}
+	err = b.client.BankQuestion.Create().
+		SetSkillID(data.SkillID).
+		SetTier(data.Tier).
+		SetDifficulty(data.Difficulty).
+		SetText(data.Text).
+		SetAnswer(data.Answer).
+		SetAnswerType(data.AnswerType).
+		SetFormat(data.Format).
+		SetChoices(data.Choices).
+		SetDiagram(data.Diagram).
+		SetHint(data.Hint).
+		SetExplanation(data.Explanation).
+		SetSource(data.Source).
+		SetLocale(bankLocale(data.Locale)).
+		SetLlmRequestID(data.LLMRequestID).
+		SetLlmOwner(llmOwner).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil // banked concurrently
+	}
+	if err != nil {
+		return fmt.Errorf("save bank question: %w", err)
+	}
+	return nil
+}
+
+func (b *questionBank) PickQuestion(ctx context.Context, query BankQuery) (*BankQuestionData, error) {
+	seen, err := b.client.AnswerEvent.Query().
+		Where(answerevent.OwnerID(b.owner), answerevent.SkillID(query.SkillID)).
+		Unique(true).
+		Select(answerevent.FieldQuestionText).
+		Strings(withOwner(ctx, b.owner))
+	if err != nil {
+		return nil, fmt.Errorf("query answered questions: %w", err)
+	}
+	reported, err := b.client.QuestionReportEvent.Query().
+		Where(questionreportevent.OwnerID(b.owner), questionreportevent.SkillID(query.SkillID)).
+		Unique(true).
+		Select(questionreportevent.FieldQuestionText).
+		Strings(withOwner(ctx, b.owner))
+	if err != nil {
+		return nil, fmt.Errorf("query reported questions: %w", err)
+	}
+	exclude := append(append(seen, reported...), query.Exclude...)
+
+	q := b.client.BankQuestion.Query().
+		Where(
+			bankquestion.SkillID(query.SkillID),
+			bankquestion.Tier(query.Tier),
+			bankquestion.Locale(bankLocale(query.Locale)),
+			bankquestion.Retired(false),
+			bankquestion.ReportsEQ(0), // awaiting triage
+		)
+	if len(exclude) > 0 {
+		q = q.Where(bankquestion.TextNotIn(exclude...))
+	}
+	if query.MinDifficulty > 0 {
+		q = q.Where(bankquestion.DifficultyGTE(query.MinDifficulty))
+	}
+	if query.MaxDifficulty > 0 {
+		q = q.Where(bankquestion.DifficultyLTE(query.MaxDifficulty))
+	}
+	row, err := q.
+		Order(func(s *sql.Selector) { s.OrderExpr(sql.Expr("RANDOM()")) }).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pick bank question: %w", err)
+	}
+
+	if err := row.Update().AddTimesServed(1).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("update bank question: %w", err)
+	}
+	data := bankQuestionData(row)
+	return &data, nil
+}
+
+func (b *questionBank) RecordAnswer(ctx context.Context, skillID, text string, correct bool) error {
+	row, err := b.client.BankQuestion.Query().
+		Where(bankquestion.SkillID(skillID), bankquestion.Text(text)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("query bank question: %w", err)
+	}
+
+	upd := row.Update().AddTimesAnswered(1)
+	answered, right := row.TimesAnswered+1, row.TimesCorrect
+	if correct {
+		upd = upd.AddTimesCorrect(1)
+		right++
+	}
+	if answered >= BankRetireMinAnswers && float64(right)/float64(answered) < BankRetireAccuracy {
+		upd = upd.SetRetired(true)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return fmt.Errorf("update bank question: %w", err)
+	}
+	return nil
+}
+
+func (b *questionBank) ListQuestions(ctx context.Context) ([]BankQuestionData, error) {
+	rows, err := b.client.BankQuestion.Query().
+		Where(bankquestion.Retired(false)).
+		Order(ent.Asc(bankquestion.FieldSkillID), ent.Asc(bankquestion.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bank questions: %w", err)
+	}
+	out := make([]BankQuestionData, len(rows))
+	for i, row := range rows {
+		out[i] = bankQuestionData(row)
+	}
+	return out, nil
+}
+
+func (b *questionBank) ReportedQuestions(ctx context.Context) ([]ReportedQuestion, error) {
+	rows, err := b.client.BankQuestion.Query().
+		Where(bankquestion.Retired(false), bankquestion.ReportsGT(0)).
+		Order(ent.Desc(bankquestion.FieldReports), ent.Asc(bankquestion.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reported bank questions: %w", err)
+	}
+	out := make([]ReportedQuestion, len(rows))
+	for i, row := range rows {
+		out[i] = ReportedQuestion{ID: row.ID, Reports: row.Reports, BankQuestionData: bankQuestionData(row)}
+	}
+	return out, nil
+}
+
+func (b *questionBank) TriageQuestion(ctx context.Context, id int, retire bool) error {
+	upd := b.client.BankQuestion.UpdateOneID(id)
+	if retire {
+		upd = upd.SetRetired(true)
+	} else {
+		upd = upd.ClearReportedBy().SetReports(0)
+	}
+	err := upd.Exec(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("bank question %d not found", id)
+	}
+	if err != nil {
+		return fmt.Errorf("triage bank question: %w", err)
+	}
+	return nil
+}
+
+func bankQuestionData(row *ent.BankQuestion) BankQuestionData {
+	return BankQuestionData{
+		SkillID:      row.SkillID,
+		Tier:         row.Tier,
+		Difficulty:   row.Difficulty,
+		Text:         row.Text,
+		Answer:       row.Answer,
+		AnswerType:   row.AnswerType,
+		Format:       row.Format,
+		Choices:      row.Choices,
+		Diagram:      row.Diagram,
+		Hint:         row.Hint,
+		Explanation:  row.Explanation,
+		Source:       row.Source,
+		Locale:       row.Locale,
+		LLMRequestID: row.LlmRequestID,
+		LLMOwner:     row.LlmOwner,
+	}
+}
+
+// bankLocale defaults an unset locale to English, which every question
+// banked before locales existed is written in.
+func bankLocale(locale string) string {
+	if locale == "" {
+		return "en"
+	}
+	return locale
+}
@@ -0,0 +1,253 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/abhisek/mathiz/ent/bankquestion"
+)
+
+func bankQuestion(skill, text string, difficulty int) BankQuestionData {
+	return BankQuestionData{
+		SkillID:     skill,
+		Tier:        "learn",
+		Difficulty:  difficulty,
+		Text:        text,
+		Answer:      "4",
+		AnswerType:  "integer",
+		Format:      "numeric",
+		Explanation: "2 + 2 = 4",
+		Source:      "llm",
+	}
+}
+
+func TestQuestionBank_SaveAndPick(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	skill := testOwner(t, "skill") // unique per test run
+	bank := s.QuestionBankFor(testOwner(t, "alice"))
+
+	q := bankQuestion(skill, "What is 2 + 2?", 2)
+	if err := bank.SaveQuestion(ctx, q); err != nil {
+		t.Fatalf("SaveQuestion: %v", err)
+	}
+	// Saving twice is a no-op.
+	if err := bank.SaveQuestion(ctx, q); err != nil {
+		t.Fatalf("SaveQuestion again: %v", err)
+	}
+
+	got, err := bank.PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"})
+	if err != nil {
+		t.Fatalf("PickQuestion: %v", err)
+	}
+	if got == nil || got.Text != q.Text || got.Answer != "4" || got.Source != "llm" || got.Locale != "en" {
+		t.Fatalf("picked %+v", got)
+	}
+
+	all, err := bank.ListQuestions(ctx)
+	if err != nil {
+		t.Fatalf("ListQuestions: %v", err)
+	}
+	if !slices.ContainsFunc(all, func(d BankQuestionData) bool { return d.SkillID == skill && d.Text == q.Text }) {
+		t.Errorf("ListQuestions is missing %q", q.Text)
+	}
+
+	for _, query := range []BankQuery{
+		{SkillID: skill, Tier: "prove"},
+		{SkillID: skill, Tier: "learn", Exclude: []string{q.Text}},
+		{SkillID: skill, Tier: "learn", MinDifficulty: 3},
+		{SkillID: skill, Tier: "learn", MaxDifficulty: 1},
+		{SkillID: skill, Tier: "learn", Locale: "es"},
+	} {
+		got, err := bank.PickQuestion(ctx, query)
+		if err != nil || got != nil {
+			t.Errorf("PickQuestion(%+v) = %+v, %v; want nothing", query, got, err)
+		}
+	}
+}
+
+func TestQuestionBank_SkipsAnsweredQuestions(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	skill := testOwner(t, "skill")
+	alice, bob := testOwner(t, "alice"), testOwner(t, "bob")
+
+	q := bankQuestion(skill, "What is 3 + 1?", 2)
+	q.LLMRequestID = 12
+	if err := s.QuestionBankFor(alice).SaveQuestion(ctx, q); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.EventRepoFor(alice).AppendAnswerEvent(ctx, AnswerEventData{
+		SessionID: "s1", SkillID: skill, Tier: "learn", Category: "frontier",
+		QuestionText: q.Text, CorrectAnswer: "4", LearnerAnswer: "4",
+		Correct: true, TimeMs: 1000, AnswerFormat: "numeric",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Alice answered it; Bob hasn't.
+	if got, err := s.QuestionBankFor(alice).PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"}); err != nil || got != nil {
+		t.Errorf("alice picked %+v, %v; want nothing", got, err)
+	}
+	got, err := s.QuestionBankFor(bob).PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"})
+	if err != nil || got == nil {
+		t.Fatalf("bob picked %+v, %v; want the banked question", got, err)
+	}
+	// The generating request stays findable in Alice's LLM log.
+	if got.LLMRequestID != 12 || got.LLMOwner != alice {
+		t.Errorf("LLM request = #%d of %q, want #12 of %q", got.LLMRequestID, got.LLMOwner, alice)
+	}
+}
+
+func TestQuestionBank_RetiresPoorQuestions(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	skill := testOwner(t, "skill")
+	bank := s.QuestionBankFor(testOwner(t, "alice"))
+
+	hard := bankQuestion(skill, "What is 9 + 9?", 2)
+	fine := bankQuestion(skill, "What is 1 + 1?", 2)
+	for _, q := range []BankQuestionData{hard, fine} {
+		if err := bank.SaveQuestion(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range BankRetireMinAnswers {
+		if err := bank.RecordAnswer(ctx, skill, hard.Text, i == 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := bank.RecordAnswer(ctx, skill, fine.Text, i%2 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Unknown questions are ignored.
+	if err := bank.RecordAnswer(ctx, skill, "not banked", true); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 5 {
+		got, err := bank.PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"})
+		if err != nil || got == nil {
+			t.Fatalf("PickQuestion = %+v, %v", got, err)
+		}
+		if got.Text != fine.Text {
+			t.Errorf("picked retired question %q", got.Text)
+		}
+	}
+}
+
+func TestQuestionBank_QueuesReportedQuestions(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	skill := testOwner(t, "skill")
+	alice, bob := testOwner(t, "alice"), testOwner(t, "bob")
+
+	bad := bankQuestion(skill, "What is 2 + 2? (answer key says 5)", 2)
+	if err := s.QuestionBankFor(alice).SaveQuestion(ctx, bad); err != nil {
+		t.Fatal(err)
+	}
+	report := func(owner string) {
+		t.Helper()
+		err := s.EventRepoFor(owner).AppendQuestionReport(ctx, QuestionReportData{
+			SessionID:    "s1",
+			SkillID:      skill,
+			Tier:         "learn",
+			QuestionText: bad.Text,
+			Answer:       "5",
+			LLMRequestID: 42,
+			Reporter:     ReporterLearner,
+			Reason:       "wrong answer",
+		})
+		if err != nil {
+			t.Fatalf("AppendQuestionReport: %v", err)
+		}
+	}
+	report(alice)
+	report(alice) // the same learner again doesn't add up
+
+	// One learner's report holds the question back for triage.
+	bank := s.QuestionBankFor(bob)
+	if got, err := bank.PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"}); err != nil || got != nil {
+		t.Errorf("PickQuestion after one report = %+v, %v; want none", got, err)
+	}
+	queue, err := bank.ReportedQuestions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || queue[0].Text != bad.Text || queue[0].Reports != 1 {
+		t.Fatalf("triage queue = %+v, want the question with 1 report", queue)
+	}
+
+	// Kept at triage: the queue empties and its reports start over.
+	if err := bank.TriageQuestion(ctx, queue[0].ID, false); err != nil {
+		t.Fatal(err)
+	}
+	if queue, _ := bank.ReportedQuestions(ctx); len(queue) != 0 {
+		t.Errorf("triage queue after keeping = %+v, want empty", queue)
+	}
+	if got, err := bank.PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"}); err != nil || got == nil {
+		t.Errorf("bob picked %+v, %v after keeping; want the question", got, err)
+	}
+	// Alice reported it, so she isn't served it again.
+	if got, err := s.QuestionBankFor(alice).PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"}); err != nil || got != nil {
+		t.Errorf("alice picked %+v, %v; want none", got, err)
+	}
+
+	// Enough different learners retire it without waiting for triage.
+	for i := range BankRetireReports {
+		report(testOwner(t, fmt.Sprintf("learner-%d", i)))
+	}
+	if got, err := bank.PickQuestion(ctx, BankQuery{SkillID: skill, Tier: "learn"}); err != nil || got != nil {
+		t.Errorf("PickQuestion after %d reports = %+v, %v; want none", BankRetireReports, got, err)
+	}
+
+	reports, err := s.EventRepoFor(alice).QueryQuestionReports(ctx, QueryOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 2 || reports[0].LLMRequestID != 42 || reports[0].Reason != "wrong answer" {
+		t.Errorf("alice's reports = %+v", reports)
+	}
+	if reports, _ := s.EventRepoFor(bob).QueryQuestionReports(ctx, QueryOpts{}); len(reports) != 0 {
+		t.Errorf("bob sees %d of alice's reports", len(reports))
+	}
+}
+
+func TestQuestionBank_ConcurrentReports(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	skill := testOwner(t, "skill")
+
+	bad := bankQuestion(skill, "What is 2 + 2? (reported all at once)", 2)
+	if err := s.QuestionBankFor(LocalOwner).SaveQuestion(ctx, bad); err != nil {
+		t.Fatal(err)
+	}
+
+	learners := BankRetireReports + 1
+	errs := make(chan error, learners)
+	for i := range learners {
+		repo := s.EventRepoFor(testOwner(t, fmt.Sprintf("learner-%d", i)))
+		go func() {
+			errs <- repo.AppendQuestionReport(ctx, QuestionReportData{
+				SessionID: "s1", SkillID: skill, Tier: "learn", QuestionText: bad.Text,
+				Answer: "4", Reporter: ReporterLearner,
+			})
+		}()
+	}
+	for range learners {
+		if err := <-errs; err != nil {
+			t.Fatalf("AppendQuestionReport: %v", err)
+		}
+	}
+
+	row, err := s.Client().BankQuestion.Query().Where(bankquestion.Text(bad.Text)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.Reports != learners || !row.Retired {
+		t.Errorf("reports = %d, retired = %v; want %d, retired: a concurrent report was lost",
+			row.Reports, row.Retired, learners)
+	}
+}
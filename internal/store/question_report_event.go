@@ -0,0 +1,143 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/abhisek/mathiz/ent"
+	"github.com/abhisek/mathiz/ent/bankquestion"
+	"github.com/abhisek/mathiz/ent/questionreportevent"
+)
+
+func (r *eventRepo) AppendQuestionReport(ctx context.Context, data QuestionReportData) error {
+	ctx = r.scope(ctx)
+	if reason := []rune(data.Reason); len(reason) > MaxReportReason {
+		data.Reason = string(reason[:MaxReportReason])
+	}
+	seqNum, err := r.seq.Next(ctx)
+	if err != nil {
+		return fmt.Errorf("next sequence: %w", err)
+	}
+
+	_, err = r.client.QuestionReportEvent.Create().
+		SetSequence(seqNum).
+		SetOwnerID(r.owner).
+		SetSessionID(data.SessionID).
+		SetSkillID(data.SkillID).
+		SetTier(data.Tier).
+		SetQuestionText(data.QuestionText).
+		SetAnswer(data.Answer).
+		SetFormat(data.Format).
+		SetChoices(data.Choices).
+		SetSource(data.Source).
+		SetLlmRequestID(data.LLMRequestID).
+		SetLlmOwner(data.LLMOwner).
+		SetLearnerAnswer(data.LearnerAnswer).
+		SetReporter(data.Reporter).
+		SetReason(data.Reason).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("save question report event: %w", err)
+	}
+
+	if err := r.queueForTriage(ctx, data.SkillID, data.QuestionText); err != nil {
+		return fmt.Errorf("queue reported question: %w", err)
+	}
+	return nil
+}
+
+// queueForTriageRetries bounds how often queueForTriage re-reads a bank
+// question that other learners reported at the same time.
+const queueForTriageRetries = 5
+
+// queueForTriage adds the reporting learner to a banked question's
+// reporters. One child's report only queues the question for review; it is
+// retired outright once BankRetireReports different learners report it.
+// Questions not in the bank are ignored.
+//
+// Learners of a shared bank can report the same question concurrently, so
+// the reporters are written back only if the report count is still the one
+// read; a lost race re-reads the question and tries again.
+func (r *eventRepo) queueForTriage(ctx context.Context, skillID, text string) error {
+	for range queueForTriageRetries {
+		row, err := r.client.BankQuestion.Query().
+			Where(bankquestion.SkillID(skillID), bankquestion.Text(text)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if slices.Contains(row.ReportedBy, r.owner) {
+			return nil
+		}
+		reporters := append(slices.Clone(row.ReportedBy), r.owner)
+		upd := r.client.BankQuestion.Update().
+			Where(bankquestion.ID(row.ID), bankquestion.Reports(row.Reports)).
+			SetReportedBy(reporters).
+			SetReports(len(reporters))
+		if len(reporters) >= BankRetireReports {
+			upd = upd.SetRetired(true)
+		}
+		n, err := upd.Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("bank question still contended after %d attempts", queueForTriageRetries)
+}
+
+func (r *eventRepo) QueryQuestionReports(ctx context.Context, opts QueryOpts) ([]QuestionReportRecord, error) {
+	ctx = r.scope(ctx)
+	query := r.client.QuestionReportEvent.Query().
+		Where(questionreportevent.OwnerID(r.owner)).
+		Order(ent.Desc(questionreportevent.FieldSequence))
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.After > 0 {
+		query = query.Where(questionreportevent.SequenceGT(opts.After))
+	}
+	if opts.Before > 0 {
+		query = query.Where(questionreportevent.SequenceLT(opts.Before))
+	}
+	if !opts.From.IsZero() {
+		query = query.Where(questionreportevent.TimestampGTE(opts.From))
+	}
+	if !opts.To.IsZero() {
+		query = query.Where(questionreportevent.TimestampLTE(opts.To))
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query question report events: %w", err)
+	}
+
+	records := make([]QuestionReportRecord, len(events))
+	for i, e := range events {
+		records[i] = QuestionReportRecord{
+			Sequence:      e.Sequence,
+			Timestamp:     e.Timestamp,
+			SessionID:     e.SessionID,
+			SkillID:       e.SkillID,
+			Tier:          e.Tier,
+			QuestionText:  e.QuestionText,
+			Answer:        e.Answer,
+			Format:        e.Format,
+			Choices:       e.Choices,
+			Source:        e.Source,
+			LLMRequestID:  e.LlmRequestID,
+			LLMOwner:      e.LlmOwner,
+			LearnerAnswer: e.LearnerAnswer,
+			Reporter:      e.Reporter,
+			Reason:        e.Reason,
+		}
+	}
+	return records, nil
+}
@@ -13,12 +13,16 @@ type QueryOpts struct {
 	Before int64     // sequence < Before
 	From   time.Time // timestamp >= From
 	To     time.Time // timestamp <= To
+
+	// SkillIDs limits answer queries to these skills (nil = all).
+	SkillIDs []string
 }
 
 // SnapshotData captures the full learner state at a point in time.
 // Domain modules register their state types here as they are implemented.
 type SnapshotData struct {
 	Version        int                    `json:"version"`
+	GraphVersion   int                    `json:"graph_version,omitempty"` // skill graph version of the skill IDs; 0 predates versioning
 	Mastery        *MasterySnapshotData   `json:"mastery,omitempty"`
 	SpacedRep      *SpacedRepSnapshotData `json:"spaced_rep,omitempty"`
 	LearnerProfile *LearnerProfileData    `json:"learner_profile,omitempty"`
@@ -60,15 +64,17 @@ type SkillMasteryData struct {
 	SpeedWindow          int       `json:"speed_window"`
 	Streak               int       `json:"streak"`
 	StreakCap            int       `json:"streak_cap"`
+	RecentResults        []bool    `json:"recent_results,omitempty"`
 	MasteredAt           *string   `json:"mastered_at,omitempty"`
 	RustyAt              *string   `json:"rusty_at,omitempty"`
 	MisconceptionPenalty int       `json:"misconception_penalty,omitempty"`
+	PKnown               float64   `json:"p_known,omitempty"`
 }
 
 // TierProgressData is the serialized form of tier progress for a skill.
 type TierProgressData struct {
 	SkillID       string `json:"skill_id"`
-	CurrentTier   string `json:"current_tier"` // "learn" or "prove"
+	CurrentTier   string `json:"current_tier"` // "learn", "prove" or "challenge"
 	TotalAttempts int    `json:"total_attempts"`
 	CorrectCount  int    `json:"correct_count"`
 }
@@ -157,6 +163,9 @@ type SessionEventData struct {
 	CorrectAnswers  int
 	DurationSecs    int
 	PlanSummary     []PlanSlotSummaryData
+	// QuestionSources counts answered questions per generator source
+	// ("llm", "offline"); set on the "end" event.
+	QuestionSources map[string]int
 	// QuestUID/QuestName attribute a quest session to its parent quest
 	// (set on the "start" event of quest expeditions only; empty for map
 	// digs). The name is denormalized as-of-play, deliberately: events
@@ -185,6 +194,9 @@ type AnswerEventData struct {
 	Correct       bool
 	TimeMs        int
 	AnswerFormat  string
+	Source        string // generator that produced the question
+	LLMRequestID  int    // 0 when the question has no logged LLM request
+	LLMOwner      string // learner whose LLM log holds LLMRequestID; empty for this one
 }
 
 // MasteryEventData captures the data for a mastery state transition event.
@@ -205,6 +217,52 @@ type HintEventData struct {
 	HintText     string
 }
 
+// Question reporters.
+const (
+	ReporterLearner = "learner"
+	ReporterParent  = "parent"
+)
+
+// MaxReportReason caps the free-text reason stored with a question report,
+// in runes; longer reasons are truncated.
+const MaxReportReason = 500
+
+// QuestionReportData records a question flagged as wrong or confusing.
+type QuestionReportData struct {
+	SessionID     string
+	SkillID       string
+	Tier          string
+	QuestionText  string
+	Answer        string
+	Format        string
+	Choices       []string
+	Source        string
+	LLMRequestID  int    // 0 when the question has no logged LLM request
+	LLMOwner      string // learner whose LLM log holds LLMRequestID; empty for this one
+	LearnerAnswer string // empty when reported before answering
+	Reporter      string // ReporterLearner or ReporterParent
+	Reason        string
+}
+
+// QuestionReportRecord is a hydrated question report for triage.
+type QuestionReportRecord struct {
+	Sequence      int64
+	Timestamp     time.Time
+	SessionID     string
+	SkillID       string
+	Tier          string
+	QuestionText  string
+	Answer        string
+	Format        string
+	Choices       []string
+	Source        string
+	LLMRequestID  int
+	LLMOwner      string
+	LearnerAnswer string
+	Reporter      string
+	Reason        string
+}
+
 // LessonEventData records that a micro-lesson was generated and shown.
 // Content fields carry the full lesson so past tips can be revisited.
 type LessonEventData struct {
@@ -319,6 +377,7 @@ type SessionSummaryRecord struct {
 	Plan            []PlanSlotSummaryData // plan from the matching "start" event
 	QuestUID        string                // quest attribution from the "start" event ("" = not a quest)
 	QuestName       string                // quest name as-of-play from the "start" event
+	QuestionSources map[string]int        // answered questions per generator source
 }
 
 // MasteryEventRecord is a hydrated mastery transition event for display.
@@ -347,6 +406,9 @@ type AnswerEventRecord struct {
 	Correct       bool
 	TimeMs        int
 	AnswerFormat  string
+	Source        string
+	LLMRequestID  int
+	LLMOwner      string
 }
 
 // GemsSnapshotData holds aggregate gem counts for quick loading.
@@ -357,8 +419,8 @@ type GemsSnapshotData struct {
 
 // EventRepo provides append access to domain events.
 type EventRepo interface {
-	// AppendLLMRequest records an LLM API call event.
-	AppendLLMRequest(ctx context.Context, data LLMRequestEventData) error
+	// AppendLLMRequest records an LLM API call event and returns its ID.
+	AppendLLMRequest(ctx context.Context, data LLMRequestEventData) (int, error)
 
 	// AppendSessionEvent records a session lifecycle event (start/end).
 	AppendSessionEvent(ctx context.Context, data SessionEventData) error
@@ -387,6 +449,14 @@ type EventRepo interface {
 	// AppendHintEvent records that a hint was shown.
 	AppendHintEvent(ctx context.Context, data HintEventData) error
 
+	// AppendQuestionReport records a reported question and queues it for
+	// triage in the question bank (see BankRetireReports).
+	AppendQuestionReport(ctx context.Context, data QuestionReportData) error
+
+	// QueryQuestionReports returns question reports matching the query
+	// options, newest first.
+	QueryQuestionReports(ctx context.Context, opts QueryOpts) ([]QuestionReportRecord, error)
+
 	// AppendLessonEvent records that a micro-lesson was shown.
 	AppendLessonEvent(ctx context.Context, data LessonEventData) error
 
@@ -421,6 +491,10 @@ type EventRepo interface {
 	// (question order).
 	AnswersForSession(ctx context.Context, sessionID string) ([]AnswerEventRecord, error)
 
+	// AnswerHistory returns answer events matching the query options, oldest
+	// first, for replaying a learner's history.
+	AnswerHistory(ctx context.Context, opts QueryOpts) ([]AnswerEventRecord, error)
+
 	// HintCountForSession returns how many hints were shown in a session.
 	HintCountForSession(ctx context.Context, sessionID string) (int, error)
 
@@ -436,3 +510,82 @@ type EventRepo interface {
 	// LLMUsageByModel returns aggregated token usage grouped by model.
 	LLMUsageByModel(ctx context.Context) ([]LLMModelUsage, error)
 }
+
+// BankQuestionData is a validated generated question stored in the question
+// bank for reuse.
+type BankQuestionData struct {
+	SkillID     string
+	Tier        string // "learn", "prove" or "challenge"
+	Difficulty  int
+	Text        string
+	Answer      string
+	AnswerType  string
+	Format      string
+	Choices     []string
+	Diagram     string // JSON-encoded, empty for none
+	Hint        string
+	Explanation string
+	Source      string // generator that produced it, e.g. "llm"
+	Locale      string // language the question is written in; empty for "en"
+
+	// LLMRequestID is the logged request that generated the question, 0
+	// if none. It lives in LLMOwner's log, which SaveQuestion sets to the
+	// learner it was generated for.
+	LLMRequestID int
+	LLMOwner     string
+}
+
+// ReportedQuestion is a banked question waiting in the triage queue.
+type ReportedQuestion struct {
+	ID      int // bank row ID, for TriageQuestion
+	Reports int // learners who reported it
+	BankQuestionData
+}
+
+// BankQuery selects a question to reuse from the bank.
+type BankQuery struct {
+	SkillID string
+	Tier    string
+
+	// Locale selects questions written in that language; empty means "en".
+	Locale string
+
+	// MinDifficulty and MaxDifficulty bound the question's difficulty; zero
+	// leaves that side unbounded.
+	MinDifficulty int
+	MaxDifficulty int
+
+	// Exclude lists question texts not to serve (the session's own prior
+	// questions).
+	Exclude []string
+}
+
+// QuestionBankRepo stores generated questions for reuse. The bank is shared
+// by every learner; a repo is scoped to one learner only so that
+// PickQuestion can skip questions that learner has already answered.
+type QuestionBankRepo interface {
+	// SaveQuestion adds a question to the bank. Saving a question already
+	// banked for the same skill is a no-op.
+	SaveQuestion(ctx context.Context, data BankQuestionData) error
+
+	// PickQuestion returns a random unretired question matching the query
+	// that the learner has never answered, or nil if there is none.
+	PickQuestion(ctx context.Context, query BankQuery) (*BankQuestionData, error)
+
+	// RecordAnswer adds a graded answer to a banked question's statistics,
+	// retiring the question once enough learners have answered it and too
+	// few got it right. Questions not in the bank are ignored.
+	RecordAnswer(ctx context.Context, skillID, text string, correct bool) error
+
+	// ListQuestions returns every unretired banked question, ordered by
+	// skill ID.
+	ListQuestions(ctx context.Context) ([]BankQuestionData, error)
+
+	// ReportedQuestions returns the triage queue: unretired questions
+	// reported since they were last triaged, most reported first.
+	ReportedQuestions(ctx context.Context) ([]ReportedQuestion, error)
+
+	// TriageQuestion settles the reports on a banked question, either
+	// retiring it or clearing its reports and keeping it in the bank.
+	TriageQuestion(ctx context.Context, id int, retire bool) error
+}
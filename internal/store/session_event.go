@@ -40,6 +40,9 @@ func (r *eventRepo) AppendSessionEvent(ctx context.Context, data SessionEventDat
 	if len(planSummary) > 0 {
 		builder = builder.SetPlanSummary(planSummary)
 	}
+	if len(data.QuestionSources) > 0 {
+		builder = builder.SetQuestionSources(data.QuestionSources)
+	}
 
 	_, err = builder.Save(ctx)
 	if err != nil {
@@ -68,6 +71,9 @@ func (r *eventRepo) AppendAnswerEvent(ctx context.Context, data AnswerEventData)
 		SetCorrect(data.Correct).
 		SetTimeMs(data.TimeMs).
 		SetAnswerFormat(data.AnswerFormat).
+		SetSource(data.Source).
+		SetLlmRequestID(data.LLMRequestID).
+		SetLlmOwner(data.LLMOwner).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("save answer event: %w", err)
@@ -84,7 +90,42 @@ func (r *eventRepo) AnswersForSession(ctx context.Context, sessionID string) ([]
 	if err != nil {
 		return nil, fmt.Errorf("query session answers: %w", err)
 	}
+	return answerRecords(events), nil
+}
+
+func (r *eventRepo) AnswerHistory(ctx context.Context, opts QueryOpts) ([]AnswerEventRecord, error) {
+	ctx = r.scope(ctx)
+	query := r.client.AnswerEvent.Query().
+		Where(answerevent.OwnerID(r.owner)).
+		Order(ent.Asc(answerevent.FieldSequence))
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.After > 0 {
+		query = query.Where(answerevent.SequenceGT(opts.After))
+	}
+	if opts.Before > 0 {
+		query = query.Where(answerevent.SequenceLT(opts.Before))
+	}
+	if !opts.From.IsZero() {
+		query = query.Where(answerevent.TimestampGTE(opts.From))
+	}
+	if !opts.To.IsZero() {
+		query = query.Where(answerevent.TimestampLTE(opts.To))
+	}
+	if opts.SkillIDs != nil {
+		query = query.Where(answerevent.SkillIDIn(opts.SkillIDs...))
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query answer history: %w", err)
+	}
+	return answerRecords(events), nil
+}
 
+func answerRecords(events []*ent.AnswerEvent) []AnswerEventRecord {
 	records := make([]AnswerEventRecord, len(events))
 	for i, e := range events {
 		records[i] = AnswerEventRecord{
@@ -100,9 +141,12 @@ func (r *eventRepo) AnswersForSession(ctx context.Context, sessionID string) ([]
 			Correct:       e.Correct,
 			TimeMs:        e.TimeMs,
 			AnswerFormat:  e.AnswerFormat,
+			Source:        e.Source,
+			LLMRequestID:  e.LlmRequestID,
+			LLMOwner:      e.LlmOwner,
 		}
 	}
-	return records, nil
+	return records
 }
 
 func (r *eventRepo) LatestAnswerTime(ctx context.Context, skillID string) (time.Time, error) {
@@ -114,6 +114,27 @@ func (s *Store) EventRepoFor(owner string) EventRepo {
 	return &eventRepo{client: s.client, seq: s.seq, db: s.db, dialect: s.dialect, owner: owner}
 }
 
+// SnapshotOwners returns every owner with at least one snapshot, sorted.
+// It reads across learners, bypassing the owner guard, so it is for
+// operator tooling only; learner-facing reads go through SnapshotRepoFor.
+func (s *Store) SnapshotOwners(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT DISTINCT owner_id FROM snapshots ORDER BY owner_id")
+	if err != nil {
+		return nil, fmt.Errorf("query snapshot owners: %w", err)
+	}
+	defer rows.Close()
+
+	var owners []string
+	for rows.Next() {
+		var owner string
+		if err := rows.Scan(&owner); err != nil {
+			return nil, fmt.Errorf("scan snapshot owner: %w", err)
+		}
+		owners = append(owners, owner)
+	}
+	return owners, rows.Err()
+}
+
 // LocalOwner is the owner ID used by the local single-user CLI. It predates
 // multi-tenancy: rows written before the owner column existed default to "".
 const LocalOwner = ""
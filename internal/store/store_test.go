@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 )
@@ -238,3 +239,56 @@ func TestAutoMigrationCreatesTable(t *testing.T) {
 		t.Errorf("table name = %q, want 'snapshots'", name)
 	}
 }
+
+func TestSnapshotOwners(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	// The in-memory database is shared across tests, so only check for the
+	// owners saved here.
+	alice, bob := testOwner(t, "alice"), testOwner(t, "bob")
+	now := time.Now().UTC()
+	for i, owner := range []string{bob, alice, bob} {
+		snap := &Snapshot{Sequence: int64(i + 1), Timestamp: now, Data: SnapshotData{Version: 1}}
+		if err := s.SnapshotRepoFor(owner).Save(ctx, snap); err != nil {
+			t.Fatalf("save %q: %v", owner, err)
+		}
+	}
+
+	owners, err := s.SnapshotOwners(ctx)
+	if err != nil {
+		t.Fatalf("SnapshotOwners: %v", err)
+	}
+	ours := slices.DeleteFunc(owners, func(o string) bool { return o != alice && o != bob })
+	if want := []string{alice, bob}; !slices.Equal(ours, want) {
+		t.Errorf("owners = %q, want %q", ours, want)
+	}
+}
+
+func TestAnswerHistorySkillFilter(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	repo := s.EventRepoFor(testOwner(t, "alice"))
+	for _, skill := range []string{"add-1", "sub-1", "add-old"} {
+		if err := repo.AppendAnswerEvent(ctx, AnswerEventData{
+			SessionID: "sess", SkillID: skill, Tier: "learn", Category: "core",
+			QuestionText: "2+3?", CorrectAnswer: "5", LearnerAnswer: "5",
+			Correct: true, TimeMs: 900, AnswerFormat: "integer",
+		}); err != nil {
+			t.Fatalf("append %s: %v", skill, err)
+		}
+	}
+
+	answers, err := repo.AnswerHistory(ctx, QueryOpts{SkillIDs: []string{"add-1", "add-old"}})
+	if err != nil {
+		t.Fatalf("AnswerHistory: %v", err)
+	}
+	var got []string
+	for _, a := range answers {
+		got = append(got, a.SkillID)
+	}
+	if want := []string{"add-1", "add-old"}; !slices.Equal(got, want) {
+		t.Errorf("skills = %v, want %v", got, want)
+	}
+}
@@ -7,10 +7,13 @@
 package tutor
 
 import (
+	"time"
+
 	"github.com/abhisek/mathiz/internal/diagnosis"
 	"github.com/abhisek/mathiz/internal/lessons"
 	"github.com/abhisek/mathiz/internal/llm"
 	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/store"
 )
 
 // Toolset is the per-learner AI tooling. Every field except Generator is
@@ -23,11 +26,40 @@ type Toolset struct {
 }
 
 // New builds the standard toolset from an LLM provider with default configs.
-func New(provider llm.Provider) *Toolset {
+// Questions come from the LLM, with the offline generator as a fallback when
+// the provider fails or is slow. A non-nil judge provider adds a
+// problemgen.JudgeValidator to question validation.
+func New(provider, judge llm.Provider) *Toolset {
+	cfg := problemgen.DefaultConfig()
+	if judge != nil {
+		cfg.Validators = append(cfg.Validators, problemgen.NewJudge(judge))
+	}
 	return &Toolset{
-		Generator:  problemgen.New(provider, problemgen.DefaultConfig()),
+		Generator: problemgen.NewHybrid(
+			problemgen.New(provider, cfg),
+			problemgen.NewOffline(uint64(time.Now().UnixNano())),
+			problemgen.DefaultHybridConfig(),
+		),
 		Diagnosis:  diagnosis.NewService(provider),
 		Lessons:    lessons.NewService(provider, lessons.DefaultConfig()),
 		Compressor: lessons.NewCompressor(provider, lessons.DefaultCompressorConfig()),
 	}
 }
+
+// UseBank puts the shared question bank in front of the generator: banked
+// questions the learner hasn't answered are served before generating new
+// ones, and new ones are banked.
+func (t *Toolset) UseBank(bank store.QuestionBankRepo) {
+	t.Generator = problemgen.NewBanked(t.Generator, bank)
+}
+
+// Close stops the toolset's background work: pending diagnoses and question
+// prefetching.
+func (t *Toolset) Close() {
+	if t.Diagnosis != nil {
+		t.Diagnosis.Close()
+	}
+	if c, ok := t.Generator.(interface{ Close() }); ok {
+		c.Close()
+	}
+}
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/abhisek/mathiz/internal/i18n"
+	"github.com/abhisek/mathiz/internal/ui/theme"
+)
+
+// BlankInputs is a group of text inputs, one per blank in a
+// fill-in-the-blank question. Tab and the arrow keys move between blanks.
+type BlankInputs struct {
+	Inputs []TextInput
+	Focus  int
+}
+
+// NewBlankInputs creates n inputs with the first one focused.
+func NewBlankInputs(n, maxWidth int) BlankInputs {
+	b := BlankInputs{Inputs: make([]TextInput, n)}
+	for i := range b.Inputs {
+		b.Inputs[i] = NewTextInput("", false, maxWidth)
+		if i > 0 {
+			b.Inputs[i].Model.Blur()
+		}
+	}
+	return b
+}
+
+// Init returns the focus command for the first input.
+func (b BlankInputs) Init() tea.Cmd {
+	if len(b.Inputs) == 0 {
+		return nil
+	}
+	return b.Inputs[b.Focus].Init()
+}
+
+// Update switches focus on tab/shift+tab/up/down and forwards everything
+// else to the focused input.
+func (b BlankInputs) Update(msg tea.Msg) (BlankInputs, tea.Cmd) {
+	if len(b.Inputs) == 0 {
+		return b, nil
+	}
+
+	if kmsg, ok := msg.(tea.KeyMsg); ok {
+		switch kmsg.String() {
+		case "tab", "down":
+			return b, b.focus(b.Focus + 1)
+		case "shift+tab", "up":
+			return b, b.focus(b.Focus - 1)
+		}
+	}
+
+	var cmd tea.Cmd
+	b.Inputs[b.Focus], cmd = b.Inputs[b.Focus].Update(msg)
+	return b, cmd
+}
+
+// focus moves focus to blank i, wrapping around at either end.
+func (b *BlankInputs) focus(i int) tea.Cmd {
+	n := len(b.Inputs)
+	i = (i%n + n) % n
+	b.Inputs[b.Focus].Model.Blur()
+	b.Focus = i
+	return b.Inputs[i].Model.Focus()
+}
+
+// View renders one labelled line per blank.
+func (b BlankInputs) View() string {
+	lines := make([]string, len(b.Inputs))
+	for i, in := range b.Inputs {
+		labelStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
+		if i == b.Focus {
+			labelStyle = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+		}
+		lines[i] = labelStyle.Render(i18n.Sprintf("Blank %d", i+1)) + "  " + in.View()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Values returns the trimmed value of every blank.
+func (b BlankInputs) Values() []string {
+	out := make([]string, len(b.Inputs))
+	for i, in := range b.Inputs {
+		out[i] = strings.TrimSpace(in.Value())
+	}
+	return out
+}
+
+// Filled reports whether every blank has a value.
+func (b BlankInputs) Filled() bool {
+	for _, v := range b.Values() {
+		if v == "" {
+			return false
+		}
+	}
+	return len(b.Inputs) > 0
+}
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/abhisek/mathiz/internal/ui/theme"
+)
+
+// MultiSelect is a checklist where any number of options can be ticked.
+type MultiSelect struct {
+	Options []string
+	Checked []bool
+	Cursor  int
+}
+
+// NewMultiSelect creates a checklist with nothing ticked.
+func NewMultiSelect(options []string) MultiSelect {
+	return MultiSelect{
+		Options: options,
+		Checked: make([]bool, len(options)),
+	}
+}
+
+// Update handles navigation and toggling. Space toggles the option under
+// the cursor; number keys toggle an option directly.
+func (m MultiSelect) Update(msg tea.Msg) (MultiSelect, tea.Cmd) {
+	kmsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	key := kmsg.String()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(m.Options)-1 {
+			m.Cursor++
+		}
+	case "space", "x":
+		m.toggle(m.Cursor)
+	default:
+		if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+			idx := int(key[0] - '1')
+			if idx < len(m.Options) {
+				m.Cursor = idx
+				m.toggle(idx)
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *MultiSelect) toggle(i int) {
+	if i >= 0 && i < len(m.Checked) {
+		m.Checked[i] = !m.Checked[i]
+	}
+}
+
+// View renders the checklist.
+func (m MultiSelect) View() string {
+	lines := make([]string, len(m.Options))
+	for i, opt := range m.Options {
+		prefix := "  "
+		if i == m.Cursor {
+			prefix = "▸ "
+		}
+		box := "[ ]"
+		if m.Checked[i] {
+			box = "[x]"
+		}
+
+		style := lipgloss.NewStyle().Foreground(theme.Text)
+		switch {
+		case i == m.Cursor:
+			style = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+		case m.Checked[i]:
+			style = lipgloss.NewStyle().Foreground(theme.Accent)
+		}
+		lines[i] = style.Render(fmt.Sprintf("%s%s %d)  %s", prefix, box, i+1, opt))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Selected returns the ticked options in display order.
+func (m MultiSelect) Selected() []string {
+	var out []string
+	for i, opt := range m.Options {
+		if m.Checked[i] {
+			out = append(out, opt)
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/abhisek/mathiz/internal/ui/theme"
+)
+
+// OrderList lets the learner rearrange a list of items. Arrows move the
+// cursor; space picks up the item under the cursor so the arrows carry it
+// along until space drops it again.
+type OrderList struct {
+	Items  []string
+	Cursor int
+	Held   bool
+}
+
+// NewOrderList creates an order list over a copy of items.
+func NewOrderList(items []string) OrderList {
+	return OrderList{Items: append([]string(nil), items...)}
+}
+
+// Update handles keyboard navigation and reordering.
+func (o OrderList) Update(msg tea.Msg) (OrderList, tea.Cmd) {
+	kmsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+
+	switch kmsg.String() {
+	case "up", "k":
+		o.move(-1)
+	case "down", "j":
+		o.move(1)
+	case "space":
+		o.Held = !o.Held
+	}
+	return o, nil
+}
+
+// move shifts the cursor, carrying the held item with it.
+func (o *OrderList) move(delta int) {
+	next := o.Cursor + delta
+	if next < 0 || next >= len(o.Items) {
+		return
+	}
+	if o.Held {
+		o.Items[o.Cursor], o.Items[next] = o.Items[next], o.Items[o.Cursor]
+	}
+	o.Cursor = next
+}
+
+// View renders the list with the cursor and held item highlighted.
+func (o OrderList) View() string {
+	lines := make([]string, len(o.Items))
+	for i, item := range o.Items {
+		prefix := "  "
+		style := lipgloss.NewStyle().Foreground(theme.Text)
+		if i == o.Cursor {
+			prefix = "▸ "
+			style = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+			if o.Held {
+				prefix = "⇅ "
+				style = lipgloss.NewStyle().Foreground(theme.Accent).Bold(true)
+			}
+		}
+		lines[i] = style.Render(fmt.Sprintf("%s%d.  %s", prefix, i+1, item))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Values returns the items in their current order.
+func (o OrderList) Values() []string {
+	return append([]string(nil), o.Items...)
+}
@@ -0,0 +1,345 @@
+// Package diagram draws question diagrams (number lines, fraction bars,
+// arrays, area models, clocks) as terminal text with Lip Gloss.
+package diagram
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+	"github.com/abhisek/mathiz/internal/ui/theme"
+)
+
+// maxWidth is the widest a diagram is drawn, so it fits the question panel.
+const maxWidth = 56
+
+// Render draws d as a block of lines, or "" for a nil or undrawable
+// diagram. Callers center the block themselves.
+func Render(d *problemgen.Diagram) string {
+	c := draw(d)
+	if c == nil {
+		return ""
+	}
+	return c.String()
+}
+
+// draw lays d out on a canvas.
+func draw(d *problemgen.Diagram) *canvas {
+	if d == nil || d.Check() != "" {
+		return nil
+	}
+	switch d.Kind {
+	case problemgen.DiagramNumberLine:
+		return numberLine(d)
+	case problemgen.DiagramFractionBar:
+		return fractionBar(d)
+	case problemgen.DiagramArray:
+		return array(d)
+	case problemgen.DiagramAreaModel:
+		return areaModel(d)
+	case problemgen.DiagramClock:
+		return clock(d)
+	}
+	return nil
+}
+
+// numberLine draws dots above a ruled line with labelled ticks below:
+//
+//	        ●
+//	├───┼───┼───┤
+//	0           1
+func numberLine(d *problemgen.Diagram) *canvas {
+	ticks := d.Ticks()
+	labelWidth := 1
+	for _, t := range ticks {
+		labelWidth = max(labelWidth, len(t.Label))
+	}
+	step := max(3, labelWidth+1)
+	if d.Intervals*step > maxWidth {
+		step = max(2, maxWidth/d.Intervals)
+	}
+	length := d.Intervals * step
+	pad := labelWidth / 2
+
+	c := newCanvas(length+1+2*pad, 3)
+	col := func(pos float64) int { return pad + int(math.Round(pos*float64(length))) }
+
+	for x := 0; x <= length; x++ {
+		c.set(pad+x, 1, '─', theme.TextDim)
+	}
+	for i, t := range ticks {
+		r := '┼'
+		switch i {
+		case 0:
+			r = '├'
+		case len(ticks) - 1:
+			r = '┤'
+		}
+		c.set(col(t.Pos), 1, r, theme.TextDim)
+	}
+	for _, p := range d.Points {
+		c.set(col(d.PointPos(p)), 0, '●', theme.Accent)
+	}
+
+	// Endpoints first so they always get their labels; an inner label that
+	// would run into a placed one is dropped.
+	order := make([]int, 0, len(ticks))
+	order = append(order, 0, len(ticks)-1)
+	for i := 1; i < len(ticks)-1; i++ {
+		order = append(order, i)
+	}
+	taken := make([]bool, c.width)
+	for _, i := range order {
+		label := ticks[i].Label
+		if label == "" {
+			continue
+		}
+		start := min(max(col(ticks[i].Pos)-len(label)/2, 0), c.width-len(label))
+		free := true
+		for x := max(start-1, 0); x < min(start+len(label)+1, c.width); x++ {
+			free = free && !taken[x]
+		}
+		if !free {
+			continue
+		}
+		c.text(start, 2, label, theme.Text)
+		for x := start; x < start+len(label); x++ {
+			taken[x] = true
+		}
+	}
+	return c
+}
+
+// fractionBar draws a boxed bar with the shaded parts filled:
+//
+//	┌───┬───┬───┐
+//	│███│   │   │
+//	└───┴───┴───┘
+func fractionBar(d *problemgen.Diagram) *canvas {
+	cell := min(6, max(3, (maxWidth-1)/d.Parts-1))
+	c := newCanvas(d.Parts*(cell+1)+1, 4)
+	w, h := d.Parts*(cell+1), 3
+	for y := 0; y <= h; y++ {
+		for x := 0; x <= w; x++ {
+			vert, horiz := x%(cell+1) == 0, y == 0 || y == h
+			switch {
+			case vert || horiz:
+				c.set(x, y, boxJoin(x, y, w, h, vert, horiz), theme.Border)
+			case x/(cell+1) < d.Shaded:
+				c.set(x, y, '█', theme.Primary)
+			}
+		}
+	}
+	return c
+}
+
+// array draws Rows rows of Cols dots.
+func array(d *problemgen.Diagram) *canvas {
+	c := newCanvas(2*d.Cols-1, d.Rows)
+	for y := range d.Rows {
+		for x := range d.Cols {
+			c.set(2*x, y, '●', theme.Secondary)
+		}
+	}
+	return c
+}
+
+// areaModel draws a grid with the column parts above and the row parts to
+// the left; the cells are left empty for the learner's partial products:
+//
+//	    20    3
+//	  ┌─────┬─────┐
+//	4 │     │     │
+//	  │     │     │
+//	  └─────┴─────┘
+func areaModel(d *problemgen.Diagram) *canvas {
+	rowLabels := make([]string, len(d.RowParts))
+	labelWidth := 0
+	for i, p := range d.RowParts {
+		rowLabels[i] = strconv.Itoa(p)
+		labelWidth = max(labelWidth, len(rowLabels[i]))
+	}
+	cell := 5
+	for _, p := range d.ColParts {
+		cell = max(cell, len(strconv.Itoa(p))+2)
+	}
+	const rowHeight = 2
+
+	left := labelWidth + 1
+	width := left + len(d.ColParts)*(cell+1) + 1
+	height := 1 + len(d.RowParts)*(rowHeight+1) + 1
+	c := newCanvas(width, height)
+
+	for i, p := range d.ColParts {
+		label := strconv.Itoa(p)
+		c.text(left+i*(cell+1)+1+(cell-len(label))/2, 0, label, theme.Text)
+	}
+	for j, label := range rowLabels {
+		c.text(labelWidth-len(label), 1+j*(rowHeight+1)+1, label, theme.Text)
+	}
+
+	// Grid lines: a line every cell+1 columns and every rowHeight+1 rows.
+	gridW, gridH := len(d.ColParts)*(cell+1), len(d.RowParts)*(rowHeight+1)
+	for y := 0; y <= gridH; y++ {
+		for x := 0; x <= gridW; x++ {
+			vert, horiz := x%(cell+1) == 0, y%(rowHeight+1) == 0
+			if !vert && !horiz {
+				continue
+			}
+			c.set(left+x, 1+y, boxJoin(x, y, gridW, gridH, vert, horiz), theme.Border)
+		}
+	}
+	return c
+}
+
+// boxJoin picks the box-drawing rune for a grid point.
+func boxJoin(x, y, w, h int, vert, horiz bool) rune {
+	switch {
+	case !horiz:
+		return '│'
+	case !vert:
+		return '─'
+	}
+	top, bottom, left, right := y == 0, y == h, x == 0, x == w
+	switch {
+	case top && left:
+		return '┌'
+	case top && right:
+		return '┐'
+	case bottom && left:
+		return '└'
+	case bottom && right:
+		return '┘'
+	case top:
+		return '┬'
+	case bottom:
+		return '┴'
+	case left:
+		return '├'
+	case right:
+		return '┤'
+	}
+	return '┼'
+}
+
+// clockRadius is the clock face's radius in rows; columns are scaled by
+// two because terminal cells are about twice as tall as they are wide.
+const clockRadius = 6
+
+// clock draws an analog clock face with all twelve numbers, a long minute
+// hand and a short hour hand in a second color.
+func clock(d *problemgen.Diagram) *canvas {
+	r := float64(clockRadius)
+	cx, cy := 2*clockRadius+1, clockRadius
+	c := newCanvas(2*cx+1, 2*clockRadius+1)
+	at := func(angle, dist float64) (int, int) {
+		rad := angle * math.Pi / 180
+		return cx + int(math.Round(2*dist*math.Sin(rad))), cy - int(math.Round(dist*math.Cos(rad)))
+	}
+
+	for a := 0.0; a < 360; a += 3 {
+		x, y := at(a, r)
+		c.set(x, y, '·', theme.Border)
+	}
+	for h := 1; h <= 12; h++ {
+		x, y := at(float64(h)*30, r-1.4)
+		label := strconv.Itoa(h)
+		c.text(x-len(label)/2, y, label, theme.Text)
+	}
+
+	hand := func(angle, length float64, col color.Color) {
+		ch := handRune(angle)
+		for dist := 0.5; dist <= length; dist += 0.25 {
+			x, y := at(angle, dist)
+			c.set(x, y, ch, col)
+		}
+	}
+	minuteAngle := float64(d.Minute) * 6
+	hourAngle := float64(d.Hour%12)*30 + float64(d.Minute)/2
+	hand(minuteAngle, r-2.5, theme.Primary)
+	hand(hourAngle, r-3.8, theme.Accent)
+	c.set(cx, cy, '●', theme.Text)
+	return c
+}
+
+// handRune picks the line character closest to a clock hand's direction.
+func handRune(angle float64) rune {
+	a := math.Mod(angle, 180)
+	switch {
+	case a < 22.5 || a >= 157.5:
+		return '│'
+	case a < 67.5:
+		return '╱'
+	case a < 112.5:
+		return '─'
+	default:
+		return '╲'
+	}
+}
+
+// canvas is a grid of colored runes.
+type canvas struct {
+	width, height int
+	cells         [][]rune
+	colors        [][]color.Color
+}
+
+func newCanvas(width, height int) *canvas {
+	c := &canvas{width: width, height: height}
+	c.cells = make([][]rune, height)
+	c.colors = make([][]color.Color, height)
+	for y := range height {
+		c.cells[y] = []rune(strings.Repeat(" ", width))
+		c.colors[y] = make([]color.Color, width)
+	}
+	return c
+}
+
+func (c *canvas) set(x, y int, r rune, col color.Color) {
+	if x < 0 || y < 0 || x >= c.width || y >= c.height {
+		return
+	}
+	c.cells[y][x] = r
+	c.colors[y][x] = col
+}
+
+func (c *canvas) text(x, y int, s string, col color.Color) {
+	for i, r := range []rune(s) {
+		c.set(x+i, y, r, col)
+	}
+}
+
+// plain returns the canvas without color, trailing spaces trimmed.
+func (c *canvas) plain() string {
+	lines := make([]string, c.height)
+	for y, row := range c.cells {
+		lines[y] = strings.TrimRight(string(row), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String renders the canvas, styling each run of same-colored runes.
+func (c *canvas) String() string {
+	lines := make([]string, c.height)
+	for y, row := range c.cells {
+		var b strings.Builder
+		for x := 0; x < c.width; {
+			end := x + 1
+			for end < c.width && c.colors[y][end] == c.colors[y][x] {
+				end++
+			}
+			run := string(row[x:end])
+			if col := c.colors[y][x]; col != nil {
+				run = lipgloss.NewStyle().Foreground(col).Render(run)
+			}
+			b.WriteString(run)
+			x = end
+		}
+		lines[y] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
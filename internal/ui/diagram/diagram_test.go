@@ -0,0 +1,82 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abhisek/mathiz/internal/problemgen"
+)
+
+func TestNumberLine(t *testing.T) {
+	d := &problemgen.Diagram{Kind: problemgen.DiagramNumberLine, Min: 0, Max: 1, Intervals: 4, Points: []float64{0.75}}
+	got := draw(d).plain()
+	want := "         ●\n├──┼──┼──┼──┤\n0           1"
+	if got != want {
+		t.Errorf("number line:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Labelled ticks on a line past 1 read as mixed numbers.
+	d = &problemgen.Diagram{Kind: problemgen.DiagramNumberLine, Min: 0, Max: 2, Intervals: 8, LabelTicks: true}
+	if got := draw(d).plain(); !strings.Contains(got, "1 1/4") || !strings.Contains(got, "3/4") {
+		t.Errorf("labelled ticks:\n%s", got)
+	}
+}
+
+func TestFractionBar(t *testing.T) {
+	d := &problemgen.Diagram{Kind: problemgen.DiagramFractionBar, Parts: 3, Shaded: 1}
+	lines := strings.Split(draw(d).plain(), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want 4", len(lines))
+	}
+	if got := strings.Count(lines[1], "██████"); got != 1 {
+		t.Errorf("shaded parts = %d, want 1: %q", got, lines[1])
+	}
+	if got := strings.Count(lines[0], "┬"); got != 2 {
+		t.Errorf("dividers = %d, want 2: %q", got, lines[0])
+	}
+}
+
+func TestArray(t *testing.T) {
+	d := &problemgen.Diagram{Kind: problemgen.DiagramArray, Rows: 2, Cols: 3}
+	if got, want := draw(d).plain(), "● ● ●\n● ● ●"; got != want {
+		t.Errorf("array:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestAreaModel(t *testing.T) {
+	d := &problemgen.Diagram{Kind: problemgen.DiagramAreaModel, RowParts: []int{4}, ColParts: []int{20, 3}}
+	want := strings.Join([]string{
+		"    20     3",
+		"  ┌─────┬─────┐",
+		"4 │     │     │",
+		"  │     │     │",
+		"  └─────┴─────┘",
+	}, "\n")
+	if got := draw(d).plain(); got != want {
+		t.Errorf("area model:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestClock(t *testing.T) {
+	d := &problemgen.Diagram{Kind: problemgen.DiagramClock, Hour: 3, Minute: 45}
+	got := draw(d).plain()
+	for _, n := range []string{"12", "11", "10", "9", "6", "3"} {
+		if !strings.Contains(got, n) {
+			t.Errorf("clock face is missing %s:\n%s", n, got)
+		}
+	}
+	// The minute hand points at the 9: a horizontal run left of center.
+	lines := strings.Split(got, "\n")
+	if center := lines[clockRadius]; !strings.Contains(center, "───●") {
+		t.Errorf("minute hand not at 9: %q", center)
+	}
+}
+
+func TestRender_Undrawable(t *testing.T) {
+	if Render(nil) != "" {
+		t.Error("nil diagram rendered")
+	}
+	if Render(&problemgen.Diagram{Kind: problemgen.DiagramClock, Hour: 13}) != "" {
+		t.Error("invalid clock rendered")
+	}
+}
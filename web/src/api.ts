@@ -22,10 +22,39 @@ export interface FamilySpace {
   createdAt: string
 }
 
+// Curriculum standard whose skill codes a child sees.
+export type Standard = 'ccss' | 'uk-nc' | 'cbse'
+
+export const STANDARDS: { id: Standard; name: string }[] = [
+  { id: 'ccss', name: 'Common Core' },
+  { id: 'uk-nc', name: 'UK National Curriculum' },
+  { id: 'cbse', name: 'CBSE' },
+]
+
+// Language a child's questions and feedback are written in.
+export type Locale = 'en' | 'es' | 'fr' | 'de'
+
+export const LOCALES: { id: Locale; name: string }[] = [
+  { id: 'en', name: 'English' },
+  { id: 'es', name: 'Español' },
+  { id: 'fr', name: 'Français' },
+  { id: 'de', name: 'Deutsch' },
+]
+
+// School grades a child profile can take; 0 is kindergarten.
+export const GRADES = [0, 1, 2, 3, 4, 5, 6]
+
+// gradeLabel renders a grade number, showing kindergarten as "K".
+export function gradeLabel(grade: number): string {
+  return grade === 0 ? 'K' : String(grade)
+}
+
 export interface ChildProfile {
   id: string
   name: string
   grade: number
+  standard: Standard
+  locale: Locale
   hasPin: boolean
   archived: boolean
   createdAt: string
@@ -91,6 +120,17 @@ export interface SkillStat {
   state: string
   accuracy: number
   attempts: number
+  // From the skill's answer history; absent for skills never answered.
+  fluency?: SkillFluency
+}
+
+export interface SkillFluency {
+  p25Ms: number
+  p50Ms: number
+  p90Ms: number
+  trend: 'improving' | 'stable' | 'declining' | 'unknown'
+  // Latest practice days, oldest first; day is YYYY-MM-DD.
+  accuracyByDay: { day: string; answers: number; correct: number }[]
 }
 
 export interface SessionStat {
@@ -135,6 +175,8 @@ export interface CurriculumSkill {
   id: string
   name: string
   grade: number
+  // Code in the requested standard (?standard=), e.g. "3.NF.A.1".
+  code?: string
   prereqs: string[]
   // Search aliases (e.g. "GCF" for HCF & LCM). Optional: older servers
   // don't send it, so consumers must treat it as possibly absent.
@@ -183,7 +225,7 @@ export interface QuestQuestion {
   text: string
   answer: string
   answerType: string
-  format: 'numeric' | 'multiple_choice'
+  format: 'numeric' | 'multiple_choice' | 'true_false' | 'ordering' | 'multi_select' | 'multi_blank'
   choices?: string[]
   hint?: string
   explanation?: string
@@ -384,12 +426,33 @@ export const api = {
     request<FamilySpace>('PATCH', `/api/v1/family/${familyId}`, token, { name }),
   listChildren: (token: string, familyId: string) =>
     request<{ children: ChildWithSummary[] }>('GET', `/api/v1/family/${familyId}/children`, token),
-  addChild: (token: string, familyId: string, name: string, grade: number, pin: string) =>
-    request<ChildProfile>('POST', `/api/v1/family/${familyId}/children`, token, { name, grade, pin }),
+  addChild: (
+    token: string,
+    familyId: string,
+    name: string,
+    grade: number,
+    pin: string,
+    standard: Standard,
+    locale: Locale,
+  ) =>
+    request<ChildProfile>('POST', `/api/v1/family/${familyId}/children`, token, {
+      name,
+      grade,
+      pin,
+      standard,
+      locale,
+    }),
   updateChild: (
     token: string,
     childId: string,
-    patch: Partial<{ name: string; grade: number; pin: string; archived: boolean }>,
+    patch: Partial<{
+      name: string
+      grade: number
+      standard: Standard
+      locale: Locale
+      pin: string
+      archived: boolean
+    }>,
   ) => request<ChildProfile>('PATCH', `/api/v1/children/${childId}`, token, patch),
   childStats: (token: string, childId: string) =>
     request<ChildStats>('GET', `/api/v1/children/${childId}/stats`, token),
@@ -453,6 +516,19 @@ export const api = {
       `/api/v1/children/${childId}/activity/sessions/${sessionId}`,
       token,
     ),
+  reportActivityAnswer: (
+    token: string,
+    childId: string,
+    sessionId: string,
+    seq: number,
+    reason: string,
+  ) =>
+    request<void>(
+      'POST',
+      `/api/v1/children/${childId}/activity/sessions/${sessionId}/report`,
+      token,
+      { seq, reason },
+    ),
   listDevices: (token: string, childId: string) =>
     request<{ devices: Device[] }>('GET', `/api/v1/children/${childId}/devices`, token),
   revokeDevice: (token: string, deviceId: string) =>
@@ -0,0 +1,193 @@
+import type { Diagram } from '../game'
+
+// QuestionDiagram draws a question's picture (number line, fraction bar,
+// array, area model or clock) as SVG. The labels are generic on purpose:
+// spelling out "clock showing 3:45" would read the answer aloud.
+export default function QuestionDiagram({ diagram }: { diagram: Diagram }) {
+  switch (diagram.kind) {
+    case 'number_line':
+      return <NumberLine diagram={diagram} />
+    case 'fraction_bar':
+      return <FractionBar diagram={diagram} />
+    case 'array':
+      return <DotArray diagram={diagram} />
+    case 'area_model':
+      return <AreaModel diagram={diagram} />
+    case 'clock':
+      return <Clock diagram={diagram} />
+  }
+}
+
+function NumberLine({ diagram }: { diagram: Diagram }) {
+  const w = 360
+  const pad = 24
+  const y = 34
+  const x = (pos: number) => pad + pos * (w - 2 * pad)
+  return (
+    <svg className="diagram" viewBox={`0 0 ${w} 64`} role="img" aria-label="Number line">
+      <line x1={pad} y1={y} x2={w - pad} y2={y} className="diagram-line" />
+      {(diagram.ticks ?? []).map((t, i) => (
+        <g key={i}>
+          <line x1={x(t.pos)} y1={y - 7} x2={x(t.pos)} y2={y + 7} className="diagram-line" />
+          {t.label && (
+            <text x={x(t.pos)} y={y + 24} textAnchor="middle" className="diagram-label">
+              {t.label}
+            </text>
+          )}
+        </g>
+      ))}
+      {(diagram.points ?? []).map((p, i) => (
+        <circle key={i} cx={x(p)} cy={y} r={6} className="diagram-point" />
+      ))}
+    </svg>
+  )
+}
+
+function FractionBar({ diagram }: { diagram: Diagram }) {
+  const parts = diagram.parts ?? 1
+  const shaded = diagram.shaded ?? 0
+  const w = 320
+  const cell = w / parts
+  return (
+    <svg className="diagram" viewBox={`-2 -2 ${w + 4} 52`} role="img" aria-label="Fraction bar">
+      {Array.from({ length: parts }, (_, i) => (
+        <rect
+          key={i}
+          x={i * cell}
+          y={0}
+          width={cell}
+          height={48}
+          className={i < shaded ? 'diagram-fill' : 'diagram-cell'}
+        />
+      ))}
+    </svg>
+  )
+}
+
+function DotArray({ diagram }: { diagram: Diagram }) {
+  const rows = diagram.rows ?? 1
+  const cols = diagram.cols ?? 1
+  const gap = 26
+  return (
+    <svg
+      className="diagram"
+      viewBox={`0 0 ${cols * gap} ${rows * gap}`}
+      style={{ maxWidth: cols * gap * 1.5 }}
+      role="img"
+      aria-label="Array of dots"
+    >
+      {Array.from({ length: rows * cols }, (_, i) => (
+        <circle
+          key={i}
+          cx={(i % cols) * gap + gap / 2}
+          cy={Math.floor(i / cols) * gap + gap / 2}
+          r={8}
+          className="diagram-dot"
+        />
+      ))}
+    </svg>
+  )
+}
+
+function AreaModel({ diagram }: { diagram: Diagram }) {
+  const rowParts = diagram.rowParts ?? []
+  const colParts = diagram.colParts ?? []
+  // Equal-sized cells: drawn to scale, the ones column of 23 x 4 would be
+  // a sliver.
+  const cell = 72
+  const left = 40
+  const top = 26
+  const w = left + colParts.length * cell + 2
+  const h = top + rowParts.length * cell + 2
+  return (
+    <svg
+      className="diagram"
+      viewBox={`0 0 ${w} ${h}`}
+      style={{ maxWidth: w * 1.2 }}
+      role="img"
+      aria-label="Area model"
+    >
+      {colParts.map((p, i) => (
+        <text
+          key={`c${i}`}
+          x={left + i * cell + cell / 2}
+          y={top - 8}
+          textAnchor="middle"
+          className="diagram-label"
+        >
+          {p}
+        </text>
+      ))}
+      {rowParts.map((p, j) => (
+        <text
+          key={`r${j}`}
+          x={left - 8}
+          y={top + j * cell + cell / 2 + 5}
+          textAnchor="end"
+          className="diagram-label"
+        >
+          {p}
+        </text>
+      ))}
+      {rowParts.flatMap((_, j) =>
+        colParts.map((_, i) => (
+          <rect
+            key={`${j}-${i}`}
+            x={left + i * cell}
+            y={top + j * cell}
+            width={cell}
+            height={cell}
+            className="diagram-cell"
+          />
+        )),
+      )}
+    </svg>
+  )
+}
+
+function Clock({ diagram }: { diagram: Diagram }) {
+  const hour = diagram.hour ?? 12
+  const minute = diagram.minute ?? 0
+  const r = 90
+  // Angle in degrees clockwise from 12 to an (x, y) at distance d.
+  const at = (deg: number, d: number) => {
+    const rad = (deg * Math.PI) / 180
+    return { x: d * Math.sin(rad), y: -d * Math.cos(rad) }
+  }
+  const minuteHand = at(minute * 6, r * 0.78)
+  const hourHand = at((hour % 12) * 30 + minute / 2, r * 0.5)
+  return (
+    <svg
+      className="diagram diagram-clock"
+      viewBox={`${-r - 4} ${-r - 4} ${2 * r + 8} ${2 * r + 8}`}
+      role="img"
+      aria-label="Clock"
+    >
+      <circle r={r} className="diagram-cell" />
+      {Array.from({ length: 60 }, (_, m) => {
+        const outer = at(m * 6, r - 4)
+        const inner = at(m * 6, m % 5 === 0 ? r - 12 : r - 8)
+        return (
+          <line key={m} x1={inner.x} y1={inner.y} x2={outer.x} y2={outer.y} className="diagram-line" />
+        )
+      })}
+      {Array.from({ length: 12 }, (_, i) => {
+        const p = at((i + 1) * 30, r - 26)
+        return (
+          <text key={i} x={p.x} y={p.y + 6} textAnchor="middle" className="diagram-label">
+            {i + 1}
+          </text>
+        )
+      })}
+      <line
+        x1={0}
+        y1={0}
+        x2={minuteHand.x}
+        y2={minuteHand.y}
+        className="diagram-hand diagram-minute"
+      />
+      <line x1={0} y1={0} x2={hourHand.x} y2={hourHand.y} className="diagram-hand diagram-hour" />
+      <circle r={5} className="diagram-point" />
+    </svg>
+  )
+}
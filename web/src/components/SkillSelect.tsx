@@ -1,5 +1,5 @@
 import { useEffect, useMemo, useRef, useState } from 'react'
-import { cachedCurriculum, type CurriculumIsland } from '../api'
+import { cachedCurriculum, gradeLabel, type CurriculumIsland } from '../api'
 
 // SkillSelect picks a quest's skill tag by human name: a searchable
 // combobox over the curriculum catalog. Typing filters skills by name,
@@ -63,9 +63,9 @@ export default function SkillSelect({
         name: island.name,
         options: island.skills.map((s) => ({
           id: s.id,
-          label: `${s.name} (grade ${s.grade})`,
+          label: `${s.name} (grade ${gradeLabel(s.grade)})`,
           islandId: island.id,
-          haystack: [s.name, ...(s.keywords ?? []), island.name, `grade ${s.grade}`]
+          haystack: [s.name, ...(s.keywords ?? []), island.name, `grade ${gradeLabel(s.grade)}`]
             .join(' ')
             .toLowerCase(),
         })),
@@ -1,5 +1,7 @@
 // Typed client for the treasure-map game API.
-import { deviceToken, request } from './api'
+import { deviceToken, request, type Locale } from './api'
+
+export type Tier = 'learn' | 'prove' | 'challenge'
 
 export type SpotState = 'locked' | 'ready' | 'digging' | 'proving' | 'treasure' | 'sinking'
 
@@ -12,6 +14,10 @@ export interface Spot {
   state: SpotState
   progress: number
   reviewDue: boolean
+  // Rusty prerequisites of a mastered spot, when decay propagation is on.
+  shakyFoundation?: string[]
+  // Tier in progress for digging/proving spots.
+  tier?: Tier
 }
 
 export interface Island {
@@ -35,6 +41,10 @@ export interface GameMap {
   islands: Island[]
   gems: { total: number; byType: Record<string, number> }
   quests?: QuestMapItem[]
+  // Set for a child with no progress yet: offer the placement quiz.
+  placementOffered?: boolean
+  // The child's language, for the game's own strings (see i18n.ts).
+  locale: Locale
 }
 
 export interface Expedition {
@@ -42,20 +52,50 @@ export interface Expedition {
   skillId: string
   skillName: string
   totalQuestions: number
-  tier: 'learn' | 'prove'
+  tier: Tier
   category: string
   questId?: string
+  // Placement quiz: totalQuestions is an upper bound, answers aren't revealed.
+  placement?: boolean
 }
 
+export type AnswerFormat =
+  | 'numeric'
+  | 'multiple_choice'
+  | 'true_false'
+  | 'ordering'
+  | 'multi_select'
+  | 'multi_blank'
+
 export interface Question {
   index: number
   total: number
   text: string
-  format: 'numeric' | 'multiple_choice'
+  format: AnswerFormat
   choices?: string[]
+  blanks?: number // multi_blank only
   answerType: string
   tier: string
   timeLimitSecs?: number
+  diagram?: Diagram
+}
+
+export type DiagramKind = 'number_line' | 'fraction_bar' | 'area_model' | 'array' | 'clock'
+
+// A question's picture. Only the fields of its kind are set; number-line
+// ticks and points come pre-placed along the line (0 = left end, 1 = right).
+export interface Diagram {
+  kind: DiagramKind
+  ticks?: { pos: number; label?: string }[]
+  points?: number[]
+  parts?: number
+  shaded?: number
+  rows?: number
+  cols?: number
+  rowParts?: number[]
+  colParts?: number[]
+  hour?: number
+  minute?: number
 }
 
 export interface GemAward {
@@ -72,6 +112,7 @@ export interface ExpeditionSummary {
   mastered: boolean
   questId?: string
   questComplete?: boolean
+  placedSkills?: number
 }
 
 // correctAnswer/explanation are absent on a WRONG answer to a quest
@@ -80,12 +121,16 @@ export interface ExpeditionSummary {
 // carry both on a wrong answer.
 export interface AnswerResult {
   correct: boolean
+  // near_miss and unsimplified are wrong but close; reason says why.
+  grade: 'correct' | 'unsimplified' | 'near_miss' | 'wrong'
+  reason?: string
   correctAnswer?: string
   explanation?: string
   hintAvailable: boolean
   streak: number
   gem?: GemAward
-  mastery?: { from: string; to: string }
+  // tier is set on a tier-up (from and to are both 'learning').
+  mastery?: { from: string; to: string; tier?: Tier }
   unlockedSkillIds?: string[]
   questionsAnswered: number
   totalQuestions: number
@@ -140,10 +185,15 @@ export const gameApi = {
   start: (skillId: string) => call<Expedition>('POST', '/api/v1/game/expeditions', { skillId }),
   startQuest: (questId: string) =>
     call<Expedition>('POST', `/api/v1/game/quests/${questId}/expeditions`),
+  startPlacement: () => call<Expedition>('POST', '/api/v1/game/placement'),
+  skipPlacement: () => call<void>('POST', '/api/v1/game/placement/skip'),
   question: (expId: string) => call<Question>('POST', `/api/v1/game/expeditions/${expId}/question`),
   answer: (expId: string, answer: string) =>
     call<AnswerResult>('POST', `/api/v1/game/expeditions/${expId}/answer`, { answer }),
   hint: (expId: string) => call<{ hint: string }>('POST', `/api/v1/game/expeditions/${expId}/hint`),
+  // Skipped: the unanswered question was taken away — fetch the next one.
+  report: (expId: string, reason = '') =>
+    call<{ skipped: boolean }>('POST', `/api/v1/game/expeditions/${expId}/report`, { reason }),
   lesson: (expId: string) => call<Lesson>('POST', `/api/v1/game/expeditions/${expId}/lesson`),
   answerLesson: (expId: string, answer: string, skip: boolean) =>
     call<LessonGrade>('POST', `/api/v1/game/expeditions/${expId}/lesson/answer`, { answer, skip }),
@@ -0,0 +1,317 @@
+// Translations for the game's own strings. Questions, lessons and feedback
+// reasons arrive already in the child's language; this covers the chrome
+// around them. The English text is the key and is shown when a translation
+// is missing, the same way internal/i18n works on the server.
+import { createElement, Fragment, type ReactNode } from 'react'
+import type { Locale } from './api'
+
+let current: Locale = 'en'
+
+// setLocale picks the language t() translates into, from the map's locale.
+export function setLocale(locale: Locale | undefined) {
+  current = locale && locale in CATALOGS ? locale : 'en'
+}
+
+// t translates msg and fills its {0}, {1}… placeholders with args.
+export function t(msg: string, ...args: (string | number)[]): string {
+  return translate(msg).replace(/\{(\d+)\}/g, (_, i: string) => String(args[Number(i)]))
+}
+
+// tn is t for placeholders that are elements, such as a bolded answer.
+export function tn(msg: string, ...args: ReactNode[]): ReactNode {
+  const parts = translate(msg).split(/(\{\d+\})/)
+  return parts.map((part, i) => {
+    const arg = /^\{(\d+)\}$/.exec(part)
+    return createElement(Fragment, { key: i }, arg ? args[Number(arg[1])] : part)
+  })
+}
+
+function translate(msg: string): string {
+  if (current === 'en') return msg
+  return CATALOGS[current]?.[msg] ?? msg
+}
+
+const CATALOGS: Partial<Record<Locale, Record<string, string>>> = {
+  es: {
+    'Mastery gems': 'Gemas de dominio',
+    'Streak gems': 'Gemas de racha',
+    'Expedition gems': 'Gemas de expedición',
+    'Comeback gems': 'Gemas de remontada',
+    'Keeper gems': 'Gemas de guardián',
+    mastery: 'dominio',
+    streak: 'racha',
+    session: 'expedición',
+    recovery: 'remontada',
+    retention: 'guardián',
+    common: 'común',
+    rare: 'rara',
+    epic: 'épica',
+    legendary: 'legendaria',
+    'Hidden in the fog': 'Escondido en la niebla',
+    'X marks the spot — dig here!': 'La X marca el lugar: ¡cava aquí!',
+    'Keep digging!': '¡Sigue cavando!',
+    'Prove it to open the chest!': '¡Demuéstralo para abrir el cofre!',
+    'Treasure secured!': '¡Tesoro asegurado!',
+    'Treasure sinking — rescue it!': 'El tesoro se hunde: ¡rescátalo!',
+    'Beat the challenge to open the chest!': '¡Supera el desafío para abrir el cofre!',
+    'Treasure on shaky ground — a path to it is crumbling!':
+      'Tesoro en terreno inestable: ¡un camino hacia él se desmorona!',
+    'Captain {0}': 'Capitán {0}',
+    'Switch player': 'Cambiar de jugador',
+    'The ship needs to rest!': '¡El barco necesita descansar!',
+    "You've explored so much today. Ask your grown-up to send the ship back out on more expeditions.":
+      'Hoy has explorado muchísimo. Pide a tu adulto que envíe el barco a más expediciones.',
+    'Back to the map': 'Volver al mapa',
+    'Your gem vault': 'Tu cámara de gemas',
+    'No gems yet — go dig some treasure!': 'Aún no tienes gemas: ¡ve a cavar tesoros!',
+    'Charting the map…': 'Trazando el mapa…',
+    'Chart your map first?': '¿Trazamos primero tu mapa?',
+    'A few quick questions skip the islands you already know.':
+      'Unas preguntas rápidas saltan las islas que ya conoces.',
+    "Let's go!": '¡Vamos!',
+    'Start from the beginning →': 'Empezar desde el principio →',
+    'The Captain left you a quest: {0}': 'El Capitán te dejó una misión: {0}',
+    '{0} of {1} solved': '{0} de {1} resueltas',
+    '1 quest completed': '1 misión completada',
+    '{0} quests completed': '{0} misiones completadas',
+    'Somewhere at sea': 'En algún lugar del mar',
+    "The guide's notebook": 'El cuaderno del guía',
+    Close: 'Cerrar',
+    'Opening the notebook…': 'Abriendo el cuaderno…',
+    'No tips yet! The guide writes one down whenever a spot gets tricky.':
+      '¡Aún no hay consejos! El guía apunta uno cada vez que un lugar se complica.',
+    'Blank {0}': 'Hueco {0}',
+    'Dig!': '¡Cava!',
+    'Thanks — the Captain will check that one!': 'Gracias: ¡el Capitán la revisará!',
+    'Something wrong with this question?': '¿Algo va mal con esta pregunta?',
+    Expedition: 'Expedición',
+    'Sail home': 'Volver a casa',
+    'Setting sail…': 'Zarpando…',
+    'Consulting the map…': 'Consultando el mapa…',
+    "Time's up — give it your best guess!": 'Se acabó el tiempo: ¡intenta adivinarlo!',
+    '{0} in a row!': '¡{0} seguidas!',
+    'Treasure found!': '¡Tesoro encontrado!',
+    'You earned a {0} gem!': '¡Ganaste una gema {0}!',
+    'So close!': '¡Casi!',
+    'Not quite!': '¡No del todo!',
+    'The treasure was {0}': 'El tesoro era {0}',
+    'The Captain keeps the answer sealed until you crack it!':
+      '¡El Capitán guarda la respuesta sellada hasta que la descubras!',
+    'Try again on your next voyage.': 'Inténtalo de nuevo en tu próximo viaje.',
+    'Show me a clue': 'Dame una pista',
+    'Proven! One last challenge — beat the clock to open the chest!':
+      '¡Demostrado! Un último desafío: ¡gana al reloj para abrir el cofre!',
+    'You found the vault — now prove it to open the chest!':
+      'Encontraste la cámara: ¡ahora demuéstralo para abrir el cofre!',
+    'The guide has a tip for you!': '¡El guía tiene un consejo para ti!',
+    'Skip the tip →': 'Saltar el consejo →',
+    'Next clue →': 'Siguiente pista →',
+    'The guide is drawing a picture for you…': 'El guía te está haciendo un dibujo…',
+    'Try it!': '¡Pruébalo!',
+    'Skip practice →': 'Saltar la práctica →',
+    'You got it!': '¡Lo lograste!',
+    'Good try!': '¡Buen intento!',
+    'The answer was {0}': 'La respuesta era {0}',
+    'Back to the hunt →': 'Volver a la búsqueda →',
+    'Map charted!': '¡Mapa trazado!',
+    'You already know {0} spots — their treasure is yours!':
+      'Ya conoces {0} lugares: ¡su tesoro es tuyo!',
+    'Your adventure starts at the first islands.': 'Tu aventura empieza en las primeras islas.',
+    'Quest complete!': '¡Misión cumplida!',
+    'You solved every question the Captain left for you!':
+      '¡Resolviste todas las preguntas que te dejó el Capitán!',
+    'Treasure chest opened!': '¡Cofre del tesoro abierto!',
+    'The fog lifted on 1 new spot!': '¡La niebla se levantó sobre 1 lugar nuevo!',
+    'The fog lifted on {0} new spots!': '¡La niebla se levantó sobre {0} lugares nuevos!',
+    'Expedition complete!': '¡Expedición completada!',
+    'You dug up {0} of {1} treasures.': 'Desenterraste {0} de {1} tesoros.',
+  },
+  fr: {
+    'Mastery gems': 'Gemmes de maîtrise',
+    'Streak gems': 'Gemmes de série',
+    'Expedition gems': "Gemmes d'expédition",
+    'Comeback gems': 'Gemmes de retour',
+    'Keeper gems': 'Gemmes de gardien',
+    mastery: 'maîtrise',
+    streak: 'série',
+    session: 'expédition',
+    recovery: 'retour',
+    retention: 'gardien',
+    common: 'commune',
+    rare: 'rare',
+    epic: 'épique',
+    legendary: 'légendaire',
+    'Hidden in the fog': 'Caché dans le brouillard',
+    'X marks the spot — dig here!': "La croix marque l'endroit : creuse ici !",
+    'Keep digging!': 'Continue de creuser !',
+    'Prove it to open the chest!': 'Prouve-le pour ouvrir le coffre !',
+    'Treasure secured!': "Trésor à l'abri !",
+    'Treasure sinking — rescue it!': 'Le trésor coule : sauve-le !',
+    'Beat the challenge to open the chest!': 'Relève le défi pour ouvrir le coffre !',
+    'Treasure on shaky ground — a path to it is crumbling!':
+      "Trésor sur un sol fragile : un chemin qui y mène s'effrite !",
+    'Captain {0}': 'Capitaine {0}',
+    'Switch player': 'Changer de joueur',
+    'The ship needs to rest!': 'Le bateau doit se reposer !',
+    "You've explored so much today. Ask your grown-up to send the ship back out on more expeditions.":
+      "Tu as beaucoup exploré aujourd'hui. Demande à ton adulte de renvoyer le bateau en expédition.",
+    'Back to the map': 'Retour à la carte',
+    'Your gem vault': 'Ton coffre à gemmes',
+    'No gems yet — go dig some treasure!': 'Pas encore de gemmes : va creuser des trésors !',
+    'Charting the map…': 'Tracé de la carte…',
+    'Chart your map first?': "On trace d'abord ta carte ?",
+    'A few quick questions skip the islands you already know.':
+      'Quelques questions rapides pour passer les îles que tu connais déjà.',
+    "Let's go!": "C'est parti !",
+    'Start from the beginning →': 'Commencer depuis le début →',
+    'The Captain left you a quest: {0}': "Le Capitaine t'a laissé une quête : {0}",
+    '{0} of {1} solved': '{0} sur {1} résolues',
+    '1 quest completed': '1 quête terminée',
+    '{0} quests completed': '{0} quêtes terminées',
+    'Somewhere at sea': 'Quelque part en mer',
+    "The guide's notebook": 'Le carnet du guide',
+    Close: 'Fermer',
+    'Opening the notebook…': 'Ouverture du carnet…',
+    'No tips yet! The guide writes one down whenever a spot gets tricky.':
+      "Pas encore d'astuces ! Le guide en note une dès qu'un endroit devient difficile.",
+    'Blank {0}': 'Case {0}',
+    'Dig!': 'Creuse !',
+    'Thanks — the Captain will check that one!': 'Merci : le Capitaine va la vérifier !',
+    'Something wrong with this question?': 'Un problème avec cette question ?',
+    Expedition: 'Expédition',
+    'Sail home': 'Rentrer au port',
+    'Setting sail…': "On lève l'ancre…",
+    'Consulting the map…': 'On consulte la carte…',
+    "Time's up — give it your best guess!": 'Temps écoulé : tente ta meilleure réponse !',
+    '{0} in a row!': "{0} d'affilée !",
+    'Treasure found!': 'Trésor trouvé !',
+    'You earned a {0} gem!': 'Tu as gagné une gemme {0} !',
+    'So close!': 'Presque !',
+    'Not quite!': 'Pas tout à fait !',
+    'The treasure was {0}': 'Le trésor était {0}',
+    'The Captain keeps the answer sealed until you crack it!':
+      "Le Capitaine garde la réponse scellée jusqu'à ce que tu la trouves !",
+    'Try again on your next voyage.': 'Réessaie lors de ton prochain voyage.',
+    'Show me a clue': 'Montre-moi un indice',
+    'Proven! One last challenge — beat the clock to open the chest!':
+      'Prouvé ! Un dernier défi : bats la montre pour ouvrir le coffre !',
+    'You found the vault — now prove it to open the chest!':
+      'Tu as trouvé la chambre forte : prouve-le pour ouvrir le coffre !',
+    'The guide has a tip for you!': 'Le guide a une astuce pour toi !',
+    'Skip the tip →': "Passer l'astuce →",
+    'Next clue →': 'Indice suivant →',
+    'The guide is drawing a picture for you…': 'Le guide te fait un dessin…',
+    'Try it!': 'Essaie !',
+    'Skip practice →': "Passer l'exercice →",
+    'You got it!': 'Tu as réussi !',
+    'Good try!': 'Bel essai !',
+    'The answer was {0}': 'La réponse était {0}',
+    'Back to the hunt →': 'Retour à la chasse →',
+    'Map charted!': 'Carte tracée !',
+    'You already know {0} spots — their treasure is yours!':
+      'Tu connais déjà {0} endroits : leur trésor est à toi !',
+    'Your adventure starts at the first islands.': 'Ton aventure commence aux premières îles.',
+    'Quest complete!': 'Quête terminée !',
+    'You solved every question the Captain left for you!':
+      'Tu as résolu toutes les questions du Capitaine !',
+    'Treasure chest opened!': 'Coffre au trésor ouvert !',
+    'The fog lifted on 1 new spot!': "Le brouillard s'est levé sur 1 nouvel endroit !",
+    'The fog lifted on {0} new spots!': "Le brouillard s'est levé sur {0} nouveaux endroits !",
+    'Expedition complete!': 'Expédition terminée !',
+    'You dug up {0} of {1} treasures.': 'Tu as déterré {0} trésors sur {1}.',
+  },
+  de: {
+    'Mastery gems': 'Meister-Edelsteine',
+    'Streak gems': 'Serien-Edelsteine',
+    'Expedition gems': 'Expeditions-Edelsteine',
+    'Comeback gems': 'Comeback-Edelsteine',
+    'Keeper gems': 'Hüter-Edelsteine',
+    mastery: 'Meister',
+    streak: 'Serie',
+    session: 'Expedition',
+    recovery: 'Comeback',
+    retention: 'Hüter',
+    common: 'gewöhnlich',
+    rare: 'selten',
+    epic: 'episch',
+    legendary: 'legendär',
+    'Hidden in the fog': 'Im Nebel verborgen',
+    'X marks the spot — dig here!': 'X markiert die Stelle – grab hier!',
+    'Keep digging!': 'Grab weiter!',
+    'Prove it to open the chest!': 'Beweise es, um die Truhe zu öffnen!',
+    'Treasure secured!': 'Schatz gesichert!',
+    'Treasure sinking — rescue it!': 'Der Schatz versinkt – rette ihn!',
+    'Beat the challenge to open the chest!': 'Meistere die Herausforderung, um die Truhe zu öffnen!',
+    'Treasure on shaky ground — a path to it is crumbling!':
+      'Schatz auf wackligem Boden – ein Weg dorthin bröckelt!',
+    'Captain {0}': 'Kapitän {0}',
+    'Switch player': 'Spieler wechseln',
+    'The ship needs to rest!': 'Das Schiff braucht eine Pause!',
+    "You've explored so much today. Ask your grown-up to send the ship back out on more expeditions.":
+      'Du hast heute so viel erkundet. Bitte deinen Erwachsenen, das Schiff auf weitere Expeditionen zu schicken.',
+    'Back to the map': 'Zurück zur Karte',
+    'Your gem vault': 'Deine Edelstein-Schatzkammer',
+    'No gems yet — go dig some treasure!': 'Noch keine Edelsteine – grab nach Schätzen!',
+    'Charting the map…': 'Die Karte wird gezeichnet…',
+    'Chart your map first?': 'Zuerst deine Karte zeichnen?',
+    'A few quick questions skip the islands you already know.':
+      'Ein paar schnelle Fragen überspringen die Inseln, die du schon kennst.',
+    "Let's go!": "Los geht's!",
+    'Start from the beginning →': 'Von vorne anfangen →',
+    'The Captain left you a quest: {0}': 'Der Kapitän hat dir eine Aufgabe hinterlassen: {0}',
+    '{0} of {1} solved': '{0} von {1} gelöst',
+    '1 quest completed': '1 Aufgabe geschafft',
+    '{0} quests completed': '{0} Aufgaben geschafft',
+    'Somewhere at sea': 'Irgendwo auf See',
+    "The guide's notebook": 'Das Notizbuch des Lotsen',
+    Close: 'Schließen',
+    'Opening the notebook…': 'Das Notizbuch wird geöffnet…',
+    'No tips yet! The guide writes one down whenever a spot gets tricky.':
+      'Noch keine Tipps! Der Lotse schreibt einen auf, sobald eine Stelle knifflig wird.',
+    'Blank {0}': 'Lücke {0}',
+    'Dig!': 'Grab!',
+    'Thanks — the Captain will check that one!': 'Danke – der Kapitän sieht sie sich an!',
+    'Something wrong with this question?': 'Stimmt etwas mit dieser Frage nicht?',
+    Expedition: 'Expedition',
+    'Sail home': 'Heimsegeln',
+    'Setting sail…': 'Segel setzen…',
+    'Consulting the map…': 'Ein Blick auf die Karte…',
+    "Time's up — give it your best guess!": 'Die Zeit ist um – rate so gut du kannst!',
+    '{0} in a row!': '{0} in Folge!',
+    'Treasure found!': 'Schatz gefunden!',
+    'You earned a {0} gem!': 'Du hast einen Edelstein verdient: {0}!',
+    'So close!': 'Knapp daneben!',
+    'Not quite!': 'Nicht ganz!',
+    'The treasure was {0}': 'Der Schatz war {0}',
+    'The Captain keeps the answer sealed until you crack it!':
+      'Der Kapitän hält die Antwort versiegelt, bis du sie knackst!',
+    'Try again on your next voyage.': 'Versuch es auf deiner nächsten Reise noch einmal.',
+    'Show me a clue': 'Zeig mir einen Hinweis',
+    'Proven! One last challenge — beat the clock to open the chest!':
+      'Bewiesen! Eine letzte Herausforderung – schlag die Uhr, um die Truhe zu öffnen!',
+    'You found the vault — now prove it to open the chest!':
+      'Du hast die Schatzkammer gefunden – jetzt beweise es, um die Truhe zu öffnen!',
+    'The guide has a tip for you!': 'Der Lotse hat einen Tipp für dich!',
+    'Skip the tip →': 'Tipp überspringen →',
+    'Next clue →': 'Nächster Hinweis →',
+    'The guide is drawing a picture for you…': 'Der Lotse malt dir ein Bild…',
+    'Try it!': 'Probier es!',
+    'Skip practice →': 'Übung überspringen →',
+    'You got it!': 'Geschafft!',
+    'Good try!': 'Guter Versuch!',
+    'The answer was {0}': 'Die Antwort war {0}',
+    'Back to the hunt →': 'Zurück zur Schatzsuche →',
+    'Map charted!': 'Karte gezeichnet!',
+    'You already know {0} spots — their treasure is yours!':
+      'Du kennst schon {0} Stellen – ihr Schatz gehört dir!',
+    'Your adventure starts at the first islands.': 'Dein Abenteuer beginnt auf den ersten Inseln.',
+    'Quest complete!': 'Aufgabe geschafft!',
+    'You solved every question the Captain left for you!':
+      'Du hast jede Frage gelöst, die der Kapitän dir hinterlassen hat!',
+    'Treasure chest opened!': 'Schatztruhe geöffnet!',
+    'The fog lifted on 1 new spot!': 'Der Nebel hat sich über 1 neuen Stelle gelichtet!',
+    'The fog lifted on {0} new spots!': 'Der Nebel hat sich über {0} neuen Stellen gelichtet!',
+    'Expedition complete!': 'Expedition geschafft!',
+    'You dug up {0} of {1} treasures.': 'Du hast {0} von {1} Schätzen ausgegraben.',
+  },
+}
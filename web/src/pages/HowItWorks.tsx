@@ -1,6 +1,6 @@
 import { useEffect, useState } from 'react'
 import { Link } from 'react-router-dom'
-import { cachedCurriculum, type CurriculumIsland } from '../api'
+import { cachedCurriculum, gradeLabel, type CurriculumIsland } from '../api'
 import { ensureAnalyticsBooted, track } from '../analytics'
 import Skeleton from '../components/Skeleton'
 
@@ -208,8 +208,8 @@ function CurriculumOutline() {
         }
         const gradeSpan =
           grades.length > 1
-            ? `grades ${grades[0].grade}–${grades[grades.length - 1].grade}`
-            : `grade ${grades[0]?.grade ?? ''}`
+            ? `grades ${gradeLabel(grades[0].grade)}–${gradeLabel(grades[grades.length - 1].grade)}`
+            : `grade ${grades[0] ? gradeLabel(grades[0].grade) : ''}`
         return (
           // First island open by way of invitation; the rest are a click away.
           <details key={island.id} className="hiw-island" open={idx === 0}>
@@ -221,7 +221,7 @@ function CurriculumOutline() {
             </summary>
             {grades.map((g) => (
               <p key={g.grade} className="hiw-grade-row">
-                <span className="hiw-grade">Grade {g.grade}</span>
+                <span className="hiw-grade">Grade {gradeLabel(g.grade)}</span>
                 {g.names.join(' · ')}
               </p>
             ))}
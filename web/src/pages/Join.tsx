@@ -1,6 +1,6 @@
 import { useEffect, useState, type FormEvent } from 'react'
 import { useNavigate } from 'react-router-dom'
-import { api, deviceToken, type ChildProfile } from '../api'
+import { api, deviceToken, gradeLabel, type ChildProfile } from '../api'
 import { attachChildToFamily, ensureAnalyticsBooted, track } from '../analytics'
 
 type Step = 'code' | 'pick' | 'pin'
@@ -113,7 +113,7 @@ export default function Join() {
                 >
                   <span className="avatar avatar-big">{c.name.charAt(0).toUpperCase()}</span>
                   <span>{c.name}</span>
-                  <span className="muted">Grade {c.grade}</span>
+                  <span className="muted">Grade {gradeLabel(c.grade)}</span>
                 </button>
               ))}
             </div>
@@ -1,6 +1,6 @@
 import { useCallback, useEffect, useRef, useState, type FormEvent } from 'react'
 import { useNavigate } from 'react-router-dom'
-import { api, deviceToken } from '../api'
+import { api, deviceToken, gradeLabel } from '../api'
 import { attachChildToFamily, ensureAnalyticsBooted, track } from '../analytics'
 import {
   gameApi,
@@ -16,6 +16,8 @@ import {
   type QuestMapItem,
   type Spot,
 } from '../game'
+import QuestionDiagram from '../components/QuestionDiagram'
+import { setLocale, t, tn } from '../i18n'
 
 // The treasure map: the skill graph as islands. Solving AI-generated math
 // digs treasure, collects gems, and lifts the fog on new territory.
@@ -48,6 +50,23 @@ const SPOT_LABEL: Record<string, string> = {
   sinking: 'Treasure sinking — rescue it!',
 }
 
+// spotLabel is the spot's tooltip; proving covers the challenge tier too.
+function spotLabel(spot: Spot): string {
+  if (spot.state === 'proving' && spot.tier === 'challenge') {
+    return t('Beat the challenge to open the chest!')
+  }
+  if (spot.state === 'treasure' && spot.shakyFoundation?.length) {
+    return t('Treasure on shaky ground — a path to it is crumbling!')
+  }
+  return t(SPOT_LABEL[spot.state])
+}
+
+// spotClass styles a spot by state, marking one on a shaky foundation.
+function spotClass(spot: Spot): string {
+  const shaky = spot.shakyFoundation?.length ? ' spot-shaky' : ''
+  return `spot spot-${spot.state}${shaky}`
+}
+
 export default function Play() {
   const navigate = useNavigate()
   const [childName, setChildName] = useState('')
@@ -60,6 +79,7 @@ export default function Play() {
   const [question, setQuestion] = useState<Question | null>(null)
   const [result, setResult] = useState<AnswerResult | null>(null)
   const [hint, setHint] = useState<string | null>(null)
+  const [reported, setReported] = useState(false)
   const [lesson, setLesson] = useState<Lesson | null>(null)
   const [lessonGrade, setLessonGrade] = useState<LessonGrade | null>(null)
   const [vaultOpen, setVaultOpen] = useState(false)
@@ -70,7 +90,9 @@ export default function Play() {
 
   const refreshMap = useCallback(async () => {
     try {
-      setMap(await gameApi.map())
+      const m = await gameApi.map()
+      setLocale(m.locale)
+      setMap(m)
       setMapError(null)
     } catch (err) {
       setMapError(err instanceof Error ? err.message : String(err))
@@ -108,6 +130,20 @@ export default function Play() {
     await setSail(() => gameApi.startQuest(quest.id))
   }
 
+  async function startPlacement() {
+    if (phase !== 'idle') return
+    await setSail(() => gameApi.startPlacement())
+  }
+
+  async function skipPlacement() {
+    try {
+      await gameApi.skipPlacement()
+    } catch (err) {
+      setExpError(err instanceof Error ? err.message : String(err))
+    }
+    await refreshMap()
+  }
+
   // setSail starts any expedition (dig spot or quest) — one flow, one
   // overlay, one kid-friendly out-of-credits screen.
   async function setSail(start: () => Promise<Expedition>) {
@@ -136,6 +172,7 @@ export default function Play() {
     setPhase('loading')
     setResult(null)
     setHint(null)
+    setReported(false)
     setLesson(null)
     setLessonGrade(null)
     try {
@@ -155,6 +192,11 @@ export default function Play() {
     setPhase('loading')
     try {
       const res = await gameApi.answer(expedition.id, answer)
+      // Placement is a check-up, not a lesson: no per-question feedback.
+      if (expedition.placement && !res.done) {
+        await nextQuestion(expedition.id)
+        return
+      }
       setResult(res)
       setPhase(res.done ? 'summary' : 'feedback')
       if (res.done) {
@@ -184,6 +226,22 @@ export default function Play() {
     }
   }
 
+  // report flags a broken or confusing question. Unanswered dig questions
+  // are swapped for a fresh one; otherwise the kid just sees a thank-you.
+  async function report() {
+    if (!expedition || reported) return
+    try {
+      const r = await gameApi.report(expedition.id)
+      if (r.skipped) {
+        await nextQuestion(expedition.id)
+        return
+      }
+      setReported(true)
+    } catch (err) {
+      setExpError(err instanceof Error ? err.message : String(err))
+    }
+  }
+
   // openLesson polls until the guide finishes writing (or gives up and
   // moves on — lessons are best-effort, never a blocker).
   async function openLesson() {
@@ -250,7 +308,7 @@ export default function Play() {
         <div className="brand brand-small brand-dark">
           <span className="brand-mark">∑</span>
           <span>Mathiz</span>
-          {childName && <span className="game-captain">Captain {childName}</span>}
+          {childName && <span className="game-captain">{t('Captain {0}', childName)}</span>}
         </div>
         <div className="game-bar-right">
           <button className="gem-counter" onClick={() => void toggleNotebook()}>
@@ -272,7 +330,7 @@ export default function Play() {
               navigate('/join')
             }}
           >
-            Switch player
+            {t('Switch player')}
           </button>
         </div>
       </header>
@@ -284,13 +342,14 @@ export default function Play() {
         <div className="expedition-backdrop" onClick={() => setShipResting(false)}>
           <div className="expedition rest-card" onClick={(e) => e.stopPropagation()}>
             <div className="summary-big">⛵💤</div>
-            <h3>The ship needs to rest!</h3>
+            <h3>{t('The ship needs to rest!')}</h3>
             <p>
-              You've explored so much today. Ask your grown-up to send the ship
-              back out on more expeditions.
+              {t(
+                "You've explored so much today. Ask your grown-up to send the ship back out on more expeditions.",
+              )}
             </p>
             <button className="btn btn-kid btn-block" onClick={() => setShipResting(false)}>
-              Back to the map
+              {t('Back to the map')}
             </button>
           </div>
         </div>
@@ -302,9 +361,9 @@ export default function Play() {
 
       {vaultOpen && map && (
         <div className="vault">
-          <h3>💎 Your gem vault</h3>
+          <h3>💎 {t('Your gem vault')}</h3>
           {map.gems.total === 0 ? (
-            <p className="vault-empty">No gems yet — go dig some treasure!</p>
+            <p className="vault-empty">{t('No gems yet — go dig some treasure!')}</p>
           ) : (
             <ul>
               {Object.entries(map.gems.byType)
@@ -312,7 +371,7 @@ export default function Play() {
                 .map(([type, count]) => (
                   <li key={type}>
                     <span>{GEM_META[type]?.icon ?? '💎'}</span>
-                    <span>{GEM_META[type]?.label ?? type}</span>
+                    <span>{t(GEM_META[type]?.label ?? type)}</span>
                     <strong>× {count}</strong>
                   </li>
                 ))}
@@ -322,7 +381,33 @@ export default function Play() {
       )}
 
       <main className="sea">
-        {!map && !mapError && <div className="boot boot-dark">Charting the map… 🧭</div>}
+        {!map && !mapError && <div className="boot boot-dark">{t('Charting the map…')} 🧭</div>}
+        {map?.placementOffered && (
+          <div className="quest-cards">
+            <div className="quest-card placement-card">
+              <span className="quest-card-text">
+                <span className="quest-card-title">🧭 {t('Chart your map first?')}</span>
+                <span className="quest-card-progress">
+                  {t('A few quick questions skip the islands you already know.')}
+                </span>
+              </span>
+              <button
+                className="btn btn-kid"
+                onClick={() => void startPlacement()}
+                disabled={phase !== 'idle'}
+              >
+                {t("Let's go!")}
+              </button>
+              <button
+                className="linklike"
+                onClick={() => void skipPlacement()}
+                disabled={phase !== 'idle'}
+              >
+                {t('Start from the beginning →')}
+              </button>
+            </div>
+          </div>
+        )}
         {map?.quests && map.quests.length > 0 && (
           <div className="quest-cards">
             {/* Full cards only for quests still in progress — completed
@@ -341,10 +426,10 @@ export default function Play() {
                   <QuestRing correct={q.correct} total={q.total} emoji={q.emoji || '⭐'} />
                   <span className="quest-card-text">
                     <span className="quest-card-title">
-                      ⭐ The Captain left you a quest: {q.name}
+                      ⭐ {t('The Captain left you a quest: {0}', q.name)}
                     </span>
                     <span className="quest-card-progress">
-                      {q.correct} of {q.total} solved
+                      {t('{0} of {1} solved', q.correct, q.total)}
                     </span>
                   </span>
                 </button>
@@ -359,10 +444,10 @@ export default function Play() {
               {island.spots.map((spot) => (
                 <button
                   key={spot.id}
-                  className={`spot spot-${spot.state}`}
+                  className={spotClass(spot)}
                   onClick={() => void dig(spot)}
                   disabled={spot.state === 'locked' || phase !== 'idle'}
-                  title={`${spot.name} — ${SPOT_LABEL[spot.state]}`}
+                  title={`${spot.name} — ${spotLabel(spot)}`}
                 >
                   <span className="spot-marker">
                     {spot.state === 'digging' || spot.state === 'proving' ? (
@@ -372,7 +457,7 @@ export default function Play() {
                     )}
                   </span>
                   <span className="spot-name">{spot.name}</span>
-                  <span className="spot-grade">G{spot.grade}</span>
+                  <span className="spot-grade">G{gradeLabel(spot.grade)}</span>
                 </button>
               ))}
             </div>
@@ -387,10 +472,12 @@ export default function Play() {
           question={question}
           result={result}
           hint={hint}
+          reported={reported}
           lesson={lesson}
           lessonGrade={lessonGrade}
           onSubmit={submit}
           onHint={showHint}
+          onReport={() => void report()}
           onLesson={() => void openLesson()}
           onLessonAnswer={(a, skip) => void submitLesson(a, skip)}
           onNext={() => expedition && void nextQuestion(expedition.id)}
@@ -413,22 +500,22 @@ function NotebookDrawer({
 
   const byIsland = new Map<string, NotebookTip[]>()
   for (const tip of notebook?.tips ?? []) {
-    const key = tip.islandName || 'Somewhere at sea'
+    const key = tip.islandName || t('Somewhere at sea')
     byIsland.set(key, [...(byIsland.get(key) ?? []), tip])
   }
 
   return (
     <div className="notebook">
       <div className="notebook-head">
-        <h3>🧭 The guide's notebook</h3>
+        <h3>🧭 {t("The guide's notebook")}</h3>
         <button className="btn btn-ghost" onClick={onClose}>
-          Close
+          {t('Close')}
         </button>
       </div>
-      {!notebook && <p className="vault-empty">Opening the notebook…</p>}
+      {!notebook && <p className="vault-empty">{t('Opening the notebook…')}</p>}
       {notebook && notebook.tips.length === 0 && (
         <p className="vault-empty">
-          No tips yet! The guide writes one down whenever a spot gets tricky.
+          {t('No tips yet! The guide writes one down whenever a spot gets tricky.')}
         </p>
       )}
       {[...byIsland.entries()].map(([island, tips]) => (
@@ -481,7 +568,8 @@ function QuestTrophies({ quests }: { quests: QuestMapItem[] }) {
         onClick={() => setOpen((s) => !s)}
         aria-expanded={open}
       >
-        🏆 {quests.length === 1 ? '1 quest completed' : `${quests.length} quests completed`}
+        🏆{' '}
+        {quests.length === 1 ? t('1 quest completed') : t('{0} quests completed', quests.length)}
         <span className="quest-trophies-caret" aria-hidden>
           {open ? '▾' : '▸'}
         </span>
@@ -530,16 +618,152 @@ function ProgressRing({ progress, icon }: { progress: number; icon: string }) {
   )
 }
 
+// Only plain numbers get the numeric keypad: fractions need "/", and
+// measurements, money and times need letters or ":".
+function answerInputMode(answerType: string): 'decimal' | 'text' {
+  return answerType === 'integer' || answerType === 'decimal' ? 'decimal' : 'text'
+}
+
+// Multi-part answers travel as one string with the parts joined by "; " —
+// the server splits them back apart to grade.
+const ANSWER_SEPARATOR = '; '
+
+function MultiPartAnswer({
+  question,
+  onSubmit,
+}: {
+  question: Question
+  onSubmit: (answer: string) => void
+}) {
+  const [items, setItems] = useState<string[]>(question.choices ?? [])
+  const [picked, setPicked] = useState<Set<number>>(new Set())
+  const [blanks, setBlanks] = useState<string[]>(() => Array(question.blanks ?? 0).fill(''))
+
+  function move(i: number, delta: number) {
+    const j = i + delta
+    if (j < 0 || j >= items.length) return
+    const next = [...items]
+    ;[next[i], next[j]] = [next[j], next[i]]
+    setItems(next)
+  }
+
+  function toggle(i: number) {
+    const next = new Set(picked)
+    if (next.has(i)) next.delete(i)
+    else next.add(i)
+    setPicked(next)
+  }
+
+  function handleSubmit(e: FormEvent) {
+    e.preventDefault()
+    if (question.format === 'ordering') {
+      onSubmit(items.join(ANSWER_SEPARATOR))
+    } else if (question.format === 'multi_select') {
+      if (picked.size === 0) return
+      onSubmit(items.filter((_, i) => picked.has(i)).join(ANSWER_SEPARATOR))
+    } else {
+      if (blanks.some((b) => !b.trim())) return
+      onSubmit(blanks.map((b) => b.trim()).join(ANSWER_SEPARATOR))
+    }
+  }
+
+  const ready =
+    question.format === 'ordering' ||
+    (question.format === 'multi_select' && picked.size > 0) ||
+    (question.format === 'multi_blank' && blanks.every((b) => b.trim()))
+
+  return (
+    <form onSubmit={handleSubmit} className="multi-answer">
+      {question.format === 'ordering' && (
+        <ol className="order-list">
+          {items.map((item, i) => (
+            <li key={item + i} className="order-item">
+              <span>{item}</span>
+              <button
+                type="button"
+                className="order-move"
+                disabled={i === 0}
+                onClick={() => move(i, -1)}
+              >
+                ▲
+              </button>
+              <button
+                type="button"
+                className="order-move"
+                disabled={i === items.length - 1}
+                onClick={() => move(i, 1)}
+              >
+                ▼
+              </button>
+            </li>
+          ))}
+        </ol>
+      )}
+      {question.format === 'multi_select' && (
+        <div className="choice-grid">
+          {items.map((c, i) => (
+            <button
+              key={i}
+              type="button"
+              className={`choice${picked.has(i) ? ' choice-picked' : ''}`}
+              onClick={() => toggle(i)}
+            >
+              {picked.has(i) ? '✓ ' : ''}
+              {c}
+            </button>
+          ))}
+        </div>
+      )}
+      {question.format === 'multi_blank' && (
+        <div className="blank-list">
+          {blanks.map((b, i) => (
+            <input
+              key={i}
+              className="answer-input"
+              value={b}
+              onChange={(e) => setBlanks(blanks.map((v, j) => (j === i ? e.target.value : v)))}
+              placeholder={t('Blank {0}', i + 1)}
+              inputMode={answerInputMode(question.answerType)}
+              autoComplete="off"
+              autoFocus={i === 0}
+            />
+          ))}
+        </div>
+      )}
+      <button className="btn btn-kid" disabled={!ready}>
+        {t('Dig!')} ⛏️
+      </button>
+    </form>
+  )
+}
+
+// ReportButton lets a kid flag a question that looks wrong or makes no
+// sense; it turns into a thank-you once sent.
+function ReportButton({ reported, onReport }: { reported: boolean; onReport: () => void }) {
+  if (reported) {
+    return (
+      <p className="muted report-done">🚩 {t('Thanks — the Captain will check that one!')}</p>
+    )
+  }
+  return (
+    <button className="linklike report-link" onClick={onReport}>
+      🚩 {t('Something wrong with this question?')}
+    </button>
+  )
+}
+
 function ExpeditionOverlay({
   phase,
   expedition,
   question,
   result,
   hint,
+  reported,
   lesson,
   lessonGrade,
   onSubmit,
   onHint,
+  onReport,
   onLesson,
   onLessonAnswer,
   onNext,
@@ -550,10 +774,12 @@ function ExpeditionOverlay({
   question: Question | null
   result: AnswerResult | null
   hint: string | null
+  reported: boolean
   lesson: Lesson | null
   lessonGrade: LessonGrade | null
   onSubmit: (answer: string) => void
   onHint: () => void
+  onReport: () => void
   onLesson: () => void
   onLessonAnswer: (answer: string, skip: boolean) => void
   onNext: () => void
@@ -571,7 +797,7 @@ function ExpeditionOverlay({
     }
   }, [phase, question])
 
-  // Prove-tier countdown: a nudge for speed, never a guillotine — answers
+  // Prove/challenge-tier countdown: a nudge for speed, never a guillotine — answers
   // are accepted after it runs out.
   useEffect(() => {
     if (phase !== 'question' || !question?.timeLimitSecs) {
@@ -594,9 +820,9 @@ function ExpeditionOverlay({
     <div className="expedition-backdrop">
       <div className="expedition">
         <div className="expedition-head">
-          <strong>⛏️ {expedition?.skillName ?? 'Expedition'}</strong>
+          <strong>⛏️ {expedition?.skillName ?? t('Expedition')}</strong>
           <button className="btn btn-ghost btn-ghost-dark" onClick={onClose}>
-            Sail home
+            {t('Sail home')}
           </button>
         </div>
 
@@ -616,7 +842,7 @@ function ExpeditionOverlay({
         {(phase === 'starting' || phase === 'loading') && (
           <div className="quest-loading">
             <span className="compass">🧭</span>
-            <p>{phase === 'starting' ? 'Setting sail…' : 'Consulting the map…'}</p>
+            <p>{phase === 'starting' ? t('Setting sail…') : t('Consulting the map…')}</p>
           </div>
         )}
 
@@ -629,12 +855,18 @@ function ExpeditionOverlay({
                   style={{ width: `${(secondsLeft / question.timeLimitSecs) * 100}%` }}
                 />
                 {secondsLeft === 0 && (
-                  <p className="timer-up">⏰ Time's up — give it your best guess!</p>
+                  <p className="timer-up">⏰ {t("Time's up — give it your best guess!")}</p>
                 )}
               </div>
             ) : null}
             <p className="quest-text">{question.text}</p>
-            {question.format === 'multiple_choice' && question.choices ? (
+            {question.diagram && <QuestionDiagram diagram={question.diagram} />}
+            {(question.format === 'ordering' ||
+              question.format === 'multi_select' ||
+              question.format === 'multi_blank') ? (
+              <MultiPartAnswer key={question.index} question={question} onSubmit={onSubmit} />
+            ) : (question.format === 'multiple_choice' || question.format === 'true_false') &&
+              question.choices ? (
               <div className="choice-grid">
                 {question.choices.map((c, i) => (
                   <button key={i} className="choice" onClick={() => onSubmit(c)}>
@@ -650,14 +882,15 @@ function ExpeditionOverlay({
                   value={answer}
                   onChange={(e) => setAnswer(e.target.value)}
                   placeholder="?"
-                  inputMode={question.answerType === 'fraction' ? 'text' : 'decimal'}
+                  inputMode={answerInputMode(question.answerType)}
                   autoComplete="off"
                 />
                 <button className="btn btn-kid" disabled={!answer.trim()}>
-                  Dig! ⛏️
+                  {t('Dig!')} ⛏️
                 </button>
               </form>
             )}
+            <ReportButton reported={reported} onReport={onReport} />
           </div>
         )}
 
@@ -666,53 +899,65 @@ function ExpeditionOverlay({
             {result.correct ? (
               <>
                 <div className="feedback-big">
-                  {result.streak >= 3 ? `🔥 ${result.streak} in a row!` : '✨ Treasure found!'}
+                  {result.streak >= 3
+                    ? `🔥 ${t('{0} in a row!', result.streak)}`
+                    : `✨ ${t('Treasure found!')}`}
                 </div>
                 {result.gem && (
                   <div className="gem-pop">
-                    💎 You earned a <strong>{result.gem.rarity}</strong> gem!
+                    💎 {tn('You earned a {0} gem!', <strong>{t(result.gem.rarity)}</strong>)}
                   </div>
                 )}
               </>
             ) : (
               <>
-                <div className="feedback-big">🌊 Not quite!</div>
+                <div className="feedback-big">
+                  {result.grade === 'near_miss' || result.grade === 'unsimplified'
+                    ? `🎯 ${t('So close!')}`
+                    : `🌊 ${t('Not quite!')}`}
+                </div>
+                {result.reason && <p className="feedback-reason">{result.reason}</p>}
                 {result.correctAnswer ? (
                   <p className="feedback-answer">
-                    The treasure was <strong>{result.correctAnswer}</strong>
+                    {tn('The treasure was {0}', <strong>{result.correctAnswer}</strong>)}
                   </p>
                 ) : (
                   // Quest questions come back until solved — the server keeps
                   // the answer sealed so it can't be copied on the retry.
                   <p className="feedback-answer">
-                    The Captain keeps the answer sealed until you crack it! 🗝️ Try again on your
-                    next voyage.
+                    {t('The Captain keeps the answer sealed until you crack it!')} 🗝️{' '}
+                    {t('Try again on your next voyage.')}
                   </p>
                 )}
                 {result.explanation && <p className="feedback-explain">{result.explanation}</p>}
                 {result.hintAvailable && !hint && (
                   <button className="btn btn-secondary" onClick={onHint}>
-                    🗺️ Show me a clue
+                    🗺️ {t('Show me a clue')}
                   </button>
                 )}
                 {hint && <p className="hint-box">🗺️ {hint}</p>}
               </>
             )}
             {result.mastery && result.mastery.to !== 'mastered' && result.mastery.from === 'learning' && (
-              <p className="tier-up">🗝️ You found the vault — now prove it to open the chest!</p>
+              <p className="tier-up">
+                {result.mastery.tier === 'challenge'
+                  ? `⚡ ${t('Proven! One last challenge — beat the clock to open the chest!')}`
+                  : `🗝️ ${t('You found the vault — now prove it to open the chest!')}`}
+              </p>
             )}
+            <ReportButton reported={reported} onReport={onReport} />
             {result.lessonPending && !result.done ? (
               <>
                 <button className="btn btn-kid btn-block" onClick={onLesson}>
-                  🧭 The guide has a tip for you!
+                  🧭 {t('The guide has a tip for you!')}
                 </button>
                 <button className="linklike" onClick={onNext}>
-                  Skip the tip →
+                  {t('Skip the tip →')}
                 </button>
               </>
             ) : (
               <button className="btn btn-kid btn-block" onClick={onNext}>
-                Next clue →
+                {t('Next clue →')}
               </button>
             )}
           </div>
@@ -721,7 +966,7 @@ function ExpeditionOverlay({
         {phase === 'lesson' && !lesson && (
           <div className="quest-loading">
             <span className="compass">🧭</span>
-            <p>The guide is drawing a picture for you…</p>
+            <p>{t('The guide is drawing a picture for you…')}</p>
           </div>
         )}
 
@@ -751,7 +996,7 @@ function ExpeditionOverlay({
                       autoFocus
                     />
                     <button className="btn btn-kid" disabled={!practiceAnswer.trim()}>
-                      Try it!
+                      {t('Try it!')}
                     </button>
                   </div>
                   <button
@@ -759,7 +1004,7 @@ function ExpeditionOverlay({
                     className="linklike"
                     onClick={() => onLessonAnswer('', true)}
                   >
-                    Skip practice →
+                    {t('Skip practice →')}
                   </button>
                 </div>
               </form>
@@ -768,18 +1013,18 @@ function ExpeditionOverlay({
             {lessonGrade && (
               <div className={`feedback ${lessonGrade.correct ? 'feedback-yes' : 'feedback-no'}`}>
                 <div className="feedback-big">
-                  {lessonGrade.correct ? '🌟 You got it!' : '💙 Good try!'}
+                  {lessonGrade.correct ? `🌟 ${t('You got it!')}` : `💙 ${t('Good try!')}`}
                 </div>
                 {!lessonGrade.correct && lessonGrade.correctAnswer && (
                   <p className="feedback-answer">
-                    The answer was <strong>{lessonGrade.correctAnswer}</strong>
+                    {tn('The answer was {0}', <strong>{lessonGrade.correctAnswer}</strong>)}
                   </p>
                 )}
                 {lessonGrade.explanation && (
                   <p className="feedback-explain">{lessonGrade.explanation}</p>
                 )}
                 <button className="btn btn-kid btn-block" onClick={onNext}>
-                  Back to the hunt →
+                  {t('Back to the hunt →')}
                 </button>
               </div>
             )}
@@ -788,46 +1033,67 @@ function ExpeditionOverlay({
 
         {phase === 'summary' && result?.summary && (
           <div className="summary">
-            {result.summary.questComplete ? (
+            {expedition?.placement ? (
+              <>
+                <div className="summary-big">🧭</div>
+                <h3>{t('Map charted!')}</h3>
+                <p className="unlock-note">
+                  🗺️{' '}
+                  {result.summary.placedSkills
+                    ? t(
+                        'You already know {0} spots — their treasure is yours!',
+                        result.summary.placedSkills,
+                      )
+                    : t('Your adventure starts at the first islands.')}
+                </p>
+              </>
+            ) : result.summary.questComplete ? (
               <>
                 <div className="summary-big chest-open">🏆</div>
-                <h3>Quest complete! 🏆</h3>
+                <h3>{t('Quest complete!')} 🏆</h3>
                 <p className="unlock-note">
-                  You solved every question the Captain left for you!
+                  {t('You solved every question the Captain left for you!')}
                 </p>
               </>
             ) : result.summary.mastered ? (
               <>
                 <div className="summary-big chest-open">💰</div>
-                <h3>Treasure chest opened!</h3>
+                <h3>{t('Treasure chest opened!')}</h3>
                 {result.unlockedSkillIds && result.unlockedSkillIds.length > 0 && (
                   <p className="unlock-note">
-                    🗺️ The fog lifted on {result.unlockedSkillIds.length} new{' '}
-                    {result.unlockedSkillIds.length === 1 ? 'spot' : 'spots'}!
+                    🗺️{' '}
+                    {result.unlockedSkillIds.length === 1
+                      ? t('The fog lifted on 1 new spot!')
+                      : t('The fog lifted on {0} new spots!', result.unlockedSkillIds.length)}
                   </p>
                 )}
               </>
             ) : (
               <>
                 <div className="summary-big">⛵</div>
-                <h3>Expedition complete!</h3>
+                <h3>{t('Expedition complete!')}</h3>
               </>
             )}
-            <p>
-              You dug up <strong>{result.summary.correct}</strong> of{' '}
-              <strong>{result.summary.questions}</strong> treasures.
-            </p>
+            {!expedition?.placement && (
+              <p>
+                {tn(
+                  'You dug up {0} of {1} treasures.',
+                  <strong>{result.summary.correct}</strong>,
+                  <strong>{result.summary.questions}</strong>,
+                )}
+              </p>
+            )}
             {result.summary.gems && result.summary.gems.length > 0 && (
               <div className="summary-gems">
                 {result.summary.gems.map((g, i) => (
                   <span key={i} className={`gem-chip gem-${g.rarity}`}>
-                    💎 {g.rarity} {g.type}
+                    💎 {t(g.rarity)} {t(g.type)}
                   </span>
                 ))}
               </div>
             )}
             <button className="btn btn-kid btn-block" onClick={onClose}>
-              Back to the map
+              {t('Back to the map')}
             </button>
           </div>
         )}
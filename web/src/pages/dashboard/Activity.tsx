@@ -474,6 +474,20 @@ function ExpeditionRow({
   const [detailLoading, setDetailLoading] = useState(false)
   const cacheKey = `${childId}:${exp.sessionId}`
   const detail = details[cacheKey] ?? null
+  const [reported, setReported] = useState<Set<number>>(new Set())
+
+  // Flag a question that was wrong or confusing; the server retires it so
+  // no learner is asked it again.
+  const [report, reporting] = useAction(async (seq: number) => {
+    const reason = window.prompt('What was wrong with this question? (optional)')
+    if (reason === null) return
+    try {
+      await api.reportActivityAnswer(token, childId, exp.sessionId, seq, reason.trim())
+      setReported((prev) => new Set(prev).add(seq))
+    } catch (err) {
+      setDetailError(err instanceof Error ? err.message : String(err))
+    }
+  })
 
   // Lazy-fetch the per-question detail on first expand; the page-level
   // cache (keyed by child + session) means a re-expand never refetches.
@@ -547,6 +561,17 @@ function ExpeditionRow({
                       <span className="muted"> · {Math.round(a.timeMs / 1000)}s</span>
                     </span>
                     <span className="muted timeline-answer-skill">{a.skillName}</span>
+                    {reported.has(a.seq) ? (
+                      <span className="muted timeline-answer-report">🚩 Reported</span>
+                    ) : (
+                      <button
+                        className="linklike timeline-answer-report"
+                        onClick={() => report(a.seq)}
+                        disabled={reporting}
+                      >
+                        Report question
+                      </button>
+                    )}
                   </li>
                 ))}
               </ul>
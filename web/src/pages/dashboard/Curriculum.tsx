@@ -1,6 +1,6 @@
 import { useEffect, useMemo, useState } from 'react'
 import { Link } from 'react-router-dom'
-import { api, cachedCurriculum, type CurriculumIsland } from '../../api'
+import { api, cachedCurriculum, gradeLabel, type CurriculumIsland } from '../../api'
 import { track } from '../../analytics'
 import Skeleton from '../../components/Skeleton'
 import { useDashboard } from './context'
@@ -143,7 +143,7 @@ export default function Curriculum() {
                 <li key={s.id} className="curriculum-row">
                   <span className="curriculum-skill">
                     {s.name}
-                    <span className="muted curriculum-grade">grade {s.grade}</span>
+                    <span className="muted curriculum-grade">grade {gradeLabel(s.grade)}</span>
                   </span>
                   {childId &&
                     (statsLoading ? (
@@ -2,10 +2,16 @@ import { useEffect, useState, type FormEvent } from 'react'
 import { Navigate, useSearchParams } from 'react-router-dom'
 import {
   api,
+  gradeLabel,
+  GRADES,
+  LOCALES,
+  STANDARDS,
   type ChildProfile,
   type ChildStats,
   type ChildWithSummary,
   type Device,
+  type Locale,
+  type Standard,
 } from '../../api'
 import { track } from '../../analytics'
 import { useAction } from '../../hooks'
@@ -187,6 +193,8 @@ function AddChildModal({
 }) {
   const [name, setName] = useState('')
   const [grade, setGrade] = useState(3)
+  const [standard, setStandard] = useState<Standard>('ccss')
+  const [locale, setLocale] = useState<Locale>('en')
   const [pin, setPin] = useState('')
   const [busy, setBusy] = useState(false)
   const [error, setError] = useState<string | null>(null)
@@ -196,7 +204,7 @@ function AddChildModal({
     setBusy(true)
     setError(null)
     try {
-      await api.addChild(token, familyId, name, grade, pin)
+      await api.addChild(token, familyId, name, grade, pin, standard, locale)
       track.childAdded(grade)
       await onAdded()
     } catch (err) {
@@ -217,9 +225,29 @@ function AddChildModal({
           <label>
             Grade
             <select value={grade} onChange={(e) => setGrade(Number(e.target.value))}>
-              {[2, 3, 4, 5].map((g) => (
+              {GRADES.map((g) => (
                 <option key={g} value={g}>
-                  Grade {g}
+                  Grade {gradeLabel(g)}
+                </option>
+              ))}
+            </select>
+          </label>
+          <label>
+            Curriculum
+            <select value={standard} onChange={(e) => setStandard(e.target.value as Standard)}>
+              {STANDARDS.map((s) => (
+                <option key={s.id} value={s.id}>
+                  {s.name}
+                </option>
+              ))}
+            </select>
+          </label>
+          <label>
+            Language <span className="muted">(questions and feedback)</span>
+            <select value={locale} onChange={(e) => setLocale(e.target.value as Locale)}>
+              {LOCALES.map((l) => (
+                <option key={l.id} value={l.id}>
+                  {l.name}
                 </option>
               ))}
             </select>
@@ -320,7 +348,7 @@ function ChildCard({
         <div className="child-meta">
           <strong>{profile.name}</strong>
           <span className="muted">
-            Grade {profile.grade}
+            Grade {gradeLabel(profile.grade)}
             {!profile.hasPin && <span className="pin-chip">no PIN</span>}
           </span>
         </div>
@@ -16,6 +16,11 @@ import SkillSelect from '../../components/SkillSelect'
 import { useDashboard } from './context'
 import { QUEST_STATUS_LABEL } from './questStatus'
 
+// Formats whose answer is picked from (or arranged out of) a choice list.
+const CHOICE_FORMATS = ['multiple_choice', 'true_false', 'ordering', 'multi_select']
+// Formats whose answer has several parts, written with "; " between them.
+const MULTI_PART_FORMATS = ['ordering', 'multi_select', 'multi_blank']
+
 const EMPTY_QUESTION: QuestQuestionInput = {
   text: '',
   answer: '',
@@ -478,7 +483,8 @@ function QuestionForm({
       answerType,
       format,
       choices:
-        format === 'multiple_choice'
+        // True/false choices are fixed server-side.
+        CHOICE_FORMATS.includes(format) && format !== 'true_false'
           ? choicesText
               .split(',')
               .map((c) => c.trim())
@@ -505,10 +511,14 @@ function QuestionForm({
           Type
           <select
             value={format}
-            onChange={(e) => setFormat(e.target.value as 'numeric' | 'multiple_choice')}
+            onChange={(e) => setFormat(e.target.value as QuestQuestion['format'])}
           >
             <option value="numeric">Numeric</option>
             <option value="multiple_choice">Multiple choice</option>
+            <option value="true_false">True / false</option>
+            <option value="ordering">Put in order</option>
+            <option value="multi_select">Pick all that apply</option>
+            <option value="multi_blank">Several blanks</option>
           </select>
         </label>
         <label>
@@ -517,7 +527,11 @@ function QuestionForm({
             <option value="integer">Integer</option>
             <option value="decimal">Decimal</option>
             <option value="fraction">Fraction</option>
-            {format === 'multiple_choice' && <option value="text">Text</option>}
+            <option value="mixed_number">Mixed number</option>
+            <option value="measurement">Measurement (with unit)</option>
+            <option value="money">Money</option>
+            <option value="time">Time</option>
+            {CHOICE_FORMATS.includes(format) && <option value="text">Text</option>}
           </select>
         </label>
         <label>
@@ -525,12 +539,18 @@ function QuestionForm({
           <input
             value={answer}
             onChange={(e) => setAnswer(e.target.value)}
-            placeholder="e.g. 6"
+            placeholder={
+              format === 'true_false'
+                ? 'True or False'
+                : MULTI_PART_FORMATS.includes(format)
+                  ? 'e.g. 3; 6; 9 (in order)'
+                  : 'e.g. 6'
+            }
             required
           />
         </label>
       </div>
-      {format === 'multiple_choice' && (
+      {CHOICE_FORMATS.includes(format) && format !== 'true_false' && (
         <label>
           Choices <span className="muted">(comma-separated, must include the answer)</span>
           <input